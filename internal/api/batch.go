@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Mutation batching.
+//
+// A bulk edit (relabel 50 issues, move a sprint's worth to Done) is one
+// request per issue through the per-method mutations — 50 requests against a
+// budget that is counted per request. A Batch sends the same mutations as
+// aliased fields of one document (m0: issueUpdate(...) m1: issueUpdate(...)),
+// maxBatchMutations at a time. GraphQL runs a document's mutation fields in
+// order and independently, so one rejected mutation does not undo or block the
+// others; each BatchOp carries its own outcome.
+
+// maxBatchMutations caps the mutations per request. Mutation complexity is
+// small and flat, so the cap is about blast radius — how much one transport
+// failure leaves in doubt — not the complexity limit.
+const maxBatchMutations = 25
+
+// BatchMutation is one mutation in a Batch: the top-level field it calls, its
+// arguments, and the selection set of its payload.
+type BatchMutation struct {
+	Field     string     // e.g. "issueUpdate"
+	Args      []BatchArg // passed as variables, in order
+	Selection string     // payload selection, e.g. "success issue { id }"
+}
+
+// BatchArg is one argument of a BatchMutation, with its GraphQL type.
+type BatchArg struct {
+	Name  string // e.g. "input"
+	Type  string // e.g. "IssueUpdateInput!"
+	Value any
+}
+
+// BatchOp is a queued mutation's outcome, filled in by Batch.Do.
+type BatchOp struct {
+	mutation BatchMutation
+	payload  map[string]json.RawMessage
+	err      error
+	done     bool
+}
+
+// Err is the mutation's own error: its GraphQL rejection, a success:false
+// payload, or the failure of the request that carried it. Nil once Do has
+// applied it.
+func (op *BatchOp) Err() error {
+	if !op.done {
+		return fmt.Errorf("%s: batch not sent", op.mutation.Field)
+	}
+	return op.err
+}
+
+// Decode unmarshals the payload's field (e.g. "issue") into v. A missing or
+// null field is an error, as it is for execMutation.
+func (op *BatchOp) Decode(field string, v any) error {
+	if err := op.Err(); err != nil {
+		return err
+	}
+	raw := op.payload[field]
+	if isJSONNull(raw) {
+		return fmt.Errorf("%s: %s missing or null in a success response", op.mutation.Field, field)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%s: decode %s: %w", op.mutation.Field, field, err)
+	}
+	return nil
+}
+
+// Batch collects mutations to send together. It is not safe for concurrent
+// use; build it on one goroutine and call Do once.
+type Batch struct {
+	c   *Client
+	ops []*BatchOp
+}
+
+// NewBatch starts an empty mutation batch.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{c: c}
+}
+
+// Len returns the number of queued mutations.
+func (b *Batch) Len() int { return len(b.ops) }
+
+// Add queues a mutation and returns the handle its outcome lands in.
+func (b *Batch) Add(m BatchMutation) *BatchOp {
+	op := &BatchOp{mutation: m}
+	b.ops = append(b.ops, op)
+	return op
+}
+
+// UpdateIssue queues the batched form of Client.UpdateIssue.
+func (b *Batch) UpdateIssue(issueID string, input map[string]any) *BatchOp {
+	return b.Add(BatchMutation{
+		Field: "issueUpdate",
+		Args: []BatchArg{
+			{Name: "id", Type: "String!", Value: issueID},
+			{Name: "input", Type: "IssueUpdateInput!", Value: input},
+		},
+		Selection: "success issue { id updatedAt }",
+	})
+}
+
+// ArchiveIssue queues the batched form of Client.ArchiveIssue.
+func (b *Batch) ArchiveIssue(issueID string) *BatchOp {
+	return b.Add(BatchMutation{
+		Field:     "issueArchive",
+		Args:      []BatchArg{{Name: "id", Type: "String!", Value: issueID}},
+		Selection: "success",
+	})
+}
+
+// Do sends the queued mutations, maxBatchMutations per request, and records
+// each one's outcome on its BatchOp. A request that fails as a whole (network,
+// rate limit, a malformed document) fails every mutation it carried and stops
+// the batch: the rest are failed with the same error rather than sent into the
+// same failure. Do returns that request-level error, or nil when every request
+// went through — individual rejections are only on the ops.
+func (b *Batch) Do(ctx context.Context) error {
+	for start := 0; start < len(b.ops); start += maxBatchMutations {
+		chunk := b.ops[start:min(start+maxBatchMutations, len(b.ops))]
+		if err := b.send(ctx, chunk); err != nil {
+			for _, op := range b.ops[start:] {
+				op.err, op.done = err, true
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// send runs one chunk as a single aliased mutation document.
+func (b *Batch) send(ctx context.Context, chunk []*BatchOp) error {
+	var decls, fields []string
+	vars := make(map[string]any)
+	for i, op := range chunk {
+		alias := fmt.Sprintf("m%d", i)
+		args := make([]string, len(op.mutation.Args))
+		for j, arg := range op.mutation.Args {
+			name := alias + "_" + arg.Name
+			decls = append(decls, fmt.Sprintf("$%s: %s", name, arg.Type))
+			args[j] = fmt.Sprintf("%s: $%s", arg.Name, name)
+			vars[name] = arg.Value
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s(%s) { %s }",
+			alias, op.mutation.Field, strings.Join(args, ", "), op.mutation.Selection))
+	}
+	query := fmt.Sprintf("mutation MutationBatch(%s) { %s }", strings.Join(decls, ", "), strings.Join(fields, " "))
+
+	var resp partialData
+	if err := b.c.query(ctx, query, vars, &resp); err != nil {
+		return err
+	}
+	failed := make(map[string]error, len(resp.errors))
+	for _, e := range resp.errors {
+		alias, _, _ := strings.Cut(e.Path, ".")
+		if _, seen := failed[alias]; !seen {
+			failed[alias] = e
+		}
+	}
+	for i, op := range chunk {
+		alias := fmt.Sprintf("m%d", i)
+		op.done = true
+		if err, ok := failed[alias]; ok {
+			op.err = fmt.Errorf("%s: %w", op.mutation.Field, err)
+			continue
+		}
+		op.payload, op.err = unwrapEnvelope(resp.data[alias], op.mutation.Field)
+	}
+	return nil
+}
+
+// partialData is a query result that tolerates field errors: query fills data
+// and, instead of failing, hands over errors that each carry a response path
+// (see Client.query).
+type partialData struct {
+	data   map[string]json.RawMessage
+	errors []*GraphQLError
+}
+
+func (p *partialData) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, &p.data)
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// batchServer answers MutationBatch documents: every mN: issueUpdate alias
+// succeeds except those whose id variable is in reject, which come back null
+// with an error pathed to the alias — Linear's partial-success shape.
+func batchServer(t *testing.T, reject map[string]bool, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		if !strings.HasPrefix(req.Query, "mutation MutationBatch(") {
+			t.Errorf("query = %q, want a MutationBatch document", req.Query)
+		}
+		data := map[string]any{}
+		var errs []map[string]any
+		for i := 0; ; i++ {
+			alias := fmt.Sprintf("m%d", i)
+			id, ok := req.Variables[alias+"_id"].(string)
+			if !ok {
+				break
+			}
+			if !strings.Contains(req.Query, alias+": issueUpdate(id: $"+alias+"_id, input: $"+alias+"_input)") {
+				t.Errorf("query lacks the %s alias field: %s", alias, req.Query)
+			}
+			if reject[id] {
+				data[alias] = nil
+				errs = append(errs, map[string]any{
+					"message":    "Entity not found: Issue",
+					"path":       []any{alias},
+					"extensions": map[string]any{"code": "INPUT_ERROR", "userError": true},
+				})
+				continue
+			}
+			data[alias] = map[string]any{"success": true, "issue": map[string]any{"id": id, "updatedAt": "2026-10-01T00:00:00Z"}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data, "errors": errs})
+	}))
+}
+
+// TestBatchUpdateIssues: 30 updates go out as two requests (25 + 5), and the
+// one the server rejects fails alone — the mutations around it still land.
+func TestBatchUpdateIssues(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	server := batchServer(t, map[string]bool{"issue-7": true}, &requests)
+	defer server.Close()
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(server.URL)
+
+	batch := client.NewBatch()
+	ops := make([]*BatchOp, 30)
+	for i := range ops {
+		ops[i] = batch.UpdateIssue(fmt.Sprintf("issue-%d", i), map[string]any{"labelIds": []string{"label-1"}})
+	}
+	if batch.Len() != 30 {
+		t.Fatalf("Len() = %d, want 30", batch.Len())
+	}
+	if err := batch.Do(context.Background()); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+
+	for i, op := range ops {
+		if i == 7 {
+			var gqlErr *GraphQLError
+			if err := op.Err(); err == nil || !errors.As(err, &gqlErr) || gqlErr.Path != "m7" {
+				t.Errorf("op 7 err = %v, want the GraphQL rejection pathed to m7", err)
+			}
+			continue
+		}
+		var issue struct{ ID string }
+		if err := op.Decode("issue", &issue); err != nil {
+			t.Errorf("op %d: %v", i, err)
+		} else if want := fmt.Sprintf("issue-%d", i); issue.ID != want {
+			t.Errorf("op %d decoded id %q, want %q", i, issue.ID, want)
+		}
+	}
+}
+
+// TestBatchRequestFailure: a request-level failure fails every op it carried
+// and every op after it, without sending them.
+func TestBatchRequestFailure(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errors": [{"message": "Syntax Error"}]}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(server.URL)
+
+	batch := client.NewBatch()
+	var ops []*BatchOp
+	for i := range 30 {
+		ops = append(ops, batch.ArchiveIssue(fmt.Sprintf("issue-%d", i)))
+	}
+	if err := batch.Do(context.Background()); err == nil {
+		t.Fatal("Do succeeded, want the request error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1 (the second chunk is not sent into the same failure)", got)
+	}
+	for i, op := range ops {
+		if op.Err() == nil {
+			t.Errorf("op %d has no error", i)
+		}
+	}
+}
+
+func TestBatchOpNotSent(t *testing.T) {
+	t.Parallel()
+	op := NewClient("k").NewBatch().ArchiveIssue("issue-1")
+	if op.Err() == nil {
+		t.Error("Err() before Do = nil, want not-sent error")
+	}
+}
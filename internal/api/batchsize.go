@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+)
+
+// Complexity-aware batch sizing.
+//
+// Linear rejects any single query scoring over maxQueryComplexity outright
+// ("Query too complex"), and the score grows with the items a query asks for:
+// issues per sync page, issues per details batch. Fixed sizes were tuned by
+// hand against one measurement — the details batch once grew from 10 to 15
+// past the cap and was silently rejected for days (#239). Instead, each bulk
+// path learns its per-item cost from every response's X-Complexity and sizes
+// the next request to fit under the cap with headroom; a rejection teaches the
+// sizer that the failed size was too big.
+
+// maxQueryComplexity is Linear's single-query complexity cap.
+const maxQueryComplexity = 10000
+
+// batchTargetFrac is the share of maxQueryComplexity a sized request aims
+// for: the rest is headroom for the query's fixed overhead and for a
+// selection that grows between measurements.
+const batchTargetFrac = 0.85
+
+// Size bounds for the two adaptive bulk paths. The initial sizes are the old
+// fixed ones, used until the first response is measured: the per-issue details
+// selection measured ~860 points live (2026-07-10: a batch of 15 scored
+// 12,897), so 10 issues fit with headroom.
+const (
+	detailsBatchInitial = 10
+	detailsBatchMin     = 1
+	detailsBatchMax     = 25
+	issuesPageInitial   = 100
+	issuesPageMin       = 10
+	issuesPageMax       = 250 // Linear's maximum first:
+)
+
+// adaptiveSize is one bulk path's item count, learned from measured cost.
+type adaptiveSize struct {
+	name              string // for the resize log line
+	initial, min, max int
+	mu                sync.Mutex
+	perItem           float64 // estimated complexity per item; 0 = not yet measured
+	lastSize          int     // last size reported, for logging changes only
+}
+
+func newAdaptiveSize(name string, initial, min, max int) *adaptiveSize {
+	return &adaptiveSize{name: name, initial: initial, min: min, max: max, lastSize: initial}
+}
+
+// size is the item count for the next request.
+func (s *adaptiveSize) size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sizeLocked()
+}
+
+func (s *adaptiveSize) sizeLocked() int {
+	if s.perItem <= 0 {
+		return s.initial
+	}
+	n := int(math.Floor(batchTargetFrac * maxQueryComplexity / s.perItem))
+	return max(s.min, min(s.max, n))
+}
+
+// observe learns from a request of items that scored cost. A rise is
+// adopted at once (the next request must fit); a fall is eased in, so one
+// cheap page does not swing the size back up into a rejection.
+func (s *adaptiveSize) observe(items int, cost float64) {
+	if items <= 0 || cost <= 0 {
+		return
+	}
+	per := cost / float64(items)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if per >= s.perItem {
+		s.perItem = per
+	} else {
+		s.perItem = 0.75*s.perItem + 0.25*per
+	}
+	s.logResizeLocked()
+}
+
+// tooComplex learns from a request of items that Linear rejected as over the
+// cap: each item cost at least cap/items, so the next size lands below items.
+func (s *adaptiveSize) tooComplex(items int) {
+	if items <= 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.perItem = max(s.perItem, maxQueryComplexity/float64(items))
+	s.logResizeLocked()
+}
+
+func (s *adaptiveSize) logResizeLocked() {
+	if n := s.sizeLocked(); n != s.lastSize {
+		log.Printf("[api] %s size %d -> %d (~%.0f complexity per item)", s.name, s.lastSize, n, s.perItem)
+		s.lastSize = n
+	}
+}
+
+// learn feeds one sized request's outcome back into s: the measured cost on
+// success, the rejection when the query was too complex.
+func (s *adaptiveSize) learn(items int, cost *complexitySink, err error) {
+	switch {
+	case err == nil && cost.seen:
+		s.observe(items, cost.value)
+	case IsQueryTooComplex(err):
+		s.tooComplex(items)
+	}
+}
+
+// complexitySink receives one request's X-Complexity from query.
+type complexitySink struct {
+	value float64
+	seen  bool
+}
+
+type complexitySinkKey struct{}
+
+// withComplexitySink returns ctx carrying sink: query stores the response's
+// X-Complexity there once the request settles.
+func withComplexitySink(ctx context.Context, sink *complexitySink) context.Context {
+	return context.WithValue(ctx, complexitySinkKey{}, sink)
+}
+
+// recordComplexity stores adm's measured complexity into ctx's sink, if any.
+func recordComplexity(ctx context.Context, adm *admission) {
+	sink, ok := ctx.Value(complexitySinkKey{}).(*complexitySink)
+	if !ok {
+		return
+	}
+	sink.value, sink.seen = adm.actualComplexity()
+}
+
+// IssuesPageSize is the page size for the next GetTeamIssuesPage: as many
+// issues as fit under the complexity cap at the measured per-issue cost.
+func (c *Client) IssuesPageSize() int {
+	return c.issuesPage.size()
+}
+
+// DetailsBatchSize is the issue count for the next GetIssueDetailsBatch, sized
+// the same way.
+func (c *Client) DetailsBatchSize() int {
+	return c.detailsBatch.size()
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/testutil"
+)
+
+func TestAdaptiveSize(t *testing.T) {
+	t.Parallel()
+	s := newAdaptiveSize("test", 10, 1, 25)
+	if got := s.size(); got != 10 {
+		t.Fatalf("unmeasured size = %d, want the initial 10", got)
+	}
+
+	// 860 per item: 8,500 / 860 → 9.
+	s.observe(10, 8600)
+	if got := s.size(); got != 9 {
+		t.Errorf("size after 860/item = %d, want 9", got)
+	}
+
+	// A rejection at 9 means each item cost at least 10,000/9.
+	s.tooComplex(9)
+	if got := s.size(); got != 7 {
+		t.Errorf("size after rejecting 9 = %d, want 7", got)
+	}
+
+	// One cheap batch eases the estimate down instead of resetting it.
+	s.observe(7, 7)
+	if got := s.size(); got != 10 {
+		t.Errorf("size after one cheap batch = %d, want 10 (1111 → ~834/item)", got)
+	}
+
+	// A rise is adopted at once, clamped to the minimum.
+	s.observe(1, 20000)
+	if got := s.size(); got != 1 {
+		t.Errorf("size after an over-cap item = %d, want the minimum 1", got)
+	}
+	for range 50 {
+		s.observe(25, 25)
+	}
+	if got := s.size(); got != 25 {
+		t.Errorf("size after many cheap batches = %d, want the maximum 25", got)
+	}
+}
+
+// TestBatchSizesLearnFromComplexity: the bulk paths feed each response's
+// X-Complexity, and a "Query too complex" rejection, back into their sizes.
+func TestBatchSizesLearnFromComplexity(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetResponse("TeamIssuesByUpdatedAt", map[string]any{"team": map[string]any{"issues": map[string]any{
+		"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+		"nodes":    []map[string]any{},
+	}}})
+	mock.SetComplexity("TeamIssuesByUpdatedAt", 4000)
+	batch := map[string]any{}
+	ids := make([]string, 10)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("issue-%d", i)
+		batch[fmt.Sprintf("i%d", i)] = detailsPayload("comment-1")
+	}
+	mock.SetResponse("IssueDetailsBatch", batch)
+	mock.SetComplexity("IssueDetailsBatch", 9000)
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+	ctx := context.Background()
+	if got := c.IssuesPageSize(); got != issuesPageInitial {
+		t.Errorf("initial page size = %d, want %d", got, issuesPageInitial)
+	}
+	if got := c.DetailsBatchSize(); got != detailsBatchInitial {
+		t.Errorf("initial batch size = %d, want %d", got, detailsBatchInitial)
+	}
+
+	// 4,000 for a page of 100 is 40 per issue: 8,500 / 40 → 212.
+	if _, _, err := c.GetTeamIssuesPage(ctx, "team-1", "", 100); err != nil {
+		t.Fatalf("GetTeamIssuesPage: %v", err)
+	}
+	if got := c.IssuesPageSize(); got != 212 {
+		t.Errorf("page size after a cheap page = %d, want 212", got)
+	}
+
+	// 9,000 for 10 issues is 900 per issue: 8,500 / 900 → 9.
+	if _, err := c.GetIssueDetailsBatch(ctx, ids); err != nil {
+		t.Fatalf("GetIssueDetailsBatch: %v", err)
+	}
+	if got := c.DetailsBatchSize(); got != 9 {
+		t.Errorf("batch size after a 9,000 batch = %d, want 9", got)
+	}
+
+	// A server that rejects everything walks the batch down to one issue,
+	// learning from each rejection; the lone issue, rejected even without
+	// attachments, fails the call.
+	mock.SetError("IssueDetailsBatch", errors.New("Query too complex"))
+	if _, err := c.GetIssueDetailsBatch(ctx, ids[:9]); !IsQueryTooComplex(err) {
+		t.Fatalf("err = %v, want a too-complex rejection", err)
+	}
+	if got := c.DetailsBatchSize(); got != detailsBatchMin {
+		t.Errorf("batch size after rejections down to one issue = %d, want %d", got, detailsBatchMin)
+	}
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"slices"
+	"time"
+)
+
+// Budget persistence.
+//
+// rateBudget lives in memory, so a restarted daemon knew nothing about the
+// hour it was restarted into: both axes started unseen (unlimited) until the
+// first response reported them, and the startup burst — viewer, workspace,
+// every team's metadata — went out ungoverned, with no reserve left for the
+// interactive reads a user makes against a fresh mount. A crash-looping
+// service spent the whole hour that way. The budget now exports its windows
+// and its hourly spend for the SQLite layer to persist (repo/budget.go), and
+// accepts the persisted windows back at startup: the ladder governs from the
+// first request, and the first response still snaps everything to server
+// truth.
+
+// BudgetWindow is one budget axis as Linear last reported it.
+type BudgetWindow struct {
+	Axis      string // "requests" or "complexity"
+	Limit     float64
+	Remaining float64
+	ResetAt   time.Time // zero when Linear sent no reset
+}
+
+// BudgetHour is this client's API spend within one UTC hour: responses
+// received (Linear counts them whatever their outcome) and the complexity they
+// reported.
+type BudgetHour struct {
+	Hour       time.Time // start of the hour, UTC
+	Requests   int64
+	Complexity float64
+}
+
+// BudgetWindows returns the axes Linear has reported so far (or that
+// RestoreBudget seeded); an axis never seen is omitted.
+func (c *Client) BudgetWindows() []BudgetWindow {
+	b := c.budget
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []BudgetWindow
+	for _, w := range []*window{&b.requests, &b.complexity} {
+		if w.seen {
+			out = append(out, BudgetWindow{Axis: w.name, Limit: w.limit, Remaining: w.remaining, ResetAt: w.resetAt})
+		}
+	}
+	return out
+}
+
+// RestoreBudget seeds the budget from persisted windows. Only axes no response
+// has reported yet are seeded — server truth always wins — and a window whose
+// reset has passed counts as full (the optimistic refill every window gets),
+// so a stale snapshot can only hold back work until the first response.
+// Call it before the client issues requests.
+func (c *Client) RestoreBudget(windows []BudgetWindow) {
+	b := c.budget
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, bw := range windows {
+		var w *window
+		switch bw.Axis {
+		case b.requests.name:
+			w = &b.requests
+		case b.complexity.name:
+			w = &b.complexity
+		default:
+			continue
+		}
+		if w.seen || bw.Limit <= 0 {
+			continue
+		}
+		w.limit, w.remaining, w.resetAt, w.seen = bw.Limit, bw.Remaining, bw.ResetAt, true
+	}
+}
+
+// TakeBudgetSpend returns the spend recorded since the last call, oldest hour
+// first, and forgets it: the caller owns persisting it.
+func (c *Client) TakeBudgetSpend() []BudgetHour {
+	b := c.budget
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]BudgetHour, 0, len(b.spend))
+	for _, h := range b.spend {
+		out = append(out, *h)
+	}
+	b.spend = nil
+	slices.SortFunc(out, func(a, b BudgetHour) int { return a.Hour.Compare(b.Hour) })
+	return out
+}
+
+// recordSpendLocked adds one response to the current hour's spend.
+func (b *rateBudget) recordSpendLocked(complexity float64) {
+	hour := b.now().UTC().Truncate(time.Hour)
+	if b.spend == nil {
+		b.spend = make(map[time.Time]*BudgetHour)
+	}
+	h, ok := b.spend[hour]
+	if !ok {
+		h = &BudgetHour{Hour: hour}
+		b.spend[hour] = h
+	}
+	h.Requests++
+	h.Complexity += complexity
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRestoreBudget_SeedsLadder: persisted windows seed unseen axes, so a
+// restarted client defers detail work in a drained hour before any response
+// lands, while interactive reads still pass.
+func TestRestoreBudget_SeedsLadder(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	c := &Client{budget: testBudget(clock)}
+	resetAt := clock.t.Add(30 * time.Minute)
+	c.RestoreBudget([]BudgetWindow{
+		{Axis: "requests", Limit: 1500, Remaining: 300, ResetAt: resetAt},
+		{Axis: "complexity", Limit: 3000000, Remaining: 2900000, ResetAt: resetAt},
+		{Axis: "bogus", Limit: 1, Remaining: 1},
+	})
+
+	if adm, _ := c.budget.admit("IssueDetailsBatch", pDetail); adm != nil {
+		t.Error("detail tier should defer with 300/1500 requests restored")
+	}
+	adm, dec := c.budget.admit("IssueDetailsBatch", pInteractive)
+	if adm == nil {
+		t.Fatalf("interactive tier should still admit: %q", dec.reason)
+	}
+	adm.release()
+
+	got := c.BudgetWindows()
+	if len(got) != 2 {
+		t.Fatalf("BudgetWindows = %+v, want the 2 known axes", got)
+	}
+	if got[0].Axis != "requests" || got[0].Remaining != 300 || !got[0].ResetAt.Equal(resetAt) {
+		t.Errorf("requests window = %+v", got[0])
+	}
+}
+
+// TestRestoreBudget_ServerTruthWins: an axis a response already reported is
+// never overwritten by the persisted snapshot.
+func TestRestoreBudget_ServerTruthWins(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	c := &Client{budget: testBudget(clock)}
+	adm, _ := c.budget.admit("Teams", pSkeleton)
+	adm.observe(fullHeaders(5, 3000000, 2000000, 2500, 2400, clock.t.Add(time.Hour)))
+
+	c.RestoreBudget([]BudgetWindow{{Axis: "requests", Limit: 1500, Remaining: 10}})
+
+	for _, w := range c.BudgetWindows() {
+		if w.Axis == "requests" && (w.Limit != 2500 || w.Remaining != 2400) {
+			t.Errorf("restore overwrote observed requests window: %+v", w)
+		}
+	}
+}
+
+// TestTakeBudgetSpend: settled responses accumulate per UTC hour, oldest
+// first; released admissions spend nothing; a take drains the ledger.
+func TestTakeBudgetSpend(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	c := &Client{budget: testBudget(clock)}
+	reset := clock.t.Add(time.Hour)
+
+	adm, _ := c.budget.admit("Teams", pSkeleton)
+	adm.observe(fullHeaders(5, 3000000, 2999995, 2500, 2499, reset))
+	adm, _ = c.budget.admit("Teams", pSkeleton)
+	adm.release()
+	clock.advance(time.Hour)
+	adm, _ = c.budget.admit("Issue", pList)
+	adm.rateLimited(fullHeaders(7, 3000000, 0, 2500, 2498, clock.t.Add(time.Hour)))
+
+	spend := c.TakeBudgetSpend()
+	if len(spend) != 2 {
+		t.Fatalf("spend = %+v, want 2 hours", spend)
+	}
+	if spend[0].Requests != 1 || spend[0].Complexity != 5 || !spend[0].Hour.Equal(newFakeClock().t) {
+		t.Errorf("first hour = %+v", spend[0])
+	}
+	if spend[1].Requests != 1 || spend[1].Complexity != 7 {
+		t.Errorf("second hour = %+v", spend[1])
+	}
+	if again := c.TakeBudgetSpend(); len(again) != 0 {
+		t.Errorf("second take = %+v, want empty", again)
+	}
+}
@@ -1,14 +1,15 @@
 package api
 
-// CDNClient is the second (and only other) network caller besides Client. Client
-// talks GraphQL to the Linear API; CDNClient talks HTTP to Linear's file CDN
+// CDNClient is the second network caller besides Client. Client talks GraphQL
+// to the Linear API; CDNClient talks HTTP to Linear's file CDN
 // (uploads.linear.app) for embedded-attachment bytes. Both embedded-file
 // consumers route through here — the FUSE read-path byte cache
 // (internal/fs/embeddedfilecache.go, GET) and the sync-side size probe
 // (internal/reconcile/extract.go, HEAD) — so CDN traffic shares one auth header,
 // one timeout policy, and one set of OTEL instruments instead of each wiring its
-// own invisible http.Client. This makes "who talks to the network" exactly two
-// clients in one package.
+// own invisible http.Client. This keeps "who talks to the network" to a short
+// list of clients in one package (the optional third, GitHubClient, is in
+// github.go).
 
 import (
 	"context"
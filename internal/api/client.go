@@ -4,20 +4,42 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"maps"
 	"net/http"
 	"os"
 	"strings"
 	gosync "sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
 )
 
-var debugRateLimit = os.Getenv("LINEARFS_DEBUG_RATE") != ""
-var debugAPI = os.Getenv("LINEARFS_DEBUG_API") != ""
+// debugRateLimit and debugAPI gate the verbose [ratelimit] and [API] traces:
+// on at startup from LINEARFS_DEBUG_RATE / LINEARFS_DEBUG_API, and switched
+// together at runtime by SetDebugLogging.
+var debugRateLimit, debugAPI atomic.Bool
+
+func init() {
+	debugRateLimit.Store(os.Getenv("LINEARFS_DEBUG_RATE") != "")
+	debugAPI.Store(os.Getenv("LINEARFS_DEBUG_API") != "")
+}
+
+// SetDebugLogging turns the per-request [API] trace and the [ratelimit] wait
+// trace on or off for every client in the process.
+func SetDebugLogging(on bool) {
+	debugRateLimit.Store(on)
+	debugAPI.Store(on)
+}
+
+// DebugLogging reports whether either debug trace is on.
+func DebugLogging() bool {
+	return debugRateLimit.Load() || debugAPI.Load()
+}
 
 const defaultAPIURL = "https://api.linear.app/graphql"
 
@@ -52,6 +74,14 @@ type Client struct {
 	apiURL     string
 	httpClient *http.Client
 
+	// uploadHTTP carries the credential-less PUT of UploadFile to the signed
+	// storage URL fileUpload returns (upload.go).
+	uploadHTTP *http.Client
+
+	// tokens, when non-nil, authenticates with OAuth instead of apiKey
+	// (oauth.go): every request asks it for a current access token.
+	tokens *TokenSource
+
 	// metrics are the api-layer OTEL instruments (metrics.go): every
 	// completed request records a count and a duration, per operation.
 	metrics apiMetrics
@@ -78,6 +108,18 @@ type Client struct {
 	// requests for circuitBreakerCooldown, then lets one probe through
 	// (circuitbreaker.go).
 	breaker *circuitBreaker
+
+	// lazy is the bulk-sync field projection (projection.go).
+	lazy LazyIssueFields
+
+	// issuesPage and detailsBatch size the two bulk paths from measured
+	// complexity (batchsize.go).
+	issuesPage   *adaptiveSize
+	detailsBatch *adaptiveSize
+
+	// responses answers repeated list reads for a few seconds
+	// (responsecache.go); every mutation clears it.
+	responses *responseCache
 }
 
 func NewClient(apiKey string) *Client {
@@ -93,16 +135,48 @@ func NewClient(apiKey string) *Client {
 		apiKey:     apiKey,
 		apiURL:     defaultAPIURL,
 		httpClient: &http.Client{Timeout: 30 * time.Second, CheckRedirect: errAPIRedirect},
+		uploadHTTP: &http.Client{Timeout: cdnTimeout, CheckRedirect: errUploadRedirect},
 		metrics:    newAPIMetrics(),
 		budget:     newRateBudget(time.Now),
 		limiter:    limiter,
 		breaker:    newCircuitBreaker(circuitBreakerThreshold, circuitBreakerCooldown, time.Now),
+
+		issuesPage:   newAdaptiveSize("issues page", issuesPageInitial, issuesPageMin, issuesPageMax),
+		detailsBatch: newAdaptiveSize("details batch", detailsBatchInitial, detailsBatchMin, detailsBatchMax),
+		responses:    newResponseCache(responseCacheTTL, responseCacheMaxEntries, time.Now),
 	}
 }
 
-// AuthHeader returns the Authorization header value for API requests.
+// NewOAuthClient is NewClient authenticating with an OAuth token source in
+// place of a personal API key; tokens refresh transparently.
+func NewOAuthClient(tokens *TokenSource) *Client {
+	c := NewClient("")
+	c.tokens = tokens
+	return c
+}
+
+// AuthHeader returns the Authorization header value for API requests: the
+// raw API key, or "Bearer <access token>" under OAuth. A failed refresh
+// yields "", which Linear rejects like any missing credential.
 func (c *Client) AuthHeader() string {
-	return c.apiKey
+	header, err := c.authHeader(context.Background())
+	if err != nil {
+		log.Printf("[oauth] %v", err)
+	}
+	return header
+}
+
+// authHeader is AuthHeader for a request in flight, refreshing the OAuth
+// token under ctx. A token that refreshed but failed to save is still used.
+func (c *Client) authHeader(ctx context.Context) (string, error) {
+	if c.tokens == nil {
+		return c.apiKey, nil
+	}
+	token, err := c.tokens.AccessToken(ctx)
+	if token == "" {
+		return "", err
+	}
+	return "Bearer " + token, err
 }
 
 // SetAPIURL overrides the API URL (for testing).
@@ -119,6 +193,7 @@ type graphQLResponse struct {
 	Data   json.RawMessage `json:"data"`
 	Errors []struct {
 		Message    string `json:"message"`
+		Path       []any  `json:"path"`
 		Extensions struct {
 			Code                   string `json:"code"`
 			UserError              bool   `json:"userError"`
@@ -133,12 +208,15 @@ type graphQLResponse struct {
 // actionable than the terse internal one (live example: internal "labelIds
 // contain parent labels" vs presentable "The label 'X' is a group and cannot
 // be assigned to projects directly."). Error() keeps the legacy "GraphQL
-// error: <message>" shape so existing string matches keep working.
+// error: <message>" shape so existing string matches keep working. Path is the
+// dotted response path of the field that failed (a batch's "m3"), empty for an
+// error about the request as a whole.
 type GraphQLError struct {
 	Message                string
 	Code                   string
 	UserError              bool
 	UserPresentableMessage string
+	Path                   string
 }
 
 func (e *GraphQLError) Error() string { return "GraphQL error: " + e.Message }
@@ -146,22 +224,39 @@ func (e *GraphQLError) Error() string { return "GraphQL error: " + e.Message }
 func (c *Client) query(ctx context.Context, query string, variables map[string]any, result any) error {
 	// Extract operation name for stats and logging
 	opName := extractOpName(query)
-	if debugAPI {
+	if debugAPI.Load() {
 		log.Printf("[API] Calling %s vars=%v", opName, variables)
 	}
 
+	// Response cache (responsecache.go): a fresh cached list read answers
+	// without a request; a mutation, sent or not, clears it once it returns.
+	isMutation := strings.HasPrefix(strings.TrimSpace(query), "mutation")
+	if isMutation {
+		defer c.responses.clear()
+	}
+	cacheKey, cacheable := c.responses.key(opName, variables)
+	var cached cacheLookup
+	if cacheable {
+		cached = c.responses.get(cacheKey)
+		if cached.fresh {
+			if debugAPI.Load() {
+				log.Printf("[API] %s answered from the response cache", opName)
+			}
+			return json.Unmarshal(cached.data, result)
+		}
+	}
+
 	// Circuit breaker: skip requests when connectivity is known to be down.
 	// This prevents burning rate limiter tokens on requests that will fail.
 	// allow() lets one probe through once the cooldown expires.
 	if !c.breaker.allow() {
-		return fmt.Errorf("circuit breaker open: skipping %s (connectivity down)", opName)
+		return fmt.Errorf("circuit breaker open: skipping %s (connectivity down): %w", opName, ErrUnreachable)
 	}
 
 	// Budget gate: the priority-reserve ladder (ratebudget.go). Reads that
 	// trip their tier's reserve defer immediately (the sync worker's queues
 	// retry them); a blocked mutation waits for the window when the wait is
 	// short, because writes are user-facing and must not be silently dropped.
-	isMutation := strings.HasPrefix(strings.TrimSpace(query), "mutation")
 	tier := tierFor(ctx, opName, isMutation)
 	adm, dec := c.budget.admit(opName, tier)
 	if adm == nil && tier == pWrite && dec.retryAfter > 0 && dec.retryAfter <= maxWriteWait {
@@ -201,7 +296,7 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 	}
 
 	// Verbose debug: log every wait >1ms
-	if debugRateLimit {
+	if debugRateLimit.Load() {
 		reservation := c.limiter.Reserve()
 		delay := reservation.Delay()
 		if delay > time.Millisecond {
@@ -235,6 +330,7 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 		elapsed := time.Since(reqStart)
 		c.metrics.record(ctx, opName, elapsed, queryErr)
 		c.logRequest(opName, variables, elapsed, queryErr, adm)
+		recordComplexity(ctx, adm)
 	}()
 
 	reqBody := graphQLRequest{
@@ -254,8 +350,22 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 		return queryErr
 	}
 
+	auth, err := c.authHeader(ctx)
+	if auth == "" && err != nil {
+		queryErr = fmt.Errorf("failed to authenticate request: %w", err)
+		return queryErr
+	}
+	if err != nil {
+		log.Printf("[oauth] %v", err)
+	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.apiKey)
+	req.Header.Set("Authorization", auth)
+	if cached.etag != "" {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+	if cached.lastModified != "" {
+		req.Header.Set("If-Modified-Since", cached.lastModified)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -264,6 +374,9 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 			log.Printf("[circuit-breaker] opened after %d consecutive errors, cooling down %s", n, circuitBreakerCooldown)
 		}
 		queryErr = fmt.Errorf("failed to execute request: %w", err)
+		if neverSent(err) {
+			queryErr = fmt.Errorf("%w: %w", ErrUnreachable, queryErr)
+		}
 		return queryErr
 	}
 	defer resp.Body.Close()
@@ -286,6 +399,22 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 		return queryErr
 	}
 
+	if resp.StatusCode == http.StatusNotModified && cached.data != nil {
+		adm.observe(resp.Header)
+		c.responses.revalidated(cacheKey, cached.gen)
+		if err := json.Unmarshal(cached.data, result); err != nil {
+			queryErr = fmt.Errorf("failed to parse data: %w", err)
+			return queryErr
+		}
+		return nil
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.tokens != nil {
+		// The access token was revoked or expired early: refresh it on the
+		// next request rather than failing every request until it expires.
+		c.tokens.Expire()
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		// Linear reports budget exhaustion as HTTP 400 with a RATELIMITED
 		// error code in the body. Non-200 bodies are Linear's own error
@@ -309,20 +438,39 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 	}
 
 	if len(gqlResp.Errors) > 0 {
-		first := gqlResp.Errors[0]
-		errMsg := first.Message
-		queryErr = &GraphQLError{
-			Message:                errMsg,
-			Code:                   first.Extensions.Code,
-			UserError:              first.Extensions.UserError,
-			UserPresentableMessage: first.Extensions.UserPresentableMessage,
+		errs := make([]*GraphQLError, len(gqlResp.Errors))
+		fieldErrors := true
+		for i, e := range gqlResp.Errors {
+			path := make([]string, len(e.Path))
+			for j, elem := range e.Path {
+				path[j] = fmt.Sprint(elem)
+			}
+			errs[i] = &GraphQLError{
+				Message:                e.Message,
+				Code:                   e.Extensions.Code,
+				UserError:              e.Extensions.UserError,
+				UserPresentableMessage: e.Extensions.UserPresentableMessage,
+				Path:                   strings.Join(path, "."),
+			}
+			fieldErrors = fieldErrors && len(path) > 0 && !IsRateLimited(errs[i])
 		}
+		queryErr = errs[0]
 		if IsRateLimited(queryErr) {
 			adm.rateLimited(resp.Header)
-			log.Printf("[ratelimit] ERROR: %s rate limited by Linear API: %s", opName, errMsg)
+			log.Printf("[ratelimit] ERROR: %s rate limited by Linear API: %s", opName, errs[0].Message)
 		} else {
 			adm.observe(resp.Header)
 		}
+		// A caller that accepts partial success (a mutation batch) gets the
+		// data alongside errors that each name the field they belong to. The
+		// request still records as failed; an unattributed error still fails
+		// the whole call.
+		if partial, ok := result.(*partialData); ok && fieldErrors && !isJSONNull(gqlResp.Data) {
+			if err := json.Unmarshal(gqlResp.Data, partial); err == nil {
+				partial.errors = errs
+				return nil
+			}
+		}
 		return queryErr
 	}
 
@@ -334,6 +482,9 @@ func (c *Client) query(ctx context.Context, query string, variables map[string]a
 		queryErr = fmt.Errorf("failed to parse data: %w", err)
 		return queryErr
 	}
+	if cacheable {
+		c.responses.put(cacheKey, cached.gen, gqlResp.Data, resp.Header)
+	}
 
 	return nil
 }
@@ -399,6 +550,32 @@ func (c *Client) syncLimiterSize() {
 	log.Printf("[ratelimit] observed request limit %.0f/hr; limiter re-sized", lim)
 }
 
+// RateLimits are operator caps on the client's API spend, for a key shared
+// with other tools or an enterprise quota the client should stay well under.
+// Zero fields keep the defaults: spend what the server reports, burst 16.
+type RateLimits struct {
+	RequestsPerHour   float64 // cap on the requests axis
+	ComplexityPerHour float64 // cap on the complexity-points axis
+	Burst             int     // micro-burst limiter size
+}
+
+// SetRateLimits applies operator caps. The caps only ever lower the limits
+// the response headers report; the limiter is re-seeded from the request cap
+// until the first response sizes it.
+func (c *Client) SetRateLimits(l RateLimits) {
+	c.budget.setCeilings(l.RequestsPerHour, l.ComplexityPerHour)
+	if l.Burst > 0 {
+		c.limiter.SetBurst(l.Burst)
+	}
+	if l.RequestsPerHour > 0 && l.RequestsPerHour < seedHourlyRequestLimit {
+		c.limiterMu.Lock()
+		if c.limiterSizedFor == 0 {
+			c.limiter.SetLimit(rate.Limit(l.RequestsPerHour / 3600.0))
+		}
+		c.limiterMu.Unlock()
+	}
+}
+
 // RateLimitResetAt returns the server-reported time when the rate limit
 // window resets (the later of the two axes' resets, parsed from the
 // per-axis millisecond headers). Zero until a response has been observed.
@@ -436,7 +613,8 @@ func (c *Client) GetTeams(ctx context.Context) ([]Team, error) {
 
 // GetTeamIssuesPage fetches a single page of issues ordered by updatedAt DESC.
 // Returns the issues, page info, and any error.
-// Use cursor="" for the first page.
+// Use cursor="" for the first page. pageSize normally comes from
+// IssuesPageSize; the page's measured complexity feeds back into it.
 func (c *Client) GetTeamIssuesPage(ctx context.Context, teamID string, cursor string, pageSize int) ([]Issue, PageInfo, error) {
 	vars := map[string]any{
 		"teamId": teamID,
@@ -445,14 +623,27 @@ func (c *Client) GetTeamIssuesPage(ctx context.Context, teamID string, cursor st
 	if cursor != "" {
 		vars["after"] = cursor
 	}
+	c.lazy.issueVars(vars)
 
-	cn, err := fetchConn[Issue](ctx, c, queryTeamIssuesByUpdatedAt, vars, "team", "issues")
+	var cost complexitySink
+	cn, err := fetchConn[Issue](withComplexitySink(ctx, &cost), c, queryTeamIssuesByUpdatedAt, vars, "team", "issues")
+	c.issuesPage.learn(pageSize, &cost, err)
 	if err != nil {
 		return nil, PageInfo{}, err
 	}
 	return cn.Nodes, *cn.PageInfo, nil
 }
 
+// GetTeamArchivedIssues fetches every issue of the team archived after since,
+// draining the connection (all-or-nothing, like every fetchAll caller). The
+// zero since fetches them all.
+func (c *Client) GetTeamArchivedIssues(ctx context.Context, teamID string, since time.Time) ([]Issue, error) {
+	return fetchAll[Issue](ctx, c, queryTeamArchivedIssues, map[string]any{
+		"teamId": teamID,
+		"since":  since.UTC().Format(time.RFC3339Nano),
+	}, "team", "issues")
+}
+
 // GetIssue fetches a single issue by ID
 func (c *Client) GetIssue(ctx context.Context, issueID string) (*Issue, error) {
 	return fetchOne[Issue](ctx, c, queryIssue, map[string]any{"id": issueID}, "issue")
@@ -473,6 +664,11 @@ func (c *Client) ArchiveIssue(ctx context.Context, issueID string) error {
 	return execMutationOK(ctx, c, mutationArchiveIssue, map[string]any{"id": issueID}, "issueArchive")
 }
 
+// UnarchiveIssue restores an archived issue
+func (c *Client) UnarchiveIssue(ctx context.Context, issueID string) error {
+	return execMutationOK(ctx, c, mutationUnarchiveIssue, map[string]any{"id": issueID}, "issueUnarchive")
+}
+
 // GetTeamMetadata fetches all metadata for a team: states, labels (team +
 // workspace, deduplicated), cycles, members — one combined query, with any
 // connection reporting hasNextPage drained to completion — and projects via
@@ -770,6 +966,71 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 	return fetchOne[User](ctx, c, queryViewer, nil, "viewer")
 }
 
+// GetOrganization fetches the workspace's identity and auth settings. The
+// SAML/SCIM flags are admin-only fields, so they come from a second query
+// whose failure is tolerated: for a non-admin token SAMLEnabled/SCIMEnabled
+// stay nil (unknown) and the rest of the organization is still returned.
+func (c *Client) GetOrganization(ctx context.Context) (*Organization, error) {
+	org, err := fetchOne[Organization](ctx, c, queryOrganization, nil, "organization")
+	if err != nil {
+		return nil, err
+	}
+	auth, err := fetchOne[organizationAuth](ctx, c, queryOrganizationAuth, nil, "organization")
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// intentionally best-effort: the SSO/SCIM flags need an admin token; a
+		// non-admin key renders them as unknown (recovers via an admin token on
+		// the next fetch)
+		return org, nil
+	}
+	org.SAMLEnabled = &auth.SAMLEnabled
+	org.SCIMEnabled = &auth.SCIMEnabled
+	return org, nil
+}
+
+// GetEmojis fetches the workspace's custom emojis, drained.
+func (c *Client) GetEmojis(ctx context.Context) ([]Emoji, error) {
+	return fetchAll[Emoji](ctx, c, queryEmojis, nil, "emojis")
+}
+
+// GetCustomViews fetches the saved views visible to the API key, drained.
+func (c *Client) GetCustomViews(ctx context.Context) ([]CustomView, error) {
+	return fetchAll[CustomView](ctx, c, queryCustomViews, nil, "customViews")
+}
+
+// GetCustomViewIssueIDs returns the IDs of every issue a saved view matches,
+// drained. All-or-nothing like GetTeamIssueIDs: the result replaces the
+// view's cached membership wholesale.
+func (c *Client) GetCustomViewIssueIDs(ctx context.Context, viewID string) ([]string, error) {
+	nodes, err := fetchAll[idNode](ctx, c, queryCustomViewIssueIDs, map[string]any{"viewId": viewID}, "customView", "issues")
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.ID)
+	}
+	return ids, nil
+}
+
+// GetTeamTemplates fetches a team's issue templates, drained. Templates of
+// other types (project, document) share the connection and are dropped.
+func (c *Client) GetTeamTemplates(ctx context.Context, teamID string) ([]Template, error) {
+	all, err := fetchAll[Template](ctx, c, queryTeamTemplates, map[string]any{"teamId": teamID}, "team", "templates")
+	if err != nil {
+		return nil, err
+	}
+	templates := all[:0]
+	for _, t := range all {
+		if t.Type == TemplateTypeIssue {
+			templates = append(templates, t)
+		}
+	}
+	return templates, nil
+}
+
 // CreateIssue creates a new issue
 func (c *Client) CreateIssue(ctx context.Context, input map[string]any) (*Issue, error) {
 	return execMutation[Issue](ctx, c, mutationCreateIssue, map[string]any{"input": input}, "issueCreate", "issue")
@@ -778,12 +1039,18 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]any) (*Issue,
 // IssueDetails contains comments, documents, attachments, and relations for
 // an issue. Relations are the outgoing rows this issue owns; InverseRelations
 // are incoming rows owned by the other issue (their `Issue` field is set).
+//
+// AttachmentsOmitted marks a batch fetched with attachments projected out
+// (api.lazy_issue_fields): Attachments is empty because it was not asked for,
+// not because the issue has none, so it must be neither persisted nor pruned
+// against.
 type IssueDetails struct {
-	Comments         []Comment
-	Documents        []Document
-	Attachments      []Attachment
-	Relations        []IssueRelation
-	InverseRelations []IssueRelation
+	Comments           []Comment
+	Documents          []Document
+	Attachments        []Attachment
+	Relations          []IssueRelation
+	InverseRelations   []IssueRelation
+	AttachmentsOmitted bool
 }
 
 // issueDetailsPayload is the wire shape of one issue's IssueDetailsSelection,
@@ -831,17 +1098,85 @@ func (c *Client) GetIssueDetails(ctx context.Context, issueID string) (*IssueDet
 // GetIssueDetailsBatch fetches comments, documents, attachments, and relations
 // for multiple issues in a single query, using GraphQL aliases to batch requests.
 //
-// The result is all-or-nothing: a nil-error return guarantees the map holds a
-// non-nil entry for every requested issue ID. A missing alias, a null alias,
-// or a payload that fails to decode fails the whole call with an error naming
-// the issue. Callers prune SQLite rows against these details, so a silent gap
-// (or a null decoded as five empty, "complete" collections) would prune a live
-// issue's details.
+// A nil-error return guarantees the map holds a non-nil entry for every
+// requested issue ID. A missing alias, a null alias, or a payload that fails
+// to decode fails the whole call with an error naming the issue. Callers prune
+// SQLite rows against these details, so a silent gap (or a null decoded as
+// five empty, "complete" collections) would prune a live issue's details.
+//
+// Batches are sized by DetailsBatchSize; each batch's measured complexity
+// (or a "too complex" rejection) feeds back into it. A rejection does not fail
+// the call: the IDs are fetched again in batches of the size the rejection
+// taught, down to one issue, and an issue too complex on its own is fetched
+// with attachments projected out (AttachmentsOmitted), the lazy-attachments
+// trim. An issue rejected even then does not sink the rest: the call returns
+// every other issue's details alongside a *DetailsTooComplexError naming the
+// ones left out.
 func (c *Client) GetIssueDetailsBatch(ctx context.Context, issueIDs []string) (map[string]*IssueDetails, error) {
 	if len(issueIDs) == 0 {
 		return make(map[string]*IssueDetails), nil
 	}
+	return c.issueDetailsFitted(ctx, issueIDs, c.lazy.Attachments)
+}
+
+// DetailsTooComplexError is GetIssueDetailsBatch reporting issues whose
+// details Linear rejects as too complex even one at a time without
+// attachments. The map returned with it holds every other requested issue.
+type DetailsTooComplexError struct {
+	IssueIDs []string
+	Err      error // the last rejection
+}
+
+func (e *DetailsTooComplexError) Error() string {
+	return fmt.Sprintf("details of %s: %v", strings.Join(e.IssueIDs, ", "), e.Err)
+}
+
+func (e *DetailsTooComplexError) Unwrap() error { return e.Err }
+
+// issueDetailsFitted is GetIssueDetailsBatch's retry on "too complex": one
+// batch, then smaller ones, then the trimmed selection for a lone issue. The
+// lone issues rejected even trimmed are collected into one
+// *DetailsTooComplexError, returned with the details of the rest.
+func (c *Client) issueDetailsFitted(ctx context.Context, issueIDs []string, omitAttachments bool) (map[string]*IssueDetails, error) {
+	result, err := c.issueDetailsBatch(ctx, issueIDs, omitAttachments)
+	if !IsQueryTooComplex(err) {
+		return result, err
+	}
+	if len(issueIDs) == 1 {
+		if omitAttachments {
+			return map[string]*IssueDetails{}, &DetailsTooComplexError{IssueIDs: issueIDs, Err: err}
+		}
+		log.Printf("[api] issue %s details too complex, fetching without attachments", issueIDs[0])
+		return c.issueDetailsFitted(ctx, issueIDs, true)
+	}
+	// tooComplex has lowered the size below len(issueIDs); the bound is a
+	// guard that each split makes progress.
+	n := max(1, min(c.detailsBatch.size(), len(issueIDs)-1))
+	log.Printf("[api] details batch of %d too complex, retrying in batches of %d", len(issueIDs), n)
+	result = make(map[string]*IssueDetails, len(issueIDs))
+	var rejected *DetailsTooComplexError
+	for start := 0; start < len(issueIDs); start += n {
+		part, err := c.issueDetailsFitted(ctx, issueIDs[start:min(start+n, len(issueIDs))], omitAttachments)
+		var partRejected *DetailsTooComplexError
+		if errors.As(err, &partRejected) {
+			if rejected == nil {
+				rejected = &DetailsTooComplexError{}
+			}
+			rejected.IssueIDs = append(rejected.IssueIDs, partRejected.IssueIDs...)
+			rejected.Err = partRejected.Err
+		} else if err != nil {
+			return nil, err
+		}
+		maps.Copy(result, part)
+	}
+	if rejected != nil {
+		return result, rejected
+	}
+	return result, nil
+}
 
+// issueDetailsBatch sends one aliased details query for issueIDs.
+func (c *Client) issueDetailsBatch(ctx context.Context, issueIDs []string, omitAttachments bool) (map[string]*IssueDetails, error) {
 	// Build a batched query using aliases
 	// Example: query { i0: issue(id: "id1") { ... } i1: issue(id: "id2") { ... } }
 	var queryParts []string
@@ -860,6 +1195,10 @@ func (c *Client) GetIssueDetailsBatch(ctx context.Context, issueIDs []string) (m
 	for i := range issueIDs {
 		varDecls = append(varDecls, fmt.Sprintf("$id%d: String!", i))
 	}
+	varDecls = append(varDecls, "$withAttachments: Boolean = true")
+	if omitAttachments {
+		vars["withAttachments"] = false
+	}
 
 	query := fmt.Sprintf(`query IssueDetailsBatch(%s) { %s } %s %s %s %s %s`,
 		strings.Join(varDecls, ", "),
@@ -873,7 +1212,9 @@ func (c *Client) GetIssueDetailsBatch(ctx context.Context, issueIDs []string) (m
 
 	// Result will be a map of alias -> issue data
 	var rawResult map[string]json.RawMessage
-	err := c.query(ctx, query, vars, &rawResult)
+	var cost complexitySink
+	err := c.query(withComplexitySink(ctx, &cost), query, vars, &rawResult)
+	c.detailsBatch.learn(len(issueIDs), &cost, err)
 	if err != nil {
 		return nil, err
 	}
@@ -898,6 +1239,7 @@ func (c *Client) GetIssueDetailsBatch(ctx context.Context, issueIDs []string) (m
 		}
 
 		result[id] = issueData.toDetails()
+		result[id].AttachmentsOmitted = omitAttachments
 	}
 
 	return result, nil
@@ -961,6 +1303,13 @@ func (c *Client) GetInitiativeDocuments(ctx context.Context, initiativeID string
 		map[string]any{"initiativeId": initiativeID}, "documents")
 }
 
+// GetAllDocuments fetches every document in the workspace, drained. It is
+// the only fetch that sees standalone documents (no issue, team, project, or
+// initiative), which no per-owner query returns.
+func (c *Client) GetAllDocuments(ctx context.Context) ([]Document, error) {
+	return fetchAll[Document](ctx, c, queryAllDocuments, nil, "documents")
+}
+
 // CreateDocument creates a new document
 func (c *Client) CreateDocument(ctx context.Context, input map[string]any) (*Document, error) {
 	return execMutation[Document](ctx, c, mutationCreateDocument, map[string]any{"input": input}, "documentCreate", "document")
@@ -357,7 +357,10 @@ func TestMockReset(t *testing.T) {
 	}
 
 	// The mock now returns empty data — under the fetch null policy that is
-	// a loud error, not a silent empty team list.
+	// a loud error, not a silent empty team list. A fresh client asks: this
+	// one would answer Teams from its response cache.
+	client = NewClient("test-api-key")
+	client.SetAPIURL(mock.URL())
 	_, err := client.GetTeams(context.Background())
 	if err == nil || !strings.Contains(err.Error(), `"teams" missing or null`) {
 		t.Fatalf("GetTeams after reset: err = %v, want missing-or-null error", err)
@@ -401,6 +404,24 @@ func TestArchiveIssueFailure(t *testing.T) {
 	}
 }
 
+func TestUnarchiveIssue(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+
+	mock.SetResponse("UnarchiveIssue", testutil.UnarchiveIssueResponse(true))
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(mock.URL())
+
+	if err := client.UnarchiveIssue(context.Background(), "issue-123"); err != nil {
+		t.Fatalf("UnarchiveIssue failed: %v", err)
+	}
+	if call := mock.LastCall(); call.Operation != "UnarchiveIssue" || call.Variables["id"] != "issue-123" {
+		t.Errorf("sent %s with id %v, want UnarchiveIssue with issue-123", call.Operation, call.Variables["id"])
+	}
+}
+
 func TestCreateIssue(t *testing.T) {
 	t.Parallel()
 	mock := testutil.NewMockLinearServer()
@@ -971,6 +992,35 @@ func TestCircuitBreakerTripsAfterConsecutiveErrors(t *testing.T) {
 	}
 }
 
+func TestRefusedConnectionIsUnreachable(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL("http://" + addr)
+
+	// A refused dial never reached Linear...
+	_, err = client.GetTeams(context.Background())
+	if !IsUnreachable(err) {
+		t.Errorf("refused connection: IsUnreachable(%v) = false, want true", err)
+	}
+
+	// ...and neither does a request the open breaker refuses.
+	for i := 1; i < circuitBreakerThreshold; i++ {
+		_, _ = client.GetTeams(context.Background())
+	}
+	_, err = client.GetTeams(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "circuit breaker open") || !IsUnreachable(err) {
+		t.Errorf("open breaker: got %v, want an unreachable circuit breaker error", err)
+	}
+}
+
 func TestCircuitBreakerResetsOnSuccess(t *testing.T) {
 	t.Parallel()
 
@@ -1155,3 +1205,110 @@ func TestClient_GetWorkspaceInitiativeIDs(t *testing.T) {
 		t.Errorf("got %q, want i1", got)
 	}
 }
+
+// TestClient_GetOrganization covers both token kinds: an admin key gets the
+// SAML/SCIM flags from the second query; a non-admin key (OrganizationAuth
+// rejected) still gets the organization, with the flags left unknown.
+func TestClient_GetOrganization(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+
+	mock.SetResponse("Organization", map[string]any{"organization": map[string]any{
+		"id": "org-1", "name": "Acme", "urlKey": "acme",
+		"allowedAuthServices": []string{"google", "saml"}, "userCount": 42,
+	}})
+	mock.SetResponse("OrganizationAuth", map[string]any{"organization": map[string]any{
+		"samlEnabled": true, "scimEnabled": false,
+	}})
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(mock.URL())
+
+	org, err := client.GetOrganization(context.Background())
+	if err != nil {
+		t.Fatalf("GetOrganization: %v", err)
+	}
+	if org.Name != "Acme" || org.URLKey != "acme" || org.UserCount != 42 || len(org.AllowedAuthServices) != 2 {
+		t.Errorf("organization = %+v", org)
+	}
+	if org.SAMLEnabled == nil || !*org.SAMLEnabled || org.SCIMEnabled == nil || *org.SCIMEnabled {
+		t.Errorf("admin flags = saml %v scim %v, want true/false", org.SAMLEnabled, org.SCIMEnabled)
+	}
+
+	mock.SetError("OrganizationAuth", errors.New("forbidden: admin required"))
+	org, err = client.GetOrganization(context.Background())
+	if err != nil {
+		t.Fatalf("GetOrganization (non-admin): %v", err)
+	}
+	if org.Name != "Acme" || org.SAMLEnabled != nil || org.SCIMEnabled != nil {
+		t.Errorf("non-admin organization = %+v, want name with unknown flags", org)
+	}
+}
+
+// TestClient_GetEmojis decodes the custom emoji list.
+func TestClient_GetEmojis(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+
+	mock.SetResponse("Emojis", map[string]any{"emojis": map[string]any{
+		"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+		"nodes": []map[string]any{
+			{"id": "e-1", "name": "party-parrot", "url": "https://uploads.linear.app/e/parrot.gif"},
+		},
+	}})
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(mock.URL())
+
+	emojis, err := client.GetEmojis(context.Background())
+	if err != nil {
+		t.Fatalf("GetEmojis: %v", err)
+	}
+	if len(emojis) != 1 || emojis[0].Name != "party-parrot" || emojis[0].URL != "https://uploads.linear.app/e/parrot.gif" {
+		t.Errorf("emojis = %+v", emojis)
+	}
+}
+
+// TestClient_GetCustomViews decodes the saved-view list and drains a view's
+// issue IDs.
+func TestClient_GetCustomViews(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+
+	mock.SetResponse("CustomViews", map[string]any{"customViews": map[string]any{
+		"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+		"nodes": []map[string]any{
+			{"id": "cv-1", "name": "My bugs", "slugId": "abc123", "shared": false,
+				"owner": map[string]any{"id": "u-1", "name": "Me"}},
+			{"id": "cv-2", "name": "Team triage", "slugId": "def456", "shared": true,
+				"team": map[string]any{"id": "team-1", "key": "ENG", "name": "Engineering"}},
+		},
+	}})
+	mock.SetResponse("CustomViewIssueIDs", map[string]any{"customView": map[string]any{"issues": map[string]any{
+		"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+		"nodes":    []map[string]any{{"id": "i1"}, {"id": "i2"}},
+	}}})
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(mock.URL())
+
+	views, err := client.GetCustomViews(context.Background())
+	if err != nil {
+		t.Fatalf("GetCustomViews: %v", err)
+	}
+	if len(views) != 2 || views[0].Owner == nil || views[0].Team != nil ||
+		!views[1].Shared || views[1].Team == nil || views[1].Team.Key != "ENG" {
+		t.Errorf("views = %+v", views)
+	}
+
+	ids, err := client.GetCustomViewIssueIDs(context.Background(), "cv-1")
+	if err != nil {
+		t.Fatalf("GetCustomViewIssueIDs: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "i1" || ids[1] != "i2" {
+		t.Errorf("ids = %v, want [i1 i2]", ids)
+	}
+}
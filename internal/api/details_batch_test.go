@@ -2,6 +2,12 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 
@@ -140,3 +146,100 @@ func TestGetIssueDetailsBatchDecodeFailureFails(t *testing.T) {
 		t.Errorf("details = %v, want nil map on error", details)
 	}
 }
+
+// TestGetIssueDetailsBatchSplitsTooComplex: a batch Linear rejects as too
+// complex is fetched again in smaller batches rather than failing, and an
+// issue too complex on its own comes back without attachments.
+func TestGetIssueDetailsBatchSplitsTooComplex(t *testing.T) {
+	t.Parallel()
+	const maxAliases = 3
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		data := map[string]any{}
+		tooComplex := false
+		for i := 0; ; i++ {
+			id, ok := req.Variables[fmt.Sprintf("id%d", i)].(string)
+			if !ok {
+				break
+			}
+			if id == "issue-huge" && req.Variables["withAttachments"] != false {
+				tooComplex = true
+			}
+			data[fmt.Sprintf("i%d", i)] = detailsPayload("comment-" + id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if tooComplex || len(data) > maxAliases {
+			fmt.Fprint(w, `{"errors": [{"message": "Query too complex"}]}`)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	c := NewClient("test")
+	c.SetAPIURL(server.URL)
+	ids := []string{"issue-0", "issue-1", "issue-2", "issue-3", "issue-huge", "issue-5", "issue-6", "issue-7"}
+	details, err := c.GetIssueDetailsBatch(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetIssueDetailsBatch: %v", err)
+	}
+	for _, id := range ids {
+		d := details[id]
+		if d == nil || len(d.Comments) != 1 || d.Comments[0].ID != "comment-"+id {
+			t.Fatalf("details[%s] = %+v, want its own comment", id, d)
+		}
+		if d.AttachmentsOmitted != (id == "issue-huge") {
+			t.Errorf("details[%s].AttachmentsOmitted = %v", id, d.AttachmentsOmitted)
+		}
+	}
+	if got := c.DetailsBatchSize(); got > maxAliases {
+		t.Errorf("batch size after the rejections = %d, want at most %d", got, maxAliases)
+	}
+}
+
+// TestGetIssueDetailsBatchKeepsFittingIssues: an issue rejected as too complex
+// even alone and without attachments is named in a DetailsTooComplexError,
+// and every other issue's details come back with it.
+func TestGetIssueDetailsBatchKeepsFittingIssues(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		data := map[string]any{}
+		for i := 0; ; i++ {
+			id, ok := req.Variables[fmt.Sprintf("id%d", i)].(string)
+			if !ok {
+				break
+			}
+			if id == "issue-hopeless" {
+				fmt.Fprint(w, `{"errors": [{"message": "Query too complex"}]}`)
+				return
+			}
+			data[fmt.Sprintf("i%d", i)] = detailsPayload("comment-" + id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer server.Close()
+
+	c := NewClient("test")
+	c.SetAPIURL(server.URL)
+	ids := []string{"issue-0", "issue-hopeless", "issue-2", "issue-3"}
+	details, err := c.GetIssueDetailsBatch(context.Background(), ids)
+	var rejected *DetailsTooComplexError
+	if !errors.As(err, &rejected) || !slices.Equal(rejected.IssueIDs, []string{"issue-hopeless"}) {
+		t.Fatalf("err = %v, want a DetailsTooComplexError naming issue-hopeless", err)
+	}
+	for _, id := range ids {
+		if got := details[id] != nil; got != (id != "issue-hopeless") {
+			t.Errorf("details[%s] present = %v", id, got)
+		}
+	}
+}
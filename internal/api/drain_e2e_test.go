@@ -2,15 +2,21 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jra3/linear-fuse/internal/testutil"
 )
 
 // End-to-end drain tests: real queries through the mock server, multi-page
 // sequences scripted with SetResponseSequence (a single static SetResponse
-// can never terminate a pagination loop whose first page says hasNextPage).
+// can never terminate a pagination loop whose first page says hasNextPage) or
+// served by cursor with SetPaginated, plus the transport faults the mock
+// simulates (failures, latency, complexity refusals).
 
 func pf(hasNext bool, cursor string) map[string]any {
 	return map[string]any{"hasNextPage": hasNext, "endCursor": cursor}
@@ -340,3 +346,142 @@ func TestGetWorkspaceProjectIDsPaginates(t *testing.T) {
 		t.Fatalf("ids = %v, want [p1 p2]", ids)
 	}
 }
+
+// issueIDNodes builds n {id} nodes for SetPaginated.
+func issueIDNodes(n int) []any {
+	nodes := make([]any, n)
+	for i := range nodes {
+		nodes[i] = map[string]any{"id": fmt.Sprintf("issue-%d", i+1)}
+	}
+	return nodes
+}
+
+func TestGetTeamIssueIDsDrainsSimulatedPages(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetPaginated("TeamIssueIDs", 3, issueIDNodes(7), "team", "issues")
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+
+	ids, err := c.GetTeamIssueIDs(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("GetTeamIssueIDs: %v", err)
+	}
+	if len(ids) != 7 || ids[0] != "issue-1" || ids[6] != "issue-7" {
+		t.Fatalf("ids = %v, want issue-1..issue-7", ids)
+	}
+	// 3+3+1: each page's cursor is threaded into the next request.
+	calls := mock.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("calls = %d, want 3", len(calls))
+	}
+	if calls[0].Variables["after"] != nil {
+		t.Errorf("first page sent after=%v, want omitted", calls[0].Variables["after"])
+	}
+	for i := 1; i < len(calls); i++ {
+		if calls[i].Variables["after"] == nil {
+			t.Errorf("page %d sent no cursor", i+1)
+		}
+	}
+}
+
+// TestDrainIntermittentServerErrorIsAllOrNothing: a 500 on a later page fails
+// the whole drain (no partial ID set for reconcile to diff-and-delete
+// against), and the next drain — the sync worker's retry — recovers.
+func TestDrainIntermittentServerErrorIsAllOrNothing(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetPaginated("TeamIssueIDs", 2, issueIDNodes(5), "team", "issues")
+	mock.SetFailures("TeamIssueIDs", 0, http.StatusInternalServerError)
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+
+	ids, err := c.GetTeamIssueIDs(context.Background(), "team-1")
+	if err == nil || !strings.Contains(err.Error(), "500") {
+		t.Fatalf("err = %v, want the page-2 500", err)
+	}
+	if ids != nil {
+		t.Errorf("ids = %v on a failed drain, want nil", ids)
+	}
+	if IsRateLimited(err) {
+		t.Errorf("a 500 classified as a rate limit: %v", err)
+	}
+
+	ids, err = c.GetTeamIssueIDs(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if len(ids) != 5 {
+		t.Errorf("retry ids = %v, want 5", ids)
+	}
+}
+
+func TestHTTP429IsRateLimited(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetPaginated("TeamIssueIDs", 50, issueIDNodes(1), "team", "issues")
+	mock.SetFailures("TeamIssueIDs", http.StatusTooManyRequests)
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+
+	if _, err := c.GetTeamIssueIDs(context.Background(), "team-1"); !IsRateLimited(err) {
+		t.Errorf("err = %v, want a rate limit", err)
+	}
+}
+
+// TestComplexityRefusalSnapsBudget: a query pricier than the server's
+// remaining complexity is refused with RATELIMITED; the client snaps its
+// budget to exhausted, so the retry defers locally instead of reaching the
+// server again.
+func TestComplexityRefusalSnapsBudget(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetPaginated("TeamIssueIDs", 50, issueIDNodes(1), "team", "issues")
+	mock.SetComplexity("TeamIssueIDs", 150)
+	mock.SetComplexityBudget(100, time.Now().Add(30*time.Minute))
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+
+	if _, err := c.GetTeamIssueIDs(context.Background(), "team-1"); !IsRateLimited(err) {
+		t.Fatalf("err = %v, want the server's RATELIMITED refusal", err)
+	}
+	if !c.LowBudget() {
+		t.Error("LowBudget() = false after a complexity refusal")
+	}
+	if _, err := c.GetTeamIssueIDs(context.Background(), "team-1"); !IsDeferred(err) {
+		t.Errorf("retry err = %v, want a local deferral", err)
+	}
+	if got := len(mock.Calls()); got != 1 {
+		t.Errorf("server saw %d calls, want 1", got)
+	}
+}
+
+func TestSlowResponseHonorsContextDeadline(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetPaginated("TeamIssueIDs", 50, issueIDNodes(1), "team", "issues")
+	mock.SetLatency(2 * time.Second)
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := c.GetTeamIssueIDs(ctx, "team-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want the context deadline", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("call took %s; the deadline did not cut the slow response short", elapsed)
+	}
+}
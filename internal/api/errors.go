@@ -2,6 +2,7 @@ package api
 
 import (
 	"errors"
+	"net"
 	"strings"
 )
 
@@ -22,6 +23,29 @@ func IsDeferred(err error) bool {
 	return errors.Is(err, ErrDeferred) || errors.Is(err, ErrBudget)
 }
 
+// ErrUnreachable marks a request that never reached Linear: the connectivity
+// circuit breaker refused it, or the connection could not be made (DNS, dial).
+// Such a request certainly had no effect, which is what lets a write be queued
+// and replayed later without risking a duplicate — a failure after the request
+// went out (a reset, a timeout awaiting the response) is deliberately not this.
+var ErrUnreachable = errors.New("linear API unreachable")
+
+// IsUnreachable reports whether err is ErrUnreachable: the request never left.
+func IsUnreachable(err error) bool {
+	return errors.Is(err, ErrUnreachable)
+}
+
+// neverSent reports whether an http.Client.Do failure happened before the
+// request could be written: the name did not resolve or the dial failed.
+func neverSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
 // Error predicates: the package-level classification of Linear API failures.
 //
 // Every layer above the client (fs mutation handlers, the repo's orphan
@@ -83,6 +107,17 @@ func IsNotFound(err error) bool {
 	return strings.Contains(err.Error(), "Entity not found")
 }
 
+// IsQueryTooComplex reports whether err is Linear rejecting a single query
+// for exceeding its complexity cap ("Query too complex"). Unlike a rate limit
+// this is not transient: the same query fails the same way until it asks for
+// less, which is what the adaptive batch sizes do with it (batchsize.go).
+func IsQueryTooComplex(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "too complex")
+}
+
 // IsFieldTooLong reports whether err is Linear rejecting a field for exceeding
 // its length cap — e.g. "description must be shorter than or equal to 255
 // characters." This is a size limit, not merely malformed input, so callers
@@ -105,3 +140,21 @@ func IsFieldTooLong(err error) bool {
 	}
 	return has(err.Error())
 }
+
+// IsForbidden reports whether err is Linear refusing this token an entity it
+// can otherwise see — an issue in a private team the token is not a member
+// of, surfaced through a relation, a parent, or a shared view. Structured
+// check first (extensions {code: "FORBIDDEN"}); the fallbacks cover the code
+// in a plain-string error envelope and a bare HTTP 403. An expired or revoked
+// token is NOT this: it is a 401 (AUTHENTICATION_ERROR) for every request.
+func IsForbidden(err error) bool {
+	if err == nil {
+		return false
+	}
+	var gqlErr *GraphQLError
+	if errors.As(err, &gqlErr) && gqlErr.Code == "FORBIDDEN" {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "FORBIDDEN") || strings.Contains(msg, "(status 403)")
+}
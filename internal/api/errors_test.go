@@ -68,6 +68,28 @@ func TestIsRateLimited(t *testing.T) {
 	}
 }
 
+func TestIsUnreachable(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"ErrUnreachable sentinel", ErrUnreachable, true},
+		{"wrapped via %w", fmt.Errorf("circuit breaker open: skipping GetIssue (connectivity down): %w", ErrUnreachable), true},
+		{"circuit breaker text without the sentinel", errors.New("circuit breaker open: skipping GetIssue (connectivity down)"), false},
+		{"rate limit", &GraphQLError{Message: "x", Code: "RATELIMITED"}, false},
+		{"unrelated error", errors.New("boom"), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsUnreachable(tc.err); got != tc.want {
+				t.Errorf("IsUnreachable(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestIsDeferred(t *testing.T) {
 	cases := []struct {
 		name string
@@ -169,3 +191,34 @@ func TestIsFieldTooLong(t *testing.T) {
 		})
 	}
 }
+
+func TestIsForbidden(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"typed FORBIDDEN code", &GraphQLError{Message: "Forbidden", Code: "FORBIDDEN"}, true},
+		{
+			"typed error wrapped via %w",
+			fmt.Errorf("fetch full issue: %w", &GraphQLError{Message: "no access", Code: "FORBIDDEN"}),
+			true,
+		},
+		{
+			"plain string carrying the envelope",
+			errors.New(`API error (status 400): {"errors":[{"extensions":{"code":"FORBIDDEN"}}]}`),
+			true,
+		},
+		{"bare HTTP 403", errors.New("API error (status 403): forbidden"), true},
+		{"expired token is not forbidden", errors.New(`API error (status 401): {"errors":[{"extensions":{"code":"AUTHENTICATION_ERROR"}}]}`), false},
+		{"not found is not forbidden", &GraphQLError{Message: "Entity not found: Issue"}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsForbidden(tc.err); got != tc.want {
+				t.Errorf("IsForbidden(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
@@ -53,8 +53,14 @@ func execEnvelope(ctx context.Context, c *Client, query string, vars map[string]
 	if err := c.query(ctx, query, vars, &envelope); err != nil {
 		return nil, err
 	}
+	return unwrapEnvelope(envelope[opField], opField)
+}
+
+// unwrapEnvelope checks one mutation payload's success flag and returns its
+// fields.
+func unwrapEnvelope(raw json.RawMessage, opField string) (map[string]json.RawMessage, error) {
 	var payload map[string]json.RawMessage
-	if err := json.Unmarshal(envelope[opField], &payload); err != nil {
+	if err := json.Unmarshal(raw, &payload); err != nil {
 		return nil, fmt.Errorf("%s: malformed mutation payload: %w", opField, err)
 	}
 	var success bool
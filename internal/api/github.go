@@ -0,0 +1,229 @@
+package api
+
+// GitHubClient is the optional third network caller, alongside Client (Linear
+// GraphQL) and CDNClient (Linear's file CDN). It talks read-only REST to
+// api.github.com to enrich GitHub pull-request attachments with PR state,
+// checks status, and reviewers. It exists only when a GitHub token is
+// configured (github.token / LINEARFS_GITHUB_TOKEN); without one, .link files
+// render exactly what Linear stores.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// githubTimeout caps a single GitHub request. Enrichment runs in the
+// background (never on a FUSE read), so this bounds a stalled fetch rather
+// than user latency.
+const githubTimeout = 15 * time.Second
+
+// maxGitHubBytes caps one GitHub response body. PR, review, and check-run
+// payloads are a few KB; the cap is a denial-of-service bound only.
+const maxGitHubBytes = 4 << 20
+
+// defaultGitHubAPI is the REST base URL; tests point SetBaseURL at httptest.
+const defaultGitHubAPI = "https://api.github.com"
+
+// errGitHubRedirect refuses every redirect: the Authorization header carries
+// the GitHub token, and a followed redirect would replay it onto whatever host
+// the response names (the same hazard errCDNRedirect closes for the CDN). A
+// renamed repository's 301 therefore surfaces as an error, not a silent hop.
+func errGitHubRedirect(req *http.Request, _ []*http.Request) error {
+	return fmt.Errorf("github: refusing redirect to %s", req.URL)
+}
+
+// GitHubClient fetches pull-request status from the GitHub REST API.
+type GitHubClient struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// NewGitHubClient builds a client authenticating with token.
+func NewGitHubClient(token string) *GitHubClient {
+	return &GitHubClient{
+		httpClient: &http.Client{Timeout: githubTimeout, CheckRedirect: errGitHubRedirect},
+		baseURL:    defaultGitHubAPI,
+		token:      token,
+	}
+}
+
+// SetBaseURL overrides the API base URL, for testing against an httptest server.
+func (c *GitHubClient) SetBaseURL(base string) {
+	c.baseURL = strings.TrimSuffix(base, "/")
+}
+
+// PullRequestStatus is the enrichment rendered into a GitHub PR attachment.
+type PullRequestStatus struct {
+	State     string   // "open", "draft", "closed", or "merged"
+	Checks    string   // "success", "failure", "pending", or "" when the head has no checks
+	Reviewers []string // "login (approved)", "login (changes requested)", "login (requested)", …
+}
+
+// ParseGitHubPRURL extracts owner, repo, and number from a GitHub pull-request
+// URL (https://github.com/{owner}/{repo}/pull/{number}[/...]). ok is false for
+// anything else, including issue and commit URLs.
+func ParseGitHubPRURL(raw string) (owner, repo string, number int, ok bool) {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Host != "github.com" && u.Host != "www.github.com") {
+		return "", "", 0, false
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 4 || parts[2] != "pull" || parts[0] == "" || parts[1] == "" {
+		return "", "", 0, false
+	}
+	n, err := strconv.Atoi(parts[3])
+	if err != nil || n <= 0 {
+		return "", "", 0, false
+	}
+	return parts[0], parts[1], n, true
+}
+
+// PullRequestStatus fetches a PR's state, its head commit's check runs, and its
+// reviewers (latest review per user, then still-requested reviewers). Three
+// requests; any failure fails the whole status so a partial render never
+// claims "no checks" when the checks call simply failed.
+func (c *GitHubClient) PullRequestStatus(ctx context.Context, owner, repo string, number int) (*PullRequestStatus, error) {
+	prefix := fmt.Sprintf("/repos/%s/%s", url.PathEscape(owner), url.PathEscape(repo))
+
+	var pr struct {
+		State  string `json:"state"`
+		Merged bool   `json:"merged"`
+		Draft  bool   `json:"draft"`
+		Head   struct {
+			SHA string `json:"sha"`
+		} `json:"head"`
+		RequestedReviewers []struct {
+			Login string `json:"login"`
+		} `json:"requested_reviewers"`
+	}
+	if err := c.get(ctx, fmt.Sprintf("%s/pulls/%d", prefix, number), &pr); err != nil {
+		return nil, err
+	}
+
+	var reviews []struct {
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		State string `json:"state"`
+	}
+	if err := c.get(ctx, fmt.Sprintf("%s/pulls/%d/reviews?per_page=100", prefix, number), &reviews); err != nil {
+		return nil, err
+	}
+
+	status := &PullRequestStatus{State: pr.State}
+	switch {
+	case pr.Merged:
+		status.State = "merged"
+	case pr.State == "open" && pr.Draft:
+		status.State = "draft"
+	}
+
+	if pr.Head.SHA != "" {
+		var runs struct {
+			CheckRuns []struct {
+				Status     string `json:"status"`
+				Conclusion string `json:"conclusion"`
+			} `json:"check_runs"`
+		}
+		if err := c.get(ctx, fmt.Sprintf("%s/commits/%s/check-runs?per_page=100", prefix, url.PathEscape(pr.Head.SHA)), &runs); err != nil {
+			return nil, err
+		}
+		for _, run := range runs.CheckRuns {
+			switch {
+			case run.Status != "completed":
+				if status.Checks != "failure" {
+					status.Checks = "pending"
+				}
+			case run.Conclusion == "failure" || run.Conclusion == "timed_out" ||
+				run.Conclusion == "cancelled" || run.Conclusion == "action_required":
+				status.Checks = "failure"
+			case status.Checks == "":
+				status.Checks = "success"
+			}
+		}
+	}
+
+	// Reviews arrive oldest-first; the last substantive review per user wins.
+	// COMMENTED never overrides an approval or change request, matching how
+	// GitHub itself summarizes review state.
+	latest := map[string]string{}
+	var order []string
+	for _, r := range reviews {
+		login := r.User.Login
+		if login == "" || r.State == "PENDING" {
+			continue
+		}
+		prev, seen := latest[login]
+		if !seen {
+			order = append(order, login)
+		}
+		if r.State == "COMMENTED" && seen && prev != "COMMENTED" {
+			continue
+		}
+		latest[login] = r.State
+	}
+	for _, login := range order {
+		status.Reviewers = append(status.Reviewers, fmt.Sprintf("%s (%s)", login, reviewStateLabel(latest[login])))
+	}
+	for _, rr := range pr.RequestedReviewers {
+		if _, reviewed := latest[rr.Login]; !reviewed && rr.Login != "" {
+			status.Reviewers = append(status.Reviewers, rr.Login+" (requested)")
+		}
+	}
+	return status, nil
+}
+
+// reviewStateLabel renders a GitHub review state in the .link file's lowercase
+// vocabulary.
+func reviewStateLabel(state string) string {
+	switch state {
+	case "APPROVED":
+		return "approved"
+	case "CHANGES_REQUESTED":
+		return "changes requested"
+	case "DISMISSED":
+		return "dismissed"
+	default:
+		return "commented"
+	}
+}
+
+// get issues one authenticated GET and decodes the JSON body into v. A non-200
+// response is an error.
+func (c *GitHubClient) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("github: HTTP %d: %s", resp.StatusCode, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxGitHubBytes+1))
+	if err != nil {
+		return err
+	}
+	if len(body) > maxGitHubBytes {
+		return fmt.Errorf("github: body exceeds %d-byte cap", maxGitHubBytes)
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestParseGitHubPRURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		url    string
+		owner  string
+		repo   string
+		number int
+		ok     bool
+	}{
+		{"https://github.com/acme/widgets/pull/42", "acme", "widgets", 42, true},
+		{"https://github.com/acme/widgets/pull/42/files", "acme", "widgets", 42, true},
+		{"https://www.github.com/acme/widgets/pull/7", "acme", "widgets", 7, true},
+		{"https://github.com/acme/widgets/issues/42", "", "", 0, false},
+		{"https://github.com/acme/widgets/pull/abc", "", "", 0, false},
+		{"https://gitlab.com/acme/widgets/pull/42", "", "", 0, false},
+		{"https://github.com/acme", "", "", 0, false},
+		{"not a url", "", "", 0, false},
+	}
+	for _, tt := range tests {
+		owner, repo, number, ok := ParseGitHubPRURL(tt.url)
+		if owner != tt.owner || repo != tt.repo || number != tt.number || ok != tt.ok {
+			t.Errorf("ParseGitHubPRURL(%q) = (%q, %q, %d, %v), want (%q, %q, %d, %v)",
+				tt.url, owner, repo, number, ok, tt.owner, tt.repo, tt.number, tt.ok)
+		}
+	}
+}
+
+// TestGitHubPullRequestStatus proves the three-call aggregation: merged wins
+// over closed, a failing check run beats a pending one, the latest substantive
+// review per user wins (a later COMMENTED does not demote an approval), and a
+// requested reviewer who has not reviewed is listed as requested.
+func TestGitHubPullRequestStatus(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case "/repos/acme/widgets/pulls/42":
+			_, _ = w.Write([]byte(`{"state":"closed","merged":true,"head":{"sha":"abc123"},
+				"requested_reviewers":[{"login":"carol"}]}`))
+		case "/repos/acme/widgets/pulls/42/reviews":
+			_, _ = w.Write([]byte(`[
+				{"user":{"login":"alice"},"state":"CHANGES_REQUESTED"},
+				{"user":{"login":"alice"},"state":"APPROVED"},
+				{"user":{"login":"alice"},"state":"COMMENTED"},
+				{"user":{"login":"bob"},"state":"COMMENTED"}]`))
+		case "/repos/acme/widgets/commits/abc123/check-runs":
+			_, _ = w.Write([]byte(`{"check_runs":[
+				{"status":"completed","conclusion":"success"},
+				{"status":"in_progress","conclusion":null},
+				{"status":"completed","conclusion":"failure"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGitHubClient("ghp_test")
+	c.SetBaseURL(srv.URL)
+
+	got, err := c.PullRequestStatus(context.Background(), "acme", "widgets", 42)
+	if err != nil {
+		t.Fatalf("PullRequestStatus: %v", err)
+	}
+	want := &PullRequestStatus{
+		State:     "merged",
+		Checks:    "failure",
+		Reviewers: []string{"alice (approved)", "bob (commented)", "carol (requested)"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PullRequestStatus = %+v, want %+v", got, want)
+	}
+	if gotAuth != "Bearer ghp_test" {
+		t.Errorf("auth = %q, want Bearer ghp_test", gotAuth)
+	}
+
+	if _, err := c.PullRequestStatus(context.Background(), "acme", "widgets", 1); err == nil {
+		t.Error("PullRequestStatus on 404 should error")
+	}
+}
+
+// TestGitHubClientRefusesRedirect pins the token-leak guard: a redirect is an
+// error, never a hop that replays the Authorization header elsewhere.
+func TestGitHubClientRefusesRedirect(t *testing.T) {
+	t.Parallel()
+
+	var hopped bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hopped = true
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL+r.URL.Path, http.StatusMovedPermanently)
+	}))
+	defer srv.Close()
+
+	c := NewGitHubClient("ghp_test")
+	c.SetBaseURL(srv.URL)
+	if _, err := c.PullRequestStatus(context.Background(), "acme", "widgets", 42); err == nil {
+		t.Error("PullRequestStatus across a redirect should error")
+	}
+	if hopped {
+		t.Error("redirect was followed")
+	}
+}
@@ -0,0 +1,297 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A minimal GraphQL document reader for the schema drift check: it keeps
+// what the check walks — operations, fragments, and their selection trees —
+// and skips arguments, variable definitions, and directives by balancing
+// their parentheses. It is not a validator; the server is.
+
+type graphQLDocument struct {
+	operations []graphQLOperation
+	fragments  map[string]graphQLFragment
+}
+
+type graphQLOperation struct {
+	kind       string // "query" or "mutation"
+	name       string
+	selections []graphQLSelection
+}
+
+type graphQLFragment struct {
+	on         string
+	selections []graphQLSelection
+}
+
+// graphQLSelection is a field (field set), a fragment spread (fragment set),
+// or an inline fragment (neither set; on names its type condition, if any).
+type graphQLSelection struct {
+	field      string
+	fragment   string
+	on         string
+	selections []graphQLSelection
+}
+
+// parseGraphQLDocument reads src's operations and fragments.
+func parseGraphQLDocument(src string) (graphQLDocument, error) {
+	toks, err := tokenizeGraphQL(src)
+	if err != nil {
+		return graphQLDocument{}, err
+	}
+	p := &graphQLParser{toks: toks}
+	doc := graphQLDocument{fragments: make(map[string]graphQLFragment)}
+	for !p.done() {
+		switch tok := p.next(); tok {
+		case "{":
+			p.pos--
+			sels, err := p.selectionSet()
+			if err != nil {
+				return doc, err
+			}
+			doc.operations = append(doc.operations, graphQLOperation{kind: "query", selections: sels})
+		case "query", "mutation", "subscription":
+			op := graphQLOperation{kind: tok}
+			if isGraphQLName(p.peek()) {
+				op.name = p.next()
+			}
+			if err := p.skipToSelectionSet(); err != nil {
+				return doc, err
+			}
+			sels, err := p.selectionSet()
+			if err != nil {
+				return doc, err
+			}
+			op.selections = sels
+			doc.operations = append(doc.operations, op)
+		case "fragment":
+			name := p.next()
+			if p.next() != "on" {
+				return doc, fmt.Errorf("graphql: fragment %s: expected \"on\"", name)
+			}
+			frag := graphQLFragment{on: p.next()}
+			if err := p.skipToSelectionSet(); err != nil {
+				return doc, err
+			}
+			sels, err := p.selectionSet()
+			if err != nil {
+				return doc, err
+			}
+			frag.selections = sels
+			doc.fragments[name] = frag
+		default:
+			return doc, fmt.Errorf("graphql: unexpected %q at top level", tok)
+		}
+	}
+	return doc, nil
+}
+
+type graphQLParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *graphQLParser) done() bool { return p.pos >= len(p.toks) }
+
+func (p *graphQLParser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.toks[p.pos]
+}
+
+func (p *graphQLParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+// skipToSelectionSet skips variable definitions and directives up to the
+// next "{".
+func (p *graphQLParser) skipToSelectionSet() error {
+	for !p.done() && p.peek() != "{" {
+		if p.next() == "(" {
+			if err := p.skipParens(); err != nil {
+				return err
+			}
+		}
+	}
+	if p.done() {
+		return fmt.Errorf("graphql: missing selection set")
+	}
+	return nil
+}
+
+// skipParens skips to just past the ")" matching an already-consumed "(".
+func (p *graphQLParser) skipParens() error {
+	for depth := 1; depth > 0; {
+		if p.done() {
+			return fmt.Errorf("graphql: unbalanced parentheses")
+		}
+		switch p.next() {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+	}
+	return nil
+}
+
+// skipDirectives skips any "@name(args)" directives.
+func (p *graphQLParser) skipDirectives() error {
+	for p.peek() == "@" {
+		p.pos += 2
+		if p.peek() == "(" {
+			p.pos++
+			if err := p.skipParens(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// selectionSet reads a "{ ... }" selection set.
+func (p *graphQLParser) selectionSet() ([]graphQLSelection, error) {
+	if p.next() != "{" {
+		return nil, fmt.Errorf("graphql: expected \"{\"")
+	}
+	var sels []graphQLSelection
+	for {
+		tok := p.next()
+		switch {
+		case tok == "}":
+			return sels, nil
+		case tok == "":
+			return nil, fmt.Errorf("graphql: unterminated selection set")
+		case tok == "...":
+			s, err := p.fragmentSelection()
+			if err != nil {
+				return nil, err
+			}
+			sels = append(sels, s)
+		case isGraphQLName(tok):
+			s := graphQLSelection{field: tok}
+			if p.peek() == ":" { // alias: the field is the name after it
+				p.pos++
+				s.field = p.next()
+			}
+			if p.peek() == "(" {
+				p.pos++
+				if err := p.skipParens(); err != nil {
+					return nil, err
+				}
+			}
+			if err := p.skipDirectives(); err != nil {
+				return nil, err
+			}
+			if p.peek() == "{" {
+				sub, err := p.selectionSet()
+				if err != nil {
+					return nil, err
+				}
+				s.selections = sub
+			}
+			sels = append(sels, s)
+		default:
+			return nil, fmt.Errorf("graphql: unexpected %q in selection set", tok)
+		}
+	}
+}
+
+// fragmentSelection reads what follows "...": a spread or an inline fragment.
+func (p *graphQLParser) fragmentSelection() (graphQLSelection, error) {
+	var s graphQLSelection
+	switch tok := p.peek(); {
+	case tok == "on":
+		p.pos++
+		s.on = p.next()
+	case isGraphQLName(tok):
+		p.pos++
+		s.fragment = tok
+		return s, p.skipDirectives()
+	}
+	if err := p.skipDirectives(); err != nil {
+		return s, err
+	}
+	sub, err := p.selectionSet()
+	s.selections = sub
+	return s, err
+}
+
+func isGraphQLName(tok string) bool {
+	if tok == "" {
+		return false
+	}
+	for i, r := range tok {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// tokenizeGraphQL splits src into names, numbers, strings, and punctuators,
+// dropping whitespace, commas, and comments.
+func tokenizeGraphQL(src string) ([]string, error) {
+	var toks []string
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ', c == '\t', c == '\n', c == '\r', c == ',':
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			toks = append(toks, "...")
+			i += 3
+		case strings.HasPrefix(src[i:], `"""`):
+			end := strings.Index(src[i+3:], `"""`)
+			if end < 0 {
+				return nil, fmt.Errorf("graphql: unterminated block string")
+			}
+			toks = append(toks, src[i:i+3+end+3])
+			i += 3 + end + 3
+		case c == '"':
+			j := i + 1
+			for j < len(src) && src[j] != '"' {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("graphql: unterminated string")
+			}
+			toks = append(toks, src[i:j+1])
+			i = j + 1
+		case strings.IndexByte("{}()[]:!$@=|&", c) >= 0:
+			toks = append(toks, string(c))
+			i++
+		default:
+			j := i
+			for j < len(src) && isGraphQLWordByte(src[j]) {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("graphql: unexpected character %q", c)
+			}
+			toks = append(toks, src[i:j])
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+func isGraphQLWordByte(c byte) bool {
+	return c == '_' || c == '-' || c == '.' || c == '+' ||
+		c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
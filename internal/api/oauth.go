@@ -0,0 +1,332 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	gosync "sync"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/atrest"
+)
+
+// OAuth2 authentication (oauth:), for workspaces that block personal API keys.
+// Login runs Linear's authorization-code flow with PKCE against a one-shot
+// listener on the redirect URL; a TokenSource then serves the access token to
+// every request and refreshes it shortly before it expires, saving each
+// rotated token so the next mount starts from it.
+
+const (
+	oauthAuthorizeURL = "https://linear.app/oauth/authorize"
+	oauthTokenURL     = "https://api.linear.app/oauth/token"
+
+	// oauthRefreshSkew refreshes a token this long before it expires, so a
+	// request never races its last second.
+	oauthRefreshSkew = 5 * time.Minute
+)
+
+// OAuthApp is the registered Linear OAuth application linearfs authenticates
+// as. ClientSecret is optional: the PKCE verifier stands in for it.
+type OAuthApp struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// OAuthToken is a stored OAuth grant. A zero ExpiresAt never expires (Linear's
+// older long-lived tokens); an empty RefreshToken cannot be refreshed.
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitzero"`
+}
+
+// oauthEndpoints are the two URLs the flow talks to; tests point them at an
+// httptest server.
+type oauthEndpoints struct {
+	authorize, token string
+}
+
+var linearOAuth = oauthEndpoints{authorize: oauthAuthorizeURL, token: oauthTokenURL}
+
+// oauthHTTPClient posts to the token endpoint. Like the GraphQL client it
+// refuses redirects: the form carries the client secret and refresh token.
+var oauthHTTPClient = &http.Client{Timeout: 30 * time.Second, CheckRedirect: errAPIRedirect}
+
+// exchange posts form to the token endpoint and returns the granted token.
+func (e oauthEndpoints) exchange(ctx context.Context, form url.Values) (OAuthToken, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.token, strings.NewReader(form.Encode()))
+	if err != nil {
+		return OAuthToken{}, fmt.Errorf("oauth: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := oauthHTTPClient.Do(req)
+	if err != nil {
+		return OAuthToken{}, fmt.Errorf("oauth: token request: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return OAuthToken{}, fmt.Errorf("oauth: read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return OAuthToken{}, fmt.Errorf("oauth: token endpoint (status %d): %s", resp.StatusCode, body)
+	}
+	var granted struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		Scope        string `json:"scope"`
+		ExpiresIn    int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &granted); err != nil {
+		return OAuthToken{}, fmt.Errorf("oauth: parse token response: %w", err)
+	}
+	if granted.AccessToken == "" {
+		return OAuthToken{}, errors.New("oauth: token response has no access_token")
+	}
+	token := OAuthToken{AccessToken: granted.AccessToken, RefreshToken: granted.RefreshToken, Scope: granted.Scope}
+	if granted.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(granted.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// Login runs the authorization-code flow for app: it listens on
+// app.RedirectURL (which must be a loopback http URL), passes the authorize
+// URL to prompt for the user to open, and exchanges the code the browser
+// brings back for a token. It returns when the exchange completes, the user
+// denies access, or ctx ends.
+func Login(ctx context.Context, app OAuthApp, prompt func(authorizeURL string)) (OAuthToken, error) {
+	return linearOAuth.login(ctx, app, prompt)
+}
+
+func (e oauthEndpoints) login(ctx context.Context, app OAuthApp, prompt func(string)) (OAuthToken, error) {
+	redirect, err := url.Parse(app.RedirectURL)
+	if err != nil || redirect.Scheme != "http" || !isLoopback(redirect.Hostname()) {
+		return OAuthToken{}, fmt.Errorf("oauth: redirect_url %q must be an http URL on localhost", app.RedirectURL)
+	}
+	state, err := randomToken()
+	if err != nil {
+		return OAuthToken{}, err
+	}
+	verifier, err := randomToken()
+	if err != nil {
+		return OAuthToken{}, err
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+
+	ln, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return OAuthToken{}, fmt.Errorf("oauth: listen for the redirect: %w", err)
+	}
+	type result struct {
+		code string
+		err  error
+	}
+	done := make(chan result, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(cmpPath(redirect.Path), func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res result
+		switch {
+		case q.Get("state") != state:
+			http.Error(w, "state mismatch", http.StatusBadRequest)
+			return // a stray request; keep waiting for the real redirect
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("oauth: authorization denied: %s", q.Get("error"))
+		case q.Get("code") == "":
+			res.err = errors.New("oauth: redirect carried no code")
+		default:
+			res.code = q.Get("code")
+		}
+		if res.err != nil {
+			fmt.Fprintln(w, "linearfs login failed; see the terminal.")
+		} else {
+			fmt.Fprintln(w, "linearfs is authorized. You can close this tab.")
+		}
+		select {
+		case done <- res:
+		default:
+		}
+	})
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go srv.Serve(ln) //nolint:errcheck // Serve returns ErrServerClosed on Close
+	defer srv.Close()
+
+	authorize := url.Values{
+		"client_id":             {app.ClientID},
+		"redirect_uri":          {app.RedirectURL},
+		"response_type":         {"code"},
+		"scope":                 {strings.Join(app.Scopes, ",")},
+		"state":                 {state},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	prompt(e.authorize + "?" + authorize.Encode())
+
+	var res result
+	select {
+	case res = <-done:
+	case <-ctx.Done():
+		return OAuthToken{}, fmt.Errorf("oauth: waiting for the redirect: %w", ctx.Err())
+	}
+	if res.err != nil {
+		return OAuthToken{}, res.err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {res.code},
+		"redirect_uri":  {app.RedirectURL},
+		"client_id":     {app.ClientID},
+		"code_verifier": {verifier},
+	}
+	if app.ClientSecret != "" {
+		form.Set("client_secret", app.ClientSecret)
+	}
+	return e.exchange(ctx, form)
+}
+
+// cmpPath is the mux pattern for a redirect path ("" serves the root).
+func cmpPath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// randomToken is a 256-bit URL-safe random string (state, PKCE verifier).
+func randomToken() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("oauth: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// SaveOAuthToken writes token to path owner-only (internal/atrest), through a
+// rename so a crash never leaves a torn file. CreateTemp already opens 0600.
+func SaveOAuthToken(path string, token OAuthToken) error {
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return fmt.Errorf("oauth: encode token: %w", err)
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, atrest.DirMode); err != nil {
+		return fmt.Errorf("oauth: save token: %w", err)
+	}
+	atrest.Chmod(dir, atrest.DirMode, atrest.ArtifactOAuth)
+	tmp, err := os.CreateTemp(dir, ".oauth-token-*")
+	if err != nil {
+		return fmt.Errorf("oauth: save token: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("oauth: save token: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("oauth: save token: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("oauth: save token: %w", err)
+	}
+	return nil
+}
+
+// TokenSource serves an OAuth access token, refreshing it when it is within
+// oauthRefreshSkew of expiry (or after Expire) and saving the rotated token
+// back to its file. The mutex is held across a refresh, so concurrent
+// requests wait for the one refresh rather than each spending the refresh
+// token.
+type TokenSource struct {
+	app       OAuthApp
+	path      string
+	endpoints oauthEndpoints
+
+	mu    gosync.Mutex
+	token OAuthToken
+}
+
+// LoadTokenSource reads the token `linearfs login` saved at path.
+func LoadTokenSource(app OAuthApp, path string) (*TokenSource, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("oauth: no token at %s - run `linearfs login`", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("oauth: read token: %w", err)
+	}
+	atrest.Chmod(path, atrest.FileMode, atrest.ArtifactOAuth)
+	var token OAuthToken
+	if err := json.Unmarshal(data, &token); err != nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("oauth: token file %s is unreadable - run `linearfs login`", path)
+	}
+	return &TokenSource{app: app, path: path, endpoints: linearOAuth, token: token}, nil
+}
+
+// AccessToken returns a current access token, refreshing first when the held
+// one is about to expire.
+func (s *TokenSource) AccessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.ExpiresAt.IsZero() || time.Until(s.token.ExpiresAt) > oauthRefreshSkew {
+		return s.token.AccessToken, nil
+	}
+	if s.token.RefreshToken == "" {
+		return "", errors.New("oauth: token expired and cannot be refreshed - run `linearfs login`")
+	}
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {s.token.RefreshToken},
+		"client_id":     {s.app.ClientID},
+	}
+	if s.app.ClientSecret != "" {
+		form.Set("client_secret", s.app.ClientSecret)
+	}
+	fresh, err := s.endpoints.exchange(ctx, form)
+	if err != nil {
+		return "", err
+	}
+	if fresh.RefreshToken == "" {
+		fresh.RefreshToken = s.token.RefreshToken
+	}
+	s.token = fresh
+	if err := SaveOAuthToken(s.path, fresh); err != nil {
+		// The refreshed token still works for this mount; the next one
+		// starts from the old refresh token, which Linear may have retired.
+		return fresh.AccessToken, fmt.Errorf("oauth: refreshed token not saved: %w", err)
+	}
+	return fresh.AccessToken, nil
+}
+
+// Expire marks the held token expired, so the next AccessToken refreshes it.
+// The client calls it when Linear rejects the token (HTTP 401) before its
+// recorded expiry, e.g. after a revocation or clock skew.
+func (s *TokenSource) Expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.RefreshToken != "" {
+		s.token.ExpiresAt = time.Now()
+	}
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	gosync "sync"
+	"testing"
+	"time"
+)
+
+// tokenServer is a fake Linear token endpoint: it answers every grant with a
+// numbered access token and records the forms it was posted.
+type tokenServer struct {
+	*httptest.Server
+	mu    gosync.Mutex
+	forms []url.Values
+}
+
+func newTokenServer(t *testing.T) *tokenServer {
+	t.Helper()
+	ts := &tokenServer{}
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ts.mu.Lock()
+		ts.forms = append(ts.forms, r.PostForm)
+		n := len(ts.forms)
+		ts.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access-%d","refresh_token":"refresh-%d","token_type":"Bearer","expires_in":86399,"scope":"read write"}`, n, n)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func (ts *tokenServer) posted() []url.Values {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return append([]url.Values(nil), ts.forms...)
+}
+
+func TestTokenSourceRefreshes(t *testing.T) {
+	t.Parallel()
+	ts := newTokenServer(t)
+	path := filepath.Join(t.TempDir(), "oauth-token.json")
+	if err := SaveOAuthToken(path, OAuthToken{AccessToken: "old", RefreshToken: "refresh-0", ExpiresAt: time.Now().Add(time.Minute)}); err != nil {
+		t.Fatalf("SaveOAuthToken: %v", err)
+	}
+	src, err := LoadTokenSource(OAuthApp{ClientID: "client"}, path)
+	if err != nil {
+		t.Fatalf("LoadTokenSource: %v", err)
+	}
+	src.endpoints.token = ts.URL
+
+	// Within the refresh skew: refreshed, and the rotated token saved.
+	got, err := src.AccessToken(context.Background())
+	if err != nil || got != "access-1" {
+		t.Fatalf("AccessToken = %q, %v; want access-1", got, err)
+	}
+	form := ts.posted()[0]
+	if form.Get("grant_type") != "refresh_token" || form.Get("refresh_token") != "refresh-0" || form.Get("client_id") != "client" {
+		t.Errorf("refresh form = %v", form)
+	}
+	if form.Has("client_secret") {
+		t.Errorf("refresh form sent an unset client_secret: %v", form)
+	}
+	saved, err := LoadTokenSource(OAuthApp{}, path)
+	if err != nil || saved.token.RefreshToken != "refresh-1" {
+		t.Errorf("saved token = %+v, %v; want refresh-1", saved.token, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("token file mode = %v, %v; want 0600", info.Mode().Perm(), err)
+	}
+
+	// Fresh: served without a request.
+	if got, _ := src.AccessToken(context.Background()); got != "access-1" || len(ts.posted()) != 1 {
+		t.Errorf("fresh token: got %q after %d requests", got, len(ts.posted()))
+	}
+
+	// Expire (a 401) forces the next call to refresh.
+	src.Expire()
+	if got, _ := src.AccessToken(context.Background()); got != "access-2" {
+		t.Errorf("after Expire: AccessToken = %q, want access-2", got)
+	}
+}
+
+func TestTokenSourceWithoutRefreshToken(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "oauth-token.json")
+	if err := SaveOAuthToken(path, OAuthToken{AccessToken: "static"}); err != nil {
+		t.Fatal(err)
+	}
+	src, err := LoadTokenSource(OAuthApp{}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.Expire() // nothing to refresh with: a non-expiring token stays in use
+	if got, err := src.AccessToken(context.Background()); err != nil || got != "static" {
+		t.Errorf("AccessToken = %q, %v; want static", got, err)
+	}
+
+	if _, err := LoadTokenSource(OAuthApp{}, filepath.Join(t.TempDir(), "missing.json")); err == nil || !strings.Contains(err.Error(), "linearfs login") {
+		t.Errorf("LoadTokenSource(missing) = %v, want a pointer at linearfs login", err)
+	}
+}
+
+// TestOAuthClientSendsBearer checks the client authenticates with the token
+// source ("Bearer " prefix, unlike a raw API key) and that a 401 makes the
+// next request refresh.
+func TestOAuthClientSendsBearer(t *testing.T) {
+	t.Parallel()
+	ts := newTokenServer(t)
+	path := filepath.Join(t.TempDir(), "oauth-token.json")
+	if err := SaveOAuthToken(path, OAuthToken{AccessToken: "access-0", RefreshToken: "refresh-0", ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	src, err := LoadTokenSource(OAuthApp{ClientID: "client"}, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src.endpoints.token = ts.URL
+
+	var mu gosync.Mutex
+	var seen []string
+	gql := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("Authorization"))
+		first := len(seen) == 1
+		mu.Unlock()
+		if first {
+			http.Error(w, `{"errors":[{"message":"revoked"}]}`, http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer gql.Close()
+
+	c := NewOAuthClient(src)
+	c.SetAPIURL(gql.URL)
+	var out struct{}
+	if err := c.query(context.Background(), `query Probe { viewer { id } }`, nil, &out); err == nil {
+		t.Fatal("first query should surface the 401")
+	}
+	if err := c.query(context.Background(), `query Probe { viewer { id } }`, nil, &out); err != nil {
+		t.Fatalf("second query: %v", err)
+	}
+	want := []string{"Bearer access-0", "Bearer access-1"}
+	if len(seen) != 2 || seen[0] != want[0] || seen[1] != want[1] {
+		t.Errorf("Authorization headers = %v, want %v", seen, want)
+	}
+	if got := c.AuthHeader(); got != "Bearer access-1" {
+		t.Errorf("AuthHeader = %q, want Bearer access-1", got)
+	}
+}
+
+// TestLogin drives the authorization-code flow end to end, playing the
+// browser: it follows the authorize URL's redirect_uri back to the login
+// listener with the state and a code, and checks the exchange carries the
+// PKCE verifier matching the challenge.
+func TestLogin(t *testing.T) {
+	t.Parallel()
+	ts := newTokenServer(t)
+	redirect := "http://" + freeLoopbackAddr(t) + "/callback"
+	app := OAuthApp{ClientID: "client", RedirectURL: redirect, Scopes: []string{"read", "write"}}
+	endpoints := oauthEndpoints{authorize: "https://linear.test/oauth/authorize", token: ts.URL}
+
+	var challenge string
+	browse := func(authorizeURL string) {
+		u, err := url.Parse(authorizeURL)
+		if err != nil {
+			t.Errorf("authorize URL: %v", err)
+			return
+		}
+		q := u.Query()
+		if q.Get("scope") != "read,write" || q.Get("code_challenge_method") != "S256" || q.Get("redirect_uri") != redirect {
+			t.Errorf("authorize query = %v", q)
+		}
+		challenge = q.Get("code_challenge")
+		go func() {
+			// A request with the wrong state is ignored, not fatal.
+			if resp, err := http.Get(redirect + "?code=forged&state=wrong"); err == nil {
+				resp.Body.Close()
+			}
+			resp, err := http.Get(redirect + "?code=the-code&state=" + url.QueryEscape(q.Get("state")))
+			if err != nil {
+				t.Errorf("redirect: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	token, err := endpoints.login(ctx, app, browse)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" || token.ExpiresAt.IsZero() {
+		t.Errorf("token = %+v", token)
+	}
+	form := ts.posted()[0]
+	if form.Get("grant_type") != "authorization_code" || form.Get("code") != "the-code" {
+		t.Errorf("exchange form = %v", form)
+	}
+	sum := sha256.Sum256([]byte(form.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+		t.Error("code_verifier does not match the code_challenge")
+	}
+}
+
+func TestLoginRefusesNonLoopbackRedirect(t *testing.T) {
+	t.Parallel()
+	for _, redirect := range []string{"https://localhost:8976/callback", "http://example.com/callback", "::bad"} {
+		_, err := Login(context.Background(), OAuthApp{ClientID: "client", RedirectURL: redirect}, func(string) {
+			t.Errorf("%s: prompted before refusing", redirect)
+		})
+		if err == nil {
+			t.Errorf("Login with redirect %q: want refusal", redirect)
+		}
+	}
+}
+
+// freeLoopbackAddr returns a loopback address with a port that was free a
+// moment ago, for a listener the code under test opens itself.
+func freeLoopbackAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return addr
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bulk-sync field projection.
+//
+// A giant workspace browsed mostly by title pays for every issue's description,
+// label list, and attachments on every sync page, though few are ever opened.
+// LazyIssueFields names the heavy fields the bulk paths — the team issues page
+// and the details batch — leave out. The selections stay the same documents:
+// each heavy field carries an @include directive whose variable defaults to
+// true, so every other caller (single-issue reads, the create mutation, the
+// SWR details refresh) keeps selecting it without passing anything.
+
+// Names accepted in api.lazy_issue_fields.
+const (
+	LazyDescription = "description"
+	LazyLabels      = "labels"
+	LazyAttachments = "attachments"
+)
+
+// LazyIssueFields is the set of heavy issue fields bulk sync does not request.
+// The zero value requests everything (the default).
+type LazyIssueFields struct {
+	Description bool
+	Labels      bool
+	Attachments bool
+}
+
+// ParseLazyIssueFields reads the api.lazy_issue_fields config list.
+func ParseLazyIssueFields(names []string) (LazyIssueFields, error) {
+	var l LazyIssueFields
+	for _, name := range names {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case LazyDescription:
+			l.Description = true
+		case LazyLabels:
+			l.Labels = true
+		case LazyAttachments:
+			l.Attachments = true
+		default:
+			return LazyIssueFields{}, fmt.Errorf("lazy_issue_fields: unknown field %q (supported: %s, %s, %s)",
+				name, LazyDescription, LazyLabels, LazyAttachments)
+		}
+	}
+	return l, nil
+}
+
+// IssueFields reports whether an issue row synced under l lacks fields only
+// a full single-issue fetch supplies.
+func (l LazyIssueFields) IssueFields() bool {
+	return l.Description || l.Labels
+}
+
+// issueVars adds the IssueFieldsLite projection variables to vars. Only the
+// projected-out fields are passed; the rest keep their true defaults.
+func (l LazyIssueFields) issueVars(vars map[string]any) {
+	if l.Description {
+		vars["withDescription"] = false
+	}
+	if l.Labels {
+		vars["withLabels"] = false
+	}
+}
+
+// SetLazyIssueFields sets the fields the bulk sync paths leave out. Set it
+// once, before the client issues any requests; the field is read without
+// synchronization.
+func (c *Client) SetLazyIssueFields(l LazyIssueFields) {
+	c.lazy = l
+}
+
+// LazyIssueFields returns the fields the bulk sync paths leave out.
+func (c *Client) LazyIssueFields() LazyIssueFields {
+	return c.lazy
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/testutil"
+)
+
+func TestParseLazyIssueFields(t *testing.T) {
+	t.Parallel()
+	got, err := ParseLazyIssueFields([]string{"description", " Labels ", "attachments"})
+	if err != nil {
+		t.Fatalf("ParseLazyIssueFields: %v", err)
+	}
+	if want := (LazyIssueFields{Description: true, Labels: true, Attachments: true}); got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if got, err := ParseLazyIssueFields(nil); err != nil || got != (LazyIssueFields{}) || got.IssueFields() {
+		t.Errorf("empty list = %+v, %v; want the zero projection", got, err)
+	}
+	if (LazyIssueFields{Attachments: true}).IssueFields() {
+		t.Error("attachments alone marked the issue row incomplete; they live outside it")
+	}
+	if _, err := ParseLazyIssueFields([]string{"comments"}); err == nil {
+		t.Error("an unknown field was accepted")
+	}
+}
+
+// TestLazyIssueFieldsProjectBulkPaths: the projection reaches only the bulk
+// paths, as false @include variables; everything else keeps the defaults.
+func TestLazyIssueFieldsProjectBulkPaths(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetResponse("TeamIssuesByUpdatedAt", map[string]any{"team": map[string]any{"issues": map[string]any{
+		"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+		"nodes":    []map[string]any{{"id": "issue-a", "identifier": "ENG-1", "title": "Title only"}},
+	}}})
+	mock.SetResponse("IssueDetailsBatch", map[string]any{"i0": detailsPayload("comment-1")})
+	mock.SetResponse("Issue", map[string]any{"issue": map[string]any{"id": "issue-a", "description": "Body"}})
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+	c.SetLazyIssueFields(LazyIssueFields{Description: true, Attachments: true})
+	ctx := context.Background()
+
+	if _, _, err := c.GetTeamIssuesPage(ctx, "team-1", "", 50); err != nil {
+		t.Fatalf("GetTeamIssuesPage: %v", err)
+	}
+	details, err := c.GetIssueDetailsBatch(ctx, []string{"issue-a"})
+	if err != nil {
+		t.Fatalf("GetIssueDetailsBatch: %v", err)
+	}
+	if !details["issue-a"].AttachmentsOmitted {
+		t.Error("a batch without attachments did not say so; the persist would prune against the empty list")
+	}
+	if len(details["issue-a"].Comments) != 1 {
+		t.Errorf("comments = %+v, want the fetched one", details["issue-a"].Comments)
+	}
+	if _, err := c.GetIssue(ctx, "issue-a"); err != nil {
+		t.Fatalf("GetIssue: %v", err)
+	}
+
+	vars := map[string]map[string]any{}
+	for _, call := range mock.Calls() {
+		vars[call.Operation] = call.Variables
+	}
+	if v := vars["TeamIssuesByUpdatedAt"]; v["withDescription"] != false {
+		t.Errorf("issues page variables = %v, want withDescription false", v)
+	} else if _, ok := v["withLabels"]; ok {
+		t.Errorf("issues page variables = %v, want withLabels left to its default", v)
+	}
+	if v := vars["IssueDetailsBatch"]; v["withAttachments"] != false {
+		t.Errorf("details batch variables = %v, want withAttachments false", v)
+	}
+	if v := vars["Issue"]; len(v) != 1 {
+		t.Errorf("single-issue variables = %v, want only id: an open fetches every field", v)
+	}
+}
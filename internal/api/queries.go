@@ -14,6 +14,9 @@ query Teams($after: String) {
       key
       name
       icon
+      color
+      description
+      private
       createdAt
       updatedAt
     }
@@ -21,9 +24,11 @@ query Teams($after: String) {
 }
 `
 
-// queryTeamIssuesByUpdatedAt fetches issues ordered by updatedAt DESC for incremental sync
+// queryTeamIssuesByUpdatedAt fetches issues ordered by updatedAt DESC for
+// incremental sync. The with* variables project heavy fields out of the page
+// (api.lazy_issue_fields, projection.go).
 var queryTeamIssuesByUpdatedAt = `
-query TeamIssuesByUpdatedAt($teamId: String!, $first: Int!, $after: String) {
+query TeamIssuesByUpdatedAt($teamId: String!, $first: Int!, $after: String, $withDescription: Boolean = true, $withLabels: Boolean = true) {
   team(id: $teamId) {
     issues(first: $first, after: $after, orderBy: updatedAt) {
       pageInfo { hasNextPage endCursor }
@@ -33,6 +38,20 @@ query TeamIssuesByUpdatedAt($teamId: String!, $first: Int!, $after: String) {
 }
 ` + issueFieldsFragmentLite
 
+// queryTeamArchivedIssues fetches a team's issues archived after $since —
+// issues(...) leaves archived issues out unless includeArchived, and the
+// archivedAt filter keeps the live ones out. For issues/.archived/.
+var queryTeamArchivedIssues = `
+query TeamArchivedIssues($teamId: String!, $since: DateTimeOrDuration!, $after: String, $withDescription: Boolean = true, $withLabels: Boolean = true) {
+  team(id: $teamId) {
+    issues(first: 50, after: $after, includeArchived: true, orderBy: updatedAt, filter: { archivedAt: { gt: $since } }) {
+      pageInfo { hasNextPage endCursor }
+      nodes { ...IssueFieldsLite }
+    }
+  }
+}
+` + issueFieldsFragmentLite
+
 var queryIssue = `
 query Issue($id: String!) {
   issue(id: $id) { ...IssueFields }
@@ -41,18 +60,20 @@ query Issue($id: String!) {
 
 // issueFieldsFragmentLite is a lighter fragment for bulk queries (no relations).
 // Use this for fetching many issues at once to avoid GraphQL complexity limits.
+// An operation spreading it must declare $withDescription and $withLabels
+// (default true) for the bulk-sync projection's @include directives.
 const issueFieldsFragmentLite = `
 fragment IssueFieldsLite on Issue {
   id
   identifier
   title
-  description
+  description @include(if: $withDescription)
   branchName
   state { id name type }
   assignee { id name email }
   creator { id name email }
   priority
-  labels { nodes { id name color description } }
+  labels @include(if: $withLabels) { nodes { id name color description } }
   dueDate
   estimate
   createdAt
@@ -61,6 +82,7 @@ fragment IssueFieldsLite on Issue {
   completedAt
   canceledAt
   archivedAt
+  slaBreachesAt
   url
   team { id key name }
   project { id name slugId }
@@ -106,6 +128,7 @@ fragment IssueFields on Issue {
   completedAt
   canceledAt
   archivedAt
+  slaBreachesAt
   url
   team { id key name }
   project { id name slugId }
@@ -123,6 +146,7 @@ const CommentFieldsFragment = `
 fragment CommentFields on Comment {
   id
   body
+  url
   createdAt
   updatedAt
   editedAt
@@ -185,6 +209,7 @@ fragment CycleFields on Cycle {
   name
   startsAt
   endsAt
+  completedAt
   completedIssueCountHistory
   issueCountHistory
 }
@@ -214,6 +239,11 @@ fragment InitiativeFields on Initiative {
     name
     email
   }
+  parentInitiative {
+    id
+    name
+    slugId
+  }
 }
 `
 
@@ -420,6 +450,8 @@ fragment ProjectFields on Project {
   name
   slugId
   description
+  icon
+  color
   content
   url
   state
@@ -719,6 +751,102 @@ query Viewer {
 }
 ` + userFieldsFragment
 
+// queryOrganization fetches the workspace identity fields any member may read;
+// queryOrganizationAuth the admin-only SSO/SCIM flags (see GetOrganization).
+const queryOrganization = `
+query Organization {
+  organization {
+    id
+    name
+    urlKey
+    allowedAuthServices
+    userCount
+    createdAt
+    updatedAt
+  }
+}
+`
+
+const queryOrganizationAuth = `
+query OrganizationAuth {
+  organization {
+    samlEnabled
+    scimEnabled
+  }
+}
+`
+
+// queryCustomViews drains the saved views visible to the API key: the
+// user's own plus those shared with the workspace or their teams.
+const queryCustomViews = `
+query CustomViews($after: String) {
+  customViews(first: 100, after: $after) {
+    pageInfo { hasNextPage endCursor }
+    nodes {
+      id
+      name
+      description
+      slugId
+      shared
+      team { id key name }
+      owner { ...UserFields }
+      createdAt
+      updatedAt
+    }
+  }
+}
+` + userFieldsFragment
+
+// queryTeamTemplates drains a team's templates. templateData is Linear's
+// JSON scalar: the prefilled entity, keyed the way its create input is.
+const queryTeamTemplates = `
+query TeamTemplates($teamId: String!, $after: String) {
+  team(id: $teamId) {
+    templates(first: 100, after: $after) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        id
+        name
+        description
+        type
+        templateData
+        createdAt
+        updatedAt
+      }
+    }
+  }
+}
+`
+
+// queryEmojis drains the workspace's custom emojis.
+const queryEmojis = `
+query Emojis($after: String) {
+  emojis(first: 100, after: $after) {
+    pageInfo { hasNextPage endCursor }
+    nodes {
+      id
+      name
+      url
+      createdAt
+      updatedAt
+    }
+  }
+}
+`
+
+// queryCustomViewIssueIDs drains the IDs of the issues a saved view matches,
+// evaluated server-side against the view's filter.
+const queryCustomViewIssueIDs = `
+query CustomViewIssueIDs($viewId: String!, $after: String) {
+  customView(id: $viewId) {
+    issues(first: 250, after: $after) {
+      pageInfo { hasNextPage endCursor }
+      nodes { id }
+    }
+  }
+}
+`
+
 const mutationUpdateIssue = `
 mutation UpdateIssue($id: String!, $input: IssueUpdateInput!) {
   issueUpdate(id: $id, input: $input) {
@@ -732,7 +860,7 @@ mutation UpdateIssue($id: String!, $input: IssueUpdateInput!) {
 `
 
 var mutationCreateIssue = `
-mutation CreateIssue($input: IssueCreateInput!) {
+mutation CreateIssue($input: IssueCreateInput!, $withDescription: Boolean = true, $withLabels: Boolean = true) {
   issueCreate(input: $input) {
     success
     issue { ...IssueFieldsLite }
@@ -748,6 +876,14 @@ mutation ArchiveIssue($id: String!) {
 }
 `
 
+const mutationUnarchiveIssue = `
+mutation UnarchiveIssue($id: String!) {
+  issueUnarchive(id: $id) {
+    success
+  }
+}
+`
+
 // IssueDetailsPageSize is the `first:` page cap on the issue-details queries
 // (single and batch). Exported because the sync worker's stale-row pruning may
 // only treat a fetched set as complete when its length is below this cap — a
@@ -764,10 +900,12 @@ const IssueRelationsPageSize = 50
 // IssueDetailsSelection is the per-issue selection body shared by the
 // single-issue details query and every alias of the batch query, so the two
 // can never drift. The relation selections mirror the IssueFields fragment's
-// (the row needs only the ids; identifier/title ride along for parity).
+// (the row needs only the ids; identifier/title ride along for parity). Both
+// declare $withAttachments (default true); the batch passes false when
+// attachments are projected out of bulk sync (projection.go).
 var IssueDetailsSelection = fmt.Sprintf(`comments(first: %d) { nodes { ...CommentFields } }
     documents(first: %d) { nodes { ...DocumentFields } }
-    attachments(first: %d) { nodes { ...AttachmentFields } }
+    attachments(first: %d) @include(if: $withAttachments) { nodes { ...AttachmentFields } }
     relations(first: %d) { nodes { ...IssueRelationFields } }
     inverseRelations(first: %d) { nodes { ...IssueInverseRelationFields } }`,
 	IssueDetailsPageSize, IssueDetailsPageSize, IssueDetailsPageSize, IssueRelationsPageSize, IssueRelationsPageSize)
@@ -775,7 +913,7 @@ var IssueDetailsSelection = fmt.Sprintf(`comments(first: %d) { nodes { ...Commen
 // queryIssueDetails fetches comments, documents, attachments, and relations
 // for an issue in one query
 var queryIssueDetails = fmt.Sprintf(`
-query IssueDetails($issueId: String!) {
+query IssueDetails($issueId: String!, $withAttachments: Boolean = true) {
   issue(id: $issueId) {
     %s
   }
@@ -880,6 +1018,16 @@ query TeamDocuments($teamId: ID!, $after: String) {
 }
 ` + DocumentFieldsFragment
 
+// queryAllDocuments drains every document the key can see, whatever owns it.
+var queryAllDocuments = `
+query AllDocuments($after: String) {
+  documents(first: 100, after: $after) {
+    pageInfo { hasNextPage endCursor }
+    nodes { ...DocumentFields }
+  }
+}
+` + DocumentFieldsFragment
+
 var mutationCreateDocument = `
 mutation CreateDocument($input: DocumentCreateInput!) {
   documentCreate(input: $input) {
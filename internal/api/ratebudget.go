@@ -88,7 +88,7 @@ var reserveFrac = map[priority]float64{
 // defaultPredictedCost prices an operation that has never been measured:
 // the single-query complexity maximum, so unknowns are treated as expensive
 // until the first response teaches us their real cost.
-const defaultPredictedCost = 10000
+const defaultPredictedCost = maxQueryComplexity
 
 // seedHourlyRequestLimit seeds the micro-burst rate.Limiter before the
 // first response has reported the real request limit. It is a smoothing
@@ -108,6 +108,8 @@ const rateLimitedFallbackBackoff = 15 * time.Minute
 var opBaseTier = map[string]priority{
 	// Skeleton: identity and metadata the filesystem's shape depends on.
 	"Viewer":                   pSkeleton,
+	"Organization":             pSkeleton,
+	"OrganizationAuth":         pSkeleton,
 	"Teams":                    pSkeleton,
 	"TeamMetadata":             pSkeleton,
 	"TeamLabelsPage":           pSkeleton,
@@ -123,6 +125,8 @@ var opBaseTier = map[string]priority{
 	"InitiativeProjectsPage":   pSkeleton,
 	"Initiative":               pSkeleton,
 	"Project":                  pSkeleton,
+	"CustomViews":              pSkeleton,
+	"TeamTemplates":            pSkeleton,
 
 	// Lists: issue pages and the reconcile ID sweeps.
 	"TeamIssuesByUpdatedAt": pList,
@@ -135,6 +139,7 @@ var opBaseTier = map[string]priority{
 	"WorkspaceProjectIDs":     pList,
 	"WorkspaceInitiativeIDs":  pList,
 	"Issue":                   pList,
+	"CustomViewIssueIDs":      pList,
 
 	// Details: the per-issue/project/initiative deep fetches — the largest
 	// complexity spenders, and the first to defer.
@@ -204,12 +209,28 @@ type decision struct {
 // window is one budget axis: {limit, remaining, resetAt}, all read from
 // response headers, never hardcoded. seen is false until the first header
 // reconcile — an unseen axis does not gate (the first response seeds it).
+//
+// ceiling is the operator's cap on this axis (api.rate_limit, 0 = none): the
+// client then spends at most ceiling per window, however much the server
+// allows, by reading the window as if the server's limit were the ceiling
+// and the difference were already spent — headroom left for other tools
+// sharing the key.
 type window struct {
-	name      string // "complexity" / "requests", for messages
-	limit     float64
-	remaining float64
-	resetAt   time.Time
-	seen      bool
+	name        string // "complexity" / "requests", for messages
+	limit       float64
+	remaining   float64
+	resetAt     time.Time
+	seen        bool
+	ceiling     float64
+	serverLimit float64 // the limit header before the ceiling applies
+}
+
+// headroom is the part of the server's window the ceiling keeps unspent.
+func (w *window) headroom() float64 {
+	if w.ceiling <= 0 || w.serverLimit <= w.ceiling {
+		return 0
+	}
+	return w.serverLimit - w.ceiling
 }
 
 // effectiveRemaining applies optimistic refill: past resetAt the axis is
@@ -237,9 +258,10 @@ type rateBudget struct {
 	now          func() time.Time
 	complexity   window
 	requests     window
-	inFlightCost float64            // complexity points reserved by unsettled admissions
-	inFlightReqs float64            // request count reserved by unsettled admissions
-	cost         map[string]float64 // opName -> last-seen X-Complexity
+	inFlightCost float64                   // complexity points reserved by unsettled admissions
+	inFlightReqs float64                   // request count reserved by unsettled admissions
+	cost         map[string]float64        // opName -> last-seen X-Complexity
+	spend        map[time.Time]*BudgetHour // hour -> spend not yet taken for persistence (budgetstate.go)
 
 	// metrics are the budget-owned OTEL instruments (metrics.go): the
 	// decisions counter fires where admit resolves, the complexity
@@ -343,6 +365,7 @@ func (a *admission) observe(h http.Header) {
 	a.settled = true
 	a.b.releaseLocked(a.cost)
 	a.actual, a.actualSeen = a.b.reconcileLocked(a.op, h)
+	a.b.recordSpendLocked(a.actual)
 }
 
 // actualComplexity reports the response's X-Complexity as parsed by the
@@ -371,6 +394,7 @@ func (a *admission) rateLimited(h http.Header) {
 	a.settled = true
 	a.b.releaseLocked(a.cost)
 	a.actual, a.actualSeen = a.b.reconcileLocked(a.op, h)
+	a.b.recordSpendLocked(a.actual)
 	a.b.snapExhaustedLocked()
 	a.b.metrics.recordDecision(a.tier, "ratelimited")
 }
@@ -428,11 +452,12 @@ func (b *rateBudget) reconcileLocked(op string, h http.Header) (complexity float
 
 func reconcileAxis(w *window, h http.Header, prefix string) {
 	if v, ok := headerFloat(h, prefix+"-Limit"); ok {
-		w.limit = v
+		w.serverLimit = v
+		w.limit = v - w.headroom()
 		w.seen = true
 	}
 	if v, ok := headerFloat(h, prefix+"-Remaining"); ok {
-		w.remaining = v
+		w.remaining = max(0, v-w.headroom())
 		w.seen = true
 	}
 	if ms, ok := headerInt(h, prefix+"-Reset"); ok {
@@ -531,7 +556,17 @@ func snapAxis(w *window, inFlight float64, now time.Time) axisSnapshot {
 	return s
 }
 
-// requestsLimit returns the server-reported hourly request limit, 0 until
+// setCeilings caps the two axes (0 = uncapped); see window.ceiling. The caps
+// apply from the next response's headers on.
+func (b *rateBudget) setCeilings(requests, complexity float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.requests.ceiling = requests
+	b.complexity.ceiling = complexity
+}
+
+// requestsLimit returns the server-reported hourly request limit (capped by
+// any ceiling), 0 until
 // the first response has been observed. Client uses it to size the
 // micro-burst limiter and the stats denominator.
 func (b *rateBudget) requestsLimit() float64 {
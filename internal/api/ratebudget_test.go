@@ -157,6 +157,41 @@ func TestRateBudget_ObserveReconciles(t *testing.T) {
 	}
 }
 
+// TestRateBudget_Ceilings: an operator cap reads the server's window as if
+// its limit were the cap and the difference already spent, so the client
+// leaves that headroom to other users of the key. A cap above the server's
+// limit changes nothing.
+func TestRateBudget_Ceilings(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	b := testBudget(clock)
+	b.setCeilings(1000, 5_000_000)
+	reset := clock.t.Add(time.Hour)
+
+	adm, _ := b.admit("Issues", pList)
+	adm.observe(fullHeaders(10, 3_000_000, 2_000_000, 2500, 2000, reset))
+
+	b.mu.Lock()
+	if b.requests.limit != 1000 || b.requests.remaining != 500 {
+		t.Errorf("requests window = %+v, want limit 1000 remaining 500 (1500 kept as headroom)", b.requests)
+	}
+	if b.complexity.limit != 3_000_000 || b.complexity.remaining != 2_000_000 {
+		t.Errorf("complexity window = %+v, want the server's numbers (cap above limit)", b.complexity)
+	}
+	b.mu.Unlock()
+
+	// Others have spent into the headroom: nothing is left for this client.
+	adm, _ = b.admit("Issues", pWrite)
+	adm.observe(fullHeaders(10, 3_000_000, 2_000_000, 2500, 1400, reset))
+	if !b.low(pSkeleton) {
+		t.Error("budget not low with the server below the headroom the cap keeps")
+	}
+	if got := b.requestsLimit(); got != 1000 {
+		t.Errorf("requestsLimit = %v, want the capped 1000 (sizes the limiter)", got)
+	}
+}
+
 // TestRateBudget_CostPredictor: an unmeasured op is priced at the
 // conservative default (10k, the single-query max); once observed, the
 // last-seen X-Complexity is used instead.
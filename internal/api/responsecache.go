@@ -0,0 +1,175 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// responseCache answers a repeated read from the last response for a short
+// while. A cold walk of the mount (an IDE indexing it, `grep -r`) asks for
+// the same team's projects, templates, documents and members from many
+// directories at once; without it each of those reads that misses the SQLite
+// cache costs a request against the hourly budget.
+//
+// Only the operations in cacheableOps are cached: idempotent list queries
+// whose answer a few seconds old is as good as a fresh one. Issue reads and
+// the sync worker's pages are never cached, since write-back and the
+// incremental sync rely on seeing Linear's current state. Entries are keyed by
+// operation and variables and live for responseCacheTTL; any mutation drops
+// them all, so a read after a write never sees the state before it (a read
+// that was in flight across the mutation is not kept either).
+//
+// An expired entry whose response carried an ETag or Last-Modified is kept and
+// revalidated: the next request sends If-None-Match / If-Modified-Since, and a
+// 304 renews the entry without a body. Linear's GraphQL endpoint does not send
+// validators today, so in practice entries simply expire.
+//
+// Like circuitBreaker it is a clock-injected state machine: query owns the
+// HTTP, this owns what is kept (see responsecache_test.go).
+type responseCache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+	gen     uint64 // bumped by clear; a response from an older gen is not kept
+}
+
+// cachedResponse is one kept response: the GraphQL data and its validators.
+type cachedResponse struct {
+	data         json.RawMessage
+	etag         string
+	lastModified string
+	expires      time.Time
+}
+
+// responseCacheTTL is how long a cached response is served without asking.
+const responseCacheTTL = 15 * time.Second
+
+// responseCacheMaxEntries bounds the cache; a full cache drops its expired
+// entries first, then everything.
+const responseCacheMaxEntries = 1024
+
+// cacheableOps are the operations whose responses are cached.
+var cacheableOps = map[string]bool{
+	"Teams":                   true,
+	"TeamMetadata":            true,
+	"TeamProjects":            true,
+	"TeamTemplates":           true,
+	"TeamDocuments":           true,
+	"TeamMembersPage":         true,
+	"TeamLabelsPage":          true,
+	"TeamCyclesPage":          true,
+	"ProjectDocuments":        true,
+	"ProjectUpdates":          true,
+	"ProjectExternalLinks":    true,
+	"ProjectLabelsPage":       true,
+	"InitiativeDocuments":     true,
+	"InitiativeUpdates":       true,
+	"InitiativeExternalLinks": true,
+	"AllDocuments":            true,
+	"CustomViews":             true,
+	"CustomViewIssueIDs":      true,
+	"Emojis":                  true,
+	"WorkspaceUsersPage":      true,
+	"WorkspaceLabelsPage":     true,
+}
+
+func newResponseCache(ttl time.Duration, maxEntries int, now func() time.Time) *responseCache {
+	return &responseCache{ttl: ttl, maxEntries: maxEntries, now: now, entries: make(map[string]*cachedResponse)}
+}
+
+// key is the cache key for a request, and whether its response may be cached
+// at all. Variables marshal with sorted map keys, so equal variables give
+// equal keys.
+func (rc *responseCache) key(opName string, variables map[string]any) (string, bool) {
+	if !cacheableOps[opName] {
+		return "", false
+	}
+	vars, err := json.Marshal(variables)
+	if err != nil {
+		return "", false
+	}
+	return opName + " " + string(vars), true
+}
+
+// cacheLookup is what get found for a key.
+type cacheLookup struct {
+	data  json.RawMessage // the kept data, fresh or awaiting revalidation
+	fresh bool
+
+	// etag and lastModified are the validators of an expired entry to
+	// revalidate ("" when there is nothing to revalidate); on a 304 its data
+	// answers the request.
+	etag, lastModified string
+
+	// gen is the generation the request starts in, handed back to put.
+	gen uint64
+}
+
+// get returns the data kept for key while it is fresh, or else the
+// validators to revalidate it with.
+func (rc *responseCache) get(key string) cacheLookup {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	l := cacheLookup{gen: rc.gen}
+	e, ok := rc.entries[key]
+	switch {
+	case !ok:
+	case rc.now().Before(e.expires):
+		l.data, l.fresh = e.data, true
+	case e.etag == "" && e.lastModified == "":
+		delete(rc.entries, key)
+	default:
+		l.data, l.etag, l.lastModified = e.data, e.etag, e.lastModified
+	}
+	return l
+}
+
+// put keeps a successful response's data under key, with the response's
+// validators, unless a mutation cleared the cache since gen.
+func (rc *responseCache) put(key string, gen uint64, data json.RawMessage, header http.Header) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if gen != rc.gen {
+		return
+	}
+	if _, ok := rc.entries[key]; !ok && len(rc.entries) >= rc.maxEntries {
+		now := rc.now()
+		for k, e := range rc.entries {
+			if !now.Before(e.expires) {
+				delete(rc.entries, k)
+			}
+		}
+		if len(rc.entries) >= rc.maxEntries {
+			clear(rc.entries)
+		}
+	}
+	rc.entries[key] = &cachedResponse{
+		data:         data,
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+		expires:      rc.now().Add(rc.ttl),
+	}
+}
+
+// revalidated renews key after a 304, unless a mutation cleared the cache
+// since gen.
+func (rc *responseCache) revalidated(key string, gen uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if e, ok := rc.entries[key]; ok && gen == rc.gen {
+		e.expires = rc.now().Add(rc.ttl)
+	}
+}
+
+// clear drops every entry: a mutation may have changed any of them.
+func (rc *responseCache) clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	clear(rc.entries)
+	rc.gen++
+}
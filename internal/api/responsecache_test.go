@@ -0,0 +1,181 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/testutil"
+)
+
+// responseCache's keeping rules are driven here with the package's fakeClock
+// (ratebudget_test.go); the two client tests below check query's use of it.
+
+func TestResponseCacheKey(t *testing.T) {
+	t.Parallel()
+	rc := newResponseCache(time.Minute, 8, newFakeClock().now)
+	a, ok := rc.key("TeamProjects", map[string]any{"teamId": "t1", "first": 50})
+	b, _ := rc.key("TeamProjects", map[string]any{"first": 50, "teamId": "t1"})
+	if !ok || a != b {
+		t.Errorf("equal variables gave keys %q and %q (cacheable %v)", a, b, ok)
+	}
+	if c, _ := rc.key("TeamProjects", map[string]any{"teamId": "t2", "first": 50}); c == a {
+		t.Errorf("different variables share key %q", c)
+	}
+	for _, op := range []string{"Issue", "IssueDetails", "TeamIssuesByUpdatedAt", "UpdateIssue"} {
+		if _, ok := rc.key(op, nil); ok {
+			t.Errorf("%s is cacheable", op)
+		}
+	}
+}
+
+func TestResponseCacheExpiryAndClear(t *testing.T) {
+	t.Parallel()
+	clk := newFakeClock()
+	rc := newResponseCache(time.Minute, 8, clk.now)
+
+	l := rc.get("k")
+	if l.fresh || l.data != nil {
+		t.Fatalf("empty cache lookup = %+v", l)
+	}
+	rc.put("k", l.gen, json.RawMessage(`{"a":1}`), http.Header{})
+	if l := rc.get("k"); !l.fresh || string(l.data) != `{"a":1}` {
+		t.Errorf("fresh lookup = %+v", l)
+	}
+
+	// Without validators an expired entry is gone.
+	clk.advance(time.Minute)
+	if l := rc.get("k"); l.fresh || l.data != nil {
+		t.Errorf("expired lookup = %+v", l)
+	}
+
+	// With one it waits to be revalidated, and a 304 renews it.
+	l = rc.get("k")
+	rc.put("k", l.gen, json.RawMessage(`{"a":2}`), http.Header{"Etag": {`"v2"`}})
+	clk.advance(2 * time.Minute)
+	l = rc.get("k")
+	if l.fresh || l.etag != `"v2"` || string(l.data) != `{"a":2}` {
+		t.Fatalf("revalidation lookup = %+v", l)
+	}
+	rc.revalidated("k", l.gen)
+	if l := rc.get("k"); !l.fresh {
+		t.Errorf("revalidated entry is not fresh: %+v", l)
+	}
+
+	// A mutation clears everything, and a read in flight across it is not
+	// kept.
+	inFlight := rc.get("other")
+	rc.clear()
+	if l := rc.get("k"); l.data != nil {
+		t.Errorf("entry survived clear: %+v", l)
+	}
+	rc.put("other", inFlight.gen, json.RawMessage(`{}`), http.Header{})
+	if l := rc.get("other"); l.data != nil {
+		t.Errorf("read from before the clear was kept: %+v", l)
+	}
+}
+
+func TestResponseCacheBounded(t *testing.T) {
+	t.Parallel()
+	clk := newFakeClock()
+	rc := newResponseCache(time.Minute, 2, clk.now)
+	put := func(k string) { rc.put(k, rc.get(k).gen, json.RawMessage(`1`), http.Header{}) }
+
+	put("a")
+	clk.advance(time.Minute) // a expires
+	put("b")
+	put("c") // full: the expired a makes room
+	if rc.get("b").data == nil || rc.get("c").data == nil {
+		t.Error("live entries dropped while an expired one made room")
+	}
+	put("d") // full of live entries: start over
+	if len(rc.entries) != 1 || rc.get("d").data == nil {
+		t.Errorf("entries after overflow = %d, want just d", len(rc.entries))
+	}
+}
+
+// TestClientResponseCache: a repeated list read is answered without a
+// request until a mutation clears the cache.
+func TestClientResponseCache(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetResponse("Teams", testutil.TeamsResponse())
+	mock.SetResponse("UpdateIssue", testutil.UpdateIssueResponse(true))
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(mock.URL())
+	ctx := context.Background()
+	count := func(op string) int {
+		n := 0
+		for _, c := range mock.Calls() {
+			if c.Operation == op {
+				n++
+			}
+		}
+		return n
+	}
+
+	for range 3 {
+		teams, err := client.GetTeams(ctx)
+		if err != nil || len(teams) != 1 {
+			t.Fatalf("GetTeams = %v, %v", teams, err)
+		}
+	}
+	if n := count("Teams"); n != 1 {
+		t.Errorf("three GetTeams sent %d requests, want 1", n)
+	}
+
+	if err := client.UpdateIssue(ctx, "issue-1", map[string]any{"title": "x"}); err != nil {
+		t.Fatalf("UpdateIssue: %v", err)
+	}
+	if _, err := client.GetTeams(ctx); err != nil {
+		t.Fatalf("GetTeams after mutation: %v", err)
+	}
+	if n := count("Teams"); n != 2 {
+		t.Errorf("GetTeams after a mutation sent %d requests in all, want 2", n)
+	}
+}
+
+// TestClientResponseCacheRevalidates: an expired response with an ETag is
+// asked for with If-None-Match, and a 304 answers from the cache.
+func TestClientResponseCacheRevalidates(t *testing.T) {
+	t.Parallel()
+	var requests, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"teams-1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"teams-1"`)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": testutil.TeamsResponse()})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(server.URL)
+	clk := newFakeClock()
+	client.responses = newResponseCache(responseCacheTTL, responseCacheMaxEntries, clk.now)
+	ctx := context.Background()
+
+	if _, err := client.GetTeams(ctx); err != nil {
+		t.Fatalf("GetTeams: %v", err)
+	}
+	clk.advance(responseCacheTTL)
+	teams, err := client.GetTeams(ctx)
+	if err != nil || len(teams) != 1 {
+		t.Fatalf("revalidated GetTeams = %v, %v", teams, err)
+	}
+	if requests.Load() != 2 || notModified.Load() != 1 {
+		t.Errorf("requests = %d (%d not modified), want 2 (1)", requests.Load(), notModified.Load())
+	}
+	if _, err := client.GetTeams(ctx); err != nil || requests.Load() != 2 {
+		t.Errorf("GetTeams after 304 sent a request (%d in all), err %v", requests.Load(), err)
+	}
+}
@@ -0,0 +1,317 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Schema drift detection.
+//
+// Every field linearfs selects is a bet that Linear still serves it. Linear
+// deprecates before it removes, so introspecting the live schema and walking
+// each registered operation's selections against it turns a future runtime
+// failure ("Cannot query field ... on type Issue") into a warning while there
+// is still time to move off the field. Used by `linearfs schema-check` and the
+// optional startup probe (api.schema_check).
+
+// schemaOperations registers every query and mutation the client sends, by Go
+// name, for the drift check. TestSchemaOperationsRegistered fails when a new
+// query/mutation constant is not listed here. GetIssueDetailsBatch builds its
+// query at runtime from IssueDetailsSelection, which queryIssueDetails covers.
+var schemaOperations = map[string]string{
+	"mutationArchiveIssue":              mutationArchiveIssue,
+	"mutationArchiveProject":            mutationArchiveProject,
+	"mutationCreateAttachment":          mutationCreateAttachment,
+	"mutationCreateComment":             mutationCreateComment,
+	"mutationCreateDocument":            mutationCreateDocument,
+	"mutationCreateEntityExternalLink":  mutationCreateEntityExternalLink,
+	"mutationCreateInitiativeUpdate":    mutationCreateInitiativeUpdate,
+	"mutationCreateIssue":               mutationCreateIssue,
+	"mutationCreateIssueRelation":       mutationCreateIssueRelation,
+	"mutationCreateLabel":               mutationCreateLabel,
+	"mutationCreateProject":             mutationCreateProject,
+	"mutationCreateProjectMilestone":    mutationCreateProjectMilestone,
+	"mutationCreateProjectUpdate":       mutationCreateProjectUpdate,
+	"mutationCreateWebhook":             mutationCreateWebhook,
+	"mutationDeleteAttachment":          mutationDeleteAttachment,
+	"mutationDeleteComment":             mutationDeleteComment,
+	"mutationDeleteDocument":            mutationDeleteDocument,
+	"mutationDeleteEntityExternalLink":  mutationDeleteEntityExternalLink,
+	"mutationDeleteIssueRelation":       mutationDeleteIssueRelation,
+	"mutationDeleteLabel":               mutationDeleteLabel,
+	"mutationDeleteProjectMilestone":    mutationDeleteProjectMilestone,
+	"mutationDeleteWebhook":             mutationDeleteWebhook,
+	"mutationFileUpload":                mutationFileUpload,
+	"mutationInitiativeToProjectCreate": mutationInitiativeToProjectCreate,
+	"mutationInitiativeToProjectDelete": mutationInitiativeToProjectDelete,
+	"mutationLinkURL":                   mutationLinkURL,
+	"mutationUnarchiveIssue":            mutationUnarchiveIssue,
+	"mutationUpdateComment":             mutationUpdateComment,
+	"mutationUpdateDocument":            mutationUpdateDocument,
+	"mutationUpdateInitiative":          mutationUpdateInitiative,
+	"mutationUpdateIssue":               mutationUpdateIssue,
+	"mutationUpdateLabel":               mutationUpdateLabel,
+	"mutationUpdateProject":             mutationUpdateProject,
+	"mutationUpdateProjectMilestone":    mutationUpdateProjectMilestone,
+	"mutationUpdateWebhook":             mutationUpdateWebhook,
+	"queryAllDocuments":                 queryAllDocuments,
+	"queryCustomViewIssueIDs":           queryCustomViewIssueIDs,
+	"queryCustomViews":                  queryCustomViews,
+	"queryEmojis":                       queryEmojis,
+	"queryInitiative":                   queryInitiative,
+	"queryInitiativeDocuments":          queryInitiativeDocuments,
+	"queryInitiativeExternalLinks":      queryInitiativeExternalLinks,
+	"queryInitiativeProjectsPage":       queryInitiativeProjectsPage,
+	"queryInitiativeUpdates":            queryInitiativeUpdates,
+	"queryInitiativesProbe":             queryInitiativesProbe,
+	"queryIssue":                        queryIssue,
+	"queryIssueAttachments":             queryIssueAttachments,
+	"queryIssueDetails":                 queryIssueDetails,
+	"queryIssueHistory":                 queryIssueHistory,
+	"queryOrganization":                 queryOrganization,
+	"queryOrganizationAuth":             queryOrganizationAuth,
+	"queryProject":                      queryProject,
+	"queryProjectDocuments":             queryProjectDocuments,
+	"queryProjectExternalLinks":         queryProjectExternalLinks,
+	"queryProjectLabelsPage":            queryProjectLabelsPage,
+	"queryProjectUpdates":               queryProjectUpdates,
+	"queryTeamCyclesPage":               queryTeamCyclesPage,
+	"queryTeamArchivedIssues":           queryTeamArchivedIssues,
+	"queryTeamDocuments":                queryTeamDocuments,
+	"queryTeamIssueIDs":                 queryTeamIssueIDs,
+	"queryTeamIssuesByUpdatedAt":        queryTeamIssuesByUpdatedAt,
+	"queryTeamLabelsPage":               queryTeamLabelsPage,
+	"queryTeamMembersPage":              queryTeamMembersPage,
+	"queryTeamMetadata":                 queryTeamMetadata,
+	"queryTeamProjects":                 queryTeamProjects,
+	"queryTeamProjectsByUpdatedAt":      queryTeamProjectsByUpdatedAt,
+	"queryTeamTemplates":                queryTeamTemplates,
+	"queryTeams":                        queryTeams,
+	"queryViewer":                       queryViewer,
+	"queryWebhooks":                     queryWebhooks,
+	"queryWorkspace":                    queryWorkspace,
+	"queryWorkspaceInitiativeIDs":       queryWorkspaceInitiativeIDs,
+	"queryWorkspaceInitiativesPage":     queryWorkspaceInitiativesPage,
+	"queryWorkspaceLabelsPage":          queryWorkspaceLabelsPage,
+	"queryWorkspaceProjectIDs":          queryWorkspaceProjectIDs,
+	"queryWorkspaceUsersPage":           queryWorkspaceUsersPage,
+}
+
+// querySchema introspects the object and interface types with their fields,
+// deprecated ones included. Four ofType levels unwrap any [T!]! a field can
+// declare.
+const querySchema = `
+query Schema {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    types {
+      name
+      fields(includeDeprecated: true) {
+        name
+        isDeprecated
+        deprecationReason
+        type { name ofType { name ofType { name ofType { name } } } }
+      }
+    }
+  }
+}
+`
+
+// SchemaDrift is one field linearfs selects that the live schema no longer
+// serves as-is.
+type SchemaDrift struct {
+	Field      string   // Type.field, e.g. "Issue.startedAt"
+	Removed    bool     // absent from the schema (removed or renamed); else deprecated
+	Reason     string   // Linear's deprecationReason, when deprecated
+	Operations []string // GraphQL operations that select it, sorted
+}
+
+func (d SchemaDrift) String() string {
+	ops := strings.Join(d.Operations, ", ")
+	if d.Removed {
+		return fmt.Sprintf("%s: no longer in the schema (selected by %s)", d.Field, ops)
+	}
+	if d.Reason == "" {
+		return fmt.Sprintf("%s: deprecated (selected by %s)", d.Field, ops)
+	}
+	return fmt.Sprintf("%s: deprecated: %s (selected by %s)", d.Field, d.Reason, ops)
+}
+
+// introspectedType is the introspected type reference: a named type, or a
+// NON_NULL/LIST wrapper around one.
+type introspectedType struct {
+	Name   string            `json:"name"`
+	OfType *introspectedType `json:"ofType"`
+}
+
+// named unwraps NON_NULL/LIST wrappers to the underlying named type.
+func (t *introspectedType) named() string {
+	for t != nil {
+		if t.Name != "" {
+			return t.Name
+		}
+		t = t.OfType
+	}
+	return ""
+}
+
+type schemaField struct {
+	Name              string           `json:"name"`
+	IsDeprecated      bool             `json:"isDeprecated"`
+	DeprecationReason string           `json:"deprecationReason"`
+	Type              introspectedType `json:"type"`
+}
+
+type schemaResponse struct {
+	Schema struct {
+		QueryType    *struct{ Name string } `json:"queryType"`
+		MutationType *struct{ Name string } `json:"mutationType"`
+		Types        []struct {
+			Name   string        `json:"name"`
+			Fields []schemaField `json:"fields"`
+		} `json:"types"`
+	} `json:"__schema"`
+}
+
+// schemaIndex is the introspected schema as type -> field -> field.
+type schemaIndex struct {
+	query, mutation string
+	types           map[string]map[string]schemaField
+}
+
+func newSchemaIndex(r schemaResponse) schemaIndex {
+	idx := schemaIndex{types: make(map[string]map[string]schemaField)}
+	if r.Schema.QueryType != nil {
+		idx.query = r.Schema.QueryType.Name
+	}
+	if r.Schema.MutationType != nil {
+		idx.mutation = r.Schema.MutationType.Name
+	}
+	for _, t := range r.Schema.Types {
+		if t.Fields == nil {
+			continue // scalars, enums, inputs, unions: nothing to select on
+		}
+		fields := make(map[string]schemaField, len(t.Fields))
+		for _, f := range t.Fields {
+			fields[f.Name] = f
+		}
+		idx.types[t.Name] = fields
+	}
+	return idx
+}
+
+// CheckSchemaDrift introspects Linear's schema and reports every field a
+// registered operation selects that is deprecated or gone, sorted by field.
+// A nil result means every selection resolves to a live, undeprecated field.
+func (c *Client) CheckSchemaDrift(ctx context.Context) ([]SchemaDrift, error) {
+	var resp schemaResponse
+	if err := c.query(ctx, querySchema, nil, &resp); err != nil {
+		return nil, fmt.Errorf("introspect schema: %w", err)
+	}
+	if resp.Schema.QueryType == nil || len(resp.Schema.Types) == 0 {
+		return nil, fmt.Errorf("introspect schema: empty schema (introspection disabled?)")
+	}
+	return checkSchemaDrift(newSchemaIndex(resp), schemaOperations)
+}
+
+// checkSchemaDrift walks each document's selections against idx. A document
+// that does not parse is an error: the check would otherwise pass it
+// unexamined.
+func checkSchemaDrift(idx schemaIndex, docs map[string]string) ([]SchemaDrift, error) {
+	found := make(map[string]*SchemaDrift)
+	names := make([]string, 0, len(docs))
+	for name := range docs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		doc, err := parseGraphQLDocument(docs[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, op := range doc.operations {
+			root := idx.query
+			if op.kind == "mutation" {
+				root = idx.mutation
+			}
+			w := driftWalk{idx: idx, doc: doc, op: op.name, found: found, visiting: make(map[string]bool)}
+			w.selections(root, op.selections)
+		}
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	out := make([]SchemaDrift, 0, len(found))
+	for _, d := range found {
+		sort.Strings(d.Operations)
+		out = append(out, *d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out, nil
+}
+
+// driftWalk walks one operation's selections, recording drift into found.
+type driftWalk struct {
+	idx      schemaIndex
+	doc      graphQLDocument
+	op       string
+	found    map[string]*SchemaDrift
+	visiting map[string]bool // fragment spreads on the current path (cycle guard)
+}
+
+func (w *driftWalk) selections(typeName string, sels []graphQLSelection) {
+	fields, known := w.idx.types[typeName]
+	for _, s := range sels {
+		switch {
+		case s.fragment != "":
+			frag, ok := w.doc.fragments[s.fragment]
+			if !ok || w.visiting[s.fragment] {
+				continue
+			}
+			w.visiting[s.fragment] = true
+			w.selections(frag.on, frag.selections)
+			delete(w.visiting, s.fragment)
+		case s.field == "":
+			// Inline fragment: "... on Type { }", or untyped "... { }".
+			on := s.on
+			if on == "" {
+				on = typeName
+			}
+			w.selections(on, s.selections)
+		case strings.HasPrefix(s.field, "__"):
+			// Meta fields (__typename) exist on every type.
+		case !known:
+			// A type the schema does not describe with fields (a union); its
+			// members are reached through inline fragments.
+		default:
+			f, ok := fields[s.field]
+			switch {
+			case !ok:
+				w.record(typeName+"."+s.field, true, "")
+				continue
+			case f.IsDeprecated:
+				w.record(typeName+"."+s.field, false, f.DeprecationReason)
+			}
+			if len(s.selections) > 0 {
+				w.selections(f.Type.named(), s.selections)
+			}
+		}
+	}
+}
+
+func (w *driftWalk) record(field string, removed bool, reason string) {
+	d, ok := w.found[field]
+	if !ok {
+		d = &SchemaDrift{Field: field, Removed: removed, Reason: reason}
+		w.found[field] = d
+	}
+	for _, op := range d.Operations {
+		if op == w.op {
+			return
+		}
+	}
+	d.Operations = append(d.Operations, w.op)
+}
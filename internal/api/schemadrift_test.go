@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/testutil"
+)
+
+// TestSchemaOperationsRegistered: a query or mutation the drift check does
+// not know about is a dependency it silently cannot warn about.
+func TestSchemaOperationsRegistered(t *testing.T) {
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || (gd.Tok != token.CONST && gd.Tok != token.VAR) {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					n := name.Name
+					if n == "querySchema" || !(strings.HasPrefix(n, "query") || strings.HasPrefix(n, "mutation")) {
+						continue
+					}
+					if _, ok := schemaOperations[n]; !ok {
+						t.Errorf("%s (%s) is not registered in schemaOperations", n, path)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestSchemaOperationsParse: every registered document parses, names an
+// operation, and spreads only fragments it defines.
+func TestSchemaOperationsParse(t *testing.T) {
+	for name, src := range schemaOperations {
+		doc, err := parseGraphQLDocument(src)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if len(doc.operations) != 1 || doc.operations[0].name == "" {
+			t.Errorf("%s: want one named operation, got %+v", name, doc.operations)
+			continue
+		}
+		var spreads func([]graphQLSelection)
+		spreads = func(sels []graphQLSelection) {
+			for _, s := range sels {
+				if s.fragment != "" {
+					if _, ok := doc.fragments[s.fragment]; !ok {
+						t.Errorf("%s: spreads undefined fragment %s", name, s.fragment)
+					}
+				}
+				spreads(s.selections)
+			}
+		}
+		spreads(doc.operations[0].selections)
+		for _, f := range doc.fragments {
+			spreads(f.selections)
+		}
+	}
+}
+
+// field builds an introspected field of the named type.
+func field(name, typ string) schemaField {
+	return schemaField{Name: name, Type: introspectedType{OfType: &introspectedType{Name: typ}}}
+}
+
+func TestCheckSchemaDrift(t *testing.T) {
+	deprecated := field("title", "String")
+	deprecated.IsDeprecated = true
+	deprecated.DeprecationReason = "Use name."
+	idx := schemaIndex{query: "Query", mutation: "Mutation", types: map[string]map[string]schemaField{
+		"Query":        {"issue": field("issue", "Issue")},
+		"Mutation":     {"issueUpdate": field("issueUpdate", "IssuePayload")},
+		"Issue":        {"id": field("id", "ID"), "title": deprecated, "labels": field("labels", "LabelConn")},
+		"LabelConn":    {"nodes": field("nodes", "Label")},
+		"Label":        {"id": field("id", "ID")},
+		"IssuePayload": {"success": field("success", "Boolean"), "issue": field("issue", "Issue")},
+	}}
+	docs := map[string]string{
+		"q": `query Issue($id: String!) {
+  it: issue(id: $id) { ...F __typename labels(first: 5) @include(if: true) { nodes { id color } } }
+}
+fragment F on Issue { id title gone }`,
+		"m": `mutation Update($input: IssueUpdateInput!) {
+  issueUpdate(id: "x", input: $input) { success issue { ... on Issue { title } } }
+}`,
+	}
+	got, err := checkSchemaDrift(idx, docs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"Issue.gone: no longer in the schema (selected by Issue)",
+		"Issue.title: deprecated: Use name. (selected by Issue, Update)",
+		"Label.color: no longer in the schema (selected by Issue)",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("drift = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("drift[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if _, err := checkSchemaDrift(idx, map[string]string{"bad": "query X { issue { id }"}); err == nil {
+		t.Error("an unparseable document passed the check")
+	}
+}
+
+// TestCheckSchemaDriftIntrospects: the client's check fetches the schema and
+// walks the registered operations against it — a schema that serves nothing
+// under Query flags every root field the client queries.
+func TestCheckSchemaDriftIntrospects(t *testing.T) {
+	t.Parallel()
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetResponse("Schema", map[string]any{"__schema": map[string]any{
+		"queryType":    map[string]any{"name": "Query"},
+		"mutationType": map[string]any{"name": "Mutation"},
+		"types": []map[string]any{
+			{"name": "Query", "fields": []map[string]any{}},
+			{"name": "Mutation", "fields": []map[string]any{}},
+		},
+	}})
+
+	c := NewClient("test")
+	c.SetAPIURL(mock.URL())
+	got, err := c.CheckSchemaDrift(context.Background())
+	if err != nil {
+		t.Fatalf("CheckSchemaDrift: %v", err)
+	}
+	fields := map[string]bool{}
+	for _, d := range got {
+		if !d.Removed {
+			t.Errorf("%s reported deprecated against a schema without it", d.Field)
+		}
+		fields[d.Field] = true
+	}
+	for _, f := range []string{"Query.issue", "Query.teams", "Mutation.issueUpdate"} {
+		if !fields[f] {
+			t.Errorf("drift missing %s: %v", f, got)
+		}
+	}
+}
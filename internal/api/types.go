@@ -1,17 +1,21 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
 
 type Team struct {
-	ID        string    `json:"id"`
-	Key       string    `json:"key"`
-	Name      string    `json:"name"`
-	Icon      string    `json:"icon"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID          string    `json:"id"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	Icon        string    `json:"icon"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	Private     bool      `json:"private"` // members-only; issues outside the token's membership come back FORBIDDEN
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
 type Issue struct {
@@ -33,6 +37,7 @@ type Issue struct {
 	CompletedAt      *time.Time        `json:"completedAt"`
 	CanceledAt       *time.Time        `json:"canceledAt"`
 	ArchivedAt       *time.Time        `json:"archivedAt"`
+	SLABreachesAt    *time.Time        `json:"slaBreachesAt"` // nil when no SLA applies
 	URL              string            `json:"url"`
 	Team             *Team             `json:"team"`
 	Project          *Project          `json:"project"`
@@ -42,6 +47,12 @@ type Issue struct {
 	Cycle            *IssueCycle       `json:"cycle"`
 	Relations        IssueRelations    `json:"relations"`
 	InverseRelations IssueRelations    `json:"inverseRelations"`
+
+	// Restricted is local, never selected: set when Linear refused this
+	// token the issue's full fields or details (api.IsForbidden), so its
+	// body cannot be trusted to be the real description. Cleared by the
+	// next fetch that succeeds.
+	Restricted bool `json:"restricted,omitempty"`
 }
 
 // IssueRelations is a collection of issue relations
@@ -87,6 +98,106 @@ type ChildIssue struct {
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
+// Organization is the Linear workspace behind the API key. SAMLEnabled and
+// SCIMEnabled are nil when the key cannot read them (non-admin).
+type Organization struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	URLKey              string    `json:"urlKey"`
+	AllowedAuthServices []string  `json:"allowedAuthServices"`
+	UserCount           int       `json:"userCount"`
+	SAMLEnabled         *bool     `json:"samlEnabled,omitempty"`
+	SCIMEnabled         *bool     `json:"scimEnabled,omitempty"`
+	CreatedAt           time.Time `json:"createdAt"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+// organizationAuth decodes queryOrganizationAuth's admin-only flags.
+type organizationAuth struct {
+	SAMLEnabled bool `json:"samlEnabled"`
+	SCIMEnabled bool `json:"scimEnabled"`
+}
+
+// CustomView is a saved view ("custom view") built in Linear's UI. Its
+// filter lives server-side; the mount lists its issues via
+// Client.GetCustomViewIssueIDs rather than re-evaluating the filter. Team is
+// nil for a workspace-wide view.
+type CustomView struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	SlugID      string    `json:"slugId"`
+	Shared      bool      `json:"shared"`
+	Team        *Team     `json:"team,omitempty"`
+	Owner       *User     `json:"owner,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// TemplateTypeIssue is Template.Type for an issue template.
+const TemplateTypeIssue = "issue"
+
+// Template is a team template. TemplateData is Linear's JSON scalar holding
+// the prefilled entity (for an issue: title, descriptionData or description,
+// priority, estimate, stateId, assigneeId, labelIds, ...), kept raw because
+// its shape is undocumented and varies by template type; see
+// Template.IssueData.
+type Template struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description,omitempty"`
+	Type         string          `json:"type"`
+	TemplateData json.RawMessage `json:"templateData,omitempty"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// TemplateIssueData is the part of an issue template's TemplateData the
+// mount renders. Priority and Estimate are pointers so an unset field stays
+// distinguishable from 0 (No priority / a zero-point estimate).
+type TemplateIssueData struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Priority    *int     `json:"priority"`
+	Estimate    *float64 `json:"estimate"`
+	StateID     string   `json:"stateId"`
+	AssigneeID  string   `json:"assigneeId"`
+	LabelIDs    []string `json:"labelIds"`
+	DueDate     string   `json:"dueDate"`
+}
+
+// IssueData decodes TemplateData as an issue template. Linear has served
+// the JSON scalar both inline and as a JSON-encoded string; both decode.
+// Empty TemplateData decodes to the zero value.
+func (t Template) IssueData() (TemplateIssueData, error) {
+	var d TemplateIssueData
+	raw := t.TemplateData
+	if len(raw) == 0 || string(raw) == "null" {
+		return d, nil
+	}
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return d, fmt.Errorf("template %s: %w", t.ID, err)
+		}
+		raw = json.RawMessage(s)
+	}
+	if err := json.Unmarshal(raw, &d); err != nil {
+		return d, fmt.Errorf("template %s: %w", t.ID, err)
+	}
+	return d, nil
+}
+
+// Emoji is a custom workspace emoji, referenced in bodies and reactions as
+// :name:. URL is its uploaded image.
+type Emoji struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 type State struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -122,6 +233,8 @@ type Project struct {
 	Name        string              `json:"name"`
 	Slug        string              `json:"slugId"`
 	Description string              `json:"description"`
+	Icon        string              `json:"icon"`
+	Color       string              `json:"color"`
 	Content     string              `json:"content"`
 	URL         string              `json:"url"`
 	State       string              `json:"state"`
@@ -243,13 +356,14 @@ type CycleIssue struct {
 }
 
 type Cycle struct {
-	ID                         string    `json:"id"`
-	Number                     int       `json:"number"`
-	Name                       string    `json:"name"`
-	StartsAt                   time.Time `json:"startsAt"`
-	EndsAt                     time.Time `json:"endsAt"`
-	CompletedIssueCountHistory []int     `json:"completedIssueCountHistory"`
-	IssueCountHistory          []int     `json:"issueCountHistory"`
+	ID                         string     `json:"id"`
+	Number                     int        `json:"number"`
+	Name                       string     `json:"name"`
+	StartsAt                   time.Time  `json:"startsAt"`
+	EndsAt                     time.Time  `json:"endsAt"`
+	CompletedAt                *time.Time `json:"completedAt"` // nil until the cycle completes
+	CompletedIssueCountHistory []int      `json:"completedIssueCountHistory"`
+	IssueCountHistory          []int      `json:"issueCountHistory"`
 }
 
 type Comment struct {
@@ -259,6 +373,14 @@ type Comment struct {
 	UpdatedAt time.Time  `json:"updatedAt"`
 	EditedAt  *time.Time `json:"editedAt"`
 	User      *User      `json:"user"`
+	// URL is the comment's permalink (the issue URL with a #comment-{shortId}
+	// fragment).
+	URL string `json:"url,omitempty"`
+
+	// DeletedAt is set on a local tombstone: a comment deleted in Linear (or
+	// via rm) whose cached row is kept so comments/ can still show it. Never
+	// on the wire — Linear drops deleted comments from its responses.
+	DeletedAt *time.Time `json:"-"`
 }
 
 // ProjectUpdate represents a status update on a project
@@ -289,20 +411,30 @@ type Document struct {
 }
 
 type Initiative struct {
-	ID          string             `json:"id"`
-	Name        string             `json:"name"`
-	Slug        string             `json:"slugId"`
-	Description string             `json:"description"`
-	Content     string             `json:"content"`
-	Status      string             `json:"status"`
-	Color       string             `json:"color"`
-	Icon        string             `json:"icon"`
-	TargetDate  *string            `json:"targetDate"`
-	URL         string             `json:"url"`
-	CreatedAt   time.Time          `json:"createdAt"`
-	UpdatedAt   time.Time          `json:"updatedAt"`
-	Owner       *User              `json:"owner"`
-	Projects    InitiativeProjects `json:"projects"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slugId"`
+	Description string    `json:"description"`
+	Content     string    `json:"content"`
+	Status      string    `json:"status"`
+	Color       string    `json:"color"`
+	Icon        string    `json:"icon"`
+	TargetDate  *string   `json:"targetDate"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Owner       *User     `json:"owner"`
+	// Parent is the enclosing initiative when this one is a sub-initiative;
+	// nil at the top of the hierarchy.
+	Parent   *ParentInitiative  `json:"parentInitiative"`
+	Projects InitiativeProjects `json:"projects"`
+}
+
+// ParentInitiative is a minimal initiative reference for parent links.
+type ParentInitiative struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slugId"`
 }
 
 type InitiativeProjects struct {
@@ -449,6 +581,55 @@ type IssueHistoryEntry struct {
 	UpdatedDescription bool       `json:"updatedDescription"`
 }
 
+// Backlink is a cached issue description, comment, or document whose text
+// mentions another issue. Derived locally from the SQLite cache (backlinks.md),
+// not fetched from Linear.
+type Backlink struct {
+	Kind       string // "issue", "comment", or "document"
+	ID         string
+	Identifier string // the mentioning issue; "" for a document not on an issue
+	Title      string
+	Author     string
+	URL        string
+	UpdatedAt  time.Time
+}
+
+// DescriptionVersion is an issue description as sync once observed it.
+// Kept locally (issues/{ID}/history/), since Linear exposes no description
+// history; ObservedAt is the issue's updatedAt at the time.
+type DescriptionVersion struct {
+	ObservedAt  time.Time
+	Description string
+}
+
+// ActivityEntry is one issue change sync observed, for the workspace
+// changelog (/activity/{date}.md). Kind is "created", "completed" or "state";
+// FromState is set for a state change, ToState for all three.
+type ActivityEntry struct {
+	At         time.Time
+	Kind       string
+	IssueID    string
+	Identifier string
+	TeamKey    string
+	Title      string
+	FromState  string
+	ToState    string
+}
+
+// ScopeChange is one change to a cycle's scope sync observed, for the
+// cycle's scope-changes.md. Kind is "added", "removed" or "resized"; the
+// estimates are nil when unset, and FromEstimate is nil for an addition,
+// ToEstimate for a removal.
+type ScopeChange struct {
+	At           time.Time
+	Kind         string
+	IssueID      string
+	Identifier   string
+	Title        string
+	FromEstimate *float64
+	ToEstimate   *float64
+}
+
 // ParentRef is a minimal issue reference for history entries
 type ParentRef struct {
 	ID         string `json:"id"`
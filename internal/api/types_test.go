@@ -1,6 +1,10 @@
 package api
 
-import "testing"
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
 
 func TestPriorityName(t *testing.T) {
 	t.Parallel()
@@ -27,3 +31,34 @@ func TestPriorityName(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateIssueData(t *testing.T) {
+	t.Parallel()
+	inline := `{"title":"Bug: ","description":"Steps","priority":2,"labelIds":["l1"]}`
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"inline", inline},
+		{"string-encoded", `"` + strings.ReplaceAll(inline, `"`, `\"`) + `"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := Template{ID: "t1", TemplateData: json.RawMessage(tt.data)}.IssueData()
+			if err != nil {
+				t.Fatalf("IssueData: %v", err)
+			}
+			if d.Title != "Bug: " || d.Description != "Steps" || d.Priority == nil || *d.Priority != 2 || len(d.LabelIDs) != 1 {
+				t.Errorf("IssueData = %+v", d)
+			}
+			if d.Estimate != nil {
+				t.Errorf("Estimate = %v, want unset", *d.Estimate)
+			}
+		})
+	}
+
+	d, err := Template{}.IssueData()
+	if err != nil || d.Title != "" {
+		t.Errorf("empty TemplateData = %+v, %v", d, err)
+	}
+}
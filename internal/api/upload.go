@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// File uploads.
+//
+// Linear stores uploaded files in its own object storage and serves them from
+// the uploads CDN. An upload is two steps: the fileUpload mutation reserves an
+// object and returns a short-lived signed PUT URL (plus the headers the
+// signature covers) and the asset URL the file will be served from; the bytes
+// then go straight to the signed URL. The PUT carries no Linear credential —
+// the signature is the authorization — so it runs on its own http.Client
+// rather than through query or CDNClient, both of which attach the API key.
+
+// MaxUploadBytes caps a single upload. It matches the CDN read cap
+// (maxCDNBytes): a file larger than that could be uploaded but never read back
+// through attachments/.
+const MaxUploadBytes = maxCDNBytes
+
+const mutationFileUpload = `
+mutation FileUpload($contentType: String!, $filename: String!, $size: Int!) {
+  fileUpload(contentType: $contentType, filename: $filename, size: $size) {
+    success
+    uploadFile {
+      uploadUrl
+      assetUrl
+      headers { key value }
+    }
+  }
+}
+`
+
+// uploadTarget is the fileUpload payload: where to PUT the bytes and where
+// Linear will serve them from.
+type uploadTarget struct {
+	UploadURL string `json:"uploadUrl"`
+	AssetURL  string `json:"assetUrl"`
+	Headers   []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"headers"`
+}
+
+// errUploadRedirect refuses every redirect from the signed upload URL, for the
+// same reason errCDNRedirect does on reads: the storage host answers the PUT
+// directly, so a 3xx is anomalous and following it would replay the file's
+// bytes to a host Linear did not name.
+func errUploadRedirect(req *http.Request, _ []*http.Request) error {
+	return fmt.Errorf("upload: refusing redirect to %s", req.URL)
+}
+
+// UploadFile uploads content to Linear's file storage and returns the asset
+// URL to reference it by (an uploads.linear.app URL, the same kind the
+// attachments/ directory lists). The file is not attached to anything yet:
+// the caller links the URL from a description or comment.
+func (c *Client) UploadFile(ctx context.Context, filename, contentType string, content []byte) (string, error) {
+	if len(content) > MaxUploadBytes {
+		return "", fmt.Errorf("upload %s: %d bytes exceeds the %d-byte cap", filename, len(content), MaxUploadBytes)
+	}
+	vars := map[string]any{
+		"contentType": contentType,
+		"filename":    filename,
+		"size":        len(content),
+	}
+	target, err := execMutation[uploadTarget](ctx, c, mutationFileUpload, vars, "fileUpload", "uploadFile")
+	if err != nil {
+		return "", err
+	}
+	// The signed URL is whatever the API returned; insist on TLS so the bytes
+	// never cross the network in the clear.
+	u, err := url.Parse(target.UploadURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return "", fmt.Errorf("upload %s: refusing non-https upload URL %q", filename, target.UploadURL)
+	}
+	if target.AssetURL == "" {
+		return "", fmt.Errorf("upload %s: fileUpload returned no asset URL", filename)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target.UploadURL, bytes.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000")
+	for _, h := range target.Headers {
+		req.Header.Set(h.Key, h.Value)
+	}
+	resp, err := c.uploadHTTP.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("upload %s: %w", filename, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("upload %s: HTTP %d: %s", filename, resp.StatusCode, resp.Status)
+	}
+	return target.AssetURL, nil
+}
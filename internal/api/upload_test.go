@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestUploadFile: fileUpload reserves the object, the bytes go to the signed
+// URL with the returned headers and NO Linear credential, and the asset URL
+// comes back.
+func TestUploadFile(t *testing.T) {
+	t.Parallel()
+	var put struct {
+		body                []byte
+		auth, ctype, signed string
+	}
+	storage := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("storage method = %s, want PUT", r.Method)
+		}
+		put.body, _ = io.ReadAll(r.Body)
+		put.auth = r.Header.Get("Authorization")
+		put.ctype = r.Header.Get("Content-Type")
+		put.signed = r.Header.Get("x-goog-content-length-range")
+	}))
+	defer storage.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		if req.Variables["filename"] != "shot.png" || req.Variables["contentType"] != "image/png" || req.Variables["size"] != float64(5) {
+			t.Errorf("fileUpload variables = %v", req.Variables)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": {"fileUpload": {"success": true, "uploadFile": {
+			"uploadUrl": %q, "assetUrl": "https://uploads.linear.app/org/abc/shot.png",
+			"headers": [{"key": "x-goog-content-length-range", "value": "5,5"}]}}}}`, storage.URL+"/signed")
+	}))
+	defer api.Close()
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(api.URL)
+	client.uploadHTTP = storage.Client()
+
+	asset, err := client.UploadFile(context.Background(), "shot.png", "image/png", []byte("\x89PNG!"))
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if asset != "https://uploads.linear.app/org/abc/shot.png" {
+		t.Errorf("asset URL = %q", asset)
+	}
+	if string(put.body) != "\x89PNG!" || put.ctype != "image/png" || put.signed != "5,5" {
+		t.Errorf("PUT body %q, content-type %q, signed header %q", put.body, put.ctype, put.signed)
+	}
+	if put.auth != "" {
+		t.Errorf("PUT sent Authorization %q; the signed URL must not see the API key", put.auth)
+	}
+}
+
+// TestUploadFileRefusesPlainHTTP: a non-https upload URL is never PUT to.
+func TestUploadFileRefusesPlainHTTP(t *testing.T) {
+	t.Parallel()
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"fileUpload": {"success": true, "uploadFile": {
+			"uploadUrl": "http://storage.example/signed", "assetUrl": "https://uploads.linear.app/x", "headers": []}}}}`)
+	}))
+	defer api.Close()
+
+	client := NewClient("test-api-key")
+	client.SetAPIURL(api.URL)
+	client.uploadHTTP = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		t.Errorf("PUT sent to %s", r.URL)
+		return nil, fmt.Errorf("unexpected request")
+	})}
+
+	if _, err := client.UploadFile(context.Background(), "a.txt", "text/plain", []byte("x")); err == nil || !strings.Contains(err.Error(), "non-https") {
+		t.Errorf("UploadFile error = %v, want the non-https refusal", err)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
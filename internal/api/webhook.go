@@ -0,0 +1,90 @@
+package api
+
+import "context"
+
+// Webhook registration for real-time sync (internal/webhook). The mount
+// registers one webhook at startup pointing at its own listener and deletes
+// it on unmount, unless `linearfs webhook setup` registered one that outlives
+// mounts; creating one needs an admin API key, so a failure is a warning and
+// the mount falls back to the polling sync alone.
+
+// Webhook is a registered Linear webhook.
+type Webhook struct {
+	ID      string `json:"id"`
+	URL     string `json:"url"`
+	Label   string `json:"label"`
+	Enabled bool   `json:"enabled"`
+}
+
+// queryWebhooks drains the workspace's webhooks.
+const queryWebhooks = `
+query Webhooks($after: String) {
+  webhooks(first: 100, after: $after) {
+    pageInfo { hasNextPage endCursor }
+    nodes { id url label enabled }
+  }
+}
+`
+
+const mutationCreateWebhook = `
+mutation CreateWebhook($input: WebhookCreateInput!) {
+  webhookCreate(input: $input) {
+    success
+    webhook { id url label enabled }
+  }
+}
+`
+
+const mutationUpdateWebhook = `
+mutation UpdateWebhook($id: String!, $input: WebhookUpdateInput!) {
+  webhookUpdate(id: $id, input: $input) {
+    success
+    webhook { id url label enabled }
+  }
+}
+`
+
+const mutationDeleteWebhook = `
+mutation DeleteWebhook($id: String!) {
+  webhookDelete(id: $id) {
+    success
+  }
+}
+`
+
+// CreateWebhook registers a webhook posting resourceTypes (e.g. "Issue",
+// "Comment", "Project") for every public team to url, signed with secret.
+func (c *Client) CreateWebhook(ctx context.Context, url, label, secret string, resourceTypes []string) (*Webhook, error) {
+	input := map[string]any{
+		"url":            url,
+		"label":          label,
+		"secret":         secret,
+		"resourceTypes":  resourceTypes,
+		"allPublicTeams": true,
+	}
+	return execMutation[Webhook](ctx, c, mutationCreateWebhook, map[string]any{"input": input}, "webhookCreate", "webhook")
+}
+
+// ListWebhooks returns every webhook in the workspace, drained.
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	return fetchAll[Webhook](ctx, c, queryWebhooks, nil, "webhooks")
+}
+
+// UpdateWebhook points an existing webhook at url with a new secret and
+// resource types, and re-enables it (Linear disables a webhook whose
+// deliveries keep failing).
+func (c *Client) UpdateWebhook(ctx context.Context, id, url, label, secret string, resourceTypes []string) (*Webhook, error) {
+	input := map[string]any{
+		"url":           url,
+		"label":         label,
+		"secret":        secret,
+		"resourceTypes": resourceTypes,
+		"enabled":       true,
+	}
+	return execMutation[Webhook](ctx, c, mutationUpdateWebhook, map[string]any{"id": id, "input": input}, "webhookUpdate", "webhook")
+}
+
+// DeleteWebhook removes a webhook registered by CreateWebhook.
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	return execMutationOK(ctx, c, mutationDeleteWebhook, map[string]any{"id": id}, "webhookDelete")
+}
@@ -1,6 +1,6 @@
 // Package atrest centralizes the at-rest permission posture for every on-disk
 // artifact LinearFS writes (the SQLite cache, the embedded-file byte cache, the
-// telemetry/request JSONL logs). All of these hold a local mirror of the user's
+// telemetry/request JSONL logs, the OAuth token). All of these hold a local mirror of the user's
 // private Linear data, so they are owner-only: 0700 dirs, 0600 files.
 //
 // The helpers are best-effort by design. They are called at startup on every
@@ -49,6 +49,9 @@ const (
 	// ArtifactLogs is the telemetry/request JSONL logs: their dir and the
 	// log files plus rotated .1 sidecars (internal/telemetry).
 	ArtifactLogs Artifact = "logs"
+	// ArtifactOAuth is the OAuth token `linearfs login` saves, and its dir
+	// (internal/api).
+	ArtifactOAuth Artifact = "oauth"
 )
 
 // chmodFailures is the linearfs.atrest.chmod_failures counter (#352) — a
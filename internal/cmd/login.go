@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/fs"
+	"github.com/spf13/cobra"
+)
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Authorize linearfs with a Linear OAuth application",
+	Long: `Run Linear's OAuth authorization flow for the application configured under
+oauth: and save the token for the mount to use, for workspaces that block
+personal API keys.
+
+Prints a URL to open in a browser; after you approve access, Linear redirects
+to oauth.redirect_url, where login is listening, and the token is written
+owner-only to oauth.token_path. The mount refreshes it from then on; run login
+again only if access is revoked.`,
+	Args: cobra.NoArgs,
+	RunE: runLogin,
+}
+
+func init() {
+	rootCmd.AddCommand(loginCmd)
+	loginCmd.Flags().Duration("timeout", 5*time.Minute, "how long to wait for the browser redirect")
+}
+
+func runLogin(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.OAuth.ClientID == "" {
+		return fmt.Errorf("oauth.client_id not set - register an OAuth application in Linear and add it to the config file")
+	}
+
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+	out := cmd.OutOrStdout()
+	token, err := api.Login(ctx, fs.OAuthApp(cfg.OAuth), func(url string) {
+		fmt.Fprintf(out, "Open this URL to authorize linearfs:\n\n  %s\n\nWaiting for the redirect to %s ...\n", url, cfg.OAuth.RedirectURL)
+	})
+	if err != nil {
+		return err
+	}
+	if err := api.SaveOAuthToken(cfg.OAuth.TokenPath, token); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Authorized. Token saved to %s\n", cfg.OAuth.TokenPath)
+	if cfg.APIKey != "" {
+		fmt.Fprintln(out, "Note: an api_key is also set and takes precedence; remove it to mount with OAuth.")
+	}
+	return nil
+}
@@ -12,8 +12,8 @@ import (
 
 	"time"
 
-	"github.com/jra3/linear-fuse/internal/config"
 	"github.com/jra3/linear-fuse/internal/fs"
+	"github.com/jra3/linear-fuse/internal/marshal"
 	"github.com/jra3/linear-fuse/internal/telemetry"
 	"github.com/spf13/cobra"
 )
@@ -29,22 +29,31 @@ var mountCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(mountCmd)
 	mountCmd.Flags().BoolP("foreground", "f", false, "run in foreground (don't daemonize)")
+	mountCmd.Flags().Bool("read-only", false, "mount read-only: writes fail with EROFS, sync still runs (mount.read_only)")
+	mountCmd.Flags().String("root", "", "mount only this subtree, e.g. teams/ENG or teams/ENG/projects/NAME (mount.root)")
 }
 
 func runMount(cmd *cobra.Command, args []string) error {
-	// --config names an exact file (unreadable = error); without it the
-	// default XDG path applies (missing = defaults + env).
-	var cfg *config.Config
-	var err error
-	if configPath, _ := cmd.Flags().GetString("config"); configPath != "" {
-		cfg, err = config.LoadFrom(configPath)
-	} else {
-		cfg, err = config.Load()
-	}
+	cfg, err := loadConfig(cmd)
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
+	// Timestamp rendering is process-wide (every marshal call reads it), so
+	// it is installed here, once, before anything renders.
+	timeStyle, err := marshal.NewTimeStyle(cfg.Display.Timezone, cfg.Display.TimeFormat)
+	if err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	marshal.SetTimeStyle(timeStyle)
+
+	if readOnly, _ := cmd.Flags().GetBool("read-only"); readOnly {
+		cfg.Mount.ReadOnly = true
+	}
+	if root, _ := cmd.Flags().GetString("root"); root != "" {
+		cfg.Mount.Root = root
+	}
+
 	mountpoint := cfg.Mount.DefaultPath
 	if len(args) > 0 {
 		mountpoint = args[0]
@@ -59,6 +68,12 @@ func runMount(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("mountpoint required: linearfs mount /path/to/mount")
 	}
 
+	dbPath := cfg.Cache.DBPath
+	if strings.HasPrefix(dbPath, "~/") {
+		home, _ := os.UserHomeDir()
+		dbPath = filepath.Join(home, dbPath[2:])
+	}
+
 	// Preflight the mountpoint before touching it. Heals the wedged-mount
 	// incident (a dead FUSE mount — "Transport endpoint is not connected" —
 	// left by a crash made mkdir fail and sent systemd into a restart loop);
@@ -77,14 +92,18 @@ func runMount(cmd *cobra.Command, args []string) error {
 		debug = true
 	}
 
-	fmt.Printf("Mounting Linear filesystem at %s\n", mountpoint)
+	if cfg.Mount.ReadOnly {
+		fmt.Printf("Mounting Linear filesystem read-only at %s\n", mountpoint)
+	} else {
+		fmt.Printf("Mounting Linear filesystem at %s\n", mountpoint)
+	}
 
 	// Telemetry first, so instruments registered during filesystem/worker
 	// construction land on the real provider. Failure must never block
 	// mounting — log and continue without it.
 	//
 	// flushTelemetry is idempotent (sync.Once): called explicitly after
-	// server.Wait() — BEFORE lfs.Close(), because the final export's
+	// the mount ends — BEFORE lfs.Close(), because the final export's
 	// observable callbacks (e.g. sync.pending_depth) read the store — and
 	// kept as a defer so early error returns still flush.
 	flushTelemetry := func() {}
@@ -112,7 +131,7 @@ func runMount(cmd *cobra.Command, args []string) error {
 
 	// Enable SQLite persistent cache and background sync BEFORE mounting
 	// This must complete before the filesystem is accessible to prevent nil repo panics
-	if err := lfs.EnableSQLiteCache(""); err != nil {
+	if err := lfs.EnableSQLiteCache(dbPath); err != nil {
 		fmt.Printf("Warning: SQLite cache disabled: %v\n", err)
 	}
 
@@ -123,18 +142,50 @@ func runMount(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to mount: %w", err)
 	}
 
-	// Handle signals for graceful shutdown
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-
+	// Handle signals for graceful shutdown (shutdown.go). SIGHUP is a closed
+	// terminal, which would otherwise kill the process with the mount wedged.
+	sigChan := make(chan os.Signal, 2)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+
+	// The mount is supervised (watchdog.go): a connection the kernel drops
+	// is detached and mounted again, so the process never outlives its mount.
+	logf := func(format string, args ...any) { fmt.Printf(format+"\n", args...) }
+	live := &liveMount{server: server}
+	supervised := make(chan struct{})
+	abandoned := make(chan struct{})
 	go func() {
-		<-sigChan
-		fmt.Println("\nUnmounting...")
-		_ = server.Unmount()
+		if !unmountOnSignal(sigChan, live, func() error { return fs.ForceUnmount(mountpoint) }, unmountGrace, logf) {
+			return // a clean unmount ends the serve loop
+		}
+		select {
+		case <-supervised:
+		case <-time.After(releaseWait):
+			logf("Mount still held open; closing the cache anyway.")
+			close(abandoned)
+		}
 	}()
 
+	remount := func() (fuseServer, error) {
+		if err := fs.PreflightMountpoint(mountpoint); err != nil {
+			return nil, err
+		}
+		server, err := fs.MountFS(mountpoint, lfs, debug)
+		if err != nil {
+			return nil, err
+		}
+		return server, nil
+	}
+	watch := func(stop <-chan struct{}) {
+		statfs := func(path string) error {
+			var st syscall.Statfs_t
+			return syscall.Statfs(path, &st)
+		}
+		watchMount(stop, mountpoint, statfs, fs.ForceUnmount, watchdogInterval, watchdogProbeTimeout, logf)
+	}
+
 	fmt.Println("Filesystem mounted. Press Ctrl+C to unmount.")
-	server.Wait()
+	serveErr := superviseMount(live, abandoned, remount, watch, remountBackoff, logf)
+	close(supervised)
 
 	// Shutdown ordering matters: flush telemetry while the store is still
 	// open (the final export's observable callbacks collect from it), THEN
@@ -142,5 +193,5 @@ func runMount(cmd *cobra.Command, args []string) error {
 	flushTelemetry()
 	lfs.Close()
 
-	return nil
+	return serveErr
 }
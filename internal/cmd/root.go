@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/jra3/linear-fuse/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -16,5 +17,15 @@ func Execute() error {
 
 func init() {
 	rootCmd.PersistentFlags().StringP("config", "c", "", "config file (default: ~/.config/linearfs/config.yaml)")
+	rootCmd.PersistentFlags().StringP("profile", "p", "", "config profile to apply (default: $LINEARFS_PROFILE)")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "enable debug logging")
 }
+
+// loadConfig loads the config every command runs under: --config names an
+// exact file (unreadable = error); without it the default XDG path applies
+// (missing = defaults + env). --profile lays a named profile over it.
+func loadConfig(cmd *cobra.Command) (*config.Config, error) {
+	path, _ := cmd.Flags().GetString("config")
+	profile, _ := cmd.Flags().GetString("profile")
+	return config.LoadProfile(path, profile)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/fs"
+	"github.com/spf13/cobra"
+)
+
+var schemaCheckCmd = &cobra.Command{
+	Use:   "schema-check",
+	Short: "Check the fields linearfs queries against Linear's live schema",
+	Long: `Introspect Linear's GraphQL schema and compare it to every field linearfs
+queries. A field Linear has deprecated is reported with Linear's reason; a
+field that is gone (removed or renamed) is reported as an error, since every
+request selecting it already fails.
+
+Exits non-zero when a field is gone, or with --strict when one is deprecated,
+so it can gate a release. Costs one API request.`,
+	Args: cobra.NoArgs,
+	RunE: runSchemaCheck,
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCheckCmd)
+	schemaCheckCmd.Flags().Bool("strict", false, "also fail on deprecated fields")
+}
+
+func runSchemaCheck(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	client, err := fs.NewAPIClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+	drift, err := client.CheckSchemaDrift(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	var removed, deprecated int
+	for _, d := range drift {
+		if d.Removed {
+			removed++
+			fmt.Fprintf(out, "ERROR  %s\n", d)
+		} else {
+			deprecated++
+			fmt.Fprintf(out, "WARN   %s\n", d)
+		}
+	}
+	if len(drift) == 0 {
+		fmt.Fprintln(out, "OK: every queried field is live and undeprecated")
+		return nil
+	}
+	fmt.Fprintf(out, "\n%d removed, %d deprecated\n", removed, deprecated)
+	if strict, _ := cmd.Flags().GetBool("strict"); removed > 0 || (strict && deprecated > 0) {
+		return fmt.Errorf("schema drift: %d removed, %d deprecated", removed, deprecated)
+	}
+	return nil
+}
@@ -0,0 +1,438 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+)
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Manage the login service that keeps linearfs mounted",
+	Long: `Install, remove, or inspect a per-user service that mounts linearfs at login
+and unmounts it on logout or shutdown: a systemd user unit on Linux, a launchd
+agent on macOS.
+
+The generated service runs this binary (by absolute path) with the --config
+and --profile given here, so install once per profile you want mounted. It
+replaces copying contrib/systemd or contrib/launchd by hand.`,
+}
+
+var serviceInstallCmd = &cobra.Command{
+	Use:   "install [mountpoint]",
+	Short: "Install, enable, and start the login service",
+	Long: `Write the service definition for this platform, enable it to start at login,
+and start it now. The mountpoint defaults to mount.default_path from the
+config. Re-running install rewrites the definition and restarts the service,
+which is how to pick up a moved binary or a new mountpoint.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runServiceInstall,
+}
+
+var serviceUninstallCmd = &cobra.Command{
+	Use:   "uninstall",
+	Short: "Stop the login service and remove it",
+	Long: `Stop the service (which unmounts the filesystem), disable it, and delete the
+service definition. Config and cache files are left in place.`,
+	Args: cobra.NoArgs,
+	RunE: runServiceUninstall,
+}
+
+var serviceStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show whether the login service is installed and running",
+	Args:  cobra.NoArgs,
+	RunE:  runServiceStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.AddCommand(serviceInstallCmd, serviceUninstallCmd, serviceStatusCmd)
+	serviceInstallCmd.Flags().Bool("print", false, "print the service definition instead of installing it")
+}
+
+// serviceSpec is what a generated service runs: Binary mount -f Mountpoint,
+// with the global flags the install was given.
+type serviceSpec struct {
+	Binary     string
+	Mountpoint string
+	Config     string // absolute --config path, or "" for the default
+	Profile    string
+	LogDir     string // launchd only: where stdout/stderr land
+}
+
+// args is the full command line, binary first.
+func (s serviceSpec) args() []string {
+	args := []string{s.Binary}
+	if s.Config != "" {
+		args = append(args, "--config", s.Config)
+	}
+	if s.Profile != "" {
+		args = append(args, "--profile", s.Profile)
+	}
+	return append(args, "mount", "-f", s.Mountpoint)
+}
+
+// serviceManager is one platform's service system: where the definition
+// lives, how it is rendered, and the commands that activate, deactivate, and
+// report on it (see runServiceSteps for how start and stop run).
+type serviceManager struct {
+	kind   string // "systemd" or "launchd"
+	path   string
+	render func(serviceSpec) (string, error)
+	start  [][]string
+	stop   [][]string
+	status []string
+}
+
+// systemdUnitName and launchdLabel name the installed service. They match the
+// contrib/ files, so install replaces a hand-copied one instead of running a
+// second mount alongside it.
+const (
+	systemdUnitName = "linearfs.service"
+	launchdLabel    = "com.linearfs.mount"
+)
+
+// serviceManagerFor returns the service system for goos, with paths under
+// home. Linux honors $XDG_CONFIG_HOME as systemd does.
+func serviceManagerFor(goos, home string, getenv func(string) string, uid int) (*serviceManager, error) {
+	switch goos {
+	case "linux":
+		configHome := getenv("XDG_CONFIG_HOME")
+		if configHome == "" {
+			configHome = filepath.Join(home, ".config")
+		}
+		return &serviceManager{
+			kind:   "systemd",
+			path:   filepath.Join(configHome, "systemd", "user", systemdUnitName),
+			render: renderSystemdUnit,
+			start: [][]string{
+				{"systemctl", "--user", "daemon-reload"},
+				{"systemctl", "--user", "enable", systemdUnitName},
+				{"systemctl", "--user", "restart", systemdUnitName},
+			},
+			stop: [][]string{
+				{"systemctl", "--user", "disable", "--now", systemdUnitName},
+			},
+			status: []string{"systemctl", "--user", "status", "--no-pager", systemdUnitName},
+		}, nil
+	case "darwin":
+		path := filepath.Join(home, "Library", "LaunchAgents", launchdLabel+".plist")
+		domain := fmt.Sprintf("gui/%d", uid)
+		return &serviceManager{
+			kind:   "launchd",
+			path:   path,
+			render: renderLaunchdPlist,
+			start: [][]string{
+				// bootout first so a reinstall picks up the new plist; it
+				// fails harmlessly when nothing is loaded.
+				{"-", "launchctl", "bootout", domain + "/" + launchdLabel},
+				{"launchctl", "bootstrap", domain, path},
+			},
+			stop: [][]string{
+				{"launchctl", "bootout", domain + "/" + launchdLabel},
+			},
+			status: []string{"launchctl", "print", domain + "/" + launchdLabel},
+		}, nil
+	}
+	return nil, fmt.Errorf("service management is not supported on %s (linux and darwin only)", goos)
+}
+
+// systemdUnitTemplate mirrors contrib/systemd/linearfs.service; see the
+// comments there for why each directive is (or is not) present. The
+// mountpoint preflight lives in the binary, so no ExecStartPre is needed.
+var systemdUnitTemplate = template.Must(template.New("unit").Parse(`# Generated by "linearfs service install"; rerun it rather than editing.
+[Unit]
+Description=LinearFS - Linear issues as a FUSE filesystem
+After=network-online.target
+Wants=network-online.target
+StartLimitBurst=5
+StartLimitIntervalSec=60
+
+[Service]
+Type=simple
+# Optional: LINEAR_API_KEY and other LINEARFS_* overrides.
+EnvironmentFile=-%h/.config/linearfs/env
+ExecStart={{.ExecStart}}
+# Stopping is SIGTERM, which mount turns into a clean unmount (or a lazy one
+# when the mount stays busy, well inside this timeout).
+TimeoutStopSec=30
+Restart=on-failure
+RestartSec=5
+SyslogIdentifier=linearfs
+LogRateLimitIntervalSec=0
+
+[Install]
+WantedBy=default.target
+`))
+
+func renderSystemdUnit(spec serviceSpec) (string, error) {
+	quoted := make([]string, 0, 8)
+	for _, arg := range spec.args() {
+		quoted = append(quoted, systemdQuote(arg))
+	}
+	var buf bytes.Buffer
+	err := systemdUnitTemplate.Execute(&buf, map[string]string{
+		"ExecStart": strings.Join(quoted, " "),
+	})
+	return buf.String(), err
+}
+
+// systemdQuote quotes one ExecStart word: double quotes with \ and " escaped,
+// % doubled so systemd does not read it as a specifier, and $ doubled so it
+// does not expand an environment variable.
+func systemdQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "%", "%%")
+	s = strings.ReplaceAll(s, "$", "$$")
+	return `"` + s + `"`
+}
+
+// launchdPlistTemplate mirrors contrib/launchd/com.linearfs.mount.plist, but
+// runs the binary directly (no shell wrapper) and logs under the user's
+// Library/Logs rather than world-readable /tmp. launchd sends SIGTERM at
+// logout, which mount turns into a clean unmount.
+var launchdPlistTemplate = template.Must(template.New("plist").Funcs(template.FuncMap{"xml": xmlEscape}).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<!-- Generated by "linearfs service install"; rerun it rather than editing. -->
+<plist version="1.0">
+<dict>
+    <key>Label</key>
+    <string>{{.Label}}</string>
+
+    <key>ProgramArguments</key>
+    <array>
+{{- range .Args}}
+        <string>{{xml .}}</string>
+{{- end}}
+    </array>
+
+    <key>RunAtLoad</key>
+    <true/>
+
+    <key>KeepAlive</key>
+    <dict>
+        <key>SuccessfulExit</key>
+        <false/>
+    </dict>
+
+    <key>StandardOutPath</key>
+    <string>{{xml .LogDir}}/linearfs.log</string>
+
+    <key>StandardErrorPath</key>
+    <string>{{xml .LogDir}}/linearfs.err</string>
+
+    <key>EnvironmentVariables</key>
+    <dict>
+        <key>PATH</key>
+        <string>/usr/local/bin:/usr/bin:/bin:/usr/sbin:/sbin</string>
+    </dict>
+</dict>
+</plist>
+`))
+
+func renderLaunchdPlist(spec serviceSpec) (string, error) {
+	var buf bytes.Buffer
+	err := launchdPlistTemplate.Execute(&buf, map[string]any{
+		"Label":  launchdLabel,
+		"Args":   spec.args(),
+		"LogDir": spec.LogDir,
+	})
+	return buf.String(), err
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// runServiceCommand runs one service-manager command with its output sent to
+// out. It is a variable so tests can stand in for systemctl and launchctl.
+var runServiceCommand = func(out io.Writer, argv []string) error {
+	c := exec.Command(argv[0], argv[1:]...)
+	c.Stdout = out
+	c.Stderr = out
+	return c.Run()
+}
+
+func currentServiceManager() (*serviceManager, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	return serviceManagerFor(runtime.GOOS, home, os.Getenv, os.Getuid())
+}
+
+func runServiceInstall(cmd *cobra.Command, args []string) error {
+	out := cmd.OutOrStdout()
+	mgr, err := currentServiceManager()
+	if err != nil {
+		return err
+	}
+	spec, err := serviceSpecFor(cmd, args)
+	if err != nil {
+		return err
+	}
+	def, err := mgr.render(spec)
+	if err != nil {
+		return err
+	}
+	if printOnly, _ := cmd.Flags().GetBool("print"); printOnly {
+		fmt.Fprint(out, def)
+		return nil
+	}
+	return installService(out, mgr, spec, def)
+}
+
+// installService writes the definition and activates it. The definition file
+// is not secret (the API key stays in the config or env file), so it gets the
+// ordinary 0644 that systemd and launchd expect.
+func installService(out io.Writer, mgr *serviceManager, spec serviceSpec, def string) error {
+	if spec.LogDir != "" {
+		if err := os.MkdirAll(spec.LogDir, 0o755); err != nil {
+			return fmt.Errorf("create log directory: %w", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(mgr.path), 0o755); err != nil {
+		return fmt.Errorf("create service directory: %w", err)
+	}
+	if err := os.WriteFile(mgr.path, []byte(def), 0o644); err != nil {
+		return fmt.Errorf("write service definition: %w", err)
+	}
+	fmt.Fprintf(out, "Wrote %s service: %s\n", mgr.kind, mgr.path)
+	if err := runServiceSteps(out, mgr.start, false); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Started; %s will be mounted at every login.\n", spec.Mountpoint)
+	return nil
+}
+
+func runServiceUninstall(cmd *cobra.Command, _ []string) error {
+	mgr, err := currentServiceManager()
+	if err != nil {
+		return err
+	}
+	return uninstallService(cmd.OutOrStdout(), mgr)
+}
+
+// uninstallService stops and removes the service. Stopping unmounts (the
+// service's stop path is a clean unmount); stop failures are reported but do
+// not block removal, since an already-stopped service fails them too.
+func uninstallService(out io.Writer, mgr *serviceManager) error {
+	if _, err := os.Stat(mgr.path); errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(out, "No %s service installed at %s\n", mgr.kind, mgr.path)
+		return nil
+	}
+	_ = runServiceSteps(out, mgr.stop, true)
+	if err := os.Remove(mgr.path); err != nil {
+		return fmt.Errorf("remove service definition: %w", err)
+	}
+	if mgr.kind == "systemd" {
+		_ = runServiceSteps(out, [][]string{{"systemctl", "--user", "daemon-reload"}}, true)
+	}
+	fmt.Fprintf(out, "Removed %s. Config and cache files were left in place.\n", mgr.path)
+	return nil
+}
+
+func runServiceStatus(cmd *cobra.Command, _ []string) error {
+	out := cmd.OutOrStdout()
+	mgr, err := currentServiceManager()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(mgr.path); errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(out, "Not installed (no %s service at %s). Run: linearfs service install\n", mgr.kind, mgr.path)
+		return nil
+	}
+	fmt.Fprintf(out, "Installed: %s\n\n", mgr.path)
+	// systemctl status exits non-zero for a stopped unit; its output already
+	// says so, so the exit code is not an error here.
+	_ = runServiceCommand(out, mgr.status)
+	return nil
+}
+
+// runServiceSteps runs steps in order, stopping at the first failure. A step
+// with a leading "-" may fail silently; tolerant turns every other failure
+// into a warning, so all steps still run.
+func runServiceSteps(out io.Writer, steps [][]string, tolerant bool) error {
+	for _, argv := range steps {
+		silent := argv[0] == "-"
+		if silent {
+			argv = argv[1:]
+		}
+		var buf bytes.Buffer
+		err := runServiceCommand(&buf, argv)
+		if err == nil || silent {
+			continue
+		}
+		msg := fmt.Sprintf("%s: %v", strings.Join(argv, " "), err)
+		if detail := strings.TrimSpace(buf.String()); detail != "" {
+			msg += " (" + detail + ")"
+		}
+		if !tolerant {
+			return errors.New(msg)
+		}
+		fmt.Fprintln(out, "warning:", msg)
+	}
+	return nil
+}
+
+// serviceSpecFor resolves what the service will run from the install
+// invocation: this binary, the mountpoint argument or mount.default_path, and
+// the --config/--profile flags made absolute, since the service does not run
+// from the caller's working directory.
+func serviceSpecFor(cmd *cobra.Command, args []string) (serviceSpec, error) {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return serviceSpec{}, fmt.Errorf("failed to load config: %w", err)
+	}
+	binary, err := os.Executable()
+	if err != nil {
+		return serviceSpec{}, fmt.Errorf("locate linearfs binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(binary); err == nil {
+		binary = resolved
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return serviceSpec{}, err
+	}
+
+	mountpoint := cfg.Mount.DefaultPath
+	if len(args) > 0 {
+		mountpoint = args[0]
+	}
+	if mountpoint == "" {
+		return serviceSpec{}, fmt.Errorf("mountpoint required: linearfs service install /path/to/mount")
+	}
+	if strings.HasPrefix(mountpoint, "~/") {
+		mountpoint = filepath.Join(home, mountpoint[2:])
+	}
+	if mountpoint, err = filepath.Abs(mountpoint); err != nil {
+		return serviceSpec{}, err
+	}
+
+	spec := serviceSpec{Binary: binary, Mountpoint: mountpoint}
+	if path, _ := cmd.Flags().GetString("config"); path != "" {
+		if spec.Config, err = filepath.Abs(path); err != nil {
+			return serviceSpec{}, err
+		}
+	}
+	spec.Profile, _ = cmd.Flags().GetString("profile")
+	if runtime.GOOS == "darwin" {
+		spec.LogDir = filepath.Join(home, "Library", "Logs", "linearfs")
+	}
+	return spec, nil
+}
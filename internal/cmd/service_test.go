@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRenderSystemdUnit(t *testing.T) {
+	spec := serviceSpec{
+		Binary:     "/home/me/.local/bin/linearfs",
+		Mountpoint: "/home/me/Linear 100%",
+		Config:     "/home/me/work.yaml",
+		Profile:    "work",
+	}
+	unit, err := renderSystemdUnit(spec)
+	if err != nil {
+		t.Fatalf("renderSystemdUnit: %v", err)
+	}
+	want := `ExecStart="/home/me/.local/bin/linearfs" "--config" "/home/me/work.yaml" "--profile" "work" "mount" "-f" "/home/me/Linear 100%%"`
+	if !strings.Contains(unit, want+"\n") {
+		t.Errorf("unit missing %q:\n%s", want, unit)
+	}
+	if strings.Contains(unit, "ExecStop=") {
+		t.Errorf("unit detaches the mount before SIGTERM can unmount it cleanly:\n%s", unit)
+	}
+	if !strings.Contains(unit, "WantedBy=default.target") {
+		t.Errorf("unit is not enabled for login:\n%s", unit)
+	}
+}
+
+func TestSystemdQuote(t *testing.T) {
+	for in, want := range map[string]string{
+		"/home/me/Linear":     `"/home/me/Linear"`,
+		"/home/me/100%":       `"/home/me/100%%"`,
+		"/home/me/$HOME":      `"/home/me/$$HOME"`,
+		`/home/me/"q" \ ${x}`: `"/home/me/\"q\" \\ $${x}"`,
+	} {
+		if got := systemdQuote(in); got != want {
+			t.Errorf("systemdQuote(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestRenderLaunchdPlist(t *testing.T) {
+	spec := serviceSpec{
+		Binary:     "/Users/me/bin/linearfs",
+		Mountpoint: "/Users/me/R&D <linear>",
+		LogDir:     "/Users/me/Library/Logs/linearfs",
+	}
+	plist, err := renderLaunchdPlist(spec)
+	if err != nil {
+		t.Fatalf("renderLaunchdPlist: %v", err)
+	}
+	for _, want := range []string{
+		"<string>com.linearfs.mount</string>",
+		"<string>/Users/me/bin/linearfs</string>\n        <string>mount</string>\n        <string>-f</string>\n        <string>/Users/me/R&amp;D &lt;linear&gt;</string>\n    </array>",
+		"<string>/Users/me/Library/Logs/linearfs/linearfs.err</string>",
+	} {
+		if !strings.Contains(plist, want) {
+			t.Errorf("plist missing %q:\n%s", want, plist)
+		}
+	}
+}
+
+func TestServiceManagerFor(t *testing.T) {
+	noEnv := func(string) string { return "" }
+	linux, err := serviceManagerFor("linux", "/home/me", noEnv, 1000)
+	if err != nil {
+		t.Fatalf("linux: %v", err)
+	}
+	if linux.path != "/home/me/.config/systemd/user/linearfs.service" {
+		t.Errorf("linux path = %q", linux.path)
+	}
+	xdg := func(k string) string {
+		if k == "XDG_CONFIG_HOME" {
+			return "/xdg"
+		}
+		return ""
+	}
+	if m, _ := serviceManagerFor("linux", "/home/me", xdg, 1000); m.path != "/xdg/systemd/user/linearfs.service" {
+		t.Errorf("XDG_CONFIG_HOME path = %q", m.path)
+	}
+
+	darwin, err := serviceManagerFor("darwin", "/Users/me", noEnv, 501)
+	if err != nil {
+		t.Fatalf("darwin: %v", err)
+	}
+	if darwin.path != "/Users/me/Library/LaunchAgents/com.linearfs.mount.plist" {
+		t.Errorf("darwin path = %q", darwin.path)
+	}
+	bootstrap := []string{"launchctl", "bootstrap", "gui/501", darwin.path}
+	if !slices.ContainsFunc(darwin.start, func(step []string) bool { return slices.Equal(step, bootstrap) }) {
+		t.Errorf("darwin start = %v, want a bootstrap into gui/501", darwin.start)
+	}
+
+	if _, err := serviceManagerFor("windows", `C:\Users\me`, noEnv, 0); err == nil {
+		t.Error("windows: want an unsupported-platform error")
+	}
+}
+
+// fakeServiceCommands replaces systemctl/launchctl for one test, recording
+// every command and failing those whose joined argv is in fail.
+func fakeServiceCommands(t *testing.T, fail ...string) *[]string {
+	t.Helper()
+	var ran []string
+	orig := runServiceCommand
+	runServiceCommand = func(out io.Writer, argv []string) error {
+		line := strings.Join(argv, " ")
+		ran = append(ran, line)
+		if slices.Contains(fail, line) {
+			io.WriteString(out, "unit not loaded")
+			return errors.New("exit status 1")
+		}
+		return nil
+	}
+	t.Cleanup(func() { runServiceCommand = orig })
+	return &ran
+}
+
+// TestInstallUninstallService: install writes the unit and enables + starts
+// it; uninstall stops it (the unmount) and removes the file even when the
+// stop step fails because the service is already down.
+func TestInstallUninstallService(t *testing.T) {
+	dir := t.TempDir()
+	mgr, err := serviceManagerFor("linux", dir, func(string) string { return "" }, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := serviceSpec{Binary: "/usr/bin/linearfs", Mountpoint: filepath.Join(dir, "linear")}
+	def, err := mgr.render(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ran := fakeServiceCommands(t, "systemctl --user disable --now linearfs.service")
+	var out bytes.Buffer
+	if err := installService(&out, mgr, spec, def); err != nil {
+		t.Fatalf("installService: %v", err)
+	}
+	if got, err := os.ReadFile(mgr.path); err != nil || string(got) != def {
+		t.Fatalf("unit file = %q, %v; want the rendered unit", got, err)
+	}
+	wantStart := []string{
+		"systemctl --user daemon-reload",
+		"systemctl --user enable linearfs.service",
+		"systemctl --user restart linearfs.service",
+	}
+	if !slices.Equal(*ran, wantStart) {
+		t.Errorf("install ran %q, want %q", *ran, wantStart)
+	}
+
+	*ran = nil
+	out.Reset()
+	if err := uninstallService(&out, mgr); err != nil {
+		t.Fatalf("uninstallService: %v", err)
+	}
+	if _, err := os.Stat(mgr.path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("unit file still present after uninstall: %v", err)
+	}
+	if !strings.Contains(out.String(), "warning: systemctl --user disable --now linearfs.service") {
+		t.Errorf("failed stop not reported:\n%s", out.String())
+	}
+	if (*ran)[len(*ran)-1] != "systemctl --user daemon-reload" {
+		t.Errorf("uninstall ran %q, want a final daemon-reload", *ran)
+	}
+
+	// A second uninstall is a no-op, not an error.
+	if err := uninstallService(&out, mgr); err != nil {
+		t.Errorf("second uninstall: %v", err)
+	}
+}
+
+func TestInstallServiceStartFailure(t *testing.T) {
+	dir := t.TempDir()
+	mgr, _ := serviceManagerFor("linux", dir, func(string) string { return "" }, 1000)
+	fakeServiceCommands(t, "systemctl --user restart linearfs.service")
+	err := installService(io.Discard, mgr, serviceSpec{Binary: "/bin/linearfs", Mountpoint: "/mnt"}, "unit")
+	if err == nil || !strings.Contains(err.Error(), "unit not loaded") {
+		t.Errorf("installService error = %v, want the failing restart with its output", err)
+	}
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"os"
+	"time"
+)
+
+// Shutdown: a signal (Ctrl+C, systemd/launchd stop, a closed terminal) must
+// end in an unmounted mountpoint and a cleanly closed cache, never in the
+// wedged "Transport endpoint is not connected" state a killed process leaves.
+// A clean unmount ends server.Wait, after which mount closes the store. The
+// kernel refuses that unmount while the mount is busy (a shell cd'd into it,
+// an editor holding a file), so it is retried; a second signal, or the grace
+// period running out, detaches the mount lazily instead, so shutdown always
+// finishes — well within systemd's stop timeout, before it would SIGKILL.
+
+// unmountGrace is how long a busy mount gets to be released before the lazy
+// detach; releaseWait is how long a lazily detached mount may keep serving
+// the processes still inside it before mount stops waiting and closes anyway.
+const (
+	unmountGrace = 10 * time.Second
+	releaseWait  = 5 * time.Second
+)
+
+// unmounter is the slice of *fuse.Server that shutdown drives.
+type unmounter interface {
+	Unmount() error
+}
+
+// unmountOnSignal waits for the first signal on sigs, then unmounts server:
+// cleanly if the kernel allows it (retried each second while busy), else with
+// force once a second signal arrives or grace runs out. It reports whether
+// it had to force.
+func unmountOnSignal(sigs <-chan os.Signal, server unmounter, force func() error, grace time.Duration, logf func(string, ...any)) (forced bool) {
+	<-sigs
+	logf("Unmounting...")
+	deadline := time.NewTimer(grace)
+	defer deadline.Stop()
+	retry := time.NewTicker(time.Second)
+	defer retry.Stop()
+	for {
+		err := server.Unmount()
+		if err == nil {
+			return false
+		}
+		logf("Unmount failed (%v) — is a shell or editor inside the mount? Retrying; signal again to detach it now.", err)
+		select {
+		case <-retry.C:
+			continue
+		case <-sigs:
+		case <-deadline.C:
+		}
+		break
+	}
+	logf("Detaching the busy mount lazily; processes inside keep their open files until they leave.")
+	if err := force(); err != nil {
+		logf("Lazy unmount failed: %v", err)
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fakeUnmounter fails its first busy Unmount calls with EBUSY, then succeeds.
+type fakeUnmounter struct {
+	busy  int
+	calls int
+}
+
+func (f *fakeUnmounter) Unmount() error {
+	f.calls++
+	if f.calls <= f.busy {
+		return syscall.EBUSY
+	}
+	return nil
+}
+
+func TestUnmountOnSignalClean(t *testing.T) {
+	sigs := make(chan os.Signal, 1)
+	sigs <- syscall.SIGTERM
+	server := &fakeUnmounter{}
+	forced := unmountOnSignal(sigs, server, func() error {
+		t.Error("clean unmount must not force")
+		return nil
+	}, time.Minute, t.Logf)
+	if forced || server.calls != 1 {
+		t.Errorf("forced = %v after %d calls, want a single clean unmount", forced, server.calls)
+	}
+}
+
+// TestUnmountOnSignalBusyThenReleased: a busy mount is retried until the
+// kernel lets it go, without forcing.
+func TestUnmountOnSignalBusyThenReleased(t *testing.T) {
+	sigs := make(chan os.Signal, 1)
+	sigs <- syscall.SIGTERM
+	server := &fakeUnmounter{busy: 1}
+	forced := unmountOnSignal(sigs, server, func() error { return nil }, time.Minute, t.Logf)
+	if forced || server.calls != 2 {
+		t.Errorf("forced = %v after %d calls, want a clean unmount on the retry", forced, server.calls)
+	}
+}
+
+// TestUnmountOnSignalForce: a mount that stays busy is detached lazily, on a
+// second signal or when the grace period runs out.
+func TestUnmountOnSignalForce(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		grace  time.Duration
+		second bool
+	}{
+		{name: "second signal", grace: time.Minute, second: true},
+		{name: "grace expired", grace: 10 * time.Millisecond},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sigs := make(chan os.Signal, 2)
+			sigs <- syscall.SIGTERM
+			if tc.second {
+				sigs <- syscall.SIGINT
+			}
+			var forces int
+			done := make(chan bool)
+			go func() {
+				done <- unmountOnSignal(sigs, &fakeUnmounter{busy: 1 << 30}, func() error {
+					forces++
+					return errors.New("diskutil: busy")
+				}, tc.grace, t.Logf)
+			}()
+			select {
+			case forced := <-done:
+				if !forced || forces != 1 {
+					t.Errorf("forced = %v with %d force calls, want one lazy unmount", forced, forces)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("shutdown never gave up on the busy mount")
+			}
+		})
+	}
+}
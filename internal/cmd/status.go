@@ -16,6 +16,7 @@ import (
 
 	"github.com/jra3/linear-fuse/internal/config"
 	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/fs"
 	"github.com/spf13/cobra"
 )
 
@@ -24,11 +25,13 @@ var statusCmd = &cobra.Command{
 	Short: "Show mount, sync, cache, and budget status",
 	Long: `Report a health snapshot of the local LinearFS state: mount liveness,
 the SQLite cache (workspace size, last full sync, pending detail-sync backlog),
-and — when the JSONL metrics export is on — the current rate-limit budget.
+and the rate-limit budget: the daemon's persisted windows and hourly spend,
+plus — when the JSONL metrics export is on — the latest metrics snapshot.
 
 It reads the local cache and config read-only and does NOT talk to the daemon,
 so it works whether or not the service is running. Live in-memory budget lives
-in the daemon; the values shown come from the last metrics snapshot on disk.`,
+in the daemon; the values shown are what it last persisted (every 30s) and the
+last metrics snapshot on disk.`,
 	RunE: runStatus,
 }
 
@@ -40,15 +43,7 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	out := cmd.OutOrStdout()
 
 	configPath, _ := cmd.Flags().GetString("config")
-	var (
-		cfg    *config.Config
-		cfgErr error
-	)
-	if configPath != "" {
-		cfg, cfgErr = config.LoadFrom(configPath)
-	} else {
-		cfg, cfgErr = config.Load()
-	}
+	cfg, cfgErr := loadConfig(cmd)
 	if cfgErr != nil {
 		// A broken config file shouldn't blind the whole command; fall back to
 		// defaults and note it.
@@ -67,6 +62,13 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	} else {
 		fmt.Fprintf(out, "  file:      %s\n", defaultConfigPath())
 	}
+	profile, _ := cmd.Flags().GetString("profile")
+	if profile == "" {
+		profile = os.Getenv("LINEARFS_PROFILE")
+	}
+	if profile != "" {
+		fmt.Fprintf(out, "  profile:   %s\n", profile)
+	}
 	fmt.Fprintf(out, "  api key:   %s\n", apiKeySource(cfg))
 
 	// --- Mount ---
@@ -74,14 +76,21 @@ func runStatus(cmd *cobra.Command, _ []string) error {
 	reportMounts(out, cfg.Mount.DefaultPath)
 
 	// --- Cache (SQLite) ---
-	dbPath := db.DefaultDBPath()
+	dbPath := cfg.Cache.DBPath
+	if dbPath == "" {
+		dbPath = db.DefaultDBPath()
+	} else if strings.HasPrefix(dbPath, "~/") {
+		home, _ := os.UserHomeDir()
+		dbPath = filepath.Join(home, dbPath[2:])
+	}
 	fmt.Fprintln(out, "\nCache:")
 	fmt.Fprintf(out, "  db:        %s\n", dbPath)
 	reportCache(out, dbPath)
 
-	// --- Budget (from the metrics export, if present) ---
+	// --- Budget (from the metrics export, if present, and the cache) ---
 	fmt.Fprintln(out, "\nBudget:")
 	reportBudget(out, cfg.Telemetry.File.Path)
+	reportBudgetLedger(out, dbPath, time.Now())
 
 	return nil
 }
@@ -103,6 +112,12 @@ func apiKeySource(cfg *config.Config) string {
 	if cfg != nil && cfg.APIKey != "" {
 		return "set (config file)"
 	}
+	if cfg != nil && cfg.OAuth.ClientID != "" {
+		if _, err := os.Stat(cfg.OAuth.TokenPath); err != nil {
+			return "NOT SET (oauth configured; run `linearfs login`)"
+		}
+		return "oauth (token " + cfg.OAuth.TokenPath + ")"
+	}
 	return "NOT SET"
 }
 
@@ -156,7 +171,7 @@ func liveOrWedged(mp string) string {
 	var st syscall.Statfs_t
 	if err := syscall.Statfs(mp, &st); err != nil {
 		if errors.Is(err, syscall.ENOTCONN) {
-			return "WEDGED — recover with: fusermount3 -uz " + mp
+			return "WEDGED — recover with: " + fs.UnmountHint(mp)
 		}
 		if errors.Is(err, os.ErrNotExist) {
 			return "not mounted (path does not exist)"
@@ -190,11 +205,7 @@ func reportCache(out io.Writer, dbPath string) {
 	}
 	fmt.Fprintf(out, "  size:      %s\n", humanBytes(fileSetSize(dbPath, info)))
 
-	// A normal read/write connection that only issues SELECTs — this coexists
-	// with the daemon's WAL connection (a read-only open of a live WAL database
-	// is fussier). No schema init or migration: status must not mutate.
-	escaped := strings.ReplaceAll(dbPath, " ", "%20")
-	conn, err := sql.Open("sqlite", "file:"+escaped+"?_time_format=sqlite&_pragma=busy_timeout(3000)")
+	conn, err := openStatusDB(dbPath)
 	if err != nil {
 		fmt.Fprintf(out, "  state:     could not open (%v)\n", err)
 		return
@@ -227,6 +238,15 @@ func reportCache(out io.Writer, dbPath string) {
 	}
 }
 
+// openStatusDB opens the cache for status's reads: a normal read/write
+// connection that only issues SELECTs — this coexists with the daemon's WAL
+// connection (a read-only open of a live WAL database is fussier). No schema
+// init or migration: status must not mutate.
+func openStatusDB(dbPath string) (*sql.DB, error) {
+	escaped := strings.ReplaceAll(dbPath, " ", "%20")
+	return sql.Open("sqlite", "file:"+escaped+"?_time_format=sqlite&_pragma=busy_timeout(3000)")
+}
+
 func scalarCount(ctx context.Context, conn *sql.DB, query string) (int64, error) {
 	var n int64
 	err := conn.QueryRowContext(ctx, query).Scan(&n)
@@ -281,6 +301,45 @@ func reportBudget(out io.Writer, metricsPath string) {
 	}
 }
 
+// reportBudgetLedger renders the budget the daemon persists to the cache
+// (api_budget_windows / api_budget_hours): Linear's last-reported windows and
+// this host's spend over the last few hours. Silent when the cache or the
+// tables do not exist yet.
+func reportBudgetLedger(out io.Writer, dbPath string, now time.Time) {
+	if _, err := os.Stat(dbPath); err != nil {
+		return
+	}
+	conn, err := openStatusDB(dbPath)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	q := db.New(conn)
+	if windows, err := q.ListAPIBudgetWindows(ctx); err == nil && len(windows) > 0 {
+		fmt.Fprintf(out, "  (persisted %s ago in the cache)\n", humanAgo(now.Sub(windows[0].ObservedAt)))
+		for _, w := range windows {
+			line := fmt.Sprintf("  %-11s %s / %s remaining", w.Axis+":", humanNum(w.Remaining), humanNum(w.LimitValue))
+			if w.ResetAt.Valid && w.ResetAt.Time.After(now) {
+				line += fmt.Sprintf(", resets in %s", humanAgo(w.ResetAt.Time.Sub(now)))
+			} else if w.ResetAt.Valid {
+				line += " (window since reset)"
+			}
+			fmt.Fprintln(out, line)
+		}
+	}
+	since := now.UTC().Truncate(time.Hour).Add(-2 * time.Hour)
+	if hours, err := q.ListAPIBudgetHoursSince(ctx, since); err == nil && len(hours) > 0 {
+		fmt.Fprintln(out, "  this host's spend:")
+		for _, h := range hours {
+			fmt.Fprintf(out, "    %s  %s requests, %s complexity\n",
+				h.Hour.Local().Format("15:04"), humanNum(float64(h.Requests)), humanNum(h.Complexity))
+		}
+	}
+}
+
 // budgetByAxis maps axis -> {limit,remaining,reset_seconds,inflight}.
 type budgetByAxis map[string]map[string]float64
 
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Mount watchdog: a FUSE connection can break while linearfs keeps running —
+// the kernel aborts it (a wedged request, `echo 1 > .../fuse/connections/N/abort`,
+// a macFUSE hiccup after sleep) and every access to the mountpoint fails with
+// "Transport endpoint is not connected" until someone unmounts and remounts by
+// hand. The watchdog self-stats the mountpoint through the kernel; a broken
+// connection is detached with a lazy unmount, which ends the serve loop, and
+// superviseMount mounts the same filesystem again. The SQLite cache and the
+// sync worker live in the process, not the mount, so they carry on untouched.
+
+// watchdogInterval is how often the mountpoint is probed; watchdogProbeTimeout
+// is how long one probe may take before the mount is reported as hung.
+// remountAttempts bounds the retries of one remount (remountBackoff, doubling)
+// before mount gives up and exits for the service manager to restart it.
+const (
+	watchdogInterval     = 30 * time.Second
+	watchdogProbeTimeout = 10 * time.Second
+	remountAttempts      = 5
+	remountBackoff       = time.Second
+)
+
+// brokenMount reports whether a statfs error means the kernel's FUSE
+// connection is gone: ENOTCONN on Linux, ENXIO ("Device not configured") on
+// macOS.
+func brokenMount(err error) bool {
+	return errors.Is(err, syscall.ENOTCONN) || errors.Is(err, syscall.ENXIO)
+}
+
+// watchMount probes path with statfs every interval until stop closes. A probe
+// that finds the connection broken is logged and the mount detached, and the
+// watch ends — the detach ends the serve loop, and the remount starts a new
+// watch. A probe that has not answered within timeout is logged as a hung
+// mount but not acted on (a slow handler is not a broken connection); no new
+// probe starts until it returns.
+func watchMount(stop <-chan struct{}, path string, statfs func(string) error, detach func(string) error, interval, timeout time.Duration, logf func(string, ...any)) {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	var pending chan error
+	for {
+		select {
+		case <-stop:
+			return
+		case <-tick.C:
+		}
+		if pending == nil {
+			pending = make(chan error, 1)
+			go func(result chan<- error) { result <- statfs(path) }(pending)
+		}
+		var err error
+		select {
+		case <-stop:
+			return
+		case err = <-pending:
+			pending = nil
+		case <-time.After(timeout):
+			logf("Mount health check: %s has not answered in %s; the filesystem may be hung.", path, timeout)
+			continue
+		}
+		if err == nil || !brokenMount(err) {
+			continue
+		}
+		logf("Mount health check: %s is disconnected (%v); detaching it to remount.", path, err)
+		if err := detach(path); err != nil {
+			logf("Lazy unmount failed: %v", err)
+		}
+		return
+	}
+}
+
+// fuseServer is the slice of *fuse.Server the supervisor drives.
+type fuseServer interface {
+	Wait()
+	Unmount() error
+}
+
+// liveMount is the current server behind the mountpoint, swapped on remount.
+// Its Unmount is shutdown's: it marks the mount as stopping first, so the
+// serve loop ending afterwards is final rather than a lost connection.
+type liveMount struct {
+	mu       sync.Mutex
+	server   fuseServer
+	stopping bool
+}
+
+func (m *liveMount) Unmount() error {
+	m.mu.Lock()
+	m.stopping = true
+	server := m.server
+	m.mu.Unlock()
+	return server.Unmount()
+}
+
+// current returns the server and whether shutdown has begun.
+func (m *liveMount) current() (fuseServer, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.server, m.stopping
+}
+
+// replace installs a remounted server, unless shutdown began meanwhile.
+func (m *liveMount) replace(server fuseServer) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopping {
+		return false
+	}
+	m.server = server
+	return true
+}
+
+// superviseMount serves live until shutdown: it returns nil once the serve
+// loop ends after shutdown began, or when abandoned closes. A serve loop that
+// ends on its own is a lost connection, and the filesystem is remounted (with
+// a watch started per mount); the error is a remount that kept failing.
+func superviseMount(live *liveMount, abandoned <-chan struct{}, remount func() (fuseServer, error), watch func(stop <-chan struct{}), backoff time.Duration, logf func(string, ...any)) error {
+	for {
+		server, _ := live.current()
+		served := make(chan struct{})
+		go func() {
+			server.Wait()
+			close(served)
+		}()
+		go watch(served)
+
+		select {
+		case <-served:
+		case <-abandoned:
+			return nil
+		}
+		if _, stopping := live.current(); stopping {
+			return nil
+		}
+
+		logf("Mount connection lost; remounting.")
+		next, err := remountWithRetry(live, remount, backoff, logf)
+		if err != nil || next == nil {
+			return err
+		}
+		if !live.replace(next) {
+			// Shutdown raced the remount; it is waiting on a clean unmount.
+			_ = next.Unmount()
+			return nil
+		}
+		logf("Remounted.")
+	}
+}
+
+// remountWithRetry runs remount up to remountAttempts times, doubling the
+// delay between tries. It returns nil, nil when shutdown begins meanwhile.
+func remountWithRetry(live *liveMount, remount func() (fuseServer, error), delay time.Duration, logf func(string, ...any)) (fuseServer, error) {
+	for attempt := 1; ; attempt++ {
+		if _, stopping := live.current(); stopping {
+			return nil, nil
+		}
+		server, err := remount()
+		if err == nil {
+			return server, nil
+		}
+		if attempt == remountAttempts {
+			return nil, fmt.Errorf("remount failed after %d attempts: %w", attempt, err)
+		}
+		logf("Remount attempt %d failed (%v); retrying in %s.", attempt, err, delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fakeServer is a serve loop that runs until ended: by Unmount (a clean
+// shutdown) or by drop (a lost connection).
+type fakeServer struct {
+	once  sync.Once
+	ended chan struct{}
+}
+
+func newFakeServer() *fakeServer { return &fakeServer{ended: make(chan struct{})} }
+
+func (s *fakeServer) Wait()          { <-s.ended }
+func (s *fakeServer) drop()          { s.once.Do(func() { close(s.ended) }) }
+func (s *fakeServer) Unmount() error { s.drop(); return nil }
+
+func noWatch(<-chan struct{}) {}
+
+// TestWatchMountDetachesBrokenMount: a disconnected probe detaches the mount
+// and ends the watch; healthy probes and other errors do nothing.
+func TestWatchMountDetachesBrokenMount(t *testing.T) {
+	probes := []error{nil, syscall.EACCES, syscall.ENOTCONN}
+	var mu sync.Mutex
+	statfs := func(string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		err := probes[0]
+		probes = probes[1:]
+		return err
+	}
+	var detached []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchMount(make(chan struct{}), "/mnt/linear", statfs, func(path string) error {
+			detached = append(detached, path)
+			return nil
+		}, time.Millisecond, time.Second, t.Logf)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not end after a disconnected probe")
+	}
+	if len(detached) != 1 || detached[0] != "/mnt/linear" {
+		t.Errorf("detached = %v, want the mountpoint once", detached)
+	}
+}
+
+// TestWatchMountHungProbe: a probe that does not answer is reported, never
+// detached, and not piled up behind.
+func TestWatchMountHungProbe(t *testing.T) {
+	var calls int
+	var mu sync.Mutex
+	release := make(chan struct{})
+	statfs := func(string) error {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		<-release
+		return nil
+	}
+	stop := make(chan struct{})
+	var logs []string
+	var logMu sync.Mutex
+	logf := func(format string, args ...any) {
+		logMu.Lock()
+		logs = append(logs, format)
+		logMu.Unlock()
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchMount(stop, "/mnt/linear", statfs, func(string) error {
+			t.Error("a hung mount must not be detached")
+			return nil
+		}, time.Millisecond, 5*time.Millisecond, logf)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(stop)
+	<-done
+	close(release)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 1 {
+		t.Errorf("statfs called %d times while hung, want 1", calls)
+	}
+	logMu.Lock()
+	defer logMu.Unlock()
+	if len(logs) == 0 || !strings.Contains(logs[0], "may be hung") {
+		t.Errorf("logs = %q, want a hung-mount report", logs)
+	}
+}
+
+// TestSuperviseMountRemounts: a serve loop that ends on its own is remounted;
+// the one that ends after shutdown began is final.
+func TestSuperviseMountRemounts(t *testing.T) {
+	first, second := newFakeServer(), newFakeServer()
+	live := &liveMount{server: first}
+	remounts := 0
+	remount := func() (fuseServer, error) {
+		remounts++
+		// The remounted server is shut down cleanly once it is live.
+		go func() {
+			for {
+				if s, _ := live.current(); s == fuseServer(second) {
+					_ = live.Unmount()
+					return
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}()
+		return second, nil
+	}
+	first.drop()
+	if err := superviseMount(live, make(chan struct{}), remount, noWatch, time.Millisecond, t.Logf); err != nil {
+		t.Fatalf("superviseMount: %v", err)
+	}
+	if remounts != 1 {
+		t.Errorf("remounts = %d, want 1", remounts)
+	}
+}
+
+func TestSuperviseMountGivesUp(t *testing.T) {
+	server := newFakeServer()
+	server.drop()
+	attempts := 0
+	err := superviseMount(&liveMount{server: server}, make(chan struct{}), func() (fuseServer, error) {
+		attempts++
+		return nil, errors.New("mountpoint busy")
+	}, noWatch, time.Millisecond, t.Logf)
+	if err == nil || !strings.Contains(err.Error(), "mountpoint busy") {
+		t.Errorf("superviseMount error = %v, want the remount failure", err)
+	}
+	if attempts != remountAttempts {
+		t.Errorf("attempts = %d, want %d", attempts, remountAttempts)
+	}
+}
+
+// TestSuperviseMountShutdownDuringRemount: a server remounted after shutdown
+// began is unmounted again instead of left serving.
+func TestSuperviseMountShutdownDuringRemount(t *testing.T) {
+	first, second := newFakeServer(), newFakeServer()
+	live := &liveMount{server: first}
+	first.drop()
+	err := superviseMount(live, make(chan struct{}), func() (fuseServer, error) {
+		live.mu.Lock()
+		live.stopping = true
+		live.mu.Unlock()
+		return second, nil
+	}, noWatch, time.Millisecond, t.Logf)
+	if err != nil {
+		t.Fatalf("superviseMount: %v", err)
+	}
+	select {
+	case <-second.ended:
+	default:
+		t.Error("server remounted during shutdown was left serving")
+	}
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/fs"
+	"github.com/spf13/cobra"
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Register or remove the webhook that feeds real-time sync",
+	Long: `Manage the Linear webhook behind real-time sync (webhook: in the config).
+
+A mount with webhook.url set registers a webhook at startup and deletes it at
+unmount. setup registers one that outlives mounts instead, so deliveries have
+somewhere to go between restarts and the mount's API key needn't be an admin's;
+a mount finding it at its URL uses it and leaves it in place. Both commands
+need an admin API key.`,
+}
+
+var webhookSetupCmd = &cobra.Command{
+	Use:   "setup",
+	Short: "Register the webhook at the public URL, or update it",
+	Long: `Point a webhook at webhook.url (or --url): update the linearfs webhook already
+registered there, re-enabling it, or create one. It is signed with
+webhook.secret; without one a secret is minted and printed, to add to the
+config so the listener can verify deliveries.
+
+With no URL configured, print how to expose webhook.listen through a tunnel
+and exit.`,
+	Args: cobra.NoArgs,
+	RunE: runWebhookSetup,
+}
+
+var webhookRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Delete the webhook registered at the public URL",
+	Long: `Delete the linearfs webhook at webhook.url (or --url). With --all, delete
+every webhook linearfs registered, whatever its URL: the leftovers of mounts
+that were killed before they could clean up.`,
+	Args: cobra.NoArgs,
+	RunE: runWebhookRemove,
+}
+
+func init() {
+	rootCmd.AddCommand(webhookCmd)
+	webhookCmd.AddCommand(webhookSetupCmd, webhookRemoveCmd)
+	webhookSetupCmd.Flags().String("url", "", "public URL Linear posts to (default: webhook.url)")
+	webhookRemoveCmd.Flags().String("url", "", "URL of the webhook to delete (default: webhook.url)")
+	webhookRemoveCmd.Flags().Bool("all", false, "delete every webhook linearfs registered")
+}
+
+// webhookTimeout bounds a setup or remove: a list plus a mutation or two.
+const webhookTimeout = 30 * time.Second
+
+// defaultWebhookListen is the address the tunnel instructions use when
+// webhook.listen is unset.
+const defaultWebhookListen = ":8787"
+
+func runWebhookSetup(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	out := cmd.OutOrStdout()
+	url, _ := cmd.Flags().GetString("url")
+	if url == "" {
+		url = cfg.Webhook.URL
+	}
+	if url == "" {
+		fmt.Fprint(out, tunnelInstructions(cfg.Webhook.Listen))
+		return nil
+	}
+
+	secret, minted := cfg.Webhook.Secret, false
+	if secret == "" {
+		if secret, err = fs.NewWebhookSecret(); err != nil {
+			return err
+		}
+		minted = true
+	}
+	client, err := fs.NewAPIClient(cfg)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), webhookTimeout)
+	defer cancel()
+	hook, created, err := fs.EnsureWebhook(ctx, client, url, secret)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		fmt.Fprintf(out, "Registered webhook %s -> %s\n", hook.ID, hook.URL)
+	} else {
+		fmt.Fprintf(out, "Updated webhook %s -> %s\n", hook.ID, hook.URL)
+	}
+	if minted {
+		fmt.Fprintf(out, "\nIt is signed with a new secret. Add it to the config so the listener\ncan verify deliveries:\n\n  webhook:\n    secret: %s\n", secret)
+	}
+	if cfg.Webhook.Listen == "" {
+		fmt.Fprintf(out, "\nwebhook.listen is not set: deliveries reach linearfs only once a mount\nlistens behind %s (e.g. listen: %q).\n", hook.URL, defaultWebhookListen)
+	}
+	return nil
+}
+
+func runWebhookRemove(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	url, _ := cmd.Flags().GetString("url")
+	if url == "" {
+		url = cfg.Webhook.URL
+	}
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		url = ""
+	} else if url == "" {
+		return fmt.Errorf("webhook.url not set - pass --url, or --all to delete every linearfs webhook")
+	}
+
+	client, err := fs.NewAPIClient(cfg)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), webhookTimeout)
+	defer cancel()
+	removed, err := fs.RemoveWebhooks(ctx, client, url)
+	out := cmd.OutOrStdout()
+	for _, h := range removed {
+		fmt.Fprintf(out, "Deleted webhook %s -> %s\n", h.ID, h.URL)
+	}
+	if err != nil {
+		return err
+	}
+	if len(removed) == 0 {
+		fmt.Fprintln(out, "No linearfs webhook to delete")
+	}
+	return nil
+}
+
+// tunnelInstructions explains how to give the listener on listen a public
+// URL, for a setup run with none configured.
+func tunnelInstructions(listen string) string {
+	if listen == "" {
+		listen = defaultWebhookListen
+	}
+	port := strings.TrimPrefix(listen, ":")
+	if _, p, err := net.SplitHostPort(listen); err == nil {
+		port = p
+	}
+	return fmt.Sprintf(`webhook.url is not set. Linear posts deliveries to a public HTTPS URL that
+reaches the mount's listener on %s. For a machine without one, start a
+tunnel to it, for example:
+
+  cloudflared tunnel --url http://localhost:%s
+  ngrok http %s
+
+then register the address it prints:
+
+  linearfs webhook setup --url https://<tunnel-host>/
+
+and add it to the config as webhook.url. A quick tunnel's address changes
+each time it starts; run setup again when it does.
+`, listen, port, port)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestTunnelInstructionsUseListenPort: the tunnel commands forward to the
+// port the listener binds, whatever form webhook.listen takes.
+func TestTunnelInstructionsUseListenPort(t *testing.T) {
+	for listen, want := range map[string]string{
+		"":               "http://localhost:8787",
+		":9000":          "http://localhost:9000",
+		"127.0.0.1:9100": "http://localhost:9100",
+	} {
+		got := tunnelInstructions(listen)
+		if !strings.Contains(got, "cloudflared tunnel --url "+want) {
+			t.Errorf("listen %q: instructions lack %q:\n%s", listen, want, got)
+		}
+		if !strings.Contains(got, "linearfs webhook setup --url") {
+			t.Errorf("listen %q: instructions lack the setup step", listen)
+		}
+	}
+}
@@ -2,24 +2,130 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	APIKey    string          `yaml:"api_key"`
-	Cache     CacheConfig     `yaml:"cache"`
-	Mount     MountConfig     `yaml:"mount"`
-	Log       LogConfig       `yaml:"log"`
-	Telemetry TelemetryConfig `yaml:"telemetry"`
+	APIKey       string              `yaml:"api_key"`
+	OAuth        OAuthConfig         `yaml:"oauth"`
+	API          APIConfig           `yaml:"api"`
+	Cache        CacheConfig         `yaml:"cache"`
+	Mount        MountConfig         `yaml:"mount"`
+	Log          LogConfig           `yaml:"log"`
+	Telemetry    TelemetryConfig     `yaml:"telemetry"`
+	GitHub       GitHubConfig        `yaml:"github"`
+	Webhook      WebhookConfig       `yaml:"webhook"`
+	LiveSync     LiveSyncConfig      `yaml:"live_sync"`
+	Views        []ViewConfig        `yaml:"views"`
+	Recurring    []RecurringConfig   `yaml:"recurring"`
+	CycleReports []CycleReportConfig `yaml:"cycle_reports"`
+	Attention    AttentionConfig     `yaml:"attention"`
+	Lint         LintConfig          `yaml:"lint"`
+	Display      DisplayConfig       `yaml:"display"`
+	// Teams limits the mount to these team keys (sync and teams/); empty
+	// mounts every team the credential can see.
+	Teams []string `yaml:"teams"`
+	// ExcludeTeams leaves these team keys out of the mount, after Teams; a
+	// key in both is excluded.
+	ExcludeTeams []string `yaml:"exclude_teams"`
+	// Profiles are named overlays selected with --profile (or
+	// LINEARFS_PROFILE): each is a config fragment laid over the settings
+	// above, so a profile names only what differs — typically api_key,
+	// cache.db_path, teams, and mount. Applied in loadPath.
+	Profiles map[string]yaml.Node `yaml:"profiles"`
 }
 
+// APIConfig tunes how the client talks to Linear. SchemaCheck introspects
+// Linear's schema once at mount and logs a warning for every field linearfs
+// queries that is deprecated or gone (the same check as `linearfs
+// schema-check`). Off by default: it costs one introspection query.
+//
+// LazyIssueFields lists heavy issue fields — "description", "labels",
+// "attachments" — the background sync leaves out, to cut its complexity cost
+// on workspaces browsed mostly by title. Description and labels are fetched
+// when an issue.md is opened, attachments when the issue's detail directories
+// are browsed; until then a lazy field shows its last fetched value (empty
+// for an issue never opened), and by/label lists by those values. Empty
+// (the default) syncs everything. Validated in fs.NewLinearFS.
+//
+// RateLimit caps the client's hourly spend below what Linear reports.
+type APIConfig struct {
+	SchemaCheck     bool            `yaml:"schema_check"`
+	LazyIssueFields []string        `yaml:"lazy_issue_fields"`
+	RateLimit       RateLimitConfig `yaml:"rate_limit"`
+}
+
+// RateLimitConfig caps the API budget. The client always reads its real
+// limits from Linear's X-RateLimit-* response headers and paces itself to
+// them; these only lower them — for a key shared with other tools or
+// scripts, or an enterprise quota to stay well inside. RequestsPerHour and
+// ComplexityPerHour cap the two hourly axes; Burst sizes the limiter that
+// smooths request spikes (default 16). Zero keeps the default for each;
+// negatives fail the mount in fs.NewLinearFS.
+type RateLimitConfig struct {
+	RequestsPerHour   int `yaml:"requests_per_hour"`
+	ComplexityPerHour int `yaml:"complexity_per_hour"`
+	Burst             int `yaml:"burst"`
+}
+
+// OAuthConfig authenticates with a Linear OAuth application instead of a
+// personal API key, for workspaces that block personal keys. `linearfs login`
+// runs the browser flow and saves the token to TokenPath; the mount reads it
+// from there and refreshes it as it expires. Used only when no api_key (or
+// LINEAR_API_KEY) is set.
+//
+// ClientID is the application's; ClientSecret may be empty (the flow uses
+// PKCE). RedirectURL must be a loopback http URL registered as a callback on
+// the application; login listens there for the one redirect.
+type OAuthConfig struct {
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectURL  string   `yaml:"redirect_url"`
+	Scopes       []string `yaml:"scopes"`
+	TokenPath    string   `yaml:"token_path"`
+}
+
+// DefaultOAuthRedirectURL is oauth.redirect_url's default.
+const DefaultOAuthRedirectURL = "http://localhost:8976/callback"
+
+// ValidateOAuth checks the oauth block: a client secret or redirect override
+// without a client ID is a half-filled block, not a choice.
+func ValidateOAuth(o OAuthConfig) error {
+	if o.ClientID == "" && o.ClientSecret != "" {
+		return fmt.Errorf("oauth: client_secret needs client_id set")
+	}
+	return nil
+}
+
+// CacheConfig tunes the local cache. DBPath is the SQLite file; empty takes
+// db.DefaultDBPath, or under a profile a cache.db of the profile's own, so
+// two profiles never share one.
 type CacheConfig struct {
-	TTL        time.Duration `yaml:"ttl"`
-	MaxEntries int           `yaml:"max_entries"`
+	TTL        time.Duration   `yaml:"ttl"`
+	MaxEntries int             `yaml:"max_entries"`
+	DBPath     string          `yaml:"db_path"`
+	Staleness  StalenessConfig `yaml:"staleness"`
+}
+
+// StalenessConfig sets how long each kind of on-demand data is read from the
+// cache before a read refreshes it in the background. Zero keeps the default:
+// 5m for documents and updates; for comments, refreshing only once the issue
+// has changed since they were fetched. RevalidateIssueOnOpen refetches an
+// issue from Linear each time its issue.md is opened: a request per open, in
+// exchange for never reading a stale body. Negatives fail the mount in
+// fs.NewLinearFS.
+type StalenessConfig struct {
+	Comments              time.Duration `yaml:"comments"`
+	Documents             time.Duration `yaml:"documents"`
+	Updates               time.Duration `yaml:"updates"`
+	RevalidateIssueOnOpen bool          `yaml:"revalidate_issue_on_open"`
 }
 
 // MountConfig configures the mount. The allow_other key that used to live
@@ -28,6 +134,119 @@ type CacheConfig struct {
 // old config files carrying it still parse.
 type MountConfig struct {
 	DefaultPath string `yaml:"default_path"`
+	// IssueDirTemplate names the directories issues/ lists, e.g.
+	// "{identifier}-{slugified-title}" or "{identifier}{flags}". Empty = bare
+	// identifiers (the default). Lookup always accepts the bare identifier
+	// as well.
+	IssueDirTemplate string `yaml:"issue_dir_template"`
+	// IconPrefix prefixes team and project directory names with their emoji
+	// icon ("🚀 ENG"), as the Linear UI shows them. Lookup always accepts the
+	// bare name as well.
+	IconPrefix bool `yaml:"icon_prefix"`
+	// IssueRmdir is what `rmdir issues/ENG-123` does: "archive" archives the
+	// issue on Linear (the default), "trash" moves it into issues/.archive/
+	// and archives only when it is removed from there, "deny" refuses.
+	IssueRmdir string `yaml:"issue_rmdir"`
+	// ConfirmDeletes, when set, holds every archive/delete (rm, rmdir) until
+	// the token it leaves in the surface's .error is written to the root
+	// .confirm file within this window. Zero (the default) deletes at once.
+	ConfirmDeletes time.Duration `yaml:"confirm_deletes"`
+	// ReadOnly mounts the filesystem read-only: every create, write, rename
+	// and delete fails with EROFS, while sync keeps the cache current. Sync
+	// makes no writes of its own either: queued offline writes wait for a
+	// writable mount, and recurring issues and cycle reports are not created.
+	// The mount command's --read-only flag sets it too.
+	ReadOnly bool `yaml:"read_only"`
+	// Root mounts one subtree of the full layout at the mountpoint instead
+	// of the workspace root: "teams/ENG" or "teams/ENG/projects/my-project".
+	// Empty (the default) mounts everything. With teams unset it also limits
+	// sync to the root's team. The mount command's --root flag sets it too.
+	// Validated by ValidateMountRoot.
+	Root string `yaml:"root"`
+}
+
+// Values accepted in mount.issue_rmdir.
+const (
+	IssueRmdirArchive = "archive"
+	IssueRmdirTrash   = "trash"
+	IssueRmdirDeny    = "deny"
+)
+
+// ValidateIssueRmdir checks mount.issue_rmdir. The empty value is valid (the
+// default, archive).
+func ValidateIssueRmdir(mode string) error {
+	switch mode {
+	case "", IssueRmdirArchive, IssueRmdirTrash, IssueRmdirDeny:
+		return nil
+	}
+	return fmt.Errorf("issue_rmdir %q: must be %s, %s, or %s", mode, IssueRmdirArchive, IssueRmdirTrash, IssueRmdirDeny)
+}
+
+// ValidateMountRoot checks mount.root: a team directory, teams/{KEY}, or one
+// of its projects, teams/{KEY}/projects/{name}, relative to the full mount.
+// The empty value is valid (the default, the whole workspace). A subtree
+// mount has no root .confirm file to confirm a held delete with, so it
+// refuses confirm_deletes rather than hold deletes forever.
+func ValidateMountRoot(m MountConfig) error {
+	if m.Root == "" {
+		return nil
+	}
+	if MountRootTeam(m.Root) == "" {
+		return fmt.Errorf("root %q: must be teams/KEY or teams/KEY/projects/NAME", m.Root)
+	}
+	if m.ConfirmDeletes > 0 {
+		return fmt.Errorf("root %q: confirm_deletes needs the whole workspace mounted (its .confirm file is at the root)", m.Root)
+	}
+	return nil
+}
+
+// MountRootTeam is the team key a valid mount.root sits under, "" for an
+// invalid or empty one. Both forms mount a part of that one team.
+func MountRootTeam(root string) string {
+	parts := strings.Split(strings.Trim(root, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "teams" && parts[1] != "":
+	case len(parts) == 4 && parts[0] == "teams" && parts[1] != "" && parts[2] == "projects" && parts[3] != "":
+	default:
+		return ""
+	}
+	// An icon-prefixed name ("🚀 ENG") still names the team by its key.
+	fields := strings.Fields(parts[1])
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// Placeholders accepted in mount.issue_dir_template.
+const (
+	IssueDirIdentifier = "{identifier}"
+	IssueDirSlugTitle  = "{slugified-title}"
+	// IssueDirFlags renders ".urgent" for an urgent issue, else ".blocked"
+	// for one an open issue blocks, else nothing.
+	IssueDirFlags = "{flags}"
+)
+
+// ValidateIssueDirTemplate checks an issue directory template: only known
+// placeholders, exactly one {identifier} (so a rendered name maps back to its
+// issue), and no path separators. The empty template is valid (the default).
+func ValidateIssueDirTemplate(tmpl string) error {
+	if tmpl == "" {
+		return nil
+	}
+	if strings.ContainsAny(tmpl, "/\\") {
+		return fmt.Errorf("issue_dir_template %q: must not contain path separators", tmpl)
+	}
+	if n := strings.Count(tmpl, IssueDirIdentifier); n != 1 {
+		return fmt.Errorf("issue_dir_template %q: must contain %s exactly once", tmpl, IssueDirIdentifier)
+	}
+	rest := strings.ReplaceAll(tmpl, IssueDirIdentifier, "")
+	rest = strings.ReplaceAll(rest, IssueDirSlugTitle, "")
+	rest = strings.ReplaceAll(rest, IssueDirFlags, "")
+	if strings.ContainsAny(rest, "{}") {
+		return fmt.Errorf("issue_dir_template %q: unknown placeholder (supported: %s, %s, %s)", tmpl, IssueDirIdentifier, IssueDirSlugTitle, IssueDirFlags)
+	}
+	return nil
 }
 
 // LogConfig configures logging. The api_stats key that used to live here is
@@ -40,11 +259,12 @@ type LogConfig struct {
 
 // TelemetryConfig configures the OTEL metrics pipeline (internal/telemetry)
 // plus the per-request debug log. The in-memory meter and the journald
-// summary line are always on; only the JSONL file export and the request log
-// are configurable here.
+// summary line are always on; only the JSONL file export, the Prometheus
+// endpoint, and the request log are configurable here.
 type TelemetryConfig struct {
-	File     TelemetryFileConfig     `yaml:"file"`
-	Requests TelemetryRequestsConfig `yaml:"requests"`
+	File       TelemetryFileConfig       `yaml:"file"`
+	Prometheus TelemetryPrometheusConfig `yaml:"prometheus"`
+	Requests   TelemetryRequestsConfig   `yaml:"requests"`
 }
 
 // TelemetryFileConfig gates the JSONL metrics file export (off by default).
@@ -55,6 +275,14 @@ type TelemetryFileConfig struct {
 	MaxSizeMB int           `yaml:"max_size_mb"`
 }
 
+// TelemetryPrometheusConfig gates the Prometheus scrape endpoint (off by
+// default). Listen is its address ("127.0.0.1:9464" serves
+// http://127.0.0.1:9464/metrics); empty = off. A bare ":9464" listens on every
+// interface, so bind loopback unless the scraper is remote.
+type TelemetryPrometheusConfig struct {
+	Listen string `yaml:"listen"`
+}
+
 // TelemetryRequestsConfig gates the per-request JSONL debug log (off by
 // default): one JSON line per completed GraphQL request, written by the api
 // client. This is an application debug log, NOT an OTEL signal — the
@@ -67,8 +295,170 @@ type TelemetryRequestsConfig struct {
 	Path    string `yaml:"path"`
 }
 
+// GitHubConfig enables GitHub pull-request enrichment of attachment .link
+// files (PR state, checks, reviewers). Empty token = off, the default: no
+// request ever leaves for api.github.com without one.
+type GitHubConfig struct {
+	Token string `yaml:"token"`
+}
+
+// WebhookConfig enables real-time sync (internal/webhook): Linear posts issue,
+// comment, and project changes to a listener the mount runs, and they land in
+// the cache within seconds instead of at the next sync cycle. The polling sync
+// keeps running as the safety net for anything a delivery missed.
+//
+// Listen is the listener's address (":8787"); empty = off, the default. URL is
+// the public address Linear posts to (a tunnel or reverse proxy in front of
+// Listen); when set, the mount registers the webhook at startup and deletes it
+// on unmount, which needs an admin API key. Secret verifies each delivery's
+// Linear-Signature; with URL set it may be empty, and a random one is minted
+// per mount. A webhook registered by hand needs its signing secret here.
+type WebhookConfig struct {
+	Listen string `yaml:"listen"`
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"`
+}
+
+// ValidateWebhook checks the webhook block: a listener needs a way to verify
+// deliveries (a secret, or a URL to register with a minted one), and a URL
+// needs a listener behind it.
+func ValidateWebhook(w WebhookConfig) error {
+	switch {
+	case w.Listen == "" && (w.URL != "" || w.Secret != ""):
+		return fmt.Errorf("webhook: url and secret need listen set")
+	case w.Listen != "" && w.URL == "" && w.Secret == "":
+		return fmt.Errorf("webhook: listen needs url (to register a webhook) or the secret of one registered by hand")
+	}
+	return nil
+}
+
+// LiveSyncConfig selects how the mount hears about changes made elsewhere
+// without a webhook listener — the case of a mount behind NAT, with no public
+// URL for Linear to post to. Transport "poll" (the default, also when empty)
+// relies on the sync interval alone. "subscription" also holds an outbound
+// GraphQL subscription (graphql-transport-ws) to URL, by default Linear's
+// API endpoint, and applies each change event through the same pipeline as
+// a webhook delivery (internal/livesync). The polling sync keeps running
+// underneath either way. Validated by ValidateLiveSync.
+type LiveSyncConfig struct {
+	Transport string `yaml:"transport"`
+	URL       string `yaml:"url"`
+}
+
+// Values accepted in live_sync.transport.
+const (
+	LiveSyncPoll         = "poll"
+	LiveSyncSubscription = "subscription"
+)
+
+// DefaultLiveSyncURL is the subscription endpoint when live_sync.url is empty.
+const DefaultLiveSyncURL = "wss://api.linear.app/graphql"
+
+// ValidateLiveSync checks the live_sync block: a known transport, and a url
+// only for the subscription transport, as a ws:// or wss:// URL.
+func ValidateLiveSync(l LiveSyncConfig) error {
+	switch l.Transport {
+	case "", LiveSyncPoll, LiveSyncSubscription:
+	default:
+		return fmt.Errorf("live_sync: transport %q: must be %s or %s", l.Transport, LiveSyncPoll, LiveSyncSubscription)
+	}
+	if l.URL == "" {
+		return nil
+	}
+	if l.Transport != LiveSyncSubscription {
+		return fmt.Errorf("live_sync: url needs transport %s", LiveSyncSubscription)
+	}
+	u, err := url.Parse(l.URL)
+	if err != nil || (u.Scheme != "ws" && u.Scheme != "wss") || u.Host == "" {
+		return fmt.Errorf("live_sync: url %q: must be a ws:// or wss:// URL", l.URL)
+	}
+	return nil
+}
+
+// ViewConfig defines one custom view: a directory teams/{KEY}/views/{name}/
+// listing symlinks to the team's issues that match Filter (see internal/view
+// for the expression syntax). Filters are compiled — and a bad one fails the
+// mount — in fs.NewLinearFS, since config cannot import the api-dependent
+// view package.
+type ViewConfig struct {
+	Name   string `yaml:"name"`
+	Filter string `yaml:"filter"`
+}
+
+// RecurringConfig defines one recurring issue: on every occurrence of Schedule
+// (a five-field cron line, see internal/cron) the sync worker creates an issue
+// in Team from Template — issue markdown exactly as written to issues/_create,
+// where "{date}" expands to the occurrence's date (YYYY-MM-DD). The schedule
+// is read in TimeZone (an IANA name; empty = the mount's local time). Like
+// views, definitions are compiled — and a bad one fails the mount — in
+// fs.NewLinearFS.
+type RecurringConfig struct {
+	Name     string `yaml:"name"`
+	Team     string `yaml:"team"` // team key, e.g. ENG
+	Schedule string `yaml:"schedule"`
+	TimeZone string `yaml:"timezone"`
+	Template string `yaml:"template"`
+}
+
+// CycleReportConfig opts a team into cycle completion reports: when the sync
+// worker sees one of Team's cycles complete, it posts the cycle's report.md as
+// a status update on Project and/or Initiative — each named as its directory
+// is listed (teams/KEY/projects/, initiatives/) or by ID. Validated in
+// fs.NewLinearFS, like recurring issues.
+type CycleReportConfig struct {
+	Team       string `yaml:"team"` // team key, e.g. ENG
+	Project    string `yaml:"project"`
+	Initiative string `yaml:"initiative"`
+}
+
+// AttentionConfig sets the thresholds behind teams/{KEY}/needs-attention.md.
+// Zero takes the default (7 days, 24 hours).
+type AttentionConfig struct {
+	StaleDays       int `yaml:"stale_days"`        // a started issue untouched this long is stale
+	SLAWarningHours int `yaml:"sla_warning_hours"` // an SLA breaching within this window is at risk
+}
+
+// LintConfig selects the rules behind teams/{KEY}/lint.md. Every rule runs
+// unless named in Disable. UnassignedStates narrows the unassigned rule to
+// those workflow state names; empty checks every started state. Rule names
+// are checked in fs.NewLinearFS, which owns the rules.
+type LintConfig struct {
+	Disable          []string `yaml:"disable"`
+	UnassignedStates []string `yaml:"unassigned_states"`
+}
+
+// DisplayConfig sets how rendered files show timestamps. Timezone is an IANA
+// name ("Europe/Berlin", or "Local"); empty keeps UTC. TimeFormat is a Go time
+// layout for timestamps in generated bodies (history.md, attachments.md,
+// backlinks.md, issue.pdf); empty keeps RFC 3339. Frontmatter timestamps are
+// machine fields: they stay RFC 3339 and take only the timezone.
+//
+// ShowDeletedComments lists a deleted comment's tombstone in comments/ as a
+// read-only, struck-through file under its old name. Off, the name is simply
+// absent.
+//
+// CrossLinks renders issue identifiers and Linear issue URLs in issue.md and
+// comment bodies as relative links to the referenced issue.md, and turns them
+// back before a save reaches Linear.
+//
+// LocalImages points uploads.linear.app images in those bodies at the issue's
+// attachments/ entries, so a markdown previewer shows them from the cache; a
+// save turns the paths back into the CDN URLs.
+type DisplayConfig struct {
+	Timezone            string `yaml:"timezone"`
+	TimeFormat          string `yaml:"time_format"`
+	ShowDeletedComments bool   `yaml:"show_deleted_comments"`
+	CrossLinks          bool   `yaml:"cross_links"`
+	LocalImages         bool   `yaml:"local_images"`
+}
+
 func DefaultConfig() *Config {
 	return &Config{
+		OAuth: OAuthConfig{
+			RedirectURL: DefaultOAuthRedirectURL,
+			Scopes:      []string{"read", "write"},
+			TokenPath:   DefaultOAuthTokenPath(),
+		},
 		Cache: CacheConfig{
 			TTL:        60 * time.Second,
 			MaxEntries: 10000,
@@ -116,6 +506,16 @@ func DefaultRequestLogPath() string {
 	return filepath.Join(configDir, "linearfs", "requests.jsonl")
 }
 
+// DefaultOAuthTokenPath returns the default path `linearfs login` saves the
+// OAuth token to, next to the other linearfs state files.
+func DefaultOAuthTokenPath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		configDir = os.Getenv("HOME")
+	}
+	return filepath.Join(configDir, "linearfs", "oauth-token.json")
+}
+
 // Load loads configuration using the real environment and the default config
 // path. A missing default file is fine: defaults + env apply.
 func Load() (*Config, error) {
@@ -127,19 +527,29 @@ func Load() (*Config, error) {
 // asked for that exact file, so silently falling back to defaults would mount
 // with the wrong config. Environment variables still override.
 func LoadFrom(path string) (*Config, error) {
-	return loadPath(os.Getenv, path, true)
+	return loadPath(os.Getenv, path, true, "")
+}
+
+// LoadProfile is Load (path "") or LoadFrom (path set) with the named
+// profile applied (the --profile flag). An empty profile falls back to
+// LINEARFS_PROFILE, then to none.
+func LoadProfile(path, profile string) (*Config, error) {
+	if path == "" {
+		return loadPath(os.Getenv, getConfigPathWithEnv(os.Getenv), false, profile)
+	}
+	return loadPath(os.Getenv, path, true, profile)
 }
 
 // LoadWithEnv loads configuration using the provided environment lookup function.
 // This allows tests to provide isolated environment values.
 func LoadWithEnv(getenv func(string) string) (*Config, error) {
-	return loadPath(getenv, getConfigPathWithEnv(getenv), false)
+	return loadPath(getenv, getConfigPathWithEnv(getenv), false, "")
 }
 
-// loadPath reads path into DefaultConfig, then applies env overrides.
-// explicit governs the missing-file contract: the default path is optional,
-// a user-named path is not.
-func loadPath(getenv func(string) string, path string, explicit bool) (*Config, error) {
+// loadPath reads path into DefaultConfig, lays the selected profile over it,
+// then applies env overrides. explicit governs the missing-file contract: the
+// default path is optional, a user-named path is not.
+func loadPath(getenv func(string) string, path string, explicit bool, profile string) (*Config, error) {
 	cfg := DefaultConfig()
 
 	fileRead := false
@@ -154,14 +564,36 @@ func loadPath(getenv func(string) string, path string, explicit bool) (*Config,
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	// The api_key came from the file unless the env var overrides it below.
+	// The profile is applied before the secret checks below, so a key a
+	// profile carries is held to the same owner-only rule as a top-level one.
+	if profile == "" {
+		profile = getenv("LINEARFS_PROFILE")
+	}
+	if profile != "" {
+		if err := applyProfile(cfg, profile); err != nil {
+			return nil, fmt.Errorf("config file %s: %w", path, err)
+		}
+	}
+
+	// The api_key (or github token) came from the file unless the env var
+	// overrides it below.
 	keyFromFile := fileRead && cfg.APIKey != ""
+	tokenFromFile := fileRead && cfg.GitHub.Token != ""
+	secretFromFile := fileRead && (cfg.Webhook.Secret != "" || cfg.OAuth.ClientSecret != "")
 
 	// Environment variables override config file
 	if apiKey := getenv("LINEAR_API_KEY"); apiKey != "" {
 		cfg.APIKey = apiKey
 		keyFromFile = false
 	}
+	if token := getenv("LINEARFS_GITHUB_TOKEN"); token != "" {
+		cfg.GitHub.Token = token
+		tokenFromFile = false
+	}
+	if secret := getenv("LINEARFS_OAUTH_CLIENT_SECRET"); secret != "" {
+		cfg.OAuth.ClientSecret = secret
+		secretFromFile = fileRead && cfg.Webhook.Secret != ""
+	}
 
 	// #338: when the API key's source is the config file (not the env-var
 	// escape hatch), the file must be owner-only — group or other access to a
@@ -169,16 +601,103 @@ func loadPath(getenv func(string) string, path string, explicit bool) (*Config,
 	// deliberately untouched: the systemd EnvironmentFile is systemd's to
 	// protect, and an operator exporting LINEAR_API_KEY has opted out of the
 	// on-disk key entirely.
-	if keyFromFile {
+	// The github token, webhook secret, and OAuth client secret are the same
+	// kind of secret and get the same check.
+	if keyFromFile || tokenFromFile || secretFromFile {
 		if err := requireOwnerOnly(path); err != nil {
 			return nil, err
 		}
 	}
 
+	if err := ValidateIssueDirTemplate(cfg.Mount.IssueDirTemplate); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := ValidateIssueRmdir(cfg.Mount.IssueRmdir); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := ValidateMountRoot(cfg.Mount); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := ValidateWebhook(cfg.Webhook); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := ValidateLiveSync(cfg.LiveSync); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+	if err := ValidateOAuth(cfg.OAuth); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+
 	return cfg, nil
 }
 
-// requireOwnerOnly refuses a config file that holds the API key and is
+// applyProfile lays profile name over cfg. yaml.v3 decodes a mapping onto the
+// existing struct key by key, so the fields a profile leaves out keep their
+// top-level values (lists, like teams, are replaced whole).
+func applyProfile(cfg *Config, name string) error {
+	node, ok := cfg.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(cfg.Profiles))
+		for n := range cfg.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return fmt.Errorf("profile %q: no profiles defined", name)
+		}
+		return fmt.Errorf("profile %q not defined (have: %s)", name, strings.Join(names, ", "))
+	}
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("profile %q: must be a mapping of config keys", name)
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == "profiles" {
+			return fmt.Errorf("profile %q: profiles cannot nest", name)
+		}
+	}
+	if err := node.Decode(cfg); err != nil {
+		return fmt.Errorf("profile %q: %w", name, err)
+	}
+	if !mappingHas(&node, "cache", "db_path") {
+		if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+			return fmt.Errorf("profile %q: name must not be a path (or set cache.db_path)", name)
+		}
+		cfg.Cache.DBPath = DefaultProfileDBPath(name)
+	}
+	return nil
+}
+
+// mappingHas reports whether the key path is set in a YAML mapping node.
+func mappingHas(node *yaml.Node, path ...string) bool {
+	for _, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return false
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = node.Content[i+1]
+			}
+		}
+		if next == nil {
+			return false
+		}
+		node = next
+	}
+	return true
+}
+
+// DefaultProfileDBPath is a profile's cache.db when it sets no
+// cache.db_path: its own directory beside the default cache.
+func DefaultProfileDBPath(profile string) string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		configDir = os.Getenv("HOME")
+	}
+	return filepath.Join(configDir, "linearfs", "profiles", profile, "cache.db")
+}
+
+// requireOwnerOnly refuses a config file that holds a secret and is
 // accessible to group or other (mode & 0o077 != 0). The error names the fix so
 // an operator can act on it directly.
 func requireOwnerOnly(path string) error {
@@ -188,7 +707,7 @@ func requireOwnerOnly(path string) error {
 	}
 	if perm := info.Mode().Perm(); perm&0o077 != 0 {
 		return fmt.Errorf(
-			"config file %s is group/other-accessible (mode %04o) but holds an api_key; "+
+			"config file %s is group/other-accessible (mode %04o) but holds an api_key or github token; "+
 				"refusing to load — run: chmod 600 %s",
 			path, perm, path)
 	}
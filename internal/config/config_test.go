@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -613,3 +614,344 @@ func TestLoadRefusesLooseKeyFile(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadGitHubToken(t *testing.T) {
+	t.Parallel()
+
+	writeConfig := func(t *testing.T, content string, mode os.FileMode) string {
+		tmpDir := t.TempDir()
+		configDir := filepath.Join(tmpDir, "linearfs")
+		if err := os.MkdirAll(configDir, 0700); err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chmod(path, mode); err != nil {
+			t.Fatal(err)
+		}
+		return tmpDir
+	}
+
+	t.Run("default is off", func(t *testing.T) {
+		if tok := DefaultConfig().GitHub.Token; tok != "" {
+			t.Errorf("default GitHub.Token = %q, want empty (enrichment off)", tok)
+		}
+	})
+
+	t.Run("file token", func(t *testing.T) {
+		tmpDir := writeConfig(t, "github:\n  token: ghp_file\n", 0600)
+		cfg, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir}))
+		if err != nil {
+			t.Fatalf("LoadWithEnv() error: %v", err)
+		}
+		if cfg.GitHub.Token != "ghp_file" {
+			t.Errorf("GitHub.Token = %q, want ghp_file", cfg.GitHub.Token)
+		}
+	})
+
+	t.Run("env overrides file", func(t *testing.T) {
+		tmpDir := writeConfig(t, "github:\n  token: ghp_file\n", 0600)
+		cfg, err := LoadWithEnv(mockEnv(map[string]string{
+			"XDG_CONFIG_HOME":       tmpDir,
+			"LINEARFS_GITHUB_TOKEN": "ghp_env",
+		}))
+		if err != nil {
+			t.Fatalf("LoadWithEnv() error: %v", err)
+		}
+		if cfg.GitHub.Token != "ghp_env" {
+			t.Errorf("GitHub.Token = %q, want ghp_env", cfg.GitHub.Token)
+		}
+	})
+
+	t.Run("loose file holding a token is refused", func(t *testing.T) {
+		tmpDir := writeConfig(t, "github:\n  token: ghp_file\n", 0644)
+		_, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir}))
+		if err == nil || !strings.Contains(err.Error(), "chmod 600") {
+			t.Fatalf("LoadWithEnv() with 0644 token file: err = %v, want owner-only refusal", err)
+		}
+	})
+}
+
+func TestValidateIssueDirTemplate(t *testing.T) {
+	t.Parallel()
+	for _, ok := range []string{"", "{identifier}", "{identifier}-{slugified-title}", "{slugified-title} ({identifier})", "{identifier}{flags}"} {
+		if err := ValidateIssueDirTemplate(ok); err != nil {
+			t.Errorf("ValidateIssueDirTemplate(%q) = %v, want nil", ok, err)
+		}
+	}
+	for _, bad := range []string{
+		"{slugified-title}",              // no identifier: names could not map back
+		"{identifier}-{identifier}",      // ambiguous
+		"{identifier}/{slugified-title}", // path separator
+		"{identifier}-{title}",           // unknown placeholder
+	} {
+		if err := ValidateIssueDirTemplate(bad); err == nil {
+			t.Errorf("ValidateIssueDirTemplate(%q) = nil, want error", bad)
+		}
+	}
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "linearfs")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := "mount:\n  issue_dir_template: \"{identifier}-{slugified-title}\"\n"
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir}))
+	if err != nil {
+		t.Fatalf("LoadWithEnv() error: %v", err)
+	}
+	if got := cfg.Mount.IssueDirTemplate; got != "{identifier}-{slugified-title}" {
+		t.Errorf("IssueDirTemplate = %q", got)
+	}
+}
+
+func TestValidateIssueRmdir(t *testing.T) {
+	t.Parallel()
+	for _, ok := range []string{"", IssueRmdirArchive, IssueRmdirTrash, IssueRmdirDeny} {
+		if err := ValidateIssueRmdir(ok); err != nil {
+			t.Errorf("ValidateIssueRmdir(%q) = %v, want nil", ok, err)
+		}
+	}
+	if err := ValidateIssueRmdir("delete"); err == nil {
+		t.Error(`ValidateIssueRmdir("delete") = nil, want error`)
+	}
+
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "linearfs")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte("mount:\n  issue_rmdir: trsh\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir})); err == nil || !strings.Contains(err.Error(), "issue_rmdir") {
+		t.Errorf("LoadWithEnv() with a bad issue_rmdir: err = %v, want issue_rmdir error", err)
+	}
+}
+
+func TestValidateMountRoot(t *testing.T) {
+	t.Parallel()
+	for _, ok := range []string{"", "teams/ENG", "/teams/ENG/", "teams/ENG/projects/my-project"} {
+		if err := ValidateMountRoot(MountConfig{Root: ok}); err != nil {
+			t.Errorf("ValidateMountRoot(%q) = %v, want nil", ok, err)
+		}
+	}
+	for _, bad := range []string{"teams", "teams/", "users/alice", "teams/ENG/issues", "teams/ENG/projects/", "teams/ENG/projects/p/docs"} {
+		if err := ValidateMountRoot(MountConfig{Root: bad}); err == nil {
+			t.Errorf("ValidateMountRoot(%q) = nil, want error", bad)
+		}
+	}
+	if err := ValidateMountRoot(MountConfig{Root: "teams/ENG", ConfirmDeletes: time.Minute}); err == nil || !strings.Contains(err.Error(), "confirm_deletes") {
+		t.Errorf("ValidateMountRoot with confirm_deletes = %v, want a confirm_deletes error", err)
+	}
+}
+
+func TestMountRootTeam(t *testing.T) {
+	t.Parallel()
+	for root, want := range map[string]string{
+		"":                       "",
+		"teams/ENG":              "ENG",
+		"/teams/ENG/projects/p/": "ENG",
+		"teams/🚀 ENG":            "ENG",
+		"users/alice":            "",
+		"teams/ENG/projects/p/x": "",
+	} {
+		if got := MountRootTeam(root); got != want {
+			t.Errorf("MountRootTeam(%q) = %q, want %q", root, got, want)
+		}
+	}
+}
+
+func TestValidateWebhook(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		w       WebhookConfig
+		wantErr bool
+	}{
+		{name: "off", w: WebhookConfig{}},
+		{name: "registered", w: WebhookConfig{Listen: ":8787", URL: "https://hooks.example.com/linear"}},
+		{name: "registered by hand", w: WebhookConfig{Listen: ":8787", Secret: "s3cret"}},
+		{name: "unverifiable listener", w: WebhookConfig{Listen: ":8787"}, wantErr: true},
+		{name: "url without listener", w: WebhookConfig{URL: "https://hooks.example.com/linear"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := ValidateWebhook(tt.w); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWebhook(%+v) = %v, wantErr %v", tt.w, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateLiveSync(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		l       LiveSyncConfig
+		wantErr bool
+	}{
+		{name: "default", l: LiveSyncConfig{}},
+		{name: "poll", l: LiveSyncConfig{Transport: "poll"}},
+		{name: "subscription", l: LiveSyncConfig{Transport: "subscription"}},
+		{name: "subscription url", l: LiveSyncConfig{Transport: "subscription", URL: "ws://localhost:4000/graphql"}},
+		{name: "unknown transport", l: LiveSyncConfig{Transport: "websocket"}, wantErr: true},
+		{name: "url without subscription", l: LiveSyncConfig{URL: "wss://api.linear.app/graphql"}, wantErr: true},
+		{name: "http url", l: LiveSyncConfig{Transport: "subscription", URL: "https://api.linear.app/graphql"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := ValidateLiveSync(tt.l); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLiveSync(%+v) = %v, wantErr %v", tt.l, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadOAuth(t *testing.T) {
+	t.Parallel()
+
+	writeConfig := func(t *testing.T, content string, mode os.FileMode) string {
+		tmpDir := t.TempDir()
+		configDir := filepath.Join(tmpDir, "linearfs")
+		if err := os.MkdirAll(configDir, 0700); err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(configDir, "config.yaml")
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chmod(path, mode); err != nil {
+			t.Fatal(err)
+		}
+		return tmpDir
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		o := DefaultConfig().OAuth
+		if o.ClientID != "" || o.RedirectURL != DefaultOAuthRedirectURL || o.TokenPath != DefaultOAuthTokenPath() {
+			t.Errorf("default OAuth = %+v", o)
+		}
+	})
+
+	t.Run("client id keeps defaults", func(t *testing.T) {
+		tmpDir := writeConfig(t, "oauth:\n  client_id: abc\n", 0644)
+		cfg, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir}))
+		if err != nil {
+			t.Fatalf("LoadWithEnv() error: %v", err)
+		}
+		if cfg.OAuth.ClientID != "abc" || cfg.OAuth.RedirectURL != DefaultOAuthRedirectURL {
+			t.Errorf("OAuth = %+v", cfg.OAuth)
+		}
+	})
+
+	t.Run("loose file holding a client secret is refused", func(t *testing.T) {
+		tmpDir := writeConfig(t, "oauth:\n  client_id: abc\n  client_secret: s3cret\n", 0644)
+		_, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir}))
+		if err == nil || !strings.Contains(err.Error(), "chmod 600") {
+			t.Fatalf("LoadWithEnv() with 0644 secret file: err = %v, want owner-only refusal", err)
+		}
+	})
+
+	t.Run("env overrides file secret", func(t *testing.T) {
+		tmpDir := writeConfig(t, "oauth:\n  client_id: abc\n  client_secret: s3cret\n", 0644)
+		cfg, err := LoadWithEnv(mockEnv(map[string]string{
+			"XDG_CONFIG_HOME":              tmpDir,
+			"LINEARFS_OAUTH_CLIENT_SECRET": "env-secret",
+		}))
+		if err != nil {
+			t.Fatalf("LoadWithEnv() error: %v", err)
+		}
+		if cfg.OAuth.ClientSecret != "env-secret" {
+			t.Errorf("OAuth.ClientSecret = %q, want env-secret", cfg.OAuth.ClientSecret)
+		}
+	})
+
+	t.Run("secret without client id", func(t *testing.T) {
+		tmpDir := writeConfig(t, "oauth:\n  client_secret: s3cret\n", 0600)
+		if _, err := LoadWithEnv(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir})); err == nil {
+			t.Fatal("LoadWithEnv() with client_secret and no client_id: want error")
+		}
+	})
+}
+
+func TestLoadProfile(t *testing.T) {
+	t.Parallel()
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "linearfs")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := `api_key: base-key
+teams: [ENG, OPS]
+mount:
+  default_path: ~/linear
+  icon_prefix: true
+profiles:
+  client-a:
+    api_key: client-key
+    teams: [CLI]
+    mount:
+      default_path: ~/client-a
+  dashboard:
+    cache:
+      db_path: /tmp/dashboard.db
+`
+	path := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	load := func(profile string) (*Config, error) {
+		return loadPath(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir}), path, true, profile)
+	}
+
+	base, err := load("")
+	if err != nil {
+		t.Fatalf("no profile: %v", err)
+	}
+	if base.APIKey != "base-key" || base.Cache.DBPath != "" {
+		t.Errorf("no profile: APIKey %q, DBPath %q", base.APIKey, base.Cache.DBPath)
+	}
+
+	cfg, err := load("client-a")
+	if err != nil {
+		t.Fatalf("client-a: %v", err)
+	}
+	if cfg.APIKey != "client-key" || !slices.Equal(cfg.Teams, []string{"CLI"}) {
+		t.Errorf("client-a: APIKey %q, Teams %v", cfg.APIKey, cfg.Teams)
+	}
+	// The overlay is key by key: the nested mount block keeps icon_prefix.
+	if cfg.Mount.DefaultPath != "~/client-a" || !cfg.Mount.IconPrefix {
+		t.Errorf("client-a: Mount = %+v", cfg.Mount)
+	}
+	if cfg.Cache.DBPath != DefaultProfileDBPath("client-a") {
+		t.Errorf("client-a: DBPath = %q, want its own default", cfg.Cache.DBPath)
+	}
+
+	cfg, err = load("dashboard")
+	if err != nil {
+		t.Fatalf("dashboard: %v", err)
+	}
+	if cfg.APIKey != "base-key" || cfg.Cache.DBPath != "/tmp/dashboard.db" || len(cfg.Teams) != 2 {
+		t.Errorf("dashboard: APIKey %q, DBPath %q, Teams %v", cfg.APIKey, cfg.Cache.DBPath, cfg.Teams)
+	}
+
+	cfg, err = loadPath(mockEnv(map[string]string{"XDG_CONFIG_HOME": tmpDir, "LINEARFS_PROFILE": "client-a"}), path, true, "")
+	if err != nil {
+		t.Fatalf("LINEARFS_PROFILE: %v", err)
+	}
+	if cfg.APIKey != "client-key" {
+		t.Errorf("LINEARFS_PROFILE: APIKey %q, want client-key", cfg.APIKey)
+	}
+
+	if _, err := load("missing"); err == nil || !strings.Contains(err.Error(), "client-a, dashboard") {
+		t.Errorf("unknown profile: err = %v, want the defined names", err)
+	}
+}
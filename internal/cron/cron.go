@@ -0,0 +1,169 @@
+// Package cron parses the five-field schedules behind config-defined
+// recurring issues (the `recurring:` config). It is pure: no SQLite, no API —
+// the sync worker asks a Schedule for its latest occurrence and decides what
+// to create.
+//
+// A schedule is the classic crontab line, evaluated in the mount's local time:
+//
+//	minute hour day-of-month month day-of-week
+//	0      9    *            *     1          (Mondays at 09:00)
+//
+// Each field is "*", a number, a range "a-b", or a comma-separated list of
+// those, each optionally stepped with "/n" ("*/15", "1-5/2"). Day-of-week runs
+// 0-6 from Sunday (7 is also Sunday). As in cron, when both day-of-month and
+// day-of-week are restricted a day matching either one qualifies. The
+// shorthands @hourly, @daily, @weekly, @monthly, and @yearly are accepted.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed five-field schedule.
+type Schedule struct {
+	spec                          string
+	minute, hour, dom, month, dow uint64 // bit i set = value i allowed
+	domRestricted, dowRestricted  bool
+}
+
+var shorthands = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+// Parse parses a schedule.
+func Parse(spec string) (*Schedule, error) {
+	expr := strings.TrimSpace(spec)
+	if s, ok := shorthands[expr]; ok {
+		expr = s
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q: want 5 fields (minute hour day-of-month month day-of-week), got %d", spec, len(fields))
+	}
+	s := &Schedule{spec: spec}
+	var err error
+	if s.minute, err = parseField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("schedule %q: minute: %w", spec, err)
+	}
+	if s.hour, err = parseField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("schedule %q: hour: %w", spec, err)
+	}
+	if s.dom, err = parseField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("schedule %q: day-of-month: %w", spec, err)
+	}
+	if s.month, err = parseField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("schedule %q: month: %w", spec, err)
+	}
+	if s.dow, err = parseField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("schedule %q: day-of-week: %w", spec, err)
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1 // 7 is Sunday too
+	}
+	s.domRestricted = fields[2] != "*"
+	s.dowRestricted = fields[4] != "*"
+	return s, nil
+}
+
+// parseField parses one field into a bitmask over [min, max].
+func parseField(field string, min, max int) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("bad step in %q", part)
+			}
+			rng, step = part[:i], n
+		}
+		lo, hi := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+			var errA, errB error
+			lo, errA = strconv.Atoi(a)
+			hi, errB = strconv.Atoi(b)
+			if errA != nil || errB != nil || lo > hi {
+				return 0, fmt.Errorf("bad range %q", rng)
+			}
+		default:
+			n, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("bad value %q", rng)
+			}
+			lo, hi = n, n
+			if step > 1 {
+				hi = max // "5/15" means from 5 on, every 15
+			}
+		}
+		if lo < min || hi > max {
+			return 0, fmt.Errorf("%q out of range %d-%d", rng, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			mask |= 1 << v
+		}
+	}
+	return mask, nil
+}
+
+// String returns the schedule as written.
+func (s *Schedule) String() string { return s.spec }
+
+// maxLookback bounds Prev's search; every valid schedule fires at least once
+// in any eight-year span (Feb 29 recurs within that).
+const maxLookback = 8 * 366
+
+// Prev returns the latest occurrence at or before t (truncated to the
+// minute), in t's location, or the zero time when the schedule can never fire
+// (e.g. "0 0 31 2 *").
+func (s *Schedule) Prev(t time.Time) time.Time {
+	t = t.Truncate(time.Minute)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for i := 0; i < maxLookback; i++ {
+		if s.dayMatches(day) {
+			lastHour, lastMinute := 23, 59
+			if i == 0 {
+				lastHour, lastMinute = t.Hour(), t.Minute()
+			}
+			for h := lastHour; h >= 0; h-- {
+				if s.hour&(1<<h) == 0 {
+					continue
+				}
+				m := 59
+				if h == lastHour {
+					m = lastMinute
+				}
+				for ; m >= 0; m-- {
+					if s.minute&(1<<m) != 0 {
+						return time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, day.Location())
+					}
+				}
+			}
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return time.Time{}
+}
+
+// dayMatches applies cron's day rule: month must match, then day-of-month
+// and day-of-week — either one when both are restricted.
+func (s *Schedule) dayMatches(day time.Time) bool {
+	if s.month&(1<<int(day.Month())) == 0 {
+		return false
+	}
+	domOK := s.dom&(1<<day.Day()) != 0
+	dowOK := s.dow&(1<<int(day.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return domOK || dowOK
+	}
+	return domOK && dowOK
+}
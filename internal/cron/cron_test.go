@@ -0,0 +1,68 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	for _, spec := range []string{
+		"",
+		"* * * *",     // four fields
+		"60 * * * *",  // minute out of range
+		"* 24 * * *",  // hour out of range
+		"* * 0 * *",   // day-of-month starts at 1
+		"* * * 13 *",  // month out of range
+		"* * * * 8",   // day-of-week out of range
+		"5-1 * * * *", // inverted range
+		"*/0 * * * *", // zero step
+		"x * * * *",   // not a number
+		"@fortnightly",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) = nil error, want error", spec)
+		}
+	}
+}
+
+func TestPrev(t *testing.T) {
+	t.Parallel()
+	// Wednesday 2026-10-14 10:30 UTC.
+	now := time.Date(2026, 10, 14, 10, 30, 45, 0, time.UTC)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"0 9 * * 1", at(12, 9, 0)},      // Mondays 09:00 → this Monday
+		{"0 9 * * 1-5", at(14, 9, 0)},    // weekdays 09:00 → today
+		{"30 10 * * *", at(14, 10, 30)},  // the current minute counts
+		{"31 10 * * *", at(13, 10, 31)},  // a minute ahead → yesterday
+		{"*/15 * * * *", at(14, 10, 30)}, // step
+		{"0 0 1 * *", at(1, 0, 0)},       // first of the month
+		{"@weekly", at(11, 0, 0)},        // Sunday midnight
+		{"0 12 13 * 5", at(13, 12, 0)},   // dom OR dow: the 13th (a Tuesday)
+		{"0 0 * * 7", at(11, 0, 0)},      // 7 is Sunday
+		{"0 8 1 1 *", time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		s, err := Parse(tc.spec)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tc.spec, err)
+		}
+		if got := s.Prev(now); !got.Equal(tc.want) {
+			t.Errorf("Prev(%q) = %v, want %v", tc.spec, got, tc.want)
+		}
+	}
+
+	never, err := Parse("0 0 31 2 *")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got := never.Prev(now); !got.IsZero() {
+		t.Errorf("Prev of an impossible date = %v, want zero", got)
+	}
+}
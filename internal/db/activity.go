@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// ActivityDaysKept bounds the days of activity kept for /activity/; recording
+// drops the days before the newest ActivityDaysKept (by today's date).
+const ActivityDaysKept = 90
+
+// ActivityDayFormat is an activity day: the UTC date of the change.
+const ActivityDayFormat = "2006-01-02"
+
+// RecordIssueActivity keeps what changed between prev (the cached row, nil
+// for an issue new to the cache) and cur for the workspace changelog: a
+// creation, a completion, a state change. Each is stamped with its own time
+// (createdAt, completedAt, updatedAt), so the same change observed by both a
+// webhook delivery and a sync is kept once.
+func (s *Store) RecordIssueActivity(ctx context.Context, prev, cur *api.Issue) error {
+	q := s.Queries()
+	record := func(kind string, at time.Time, from, to string) error {
+		at = at.UTC()
+		teamKey := ""
+		if cur.Team != nil {
+			teamKey = cur.Team.Key
+		}
+		return q.InsertActivity(ctx, InsertActivityParams{
+			IssueID:    cur.ID,
+			Kind:       kind,
+			At:         at,
+			Day:        at.Format(ActivityDayFormat),
+			Identifier: cur.Identifier,
+			TeamKey:    teamKey,
+			Title:      cur.Title,
+			FromState:  from,
+			ToState:    to,
+		})
+	}
+	if prev == nil {
+		if err := record("created", cur.CreatedAt, "", cur.State.Name); err != nil {
+			return err
+		}
+	} else if prev.State.ID != cur.State.ID {
+		if err := record("state", cur.UpdatedAt, prev.State.Name, cur.State.Name); err != nil {
+			return err
+		}
+	}
+	if cur.CompletedAt != nil && (prev == nil || prev.CompletedAt == nil) {
+		if err := record("completed", *cur.CompletedAt, "", cur.State.Name); err != nil {
+			return err
+		}
+	}
+	return q.PruneActivity(ctx, Now().UTC().AddDate(0, 0, 1-ActivityDaysKept).Format(ActivityDayFormat))
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestRecordIssueActivity: a new issue records its creation, a state change
+// and a completion record once each however often they are observed, and
+// days past the bound are dropped.
+func TestRecordIssueActivity(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+	today := Now().UTC().Truncate(24 * time.Hour)
+	day := today.Format(ActivityDayFormat)
+
+	todo := api.State{ID: "s-todo", Name: "Todo"}
+	done := api.State{ID: "s-done", Name: "Done"}
+	team := &api.Team{Key: "ENG"}
+	created := api.Issue{ID: "i1", Identifier: "ENG-1", Title: "Fix login", Team: team, State: todo,
+		CreatedAt: today.Add(9 * time.Hour), UpdatedAt: today.Add(9 * time.Hour)}
+	retitled := created
+	retitled.Title = "Fix login redirect"
+	retitled.UpdatedAt = today.Add(10 * time.Hour)
+	completedAt := today.Add(11 * time.Hour)
+	completed := retitled
+	completed.State, completed.CompletedAt, completed.UpdatedAt = done, &completedAt, completedAt
+
+	record := func(prev, cur *api.Issue) {
+		t.Helper()
+		if err := store.RecordIssueActivity(ctx, prev, cur); err != nil {
+			t.Fatalf("RecordIssueActivity: %v", err)
+		}
+	}
+	record(nil, &created)
+	record(&created, &retitled)
+	record(&retitled, &completed)
+	record(&retitled, &completed) // a webhook and a sync see the same change
+
+	rows, err := store.Queries().ListActivityByDay(ctx, day)
+	if err != nil {
+		t.Fatalf("ListActivityByDay: %v", err)
+	}
+	var got []string
+	for _, r := range rows {
+		got = append(got, r.Kind+" "+r.Identifier+" "+r.FromState+">"+r.ToState)
+	}
+	want := []string{"created ENG-1 >Todo", "completed ENG-1 >Done", "state ENG-1 Todo>Done"}
+	if len(got) != len(want) {
+		t.Fatalf("activity = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("activity[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if rows[0].TeamKey != "ENG" || rows[0].Title != "Fix login" {
+		t.Errorf("created row = %+v", rows[0])
+	}
+
+	old := api.Issue{ID: "i2", Identifier: "ENG-2", Team: team, State: todo, CreatedAt: today.AddDate(0, 0, -ActivityDaysKept)}
+	record(nil, &old)
+	days, err := store.Queries().ListActivityDays(ctx)
+	if err != nil {
+		t.Fatalf("ListActivityDays: %v", err)
+	}
+	if len(days) != 1 || days[0] != day {
+		t.Errorf("days = %v, want only %s", days, day)
+	}
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"encoding/json"
+	"fmt"
 
 	"github.com/jra3/linear-fuse/internal/api"
 )
@@ -112,6 +113,40 @@ func DBIssuesToAPIIssues(issues []Issue) ([]api.Issue, error) {
 	return result, nil
 }
 
+// APIIssueToDBArchivedIssue converts an archived api.Issue (ArchivedAt set)
+// to the params keeping it in archived_issues.
+func APIIssueToDBArchivedIssue(issue api.Issue) (UpsertArchivedIssueParams, error) {
+	if issue.ArchivedAt == nil {
+		return UpsertArchivedIssueParams{}, fmt.Errorf("issue %s is not archived", issue.Identifier)
+	}
+	data, err := json.Marshal(issue)
+	if err != nil {
+		return UpsertArchivedIssueParams{}, err
+	}
+	p := UpsertArchivedIssueParams{
+		ID:         issue.ID,
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		ArchivedAt: *issue.ArchivedAt,
+		UpdatedAt:  issue.UpdatedAt,
+		SyncedAt:   Now(),
+		Data:       data,
+	}
+	if issue.Team != nil {
+		p.TeamID = issue.Team.ID
+	}
+	return p, nil
+}
+
+// DBArchivedIssueToAPIIssue converts an archived_issues row back to api.Issue.
+func DBArchivedIssueToAPIIssue(issue ArchivedIssue) (api.Issue, error) {
+	var apiIssue api.Issue
+	if err := json.Unmarshal(issue.Data, &apiIssue); err != nil {
+		return api.Issue{}, err
+	}
+	return apiIssue, nil
+}
+
 // APITeamToDBTeam converts an api.Team to db.UpsertTeamParams
 func APITeamToDBTeam(team api.Team) UpsertTeamParams {
 	return UpsertTeamParams{
@@ -127,19 +162,25 @@ func APITeamToDBTeam(team api.Team) UpsertTeamParams {
 			Time:  team.UpdatedAt,
 			Valid: !team.UpdatedAt.IsZero(),
 		},
-		SyncedAt: Now(),
+		SyncedAt:    Now(),
+		Private:     boolToInt64(team.Private),
+		Color:       sql.NullString{String: team.Color, Valid: team.Color != ""},
+		Description: sql.NullString{String: team.Description, Valid: team.Description != ""},
 	}
 }
 
 // DBTeamToAPITeam converts a db.Team to api.Team
 func DBTeamToAPITeam(team Team) api.Team {
 	return api.Team{
-		ID:        team.ID,
-		Key:       team.Key,
-		Name:      team.Name,
-		Icon:      team.Icon.String,
-		CreatedAt: team.CreatedAt.Time,
-		UpdatedAt: team.UpdatedAt.Time,
+		ID:          team.ID,
+		Key:         team.Key,
+		Name:        team.Name,
+		Icon:        team.Icon.String,
+		Color:       team.Color.String,
+		Description: team.Description.String,
+		Private:     team.Private != 0,
+		CreatedAt:   team.CreatedAt.Time,
+		UpdatedAt:   team.UpdatedAt.Time,
 	}
 }
 
@@ -422,7 +463,7 @@ func APICycleToDBCycle(cycle api.Cycle, teamID string) (UpsertCycleParams, error
 	if err != nil {
 		return UpsertCycleParams{}, err
 	}
-	return UpsertCycleParams{
+	params := UpsertCycleParams{
 		ID:       cycle.ID,
 		TeamID:   teamID,
 		Number:   int64(cycle.Number),
@@ -431,7 +472,11 @@ func APICycleToDBCycle(cycle api.Cycle, teamID string) (UpsertCycleParams, error
 		EndsAt:   sql.NullTime{Time: cycle.EndsAt, Valid: !cycle.EndsAt.IsZero()},
 		SyncedAt: Now(),
 		Data:     data,
-	}, nil
+	}
+	if cycle.CompletedAt != nil {
+		params.CompletedAt = sql.NullTime{Time: *cycle.CompletedAt, Valid: true}
+	}
+	return params, nil
 }
 
 // DBCycleToAPICycle converts a db.Cycle to api.Cycle.
@@ -450,6 +495,10 @@ func DBCycleToAPICycle(cycle Cycle) api.Cycle {
 	c.Name = NullStringValue(cycle.Name)
 	c.StartsAt = cycle.StartsAt.Time
 	c.EndsAt = cycle.EndsAt.Time
+	if cycle.CompletedAt.Valid {
+		t := cycle.CompletedAt.Time
+		c.CompletedAt = &t
+	}
 	return c
 }
 
@@ -554,6 +603,10 @@ func DBCommentToAPIComment(comment Comment) (api.Comment, error) {
 	if err := json.Unmarshal(comment.Data, &apiComment); err != nil {
 		return api.Comment{}, err
 	}
+	if comment.DeletedAt.Valid {
+		deletedAt := comment.DeletedAt.Time
+		apiComment.DeletedAt = &deletedAt
+	}
 	return apiComment, nil
 }
 
@@ -663,6 +716,9 @@ func APIInitiativeToDBInitiative(initiative api.Initiative) (UpsertInitiativePar
 	if initiative.Owner != nil {
 		params.OwnerID = sql.NullString{String: initiative.Owner.ID, Valid: true}
 	}
+	if initiative.Parent != nil {
+		params.ParentID = sql.NullString{String: initiative.Parent.ID, Valid: true}
+	}
 	return params, nil
 }
 
@@ -31,12 +31,14 @@ func TestDBTeamToAPITeam(t *testing.T) {
 	now := time.Now()
 
 	team := Team{
-		ID:        "team-1",
-		Key:       "TST",
-		Name:      "Test Team",
-		Icon:      toNullString(strPtr("icon")),
-		CreatedAt: toNullTime(&now),
-		UpdatedAt: toNullTime(&now),
+		ID:          "team-1",
+		Key:         "TST",
+		Name:        "Test Team",
+		Icon:        toNullString(strPtr("icon")),
+		Color:       toNullString(strPtr("#5e6ad2")),
+		Description: toNullString(strPtr("Platform work")),
+		CreatedAt:   toNullTime(&now),
+		UpdatedAt:   toNullTime(&now),
 	}
 
 	apiTeam := DBTeamToAPITeam(team)
@@ -53,6 +55,12 @@ func TestDBTeamToAPITeam(t *testing.T) {
 	if apiTeam.Icon != team.Icon.String {
 		t.Errorf("Icon mismatch: got %s, want %s", apiTeam.Icon, team.Icon.String)
 	}
+	if apiTeam.Color != "#5e6ad2" || apiTeam.Description != "Platform work" {
+		t.Errorf("Color, Description = %q, %q", apiTeam.Color, apiTeam.Description)
+	}
+	if back := APITeamToDBTeam(apiTeam); back.Color != team.Color || back.Description != team.Description {
+		t.Errorf("APITeamToDBTeam color, description = %v, %v", back.Color, back.Description)
+	}
 }
 
 func TestDBTeamsToAPITeams(t *testing.T) {
@@ -343,6 +351,24 @@ func TestAPICycleToDBCycle(t *testing.T) {
 	if params.Name.String != cycle.Name {
 		t.Errorf("Name mismatch")
 	}
+	if params.CompletedAt.Valid {
+		t.Errorf("CompletedAt set for a running cycle")
+	}
+
+	// completedAt lands in its column and overlays back: the sync worker's
+	// cycle reports key off it.
+	cycle.CompletedAt = &cycle.EndsAt
+	params, err = APICycleToDBCycle(cycle, "team-1")
+	if err != nil {
+		t.Fatalf("APICycleToDBCycle failed: %v", err)
+	}
+	if !params.CompletedAt.Valid || !params.CompletedAt.Time.Equal(cycle.EndsAt) {
+		t.Errorf("CompletedAt = %v, want %v", params.CompletedAt, cycle.EndsAt)
+	}
+	back := DBCycleToAPICycle(Cycle{ID: params.ID, CompletedAt: params.CompletedAt})
+	if back.CompletedAt == nil || !back.CompletedAt.Equal(cycle.EndsAt) {
+		t.Errorf("round-tripped CompletedAt = %v", back.CompletedAt)
+	}
 }
 
 func TestDBCycleToAPICycle(t *testing.T) {
@@ -51,6 +51,76 @@ func TestDeleteComment(t *testing.T) {
 	}
 }
 
+// TestPruneIssueCommentsTombstones: the details-sync prune stamps deleted_at
+// on comments the fetch no longer returned instead of dropping them, so the
+// comments/ listing (ListIssueCommentsWithDeleted) keeps their slots while
+// every other reader (ListIssueComments) stops seeing them. The stamp is set
+// once, and a comment that comes back is revived by its upsert.
+func TestPruneIssueCommentsTombstones(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	before := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := before.Add(time.Hour)
+	upsert := func(id string, syncedAt time.Time) {
+		t.Helper()
+		if err := store.Queries().UpsertComment(ctx, UpsertCommentParams{
+			ID: id, IssueID: "issue-1", Body: "Body of " + id,
+			CreatedAt: before, UpdatedAt: before, SyncedAt: syncedAt,
+			Data: json.RawMessage("{}"),
+		}); err != nil {
+			t.Fatalf("UpsertComment %s: %v", id, err)
+		}
+	}
+	prune := func(at time.Time) {
+		t.Helper()
+		if err := store.Queries().PruneIssueComments(ctx, PruneIssueCommentsParams{
+			DeletedAt: sql.NullTime{Time: at, Valid: true}, IssueID: "issue-1", SyncedAt: cutoff,
+		}); err != nil {
+			t.Fatalf("PruneIssueComments: %v", err)
+		}
+	}
+
+	upsert("gone", before)                  // not seen by the sync below
+	upsert("kept", cutoff.Add(time.Minute)) // re-upserted by the sync
+	deletedAt := cutoff.Add(2 * time.Minute)
+	prune(deletedAt)
+
+	live, _ := store.Queries().ListIssueComments(ctx, "issue-1")
+	if len(live) != 1 || live[0].ID != "kept" {
+		t.Fatalf("live comments = %v, want only kept", live)
+	}
+	all, _ := store.Queries().ListIssueCommentsWithDeleted(ctx, "issue-1")
+	if len(all) != 2 {
+		t.Fatalf("comments with deleted = %d, want 2 (tombstone kept)", len(all))
+	}
+	for _, c := range all {
+		if c.ID == "gone" && !c.DeletedAt.Time.Equal(deletedAt) {
+			t.Errorf("tombstone deleted_at = %v, want %v", c.DeletedAt, deletedAt)
+		}
+		if c.ID == "kept" && c.DeletedAt.Valid {
+			t.Errorf("kept comment tombstoned at %v", c.DeletedAt.Time)
+		}
+	}
+
+	// A later prune keeps the first deletion time.
+	prune(deletedAt.Add(time.Hour))
+	all, _ = store.Queries().ListIssueCommentsWithDeleted(ctx, "issue-1")
+	for _, c := range all {
+		if c.ID == "gone" && !c.DeletedAt.Time.Equal(deletedAt) {
+			t.Errorf("re-prune moved deleted_at to %v", c.DeletedAt.Time)
+		}
+	}
+
+	// Seen again (a prune raced a slow page): the upsert clears the tombstone.
+	upsert("gone", deletedAt.Add(2*time.Hour))
+	if live, _ := store.Queries().ListIssueComments(ctx, "issue-1"); len(live) != 2 {
+		t.Errorf("live comments after revive = %d, want 2", len(live))
+	}
+}
+
 func TestDeleteComment_NonExistent(t *testing.T) {
 	t.Parallel()
 	store := openTestStore(t)
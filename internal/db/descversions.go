@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// DescriptionVersionsKept bounds the description versions kept per issue;
+// recording one more drops the oldest.
+const DescriptionVersionsKept = 20
+
+// RecordDescriptionVersion keeps description as a version of the issue's
+// description if it differs from the newest one kept, stamped with
+// observedAt (the issue's updatedAt, so replays of one delivery collapse).
+// An issue with no versions yet treats the empty description as the newest
+// one, so a body that was never set records nothing.
+func (s *Store) RecordDescriptionVersion(ctx context.Context, issueID, description string, observedAt time.Time) error {
+	q := s.Queries()
+	latest, err := q.GetLatestDescriptionVersion(ctx, issueID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if latest == description {
+		return nil
+	}
+	if observedAt.IsZero() {
+		observedAt = Now()
+	}
+	if err := q.UpsertDescriptionVersion(ctx, UpsertDescriptionVersionParams{
+		IssueID:     issueID,
+		ObservedAt:  observedAt.UTC(),
+		Description: description,
+	}); err != nil {
+		return err
+	}
+	return q.PruneDescriptionVersions(ctx, PruneDescriptionVersionsParams{
+		IssueID:   issueID,
+		IssueID_2: issueID,
+		Limit:     DescriptionVersionsKept,
+	})
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// TestRecordDescriptionVersion: only a changed description is kept, a body
+// never set records nothing, and the ring drops the oldest past the bound.
+func TestRecordDescriptionVersion(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+	base := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	record := func(desc string, at time.Time) {
+		t.Helper()
+		if err := store.RecordDescriptionVersion(ctx, "issue-1", desc, at); err != nil {
+			t.Fatalf("RecordDescriptionVersion: %v", err)
+		}
+	}
+	list := func() []IssueDescriptionVersion {
+		t.Helper()
+		versions, err := store.Queries().ListDescriptionVersions(ctx, "issue-1")
+		if err != nil {
+			t.Fatalf("ListDescriptionVersions: %v", err)
+		}
+		return versions
+	}
+
+	record("", base)
+	if got := list(); len(got) != 0 {
+		t.Fatalf("empty first description recorded %d versions", len(got))
+	}
+	record("v1", base.Add(time.Minute))
+	record("v1", base.Add(2*time.Minute))
+	record("v2", base.Add(3*time.Minute))
+	if got := list(); len(got) != 2 || got[0].Description != "v1" || got[1].Description != "v2" {
+		t.Fatalf("versions = %+v, want v1 then v2", got)
+	}
+
+	for i := range DescriptionVersionsKept {
+		record(fmt.Sprintf("v%d", i+3), base.Add(time.Duration(i+4)*time.Minute))
+	}
+	got := list()
+	if len(got) != DescriptionVersionsKept {
+		t.Fatalf("kept %d versions, want %d", len(got), DescriptionVersionsKept)
+	}
+	if got[0].Description != "v3" || got[len(got)-1].Description != fmt.Sprintf("v%d", DescriptionVersionsKept+2) {
+		t.Errorf("ring kept %q..%q", got[0].Description, got[len(got)-1].Description)
+	}
+}
@@ -0,0 +1,104 @@
+// Package fixtures seeds a test's SQLite store with whole entity shapes, built
+// on the row-level Populate* helpers and Fixture* defaults of
+// internal/testutil/fixtures.
+package fixtures
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	testfixtures "github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// Seed builders.
+//
+// Each testutil Populate* helper writes one table. A test for a new directory type
+// usually needs several of them, in order and with IDs that agree: a team's
+// catalog before its issues, a parent before the children that point at it,
+// a project before its milestones. The Seed* builders bundle those writes so
+// a test states the shape it needs rather than the rows behind it. Entity
+// data comes from the arguments and the Fixture* defaults only, never the
+// clock, so every run seeds the same content (synced_at bookkeeping aside).
+
+// SeedTeam inserts team with the standard catalog — FixtureAPIStates,
+// FixtureAPILabels, FixtureAPIUsers, and a membership row per user — followed
+// by issues. An issue without a team is filed under team.
+func SeedTeam(ctx context.Context, store *db.Store, team api.Team, issues ...api.Issue) error {
+	users := testfixtures.FixtureAPIUsers()
+	if err := testfixtures.PopulateUsers(ctx, store, users); err != nil {
+		return fmt.Errorf("seed team %s: %w", team.Key, err)
+	}
+	for i := range issues {
+		if issues[i].Team == nil {
+			issues[i].Team = &team
+		}
+	}
+	if err := testfixtures.PopulateTeam(ctx, store, team, testfixtures.FixtureAPIStates(), testfixtures.FixtureAPILabels(), issues); err != nil {
+		return fmt.Errorf("seed team %s: %w", team.Key, err)
+	}
+	userIDs := make([]string, len(users))
+	for i, u := range users {
+		userIDs[i] = u.ID
+	}
+	if err := testfixtures.PopulateTeamMembers(ctx, store, team.ID, userIDs); err != nil {
+		return fmt.Errorf("seed team %s: %w", team.Key, err)
+	}
+	return nil
+}
+
+// SeedIssueTree inserts parent and its children, linked both ways as a sync
+// would store them: each child's Parent edge (which fills the parent_id
+// column) and the parent's Children list. The team must already be seeded.
+func SeedIssueTree(ctx context.Context, store *db.Store, parent api.Issue, children ...api.Issue) error {
+	parent.Children = api.ChildIssues{Nodes: make([]api.ChildIssue, len(children))}
+	for i := range children {
+		children[i].Parent = &api.ParentIssue{ID: parent.ID, Identifier: parent.Identifier, Title: parent.Title}
+		parent.Children.Nodes[i] = api.ChildIssue{
+			ID:         children[i].ID,
+			Identifier: children[i].Identifier,
+			Title:      children[i].Title,
+			CreatedAt:  children[i].CreatedAt,
+			UpdatedAt:  children[i].UpdatedAt,
+		}
+	}
+	if err := upsertIssues(ctx, store, append([]api.Issue{parent}, children...)); err != nil {
+		return fmt.Errorf("seed issue tree %s: %w", parent.Identifier, err)
+	}
+	return nil
+}
+
+// SeedProjectWithMilestones inserts project (linked to teamID) and its
+// milestones, then issues filed under the project. Put an issue in a
+// milestone with testfixtures.WithMilestone; the project edge is set here.
+func SeedProjectWithMilestones(ctx context.Context, store *db.Store, teamID string, project api.Project, milestones []api.ProjectMilestone, issues ...api.Issue) error {
+	if err := testfixtures.PopulateProject(ctx, store, project, teamID); err != nil {
+		return fmt.Errorf("seed project %s: %w", project.Slug, err)
+	}
+	if err := testfixtures.PopulateProjectMilestones(ctx, store, project.ID, milestones); err != nil {
+		return fmt.Errorf("seed project %s: %w", project.Slug, err)
+	}
+	for i := range issues {
+		issues[i].Project = &project
+	}
+	if err := upsertIssues(ctx, store, issues); err != nil {
+		return fmt.Errorf("seed project %s: %w", project.Slug, err)
+	}
+	return nil
+}
+
+// upsertIssues writes issues as the sync worker would.
+func upsertIssues(ctx context.Context, store *db.Store, issues []api.Issue) error {
+	q := store.Queries()
+	for _, issue := range issues {
+		row, err := db.APIIssueToDBIssue(issue)
+		if err != nil {
+			return err
+		}
+		if err := q.UpsertIssue(ctx, row.ToUpsertParams()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package fixtures
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	testfixtures "github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+func TestSeedBuilders(t *testing.T) {
+	sqliteRepo, store := testfixtures.NewTestSQLiteRepository(t)
+	ctx := context.Background()
+
+	team := testfixtures.FixtureAPITeam()
+	if err := SeedTeam(ctx, store, team); err != nil {
+		t.Fatalf("SeedTeam failed: %v", err)
+	}
+	members, err := sqliteRepo.GetTeamMembers(ctx, team.ID)
+	if err != nil || len(members) != len(testfixtures.FixtureAPIUsers()) {
+		t.Fatalf("GetTeamMembers = %d, %v; want %d", len(members), err, len(testfixtures.FixtureAPIUsers()))
+	}
+
+	parent := testfixtures.FixtureAPIIssue(testfixtures.WithIssueID("issue-1", "TST-1"))
+	if err := SeedIssueTree(ctx, store, parent,
+		testfixtures.FixtureAPIIssue(testfixtures.WithIssueID("issue-2", "TST-2")),
+		testfixtures.FixtureAPIIssue(testfixtures.WithIssueID("issue-3", "TST-3")),
+	); err != nil {
+		t.Fatalf("SeedIssueTree failed: %v", err)
+	}
+	children, err := sqliteRepo.GetIssueChildren(ctx, "issue-1")
+	if err != nil || len(children) != 2 {
+		t.Fatalf("GetIssueChildren = %d, %v; want 2", len(children), err)
+	}
+	if children[0].Parent == nil || children[0].Parent.Identifier != "TST-1" {
+		t.Errorf("child Parent = %+v, want TST-1", children[0].Parent)
+	}
+
+	project := testfixtures.FixtureAPIProject()
+	milestone := testfixtures.FixtureAPIProjectMilestone()
+	if err := SeedProjectWithMilestones(ctx, store, team.ID, project, []api.ProjectMilestone{milestone},
+		testfixtures.FixtureAPIIssue(testfixtures.WithIssueID("issue-4", "TST-4"), testfixtures.WithMilestone(&milestone)),
+		testfixtures.FixtureAPIIssue(testfixtures.WithIssueID("issue-5", "TST-5")),
+	); err != nil {
+		t.Fatalf("SeedProjectWithMilestones failed: %v", err)
+	}
+	milestones, err := sqliteRepo.GetProjectMilestones(ctx, project.ID)
+	if err != nil || len(milestones) != 1 {
+		t.Fatalf("GetProjectMilestones = %d, %v; want 1", len(milestones), err)
+	}
+	inMilestone, err := sqliteRepo.GetIssuesByMilestone(ctx, project.ID, milestone.ID)
+	if err != nil || len(inMilestone) != 1 || inMilestone[0].Identifier != "TST-4" {
+		t.Fatalf("GetIssuesByMilestone = %+v, %v; want TST-4", inMilestone, err)
+	}
+	issues, err := sqliteRepo.GetTeamIssues(ctx, team.ID)
+	if err != nil || len(issues) != 5 {
+		t.Fatalf("GetTeamIssues = %d, %v; want 5", len(issues), err)
+	}
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/jra3/linear-fuse/internal/atrest"
+)
+
+// The writer lock decides which of several processes sharing one cache.db
+// writes it. SQLite's own locking already keeps concurrent writes from
+// corrupting the file; the lock is about roles. Two sync workers on one cache
+// would double the API spend and race each other's prunes, and a second
+// Open that found a stale schema would delete the file from under the first.
+// So the first Open takes an exclusive flock on cache.db.lock and holds it
+// until Close; an Open that finds it taken opens the cache read-only instead
+// and leaves schema, migrations and sync to the holder.
+//
+// The lock is advisory and per open file description: it dies with the
+// process, so a crash never leaves a stale lock behind.
+
+// writerLockSuffix names the lock file next to the database.
+const writerLockSuffix = ".lock"
+
+// acquireWriterLock takes the writer lock for dbPath without waiting. It
+// returns the held lock file, or nil and the holder's pid (0 if unknown) when
+// another process holds it.
+func acquireWriterLock(dbPath string) (*os.File, int, error) {
+	path := dbPath + writerLockSuffix
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, atrest.FileMode)
+	if err != nil {
+		return nil, 0, fmt.Errorf("open writer lock: %w", err)
+	}
+	atrest.Chmod(path, atrest.FileMode, atrest.ArtifactDB)
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		defer f.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, lockHolder(f), nil
+		}
+		return nil, 0, fmt.Errorf("lock %s: %w", path, err)
+	}
+	// The holder's pid is for the message a reader logs; best-effort.
+	if err := f.Truncate(0); err == nil {
+		_, _ = f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}
+	return f, 0, nil
+}
+
+// lockHolder reads the pid the lock's holder wrote, 0 if there is none.
+func lockHolder(f *os.File) int {
+	buf := make([]byte, 32)
+	n, _ := f.ReadAt(buf, 0)
+	pid, _ := strconv.Atoi(strings.TrimSpace(string(buf[:n])))
+	return pid
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestOpenSecondWriterReadOnly: while one store holds the writer lock, a
+// second Open of the same cache (a flock held by another open file behaves as
+// another process's would) is read-only, sees the writer's rows as they land,
+// and refuses writes; closing the writer frees the role.
+func TestOpenSecondWriterReadOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "cache.db")
+	upsert := func(s *Store, id string) error {
+		return s.Queries().UpsertTeam(ctx, UpsertTeamParams{ID: id, Key: id, Name: id, SyncedAt: Now()})
+	}
+
+	writer, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open (writer): %v", err)
+	}
+	if writer.ReadOnly() {
+		t.Fatal("first Open is read-only")
+	}
+	if err := upsert(writer, "T1"); err != nil {
+		t.Fatalf("UpsertTeam: %v", err)
+	}
+
+	reader, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open (reader): %v", err)
+	}
+	defer reader.Close()
+	if !reader.ReadOnly() || reader.WriterPID() != os.Getpid() {
+		t.Fatalf("second Open: read-only %v, writer pid %d", reader.ReadOnly(), reader.WriterPID())
+	}
+	if err := upsert(writer, "T2"); err != nil {
+		t.Fatalf("UpsertTeam after reader opened: %v", err)
+	}
+	if teams, err := reader.Queries().ListTeams(ctx); err != nil || len(teams) != 2 {
+		t.Errorf("reader sees %d teams (%v), want 2", len(teams), err)
+	}
+	if err := upsert(reader, "T3"); err == nil {
+		t.Error("read-only store accepted a write")
+	}
+
+	writer.Close()
+	next, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open after writer closed: %v", err)
+	}
+	defer next.Close()
+	if next.ReadOnly() {
+		t.Error("Open after the writer closed is read-only")
+	}
+}
+
+// TestOpenReadOnlyNeedsWriterSchema: a reader that finds no database (its
+// writer has not created it yet) fails rather than creating one.
+func TestOpenReadOnlyNeedsWriterSchema(t *testing.T) {
+	t.Parallel()
+	dbPath := filepath.Join(t.TempDir(), "cache.db")
+	lock, _, err := acquireWriterLock(dbPath)
+	if err != nil || lock == nil {
+		t.Fatalf("acquireWriterLock = %v, %v", lock, err)
+	}
+	defer lock.Close()
+	if store, err := Open(dbPath); err == nil {
+		store.Close()
+		t.Fatal("Open without a database succeeded under another writer")
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Errorf("reader created the database: %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// The mention index behind backlinks.md. IndexMentions, run by the sync worker
+// after each cycle, reads every issue description, comment, and document
+// synced since it was last indexed, extracts what it mentions, and replaces
+// that source's rows in mentions. ListBacklinks then answers "who mentions X"
+// with one indexed read per kind.
+
+// Mention patterns, compiled once. An identifier is a whole token: not
+// preceded by an alphanumeric, and the greedy digits leave no digit after it,
+// so ENG-1 is found in "see ENG-1." and ".../issue/ENG-1/slug" but ENG-12 and
+// XENG-1 are not ENG-1. An issue URL embeds its identifier, so it needs no
+// pattern of its own; a document URL is matched by its last path segment.
+var (
+	identifierMention = regexp.MustCompile(`(?:^|[^A-Za-z0-9])([A-Z][A-Z0-9]*-[0-9]+)`)
+	documentMention   = regexp.MustCompile(`linear\.app/[^/\s]+/document/([A-Za-z0-9-]+)`)
+)
+
+// mentionTargets returns the distinct targets text mentions: issue
+// identifiers, and DocumentMentionTarget values for document URLs.
+func mentionTargets(text string) []string {
+	seen := make(map[string]bool)
+	var targets []string
+	add := func(t string) {
+		if !seen[t] {
+			seen[t] = true
+			targets = append(targets, t)
+		}
+	}
+	for _, m := range identifierMention.FindAllStringSubmatch(text, -1) {
+		add(m[1])
+	}
+	for _, m := range documentMention.FindAllStringSubmatch(text, -1) {
+		add("document/" + m[1])
+	}
+	return targets
+}
+
+// DocumentMentionTarget is the mentions target a document is found under:
+// its URL's last path segment, "" for a document without a Linear URL.
+func DocumentMentionTarget(url string) string {
+	m := documentMention.FindStringSubmatch(url)
+	if m == nil {
+		return ""
+	}
+	return "document/" + m[1]
+}
+
+// mentionSource is one kind of text IndexMentions indexes.
+type mentionSource struct {
+	kind  string // mentions.source_kind
+	table string
+	text  string // the indexed column, qualified by alias t
+}
+
+var mentionSources = []mentionSource{
+	{"issue", "issues", "t.description"},
+	{"comment", "comments", "t.body"},
+	{"document", "documents", "t.content"},
+}
+
+// mentionBatch bounds how many texts one IndexMentions round holds in memory
+// and in one transaction; the first pass over a large cache takes several.
+const mentionBatch = 500
+
+// IndexMentions brings the mention index up to date: texts synced since they
+// were last indexed (all of them, the first time) are re-read and their
+// mentions replaced, and rows of sources no longer cached are dropped. Returns
+// how many texts it indexed.
+func (s *Store) IndexMentions(ctx context.Context) (int, error) {
+	indexed := 0
+	for _, src := range mentionSources {
+		for {
+			n, err := s.indexMentionBatch(ctx, src)
+			if err != nil {
+				return indexed, fmt.Errorf("index %s mentions: %w", src.kind, err)
+			}
+			indexed += n
+			if n < mentionBatch {
+				break
+			}
+		}
+		for _, table := range []string{"mentions", "mention_sources"} {
+			if _, err := s.qdb.ExecContext(ctx, `DELETE FROM `+table+`
+				WHERE source_kind = ? AND source_id NOT IN (SELECT id FROM `+src.table+`)`, src.kind); err != nil {
+				return indexed, fmt.Errorf("prune %s mentions: %w", src.kind, err)
+			}
+		}
+	}
+	return indexed, nil
+}
+
+// indexMentionBatch indexes up to mentionBatch of src's texts that changed
+// since they were indexed. synced_at is compared and stored as text, so the
+// round trip is exact whatever the driver's DATETIME decoding.
+func (s *Store) indexMentionBatch(ctx context.Context, src mentionSource) (int, error) {
+	rows, err := s.qdb.QueryContext(ctx, `
+		SELECT t.id, COALESCE(`+src.text+`, ''), CAST(t.synced_at AS TEXT)
+		FROM `+src.table+` t
+		LEFT JOIN mention_sources ms ON ms.source_kind = ? AND ms.source_id = t.id
+		WHERE ms.indexed_at IS NULL OR ms.indexed_at != CAST(t.synced_at AS TEXT)
+		LIMIT ?`, src.kind, mentionBatch)
+	if err != nil {
+		return 0, err
+	}
+	type pending struct{ id, text, syncedAt string }
+	var batch []pending
+	for rows.Next() {
+		var p pending
+		if err := rows.Scan(&p.id, &p.text, &p.syncedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		batch = append(batch, p)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil || len(batch) == 0 {
+		return 0, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	for _, p := range batch {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM mentions WHERE source_kind = ? AND source_id = ?`, src.kind, p.id); err != nil {
+			return 0, err
+		}
+		for _, target := range mentionTargets(p.text) {
+			if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO mentions (target, source_kind, source_id) VALUES (?, ?, ?)`,
+				target, src.kind, p.id); err != nil {
+				return 0, err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO mention_sources (source_kind, source_id, indexed_at) VALUES (?, ?, ?)
+			ON CONFLICT(source_kind, source_id) DO UPDATE SET indexed_at = excluded.indexed_at`,
+			src.kind, p.id, p.syncedAt); err != nil {
+			return 0, err
+		}
+	}
+	return len(batch), tx.Commit()
+}
+
+// BacklinkSource is one cached text that mentions an issue or document:
+// another issue's description, a comment, or a document. Kind is "issue",
+// "comment", or "document"; Identifier/Title describe the mentioning issue
+// (for documents, Title is the document's own and Identifier is "" unless it
+// is attached to an issue).
+type BacklinkSource struct {
+	Kind       string
+	ID         string
+	Identifier string
+	Title      string
+	Author     string
+	URL        string
+	UpdatedAt  time.Time
+}
+
+// ListBacklinks returns the indexed sources that mention target (an issue
+// identifier or a DocumentMentionTarget), leaving out the texts of issueID
+// (its description, comments, and documents) and the document documentID
+// themselves; either may be "". updated_at is read as text and parsed, so the
+// read does not depend on the driver's DATETIME decoding.
+func (s *Store) ListBacklinks(ctx context.Context, target, issueID, documentID string) ([]BacklinkSource, error) {
+	queries := []struct {
+		kind string
+		sql  string
+		args []any
+	}{
+		{"issue", `
+			SELECT i.id, i.identifier, i.title, COALESCE(i.creator_email, ''), COALESCE(i.url, ''),
+				CAST(i.updated_at AS TEXT)
+			FROM mentions m JOIN issues i ON i.id = m.source_id
+			WHERE m.target = ?1 AND m.source_kind = 'issue' AND i.id != ?2`,
+			[]any{target, issueID}},
+		{"comment", `
+			SELECT c.id, i.identifier, i.title, COALESCE(c.user_email, c.user_name, ''), COALESCE(i.url, ''),
+				CAST(c.updated_at AS TEXT)
+			FROM mentions m JOIN comments c ON c.id = m.source_id JOIN issues i ON i.id = c.issue_id
+			WHERE m.target = ?1 AND m.source_kind = 'comment' AND c.issue_id != ?2 AND c.deleted_at IS NULL`,
+			[]any{target, issueID}},
+		{"document", `
+			SELECT d.id, COALESCE(i.identifier, ''), d.title, '', COALESCE(d.url, ''),
+				CAST(COALESCE(d.updated_at, d.created_at, d.synced_at) AS TEXT)
+			FROM mentions m JOIN documents d ON d.id = m.source_id LEFT JOIN issues i ON i.id = d.issue_id
+			WHERE m.target = ?1 AND m.source_kind = 'document'
+				AND (?2 = '' OR COALESCE(d.issue_id, '') != ?2) AND d.id != ?3`,
+			[]any{target, issueID, documentID}},
+	}
+
+	var out []BacklinkSource
+	for _, q := range queries {
+		rows, err := s.qdb.QueryContext(ctx, q.sql, q.args...)
+		if err != nil {
+			return nil, fmt.Errorf("list %s backlinks: %w", q.kind, err)
+		}
+		for rows.Next() {
+			src := BacklinkSource{Kind: q.kind}
+			var updated sql.NullString
+			if err := rows.Scan(&src.ID, &src.Identifier, &src.Title, &src.Author, &src.URL, &updated); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			src.UpdatedAt = ParseSQLiteTime(updated.String)
+			out = append(out, src)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
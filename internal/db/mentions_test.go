@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestMentionTargets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"TST-1", []string{"TST-1"}},
+		{"see TST-1. and (TST-1)", []string{"TST-1"}},
+		{"https://linear.app/x/issue/TST-1/slug", []string{"TST-1"}},
+		{"TST-12 XTST-1 tst-1", []string{"TST-12", "XTST-1"}},
+		{"spec: https://linear.app/x/document/launch-plan-0f3a9c1b2d4e#goals", []string{"document/launch-plan-0f3a9c1b2d4e"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := mentionTargets(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("mentionTargets(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+// TestIndexMentions: the index picks up texts as they are synced, re-reads
+// one only when sync writes it again, drops sources no longer cached, and
+// ListBacklinks answers documents by their URL.
+func TestIndexMentions(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+	q := store.Queries()
+	team := &api.Team{ID: "team-1", Key: "TST"}
+	docURL := "https://linear.app/x/document/plan-0f3a9c1b2d4e"
+
+	upsertIssue := func(issue api.Issue, synced time.Time) {
+		t.Helper()
+		data, err := APIIssueToDBIssue(issue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		params := data.ToUpsertParams()
+		params.SyncedAt = synced
+		if err := q.UpsertIssue(ctx, params); err != nil {
+			t.Fatalf("UpsertIssue: %v", err)
+		}
+	}
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	upsertIssue(api.Issue{ID: "i1", Identifier: "TST-1", Title: "Target", Team: team, CreatedAt: base, UpdatedAt: base}, base)
+	upsertIssue(api.Issue{ID: "i2", Identifier: "TST-2", Title: "Refers", Team: team, Description: "After TST-1, see " + docURL, CreatedAt: base, UpdatedAt: base}, base)
+	doc, err := APIDocumentToDBDocument(api.Document{ID: "d1", SlugID: "0f3a9c1b2d4e", Title: "Plan", URL: docURL, Content: "Tracks TST-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.UpsertDocument(ctx, doc); err != nil {
+		t.Fatalf("UpsertDocument: %v", err)
+	}
+
+	backlinks := func(target, issueID, documentID string) []string {
+		t.Helper()
+		srcs, err := store.ListBacklinks(ctx, target, issueID, documentID)
+		if err != nil {
+			t.Fatalf("ListBacklinks(%s): %v", target, err)
+		}
+		var ids []string
+		for _, s := range srcs {
+			ids = append(ids, s.Kind+":"+s.ID)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	if n, err := store.IndexMentions(ctx); err != nil || n != 3 {
+		t.Fatalf("IndexMentions = %d, %v; want the 3 texts", n, err)
+	}
+	if got, want := backlinks("TST-1", "i1", ""), []string{"document:d1", "issue:i2"}; !slices.Equal(got, want) {
+		t.Errorf("TST-1 backlinks = %v, want %v", got, want)
+	}
+	if got, want := backlinks(DocumentMentionTarget(docURL), "", "d1"), []string{"issue:i2"}; !slices.Equal(got, want) {
+		t.Errorf("document backlinks = %v, want %v", got, want)
+	}
+	if n, err := store.IndexMentions(ctx); err != nil || n != 0 {
+		t.Errorf("IndexMentions with nothing synced since = %d, %v; want 0", n, err)
+	}
+
+	// TST-2 no longer mentions TST-1 once resynced; the document is gone.
+	upsertIssue(api.Issue{ID: "i2", Identifier: "TST-2", Title: "Refers", Team: team, Description: "Unrelated", CreatedAt: base, UpdatedAt: base}, base.Add(time.Minute))
+	if err := q.DeleteDocument(ctx, "d1"); err != nil {
+		t.Fatalf("DeleteDocument: %v", err)
+	}
+	if n, err := store.IndexMentions(ctx); err != nil || n != 1 {
+		t.Fatalf("IndexMentions after a resync = %d, %v; want the 1 text", n, err)
+	}
+	if got := backlinks("TST-1", "i1", ""); len(got) != 0 {
+		t.Errorf("TST-1 backlinks after the edit = %v, want none", got)
+	}
+	var rows int
+	if err := store.DB().QueryRowContext(ctx, `SELECT COUNT(*) FROM mentions WHERE source_kind = 'document'`).Scan(&rows); err != nil || rows != 0 {
+		t.Errorf("mentions of the deleted document = %d, %v; want pruned", rows, err)
+	}
+}
@@ -10,6 +10,43 @@ import (
 	"time"
 )
 
+type ActivityLog struct {
+	IssueID    string    `json:"issue_id"`
+	Kind       string    `json:"kind"`
+	At         time.Time `json:"at"`
+	Day        string    `json:"day"`
+	Identifier string    `json:"identifier"`
+	TeamKey    string    `json:"team_key"`
+	Title      string    `json:"title"`
+	FromState  string    `json:"from_state"`
+	ToState    string    `json:"to_state"`
+}
+
+type ApiBudgetHour struct {
+	Hour       time.Time `json:"hour"`
+	Requests   int64     `json:"requests"`
+	Complexity float64   `json:"complexity"`
+}
+
+type ApiBudgetWindow struct {
+	Axis       string       `json:"axis"`
+	LimitValue float64      `json:"limit_value"`
+	Remaining  float64      `json:"remaining"`
+	ResetAt    sql.NullTime `json:"reset_at"`
+	ObservedAt time.Time    `json:"observed_at"`
+}
+
+type ArchivedIssue struct {
+	ID         string          `json:"id"`
+	Identifier string          `json:"identifier"`
+	TeamID     string          `json:"team_id"`
+	Title      string          `json:"title"`
+	ArchivedAt time.Time       `json:"archived_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	SyncedAt   time.Time       `json:"synced_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
 type Attachment struct {
 	ID           string          `json:"id"`
 	IssueID      string          `json:"issue_id"`
@@ -40,6 +77,27 @@ type Comment struct {
 	UpdatedAt time.Time       `json:"updated_at"`
 	SyncedAt  time.Time       `json:"synced_at"`
 	Data      json.RawMessage `json:"data"`
+	DeletedAt sql.NullTime    `json:"deleted_at"`
+}
+
+type CommentDraft struct {
+	IssueID   string    `json:"issue_id"`
+	Name      string    `json:"name"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CustomViewIssueCache struct {
+	ViewID   string          `json:"view_id"`
+	SyncedAt time.Time       `json:"synced_at"`
+	IssueIds json.RawMessage `json:"issue_ids"`
+}
+
+type CustomViewsCache struct {
+	Singleton int64           `json:"singleton"`
+	SyncedAt  time.Time       `json:"synced_at"`
+	Data      json.RawMessage `json:"data"`
 }
 
 type Cycle struct {
@@ -91,6 +149,12 @@ type EmbeddedFile struct {
 	SyncedAt  time.Time      `json:"synced_at"`
 }
 
+type EmojiCache struct {
+	Singleton int64           `json:"singleton"`
+	SyncedAt  time.Time       `json:"synced_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
 type EntityExternalLink struct {
 	ID           string          `json:"id"`
 	ProjectID    sql.NullString  `json:"project_id"`
@@ -123,6 +187,7 @@ type Initiative struct {
 	UpdatedAt   sql.NullTime    `json:"updated_at"`
 	SyncedAt    time.Time       `json:"synced_at"`
 	Data        json.RawMessage `json:"data"`
+	ParentID    sql.NullString  `json:"parent_id"`
 }
 
 type InitiativeProject struct {
@@ -181,6 +246,12 @@ type Issue struct {
 	Data           json.RawMessage `json:"data"`
 }
 
+type IssueDescriptionVersion struct {
+	IssueID     string    `json:"issue_id"`
+	ObservedAt  time.Time `json:"observed_at"`
+	Description string    `json:"description"`
+}
+
 type IssueHistoryCache struct {
 	IssueID  string          `json:"issue_id"`
 	SyncedAt time.Time       `json:"synced_at"`
@@ -210,12 +281,34 @@ type Label struct {
 	Data        json.RawMessage `json:"data"`
 }
 
+type MutationQueue struct {
+	ID        int64           `json:"id"`
+	Kind      string          `json:"kind"`
+	EntityID  string          `json:"entity_id"`
+	Label     string          `json:"label"`
+	Payload   json.RawMessage `json:"payload"`
+	QueuedAt  time.Time       `json:"queued_at"`
+	Attempts  int64           `json:"attempts"`
+	LastError sql.NullString  `json:"last_error"`
+}
+
+type OrganizationCache struct {
+	Singleton int64           `json:"singleton"`
+	SyncedAt  time.Time       `json:"synced_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
 type PendingDetailSync struct {
 	IssueID    string    `json:"issue_id"`
 	Identifier string    `json:"identifier"`
 	QueuedAt   time.Time `json:"queued_at"`
 }
 
+type PendingIssueField struct {
+	IssueID  string    `json:"issue_id"`
+	QueuedAt time.Time `json:"queued_at"`
+}
+
 type Project struct {
 	ID          string          `json:"id"`
 	SlugID      string          `json:"slug_id"`
@@ -284,6 +377,25 @@ type ProjectUpdate struct {
 	Data      json.RawMessage `json:"data"`
 }
 
+type RecurringIssueLog struct {
+	Name       string    `json:"name"`
+	Occurrence time.Time `json:"occurrence"`
+	IssueID    string    `json:"issue_id"`
+	Identifier string    `json:"identifier"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ScopeChange struct {
+	CycleID      string          `json:"cycle_id"`
+	IssueID      string          `json:"issue_id"`
+	Kind         string          `json:"kind"`
+	At           time.Time       `json:"at"`
+	Identifier   string          `json:"identifier"`
+	Title        string          `json:"title"`
+	FromEstimate sql.NullFloat64 `json:"from_estimate"`
+	ToEstimate   sql.NullFloat64 `json:"to_estimate"`
+}
+
 type State struct {
 	ID        string          `json:"id"`
 	TeamID    string          `json:"team_id"`
@@ -310,13 +422,16 @@ type SyncSchedule struct {
 }
 
 type Team struct {
-	ID        string         `json:"id"`
-	Key       string         `json:"key"`
-	Name      string         `json:"name"`
-	Icon      sql.NullString `json:"icon"`
-	CreatedAt sql.NullTime   `json:"created_at"`
-	UpdatedAt sql.NullTime   `json:"updated_at"`
-	SyncedAt  time.Time      `json:"synced_at"`
+	ID          string         `json:"id"`
+	Key         string         `json:"key"`
+	Name        string         `json:"name"`
+	Icon        sql.NullString `json:"icon"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	SyncedAt    time.Time      `json:"synced_at"`
+	Private     int64          `json:"private"`
+	Color       sql.NullString `json:"color"`
+	Description sql.NullString `json:"description"`
 }
 
 type TeamMember struct {
@@ -325,6 +440,12 @@ type TeamMember struct {
 	SyncedAt time.Time `json:"synced_at"`
 }
 
+type TeamTemplatesCache struct {
+	TeamID   string          `json:"team_id"`
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
 type User struct {
 	ID          string          `json:"id"`
 	Email       string          `json:"email"`
@@ -344,3 +465,8 @@ type ViewerCache struct {
 	UserID    string    `json:"user_id"`
 	SyncedAt  time.Time `json:"synced_at"`
 }
+
+type WebhookDelivery struct {
+	DeliveryID string    `json:"delivery_id"`
+	ReceivedAt time.Time `json:"received_at"`
+}
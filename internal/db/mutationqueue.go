@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Kinds of queued mutation (mutation_queue.kind), each with its payload.
+const (
+	// MutationIssueUpdate is an issue.md save: IssueUpdatePayload.
+	MutationIssueUpdate = "issue-update"
+	// MutationCommentCreate is a new comment: CommentCreatePayload.
+	MutationCommentCreate = "comment-create"
+)
+
+// IssueUpdatePayload is a queued issueUpdate: the input as resolved at save
+// time (IDs, not names), sent as-is on replay.
+type IssueUpdatePayload struct {
+	IssueID string         `json:"issueId"`
+	Input   map[string]any `json:"input"`
+}
+
+// CommentCreatePayload is a queued commentCreate.
+type CommentCreatePayload struct {
+	IssueID string `json:"issueId"`
+	Body    string `json:"body"`
+}
+
+// QueueMutation appends a write to the mutation queue for the sync worker to
+// replay, returning its queue ID. entityID is the issue the write belongs to —
+// writes to one issue replay in the order they were queued — and label its
+// identifier, for the pending/ listing.
+func (s *Store) QueueMutation(ctx context.Context, kind, entityID, label string, payload any) (int64, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+	return s.Queries().EnqueueMutation(ctx, EnqueueMutationParams{
+		Kind:     kind,
+		EntityID: entityID,
+		Label:    label,
+		Payload:  data,
+		QueuedAt: Now(),
+	})
+}
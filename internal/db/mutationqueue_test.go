@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+// TestQueueMutation: queued writes list oldest first with their payloads, count
+// per issue, record failed attempts, and leave the queue once deleted.
+func TestQueueMutation(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+	q := store.Queries()
+
+	first, err := store.QueueMutation(ctx, MutationIssueUpdate, "issue-1", "ENG-1",
+		IssueUpdatePayload{IssueID: "issue-1", Input: map[string]any{"title": "Offline"}})
+	if err != nil {
+		t.Fatalf("QueueMutation: %v", err)
+	}
+	second, err := store.QueueMutation(ctx, MutationCommentCreate, "issue-1", "ENG-1",
+		CommentCreatePayload{IssueID: "issue-1", Body: "hello"})
+	if err != nil {
+		t.Fatalf("QueueMutation: %v", err)
+	}
+	if second <= first {
+		t.Fatalf("queue IDs %d then %d, want increasing", first, second)
+	}
+
+	queued, err := q.ListQueuedMutations(ctx)
+	if err != nil {
+		t.Fatalf("ListQueuedMutations: %v", err)
+	}
+	if len(queued) != 2 || queued[0].ID != first || queued[1].Kind != MutationCommentCreate {
+		t.Fatalf("queue = %+v, want the update then the comment", queued)
+	}
+	var update IssueUpdatePayload
+	if err := json.Unmarshal(queued[0].Payload, &update); err != nil || update.Input["title"] != "Offline" {
+		t.Fatalf("payload = %s (%v), want the title input", queued[0].Payload, err)
+	}
+	if n, _ := q.CountEntityQueuedMutations(ctx, "issue-1"); n != 2 {
+		t.Errorf("CountEntityQueuedMutations = %d, want 2", n)
+	}
+
+	if err := q.RecordMutationAttempt(ctx, RecordMutationAttemptParams{
+		LastError: sql.NullString{String: "rejected", Valid: true}, ID: first,
+	}); err != nil {
+		t.Fatalf("RecordMutationAttempt: %v", err)
+	}
+	if err := q.DeleteQueuedMutation(ctx, second); err != nil {
+		t.Fatalf("DeleteQueuedMutation: %v", err)
+	}
+	queued, _ = q.ListQueuedMutations(ctx)
+	if len(queued) != 1 || queued[0].Attempts != 1 || queued[0].LastError.String != "rejected" {
+		t.Fatalf("queue = %+v, want the update with one failed attempt", queued)
+	}
+}
@@ -13,9 +13,22 @@ SELECT * FROM issues WHERE team_id = ? AND state_id = ? ORDER BY updated_at DESC
 -- name: ListTeamIssuesByAssignee :many
 SELECT * FROM issues WHERE team_id = ? AND assignee_id = ? ORDER BY updated_at DESC;
 
+-- name: ListTeamIssuesByCreator :many
+SELECT * FROM issues WHERE team_id = ? AND creator_id = ? ORDER BY updated_at DESC;
+
+-- ListTeamIssueCreators lists everyone who filed one of the team's synced
+-- issues (the by/creator/ values) — not just team members.
+-- name: ListTeamIssueCreators :many
+SELECT DISTINCT creator_id, creator_email FROM issues
+WHERE team_id = ? AND creator_id IS NOT NULL AND creator_email IS NOT NULL
+ORDER BY creator_email;
+
 -- name: ListTeamUnassignedIssues :many
 SELECT * FROM issues WHERE team_id = ? AND assignee_id IS NULL ORDER BY updated_at DESC;
 
+-- name: ListTeamIssuesByPriority :many
+SELECT * FROM issues WHERE team_id = ? AND priority = ? ORDER BY updated_at DESC;
+
 -- name: ListTeamIssuesByParent :many
 SELECT * FROM issues WHERE parent_id = ? ORDER BY updated_at DESC;
 
@@ -34,6 +47,13 @@ SELECT * FROM issues WHERE creator_id = ? ORDER BY updated_at DESC;
 -- name: ListProjectIssues :many
 SELECT * FROM issues WHERE project_id = ? ORDER BY updated_at DESC;
 
+-- name: ListIssuesByMilestone :many
+-- A project's issues in one of its milestones. The milestone is stored only in
+-- the issue's data JSON, so project_id narrows the scan to one project's rows.
+SELECT * FROM issues
+WHERE project_id = sqlc.arg(project_id) AND json_extract(data, '$.projectMilestone.id') = sqlc.arg(milestone_id)
+ORDER BY updated_at DESC;
+
 -- name: ListCycleIssues :many
 SELECT * FROM issues WHERE cycle_id = ? ORDER BY updated_at DESC;
 
@@ -98,6 +118,32 @@ SELECT COUNT(*) FROM issues WHERE team_id = ?;
 -- name: GetLatestTeamIssueUpdatedAt :one
 SELECT MAX(updated_at) FROM issues WHERE team_id = ?;
 
+-- Archived issues queries
+
+-- name: UpsertArchivedIssue :exec
+INSERT INTO archived_issues (id, identifier, team_id, title, archived_at, updated_at, synced_at, data)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    identifier = excluded.identifier,
+    team_id = excluded.team_id,
+    title = excluded.title,
+    archived_at = excluded.archived_at,
+    updated_at = excluded.updated_at,
+    synced_at = excluded.synced_at,
+    data = excluded.data;
+
+-- name: ListTeamArchivedIssues :many
+SELECT * FROM archived_issues WHERE team_id = ? ORDER BY archived_at DESC;
+
+-- name: GetArchivedIssueByID :one
+SELECT * FROM archived_issues WHERE id = ?;
+
+-- name: GetArchivedIssueByIdentifier :one
+SELECT * FROM archived_issues WHERE identifier = ?;
+
+-- name: DeleteArchivedIssue :exec
+DELETE FROM archived_issues WHERE id = ?;
+
 -- Sync metadata queries
 
 -- name: GetSyncMeta :one
@@ -127,15 +173,18 @@ ON CONFLICT(key) DO UPDATE SET last_run = excluded.last_run;
 SELECT * FROM teams ORDER BY name;
 
 -- name: UpsertTeam :exec
-INSERT INTO teams (id, key, name, icon, created_at, updated_at, synced_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO teams (id, key, name, icon, created_at, updated_at, synced_at, private, color, description)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     key = excluded.key,
     name = excluded.name,
     icon = excluded.icon,
     created_at = excluded.created_at,
     updated_at = excluded.updated_at,
-    synced_at = excluded.synced_at;
+    synced_at = excluded.synced_at,
+    private = excluded.private,
+    color = excluded.color,
+    description = excluded.description;
 
 -- Full-text search queries are handled with raw SQL (FTS5 not supported by sqlc)
 -- See internal/db/search.go for FTS implementation
@@ -435,7 +484,12 @@ DELETE FROM project_milestones WHERE project_id = ?;
 -- =============================================================================
 
 -- name: ListIssueComments :many
-SELECT * FROM comments WHERE issue_id = ? ORDER BY created_at;
+SELECT * FROM comments WHERE issue_id = ? AND deleted_at IS NULL ORDER BY created_at, id;
+
+-- ListIssueCommentsWithDeleted includes tombstones, which the comments/
+-- listing shows struck through under display.show_deleted_comments.
+-- name: ListIssueCommentsWithDeleted :many
+SELECT * FROM comments WHERE issue_id = ? ORDER BY created_at, id;
 
 -- name: UpsertComment :exec
 INSERT INTO comments (id, issue_id, body, body_data, user_id, user_name, user_email, edited_at, created_at, updated_at, synced_at, data)
@@ -451,20 +505,26 @@ ON CONFLICT(id) DO UPDATE SET
     created_at = excluded.created_at,
     updated_at = excluded.updated_at,
     synced_at = excluded.synced_at,
-    data = excluded.data;
+    data = excluded.data,
+    deleted_at = NULL;
 
 -- name: DeleteComment :exec
 DELETE FROM comments WHERE id = ?;
 
+-- name: TombstoneComment :exec
+UPDATE comments SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL;
+
 -- name: DeleteIssueComments :exec
 DELETE FROM comments WHERE issue_id = ?;
 
 -- Prune*: delete rows the details sync no longer sees for an issue. Scoped by
 -- issue and a synced_at cutoff taken before the sync's upserts, so only rows
 -- the fresh fetch did NOT touch are removed (e.g. a comment deleted in Linear,
--- or a phantom left by a delete whose SQLite forget failed).
+-- or a phantom left by a delete whose SQLite forget failed). Comments are the
+-- exception: they are tombstoned (deleted_at stamped once), not removed, so
+-- the comments/ listing can still show what was said.
 -- name: PruneIssueComments :exec
-DELETE FROM comments WHERE issue_id = ? AND synced_at < ?;
+UPDATE comments SET deleted_at = ? WHERE issue_id = ? AND synced_at < ? AND deleted_at IS NULL;
 
 -- =============================================================================
 -- Documents queries
@@ -530,6 +590,19 @@ DELETE FROM documents WHERE team_id = ?;
 -- name: GetTeamDocumentsSyncedAt :one
 SELECT MAX(synced_at) FROM documents WHERE team_id = ?;
 
+-- Standalone documents: owned by no issue, team, project, or initiative (the
+-- root docs/ directory).
+-- name: ListStandaloneDocuments :many
+SELECT * FROM documents
+WHERE issue_id IS NULL AND project_id IS NULL AND initiative_id IS NULL AND team_id IS NULL
+ORDER BY title;
+
+-- Licensed ONLY by a complete drain of Query.documents (GetAllDocuments).
+-- name: PruneStandaloneDocuments :exec
+DELETE FROM documents
+WHERE issue_id IS NULL AND project_id IS NULL AND initiative_id IS NULL AND team_id IS NULL
+  AND synced_at < ?;
+
 -- =============================================================================
 -- Initiatives queries
 -- =============================================================================
@@ -540,9 +613,12 @@ SELECT * FROM initiatives WHERE id = ?;
 -- name: ListInitiatives :many
 SELECT * FROM initiatives ORDER BY sort_order, name;
 
+-- name: ListChildInitiatives :many
+SELECT * FROM initiatives WHERE parent_id = ? ORDER BY sort_order, name;
+
 -- name: UpsertInitiative :exec
-INSERT INTO initiatives (id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO initiatives (id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data, parent_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     slug_id = excluded.slug_id,
     name = excluded.name,
@@ -557,7 +633,8 @@ ON CONFLICT(id) DO UPDATE SET
     created_at = excluded.created_at,
     updated_at = excluded.updated_at,
     synced_at = excluded.synced_at,
-    data = excluded.data;
+    data = excluded.data,
+    parent_id = excluded.parent_id;
 
 -- name: DeleteInitiative :exec
 DELETE FROM initiatives WHERE id = ?;
@@ -658,6 +735,10 @@ SELECT MAX(synced_at) FROM initiative_updates WHERE initiative_id = ?;
 -- attachmentListing dedup suffixes stay stable across calls.
 SELECT * FROM attachments WHERE issue_id = ? ORDER BY created_at, id;
 
+-- name: ListTeamAttachments :many
+-- Attachments on any of a team's issues, grouped by issue (lint.md).
+SELECT * FROM attachments WHERE issue_id IN (SELECT id FROM issues WHERE team_id = ?) ORDER BY issue_id, created_at, id;
+
 -- name: UpsertAttachment :exec
 INSERT INTO attachments (id, issue_id, title, subtitle, url, source_type, metadata, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -767,6 +848,38 @@ SELECT * FROM issue_relations WHERE issue_id = ? ORDER BY type, related_issue_id
 -- name: ListIssueInverseRelations :many
 SELECT * FROM issue_relations WHERE related_issue_id = ? ORDER BY type, issue_id;
 
+-- name: ListTeamIssueRelations :many
+-- Relations owned by any of a team's issues: the edge set of the team's
+-- graph.dot/graph.json.
+SELECT * FROM issue_relations WHERE issue_id IN (SELECT id FROM issues WHERE team_id = ?) ORDER BY issue_id, type, related_issue_id;
+
+-- name: ListProjectIssueRelations :many
+-- Relations owned by any of a project's issues (the project graph twin of
+-- ListTeamIssueRelations).
+SELECT * FROM issue_relations WHERE issue_id IN (SELECT id FROM issues WHERE project_id = ?) ORDER BY issue_id, type, related_issue_id;
+
+-- name: ListIssueOpenBlockers :many
+-- The cached, still-open issues blocking an issue: owners of its incoming
+-- "blocks" relations not completed or canceled. Behind issue.meta's
+-- blockedBy/blocked; ListTeamBlockedIssues shares the predicate.
+SELECT b.* FROM issues b
+JOIN issue_relations r ON r.issue_id = b.id
+WHERE r.related_issue_id = ? AND r.type = 'blocks'
+  AND COALESCE(b.state_type, '') NOT IN ('completed', 'canceled')
+ORDER BY b.identifier;
+
+-- name: ListTeamBlockedIssues :many
+-- A team's open issues with at least one open blocker (by/blocked/).
+SELECT i.* FROM issues i
+WHERE i.team_id = ?
+  AND COALESCE(i.state_type, '') NOT IN ('completed', 'canceled')
+  AND EXISTS (
+    SELECT 1 FROM issue_relations r
+    JOIN issues b ON b.id = r.issue_id
+    WHERE r.related_issue_id = i.id AND r.type = 'blocks'
+      AND COALESCE(b.state_type, '') NOT IN ('completed', 'canceled'))
+ORDER BY i.identifier;
+
 -- name: UpsertIssueRelation :exec
 INSERT INTO issue_relations (id, issue_id, related_issue_id, type, created_at, updated_at, synced_at)
 VALUES (?, ?, ?, ?, ?, ?, ?)
@@ -804,6 +917,69 @@ ON CONFLICT(issue_id) DO UPDATE SET
 -- name: GetIssueHistoryCache :one
 SELECT issue_id, synced_at, data FROM issue_history_cache WHERE issue_id = ?;
 
+-- name: GetLatestDescriptionVersion :one
+SELECT description FROM issue_description_versions
+WHERE issue_id = ?
+ORDER BY observed_at DESC
+LIMIT 1;
+
+-- name: UpsertDescriptionVersion :exec
+INSERT INTO issue_description_versions (issue_id, observed_at, description)
+VALUES (?, ?, ?)
+ON CONFLICT(issue_id, observed_at) DO UPDATE SET
+    description = excluded.description;
+
+-- name: PruneDescriptionVersions :exec
+DELETE FROM issue_description_versions
+WHERE issue_id = ? AND observed_at NOT IN (
+    SELECT observed_at FROM issue_description_versions
+    WHERE issue_id = ?
+    ORDER BY observed_at DESC
+    LIMIT ?
+);
+
+-- name: ListDescriptionVersions :many
+SELECT issue_id, observed_at, description FROM issue_description_versions
+WHERE issue_id = ?
+ORDER BY observed_at ASC;
+
+-- name: DeleteIssueDescriptionVersions :exec
+DELETE FROM issue_description_versions WHERE issue_id = ?;
+
+-- =============================================================================
+-- Activity Log
+-- =============================================================================
+
+-- name: InsertActivity :exec
+INSERT INTO activity_log (issue_id, kind, at, day, identifier, team_key, title, from_state, to_state)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(issue_id, kind, at) DO NOTHING;
+
+-- name: ListActivityDays :many
+SELECT DISTINCT day FROM activity_log ORDER BY day ASC;
+
+-- name: ListActivityByDay :many
+SELECT issue_id, kind, at, day, identifier, team_key, title, from_state, to_state FROM activity_log
+WHERE day = ?
+ORDER BY at ASC, identifier ASC, kind ASC;
+
+-- name: PruneActivity :exec
+DELETE FROM activity_log WHERE day < ?;
+
+-- =============================================================================
+-- Scope Changes
+-- =============================================================================
+
+-- name: InsertScopeChange :exec
+INSERT INTO scope_changes (cycle_id, issue_id, kind, at, identifier, title, from_estimate, to_estimate)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(cycle_id, issue_id, kind, at) DO NOTHING;
+
+-- name: ListCycleScopeChanges :many
+SELECT cycle_id, issue_id, kind, at, identifier, title, from_estimate, to_estimate FROM scope_changes
+WHERE cycle_id = ?
+ORDER BY at ASC, identifier ASC, kind ASC;
+
 -- =============================================================================
 -- Viewer Cache
 -- =============================================================================
@@ -818,6 +994,98 @@ ON CONFLICT(singleton) DO UPDATE SET
     user_id = excluded.user_id,
     synced_at = excluded.synced_at;
 
+-- name: GetOrganizationCache :one
+SELECT synced_at, data FROM organization_cache WHERE singleton = 1;
+
+-- name: SetOrganizationCache :exec
+INSERT INTO organization_cache (singleton, synced_at, data)
+VALUES (1, ?, ?)
+ON CONFLICT(singleton) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data;
+
+-- name: GetCustomViewsCache :one
+SELECT synced_at, data FROM custom_views_cache WHERE singleton = 1;
+
+-- name: SetCustomViewsCache :exec
+INSERT INTO custom_views_cache (singleton, synced_at, data)
+VALUES (1, ?, ?)
+ON CONFLICT(singleton) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data;
+
+-- name: GetEmojiCache :one
+SELECT synced_at, data FROM emoji_cache WHERE singleton = 1;
+
+-- name: SetEmojiCache :exec
+INSERT INTO emoji_cache (singleton, synced_at, data)
+VALUES (1, ?, ?)
+ON CONFLICT(singleton) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data;
+
+-- name: GetCustomViewIssueCacheSyncedAt :one
+SELECT synced_at FROM custom_view_issue_cache WHERE view_id = ?;
+
+-- name: UpsertCustomViewIssueCache :exec
+INSERT INTO custom_view_issue_cache (view_id, synced_at, issue_ids)
+VALUES (?, ?, ?)
+ON CONFLICT(view_id) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    issue_ids = excluded.issue_ids;
+
+-- name: ListCustomViewIssues :many
+-- Issues not (yet) in the local issues table are skipped; they appear once
+-- the sync worker has them.
+SELECT * FROM issues
+WHERE id IN (SELECT value FROM json_each((SELECT issue_ids FROM custom_view_issue_cache WHERE view_id = ?)))
+ORDER BY updated_at DESC;
+
+-- name: GetTeamTemplatesCache :one
+SELECT synced_at, data FROM team_templates_cache WHERE team_id = ?;
+
+-- name: SetTeamTemplatesCache :exec
+INSERT INTO team_templates_cache (team_id, synced_at, data)
+VALUES (?, ?, ?)
+ON CONFLICT(team_id) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data;
+
+-- =============================================================================
+-- Comment Drafts
+-- =============================================================================
+
+-- name: ListCommentDrafts :many
+SELECT * FROM comment_drafts WHERE issue_id = ? ORDER BY name;
+
+-- name: GetCommentDraft :one
+SELECT * FROM comment_drafts WHERE issue_id = ? AND name = ?;
+
+-- name: UpsertCommentDraft :exec
+INSERT INTO comment_drafts (issue_id, name, body, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(issue_id, name) DO UPDATE SET
+    body = excluded.body,
+    updated_at = excluded.updated_at;
+
+-- name: RenameCommentDraft :exec
+UPDATE comment_drafts SET name = ?, updated_at = ? WHERE issue_id = ? AND name = ?;
+
+-- name: DeleteCommentDraft :exec
+DELETE FROM comment_drafts WHERE issue_id = ? AND name = ?;
+
+-- =============================================================================
+-- Recurring Issue Log
+-- =============================================================================
+
+-- name: InsertRecurringIssueLog :exec
+INSERT INTO recurring_issue_log (name, occurrence, issue_id, identifier, created_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(name, occurrence) DO NOTHING;
+
+-- name: ListRecurringIssueLog :many
+SELECT * FROM recurring_issue_log WHERE name = ? ORDER BY occurrence DESC;
+
 -- =============================================================================
 -- Pending Detail Sync Queue
 -- =============================================================================
@@ -834,4 +1102,95 @@ DELETE FROM pending_detail_sync WHERE issue_id = ?;
 SELECT issue_id, identifier FROM pending_detail_sync ORDER BY queued_at;
 
 -- name: CountPendingDetailSync :one
-SELECT COUNT(*) FROM pending_detail_sync;
\ No newline at end of file
+SELECT COUNT(*) FROM pending_detail_sync;
+
+-- =============================================================================
+-- Pending Issue Fields
+-- =============================================================================
+
+-- name: UpsertPendingIssueFields :exec
+INSERT INTO pending_issue_fields (issue_id, queued_at)
+VALUES (?, ?)
+ON CONFLICT(issue_id) DO UPDATE SET queued_at = excluded.queued_at;
+
+-- name: DeletePendingIssueFields :exec
+DELETE FROM pending_issue_fields WHERE issue_id = ?;
+
+-- name: HasPendingIssueFields :one
+SELECT COUNT(*) FROM pending_issue_fields WHERE issue_id = ?;
+
+-- =============================================================================
+-- Mutation Queue
+-- =============================================================================
+
+-- name: EnqueueMutation :one
+INSERT INTO mutation_queue (kind, entity_id, label, payload, queued_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id;
+
+-- name: ListQueuedMutations :many
+SELECT * FROM mutation_queue ORDER BY id;
+
+-- name: CountEntityQueuedMutations :one
+SELECT COUNT(*) FROM mutation_queue WHERE entity_id = ?;
+
+-- name: RecordMutationAttempt :exec
+UPDATE mutation_queue SET attempts = attempts + 1, last_error = ? WHERE id = ?;
+
+-- name: DeleteQueuedMutation :exec
+DELETE FROM mutation_queue WHERE id = ?;
+
+-- API budget queries
+
+-- name: UpsertAPIBudgetWindow :exec
+INSERT INTO api_budget_windows (axis, limit_value, remaining, reset_at, observed_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(axis) DO UPDATE SET
+    limit_value = excluded.limit_value,
+    remaining = excluded.remaining,
+    reset_at = excluded.reset_at,
+    observed_at = excluded.observed_at;
+
+-- name: ListAPIBudgetWindows :many
+SELECT * FROM api_budget_windows ORDER BY axis;
+
+-- name: AddAPIBudgetHour :exec
+INSERT INTO api_budget_hours (hour, requests, complexity)
+VALUES (?, ?, ?)
+ON CONFLICT(hour) DO UPDATE SET
+    requests = api_budget_hours.requests + excluded.requests,
+    complexity = api_budget_hours.complexity + excluded.complexity;
+
+-- name: ListAPIBudgetHoursSince :many
+SELECT * FROM api_budget_hours WHERE hour >= ? ORDER BY hour;
+
+-- name: DeleteAPIBudgetHoursBefore :exec
+DELETE FROM api_budget_hours WHERE hour < ?;
+
+-- Webhook delivery queries
+
+-- name: RecordWebhookDelivery :execrows
+-- Zero rows affected means the delivery was already recorded.
+INSERT INTO webhook_deliveries (delivery_id, received_at)
+VALUES (?, ?)
+ON CONFLICT(delivery_id) DO NOTHING;
+
+-- name: DeleteWebhookDelivery :exec
+DELETE FROM webhook_deliveries WHERE delivery_id = ?;
+
+-- name: DeleteWebhookDeliveriesBefore :exec
+DELETE FROM webhook_deliveries WHERE received_at < ?;
+
+-- Telemetry queries
+
+-- name: CountCachedRows :one
+-- The cached entity tables' row counts, for the linearfs.db.rows gauge.
+SELECT
+    (SELECT COUNT(*) FROM issues) AS issues,
+    (SELECT COUNT(*) FROM comments) AS comments,
+    (SELECT COUNT(*) FROM documents) AS documents,
+    (SELECT COUNT(*) FROM projects) AS projects,
+    (SELECT COUNT(*) FROM initiatives) AS initiatives,
+    (SELECT COUNT(*) FROM attachments) AS attachments,
+    (SELECT COUNT(*) FROM users) AS users,
+    (SELECT COUNT(*) FROM embedded_files) AS embedded_files;
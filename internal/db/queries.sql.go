@@ -12,6 +12,76 @@ import (
 	"time"
 )
 
+const addAPIBudgetHour = `-- name: AddAPIBudgetHour :exec
+INSERT INTO api_budget_hours (hour, requests, complexity)
+VALUES (?, ?, ?)
+ON CONFLICT(hour) DO UPDATE SET
+    requests = api_budget_hours.requests + excluded.requests,
+    complexity = api_budget_hours.complexity + excluded.complexity
+`
+
+type AddAPIBudgetHourParams struct {
+	Hour       time.Time `json:"hour"`
+	Requests   int64     `json:"requests"`
+	Complexity float64   `json:"complexity"`
+}
+
+func (q *Queries) AddAPIBudgetHour(ctx context.Context, arg AddAPIBudgetHourParams) error {
+	_, err := q.db.ExecContext(ctx, addAPIBudgetHour, arg.Hour, arg.Requests, arg.Complexity)
+	return err
+}
+
+const countCachedRows = `-- name: CountCachedRows :one
+SELECT
+    (SELECT COUNT(*) FROM issues) AS issues,
+    (SELECT COUNT(*) FROM comments) AS comments,
+    (SELECT COUNT(*) FROM documents) AS documents,
+    (SELECT COUNT(*) FROM projects) AS projects,
+    (SELECT COUNT(*) FROM initiatives) AS initiatives,
+    (SELECT COUNT(*) FROM attachments) AS attachments,
+    (SELECT COUNT(*) FROM users) AS users,
+    (SELECT COUNT(*) FROM embedded_files) AS embedded_files
+`
+
+type CountCachedRowsRow struct {
+	Issues        int64 `json:"issues"`
+	Comments      int64 `json:"comments"`
+	Documents     int64 `json:"documents"`
+	Projects      int64 `json:"projects"`
+	Initiatives   int64 `json:"initiatives"`
+	Attachments   int64 `json:"attachments"`
+	Users         int64 `json:"users"`
+	EmbeddedFiles int64 `json:"embedded_files"`
+}
+
+// The cached entity tables' row counts, for the linearfs.db.rows gauge.
+func (q *Queries) CountCachedRows(ctx context.Context) (CountCachedRowsRow, error) {
+	row := q.db.QueryRowContext(ctx, countCachedRows)
+	var i CountCachedRowsRow
+	err := row.Scan(
+		&i.Issues,
+		&i.Comments,
+		&i.Documents,
+		&i.Projects,
+		&i.Initiatives,
+		&i.Attachments,
+		&i.Users,
+		&i.EmbeddedFiles,
+	)
+	return i, err
+}
+
+const countEntityQueuedMutations = `-- name: CountEntityQueuedMutations :one
+SELECT COUNT(*) FROM mutation_queue WHERE entity_id = ?
+`
+
+func (q *Queries) CountEntityQueuedMutations(ctx context.Context, entityID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countEntityQueuedMutations, entityID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countPendingDetailSync = `-- name: CountPendingDetailSync :one
 SELECT COUNT(*) FROM pending_detail_sync
 `
@@ -23,6 +93,24 @@ func (q *Queries) CountPendingDetailSync(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const deleteAPIBudgetHoursBefore = `-- name: DeleteAPIBudgetHoursBefore :exec
+DELETE FROM api_budget_hours WHERE hour < ?
+`
+
+func (q *Queries) DeleteAPIBudgetHoursBefore(ctx context.Context, hour time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteAPIBudgetHoursBefore, hour)
+	return err
+}
+
+const deleteArchivedIssue = `-- name: DeleteArchivedIssue :exec
+DELETE FROM archived_issues WHERE id = ?
+`
+
+func (q *Queries) DeleteArchivedIssue(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteArchivedIssue, id)
+	return err
+}
+
 const deleteAttachment = `-- name: DeleteAttachment :exec
 DELETE FROM attachments WHERE id = ?
 `
@@ -41,6 +129,20 @@ func (q *Queries) DeleteComment(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteCommentDraft = `-- name: DeleteCommentDraft :exec
+DELETE FROM comment_drafts WHERE issue_id = ? AND name = ?
+`
+
+type DeleteCommentDraftParams struct {
+	IssueID string `json:"issue_id"`
+	Name    string `json:"name"`
+}
+
+func (q *Queries) DeleteCommentDraft(ctx context.Context, arg DeleteCommentDraftParams) error {
+	_, err := q.db.ExecContext(ctx, deleteCommentDraft, arg.IssueID, arg.Name)
+	return err
+}
+
 const deleteDocument = `-- name: DeleteDocument :exec
 DELETE FROM documents WHERE id = ?
 `
@@ -154,6 +256,15 @@ func (q *Queries) DeleteIssueComments(ctx context.Context, issueID string) error
 	return err
 }
 
+const deleteIssueDescriptionVersions = `-- name: DeleteIssueDescriptionVersions :exec
+DELETE FROM issue_description_versions WHERE issue_id = ?
+`
+
+func (q *Queries) DeleteIssueDescriptionVersions(ctx context.Context, issueID string) error {
+	_, err := q.db.ExecContext(ctx, deleteIssueDescriptionVersions, issueID)
+	return err
+}
+
 const deleteIssueDocuments = `-- name: DeleteIssueDocuments :exec
 DELETE FROM documents WHERE issue_id = ?
 `
@@ -217,6 +328,15 @@ func (q *Queries) DeletePendingDetailSync(ctx context.Context, issueID string) e
 	return err
 }
 
+const deletePendingIssueFields = `-- name: DeletePendingIssueFields :exec
+DELETE FROM pending_issue_fields WHERE issue_id = ?
+`
+
+func (q *Queries) DeletePendingIssueFields(ctx context.Context, issueID string) error {
+	_, err := q.db.ExecContext(ctx, deletePendingIssueFields, issueID)
+	return err
+}
+
 const deleteProject = `-- name: DeleteProject :exec
 DELETE FROM projects WHERE id = ?
 `
@@ -280,6 +400,15 @@ func (q *Queries) DeleteProjectUpdates(ctx context.Context, projectID string) er
 	return err
 }
 
+const deleteQueuedMutation = `-- name: DeleteQueuedMutation :exec
+DELETE FROM mutation_queue WHERE id = ?
+`
+
+func (q *Queries) DeleteQueuedMutation(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteQueuedMutation, id)
+	return err
+}
+
 const deleteTeamDocuments = `-- name: DeleteTeamDocuments :exec
 DELETE FROM documents WHERE team_id = ?
 `
@@ -289,14 +418,160 @@ func (q *Queries) DeleteTeamDocuments(ctx context.Context, teamID sql.NullString
 	return err
 }
 
-const getInitiative = `-- name: GetInitiative :one
+const deleteWebhookDeliveriesBefore = `-- name: DeleteWebhookDeliveriesBefore :exec
+DELETE FROM webhook_deliveries WHERE received_at < ?
+`
+
+func (q *Queries) DeleteWebhookDeliveriesBefore(ctx context.Context, receivedAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteWebhookDeliveriesBefore, receivedAt)
+	return err
+}
 
-SELECT id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data FROM initiatives WHERE id = ?
+const deleteWebhookDelivery = `-- name: DeleteWebhookDelivery :exec
+DELETE FROM webhook_deliveries WHERE delivery_id = ?
+`
+
+func (q *Queries) DeleteWebhookDelivery(ctx context.Context, deliveryID string) error {
+	_, err := q.db.ExecContext(ctx, deleteWebhookDelivery, deliveryID)
+	return err
+}
+
+const enqueueMutation = `-- name: EnqueueMutation :one
+INSERT INTO mutation_queue (kind, entity_id, label, payload, queued_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id
+`
+
+type EnqueueMutationParams struct {
+	Kind     string          `json:"kind"`
+	EntityID string          `json:"entity_id"`
+	Label    string          `json:"label"`
+	Payload  json.RawMessage `json:"payload"`
+	QueuedAt time.Time       `json:"queued_at"`
+}
+
+func (q *Queries) EnqueueMutation(ctx context.Context, arg EnqueueMutationParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, enqueueMutation,
+		arg.Kind,
+		arg.EntityID,
+		arg.Label,
+		arg.Payload,
+		arg.QueuedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getArchivedIssueByID = `-- name: GetArchivedIssueByID :one
+SELECT id, identifier, team_id, title, archived_at, updated_at, synced_at, data FROM archived_issues WHERE id = ?
+`
+
+func (q *Queries) GetArchivedIssueByID(ctx context.Context, id string) (ArchivedIssue, error) {
+	row := q.db.QueryRowContext(ctx, getArchivedIssueByID, id)
+	var i ArchivedIssue
+	err := row.Scan(
+		&i.ID,
+		&i.Identifier,
+		&i.TeamID,
+		&i.Title,
+		&i.ArchivedAt,
+		&i.UpdatedAt,
+		&i.SyncedAt,
+		&i.Data,
+	)
+	return i, err
+}
+
+const getArchivedIssueByIdentifier = `-- name: GetArchivedIssueByIdentifier :one
+SELECT id, identifier, team_id, title, archived_at, updated_at, synced_at, data FROM archived_issues WHERE identifier = ?
+`
+
+func (q *Queries) GetArchivedIssueByIdentifier(ctx context.Context, identifier string) (ArchivedIssue, error) {
+	row := q.db.QueryRowContext(ctx, getArchivedIssueByIdentifier, identifier)
+	var i ArchivedIssue
+	err := row.Scan(
+		&i.ID,
+		&i.Identifier,
+		&i.TeamID,
+		&i.Title,
+		&i.ArchivedAt,
+		&i.UpdatedAt,
+		&i.SyncedAt,
+		&i.Data,
+	)
+	return i, err
+}
+
+const getCommentDraft = `-- name: GetCommentDraft :one
+SELECT issue_id, name, body, created_at, updated_at FROM comment_drafts WHERE issue_id = ? AND name = ?
+`
+
+type GetCommentDraftParams struct {
+	IssueID string `json:"issue_id"`
+	Name    string `json:"name"`
+}
+
+func (q *Queries) GetCommentDraft(ctx context.Context, arg GetCommentDraftParams) (CommentDraft, error) {
+	row := q.db.QueryRowContext(ctx, getCommentDraft, arg.IssueID, arg.Name)
+	var i CommentDraft
+	err := row.Scan(
+		&i.IssueID,
+		&i.Name,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCustomViewIssueCacheSyncedAt = `-- name: GetCustomViewIssueCacheSyncedAt :one
+SELECT synced_at FROM custom_view_issue_cache WHERE view_id = ?
+`
+
+func (q *Queries) GetCustomViewIssueCacheSyncedAt(ctx context.Context, viewID string) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getCustomViewIssueCacheSyncedAt, viewID)
+	var syncedAt time.Time
+	err := row.Scan(&syncedAt)
+	return syncedAt, err
+}
+
+const getCustomViewsCache = `-- name: GetCustomViewsCache :one
+SELECT synced_at, data FROM custom_views_cache WHERE singleton = 1
+`
+
+type GetCustomViewsCacheRow struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) GetCustomViewsCache(ctx context.Context) (GetCustomViewsCacheRow, error) {
+	row := q.db.QueryRowContext(ctx, getCustomViewsCache)
+	var i GetCustomViewsCacheRow
+	err := row.Scan(&i.SyncedAt, &i.Data)
+	return i, err
+}
+
+const getEmojiCache = `-- name: GetEmojiCache :one
+SELECT synced_at, data FROM emoji_cache WHERE singleton = 1
+`
+
+type GetEmojiCacheRow struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) GetEmojiCache(ctx context.Context) (GetEmojiCacheRow, error) {
+	row := q.db.QueryRowContext(ctx, getEmojiCache)
+	var i GetEmojiCacheRow
+	err := row.Scan(&i.SyncedAt, &i.Data)
+	return i, err
+}
+
+const getInitiative = `-- name: GetInitiative :one
+SELECT id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data, parent_id FROM initiatives WHERE id = ?
 `
 
-// =============================================================================
-// Initiatives queries
-// =============================================================================
 func (q *Queries) GetInitiative(ctx context.Context, id string) (Initiative, error) {
 	row := q.db.QueryRowContext(ctx, getInitiative, id)
 	var i Initiative
@@ -316,6 +591,7 @@ func (q *Queries) GetInitiative(ctx context.Context, id string) (Initiative, err
 		&i.UpdatedAt,
 		&i.SyncedAt,
 		&i.Data,
+		&i.ParentID,
 	)
 	return i, err
 }
@@ -537,6 +813,20 @@ func (q *Queries) GetLabelByName(ctx context.Context, arg GetLabelByNameParams)
 	return i, err
 }
 
+const getLatestDescriptionVersion = `-- name: GetLatestDescriptionVersion :one
+SELECT description FROM issue_description_versions
+WHERE issue_id = ?
+ORDER BY observed_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestDescriptionVersion(ctx context.Context, issueID string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getLatestDescriptionVersion, issueID)
+	var description string
+	err := row.Scan(&description)
+	return description, err
+}
+
 const getLatestTeamIssueUpdatedAt = `-- name: GetLatestTeamIssueUpdatedAt :one
 SELECT MAX(updated_at) FROM issues WHERE team_id = ?
 `
@@ -548,6 +838,22 @@ func (q *Queries) GetLatestTeamIssueUpdatedAt(ctx context.Context, teamID string
 	return max, err
 }
 
+const getOrganizationCache = `-- name: GetOrganizationCache :one
+SELECT synced_at, data FROM organization_cache WHERE singleton = 1
+`
+
+type GetOrganizationCacheRow struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) GetOrganizationCache(ctx context.Context) (GetOrganizationCacheRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationCache)
+	var i GetOrganizationCacheRow
+	err := row.Scan(&i.SyncedAt, &i.Data)
+	return i, err
+}
+
 const getProject = `-- name: GetProject :one
 
 SELECT id, slug_id, name, description, icon, color, state, progress, start_date, target_date, lead_id, url, created_at, updated_at, synced_at, data FROM projects WHERE id = ?
@@ -767,6 +1073,22 @@ func (q *Queries) GetTeamIssueCount(ctx context.Context, teamID string) (int64,
 	return count, err
 }
 
+const getTeamTemplatesCache = `-- name: GetTeamTemplatesCache :one
+SELECT synced_at, data FROM team_templates_cache WHERE team_id = ?
+`
+
+type GetTeamTemplatesCacheRow struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) GetTeamTemplatesCache(ctx context.Context, teamID string) (GetTeamTemplatesCacheRow, error) {
+	row := q.db.QueryRowContext(ctx, getTeamTemplatesCache, teamID)
+	var i GetTeamTemplatesCacheRow
+	err := row.Scan(&i.SyncedAt, &i.Data)
+	return i, err
+}
+
 const getUser = `-- name: GetUser :one
 
 SELECT id, email, name, display_name, avatar_url, active, admin, created_at, updated_at, synced_at, data FROM users WHERE id = ?
@@ -809,51 +1131,131 @@ func (q *Queries) GetViewerUserID(ctx context.Context) (string, error) {
 	return user_id, err
 }
 
-const listCycleIssues = `-- name: ListCycleIssues :many
-SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE cycle_id = ? ORDER BY updated_at DESC
+const hasPendingIssueFields = `-- name: HasPendingIssueFields :one
+SELECT COUNT(*) FROM pending_issue_fields WHERE issue_id = ?
 `
 
-func (q *Queries) ListCycleIssues(ctx context.Context, cycleID sql.NullString) ([]Issue, error) {
-	rows, err := q.db.QueryContext(ctx, listCycleIssues, cycleID)
+func (q *Queries) HasPendingIssueFields(ctx context.Context, issueID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, hasPendingIssueFields, issueID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const insertActivity = `-- name: InsertActivity :exec
+INSERT INTO activity_log (issue_id, kind, at, day, identifier, team_key, title, from_state, to_state)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(issue_id, kind, at) DO NOTHING
+`
+
+type InsertActivityParams struct {
+	IssueID    string    `json:"issue_id"`
+	Kind       string    `json:"kind"`
+	At         time.Time `json:"at"`
+	Day        string    `json:"day"`
+	Identifier string    `json:"identifier"`
+	TeamKey    string    `json:"team_key"`
+	Title      string    `json:"title"`
+	FromState  string    `json:"from_state"`
+	ToState    string    `json:"to_state"`
+}
+
+func (q *Queries) InsertActivity(ctx context.Context, arg InsertActivityParams) error {
+	_, err := q.db.ExecContext(ctx, insertActivity,
+		arg.IssueID,
+		arg.Kind,
+		arg.At,
+		arg.Day,
+		arg.Identifier,
+		arg.TeamKey,
+		arg.Title,
+		arg.FromState,
+		arg.ToState,
+	)
+	return err
+}
+
+const insertRecurringIssueLog = `-- name: InsertRecurringIssueLog :exec
+INSERT INTO recurring_issue_log (name, occurrence, issue_id, identifier, created_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(name, occurrence) DO NOTHING
+`
+
+type InsertRecurringIssueLogParams struct {
+	Name       string    `json:"name"`
+	Occurrence time.Time `json:"occurrence"`
+	IssueID    string    `json:"issue_id"`
+	Identifier string    `json:"identifier"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (q *Queries) InsertRecurringIssueLog(ctx context.Context, arg InsertRecurringIssueLogParams) error {
+	_, err := q.db.ExecContext(ctx, insertRecurringIssueLog,
+		arg.Name,
+		arg.Occurrence,
+		arg.IssueID,
+		arg.Identifier,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertScopeChange = `-- name: InsertScopeChange :exec
+INSERT INTO scope_changes (cycle_id, issue_id, kind, at, identifier, title, from_estimate, to_estimate)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(cycle_id, issue_id, kind, at) DO NOTHING
+`
+
+type InsertScopeChangeParams struct {
+	CycleID      string          `json:"cycle_id"`
+	IssueID      string          `json:"issue_id"`
+	Kind         string          `json:"kind"`
+	At           time.Time       `json:"at"`
+	Identifier   string          `json:"identifier"`
+	Title        string          `json:"title"`
+	FromEstimate sql.NullFloat64 `json:"from_estimate"`
+	ToEstimate   sql.NullFloat64 `json:"to_estimate"`
+}
+
+func (q *Queries) InsertScopeChange(ctx context.Context, arg InsertScopeChangeParams) error {
+	_, err := q.db.ExecContext(ctx, insertScopeChange,
+		arg.CycleID,
+		arg.IssueID,
+		arg.Kind,
+		arg.At,
+		arg.Identifier,
+		arg.Title,
+		arg.FromEstimate,
+		arg.ToEstimate,
+	)
+	return err
+}
+
+const listActivityByDay = `-- name: ListActivityByDay :many
+SELECT issue_id, kind, at, day, identifier, team_key, title, from_state, to_state FROM activity_log
+WHERE day = ?
+ORDER BY at ASC, identifier ASC, kind ASC
+`
+
+func (q *Queries) ListActivityByDay(ctx context.Context, day string) ([]ActivityLog, error) {
+	rows, err := q.db.QueryContext(ctx, listActivityByDay, day)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Issue{}
+	items := []ActivityLog{}
 	for rows.Next() {
-		var i Issue
+		var i ActivityLog
 		if err := rows.Scan(
-			&i.ID,
+			&i.IssueID,
+			&i.Kind,
+			&i.At,
+			&i.Day,
 			&i.Identifier,
-			&i.TeamID,
+			&i.TeamKey,
 			&i.Title,
-			&i.Description,
-			&i.StateID,
-			&i.StateName,
-			&i.StateType,
-			&i.AssigneeID,
-			&i.AssigneeEmail,
-			&i.CreatorID,
-			&i.CreatorEmail,
-			&i.Priority,
-			&i.ProjectID,
-			&i.ProjectName,
-			&i.CycleID,
-			&i.CycleName,
-			&i.ParentID,
-			&i.DueDate,
-			&i.Estimate,
-			&i.Url,
-			&i.BranchName,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.StartedAt,
-			&i.CompletedAt,
-			&i.CanceledAt,
-			&i.ArchivedAt,
-			&i.SyncedAt,
-			&i.DetailSyncedAt,
-			&i.Data,
+			&i.FromState,
+			&i.ToState,
 		); err != nil {
 			return nil, err
 		}
@@ -868,24 +1270,1022 @@ func (q *Queries) ListCycleIssues(ctx context.Context, cycleID sql.NullString) (
 	return items, nil
 }
 
-const listInitiativeDocuments = `-- name: ListInitiativeDocuments :many
-SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE initiative_id = ? ORDER BY title
+const listActivityDays = `-- name: ListActivityDays :many
+SELECT DISTINCT day FROM activity_log ORDER BY day ASC
 `
 
-func (q *Queries) ListInitiativeDocuments(ctx context.Context, initiativeID sql.NullString) ([]Document, error) {
-	rows, err := q.db.QueryContext(ctx, listInitiativeDocuments, initiativeID)
+func (q *Queries) ListActivityDays(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listActivityDays)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Document{}
+	items := []string{}
 	for rows.Next() {
-		var i Document
-		if err := rows.Scan(
-			&i.ID,
-			&i.SlugID,
-			&i.Title,
-			&i.Icon,
+		var day string
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		items = append(items, day)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAPIBudgetHoursSince = `-- name: ListAPIBudgetHoursSince :many
+SELECT hour, requests, complexity FROM api_budget_hours WHERE hour >= ? ORDER BY hour
+`
+
+func (q *Queries) ListAPIBudgetHoursSince(ctx context.Context, hour time.Time) ([]ApiBudgetHour, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIBudgetHoursSince, hour)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiBudgetHour{}
+	for rows.Next() {
+		var i ApiBudgetHour
+		if err := rows.Scan(&i.Hour, &i.Requests, &i.Complexity); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAPIBudgetWindows = `-- name: ListAPIBudgetWindows :many
+SELECT axis, limit_value, remaining, reset_at, observed_at FROM api_budget_windows ORDER BY axis
+`
+
+func (q *Queries) ListAPIBudgetWindows(ctx context.Context) ([]ApiBudgetWindow, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIBudgetWindows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiBudgetWindow{}
+	for rows.Next() {
+		var i ApiBudgetWindow
+		if err := rows.Scan(
+			&i.Axis,
+			&i.LimitValue,
+			&i.Remaining,
+			&i.ResetAt,
+			&i.ObservedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChildInitiatives = `-- name: ListChildInitiatives :many
+SELECT id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data, parent_id FROM initiatives WHERE parent_id = ? ORDER BY sort_order, name
+`
+
+func (q *Queries) ListChildInitiatives(ctx context.Context, parentID sql.NullString) ([]Initiative, error) {
+	rows, err := q.db.QueryContext(ctx, listChildInitiatives, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Initiative{}
+	for rows.Next() {
+		var i Initiative
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlugID,
+			&i.Name,
+			&i.Description,
+			&i.Icon,
+			&i.Color,
+			&i.Status,
+			&i.SortOrder,
+			&i.TargetDate,
+			&i.OwnerID,
+			&i.Url,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+			&i.ParentID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentDrafts = `-- name: ListCommentDrafts :many
+SELECT issue_id, name, body, created_at, updated_at FROM comment_drafts WHERE issue_id = ? ORDER BY name
+`
+
+func (q *Queries) ListCommentDrafts(ctx context.Context, issueID string) ([]CommentDraft, error) {
+	rows, err := q.db.QueryContext(ctx, listCommentDrafts, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CommentDraft{}
+	for rows.Next() {
+		var i CommentDraft
+		if err := rows.Scan(
+			&i.IssueID,
+			&i.Name,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCustomViewIssues = `-- name: ListCustomViewIssues :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues
+WHERE id IN (SELECT value FROM json_each((SELECT issue_ids FROM custom_view_issue_cache WHERE view_id = ?)))
+ORDER BY updated_at DESC
+`
+
+// Issues not (yet) in the local issues table are skipped; they appear once
+// the sync worker has them.
+func (q *Queries) ListCustomViewIssues(ctx context.Context, viewID string) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listCustomViewIssues, viewID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Issue{}
+	for rows.Next() {
+		var i Issue
+		if err := rows.Scan(
+			&i.ID,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
+			&i.Description,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
+			&i.CreatorID,
+			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
+			&i.Url,
+			&i.BranchName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
+			&i.SyncedAt,
+			&i.DetailSyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCycleIssues = `-- name: ListCycleIssues :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE cycle_id = ? ORDER BY updated_at DESC
+`
+
+func (q *Queries) ListCycleIssues(ctx context.Context, cycleID sql.NullString) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listCycleIssues, cycleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Issue{}
+	for rows.Next() {
+		var i Issue
+		if err := rows.Scan(
+			&i.ID,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
+			&i.Description,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
+			&i.CreatorID,
+			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
+			&i.Url,
+			&i.BranchName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
+			&i.SyncedAt,
+			&i.DetailSyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCycleScopeChanges = `-- name: ListCycleScopeChanges :many
+SELECT cycle_id, issue_id, kind, at, identifier, title, from_estimate, to_estimate FROM scope_changes
+WHERE cycle_id = ?
+ORDER BY at ASC, identifier ASC, kind ASC
+`
+
+func (q *Queries) ListCycleScopeChanges(ctx context.Context, cycleID string) ([]ScopeChange, error) {
+	rows, err := q.db.QueryContext(ctx, listCycleScopeChanges, cycleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScopeChange{}
+	for rows.Next() {
+		var i ScopeChange
+		if err := rows.Scan(
+			&i.CycleID,
+			&i.IssueID,
+			&i.Kind,
+			&i.At,
+			&i.Identifier,
+			&i.Title,
+			&i.FromEstimate,
+			&i.ToEstimate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDescriptionVersions = `-- name: ListDescriptionVersions :many
+SELECT issue_id, observed_at, description FROM issue_description_versions
+WHERE issue_id = ?
+ORDER BY observed_at ASC
+`
+
+func (q *Queries) ListDescriptionVersions(ctx context.Context, issueID string) ([]IssueDescriptionVersion, error) {
+	rows, err := q.db.QueryContext(ctx, listDescriptionVersions, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []IssueDescriptionVersion{}
+	for rows.Next() {
+		var i IssueDescriptionVersion
+		if err := rows.Scan(&i.IssueID, &i.ObservedAt, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInitiativeDocuments = `-- name: ListInitiativeDocuments :many
+SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE initiative_id = ? ORDER BY title
+`
+
+func (q *Queries) ListInitiativeDocuments(ctx context.Context, initiativeID sql.NullString) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listInitiativeDocuments, initiativeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Document{}
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlugID,
+			&i.Title,
+			&i.Icon,
+			&i.Color,
+			&i.Content,
+			&i.ContentData,
+			&i.IssueID,
+			&i.ProjectID,
+			&i.InitiativeID,
+			&i.TeamID,
+			&i.CreatorID,
+			&i.Url,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInitiativeLinks = `-- name: ListInitiativeLinks :many
+SELECT id, project_id, initiative_id, label, url, sort_order, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM entity_external_links WHERE initiative_id = ? ORDER BY sort_order, id
+`
+
+func (q *Queries) ListInitiativeLinks(ctx context.Context, initiativeID sql.NullString) ([]EntityExternalLink, error) {
+	rows, err := q.db.QueryContext(ctx, listInitiativeLinks, initiativeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EntityExternalLink{}
+	for rows.Next() {
+		var i EntityExternalLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.InitiativeID,
+			&i.Label,
+			&i.Url,
+			&i.SortOrder,
+			&i.CreatorID,
+			&i.CreatorName,
+			&i.CreatorEmail,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInitiativeUpdates = `-- name: ListInitiativeUpdates :many
+
+SELECT id, initiative_id, body, body_data, health, user_id, user_name, url, edited_at, created_at, updated_at, synced_at, data FROM initiative_updates WHERE initiative_id = ? ORDER BY created_at DESC
+`
+
+// =============================================================================
+// Initiative Updates queries
+// =============================================================================
+func (q *Queries) ListInitiativeUpdates(ctx context.Context, initiativeID string) ([]InitiativeUpdate, error) {
+	rows, err := q.db.QueryContext(ctx, listInitiativeUpdates, initiativeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InitiativeUpdate{}
+	for rows.Next() {
+		var i InitiativeUpdate
+		if err := rows.Scan(
+			&i.ID,
+			&i.InitiativeID,
+			&i.Body,
+			&i.BodyData,
+			&i.Health,
+			&i.UserID,
+			&i.UserName,
+			&i.Url,
+			&i.EditedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInitiatives = `-- name: ListInitiatives :many
+SELECT id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data, parent_id FROM initiatives ORDER BY sort_order, name
+`
+
+func (q *Queries) ListInitiatives(ctx context.Context) ([]Initiative, error) {
+	rows, err := q.db.QueryContext(ctx, listInitiatives)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Initiative{}
+	for rows.Next() {
+		var i Initiative
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlugID,
+			&i.Name,
+			&i.Description,
+			&i.Icon,
+			&i.Color,
+			&i.Status,
+			&i.SortOrder,
+			&i.TargetDate,
+			&i.OwnerID,
+			&i.Url,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+			&i.ParentID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueAttachments = `-- name: ListIssueAttachments :many
+
+SELECT id, issue_id, title, subtitle, url, source_type, metadata, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM attachments WHERE issue_id = ? ORDER BY created_at, id
+`
+
+// =============================================================================
+// Attachments queries (external links: GitHub PRs, Slack, etc.)
+// =============================================================================
+// The id tiebreaker keeps the order deterministic on equal created_at, so
+// attachmentListing dedup suffixes stay stable across calls.
+func (q *Queries) ListIssueAttachments(ctx context.Context, issueID string) ([]Attachment, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueAttachments, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.Title,
+			&i.Subtitle,
+			&i.Url,
+			&i.SourceType,
+			&i.Metadata,
+			&i.CreatorID,
+			&i.CreatorName,
+			&i.CreatorEmail,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueComments = `-- name: ListIssueComments :many
+
+SELECT id, issue_id, body, body_data, user_id, user_name, user_email, edited_at, created_at, updated_at, synced_at, data, deleted_at FROM comments WHERE issue_id = ? AND deleted_at IS NULL ORDER BY created_at, id
+`
+
+// =============================================================================
+// Comments queries
+// =============================================================================
+func (q *Queries) ListIssueComments(ctx context.Context, issueID string) ([]Comment, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueComments, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Comment{}
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.Body,
+			&i.BodyData,
+			&i.UserID,
+			&i.UserName,
+			&i.UserEmail,
+			&i.EditedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueCommentsWithDeleted = `-- name: ListIssueCommentsWithDeleted :many
+
+SELECT id, issue_id, body, body_data, user_id, user_name, user_email, edited_at, created_at, updated_at, synced_at, data, deleted_at FROM comments WHERE issue_id = ? ORDER BY created_at, id
+`
+
+// ListIssueCommentsWithDeleted includes tombstones, which the comments/
+// listing shows struck through under display.show_deleted_comments.
+func (q *Queries) ListIssueCommentsWithDeleted(ctx context.Context, issueID string) ([]Comment, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueCommentsWithDeleted, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Comment{}
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.Body,
+			&i.BodyData,
+			&i.UserID,
+			&i.UserName,
+			&i.UserEmail,
+			&i.EditedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueDocuments = `-- name: ListIssueDocuments :many
+
+SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE issue_id = ? ORDER BY title
+`
+
+// =============================================================================
+// Documents queries
+// =============================================================================
+func (q *Queries) ListIssueDocuments(ctx context.Context, issueID sql.NullString) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueDocuments, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Document{}
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlugID,
+			&i.Title,
+			&i.Icon,
+			&i.Color,
+			&i.Content,
+			&i.ContentData,
+			&i.IssueID,
+			&i.ProjectID,
+			&i.InitiativeID,
+			&i.TeamID,
+			&i.CreatorID,
+			&i.Url,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueEmbeddedFiles = `-- name: ListIssueEmbeddedFiles :many
+
+SELECT id, issue_id, url, filename, mime_type, file_size, cache_path, source, created_at, synced_at FROM embedded_files WHERE issue_id = ? ORDER BY filename, id
+`
+
+// =============================================================================
+// Embedded Files queries (images, PDFs from Linear CDN)
+// =============================================================================
+// The id tiebreaker keeps the order deterministic on equal filenames (the
+// dedup case), so which duplicate gets the (2) suffix stays stable.
+func (q *Queries) ListIssueEmbeddedFiles(ctx context.Context, issueID string) ([]EmbeddedFile, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueEmbeddedFiles, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EmbeddedFile{}
+	for rows.Next() {
+		var i EmbeddedFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.Url,
+			&i.Filename,
+			&i.MimeType,
+			&i.FileSize,
+			&i.CachePath,
+			&i.Source,
+			&i.CreatedAt,
+			&i.SyncedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueInverseRelations = `-- name: ListIssueInverseRelations :many
+SELECT id, issue_id, related_issue_id, type, created_at, updated_at, synced_at FROM issue_relations WHERE related_issue_id = ? ORDER BY type, issue_id
+`
+
+func (q *Queries) ListIssueInverseRelations(ctx context.Context, relatedIssueID string) ([]IssueRelation, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueInverseRelations, relatedIssueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []IssueRelation{}
+	for rows.Next() {
+		var i IssueRelation
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.RelatedIssueID,
+			&i.Type,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueOpenBlockers = `-- name: ListIssueOpenBlockers :many
+SELECT b.id, b.identifier, b.team_id, b.title, b.description, b.state_id, b.state_name, b.state_type, b.assignee_id, b.assignee_email, b.creator_id, b.creator_email, b.priority, b.project_id, b.project_name, b.cycle_id, b.cycle_name, b.parent_id, b.due_date, b.estimate, b.url, b.branch_name, b.created_at, b.updated_at, b.started_at, b.completed_at, b.canceled_at, b.archived_at, b.synced_at, b.detail_synced_at, b.data FROM issues b
+JOIN issue_relations r ON r.issue_id = b.id
+WHERE r.related_issue_id = ? AND r.type = 'blocks'
+  AND COALESCE(b.state_type, '') NOT IN ('completed', 'canceled')
+ORDER BY b.identifier
+`
+
+// The cached, still-open issues blocking an issue: owners of its incoming
+// "blocks" relations not completed or canceled. Behind issue.meta's
+// blockedBy/blocked; ListTeamBlockedIssues shares the predicate.
+func (q *Queries) ListIssueOpenBlockers(ctx context.Context, relatedIssueID string) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueOpenBlockers, relatedIssueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Issue{}
+	for rows.Next() {
+		var i Issue
+		if err := rows.Scan(
+			&i.ID,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
+			&i.Description,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
+			&i.CreatorID,
+			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
+			&i.Url,
+			&i.BranchName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
+			&i.SyncedAt,
+			&i.DetailSyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssueRelations = `-- name: ListIssueRelations :many
+
+SELECT id, issue_id, related_issue_id, type, created_at, updated_at, synced_at FROM issue_relations WHERE issue_id = ? ORDER BY type, related_issue_id
+`
+
+// =============================================================================
+// Issue Relations queries
+// =============================================================================
+func (q *Queries) ListIssueRelations(ctx context.Context, issueID string) ([]IssueRelation, error) {
+	rows, err := q.db.QueryContext(ctx, listIssueRelations, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []IssueRelation{}
+	for rows.Next() {
+		var i IssueRelation
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.RelatedIssueID,
+			&i.Type,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIssuesByMilestone = `-- name: ListIssuesByMilestone :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues
+WHERE project_id = ? AND json_extract(data, '$.projectMilestone.id') = ?
+ORDER BY updated_at DESC
+`
+
+type ListIssuesByMilestoneParams struct {
+	ProjectID   sql.NullString `json:"project_id"`
+	MilestoneID interface{}    `json:"milestone_id"`
+}
+
+// A project's issues in one of its milestones. The milestone is stored only in
+// the issue's data JSON, so project_id narrows the scan to one project's rows.
+func (q *Queries) ListIssuesByMilestone(ctx context.Context, arg ListIssuesByMilestoneParams) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listIssuesByMilestone, arg.ProjectID, arg.MilestoneID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Issue{}
+	for rows.Next() {
+		var i Issue
+		if err := rows.Scan(
+			&i.ID,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
+			&i.Description,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
+			&i.CreatorID,
+			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
+			&i.Url,
+			&i.BranchName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
+			&i.SyncedAt,
+			&i.DetailSyncedAt,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingDetailSync = `-- name: ListPendingDetailSync :many
+SELECT issue_id, identifier FROM pending_detail_sync ORDER BY queued_at
+`
+
+type ListPendingDetailSyncRow struct {
+	IssueID    string `json:"issue_id"`
+	Identifier string `json:"identifier"`
+}
+
+func (q *Queries) ListPendingDetailSync(ctx context.Context) ([]ListPendingDetailSyncRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPendingDetailSync)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingDetailSyncRow{}
+	for rows.Next() {
+		var i ListPendingDetailSyncRow
+		if err := rows.Scan(&i.IssueID, &i.Identifier); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectDocuments = `-- name: ListProjectDocuments :many
+SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE project_id = ? ORDER BY title
+`
+
+func (q *Queries) ListProjectDocuments(ctx context.Context, projectID sql.NullString) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectDocuments, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Document{}
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.SlugID,
+			&i.Title,
+			&i.Icon,
 			&i.Color,
 			&i.Content,
 			&i.ContentData,
@@ -913,32 +2313,87 @@ func (q *Queries) ListInitiativeDocuments(ctx context.Context, initiativeID sql.
 	return items, nil
 }
 
-const listInitiativeLinks = `-- name: ListInitiativeLinks :many
-SELECT id, project_id, initiative_id, label, url, sort_order, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM entity_external_links WHERE initiative_id = ? ORDER BY sort_order, id
+const listProjectIssueRelations = `-- name: ListProjectIssueRelations :many
+SELECT id, issue_id, related_issue_id, type, created_at, updated_at, synced_at FROM issue_relations WHERE issue_id IN (SELECT id FROM issues WHERE project_id = ?) ORDER BY issue_id, type, related_issue_id
 `
 
-func (q *Queries) ListInitiativeLinks(ctx context.Context, initiativeID sql.NullString) ([]EntityExternalLink, error) {
-	rows, err := q.db.QueryContext(ctx, listInitiativeLinks, initiativeID)
+// Relations owned by any of a project's issues (the project graph twin of
+// ListTeamIssueRelations).
+func (q *Queries) ListProjectIssueRelations(ctx context.Context, projectID sql.NullString) ([]IssueRelation, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectIssueRelations, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []EntityExternalLink{}
+	items := []IssueRelation{}
+	for rows.Next() {
+		var i IssueRelation
+		if err := rows.Scan(
+			&i.ID,
+			&i.IssueID,
+			&i.RelatedIssueID,
+			&i.Type,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProjectIssues = `-- name: ListProjectIssues :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE project_id = ? ORDER BY updated_at DESC
+`
+
+func (q *Queries) ListProjectIssues(ctx context.Context, projectID sql.NullString) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectIssues, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Issue{}
 	for rows.Next() {
-		var i EntityExternalLink
+		var i Issue
 		if err := rows.Scan(
 			&i.ID,
-			&i.ProjectID,
-			&i.InitiativeID,
-			&i.Label,
-			&i.Url,
-			&i.SortOrder,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
+			&i.Description,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
 			&i.CreatorID,
-			&i.CreatorName,
 			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
+			&i.Url,
+			&i.BranchName,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
 			&i.SyncedAt,
+			&i.DetailSyncedAt,
 			&i.Data,
 		); err != nil {
 			return nil, err
@@ -954,33 +2409,31 @@ func (q *Queries) ListInitiativeLinks(ctx context.Context, initiativeID sql.Null
 	return items, nil
 }
 
-const listInitiativeUpdates = `-- name: ListInitiativeUpdates :many
+const listProjectLabels = `-- name: ListProjectLabels :many
 
-SELECT id, initiative_id, body, body_data, health, user_id, user_name, url, edited_at, created_at, updated_at, synced_at, data FROM initiative_updates WHERE initiative_id = ? ORDER BY created_at DESC
+SELECT id, name, color, description, is_group, parent_id, retired_at, created_at, updated_at, synced_at, data FROM project_labels ORDER BY name COLLATE NOCASE
 `
 
 // =============================================================================
-// Initiative Updates queries
+// Project labels queries (workspace-scoped catalog; see schema.sql)
 // =============================================================================
-func (q *Queries) ListInitiativeUpdates(ctx context.Context, initiativeID string) ([]InitiativeUpdate, error) {
-	rows, err := q.db.QueryContext(ctx, listInitiativeUpdates, initiativeID)
+func (q *Queries) ListProjectLabels(ctx context.Context) ([]ProjectLabel, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectLabels)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []InitiativeUpdate{}
+	items := []ProjectLabel{}
 	for rows.Next() {
-		var i InitiativeUpdate
+		var i ProjectLabel
 		if err := rows.Scan(
 			&i.ID,
-			&i.InitiativeID,
-			&i.Body,
-			&i.BodyData,
-			&i.Health,
-			&i.UserID,
-			&i.UserName,
-			&i.Url,
-			&i.EditedAt,
+			&i.Name,
+			&i.Color,
+			&i.Description,
+			&i.IsGroup,
+			&i.ParentID,
+			&i.RetiredAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
@@ -999,31 +2452,35 @@ func (q *Queries) ListInitiativeUpdates(ctx context.Context, initiativeID string
 	return items, nil
 }
 
-const listInitiatives = `-- name: ListInitiatives :many
-SELECT id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data FROM initiatives ORDER BY sort_order, name
+const listProjectLinks = `-- name: ListProjectLinks :many
+
+SELECT id, project_id, initiative_id, label, url, sort_order, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM entity_external_links WHERE project_id = ? ORDER BY sort_order, id
 `
 
-func (q *Queries) ListInitiatives(ctx context.Context) ([]Initiative, error) {
-	rows, err := q.db.QueryContext(ctx, listInitiatives)
+// =============================================================================
+// Entity external links queries (project/initiative "Links / Resources")
+// =============================================================================
+// The id tiebreaker keeps the order deterministic on equal sort_order, so
+// linkListing dedup suffixes stay stable across calls.
+func (q *Queries) ListProjectLinks(ctx context.Context, projectID sql.NullString) ([]EntityExternalLink, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectLinks, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Initiative{}
+	items := []EntityExternalLink{}
 	for rows.Next() {
-		var i Initiative
+		var i EntityExternalLink
 		if err := rows.Scan(
 			&i.ID,
-			&i.SlugID,
-			&i.Name,
-			&i.Description,
-			&i.Icon,
-			&i.Color,
-			&i.Status,
-			&i.SortOrder,
-			&i.TargetDate,
-			&i.OwnerID,
+			&i.ProjectID,
+			&i.InitiativeID,
+			&i.Label,
 			&i.Url,
+			&i.SortOrder,
+			&i.CreatorID,
+			&i.CreatorName,
+			&i.CreatorEmail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
@@ -1042,36 +2499,26 @@ func (q *Queries) ListInitiatives(ctx context.Context) ([]Initiative, error) {
 	return items, nil
 }
 
-const listIssueAttachments = `-- name: ListIssueAttachments :many
-
-SELECT id, issue_id, title, subtitle, url, source_type, metadata, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM attachments WHERE issue_id = ? ORDER BY created_at, id
+const listProjectMilestones = `-- name: ListProjectMilestones :many
+SELECT id, project_id, name, description, target_date, sort_order, created_at, updated_at, synced_at, data FROM project_milestones WHERE project_id = ? ORDER BY sort_order
 `
 
-// =============================================================================
-// Attachments queries (external links: GitHub PRs, Slack, etc.)
-// =============================================================================
-// The id tiebreaker keeps the order deterministic on equal created_at, so
-// attachmentListing dedup suffixes stay stable across calls.
-func (q *Queries) ListIssueAttachments(ctx context.Context, issueID string) ([]Attachment, error) {
-	rows, err := q.db.QueryContext(ctx, listIssueAttachments, issueID)
+func (q *Queries) ListProjectMilestones(ctx context.Context, projectID string) ([]ProjectMilestone, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectMilestones, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Attachment{}
+	items := []ProjectMilestone{}
 	for rows.Next() {
-		var i Attachment
+		var i ProjectMilestone
 		if err := rows.Scan(
 			&i.ID,
-			&i.IssueID,
-			&i.Title,
-			&i.Subtitle,
-			&i.Url,
-			&i.SourceType,
-			&i.Metadata,
-			&i.CreatorID,
-			&i.CreatorName,
-			&i.CreatorEmail,
+			&i.ProjectID,
+			&i.Name,
+			&i.Description,
+			&i.TargetDate,
+			&i.SortOrder,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
@@ -1090,31 +2537,32 @@ func (q *Queries) ListIssueAttachments(ctx context.Context, issueID string) ([]A
 	return items, nil
 }
 
-const listIssueComments = `-- name: ListIssueComments :many
+const listProjectUpdates = `-- name: ListProjectUpdates :many
 
-SELECT id, issue_id, body, body_data, user_id, user_name, user_email, edited_at, created_at, updated_at, synced_at, data FROM comments WHERE issue_id = ? ORDER BY created_at
+SELECT id, project_id, body, body_data, health, user_id, user_name, url, edited_at, created_at, updated_at, synced_at, data FROM project_updates WHERE project_id = ? ORDER BY created_at DESC
 `
 
 // =============================================================================
-// Comments queries
+// Project Updates queries
 // =============================================================================
-func (q *Queries) ListIssueComments(ctx context.Context, issueID string) ([]Comment, error) {
-	rows, err := q.db.QueryContext(ctx, listIssueComments, issueID)
+func (q *Queries) ListProjectUpdates(ctx context.Context, projectID string) ([]ProjectUpdate, error) {
+	rows, err := q.db.QueryContext(ctx, listProjectUpdates, projectID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Comment{}
+	items := []ProjectUpdate{}
 	for rows.Next() {
-		var i Comment
+		var i ProjectUpdate
 		if err := rows.Scan(
 			&i.ID,
-			&i.IssueID,
+			&i.ProjectID,
 			&i.Body,
 			&i.BodyData,
+			&i.Health,
 			&i.UserID,
 			&i.UserName,
-			&i.UserEmail,
+			&i.Url,
 			&i.EditedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -1134,36 +2582,31 @@ func (q *Queries) ListIssueComments(ctx context.Context, issueID string) ([]Comm
 	return items, nil
 }
 
-const listIssueDocuments = `-- name: ListIssueDocuments :many
-
-SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE issue_id = ? ORDER BY title
+const listProjects = `-- name: ListProjects :many
+SELECT id, slug_id, name, description, icon, color, state, progress, start_date, target_date, lead_id, url, created_at, updated_at, synced_at, data FROM projects ORDER BY name
 `
 
-// =============================================================================
-// Documents queries
-// =============================================================================
-func (q *Queries) ListIssueDocuments(ctx context.Context, issueID sql.NullString) ([]Document, error) {
-	rows, err := q.db.QueryContext(ctx, listIssueDocuments, issueID)
+func (q *Queries) ListProjects(ctx context.Context) ([]Project, error) {
+	rows, err := q.db.QueryContext(ctx, listProjects)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Document{}
+	items := []Project{}
 	for rows.Next() {
-		var i Document
+		var i Project
 		if err := rows.Scan(
 			&i.ID,
 			&i.SlugID,
-			&i.Title,
+			&i.Name,
+			&i.Description,
 			&i.Icon,
 			&i.Color,
-			&i.Content,
-			&i.ContentData,
-			&i.IssueID,
-			&i.ProjectID,
-			&i.InitiativeID,
-			&i.TeamID,
-			&i.CreatorID,
+			&i.State,
+			&i.Progress,
+			&i.StartDate,
+			&i.TargetDate,
+			&i.LeadID,
 			&i.Url,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -1183,36 +2626,28 @@ func (q *Queries) ListIssueDocuments(ctx context.Context, issueID sql.NullString
 	return items, nil
 }
 
-const listIssueEmbeddedFiles = `-- name: ListIssueEmbeddedFiles :many
-
-SELECT id, issue_id, url, filename, mime_type, file_size, cache_path, source, created_at, synced_at FROM embedded_files WHERE issue_id = ? ORDER BY filename, id
+const listQueuedMutations = `-- name: ListQueuedMutations :many
+SELECT id, kind, entity_id, label, payload, queued_at, attempts, last_error FROM mutation_queue ORDER BY id
 `
 
-// =============================================================================
-// Embedded Files queries (images, PDFs from Linear CDN)
-// =============================================================================
-// The id tiebreaker keeps the order deterministic on equal filenames (the
-// dedup case), so which duplicate gets the (2) suffix stays stable.
-func (q *Queries) ListIssueEmbeddedFiles(ctx context.Context, issueID string) ([]EmbeddedFile, error) {
-	rows, err := q.db.QueryContext(ctx, listIssueEmbeddedFiles, issueID)
+func (q *Queries) ListQueuedMutations(ctx context.Context) ([]MutationQueue, error) {
+	rows, err := q.db.QueryContext(ctx, listQueuedMutations)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []EmbeddedFile{}
+	items := []MutationQueue{}
 	for rows.Next() {
-		var i EmbeddedFile
+		var i MutationQueue
 		if err := rows.Scan(
 			&i.ID,
-			&i.IssueID,
-			&i.Url,
-			&i.Filename,
-			&i.MimeType,
-			&i.FileSize,
-			&i.CachePath,
-			&i.Source,
-			&i.CreatedAt,
-			&i.SyncedAt,
+			&i.Kind,
+			&i.EntityID,
+			&i.Label,
+			&i.Payload,
+			&i.QueuedAt,
+			&i.Attempts,
+			&i.LastError,
 		); err != nil {
 			return nil, err
 		}
@@ -1227,27 +2662,25 @@ func (q *Queries) ListIssueEmbeddedFiles(ctx context.Context, issueID string) ([
 	return items, nil
 }
 
-const listIssueInverseRelations = `-- name: ListIssueInverseRelations :many
-SELECT id, issue_id, related_issue_id, type, created_at, updated_at, synced_at FROM issue_relations WHERE related_issue_id = ? ORDER BY type, issue_id
+const listRecurringIssueLog = `-- name: ListRecurringIssueLog :many
+SELECT name, occurrence, issue_id, identifier, created_at FROM recurring_issue_log WHERE name = ? ORDER BY occurrence DESC
 `
 
-func (q *Queries) ListIssueInverseRelations(ctx context.Context, relatedIssueID string) ([]IssueRelation, error) {
-	rows, err := q.db.QueryContext(ctx, listIssueInverseRelations, relatedIssueID)
+func (q *Queries) ListRecurringIssueLog(ctx context.Context, name string) ([]RecurringIssueLog, error) {
+	rows, err := q.db.QueryContext(ctx, listRecurringIssueLog, name)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []IssueRelation{}
+	items := []RecurringIssueLog{}
 	for rows.Next() {
-		var i IssueRelation
+		var i RecurringIssueLog
 		if err := rows.Scan(
-			&i.ID,
+			&i.Name,
+			&i.Occurrence,
 			&i.IssueID,
-			&i.RelatedIssueID,
-			&i.Type,
+			&i.Identifier,
 			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.SyncedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1262,31 +2695,42 @@ func (q *Queries) ListIssueInverseRelations(ctx context.Context, relatedIssueID
 	return items, nil
 }
 
-const listIssueRelations = `-- name: ListIssueRelations :many
+const listStandaloneDocuments = `-- name: ListStandaloneDocuments :many
 
-SELECT id, issue_id, related_issue_id, type, created_at, updated_at, synced_at FROM issue_relations WHERE issue_id = ? ORDER BY type, related_issue_id
+SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents
+WHERE issue_id IS NULL AND project_id IS NULL AND initiative_id IS NULL AND team_id IS NULL
+ORDER BY title
 `
 
-// =============================================================================
-// Issue Relations queries
-// =============================================================================
-func (q *Queries) ListIssueRelations(ctx context.Context, issueID string) ([]IssueRelation, error) {
-	rows, err := q.db.QueryContext(ctx, listIssueRelations, issueID)
+// Standalone documents: owned by no issue, team, project, or initiative (the
+// root docs/ directory).
+func (q *Queries) ListStandaloneDocuments(ctx context.Context) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listStandaloneDocuments)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []IssueRelation{}
+	items := []Document{}
 	for rows.Next() {
-		var i IssueRelation
+		var i Document
 		if err := rows.Scan(
 			&i.ID,
+			&i.SlugID,
+			&i.Title,
+			&i.Icon,
+			&i.Color,
+			&i.Content,
+			&i.ContentData,
 			&i.IssueID,
-			&i.RelatedIssueID,
-			&i.Type,
+			&i.ProjectID,
+			&i.InitiativeID,
+			&i.TeamID,
+			&i.CreatorID,
+			&i.Url,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
+			&i.Data,
 		); err != nil {
 			return nil, err
 		}
@@ -1301,25 +2745,29 @@ func (q *Queries) ListIssueRelations(ctx context.Context, issueID string) ([]Iss
 	return items, nil
 }
 
-const listPendingDetailSync = `-- name: ListPendingDetailSync :many
-SELECT issue_id, identifier FROM pending_detail_sync ORDER BY queued_at
+const listTeamArchivedIssues = `-- name: ListTeamArchivedIssues :many
+SELECT id, identifier, team_id, title, archived_at, updated_at, synced_at, data FROM archived_issues WHERE team_id = ? ORDER BY archived_at DESC
 `
 
-type ListPendingDetailSyncRow struct {
-	IssueID    string `json:"issue_id"`
-	Identifier string `json:"identifier"`
-}
-
-func (q *Queries) ListPendingDetailSync(ctx context.Context) ([]ListPendingDetailSyncRow, error) {
-	rows, err := q.db.QueryContext(ctx, listPendingDetailSync)
+func (q *Queries) ListTeamArchivedIssues(ctx context.Context, teamID string) ([]ArchivedIssue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamArchivedIssues, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListPendingDetailSyncRow{}
+	items := []ArchivedIssue{}
 	for rows.Next() {
-		var i ListPendingDetailSyncRow
-		if err := rows.Scan(&i.IssueID, &i.Identifier); err != nil {
+		var i ArchivedIssue
+		if err := rows.Scan(
+			&i.ID,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
+			&i.ArchivedAt,
+			&i.UpdatedAt,
+			&i.SyncedAt,
+			&i.Data,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1333,33 +2781,31 @@ func (q *Queries) ListPendingDetailSync(ctx context.Context) ([]ListPendingDetai
 	return items, nil
 }
 
-const listProjectDocuments = `-- name: ListProjectDocuments :many
-SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE project_id = ? ORDER BY title
+const listTeamAttachments = `-- name: ListTeamAttachments :many
+SELECT id, issue_id, title, subtitle, url, source_type, metadata, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM attachments WHERE issue_id IN (SELECT id FROM issues WHERE team_id = ?) ORDER BY issue_id, created_at, id
 `
 
-func (q *Queries) ListProjectDocuments(ctx context.Context, projectID sql.NullString) ([]Document, error) {
-	rows, err := q.db.QueryContext(ctx, listProjectDocuments, projectID)
+// Attachments on any of a team's issues, grouped by issue (lint.md).
+func (q *Queries) ListTeamAttachments(ctx context.Context, teamID string) ([]Attachment, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamAttachments, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Document{}
+	items := []Attachment{}
 	for rows.Next() {
-		var i Document
+		var i Attachment
 		if err := rows.Scan(
 			&i.ID,
-			&i.SlugID,
-			&i.Title,
-			&i.Icon,
-			&i.Color,
-			&i.Content,
-			&i.ContentData,
 			&i.IssueID,
-			&i.ProjectID,
-			&i.InitiativeID,
-			&i.TeamID,
-			&i.CreatorID,
+			&i.Title,
+			&i.Subtitle,
 			&i.Url,
+			&i.SourceType,
+			&i.Metadata,
+			&i.CreatorID,
+			&i.CreatorName,
+			&i.CreatorEmail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
@@ -1378,12 +2824,21 @@ func (q *Queries) ListProjectDocuments(ctx context.Context, projectID sql.NullSt
 	return items, nil
 }
 
-const listProjectIssues = `-- name: ListProjectIssues :many
-SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE project_id = ? ORDER BY updated_at DESC
+const listTeamBlockedIssues = `-- name: ListTeamBlockedIssues :many
+SELECT i.id, i.identifier, i.team_id, i.title, i.description, i.state_id, i.state_name, i.state_type, i.assignee_id, i.assignee_email, i.creator_id, i.creator_email, i.priority, i.project_id, i.project_name, i.cycle_id, i.cycle_name, i.parent_id, i.due_date, i.estimate, i.url, i.branch_name, i.created_at, i.updated_at, i.started_at, i.completed_at, i.canceled_at, i.archived_at, i.synced_at, i.detail_synced_at, i.data FROM issues i
+WHERE i.team_id = ?
+  AND COALESCE(i.state_type, '') NOT IN ('completed', 'canceled')
+  AND EXISTS (
+    SELECT 1 FROM issue_relations r
+    JOIN issues b ON b.id = r.issue_id
+    WHERE r.related_issue_id = i.id AND r.type = 'blocks'
+      AND COALESCE(b.state_type, '') NOT IN ('completed', 'canceled'))
+ORDER BY i.identifier
 `
 
-func (q *Queries) ListProjectIssues(ctx context.Context, projectID sql.NullString) ([]Issue, error) {
-	rows, err := q.db.QueryContext(ctx, listProjectIssues, projectID)
+// A team's open issues with at least one open blocker (by/blocked/).
+func (q *Queries) ListTeamBlockedIssues(ctx context.Context, teamID string) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamBlockedIssues, teamID)
 	if err != nil {
 		return nil, err
 	}
@@ -1437,31 +2892,33 @@ func (q *Queries) ListProjectIssues(ctx context.Context, projectID sql.NullStrin
 	return items, nil
 }
 
-const listProjectLabels = `-- name: ListProjectLabels :many
+const listTeamCycles = `-- name: ListTeamCycles :many
 
-SELECT id, name, color, description, is_group, parent_id, retired_at, created_at, updated_at, synced_at, data FROM project_labels ORDER BY name COLLATE NOCASE
+SELECT id, team_id, number, name, description, starts_at, ends_at, completed_at, progress, created_at, updated_at, synced_at, data FROM cycles WHERE team_id = ? ORDER BY number DESC
 `
 
 // =============================================================================
-// Project labels queries (workspace-scoped catalog; see schema.sql)
+// Cycles queries
 // =============================================================================
-func (q *Queries) ListProjectLabels(ctx context.Context) ([]ProjectLabel, error) {
-	rows, err := q.db.QueryContext(ctx, listProjectLabels)
+func (q *Queries) ListTeamCycles(ctx context.Context, teamID string) ([]Cycle, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamCycles, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ProjectLabel{}
+	items := []Cycle{}
 	for rows.Next() {
-		var i ProjectLabel
+		var i Cycle
 		if err := rows.Scan(
 			&i.ID,
+			&i.TeamID,
+			&i.Number,
 			&i.Name,
-			&i.Color,
 			&i.Description,
-			&i.IsGroup,
-			&i.ParentID,
-			&i.RetiredAt,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CompletedAt,
+			&i.Progress,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
@@ -1480,35 +2937,33 @@ func (q *Queries) ListProjectLabels(ctx context.Context) ([]ProjectLabel, error)
 	return items, nil
 }
 
-const listProjectLinks = `-- name: ListProjectLinks :many
-
-SELECT id, project_id, initiative_id, label, url, sort_order, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data FROM entity_external_links WHERE project_id = ? ORDER BY sort_order, id
+const listTeamDocuments = `-- name: ListTeamDocuments :many
+SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE team_id = ? ORDER BY title
 `
 
-// =============================================================================
-// Entity external links queries (project/initiative "Links / Resources")
-// =============================================================================
-// The id tiebreaker keeps the order deterministic on equal sort_order, so
-// linkListing dedup suffixes stay stable across calls.
-func (q *Queries) ListProjectLinks(ctx context.Context, projectID sql.NullString) ([]EntityExternalLink, error) {
-	rows, err := q.db.QueryContext(ctx, listProjectLinks, projectID)
+func (q *Queries) ListTeamDocuments(ctx context.Context, teamID sql.NullString) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamDocuments, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []EntityExternalLink{}
+	items := []Document{}
 	for rows.Next() {
-		var i EntityExternalLink
+		var i Document
 		if err := rows.Scan(
 			&i.ID,
+			&i.SlugID,
+			&i.Title,
+			&i.Icon,
+			&i.Color,
+			&i.Content,
+			&i.ContentData,
+			&i.IssueID,
 			&i.ProjectID,
 			&i.InitiativeID,
-			&i.Label,
-			&i.Url,
-			&i.SortOrder,
+			&i.TeamID,
 			&i.CreatorID,
-			&i.CreatorName,
-			&i.CreatorEmail,
+			&i.Url,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
@@ -1527,31 +2982,25 @@ func (q *Queries) ListProjectLinks(ctx context.Context, projectID sql.NullString
 	return items, nil
 }
 
-const listProjectMilestones = `-- name: ListProjectMilestones :many
-SELECT id, project_id, name, description, target_date, sort_order, created_at, updated_at, synced_at, data FROM project_milestones WHERE project_id = ? ORDER BY sort_order
+const listTeamIssueIDs = `-- name: ListTeamIssueIDs :many
+SELECT id, updated_at FROM issues WHERE team_id = ? ORDER BY updated_at DESC
 `
 
-func (q *Queries) ListProjectMilestones(ctx context.Context, projectID string) ([]ProjectMilestone, error) {
-	rows, err := q.db.QueryContext(ctx, listProjectMilestones, projectID)
+type ListTeamIssueIDsRow struct {
+	ID        string    `json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (q *Queries) ListTeamIssueIDs(ctx context.Context, teamID string) ([]ListTeamIssueIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssueIDs, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ProjectMilestone{}
+	items := []ListTeamIssueIDsRow{}
 	for rows.Next() {
-		var i ProjectMilestone
-		if err := rows.Scan(
-			&i.ID,
-			&i.ProjectID,
-			&i.Name,
-			&i.Description,
-			&i.TargetDate,
-			&i.SortOrder,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.SyncedAt,
-			&i.Data,
-		); err != nil {
+		var i ListTeamIssueIDsRow
+		if err := rows.Scan(&i.ID, &i.UpdatedAt); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1565,37 +3014,29 @@ func (q *Queries) ListProjectMilestones(ctx context.Context, projectID string) (
 	return items, nil
 }
 
-const listProjectUpdates = `-- name: ListProjectUpdates :many
-
-SELECT id, project_id, body, body_data, health, user_id, user_name, url, edited_at, created_at, updated_at, synced_at, data FROM project_updates WHERE project_id = ? ORDER BY created_at DESC
+const listTeamIssueRelations = `-- name: ListTeamIssueRelations :many
+SELECT id, issue_id, related_issue_id, type, created_at, updated_at, synced_at FROM issue_relations WHERE issue_id IN (SELECT id FROM issues WHERE team_id = ?) ORDER BY issue_id, type, related_issue_id
 `
 
-// =============================================================================
-// Project Updates queries
-// =============================================================================
-func (q *Queries) ListProjectUpdates(ctx context.Context, projectID string) ([]ProjectUpdate, error) {
-	rows, err := q.db.QueryContext(ctx, listProjectUpdates, projectID)
+// Relations owned by any of a team's issues: the edge set of the team's
+// graph.dot/graph.json.
+func (q *Queries) ListTeamIssueRelations(ctx context.Context, teamID string) ([]IssueRelation, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssueRelations, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ProjectUpdate{}
+	items := []IssueRelation{}
 	for rows.Next() {
-		var i ProjectUpdate
+		var i IssueRelation
 		if err := rows.Scan(
 			&i.ID,
-			&i.ProjectID,
-			&i.Body,
-			&i.BodyData,
-			&i.Health,
-			&i.UserID,
-			&i.UserName,
-			&i.Url,
-			&i.EditedAt,
+			&i.IssueID,
+			&i.RelatedIssueID,
+			&i.Type,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
-			&i.Data,
 		); err != nil {
 			return nil, err
 		}
@@ -1610,35 +3051,50 @@ func (q *Queries) ListProjectUpdates(ctx context.Context, projectID string) ([]P
 	return items, nil
 }
 
-const listProjects = `-- name: ListProjects :many
-SELECT id, slug_id, name, description, icon, color, state, progress, start_date, target_date, lead_id, url, created_at, updated_at, synced_at, data FROM projects ORDER BY name
+const listTeamIssues = `-- name: ListTeamIssues :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE team_id = ? ORDER BY updated_at DESC
 `
 
-func (q *Queries) ListProjects(ctx context.Context) ([]Project, error) {
-	rows, err := q.db.QueryContext(ctx, listProjects)
+func (q *Queries) ListTeamIssues(ctx context.Context, teamID string) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssues, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Project{}
+	items := []Issue{}
 	for rows.Next() {
-		var i Project
+		var i Issue
 		if err := rows.Scan(
 			&i.ID,
-			&i.SlugID,
-			&i.Name,
+			&i.Identifier,
+			&i.TeamID,
+			&i.Title,
 			&i.Description,
-			&i.Icon,
-			&i.Color,
-			&i.State,
-			&i.Progress,
-			&i.StartDate,
-			&i.TargetDate,
-			&i.LeadID,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
+			&i.CreatorID,
+			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
 			&i.Url,
+			&i.BranchName,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
 			&i.SyncedAt,
+			&i.DetailSyncedAt,
 			&i.Data,
 		); err != nil {
 			return nil, err
@@ -1654,38 +3110,30 @@ func (q *Queries) ListProjects(ctx context.Context) ([]Project, error) {
 	return items, nil
 }
 
-const listTeamCycles = `-- name: ListTeamCycles :many
+const listTeamIssueCreators = `-- name: ListTeamIssueCreators :many
 
-SELECT id, team_id, number, name, description, starts_at, ends_at, completed_at, progress, created_at, updated_at, synced_at, data FROM cycles WHERE team_id = ? ORDER BY number DESC
+SELECT DISTINCT creator_id, creator_email FROM issues
+WHERE team_id = ? AND creator_id IS NOT NULL AND creator_email IS NOT NULL
+ORDER BY creator_email
 `
 
-// =============================================================================
-// Cycles queries
-// =============================================================================
-func (q *Queries) ListTeamCycles(ctx context.Context, teamID string) ([]Cycle, error) {
-	rows, err := q.db.QueryContext(ctx, listTeamCycles, teamID)
+type ListTeamIssueCreatorsRow struct {
+	CreatorID    sql.NullString `json:"creator_id"`
+	CreatorEmail sql.NullString `json:"creator_email"`
+}
+
+// ListTeamIssueCreators lists everyone who filed one of the team's synced
+// issues (the by/creator/ values) — not just team members.
+func (q *Queries) ListTeamIssueCreators(ctx context.Context, teamID string) ([]ListTeamIssueCreatorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssueCreators, teamID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Cycle{}
+	items := []ListTeamIssueCreatorsRow{}
 	for rows.Next() {
-		var i Cycle
-		if err := rows.Scan(
-			&i.ID,
-			&i.TeamID,
-			&i.Number,
-			&i.Name,
-			&i.Description,
-			&i.StartsAt,
-			&i.EndsAt,
-			&i.CompletedAt,
-			&i.Progress,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.SyncedAt,
-			&i.Data,
-		); err != nil {
+		var i ListTeamIssueCreatorsRow
+		if err := rows.Scan(&i.CreatorID, &i.CreatorEmail); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1699,36 +3147,55 @@ func (q *Queries) ListTeamCycles(ctx context.Context, teamID string) ([]Cycle, e
 	return items, nil
 }
 
-const listTeamDocuments = `-- name: ListTeamDocuments :many
-SELECT id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data FROM documents WHERE team_id = ? ORDER BY title
+const listTeamIssuesByAssignee = `-- name: ListTeamIssuesByAssignee :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE team_id = ? AND assignee_id = ? ORDER BY updated_at DESC
 `
 
-func (q *Queries) ListTeamDocuments(ctx context.Context, teamID sql.NullString) ([]Document, error) {
-	rows, err := q.db.QueryContext(ctx, listTeamDocuments, teamID)
+type ListTeamIssuesByAssigneeParams struct {
+	TeamID     string         `json:"team_id"`
+	AssigneeID sql.NullString `json:"assignee_id"`
+}
+
+func (q *Queries) ListTeamIssuesByAssignee(ctx context.Context, arg ListTeamIssuesByAssigneeParams) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssuesByAssignee, arg.TeamID, arg.AssigneeID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Document{}
+	items := []Issue{}
 	for rows.Next() {
-		var i Document
+		var i Issue
 		if err := rows.Scan(
 			&i.ID,
-			&i.SlugID,
-			&i.Title,
-			&i.Icon,
-			&i.Color,
-			&i.Content,
-			&i.ContentData,
-			&i.IssueID,
-			&i.ProjectID,
-			&i.InitiativeID,
+			&i.Identifier,
 			&i.TeamID,
+			&i.Title,
+			&i.Description,
+			&i.StateID,
+			&i.StateName,
+			&i.StateType,
+			&i.AssigneeID,
+			&i.AssigneeEmail,
 			&i.CreatorID,
+			&i.CreatorEmail,
+			&i.Priority,
+			&i.ProjectID,
+			&i.ProjectName,
+			&i.CycleID,
+			&i.CycleName,
+			&i.ParentID,
+			&i.DueDate,
+			&i.Estimate,
 			&i.Url,
+			&i.BranchName,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CanceledAt,
+			&i.ArchivedAt,
 			&i.SyncedAt,
+			&i.DetailSyncedAt,
 			&i.Data,
 		); err != nil {
 			return nil, err
@@ -1744,44 +3211,17 @@ func (q *Queries) ListTeamDocuments(ctx context.Context, teamID sql.NullString)
 	return items, nil
 }
 
-const listTeamIssueIDs = `-- name: ListTeamIssueIDs :many
-SELECT id, updated_at FROM issues WHERE team_id = ? ORDER BY updated_at DESC
+const listTeamIssuesByCreator = `-- name: ListTeamIssuesByCreator :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE team_id = ? AND creator_id = ? ORDER BY updated_at DESC
 `
 
-type ListTeamIssueIDsRow struct {
-	ID        string    `json:"id"`
-	UpdatedAt time.Time `json:"updated_at"`
-}
-
-func (q *Queries) ListTeamIssueIDs(ctx context.Context, teamID string) ([]ListTeamIssueIDsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listTeamIssueIDs, teamID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListTeamIssueIDsRow{}
-	for rows.Next() {
-		var i ListTeamIssueIDsRow
-		if err := rows.Scan(&i.ID, &i.UpdatedAt); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+type ListTeamIssuesByCreatorParams struct {
+	TeamID    string         `json:"team_id"`
+	CreatorID sql.NullString `json:"creator_id"`
 }
 
-const listTeamIssues = `-- name: ListTeamIssues :many
-SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE team_id = ? ORDER BY updated_at DESC
-`
-
-func (q *Queries) ListTeamIssues(ctx context.Context, teamID string) ([]Issue, error) {
-	rows, err := q.db.QueryContext(ctx, listTeamIssues, teamID)
+func (q *Queries) ListTeamIssuesByCreator(ctx context.Context, arg ListTeamIssuesByCreatorParams) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssuesByCreator, arg.TeamID, arg.CreatorID)
 	if err != nil {
 		return nil, err
 	}
@@ -1835,17 +3275,12 @@ func (q *Queries) ListTeamIssues(ctx context.Context, teamID string) ([]Issue, e
 	return items, nil
 }
 
-const listTeamIssuesByAssignee = `-- name: ListTeamIssuesByAssignee :many
-SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE team_id = ? AND assignee_id = ? ORDER BY updated_at DESC
+const listTeamIssuesByParent = `-- name: ListTeamIssuesByParent :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE parent_id = ? ORDER BY updated_at DESC
 `
 
-type ListTeamIssuesByAssigneeParams struct {
-	TeamID     string         `json:"team_id"`
-	AssigneeID sql.NullString `json:"assignee_id"`
-}
-
-func (q *Queries) ListTeamIssuesByAssignee(ctx context.Context, arg ListTeamIssuesByAssigneeParams) ([]Issue, error) {
-	rows, err := q.db.QueryContext(ctx, listTeamIssuesByAssignee, arg.TeamID, arg.AssigneeID)
+func (q *Queries) ListTeamIssuesByParent(ctx context.Context, parentID sql.NullString) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssuesByParent, parentID)
 	if err != nil {
 		return nil, err
 	}
@@ -1899,12 +3334,17 @@ func (q *Queries) ListTeamIssuesByAssignee(ctx context.Context, arg ListTeamIssu
 	return items, nil
 }
 
-const listTeamIssuesByParent = `-- name: ListTeamIssuesByParent :many
-SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE parent_id = ? ORDER BY updated_at DESC
+const listTeamIssuesByPriority = `-- name: ListTeamIssuesByPriority :many
+SELECT id, identifier, team_id, title, description, state_id, state_name, state_type, assignee_id, assignee_email, creator_id, creator_email, priority, project_id, project_name, cycle_id, cycle_name, parent_id, due_date, estimate, url, branch_name, created_at, updated_at, started_at, completed_at, canceled_at, archived_at, synced_at, detail_synced_at, data FROM issues WHERE team_id = ? AND priority = ? ORDER BY updated_at DESC
 `
 
-func (q *Queries) ListTeamIssuesByParent(ctx context.Context, parentID sql.NullString) ([]Issue, error) {
-	rows, err := q.db.QueryContext(ctx, listTeamIssuesByParent, parentID)
+type ListTeamIssuesByPriorityParams struct {
+	TeamID   string        `json:"team_id"`
+	Priority sql.NullInt64 `json:"priority"`
+}
+
+func (q *Queries) ListTeamIssuesByPriority(ctx context.Context, arg ListTeamIssuesByPriorityParams) ([]Issue, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamIssuesByPriority, arg.TeamID, arg.Priority)
 	if err != nil {
 		return nil, err
 	}
@@ -2252,7 +3692,7 @@ func (q *Queries) ListTeamUnassignedIssues(ctx context.Context, teamID string) (
 
 const listTeams = `-- name: ListTeams :many
 
-SELECT id, "key", name, icon, created_at, updated_at, synced_at FROM teams ORDER BY name
+SELECT id, "key", name, icon, created_at, updated_at, synced_at, private, color, description FROM teams ORDER BY name
 `
 
 // Teams queries
@@ -2273,6 +3713,9 @@ func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.SyncedAt,
+			&i.Private,
+			&i.Color,
+			&i.Description,
 		); err != nil {
 			return nil, err
 		}
@@ -2503,6 +3946,36 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 	return items, nil
 }
 
+const pruneActivity = `-- name: PruneActivity :exec
+DELETE FROM activity_log WHERE day < ?
+`
+
+func (q *Queries) PruneActivity(ctx context.Context, day string) error {
+	_, err := q.db.ExecContext(ctx, pruneActivity, day)
+	return err
+}
+
+const pruneDescriptionVersions = `-- name: PruneDescriptionVersions :exec
+DELETE FROM issue_description_versions
+WHERE issue_id = ? AND observed_at NOT IN (
+    SELECT observed_at FROM issue_description_versions
+    WHERE issue_id = ?
+    ORDER BY observed_at DESC
+    LIMIT ?
+)
+`
+
+type PruneDescriptionVersionsParams struct {
+	IssueID   string `json:"issue_id"`
+	IssueID_2 string `json:"issue_id_2"`
+	Limit     int64  `json:"limit"`
+}
+
+func (q *Queries) PruneDescriptionVersions(ctx context.Context, arg PruneDescriptionVersionsParams) error {
+	_, err := q.db.ExecContext(ctx, pruneDescriptionVersions, arg.IssueID, arg.IssueID_2, arg.Limit)
+	return err
+}
+
 const pruneInitiativeProjects = `-- name: PruneInitiativeProjects :exec
 DELETE FROM initiative_projects WHERE initiative_id = ? AND synced_at < ?
 `
@@ -2537,20 +4010,24 @@ func (q *Queries) PruneIssueAttachments(ctx context.Context, arg PruneIssueAttac
 }
 
 const pruneIssueComments = `-- name: PruneIssueComments :exec
-DELETE FROM comments WHERE issue_id = ? AND synced_at < ?
+
+UPDATE comments SET deleted_at = ? WHERE issue_id = ? AND synced_at < ? AND deleted_at IS NULL
 `
 
 type PruneIssueCommentsParams struct {
-	IssueID  string    `json:"issue_id"`
-	SyncedAt time.Time `json:"synced_at"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	IssueID   string       `json:"issue_id"`
+	SyncedAt  time.Time    `json:"synced_at"`
 }
 
 // Prune*: delete rows the details sync no longer sees for an issue. Scoped by
 // issue and a synced_at cutoff taken before the sync's upserts, so only rows
 // the fresh fetch did NOT touch are removed (e.g. a comment deleted in Linear,
-// or a phantom left by a delete whose SQLite forget failed).
+// or a phantom left by a delete whose SQLite forget failed). Comments are the
+// exception: they are tombstoned (deleted_at stamped once), not removed, so
+// the comments/ listing can still show what was said.
 func (q *Queries) PruneIssueComments(ctx context.Context, arg PruneIssueCommentsParams) error {
-	_, err := q.db.ExecContext(ctx, pruneIssueComments, arg.IssueID, arg.SyncedAt)
+	_, err := q.db.ExecContext(ctx, pruneIssueComments, arg.DeletedAt, arg.IssueID, arg.SyncedAt)
 	return err
 }
 
@@ -2614,6 +4091,19 @@ func (q *Queries) PruneProjectTeams(ctx context.Context, arg PruneProjectTeamsPa
 	return err
 }
 
+const pruneStandaloneDocuments = `-- name: PruneStandaloneDocuments :exec
+
+DELETE FROM documents
+WHERE issue_id IS NULL AND project_id IS NULL AND initiative_id IS NULL AND team_id IS NULL
+  AND synced_at < ?
+`
+
+// Licensed ONLY by a complete drain of Query.documents (GetAllDocuments).
+func (q *Queries) PruneStandaloneDocuments(ctx context.Context, syncedAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, pruneStandaloneDocuments, syncedAt)
+	return err
+}
+
 const pruneTeamCycles = `-- name: PruneTeamCycles :exec
 DELETE FROM cycles WHERE team_id = ? AND synced_at < ?
 `
@@ -2662,6 +4152,97 @@ func (q *Queries) PruneTeamMembers(ctx context.Context, arg PruneTeamMembersPara
 	return err
 }
 
+const recordMutationAttempt = `-- name: RecordMutationAttempt :exec
+UPDATE mutation_queue SET attempts = attempts + 1, last_error = ? WHERE id = ?
+`
+
+type RecordMutationAttemptParams struct {
+	LastError sql.NullString `json:"last_error"`
+	ID        int64          `json:"id"`
+}
+
+func (q *Queries) RecordMutationAttempt(ctx context.Context, arg RecordMutationAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, recordMutationAttempt, arg.LastError, arg.ID)
+	return err
+}
+
+const recordWebhookDelivery = `-- name: RecordWebhookDelivery :execrows
+INSERT INTO webhook_deliveries (delivery_id, received_at)
+VALUES (?, ?)
+ON CONFLICT(delivery_id) DO NOTHING
+`
+
+type RecordWebhookDeliveryParams struct {
+	DeliveryID string    `json:"delivery_id"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// Zero rows affected means the delivery was already recorded.
+func (q *Queries) RecordWebhookDelivery(ctx context.Context, arg RecordWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, recordWebhookDelivery, arg.DeliveryID, arg.ReceivedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const renameCommentDraft = `-- name: RenameCommentDraft :exec
+UPDATE comment_drafts SET name = ?, updated_at = ? WHERE issue_id = ? AND name = ?
+`
+
+type RenameCommentDraftParams struct {
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+	IssueID   string    `json:"issue_id"`
+	Name_2    string    `json:"name_2"`
+}
+
+func (q *Queries) RenameCommentDraft(ctx context.Context, arg RenameCommentDraftParams) error {
+	_, err := q.db.ExecContext(ctx, renameCommentDraft,
+		arg.Name,
+		arg.UpdatedAt,
+		arg.IssueID,
+		arg.Name_2,
+	)
+	return err
+}
+
+const setCustomViewsCache = `-- name: SetCustomViewsCache :exec
+INSERT INTO custom_views_cache (singleton, synced_at, data)
+VALUES (1, ?, ?)
+ON CONFLICT(singleton) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data
+`
+
+type SetCustomViewsCacheParams struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) SetCustomViewsCache(ctx context.Context, arg SetCustomViewsCacheParams) error {
+	_, err := q.db.ExecContext(ctx, setCustomViewsCache, arg.SyncedAt, arg.Data)
+	return err
+}
+
+const setEmojiCache = `-- name: SetEmojiCache :exec
+INSERT INTO emoji_cache (singleton, synced_at, data)
+VALUES (1, ?, ?)
+ON CONFLICT(singleton) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data
+`
+
+type SetEmojiCacheParams struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) SetEmojiCache(ctx context.Context, arg SetEmojiCacheParams) error {
+	_, err := q.db.ExecContext(ctx, setEmojiCache, arg.SyncedAt, arg.Data)
+	return err
+}
+
 const setIssueParent = `-- name: SetIssueParent :exec
 UPDATE issues SET parent_id = ? WHERE id = ?
 `
@@ -2676,6 +4257,43 @@ func (q *Queries) SetIssueParent(ctx context.Context, arg SetIssueParentParams)
 	return err
 }
 
+const setOrganizationCache = `-- name: SetOrganizationCache :exec
+INSERT INTO organization_cache (singleton, synced_at, data)
+VALUES (1, ?, ?)
+ON CONFLICT(singleton) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data
+`
+
+type SetOrganizationCacheParams struct {
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) SetOrganizationCache(ctx context.Context, arg SetOrganizationCacheParams) error {
+	_, err := q.db.ExecContext(ctx, setOrganizationCache, arg.SyncedAt, arg.Data)
+	return err
+}
+
+const setTeamTemplatesCache = `-- name: SetTeamTemplatesCache :exec
+INSERT INTO team_templates_cache (team_id, synced_at, data)
+VALUES (?, ?, ?)
+ON CONFLICT(team_id) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    data = excluded.data
+`
+
+type SetTeamTemplatesCacheParams struct {
+	TeamID   string          `json:"team_id"`
+	SyncedAt time.Time       `json:"synced_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) SetTeamTemplatesCache(ctx context.Context, arg SetTeamTemplatesCacheParams) error {
+	_, err := q.db.ExecContext(ctx, setTeamTemplatesCache, arg.TeamID, arg.SyncedAt, arg.Data)
+	return err
+}
+
 const setViewerUserID = `-- name: SetViewerUserID :exec
 INSERT INTO viewer_cache (singleton, user_id, synced_at)
 VALUES (1, ?, ?)
@@ -2711,6 +4329,20 @@ func (q *Queries) StampIssueDetailSynced(ctx context.Context, arg StampIssueDeta
 	return err
 }
 
+const tombstoneComment = `-- name: TombstoneComment :exec
+UPDATE comments SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL
+`
+
+type TombstoneCommentParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	ID        string       `json:"id"`
+}
+
+func (q *Queries) TombstoneComment(ctx context.Context, arg TombstoneCommentParams) error {
+	_, err := q.db.ExecContext(ctx, tombstoneComment, arg.DeletedAt, arg.ID)
+	return err
+}
+
 const updateEmbeddedFileCache = `-- name: UpdateEmbeddedFileCache :exec
 UPDATE embedded_files SET cache_path = ?, file_size = ? WHERE id = ?
 `
@@ -2726,6 +4358,73 @@ func (q *Queries) UpdateEmbeddedFileCache(ctx context.Context, arg UpdateEmbedde
 	return err
 }
 
+const upsertAPIBudgetWindow = `-- name: UpsertAPIBudgetWindow :exec
+INSERT INTO api_budget_windows (axis, limit_value, remaining, reset_at, observed_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(axis) DO UPDATE SET
+    limit_value = excluded.limit_value,
+    remaining = excluded.remaining,
+    reset_at = excluded.reset_at,
+    observed_at = excluded.observed_at
+`
+
+type UpsertAPIBudgetWindowParams struct {
+	Axis       string       `json:"axis"`
+	LimitValue float64      `json:"limit_value"`
+	Remaining  float64      `json:"remaining"`
+	ResetAt    sql.NullTime `json:"reset_at"`
+	ObservedAt time.Time    `json:"observed_at"`
+}
+
+func (q *Queries) UpsertAPIBudgetWindow(ctx context.Context, arg UpsertAPIBudgetWindowParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAPIBudgetWindow,
+		arg.Axis,
+		arg.LimitValue,
+		arg.Remaining,
+		arg.ResetAt,
+		arg.ObservedAt,
+	)
+	return err
+}
+
+const upsertArchivedIssue = `-- name: UpsertArchivedIssue :exec
+INSERT INTO archived_issues (id, identifier, team_id, title, archived_at, updated_at, synced_at, data)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    identifier = excluded.identifier,
+    team_id = excluded.team_id,
+    title = excluded.title,
+    archived_at = excluded.archived_at,
+    updated_at = excluded.updated_at,
+    synced_at = excluded.synced_at,
+    data = excluded.data
+`
+
+type UpsertArchivedIssueParams struct {
+	ID         string          `json:"id"`
+	Identifier string          `json:"identifier"`
+	TeamID     string          `json:"team_id"`
+	Title      string          `json:"title"`
+	ArchivedAt time.Time       `json:"archived_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	SyncedAt   time.Time       `json:"synced_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func (q *Queries) UpsertArchivedIssue(ctx context.Context, arg UpsertArchivedIssueParams) error {
+	_, err := q.db.ExecContext(ctx, upsertArchivedIssue,
+		arg.ID,
+		arg.Identifier,
+		arg.TeamID,
+		arg.Title,
+		arg.ArchivedAt,
+		arg.UpdatedAt,
+		arg.SyncedAt,
+		arg.Data,
+	)
+	return err
+}
+
 const upsertAttachment = `-- name: UpsertAttachment :exec
 INSERT INTO attachments (id, issue_id, title, subtitle, url, source_type, metadata, creator_id, creator_name, creator_email, created_at, updated_at, synced_at, data)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -2796,7 +4495,8 @@ ON CONFLICT(id) DO UPDATE SET
     created_at = excluded.created_at,
     updated_at = excluded.updated_at,
     synced_at = excluded.synced_at,
-    data = excluded.data
+    data = excluded.data,
+    deleted_at = NULL
 `
 
 type UpsertCommentParams struct {
@@ -2832,6 +4532,52 @@ func (q *Queries) UpsertComment(ctx context.Context, arg UpsertCommentParams) er
 	return err
 }
 
+const upsertCommentDraft = `-- name: UpsertCommentDraft :exec
+INSERT INTO comment_drafts (issue_id, name, body, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(issue_id, name) DO UPDATE SET
+    body = excluded.body,
+    updated_at = excluded.updated_at
+`
+
+type UpsertCommentDraftParams struct {
+	IssueID   string    `json:"issue_id"`
+	Name      string    `json:"name"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (q *Queries) UpsertCommentDraft(ctx context.Context, arg UpsertCommentDraftParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCommentDraft,
+		arg.IssueID,
+		arg.Name,
+		arg.Body,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const upsertCustomViewIssueCache = `-- name: UpsertCustomViewIssueCache :exec
+INSERT INTO custom_view_issue_cache (view_id, synced_at, issue_ids)
+VALUES (?, ?, ?)
+ON CONFLICT(view_id) DO UPDATE SET
+    synced_at = excluded.synced_at,
+    issue_ids = excluded.issue_ids
+`
+
+type UpsertCustomViewIssueCacheParams struct {
+	ViewID   string          `json:"view_id"`
+	SyncedAt time.Time       `json:"synced_at"`
+	IssueIds json.RawMessage `json:"issue_ids"`
+}
+
+func (q *Queries) UpsertCustomViewIssueCache(ctx context.Context, arg UpsertCustomViewIssueCacheParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCustomViewIssueCache, arg.ViewID, arg.SyncedAt, arg.IssueIds)
+	return err
+}
+
 const upsertCycle = `-- name: UpsertCycle :exec
 INSERT INTO cycles (id, team_id, number, name, description, starts_at, ends_at, completed_at, progress, created_at, updated_at, synced_at, data)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -2885,6 +4631,24 @@ func (q *Queries) UpsertCycle(ctx context.Context, arg UpsertCycleParams) error
 	return err
 }
 
+const upsertDescriptionVersion = `-- name: UpsertDescriptionVersion :exec
+INSERT INTO issue_description_versions (issue_id, observed_at, description)
+VALUES (?, ?, ?)
+ON CONFLICT(issue_id, observed_at) DO UPDATE SET
+    description = excluded.description
+`
+
+type UpsertDescriptionVersionParams struct {
+	IssueID     string    `json:"issue_id"`
+	ObservedAt  time.Time `json:"observed_at"`
+	Description string    `json:"description"`
+}
+
+func (q *Queries) UpsertDescriptionVersion(ctx context.Context, arg UpsertDescriptionVersionParams) error {
+	_, err := q.db.ExecContext(ctx, upsertDescriptionVersion, arg.IssueID, arg.ObservedAt, arg.Description)
+	return err
+}
+
 const upsertDocument = `-- name: UpsertDocument :exec
 INSERT INTO documents (id, slug_id, title, icon, color, content, content_data, issue_id, project_id, initiative_id, team_id, creator_id, url, created_at, updated_at, synced_at, data)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -3047,8 +4811,8 @@ func (q *Queries) UpsertEntityExternalLink(ctx context.Context, arg UpsertEntity
 }
 
 const upsertInitiative = `-- name: UpsertInitiative :exec
-INSERT INTO initiatives (id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO initiatives (id, slug_id, name, description, icon, color, status, sort_order, target_date, owner_id, url, created_at, updated_at, synced_at, data, parent_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     slug_id = excluded.slug_id,
     name = excluded.name,
@@ -3063,7 +4827,8 @@ ON CONFLICT(id) DO UPDATE SET
     created_at = excluded.created_at,
     updated_at = excluded.updated_at,
     synced_at = excluded.synced_at,
-    data = excluded.data
+    data = excluded.data,
+    parent_id = excluded.parent_id
 `
 
 type UpsertInitiativeParams struct {
@@ -3082,6 +4847,7 @@ type UpsertInitiativeParams struct {
 	UpdatedAt   sql.NullTime    `json:"updated_at"`
 	SyncedAt    time.Time       `json:"synced_at"`
 	Data        json.RawMessage `json:"data"`
+	ParentID    sql.NullString  `json:"parent_id"`
 }
 
 func (q *Queries) UpsertInitiative(ctx context.Context, arg UpsertInitiativeParams) error {
@@ -3101,6 +4867,7 @@ func (q *Queries) UpsertInitiative(ctx context.Context, arg UpsertInitiativePara
 		arg.UpdatedAt,
 		arg.SyncedAt,
 		arg.Data,
+		arg.ParentID,
 	)
 	return err
 }
@@ -3425,6 +5192,22 @@ func (q *Queries) UpsertPendingDetailSync(ctx context.Context, arg UpsertPending
 	return err
 }
 
+const upsertPendingIssueFields = `-- name: UpsertPendingIssueFields :exec
+INSERT INTO pending_issue_fields (issue_id, queued_at)
+VALUES (?, ?)
+ON CONFLICT(issue_id) DO UPDATE SET queued_at = excluded.queued_at
+`
+
+type UpsertPendingIssueFieldsParams struct {
+	IssueID  string    `json:"issue_id"`
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+func (q *Queries) UpsertPendingIssueFields(ctx context.Context, arg UpsertPendingIssueFieldsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPendingIssueFields, arg.IssueID, arg.QueuedAt)
+	return err
+}
+
 const upsertProject = `-- name: UpsertProject :exec
 INSERT INTO projects (id, slug_id, name, description, icon, color, state, progress, start_date, target_date, lead_id, url, created_at, updated_at, synced_at, data)
 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
@@ -3741,25 +5524,31 @@ func (q *Queries) UpsertSyncSchedule(ctx context.Context, arg UpsertSyncSchedule
 }
 
 const upsertTeam = `-- name: UpsertTeam :exec
-INSERT INTO teams (id, key, name, icon, created_at, updated_at, synced_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO teams (id, key, name, icon, created_at, updated_at, synced_at, private, color, description)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     key = excluded.key,
     name = excluded.name,
     icon = excluded.icon,
     created_at = excluded.created_at,
     updated_at = excluded.updated_at,
-    synced_at = excluded.synced_at
+    synced_at = excluded.synced_at,
+    private = excluded.private,
+    color = excluded.color,
+    description = excluded.description
 `
 
 type UpsertTeamParams struct {
-	ID        string         `json:"id"`
-	Key       string         `json:"key"`
-	Name      string         `json:"name"`
-	Icon      sql.NullString `json:"icon"`
-	CreatedAt sql.NullTime   `json:"created_at"`
-	UpdatedAt sql.NullTime   `json:"updated_at"`
-	SyncedAt  time.Time      `json:"synced_at"`
+	ID          string         `json:"id"`
+	Key         string         `json:"key"`
+	Name        string         `json:"name"`
+	Icon        sql.NullString `json:"icon"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	SyncedAt    time.Time      `json:"synced_at"`
+	Private     int64          `json:"private"`
+	Color       sql.NullString `json:"color"`
+	Description sql.NullString `json:"description"`
 }
 
 func (q *Queries) UpsertTeam(ctx context.Context, arg UpsertTeamParams) error {
@@ -3771,6 +5560,9 @@ func (q *Queries) UpsertTeam(ctx context.Context, arg UpsertTeamParams) error {
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.SyncedAt,
+		arg.Private,
+		arg.Color,
+		arg.Description,
 	)
 	return err
 }
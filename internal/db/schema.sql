@@ -41,8 +41,27 @@ CREATE TABLE IF NOT EXISTS issues (
 CREATE INDEX IF NOT EXISTS idx_issues_team ON issues(team_id);
 CREATE INDEX IF NOT EXISTS idx_issues_identifier ON issues(identifier);
 CREATE INDEX IF NOT EXISTS idx_issues_updated ON issues(updated_at DESC);
+
+-- Archived issues (issues/.archived/): Linear's issue queries leave archived
+-- issues out, so they drop from the issues table above; this keeps each one,
+-- fetched with includeArchived, for reading. A row goes when its issue is
+-- unarchived and back in issues.
+CREATE TABLE IF NOT EXISTS archived_issues (
+    id TEXT PRIMARY KEY,
+    identifier TEXT NOT NULL,
+    team_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    archived_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    synced_at DATETIME NOT NULL,
+    data JSON NOT NULL  -- Full issue JSON, as in issues.data
+);
+
+CREATE INDEX IF NOT EXISTS idx_archived_issues_team ON archived_issues(team_id);
+CREATE INDEX IF NOT EXISTS idx_archived_issues_identifier ON archived_issues(identifier);
 CREATE INDEX IF NOT EXISTS idx_issues_state ON issues(team_id, state_id);
 CREATE INDEX IF NOT EXISTS idx_issues_assignee ON issues(team_id, assignee_id);
+CREATE INDEX IF NOT EXISTS idx_issues_priority ON issues(team_id, priority);
 CREATE INDEX IF NOT EXISTS idx_issues_creator ON issues(creator_id);
 CREATE INDEX IF NOT EXISTS idx_issues_project ON issues(project_id);
 CREATE INDEX IF NOT EXISTS idx_issues_cycle ON issues(cycle_id);
@@ -75,7 +94,10 @@ CREATE TABLE IF NOT EXISTS teams (
     icon TEXT,
     created_at DATETIME,
     updated_at DATETIME,
-    synced_at DATETIME NOT NULL
+    synced_at DATETIME NOT NULL,
+    private INTEGER NOT NULL DEFAULT 0,  -- members-only team (Team.private)
+    color TEXT,
+    description TEXT
 );
 
 -- =============================================================================
@@ -266,7 +288,8 @@ CREATE TABLE IF NOT EXISTS comments (
     created_at DATETIME NOT NULL,
     updated_at DATETIME NOT NULL,
     synced_at DATETIME NOT NULL,
-    data JSON NOT NULL
+    data JSON NOT NULL,
+    deleted_at DATETIME  -- tombstone: deleted in Linear (or via rm); row kept for display
 );
 
 CREATE INDEX IF NOT EXISTS idx_comments_issue ON comments(issue_id);
@@ -303,6 +326,25 @@ CREATE INDEX IF NOT EXISTS idx_documents_initiative ON documents(initiative_id);
 CREATE INDEX IF NOT EXISTS idx_documents_team ON documents(team_id);
 CREATE INDEX IF NOT EXISTS idx_documents_creator ON documents(creator_id);
 
+-- Full-text index over document titles and content (docs/search/). A
+-- standalone FTS5 table keyed by doc_id, kept in step with documents by the
+-- triggers below so every write path (upserts, prunes, orphan cascades) is
+-- covered without touching the sqlc queries. Queried by internal/db/search.go.
+CREATE VIRTUAL TABLE IF NOT EXISTS documents_fts USING fts5(doc_id UNINDEXED, title, content);
+
+CREATE TRIGGER IF NOT EXISTS documents_fts_insert AFTER INSERT ON documents BEGIN
+    INSERT INTO documents_fts (doc_id, title, content) VALUES (new.id, new.title, COALESCE(new.content, ''));
+END;
+
+CREATE TRIGGER IF NOT EXISTS documents_fts_update AFTER UPDATE ON documents BEGIN
+    DELETE FROM documents_fts WHERE doc_id = old.id;
+    INSERT INTO documents_fts (doc_id, title, content) VALUES (new.id, new.title, COALESCE(new.content, ''));
+END;
+
+CREATE TRIGGER IF NOT EXISTS documents_fts_delete AFTER DELETE ON documents BEGIN
+    DELETE FROM documents_fts WHERE doc_id = old.id;
+END;
+
 -- =============================================================================
 -- Initiatives
 -- =============================================================================
@@ -321,11 +363,14 @@ CREATE TABLE IF NOT EXISTS initiatives (
     created_at DATETIME,
     updated_at DATETIME,
     synced_at DATETIME NOT NULL,
-    data JSON NOT NULL
+    data JSON NOT NULL,
+    parent_id TEXT  -- enclosing initiative (sub-initiatives); NULL at the top
 );
 
 CREATE INDEX IF NOT EXISTS idx_initiatives_slug ON initiatives(slug_id);
 CREATE INDEX IF NOT EXISTS idx_initiatives_owner ON initiatives(owner_id);
+-- idx_initiatives_parent is created by migrateSchema (store.go): on a database
+-- from before parent_id, this script runs before the column exists.
 
 -- =============================================================================
 -- Initiative-Project Associations (M2M: initiatives <-> projects)
@@ -467,6 +512,85 @@ CREATE INDEX IF NOT EXISTS idx_issue_relations_issue ON issue_relations(issue_id
 CREATE INDEX IF NOT EXISTS idx_issue_relations_related ON issue_relations(related_issue_id);
 CREATE INDEX IF NOT EXISTS idx_issue_relations_type ON issue_relations(issue_id, type);
 
+-- =============================================================================
+-- Issue Description Versions (issues/{ID}/history/)
+-- Linear exposes no description history, so each description sync observes is
+-- kept here, stamped with the issue's updatedAt: the newest
+-- DescriptionVersionsKept per issue (Store.RecordDescriptionVersion).
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS issue_description_versions (
+    issue_id TEXT NOT NULL,
+    observed_at DATETIME NOT NULL,
+    description TEXT NOT NULL,
+    PRIMARY KEY (issue_id, observed_at)
+);
+
+-- =============================================================================
+-- Activity Log (/activity/{date}.md)
+-- What sync and webhook deliveries observed happening to issues, for the
+-- workspace changelog: one row per creation, completion or state change,
+-- keyed by the change's own time so a change seen twice is kept once. day is
+-- the UTC date of at. The newest ActivityDaysKept days are kept
+-- (Store.RecordIssueActivity).
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS activity_log (
+    issue_id TEXT NOT NULL,
+    kind TEXT NOT NULL,          -- created, completed, or state
+    at DATETIME NOT NULL,
+    day TEXT NOT NULL,           -- YYYY-MM-DD
+    identifier TEXT NOT NULL,
+    team_key TEXT NOT NULL,
+    title TEXT NOT NULL,
+    from_state TEXT NOT NULL DEFAULT '',
+    to_state TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (issue_id, kind, at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_activity_log_day ON activity_log(day);
+
+-- =============================================================================
+-- Scope Changes (/teams/{KEY}/cycles/{name}/scope-changes.md)
+-- What sync and webhook deliveries observed changing a cycle's scope: an
+-- issue entering it (added), leaving it (removed), or its estimate changing
+-- while in it (resized). Keyed like activity_log by the change's own time
+-- (the issue's updatedAt), so a change seen twice is kept once. A removal is
+-- kept under the cycle the issue left.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS scope_changes (
+    cycle_id TEXT NOT NULL,
+    issue_id TEXT NOT NULL,
+    kind TEXT NOT NULL,          -- added, removed, or resized
+    at DATETIME NOT NULL,
+    identifier TEXT NOT NULL,
+    title TEXT NOT NULL,
+    from_estimate REAL,          -- NULL: unestimated (or not in the cycle)
+    to_estimate REAL,
+    PRIMARY KEY (cycle_id, issue_id, kind, at)
+);
+
+-- =============================================================================
+-- Mention index (backlinks.md): which issue descriptions, comments, and
+-- documents mention which issue or document. Built by the sync worker
+-- (Store.IndexMentions) from the texts synced since they were last indexed;
+-- read by target, so a backlinks.md read never scans the texts.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS mentions (
+    target TEXT NOT NULL,       -- an issue identifier, or document/{url segment}
+    source_kind TEXT NOT NULL,  -- issue, comment, or document
+    source_id TEXT NOT NULL,
+    PRIMARY KEY (target, source_kind, source_id)
+);
+CREATE INDEX IF NOT EXISTS idx_mentions_source ON mentions(source_kind, source_id);
+
+-- One row per indexed text: the source's synced_at when it was indexed, so
+-- the next pass skips it until sync writes it again.
+CREATE TABLE IF NOT EXISTS mention_sources (
+    source_kind TEXT NOT NULL,
+    source_id TEXT NOT NULL,
+    indexed_at TEXT NOT NULL,
+    PRIMARY KEY (source_kind, source_id)
+);
+
 -- =============================================================================
 -- Issue History Cache (JSON blob per issue, read-only history)
 -- =============================================================================
@@ -486,6 +610,84 @@ CREATE TABLE IF NOT EXISTS viewer_cache (
     synced_at DATETIME NOT NULL
 );
 
+-- =============================================================================
+-- Organization Cache (the workspace behind the API key, for /organization.md)
+-- Singleton table like viewer_cache; data is the api.Organization JSON.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS organization_cache (
+    singleton INTEGER PRIMARY KEY DEFAULT 1 CHECK (singleton = 1),
+    synced_at DATETIME NOT NULL,
+    data JSON NOT NULL
+);
+
+-- =============================================================================
+-- Custom Views Cache (Linear saved views, for /views/)
+-- custom_views_cache is a singleton like organization_cache; data is the
+-- []api.CustomView JSON. custom_view_issue_cache holds each view's
+-- server-evaluated membership as a JSON array of issue IDs, resolved against
+-- the issues table on read.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS custom_views_cache (
+    singleton INTEGER PRIMARY KEY DEFAULT 1 CHECK (singleton = 1),
+    synced_at DATETIME NOT NULL,
+    data JSON NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS custom_view_issue_cache (
+    view_id TEXT PRIMARY KEY,
+    synced_at DATETIME NOT NULL,
+    issue_ids JSON NOT NULL
+);
+
+-- =============================================================================
+-- Team Templates Cache (Linear issue templates, for teams/{KEY}/templates/)
+-- One row per team; data is the []api.Template JSON, issue templates only.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS team_templates_cache (
+    team_id TEXT PRIMARY KEY,
+    synced_at DATETIME NOT NULL,
+    data JSON NOT NULL
+);
+
+-- =============================================================================
+-- Emoji Cache (custom workspace emojis, for /.linearfs/emoji.json)
+-- Singleton like organization_cache; data is the []api.Emoji JSON.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS emoji_cache (
+    singleton INTEGER PRIMARY KEY DEFAULT 1 CHECK (singleton = 1),
+    synced_at DATETIME NOT NULL,
+    data JSON NOT NULL
+);
+
+-- =============================================================================
+-- Comment Drafts (issues/{ID}/drafts/)
+-- Local-only: never synced, never sent to Linear until published as a comment.
+-- Unlike every other table this is user data, not a cache of Linear's.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS comment_drafts (
+    issue_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    PRIMARY KEY (issue_id, name)
+);
+
+-- =============================================================================
+-- Recurring Issue Log (the `recurring:` config)
+-- One row per issue the sync worker created for a recurring definition: the
+-- audit trail of scheduled creations. Like comment_drafts, a record of this
+-- mount's own actions rather than a cache of Linear's.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS recurring_issue_log (
+    name TEXT NOT NULL,
+    occurrence DATETIME NOT NULL,
+    issue_id TEXT NOT NULL,
+    identifier TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (name, occurrence)
+);
+
 -- =============================================================================
 -- Pending Detail Sync Queue
 -- Issues that need comments/docs/attachments synced but were skipped due to
@@ -496,3 +698,63 @@ CREATE TABLE IF NOT EXISTS pending_detail_sync (
     identifier TEXT NOT NULL,
     queued_at  DATETIME NOT NULL
 );
+
+-- =============================================================================
+-- Mutation Queue (/.linearfs/pending/)
+-- Writes made while Linear was unreachable, oldest first: already applied to
+-- the local cache, replayed by the sync worker once connectivity returns.
+-- Like comment_drafts, user data rather than a cache of Linear's.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS mutation_queue (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    kind       TEXT NOT NULL,      -- 'issue-update' or 'comment-create'
+    entity_id  TEXT NOT NULL,      -- the issue the write belongs to
+    label      TEXT NOT NULL,      -- its identifier, for the pending/ listing
+    payload    JSON NOT NULL,
+    queued_at  DATETIME NOT NULL,
+    attempts   INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT
+);
+
+-- =============================================================================
+-- Pending Issue Fields
+-- Issues whose row came from a projected bulk sync (api.lazy_issue_fields):
+-- description and/or labels are as of an earlier full fetch, or absent.
+-- Opening issue.md fetches the full issue and clears the mark.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS pending_issue_fields (
+    issue_id  TEXT PRIMARY KEY,
+    queued_at DATETIME NOT NULL
+);
+
+-- =============================================================================
+-- API Budget
+-- The rate budget's last server-reported windows (one row per axis) and this
+-- host's API spend per UTC hour. A restart seeds the budget from the windows,
+-- so the priority ladder governs from the first request instead of spending
+-- blind until a response reports the budget again.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS api_budget_windows (
+    axis        TEXT PRIMARY KEY,  -- 'requests' or 'complexity'
+    limit_value REAL NOT NULL,
+    remaining   REAL NOT NULL,
+    reset_at    DATETIME,
+    observed_at DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS api_budget_hours (
+    hour       DATETIME PRIMARY KEY,  -- start of the UTC hour
+    requests   INTEGER NOT NULL,
+    complexity REAL NOT NULL
+);
+
+-- =============================================================================
+-- Webhook Deliveries
+-- The Linear-Delivery IDs of webhook deliveries already applied, so a retried
+-- or replayed delivery is acknowledged without being applied twice. Rows older
+-- than the handler's replay window are pruned as new ones arrive.
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    delivery_id TEXT PRIMARY KEY,
+    received_at DATETIME NOT NULL
+);
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// RecordScopeChanges keeps how the change from prev (the cached row, nil for
+// an issue new to the cache) to cur moved cycle scope, for a cycle's
+// scope-changes.md: cur entering a cycle is "added" to it, leaving one is
+// "removed" from it, and a new estimate while it stays is "resized". Each is
+// stamped with cur's updatedAt, so the same change observed by both a
+// webhook delivery and a sync is kept once.
+func (s *Store) RecordScopeChanges(ctx context.Context, prev, cur *api.Issue) error {
+	q := s.Queries()
+	var prevCycle string
+	var prevEstimate *float64
+	if prev != nil {
+		prevEstimate = prev.Estimate
+		if prev.Cycle != nil {
+			prevCycle = prev.Cycle.ID
+		}
+	}
+	var curCycle string
+	if cur.Cycle != nil {
+		curCycle = cur.Cycle.ID
+	}
+	record := func(cycleID, kind string, from, to *float64) error {
+		return q.InsertScopeChange(ctx, InsertScopeChangeParams{
+			CycleID:      cycleID,
+			IssueID:      cur.ID,
+			Kind:         kind,
+			At:           cur.UpdatedAt.UTC(),
+			Identifier:   cur.Identifier,
+			Title:        cur.Title,
+			FromEstimate: toNullFloat64(from),
+			ToEstimate:   toNullFloat64(to),
+		})
+	}
+	if prevCycle == curCycle {
+		if curCycle == "" || sameEstimate(prevEstimate, cur.Estimate) {
+			return nil
+		}
+		return record(curCycle, "resized", prevEstimate, cur.Estimate)
+	}
+	if prevCycle != "" {
+		if err := record(prevCycle, "removed", prevEstimate, nil); err != nil {
+			return err
+		}
+	}
+	if curCycle != "" {
+		return record(curCycle, "added", nil, cur.Estimate)
+	}
+	return nil
+}
+
+func sameEstimate(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
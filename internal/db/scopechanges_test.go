@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestRecordScopeChanges: entering a cycle records an addition, a new
+// estimate in it a resize, and moving to another cycle a removal from the
+// first and an addition to the second; a change seen twice is kept once, and
+// an unchanged cycle and estimate record nothing.
+func TestRecordScopeChanges(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+	base := Now().UTC().Truncate(time.Second)
+	two, five := 2.0, 5.0
+
+	c1, c2 := &api.IssueCycle{ID: "c1"}, &api.IssueCycle{ID: "c2"}
+	backlog := api.Issue{ID: "i1", Identifier: "ENG-1", Title: "Fix login", Estimate: &two, UpdatedAt: base}
+	added := backlog
+	added.Cycle, added.UpdatedAt = c1, base.Add(time.Hour)
+	retitled := added
+	retitled.Title, retitled.UpdatedAt = "Fix login redirect", base.Add(2*time.Hour)
+	resized := retitled
+	resized.Estimate, resized.UpdatedAt = &five, base.Add(3*time.Hour)
+	moved := resized
+	moved.Cycle, moved.UpdatedAt = c2, base.Add(4*time.Hour)
+
+	for _, step := range [][2]*api.Issue{
+		{&backlog, &added}, {&backlog, &added}, {&added, &retitled}, {&retitled, &resized}, {&resized, &moved},
+	} {
+		if err := store.RecordScopeChanges(ctx, step[0], step[1]); err != nil {
+			t.Fatalf("RecordScopeChanges: %v", err)
+		}
+	}
+
+	kinds := func(cycleID string) []string {
+		t.Helper()
+		rows, err := store.Queries().ListCycleScopeChanges(ctx, cycleID)
+		if err != nil {
+			t.Fatalf("ListCycleScopeChanges: %v", err)
+		}
+		var out []string
+		for _, r := range rows {
+			out = append(out, r.Kind)
+		}
+		return out
+	}
+	if got := kinds("c1"); len(got) != 3 || got[0] != "added" || got[1] != "resized" || got[2] != "removed" {
+		t.Errorf("c1 changes = %v, want added, resized, removed", got)
+	}
+	if got := kinds("c2"); len(got) != 1 || got[0] != "added" {
+		t.Errorf("c2 changes = %v, want added", got)
+	}
+	rows, _ := store.Queries().ListCycleScopeChanges(ctx, "c1")
+	if r := rows[1]; r.FromEstimate.Float64 != 2 || r.ToEstimate.Float64 != 5 || r.Title != "Fix login redirect" {
+		t.Errorf("resize = %+v, want 2 → 5 under the current title", r)
+	}
+	if r := rows[2]; r.FromEstimate.Float64 != 5 || r.ToEstimate.Valid {
+		t.Errorf("removal = %+v, want from 5", r)
+	}
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// SearchDocuments returns up to limit documents whose title or content matches
+// every term of query, best match first (FTS5 bm25 rank). Terms are matched as
+// quoted prefixes, so user input never reaches the FTS5 query grammar: `api
+// auth` finds "API authentication" and a stray quote or operator is just text.
+// A query with no terms matches nothing.
+func (s *Store) SearchDocuments(ctx context.Context, query string, limit int) ([]Document, error) {
+	match := ftsMatchExpr(query)
+	if match == "" {
+		return nil, nil
+	}
+	rows, err := s.qdb.QueryContext(ctx, `
+		SELECT d.id, d.slug_id, d.title, d.icon, d.color, d.content, d.content_data,
+			d.issue_id, d.project_id, d.initiative_id, d.team_id, d.creator_id,
+			d.url, d.created_at, d.updated_at, d.synced_at, d.data
+		FROM documents_fts f
+		JOIN documents d ON d.id = f.doc_id
+		WHERE documents_fts MATCH ?
+		ORDER BY f.rank
+		LIMIT ?
+	`, match, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDocuments(rows)
+}
+
+// ftsMatchExpr turns free text into an FTS5 MATCH expression: each
+// whitespace-separated term becomes a quoted prefix ("term"*), implicitly
+// ANDed. Embedded double quotes are doubled, FTS5's string escape.
+func ftsMatchExpr(query string) string {
+	terms := strings.Fields(query)
+	for i, t := range terms {
+		terms[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"*`
+	}
+	return strings.Join(terms, " ")
+}
+
+// backfillDocumentsFTS indexes documents that predate documents_fts (a cache
+// created before the index existed): the triggers only see writes made after
+// they were installed. Idempotent, so it runs on every open.
+func backfillDocumentsFTS(db *sql.DB) error {
+	_, err := db.Exec(`
+		INSERT INTO documents_fts (doc_id, title, content)
+		SELECT id, title, COALESCE(content, '') FROM documents
+		WHERE id NOT IN (SELECT doc_id FROM documents_fts)
+	`)
+	return err
+}
+
+// scanDocuments scans rows into Document structs
+func scanDocuments(rows *sql.Rows) ([]Document, error) {
+	var docs []Document
+	for rows.Next() {
+		var d Document
+		if err := rows.Scan(
+			&d.ID, &d.SlugID, &d.Title, &d.Icon, &d.Color, &d.Content, &d.ContentData,
+			&d.IssueID, &d.ProjectID, &d.InitiativeID, &d.TeamID, &d.CreatorID,
+			&d.Url, &d.CreatedAt, &d.UpdatedAt, &d.SyncedAt, &d.Data,
+		); err != nil {
+			return nil, err
+		}
+		docs = append(docs, d)
+	}
+	return docs, rows.Err()
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// FuzzSearchDocuments drives the docs/search/{query}/ path component through
+// ftsMatchExpr into a real FTS5 MATCH. The query is whatever name a user (or
+// a tool globbing the mount) looks up, so the contract is that no input reaches
+// the FTS5 grammar: every query either matches nothing or returns rows, never
+// a syntax error. Also checks the expression keeps one quoted prefix per term.
+func FuzzSearchDocuments(f *testing.F) {
+	for _, s := range []string{
+		"api",
+		"api auth",
+		`"unbalanced`,
+		`a" OR "b`,
+		"NEAR(a b)",
+		"title:api",
+		"-api",
+		"api*",
+		"(api",
+		"^api",
+		"AND",
+		"a\tb\nc",
+		"日本語 café",
+		"\xff\xfe",
+		strings.Repeat("x ", 200),
+	} {
+		f.Add(s)
+	}
+
+	ctx := context.Background()
+	store, err := Open(filepath.Join(f.TempDir(), "test.db"))
+	if err != nil {
+		f.Fatalf("Open failed: %v", err)
+	}
+	defer store.Close()
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	params, err := APIDocumentToDBDocument(api.Document{ID: "doc-1", SlugID: "s1", Title: "API authentication", Content: "Tokens rotate weekly.", CreatedAt: now, UpdatedAt: now})
+	if err != nil {
+		f.Fatal(err)
+	}
+	if err := store.Queries().UpsertDocument(ctx, params); err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, query string) {
+		// A path component never holds a slash or NUL; the kernel splits or
+		// rejects those before Lookup sees the name.
+		if strings.ContainsAny(query, "/\x00") {
+			return
+		}
+		if _, err := store.SearchDocuments(ctx, query, 10); err != nil {
+			t.Fatalf("SearchDocuments(%q) = %v; want rows or none", query, err)
+		}
+		match := ftsMatchExpr(query)
+		if got, want := strings.Count(match, `"*`), len(strings.Fields(query)); got < want {
+			t.Fatalf("ftsMatchExpr(%q) = %q: %d quoted prefixes for %d terms", query, match, got, want)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestSearchDocuments(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store, err := Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer store.Close()
+
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	upsert := func(doc api.Document) {
+		t.Helper()
+		doc.CreatedAt, doc.UpdatedAt = now, now
+		params, err := APIDocumentToDBDocument(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Queries().UpsertDocument(ctx, params); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ids := func(query string) []string {
+		t.Helper()
+		docs, err := store.SearchDocuments(ctx, query, 10)
+		if err != nil {
+			t.Fatalf("SearchDocuments(%q): %v", query, err)
+		}
+		var out []string
+		for _, d := range docs {
+			out = append(out, d.ID)
+		}
+		return out
+	}
+	same := func(got []string, want ...string) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	upsert(api.Document{ID: "doc-1", SlugID: "s1", Title: "API authentication", Content: "Tokens rotate weekly."})
+	upsert(api.Document{ID: "doc-2", SlugID: "s2", Title: "Onboarding", Content: "Ask for an API key on day one."})
+
+	if got := ids("api"); len(got) != 2 {
+		t.Errorf("api = %v, want both documents", got)
+	}
+	if got := ids("authent"); !same(got, "doc-1") {
+		t.Errorf("prefix authent = %v, want [doc-1]", got)
+	}
+	if got := ids("api onboarding"); !same(got, "doc-2") {
+		t.Errorf("api onboarding = %v, want [doc-2] (terms AND)", got)
+	}
+	// FTS5 syntax in the query is plain text, not an error.
+	if got := ids(`"tokens OR NEAR(`); got != nil {
+		t.Errorf("operator soup = %v, want no match", got)
+	}
+	if got := ids("   "); got != nil {
+		t.Errorf("blank query = %v, want nil", got)
+	}
+
+	// Updates and deletes keep the index in step.
+	upsert(api.Document{ID: "doc-1", SlugID: "s1", Title: "API authentication", Content: "Passkeys only."})
+	if got := ids("tokens"); got != nil {
+		t.Errorf("stale content still indexed: %v", got)
+	}
+	if got := ids("passkeys"); !same(got, "doc-1") {
+		t.Errorf("passkeys = %v, want [doc-1]", got)
+	}
+	if err := store.Queries().DeleteDocument(ctx, "doc-2"); err != nil {
+		t.Fatal(err)
+	}
+	if got := ids("onboarding"); got != nil {
+		t.Errorf("deleted document still indexed: %v", got)
+	}
+
+	// A cache from before the index: rows the triggers never saw are
+	// backfilled on open.
+	if _, err := store.DB().Exec("DELETE FROM documents_fts"); err != nil {
+		t.Fatal(err)
+	}
+	if err := backfillDocumentsFTS(store.DB()); err != nil {
+		t.Fatalf("backfill: %v", err)
+	}
+	if got := ids("passkeys"); !same(got, "doc-1") {
+		t.Errorf("after backfill passkeys = %v, want [doc-1]", got)
+	}
+}
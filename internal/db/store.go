@@ -28,11 +28,47 @@ type Store struct {
 	// caller can wedge a local read/write into a spurious EIO on a cancelled
 	// FUSE request (#296). db stays raw for lifecycle (Close) and the test seam.
 	qdb DBTX
+
+	// lock is the held writer lock (lock.go); nil for a read-only store,
+	// which another process's writer keeps current.
+	lock      *os.File
+	writerPID int // the writer's pid when read-only, 0 if unknown
 }
 
-// Open opens or creates a SQLite database at the given path.
+// Open opens or creates a SQLite database at the given path as its writer.
 // If the existing database has an incompatible schema, it is deleted and recreated.
+// When another process already holds the cache's writer lock (lock.go), it
+// opens the existing database read-only instead; see ReadOnly.
 func Open(dbPath string) (*Store, error) {
+	// Ensure parent directory exists. 0700: the SQLite cache holds a full local
+	// copy of the user's Linear data (issue bodies, comments, ...) and must be
+	// owner-only (#339). atrest.Chmod self-heals an existing loose dir that an
+	// older binary created 0755.
+	dir := filepath.Dir(dbPath)
+	if err := os.MkdirAll(dir, atrest.DirMode); err != nil {
+		return nil, fmt.Errorf("create db directory: %w", err)
+	}
+	atrest.Chmod(dir, atrest.DirMode, atrest.ArtifactDB)
+
+	lock, holder, err := acquireWriterLock(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if lock == nil {
+		return openReadOnly(dbPath, holder)
+	}
+	store, err := openWriter(dbPath)
+	if err != nil {
+		lock.Close()
+		return nil, err
+	}
+	store.lock = lock
+	return store, nil
+}
+
+// openWriter opens the database under the writer lock, recreating it when
+// its schema is incompatible.
+func openWriter(dbPath string) (*Store, error) {
 	store, err := openDB(dbPath)
 	if err != nil {
 		// Check if this is a schema error (e.g., missing column)
@@ -56,16 +92,6 @@ func Open(dbPath string) (*Store, error) {
 
 // openDB is the internal function that opens the database
 func openDB(dbPath string) (*Store, error) {
-	// Ensure parent directory exists. 0700: the SQLite cache holds a full local
-	// copy of the user's Linear data (issue bodies, comments, ...) and must be
-	// owner-only (#339). atrest.Chmod self-heals an existing loose dir that an
-	// older binary created 0755.
-	dir := filepath.Dir(dbPath)
-	if err := os.MkdirAll(dir, atrest.DirMode); err != nil {
-		return nil, fmt.Errorf("create db directory: %w", err)
-	}
-	atrest.Chmod(dir, atrest.DirMode, atrest.ArtifactDB)
-
 	// Use file: URI format to properly handle paths with spaces and query params
 	// Escape spaces in path for URI format
 	escapedPath := strings.ReplaceAll(dbPath, " ", "%20")
@@ -85,6 +111,15 @@ func openDB(dbPath string) (*Store, error) {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
 
+	// Readers in other processes rely on WAL: under a rollback journal a
+	// sync's write transaction would block every read of the mount. The pragma
+	// is a request — a filesystem without shared memory (some network mounts)
+	// leaves the database in its old mode — so check what it got.
+	if err := requireWAL(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	// Initialize schema
 	if _, err := db.Exec(schemaSQL); err != nil {
 		db.Close()
@@ -114,6 +149,55 @@ func openDB(dbPath string) (*Store, error) {
 	}, nil
 }
 
+// openReadOnly opens an existing database another process writes. Schema,
+// migrations and the WAL switch are the writer's; a database without the
+// schema yet (the writer is still creating it) fails like any other open
+// error.
+func openReadOnly(dbPath string, writerPID int) (*Store, error) {
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("open read-only database: %w", err)
+	}
+	// query_only rather than mode=ro: a read-only open of a live WAL database
+	// cannot create the -shm index it reads through, and the writer deletes
+	// that on its last close. query_only refuses writes all the same.
+	escapedPath := strings.ReplaceAll(dbPath, " ", "%20")
+	connStr := "file:" + escapedPath + "?_time_format=sqlite" +
+		"&_pragma=busy_timeout(5000)" +
+		"&_pragma=foreign_keys(1)" +
+		"&_pragma=query_only(1)"
+	db, err := sql.Open("sqlite", connStr)
+	if err != nil {
+		return nil, fmt.Errorf("open read-only database: %w", err)
+	}
+	if err := requireWAL(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if ok, err := tableHasColumn(db, "issues", "detail_synced_at"); err != nil || !ok {
+		db.Close()
+		return nil, fmt.Errorf("open read-only database: schema not initialized by its writer")
+	}
+	qdb := ctxDetachDBTX{inner: db}
+	return &Store{
+		db:        db,
+		queries:   New(qdb),
+		qdb:       qdb,
+		writerPID: writerPID,
+	}, nil
+}
+
+// requireWAL fails unless the database is in WAL mode.
+func requireWAL(db *sql.DB) error {
+	var mode string
+	if err := db.QueryRow("PRAGMA journal_mode").Scan(&mode); err != nil {
+		return fmt.Errorf("read journal mode: %w", err)
+	}
+	if !strings.EqualFold(mode, "wal") {
+		return fmt.Errorf("journal mode is %q, want wal (is the cache on a filesystem without shared-memory support?)", mode)
+	}
+	return nil
+}
+
 // tightenDBFiles chmods cache.db and its WAL/SHM sidecars to 0600, best-effort.
 func tightenDBFiles(dbPath string) {
 	atrest.Chmod(dbPath, atrest.FileMode, atrest.ArtifactDB)
@@ -154,6 +238,65 @@ func migrateSchema(db *sql.DB) error {
 			return fmt.Errorf("index documents.team_id: %w", err)
 		}
 	}
+
+	// parent_id links a sub-initiative to its enclosing initiative. Existing
+	// rows stay NULL (top level) until the next workspace sync fills them.
+	hasParent, err := tableHasColumn(db, "initiatives", "parent_id")
+	if err != nil {
+		return err
+	}
+	if !hasParent {
+		if _, err := db.Exec("ALTER TABLE initiatives ADD COLUMN parent_id TEXT"); err != nil {
+			return fmt.Errorf("add initiatives.parent_id: %w", err)
+		}
+	}
+	if _, err := db.Exec("CREATE INDEX IF NOT EXISTS idx_initiatives_parent ON initiatives(parent_id)"); err != nil {
+		return fmt.Errorf("index initiatives.parent_id: %w", err)
+	}
+
+	// deleted_at tombstones a comment deleted in Linear instead of dropping
+	// its row. Comments pruned before it existed are gone for good.
+	hasDeletedAt, err := tableHasColumn(db, "comments", "deleted_at")
+	if err != nil {
+		return err
+	}
+	if !hasDeletedAt {
+		if _, err := db.Exec("ALTER TABLE comments ADD COLUMN deleted_at DATETIME"); err != nil {
+			return fmt.Errorf("add comments.deleted_at: %w", err)
+		}
+	}
+
+	// private flags a members-only team. Teams synced before it existed read
+	// as public until the next workspace sync rewrites their rows.
+	hasPrivate, err := tableHasColumn(db, "teams", "private")
+	if err != nil {
+		return err
+	}
+	if !hasPrivate {
+		if _, err := db.Exec("ALTER TABLE teams ADD COLUMN private INTEGER NOT NULL DEFAULT 0"); err != nil {
+			return fmt.Errorf("add teams.private: %w", err)
+		}
+	}
+
+	// color and description came with .team.json and the team dir's xattrs.
+	// Teams synced before them read blank until the next workspace sync.
+	for _, col := range []string{"color", "description"} {
+		has, err := tableHasColumn(db, "teams", col)
+		if err != nil {
+			return err
+		}
+		if !has {
+			if _, err := db.Exec("ALTER TABLE teams ADD COLUMN " + col + " TEXT"); err != nil {
+				return fmt.Errorf("add teams.%s: %w", col, err)
+			}
+		}
+	}
+
+	// documents_fts is created by schema.sql; rows synced before it existed
+	// were never seen by its triggers.
+	if err := backfillDocumentsFTS(db); err != nil {
+		return fmt.Errorf("backfill documents_fts: %w", err)
+	}
 	return nil
 }
 
@@ -184,9 +327,26 @@ func tableHasColumn(db *sql.DB, table, column string) (bool, error) {
 	return false, rows.Err()
 }
 
-// Close closes the database connection
+// Close closes the database connection and releases the writer lock.
 func (s *Store) Close() error {
-	return s.db.Close()
+	err := s.db.Close()
+	if s.lock != nil {
+		s.lock.Close()
+	}
+	return err
+}
+
+// ReadOnly reports whether Open found another process holding the writer
+// lock and opened the database read-only. Writes through a read-only store
+// fail; its data is as fresh as that process keeps it.
+func (s *Store) ReadOnly() bool {
+	return s.lock == nil
+}
+
+// WriterPID is the pid of the process writing a read-only store's database,
+// 0 when it is not known (or the store is the writer).
+func (s *Store) WriterPID() int {
+	return s.writerPID
 }
 
 // Queries returns the sqlc queries interface
@@ -207,6 +367,18 @@ func (s *Store) DB() *sql.DB {
 // while a fresh one has it in schema.sql order — positional scanning over *
 // would misalign on one of them.
 func (s *Store) ListIssuesByLabel(ctx context.Context, teamID, labelName string) ([]Issue, error) {
+	return s.listIssuesByLabelField(ctx, teamID, "$.name", labelName)
+}
+
+// ListIssuesByLabelID is ListIssuesByLabel keyed by label ID, which tells
+// apart two labels that share a name (a team label and a workspace one).
+func (s *Store) ListIssuesByLabelID(ctx context.Context, teamID, labelID string) ([]Issue, error) {
+	return s.listIssuesByLabelField(ctx, teamID, "$.id", labelID)
+}
+
+// listIssuesByLabelField lists a team's issues with a label node whose field
+// (a JSON path into the node) equals value.
+func (s *Store) listIssuesByLabelField(ctx context.Context, teamID, field, value string) ([]Issue, error) {
 	rows, err := s.qdb.QueryContext(ctx, `
 		SELECT id, identifier, team_id, title, description,
 			state_id, state_name, state_type,
@@ -219,10 +391,10 @@ func (s *Store) ListIssuesByLabel(ctx context.Context, teamID, labelName string)
 		WHERE team_id = ?
 		AND EXISTS (
 			SELECT 1 FROM json_each(json_extract(data, '$.labels.nodes'))
-			WHERE json_extract(value, '$.name') = ?
+			WHERE json_extract(value, ?) = ?
 		)
 		ORDER BY updated_at DESC
-	`, teamID, labelName)
+	`, teamID, field, value)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +403,51 @@ func (s *Store) ListIssuesByLabel(ctx context.Context, teamID, labelName string)
 	return scanIssues(rows)
 }
 
+// RenameIssueLabel rewrites a renamed label's name inside the labels embedded
+// in every cached issue's data, so name-keyed readers (ListIssuesByLabel,
+// issue.md's labels:) follow a rename at once instead of at the issue's next
+// sync — a label rename does not bump the issues' updatedAt, so an
+// incremental sync never refetches them. It returns the affected issues as
+// they were before the rewrite, for the caller's kernel-cache notifies.
+func (s *Store) RenameIssueLabel(ctx context.Context, labelID, name string) ([]Issue, error) {
+	const match = `EXISTS (
+			SELECT 1 FROM json_each(json_extract(data, '$.labels.nodes'))
+			WHERE json_extract(value, '$.id') = ?1 AND json_extract(value, '$.name') != ?2
+		)`
+	rows, err := s.qdb.QueryContext(ctx, `
+		SELECT id, identifier, team_id, title, description,
+			state_id, state_name, state_type,
+			assignee_id, assignee_email, creator_id, creator_email, priority,
+			project_id, project_name, cycle_id, cycle_name,
+			parent_id, due_date, estimate, url, branch_name,
+			created_at, updated_at, started_at, completed_at, canceled_at, archived_at,
+			synced_at, detail_synced_at, data
+		FROM issues
+		WHERE `+match, labelID, name)
+	if err != nil {
+		return nil, err
+	}
+	before, err := scanIssues(rows)
+	rows.Close()
+	if err != nil || len(before) == 0 {
+		return nil, err
+	}
+
+	// json_each's key for an array element is its index, which addresses the
+	// label node for json_set. json_set returns TEXT; the CAST keeps data the
+	// BLOB every other writer stores (and every scan into json.RawMessage needs).
+	if _, err := s.qdb.ExecContext(ctx, `
+		UPDATE issues SET data = CAST(json_set(data,
+			'$.labels.nodes[' || (
+				SELECT key FROM json_each(json_extract(data, '$.labels.nodes'))
+				WHERE json_extract(value, '$.id') = ?1
+			) || '].name', ?2) AS BLOB)
+		WHERE `+match, labelID, name); err != nil {
+		return nil, err
+	}
+	return before, nil
+}
+
 // scanIssues scans rows into Issue structs
 func scanIssues(rows *sql.Rows) ([]Issue, error) {
 	var issues []Issue
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -628,6 +629,7 @@ func TestAPITeamConversion(t *testing.T) {
 		Key:       "TST",
 		Name:      "Test Team",
 		Icon:      "icon",
+		Private:   true,
 		CreatedAt: time.Now().Add(-24 * time.Hour),
 		UpdatedAt: time.Now(),
 	}
@@ -643,6 +645,9 @@ func TestAPITeamConversion(t *testing.T) {
 	if params.Icon.String != team.Icon {
 		t.Errorf("Icon mismatch")
 	}
+	if params.Private != 1 {
+		t.Errorf("Private = %d, want 1", params.Private)
+	}
 }
 
 func TestDefaultDBPath(t *testing.T) {
@@ -881,3 +886,156 @@ func strPtr(s string) *string {
 func float64Ptr(f float64) *float64 {
 	return &f
 }
+
+// TestMigrateAddsInitiativeParentID: a database from before sub-initiatives
+// opens cleanly, gains initiatives.parent_id (and its index), and keeps its
+// rows readable as top-level initiatives.
+func TestMigrateAddsInitiativeParentID(t *testing.T) {
+	t.Parallel()
+	dbPath := filepath.Join(t.TempDir(), "old.db")
+
+	raw, err := sql.Open("sqlite", "file:"+dbPath+"?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("open raw db: %v", err)
+	}
+	if _, err := raw.Exec(`CREATE TABLE initiatives (
+		id TEXT PRIMARY KEY,
+		slug_id TEXT UNIQUE NOT NULL,
+		name TEXT NOT NULL,
+		description TEXT,
+		icon TEXT,
+		color TEXT,
+		status TEXT,
+		sort_order REAL,
+		target_date TEXT,
+		owner_id TEXT,
+		url TEXT,
+		created_at DATETIME,
+		updated_at DATETIME,
+		synced_at DATETIME NOT NULL,
+		data JSON NOT NULL
+	)`); err != nil {
+		t.Fatalf("create old initiatives table: %v", err)
+	}
+	if _, err := raw.Exec(`INSERT INTO initiatives (id, slug_id, name, synced_at, data)
+		VALUES ('init-old', 'old', 'Pre-migration initiative', ?, ?)`, Now(), []byte("{}")); err != nil {
+		t.Fatalf("insert old row: %v", err)
+	}
+	if err := raw.Close(); err != nil {
+		t.Fatalf("close raw db: %v", err)
+	}
+
+	for i := 0; i < 2; i++ { // the second Open proves idempotence
+		store, err := Open(dbPath)
+		if err != nil {
+			t.Fatalf("Open #%d on pre-migration db failed: %v", i+1, err)
+		}
+		got, err := store.Queries().GetInitiative(context.Background(), "init-old")
+		if err != nil {
+			t.Fatalf("GetInitiative on migrated db: %v", err)
+		}
+		if got.Name != "Pre-migration initiative" || got.ParentID.Valid {
+			t.Errorf("migrated row = name %q parent %v, want top-level", got.Name, got.ParentID)
+		}
+		store.Close()
+	}
+}
+
+// TestMigrateAddsTeamPrivate: a database from before team permissions opens
+// cleanly and reads its teams as public until the next sync rewrites them.
+func TestMigrateAddsTeamPrivate(t *testing.T) {
+	t.Parallel()
+	dbPath := filepath.Join(t.TempDir(), "old.db")
+
+	raw, err := sql.Open("sqlite", "file:"+dbPath+"?_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("open raw db: %v", err)
+	}
+	if _, err := raw.Exec(`CREATE TABLE teams (
+		id TEXT PRIMARY KEY,
+		key TEXT UNIQUE NOT NULL,
+		name TEXT NOT NULL,
+		icon TEXT,
+		created_at DATETIME,
+		updated_at DATETIME,
+		synced_at DATETIME NOT NULL
+	)`); err != nil {
+		t.Fatalf("create old teams table: %v", err)
+	}
+	if _, err := raw.Exec(`INSERT INTO teams (id, key, name, synced_at) VALUES ('team-old', 'OLD', 'Old', ?)`, Now()); err != nil {
+		t.Fatalf("insert old row: %v", err)
+	}
+	if err := raw.Close(); err != nil {
+		t.Fatalf("close raw db: %v", err)
+	}
+
+	for i := 0; i < 2; i++ { // the second Open proves idempotence
+		store, err := Open(dbPath)
+		if err != nil {
+			t.Fatalf("Open #%d on pre-migration db failed: %v", i+1, err)
+		}
+		teams, err := store.Queries().ListTeams(context.Background())
+		if err != nil {
+			t.Fatalf("ListTeams on migrated db: %v", err)
+		}
+		if len(teams) != 1 || teams[0].Key != "OLD" || teams[0].Private != 0 {
+			t.Errorf("migrated teams = %+v, want OLD as public", teams)
+		}
+		store.Close()
+	}
+}
+
+// TestRenameIssueLabel: a label rename rewrites the name inside every cached
+// issue's embedded labels (only the renamed label's node), returns those issues
+// as they were, and leaves issues without the label untouched — so the
+// name-keyed ListIssuesByLabel finds them under the new name at once.
+func TestRenameIssueLabel(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	put := func(id string, labels ...api.Label) {
+		t.Helper()
+		issue := api.Issue{ID: id, Identifier: "TST-" + id, Title: id, Team: &api.Team{ID: "team-1"}, CreatedAt: Now(), UpdatedAt: Now()}
+		issue.Labels.Nodes = labels
+		data, err := APIIssueToDBIssue(issue)
+		if err != nil {
+			t.Fatalf("convert %s: %v", id, err)
+		}
+		if err := store.Queries().UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+			t.Fatalf("upsert %s: %v", id, err)
+		}
+	}
+	bug := api.Label{ID: "label-bug", Name: "Bug"}
+	ui := api.Label{ID: "label-ui", Name: "UI"}
+	put("1", ui, bug)
+	put("2", ui)
+
+	before, err := store.RenameIssueLabel(ctx, "label-bug", "Defect")
+	if err != nil {
+		t.Fatalf("RenameIssueLabel: %v", err)
+	}
+	if len(before) != 1 || before[0].ID != "1" {
+		t.Fatalf("affected = %v, want only issue 1", before)
+	}
+	if !strings.Contains(string(before[0].Data), `"Bug"`) {
+		t.Errorf("returned issue should carry the old name, data = %s", before[0].Data)
+	}
+
+	got, err := store.ListIssuesByLabel(ctx, "team-1", "Defect")
+	if err != nil || len(got) != 1 || got[0].ID != "1" {
+		t.Fatalf("ListIssuesByLabel(Defect) = %v, %v; want issue 1", got, err)
+	}
+	if old, _ := store.ListIssuesByLabel(ctx, "team-1", "Bug"); len(old) != 0 {
+		t.Errorf("ListIssuesByLabel(Bug) = %d issues after rename, want 0", len(old))
+	}
+	if ui, _ := store.ListIssuesByLabel(ctx, "team-1", "UI"); len(ui) != 2 {
+		t.Errorf("the other label's node was touched: ListIssuesByLabel(UI) = %d, want 2", len(ui))
+	}
+
+	// Already renamed: nothing left to rewrite.
+	if again, err := store.RenameIssueLabel(ctx, "label-bug", "Defect"); err != nil || len(again) != 0 {
+		t.Errorf("second rename = %v, %v; want none", again, err)
+	}
+}
@@ -0,0 +1,108 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// activityDirName is the root changelog directory.
+const activityDirName = "activity"
+
+// ActivityNode is /activity/: the workspace's changelog, one {date}.md per UTC
+// day with recorded activity (the newest db.ActivityDaysKept). Each lists the
+// issues created and completed that day and the state changes, across every
+// team, as sync and webhook deliveries observed them. Linear has no feed to
+// backfill from, so it starts when the mount has synced a team once and
+// misses what happened while it was not running. Read-only; contrast .events,
+// a live trigger feed with no history.
+type ActivityNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*ActivityNode)(nil)
+var _ fs.NodeLookuper = (*ActivityNode)(nil)
+var _ fs.NodeGetattrer = (*ActivityNode)(nil)
+
+func (n *ActivityNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	days, err := n.lfs.repo.GetActivityDays(ctx)
+	if err != nil {
+		log.Printf("Failed to list activity days: %v", err)
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(days))
+	for i, day := range days {
+		entries[i] = fuse.DirEntry{Name: day + ".md", Mode: syscall.S_IFREG}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *ActivityNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	day, ok := strings.CutSuffix(name, ".md")
+	if !ok {
+		return nil, syscall.ENOENT
+	}
+	if _, err := time.Parse(db.ActivityDayFormat, day); err != nil {
+		return nil, syscall.ENOENT
+	}
+	if entries, err := n.lfs.repo.GetActivity(ctx, day); err != nil || len(entries) == 0 {
+		return nil, syscall.ENOENT
+	}
+	// Rendered on each read, so today's file follows changes as they land.
+	return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		entries, err := n.lfs.repo.GetActivity(ctx, day)
+		if err != nil {
+			log.Printf("Failed to read activity for %s: %v", day, err)
+		}
+		return renderActivity(day, entries)
+	}, activityDayIno(day), inheritTimeout), 0
+}
+
+// renderActivity renders a day's {date}.md from its entries, in time order:
+// the issues created, the issues completed, then every state change. The file
+// is timed at the day's last entry.
+func renderActivity(day string, entries []api.ActivityEntry) ([]byte, time.Time, time.Time) {
+	var created, completed, moved []api.ActivityEntry
+	for _, e := range entries {
+		switch e.Kind {
+		case "created":
+			created = append(created, e)
+		case "completed":
+			completed = append(completed, e)
+		case "state":
+			moved = append(moved, e)
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Activity %s\n\n", day)
+	b.WriteString("Issue changes observed across the workspace on this day (times UTC).\n")
+	if len(entries) == 0 {
+		b.WriteString("\nNo activity.\n")
+		return []byte(b.String()), time.Time{}, time.Time{}
+	}
+
+	section := func(title string, list []api.ActivityEntry, detail func(api.ActivityEntry) string) {
+		if len(list) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", title, len(list))
+		for _, e := range list {
+			fmt.Fprintf(&b, "- %s %s %s (%s, %s)\n", e.At.UTC().Format("15:04"), e.Identifier, e.Title, e.TeamKey, detail(e))
+		}
+	}
+	section("Created", created, func(e api.ActivityEntry) string { return e.ToState })
+	section("Completed", completed, func(e api.ActivityEntry) string { return e.ToState })
+	section("State changes", moved, func(e api.ActivityEntry) string { return e.FromState + " → " + e.ToState })
+
+	last := entries[len(entries)-1].At
+	return []byte(b.String()), last, last
+}
@@ -0,0 +1,70 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+func TestRenderActivity(t *testing.T) {
+	t.Parallel()
+	at := func(hour, min int) time.Time { return time.Date(2026, 10, 17, hour, min, 0, 0, time.UTC) }
+	entries := []api.ActivityEntry{
+		{At: at(9, 5), Kind: "created", Identifier: "ENG-12", TeamKey: "ENG", Title: "Fix login", ToState: "Todo"},
+		{At: at(10, 30), Kind: "state", Identifier: "OPS-3", TeamKey: "OPS", Title: "Rotate keys", FromState: "Todo", ToState: "In Progress"},
+		{At: at(16, 0), Kind: "completed", Identifier: "ENG-7", TeamKey: "ENG", Title: "Ship v2", ToState: "Done"},
+	}
+
+	data, mtime, _ := renderActivity("2026-10-17", entries)
+	got := string(data)
+	for _, want := range []string{
+		"# Activity 2026-10-17\n",
+		"## Created (1)\n\n- 09:05 ENG-12 Fix login (ENG, Todo)\n",
+		"## Completed (1)\n\n- 16:00 ENG-7 Ship v2 (ENG, Done)\n",
+		"## State changes (1)\n\n- 10:30 OPS-3 Rotate keys (OPS, Todo → In Progress)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("activity missing %q:\n%s", want, got)
+		}
+	}
+	if !mtime.Equal(at(16, 0)) {
+		t.Errorf("mtime = %v, want the last entry's time", mtime)
+	}
+	if data, _, _ := renderActivity("2026-10-17", nil); !strings.Contains(string(data), "No activity.") {
+		t.Errorf("empty day:\n%s", data)
+	}
+}
+
+// TestActivityDir: activity/ lists one file per day with recorded activity,
+// and only those days resolve.
+func TestActivityDir(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	today := db.Now().UTC().Truncate(24 * time.Hour)
+	yesterday := today.AddDate(0, 0, -1)
+	for _, issue := range []api.Issue{
+		{ID: "i1", Identifier: "TST-1", Title: "Old", Team: &api.Team{Key: "TST"}, CreatedAt: yesterday.Add(time.Hour)},
+		{ID: "i2", Identifier: "TST-2", Title: "New", Team: &api.Team{Key: "TST"}, CreatedAt: today.Add(time.Hour)},
+	} {
+		if err := store.RecordIssueActivity(ctx, nil, &issue); err != nil {
+			t.Fatalf("RecordIssueActivity: %v", err)
+		}
+	}
+
+	node := &ActivityNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}}
+	want := []string{yesterday.Format(db.ActivityDayFormat) + ".md", today.Format(db.ActivityDayFormat) + ".md"}
+	if got := readdirNames(t, node); !slices.Equal(got, want) {
+		t.Errorf("activity/ = %v, want %v", got, want)
+	}
+
+	entries, err := lfs.repo.GetActivity(ctx, today.Format(db.ActivityDayFormat))
+	if err != nil || len(entries) != 1 || entries[0].Identifier != "TST-2" {
+		t.Errorf("today's activity = %+v, %v; want TST-2 only", entries, err)
+	}
+}
@@ -147,7 +147,7 @@ func (n *AttachmentsNode) createExternalAttachmentNode(ctx context.Context, name
 		renderFile: renderFile{
 			BaseNode: BaseNode{lfs: n.lfs},
 			render: func(context.Context) ([]byte, time.Time, time.Time) {
-				return []byte(externalAttachmentContent(att)), att.UpdatedAt, att.CreatedAt
+				return []byte(externalAttachmentContent(att, n.lfs.prStatus(att.URL))), att.UpdatedAt, att.CreatedAt
 			},
 		},
 		attachment: att,
@@ -254,8 +254,9 @@ func (n *ExternalAttachmentNode) refreshFrom(fresh fs.InodeEmbedder) {
 	n.renderMu.Unlock()
 }
 
-// externalAttachmentContent renders a .link file's YAML body.
-func externalAttachmentContent(att api.Attachment) string {
+// externalAttachmentContent renders a .link file's YAML body. pr, when non-nil,
+// is the GitHub enrichment for a pull-request URL (see prstatus.go).
+func externalAttachmentContent(att api.Attachment, pr *api.PullRequestStatus) string {
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("title: %s\n", att.Title))
 	sb.WriteString(fmt.Sprintf("url: %s\n", att.URL))
@@ -265,6 +266,15 @@ func externalAttachmentContent(att api.Attachment) string {
 	if att.SourceType != "" {
 		sb.WriteString(fmt.Sprintf("source: %s\n", att.SourceType))
 	}
+	if pr != nil {
+		sb.WriteString(fmt.Sprintf("pr_state: %s\n", pr.State))
+		if pr.Checks != "" {
+			sb.WriteString(fmt.Sprintf("checks: %s\n", pr.Checks))
+		}
+		if len(pr.Reviewers) > 0 {
+			sb.WriteString(fmt.Sprintf("reviewers: %s\n", strings.Join(pr.Reviewers, ", ")))
+		}
+	}
 	return sb.String()
 }
 
@@ -144,3 +144,59 @@ func TestCreateAttachmentMutateFailureRechecksLive(t *testing.T) {
 		t.Fatalf("re-check must adopt the live attachment as success (.last), got: %+v", got)
 	}
 }
+
+// TestUploadFileEmbedsInDescription drives a drop-in upload's flush: the
+// description gains the image link after its existing text, attachments/
+// lists the file under its own name, and reading it back is served from the
+// bytes just written rather than a CDN download.
+func TestUploadFileEmbedsInDescription(t *testing.T) {
+	lfs, _ := linkTestLFS(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	issue := api.Issue{
+		ID: "issue-up", Identifier: "ENG-7", Title: "Upload target", Description: "Repro below.",
+		Team: &api.Team{ID: "team-1", Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := lfs.UpsertIssue(ctx, issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	dir := &AttachmentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issueID: issue.ID}
+	content := []byte("\x89PNG\r\n\x1a\nfake")
+
+	if errno := dir.uploadFile(ctx, "screenshot.png", content); errno != 0 {
+		t.Fatalf("uploadFile errno = %v", errno)
+	}
+
+	last := lfs.GetWriteSuccess(collectionErrorKey("attachments", issue.ID))
+	if len(last) != 1 || last[0].Path != "screenshot.png" {
+		t.Fatalf(".last = %+v, want one entry for screenshot.png", last)
+	}
+	stored, err := lfs.repo.GetIssueByID(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("GetIssueByID: %v", err)
+	}
+	if want := "Repro below.\n\n![screenshot.png](" + last[0].URL + ")"; stored.Description != want {
+		t.Errorf("cached description = %q, want %q", stored.Description, want)
+	}
+
+	entry, ok := dir.listing(ctx, nil).find("screenshot.png")
+	if !ok || entry.embedded == nil {
+		t.Fatalf("screenshot.png not listed as an embedded file")
+	}
+	if entry.embedded.MimeType != "image/png" || entry.embedded.FileSize != int64(len(content)) {
+		t.Errorf("embedded row = %+v", *entry.embedded)
+	}
+	got, err := lfs.FetchEmbeddedFile(ctx, *entry.embedded)
+	if err != nil || string(got) != string(content) {
+		t.Errorf("FetchEmbeddedFile = %q, %v; want the uploaded bytes", got, err)
+	}
+}
+
+func TestUploadFileRejectsOversize(t *testing.T) {
+	lfs, _ := linkTestLFS(t)
+	dir := &AttachmentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issueID: "issue-big"}
+	if errno := dir.uploadFile(context.Background(), "huge.bin", make([]byte, api.MaxUploadBytes+1)); errno != syscall.EINVAL {
+		t.Errorf("uploadFile over the cap errno = %v, want EINVAL", errno)
+	}
+}
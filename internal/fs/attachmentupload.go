@@ -0,0 +1,157 @@
+package fs
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// Drop-in uploads.
+//
+// `cp screenshot.png attachments/` uploads the file and embeds it in the
+// issue. Create hands the new name a createFileNode, so the copied bytes
+// collect in the per-open buffer like any _create write; the close-time Flush
+// runs the upload tail: the bytes go to Linear's file storage (api.UploadFile),
+// the returned asset URL is appended to the issue's CURRENT description as a
+// markdown link named after the file, and the embedded_files row is written
+// so the file lists under the same name at once. That row is exactly the one
+// the next sync would extract from the new link (same URL-derived id, same
+// link-text filename), so sync confirms it rather than renaming it.
+
+var _ fs.NodeCreater = (*AttachmentsNode)(nil)
+
+// Create starts a drop-in upload. Names are restricted to what can round-trip
+// as markdown link text, and never shadow the trio, a dotfile, or a .link
+// (external links are created by writing _create).
+func (n *AttachmentsNode) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	if name == "_create" || strings.HasPrefix(name, ".") {
+		return nil, nil, 0, syscall.EPERM
+	}
+	if strings.HasSuffix(name, ".link") || strings.ContainsAny(name, "[]\n") {
+		return nil, nil, 0, syscall.EINVAL
+	}
+	var fetchErr error
+	if _, ok := n.listing(ctx, &fetchErr).find(name); ok {
+		return nil, nil, 0, syscall.EEXIST
+	} else if fetchErr != nil {
+		return nil, nil, 0, syscall.EIO
+	}
+	node := newCreateFile(n.lfs, func(ctx context.Context, content []byte) syscall.Errno {
+		return n.uploadFile(ctx, name, content)
+	})
+	inode := n.NewInode(ctx, node, fs.StableAttr{Mode: syscall.S_IFREG})
+	return inode, &createFileHandle{}, fuse.FOPEN_DIRECT_IO, 0
+}
+
+// uploadFile is the drop-in upload's onFlush: upload, link from the
+// description, and record the embedded file.
+func (n *AttachmentsNode) uploadFile(ctx context.Context, name string, content []byte) syscall.Errno {
+	var fresh *api.Issue
+	_, errno := commitCreate(ctx, n.lfs, createSpec[api.EmbeddedFile]{
+		op:  `upload "` + name + `"`,
+		key: collectionErrorKey("attachments", n.issueID),
+		mutate: func(ctx context.Context) (*api.EmbeddedFile, error) {
+			if len(content) > api.MaxUploadBytes {
+				return nil, &FieldError{Field: "file", Value: name,
+					Message: fmt.Sprintf("%d bytes exceeds the %d-byte upload limit", len(content), api.MaxUploadBytes)}
+			}
+			contentType := uploadContentType(name, content)
+			url, err := n.lfs.mutator().UploadFile(ctx, name, contentType, content)
+			if err != nil {
+				return nil, err
+			}
+			// Re-read the base so the link never clobbers a description
+			// edited elsewhere (the issue.md append path does the same).
+			current, err := n.lfs.verify().GetIssue(ctx, n.issueID)
+			if err != nil {
+				return nil, err
+			}
+			description, _ := marshal.AppendToDescription(current.Description, embedLink(name, contentType, url))
+			if err := n.lfs.mutator().UpdateIssue(ctx, n.issueID, map[string]any{"description": description}); err != nil {
+				return nil, err
+			}
+			// The upload is linked either way; a failed re-read only leaves
+			// the cached description stale until the next sync.
+			if fresh, err = n.lfs.verify().GetIssue(ctx, n.issueID); err != nil {
+				fresh = nil
+			}
+			hash := sha256.Sum256([]byte(url))
+			return &api.EmbeddedFile{
+				ID:       hex.EncodeToString(hash[:16]),
+				IssueID:  n.issueID,
+				URL:      url,
+				Filename: name,
+				MimeType: contentType,
+				FileSize: int64(len(content)),
+				Source:   "description",
+			}, nil
+		},
+		result: func(f *api.EmbeddedFile) WriteResult {
+			return WriteResult{URL: f.URL, Path: f.Filename}
+		},
+		persist: func(ctx context.Context, f *api.EmbeddedFile) error {
+			if fresh != nil {
+				if err := n.lfs.UpsertIssue(ctx, *fresh); err != nil {
+					return err
+				}
+			}
+			now := db.Now()
+			if err := n.lfs.store.Queries().UpsertEmbeddedFile(ctx, db.UpsertEmbeddedFileParams{
+				ID:        f.ID,
+				IssueID:   f.IssueID,
+				Url:       f.URL,
+				Filename:  f.Filename,
+				MimeType:  sql.NullString{String: f.MimeType, Valid: f.MimeType != ""},
+				FileSize:  sql.NullInt64{Int64: f.FileSize, Valid: true},
+				Source:    f.Source,
+				CreatedAt: now,
+				SyncedAt:  now,
+			}); err != nil {
+				return err
+			}
+			// The bytes are already here; reading the file back must not
+			// download what was just uploaded.
+			n.lfs.embeddedFileCache.store(f.ID, content)
+			return nil
+		},
+		dir:       attachmentsDirIno(n.issueID),
+		entryName: func(f *api.EmbeddedFile) string { return f.Filename },
+		invalidateExtra: func(*api.EmbeddedFile) {
+			n.lfs.InvalidateUpdated(issueIno(n.issueID))
+			n.lfs.InvalidateUpdated(metaIno(n.issueID))
+		},
+	})
+	return errno
+}
+
+// uploadContentType names the upload's MIME type: by extension when the name
+// has a known one, else sniffed from the bytes.
+func uploadContentType(name string, content []byte) string {
+	if ct := mime.TypeByExtension(path.Ext(name)); ct != "" {
+		return ct
+	}
+	return http.DetectContentType(content)
+}
+
+// embedLink renders the description line that references an upload: an
+// inline image for images, a plain link otherwise. The link text is the file
+// name, which is what sync lists the file as.
+func embedLink(name, contentType, url string) string {
+	if strings.HasPrefix(contentType, "image/") {
+		return "![" + name + "](" + url + ")"
+	}
+	return "[" + name + "](" + url + ")"
+}
@@ -0,0 +1,106 @@
+package fs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// needsAttentionName is the per-team nudge file in teams/{KEY}/.
+const needsAttentionName = "needs-attention.md"
+
+// Default needs-attention.md thresholds (config `attention:`).
+const (
+	defaultStaleDays       = 7
+	defaultSLAWarningHours = 24
+)
+
+// attentionThresholds resolves the configured thresholds, zero meaning the
+// default.
+func attentionThresholds(cfg config.AttentionConfig) (stale, slaWarning time.Duration) {
+	days, hours := cfg.StaleDays, cfg.SLAWarningHours
+	if days == 0 {
+		days = defaultStaleDays
+	}
+	if hours == 0 {
+		hours = defaultSLAWarningHours
+	}
+	return time.Duration(days) * 24 * time.Hour, time.Duration(hours) * time.Hour
+}
+
+// renderNeedsAttention renders a team's needs-attention.md from its issues as
+// SQLite holds them: open issues whose SLA has breached or breaches within
+// slaWarning, and started issues not updated for stale. An issue in both
+// lists appears under its SLA only. Each list is most urgent first.
+func renderNeedsAttention(team api.Team, issues []api.Issue, stale, slaWarning time.Duration, now time.Time) []byte {
+	var breached, atRisk, stalled []api.Issue
+	for _, issue := range issues {
+		open := issue.State.Type != "completed" && issue.State.Type != "canceled"
+		switch {
+		case open && issue.SLABreachesAt != nil && !issue.SLABreachesAt.After(now):
+			breached = append(breached, issue)
+		case open && issue.SLABreachesAt != nil && issue.SLABreachesAt.Sub(now) <= slaWarning:
+			atRisk = append(atRisk, issue)
+		case issue.State.Type == "started" && now.Sub(issue.UpdatedAt) > stale:
+			stalled = append(stalled, issue)
+		}
+	}
+	bySLA := func(list []api.Issue) {
+		sort.Slice(list, func(i, j int) bool { return list[i].SLABreachesAt.Before(*list[j].SLABreachesAt) })
+	}
+	bySLA(breached)
+	bySLA(atRisk)
+	sort.Slice(stalled, func(i, j int) bool { return stalled[i].UpdatedAt.Before(stalled[j].UpdatedAt) })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s: needs attention\n\n", team.Key)
+	fmt.Fprintf(&b, "Open issues past or within %s of their SLA, and started issues untouched for more than %s.\n",
+		humanDuration(slaWarning), humanDuration(stale))
+	if len(breached)+len(atRisk)+len(stalled) == 0 {
+		b.WriteString("\nNothing needs attention.\n")
+		return []byte(b.String())
+	}
+
+	section := func(title string, list []api.Issue, detail func(api.Issue) string) {
+		if len(list) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", title, len(list))
+		for _, issue := range list {
+			fmt.Fprintf(&b, "- %s %s (%s", issue.Identifier, issue.Title, detail(issue))
+			if issue.Assignee != nil {
+				fmt.Fprintf(&b, ", %s", issue.Assignee.Name)
+			}
+			b.WriteString(")\n")
+		}
+	}
+	section("SLA breached", breached, func(i api.Issue) string {
+		return "breached " + humanDuration(now.Sub(*i.SLABreachesAt)) + " ago"
+	})
+	section("SLA at risk", atRisk, func(i api.Issue) string {
+		return "breaches in " + humanDuration(i.SLABreachesAt.Sub(now))
+	})
+	section("Stale", stalled, func(i api.Issue) string {
+		return i.State.Name + ", untouched " + humanDuration(now.Sub(i.UpdatedAt)) // safename:ok file content (a list line, not a path)
+	})
+	return []byte(b.String())
+}
+
+// humanDuration renders d coarsely: whole days from two days up, whole hours
+// below that, and minutes under an hour.
+func humanDuration(d time.Duration) string {
+	switch {
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%d days", int(d/(24*time.Hour)))
+	case d >= 2*time.Hour:
+		return fmt.Sprintf("%d hours", int(d/time.Hour))
+	case d >= time.Hour:
+		return "1 hour"
+	default:
+		return fmt.Sprintf("%d minutes", int(d/time.Minute))
+	}
+}
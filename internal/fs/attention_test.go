@@ -0,0 +1,61 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+func TestRenderNeedsAttention(t *testing.T) {
+	t.Parallel()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		ts := now.Add(d)
+		return &ts
+	}
+	started := api.State{Name: "In Progress", Type: "started"}
+	issues := []api.Issue{
+		{Identifier: "ENG-1", Title: "Stuck", State: started, UpdatedAt: now.Add(-10 * 24 * time.Hour), Assignee: &api.User{Name: "Ada"}},
+		{Identifier: "ENG-2", Title: "Fresh", State: started, UpdatedAt: now.Add(-time.Hour)},
+		{Identifier: "ENG-3", Title: "Breached", State: api.State{Name: "Todo", Type: "unstarted"}, SLABreachesAt: at(-3 * time.Hour), UpdatedAt: now},
+		{Identifier: "ENG-4", Title: "At risk", State: started, SLABreachesAt: at(5 * time.Hour), UpdatedAt: now.Add(-30 * 24 * time.Hour)},
+		{Identifier: "ENG-5", Title: "Far off", State: started, SLABreachesAt: at(72 * time.Hour), UpdatedAt: now},
+		{Identifier: "ENG-6", Title: "Done late", State: api.State{Name: "Done", Type: "completed"}, SLABreachesAt: at(-time.Hour), UpdatedAt: now.Add(-30 * 24 * time.Hour)},
+		{Identifier: "ENG-7", Title: "Old backlog", State: api.State{Name: "Backlog", Type: "backlog"}, UpdatedAt: now.Add(-90 * 24 * time.Hour)},
+	}
+
+	stale, slaWarning := attentionThresholds(config.AttentionConfig{})
+	got := string(renderNeedsAttention(team, issues, stale, slaWarning, now))
+	for _, want := range []string{
+		"# ENG: needs attention",
+		"within 24 hours of their SLA, and started issues untouched for more than 7 days.",
+		"## SLA breached (1)\n\n- ENG-3 Breached (breached 3 hours ago)\n",
+		"## SLA at risk (1)\n\n- ENG-4 At risk (breaches in 5 hours)\n",
+		"## Stale (1)\n\n- ENG-1 Stuck (In Progress, untouched 10 days, Ada)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("needs-attention.md missing %q:\n%s", want, got)
+		}
+	}
+	for _, absent := range []string{"ENG-2", "ENG-5", "ENG-6", "ENG-7"} {
+		if strings.Contains(got, absent) {
+			t.Errorf("needs-attention.md lists %s:\n%s", absent, got)
+		}
+	}
+
+	// Thresholds are configurable: a 4-day SLA window takes ENG-5, and an
+	// hour-old ENG-2 is still fresh under a 1-day staleness window.
+	stale, slaWarning = attentionThresholds(config.AttentionConfig{StaleDays: 1, SLAWarningHours: 96})
+	got = string(renderNeedsAttention(team, issues, stale, slaWarning, now))
+	if !strings.Contains(got, "ENG-5 Far off (breaches in 3 days)") || strings.Contains(got, "ENG-2") {
+		t.Errorf("custom thresholds:\n%s", got)
+	}
+
+	if got := string(renderNeedsAttention(team, nil, stale, slaWarning, now)); !strings.Contains(got, "Nothing needs attention.") {
+		t.Errorf("empty team:\n%s", got)
+	}
+}
@@ -0,0 +1,66 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// blockedViewName is the by/ entry listing the team's blocked issues. Unlike
+// the category directories it has no value level: by/blocked/ holds the issue
+// symlinks directly.
+const blockedViewName = "blocked"
+
+// BlockedNode is teams/{KEY}/by/blocked/: the team's open issues that an open
+// issue blocks (per the synced "blocks" relations), as symlinks — the
+// complement a standup skips to find workable issues. It is the listing twin
+// of issue.meta's `blocked: true`. Membership turns on other issues' states (a
+// blocker completing frees its dependents), so no single issue write can diff
+// it; like remote changes, the listing is entry-timeout bounded.
+type BlockedNode struct {
+	attrNode
+	entityCell[api.Team]
+}
+
+var _ fs.NodeReaddirer = (*BlockedNode)(nil)
+var _ fs.NodeLookuper = (*BlockedNode)(nil)
+var _ fs.NodeGetattrer = (*BlockedNode)(nil)
+
+// entity()/setEntity() are promoted from the embedded entityCell[api.Team].
+// refreshFrom is the nodeRefresher seam (refresh.go).
+func (n *BlockedNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*BlockedNode); ok {
+		n.setEntity(f.entity())
+	}
+}
+
+func (n *BlockedNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.lfs.repo.GetBlockedIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(issues))
+	for i, issue := range issues {
+		entries[i] = fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *BlockedNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.lfs.repo.GetBlockedIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			// From by/blocked/ go up 2 levels to the team dir, then into issues/.
+			target := fmt.Sprintf("../../issues/%s", safeName(issue.Identifier, issue.ID))
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
@@ -0,0 +1,71 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+
+	dbfixtures "github.com/jra3/linear-fuse/internal/db/fixtures"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// childrenTestNode seeds the fixture team with TST-1 and returns TST-1's
+// children/ node over linkTestLFS's succeeding mock mutator.
+func childrenTestNode(t *testing.T) (*ChildrenNode, *LinearFS) {
+	t.Helper()
+	lfs, store := linkTestLFS(t)
+	parent := fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-1", "TST-1"))
+	if err := dbfixtures.SeedTeam(context.Background(), store, fixtures.FixtureAPITeam(), parent); err != nil {
+		t.Fatalf("SeedTeam: %v", err)
+	}
+	return &ChildrenNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issue: parent}, lfs
+}
+
+// TestChildrenCreateSpec: a full spec written to children/_create files a
+// sub-issue of the directory's issue, with the spec's fields applied and the
+// parent preset — it lands in children/ and reports to the parent's .last.
+func TestChildrenCreateSpec(t *testing.T) {
+	n, lfs := childrenTestNode(t)
+	ctx := context.Background()
+
+	spec := "---\ntitle: Split out the parser\npriority: high\n---\nPer the parent's plan.\n"
+	if errno := n.createChild(ctx, []byte(spec)); errno != 0 {
+		t.Fatalf("createChild: errno = %v (.error: %+v)", errno, lfs.GetWriteError(n.issue.ID))
+	}
+
+	children, err := lfs.repo.GetIssueChildren(ctx, "issue-1")
+	if err != nil || len(children) != 1 {
+		t.Fatalf("GetIssueChildren = %+v, %v; want one child", children, err)
+	}
+	child := children[0]
+	if child.Title != "Split out the parser" || child.Priority != 2 || child.Description != "Per the parent's plan.\n" {
+		t.Errorf("child = title %q priority %d description %q", child.Title, child.Priority, child.Description)
+	}
+	if got := lfs.GetWriteSuccess(n.issue.ID); len(got) != 1 {
+		t.Errorf("parent .last = %+v, want the created sub-issue", got)
+	}
+}
+
+// TestChildrenCreateSpecParentConflict: a spec naming another parent is
+// refused (EINVAL with a parent .error) rather than silently re-parented,
+// while naming the directory's own issue is accepted.
+func TestChildrenCreateSpecParentConflict(t *testing.T) {
+	n, lfs := childrenTestNode(t)
+	ctx := context.Background()
+
+	if errno := n.createChild(ctx, []byte("---\ntitle: Elsewhere\nparent: TST-9\n---\n")); errno != syscall.EINVAL {
+		t.Fatalf("conflicting parent: errno = %v, want EINVAL", errno)
+	}
+	if e := lfs.GetWriteError(n.issue.ID); e == nil || !strings.Contains(e.Message, "TST-9") {
+		t.Errorf(".error = %+v, want a parent field error", e)
+	}
+
+	if errno := n.createChild(ctx, []byte("---\ntitle: Here\nparent: TST-1\n---\n")); errno != 0 {
+		t.Fatalf("own parent: errno = %v", errno)
+	}
+	children, _ := lfs.repo.GetIssueChildren(ctx, "issue-1")
+	if len(children) != 1 || children[0].Title != "Here" {
+		t.Errorf("children = %+v, want only Here", children)
+	}
+}
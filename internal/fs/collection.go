@@ -63,16 +63,7 @@ func (lfs *LinearFS) lookupCollectionTrio(ctx context.Context, parent fs.InodeEm
 		if t.onFlush == nil {
 			return nil, false
 		}
-		now := time.Now()
-		node := newCreateFile(lfs, t.onFlush)
-		out.Attr.Mode = 0200 | syscall.S_IFREG
-		out.Attr.Uid = lfs.uid
-		out.Attr.Gid = lfs.gid
-		out.Attr.Size = 0
-		out.Attr.SetTimes(&now, &now, &now)
-		out.SetAttrTimeout(1 * time.Second)
-		out.SetEntryTimeout(1 * time.Second)
-		return parent.EmbeddedInode().NewInode(ctx, node, fs.StableAttr{Mode: syscall.S_IFREG}), true
+		return lfs.lookupTriggerFile(ctx, parent, t.onFlush, out), true
 	case ".error":
 		return lfs.lookupErrorFile(ctx, parent, collectionErrorKey(t.kind, t.parentID), out), true
 	case ".last":
@@ -80,3 +71,18 @@ func (lfs *LinearFS) lookupCollectionTrio(ctx context.Context, parent fs.InodeEm
 	}
 	return nil, false
 }
+
+// lookupTriggerFile mounts a write-only trigger file (_create, or a surface's
+// extra trigger such as issues/_clone) whose every write cycle runs onFlush.
+func (lfs *LinearFS) lookupTriggerFile(ctx context.Context, parent fs.InodeEmbedder, onFlush func(ctx context.Context, content []byte) syscall.Errno, out *fuse.EntryOut) *fs.Inode {
+	now := time.Now()
+	node := newCreateFile(lfs, onFlush)
+	out.Attr.Mode = 0200 | syscall.S_IFREG
+	out.Attr.Uid = lfs.uid
+	out.Attr.Gid = lfs.gid
+	out.Attr.Size = 0
+	out.Attr.SetTimes(&now, &now, &now)
+	out.SetAttrTimeout(1 * time.Second)
+	out.SetEntryTimeout(1 * time.Second)
+	return parent.EmbeddedInode().NewInode(ctx, node, fs.StableAttr{Mode: syscall.S_IFREG})
+}
@@ -67,15 +67,31 @@ type collectionDir[T any] struct {
 	metaTimes   func(T) (mtime, ctime time.Time)
 	metaIno     func(T) uint64
 
+	// fullView, when set, reports whether an item's file shows its body cut
+	// (truncatedview.go). Such an item also lists a read-only "{base}.full.md"
+	// sibling: fullMarshal renders it whole, fullIno is its stable inode, and
+	// metaTimes gives its times. nil for collections that never cut a body.
+	fullView    func(T) bool
+	fullMarshal func(ctx context.Context, item *T) []byte
+	fullIno     func(T) uint64
+
+	// backlinksMarshal, when set, gives every item a read-only
+	// "{base}.backlinks.md" sibling listing what mentions it; backlinksIno is
+	// its stable inode, and metaTimes gives its times. nil for collections
+	// nothing links to by URL.
+	backlinksMarshal func(ctx context.Context, item *T) []byte
+	backlinksIno     func(T) uint64
+
 	// deleteMutate archives/deletes via the API; deleteForget removes the row
-	// from SQLite (the listing source of truth). See deleteSpec.
+	// from SQLite (the listing source of truth; comments tombstone it instead).
+	// See deleteSpec.
 	deleteMutate func(ctx context.Context, target *T) error
 	deleteForget func(ctx context.Context, target *T) error
 }
 
 // collectionListing is the naming round-trip seam collectionDir needs: derive
 // the directory entries and resolve one name back to its item. indexedListing
-// (creation-ordered %04d-date names) and namedListing (title-derived names)
+// (creation-ordered %04d-date names) and namedListing (entity-derived names)
 // both satisfy it structurally.
 type collectionListing[T any] interface {
 	entries() []fuse.DirEntry
@@ -97,11 +113,26 @@ func (c collectionDir[T]) readdir(ctx context.Context) (fs.DirStream, syscall.Er
 }
 
 // entries assembles the full directory listing: trio, then item .md files, then
-// their .meta sidecars. Pure — the Readdir assembly under test without a mount.
+// their .meta sidecars, then the .full.md siblings of items shown cut, then
+// the .backlinks.md siblings. Pure —
+// the Readdir assembly under test without a mount.
 func (c collectionDir[T]) entries(items []T) []fuse.DirEntry {
-	files := c.listing(items).entries()
+	listing := c.listing(items)
+	files := listing.entries()
 	out := append(c.trio.entries(), files...)
 	out = append(out, metaSidecarEntries(files)...)
+	if c.fullView != nil {
+		for _, f := range files {
+			if item, ok := listing.find(f.Name); ok && c.fullView(item) {
+				out = append(out, fuse.DirEntry{Name: fullSiblingName(f.Name), Mode: syscall.S_IFREG})
+			}
+		}
+	}
+	if c.backlinksMarshal != nil {
+		for _, f := range files {
+			out = append(out, fuse.DirEntry{Name: backlinksSiblingName(f.Name), Mode: syscall.S_IFREG})
+		}
+	}
 	return out
 }
 
@@ -109,9 +140,11 @@ func (c collectionDir[T]) entries(items []T) []fuse.DirEntry {
 type lookupKind int
 
 const (
-	lookupNotFound lookupKind = iota
-	lookupMeta                // "{base}.meta" — the read-only sidecar
-	lookupFile                // "{base}.md" — the read/write item file
+	lookupNotFound  lookupKind = iota
+	lookupMeta                 // "{base}.meta" — the read-only sidecar
+	lookupFile                 // "{base}.md" — the read/write item file
+	lookupFull                 // "{base}.full.md" — the whole body of a cut item
+	lookupBacklinks            // "{base}.backlinks.md" — what mentions the item
 )
 
 // lookupResult is classify's verdict: the kind and, for a hit, the item.
@@ -121,9 +154,20 @@ type lookupResult[T any] struct {
 }
 
 // classify resolves a name (already known not to be a trio surface) to an
-// action: a .meta sidecar, an item .md, or ENOENT. Pure — the branchy part
-// (meta shadowing, find-or-miss) under test without a mount.
+// action: a .meta sidecar, a .full.md or .backlinks.md sibling, an item .md,
+// or ENOENT. Pure —
+// the branchy part (meta shadowing, find-or-miss) under test without a mount.
 func (c collectionDir[T]) classify(name string, items []T) lookupResult[T] {
+	if mdName, ok := fullSiblingSource(name); ok && c.fullView != nil {
+		if item, found := c.resolveItem(mdName, items); found && c.fullView(item) {
+			return lookupResult[T]{kind: lookupFull, item: item}
+		}
+	}
+	if mdName, ok := backlinksSiblingSource(name); ok && c.backlinksMarshal != nil {
+		if item, found := c.resolveItem(mdName, items); found {
+			return lookupResult[T]{kind: lookupBacklinks, item: item}
+		}
+	}
 	if mdName, ok := metaSidecarSource(name); ok {
 		if item, found := c.resolveItem(mdName, items); found {
 			return lookupResult[T]{kind: lookupMeta, item: item}
@@ -177,6 +221,10 @@ func (c collectionDir[T]) lookup(ctx context.Context, name string, out *fuse.Ent
 	switch res.kind {
 	case lookupMeta:
 		return c.lfs.mountRenderFile(ctx, c.parent, name, c.metaRender(res.item), c.metaIno(res.item), 0, out), 0
+	case lookupFull:
+		return c.lfs.mountRenderFile(ctx, c.parent, name, c.fullRender(res.item), c.fullIno(res.item), 0, out), 0
+	case lookupBacklinks:
+		return c.lfs.mountRenderFile(ctx, c.parent, name, c.siblingRender(res.item, c.backlinksMarshal), c.backlinksIno(res.item), 0, out), 0
 	case lookupFile:
 		return c.buildFile(ctx, name, res.item, out)
 	default:
@@ -203,6 +251,41 @@ func (c collectionDir[T]) metaRender(item T) renderFunc {
 	}
 }
 
+// fullRender builds a .full.md sibling's render closure.
+func (c collectionDir[T]) fullRender(item T) renderFunc {
+	return c.siblingRender(item, c.fullMarshal)
+}
+
+// siblingRender builds a generated sibling's render closure, re-deriving the
+// freshest item on every read as metaRender does.
+func (c collectionDir[T]) siblingRender(item T, render func(context.Context, *T) []byte) renderFunc {
+	id := c.idOf(item)
+	return func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		cur := item
+		if items, err := c.fetch(ctx); err == nil {
+			cur = freshestByID(items, id, c.idOf, item)
+		}
+		mtime, ctime := c.metaTimes(cur)
+		return render(ctx, &cur), mtime, ctime
+	}
+}
+
+// backlinksSiblingName maps an item file to its backlinks sibling: "X.md" ->
+// "X.backlinks.md".
+func backlinksSiblingName(mdName string) string {
+	return strings.TrimSuffix(mdName, ".md") + ".backlinks.md"
+}
+
+// backlinksSiblingSource maps a possible backlinks sibling back to its item
+// file: "X.backlinks.md" -> ("X.md", true). Any other name is a miss.
+func backlinksSiblingSource(name string) (string, bool) {
+	base, ok := strings.CutSuffix(name, ".backlinks.md")
+	if !ok || base == "" {
+		return "", false
+	}
+	return base + ".md", true
+}
+
 // create binds a new item file. onFlush is the create trigger for this name
 // (the trio's onFlush, or a name-bound variant where the filename seeds the
 // title, as docs does). Returns the FUSE Create quad.
@@ -210,10 +293,9 @@ func (c collectionDir[T]) metaRender(item T) renderFunc {
 // Overwrite in place: a name that already resolves to an item — a save-over of
 // an existing .md (mv/cp/editor) — returns that item's read/write node so the
 // write updates it through the normal truncate+flush path, rather than binding
-// a write-only _create node to the name and corrupting it (#137). Harmless for
-// an indexed collection (comments): Create only fires for a name Lookup missed,
-// and a user-chosen name never matches an index-derived filename, so find()
-// misses and falls through to the create node.
+// a write-only _create node to the name and corrupting it (#137). For comments
+// a user-chosen name practically never matches a <date-time>-<short id> name,
+// so find() misses and falls through to the create node.
 func (c collectionDir[T]) create(ctx context.Context, name string, flags uint32, out *fuse.EntryOut, onFlush func(ctx context.Context, content []byte) syscall.Errno) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
 	if !strings.HasSuffix(name, ".md") {
 		return nil, nil, 0, syscall.EINVAL
@@ -256,6 +338,12 @@ func (c collectionDir[T]) unlink(ctx context.Context, name string) syscall.Errno
 	if _, isMeta := metaSidecarSource(name); isMeta {
 		return syscall.EPERM
 	}
+	if _, isFull := fullSiblingSource(name); isFull && c.fullView != nil {
+		return syscall.EPERM
+	}
+	if _, isBacklinks := backlinksSiblingSource(name); isBacklinks && c.backlinksMarshal != nil {
+		return syscall.EPERM
+	}
 
 	// The node is mounted at its collection's dir inode (xDirIno(parentID)); use
 	// the live inode the kernel actually knows for the coherence notify.
@@ -272,6 +360,12 @@ func (c collectionDir[T]) unlink(ctx context.Context, name string) syscall.Errno
 		// The .meta sidecar renders from the deleted entity: drop its entry too.
 		invalidateExtra: func(*T) {
 			c.lfs.InvalidateDeleted(dir, metaSidecarName(name))
+			if c.fullView != nil {
+				c.lfs.InvalidateDeleted(dir, fullSiblingName(name))
+			}
+			if c.backlinksMarshal != nil {
+				c.lfs.InvalidateDeleted(dir, backlinksSiblingName(name))
+			}
 		},
 	})
 }
@@ -99,6 +99,48 @@ func TestCollectionDirClassify(t *testing.T) {
 	}
 }
 
+// TestCollectionDirFullSiblings: with fullView set, an item shown cut lists
+// and resolves a "{base}.full.md" sibling; an item shown whole has none.
+func TestCollectionDirFullSiblings(t *testing.T) {
+	t.Parallel()
+	cd := testCollectionDir()
+	cd.fullView = func(s string) bool { return s == "big" }
+	items := []string{"big", "small"}
+
+	got := entryNameSet(cd.entries(items))
+	if !got["big.full.md"] || got["small.full.md"] {
+		t.Errorf("entries = %v, want big.full.md only", got)
+	}
+	if res := cd.classify("big.full.md", items); res.kind != lookupFull || res.item != "big" {
+		t.Errorf("classify(big.full.md) = %v %q, want the full sibling of big", res.kind, res.item)
+	}
+	if res := cd.classify("small.full.md", items); res.kind != lookupNotFound {
+		t.Errorf("classify(small.full.md) kind = %v, want not found", res.kind)
+	}
+}
+
+// TestCollectionDirBacklinksSiblings: with backlinksMarshal set, every item
+// lists and resolves a "{base}.backlinks.md" sibling.
+func TestCollectionDirBacklinksSiblings(t *testing.T) {
+	t.Parallel()
+	cd := testCollectionDir()
+	items := []string{"a"}
+	if got := entryNameSet(cd.entries(items)); got["a.backlinks.md"] {
+		t.Errorf("entries without backlinksMarshal = %v, want no backlinks sibling", got)
+	}
+
+	cd.backlinksMarshal = func(context.Context, *string) []byte { return nil }
+	if got := entryNameSet(cd.entries(items)); !got["a.backlinks.md"] {
+		t.Errorf("entries = %v, want a.backlinks.md", got)
+	}
+	if res := cd.classify("a.backlinks.md", items); res.kind != lookupBacklinks || res.item != "a" {
+		t.Errorf("classify(a.backlinks.md) = %v %q, want the backlinks sibling of a", res.kind, res.item)
+	}
+	if res := cd.classify("z.backlinks.md", items); res.kind != lookupNotFound {
+		t.Errorf("classify(z.backlinks.md) kind = %v, want not found", res.kind)
+	}
+}
+
 // TestCollectionDirResolve pins the shared ctx-ful find that Unlink and both
 // Rename specs delegate to: a hit returns the item, a clean miss is (nil, nil)
 // (the contract commitDelete/commitRename expect), and a fetch failure
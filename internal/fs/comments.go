@@ -2,7 +2,7 @@ package fs
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 	"log"
 	"strings"
 	"syscall"
@@ -11,6 +11,7 @@ import (
 	"github.com/hanwen/go-fuse/v2/fs"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
 	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
@@ -19,6 +20,7 @@ type CommentsNode struct {
 	attrNode
 	issueID string
 	teamID  string
+	teamKey string // the team directory above, for cross-linked bodies
 }
 
 var _ fs.NodeReaddirer = (*CommentsNode)(nil)
@@ -34,50 +36,90 @@ func (n *CommentsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno
 // collection is the item-file surface (Readdir/Lookup/Unlink) for comments/.
 func (n *CommentsNode) collection() collectionDir[api.Comment] {
 	return collectionDir[api.Comment]{
-		parent:       n,
-		lfs:          n.lfs,
-		trio:         n.trio(),
-		noun:         "comment",
-		refresh:      func(ctx context.Context) { n.lfs.repo.MaybeRefreshIssueDetails(n.issueID) },
-		fetch:        func(ctx context.Context) ([]api.Comment, error) { return n.lfs.repo.GetIssueComments(ctx, n.issueID) },
+		parent:  n,
+		lfs:     n.lfs,
+		trio:    n.trio(),
+		noun:    "comment",
+		refresh: func(ctx context.Context) { n.lfs.repo.MaybeRefreshIssueDetails(n.issueID) },
+		fetch: func(ctx context.Context) ([]api.Comment, error) {
+			return n.lfs.repo.GetIssueCommentsWithDeleted(ctx, n.issueID)
+		},
 		listing:      func(items []api.Comment) collectionListing[api.Comment] { return n.listing(items) },
 		idOf:         func(c api.Comment) string { return c.ID },
 		buildFile:    n.buildComment,
 		metaMarshal:  marshal.CommentMetaToMarkdown,
 		metaTimes:    func(c api.Comment) (time.Time, time.Time) { return c.UpdatedAt, c.CreatedAt },
 		metaIno:      func(c api.Comment) uint64 { return commentMetaIno(c.ID) },
+		fullView:     func(c api.Comment) bool { return c.DeletedAt == nil && len(c.Body) > marshal.BodyViewLimit },
+		fullMarshal:  n.renderCommentFull,
+		fullIno:      func(c api.Comment) uint64 { return commentFullIno(c.ID) },
 		deleteMutate: func(ctx context.Context, c *api.Comment) error { return n.lfs.mutator().DeleteComment(ctx, c.ID) },
-		deleteForget: func(ctx context.Context, c *api.Comment) error { return n.lfs.store.Queries().DeleteComment(ctx, c.ID) },
+		deleteForget: n.tombstoneComment,
 	}
 }
 
+// tombstoneComment is the delete's SQLite forget: stamp deleted_at rather than
+// drop the row, exactly as the details sync does for a comment deleted in
+// Linear, so display.show_deleted_comments can still list it.
+func (n *CommentsNode) tombstoneComment(ctx context.Context, c *api.Comment) error {
+	return n.lfs.store.Queries().TombstoneComment(ctx, db.TombstoneCommentParams{
+		DeletedAt: sql.NullTime{Time: db.Now(), Valid: true},
+		ID:        c.ID,
+	})
+}
+
 // trio declares the comments collection's writable surfaces.
 func (n *CommentsNode) trio() collectionTrio {
 	return collectionTrio{kind: "comments", parentID: n.issueID, onFlush: n.createComment}
 }
 
-// listing declares how comment files are named — <NNNN>-<date-time>.md by
-// creation order — so Readdir, Lookup, and Unlink derive identical names.
-func (n *CommentsNode) listing(comments []api.Comment) indexedListing[api.Comment] {
-	return indexedListing[api.Comment]{
-		items:   comments,
-		lessKey: func(c api.Comment) time.Time { return c.CreatedAt },
-		nameOf: func(i int, c api.Comment) string {
-			return fmt.Sprintf("%04d-%s.md", i+1, c.CreatedAt.Format("2006-01-02T15-04"))
-		},
+// listing declares how comment files are named (commentFilename) so Readdir,
+// Lookup, and Unlink derive identical names. The repo lists comments by
+// creation time, then ID, so comments sharing a minute keep their places while
+// sync writes new ones. Tombstones (deleted
+// comments) are listed only under display.show_deleted_comments.
+func (n *CommentsNode) listing(comments []api.Comment) namedListing[api.Comment] {
+	if !n.lfs.tombstones {
+		live := make([]api.Comment, 0, len(comments))
+		for _, c := range comments {
+			if c.DeletedAt == nil {
+				live = append(live, c)
+			}
+		}
+		comments = live
 	}
+	return namedListing[api.Comment]{items: comments, nameOf: commentFilename}
+}
+
+// commentFilename names a comment file <date-time>-<short id>.md. Both parts
+// are fixed at creation, so a name never changes while the comment exists —
+// unlike the positional <NNNN>- names this replaced, which shifted whenever an
+// earlier comment was deleted. The date-time prefix keeps `ls` chronological.
+func commentFilename(c api.Comment) string {
+	return c.CreatedAt.Format("2006-01-02T15-04") + "-" + marshal.CommentShortID(c.ID) + ".md"
 }
 
 func (n *CommentsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
 	return n.collection().lookup(ctx, name, out)
 }
 
-// buildComment mounts the read/write CommentNode for an existing comment.
+// buildComment mounts the read/write CommentNode for an existing comment, or
+// the read-only struck-through render of a tombstone. The tombstone takes its
+// own inode: the comment's live CommentNode may still be known to the kernel.
 func (n *CommentsNode) buildComment(ctx context.Context, name string, comment api.Comment, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
-	content := marshal.CommentToMarkdown(&comment)
+	if comment.DeletedAt != nil {
+		content := marshal.CommentTombstoneToMarkdown(&comment)
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			return content, *comment.DeletedAt, comment.CreatedAt
+		}, commentTombstoneIno(comment.ID), 0), 0
+	}
+	linked := comment
+	linked.Body = bodyView(n.lfs.crossLinker(ctx, n.teamKey, n.issueID, 2), comment.Body, fullSiblingName(name))
+	content := marshal.CommentToMarkdown(&linked)
 	node := &CommentNode{
 		BaseNode:   BaseNode{lfs: n.lfs},
 		issueID:    n.issueID,
+		teamKey:    n.teamKey,
 		comment:    comment,
 		editBuffer: editBuffer{content: content},
 	}
@@ -85,7 +127,19 @@ func (n *CommentsNode) buildComment(ctx context.Context, name string, comment ap
 	return n.newFileInode(ctx, out, name, node, fileAttr(len(content), comment.CreatedAt, comment.UpdatedAt), commentIno(comment.ID), 5*time.Second), 0
 }
 
+// renderCommentFull renders a cut comment's .full.md sibling: the comment
+// file with the whole body.
+func (n *CommentsNode) renderCommentFull(ctx context.Context, comment *api.Comment) []byte {
+	linked := *comment
+	linked.Body = n.lfs.crossLinker(ctx, n.teamKey, n.issueID, 2).Link(comment.Body)
+	return marshal.CommentToMarkdown(&linked)
+}
+
 func (n *CommentsNode) Unlink(ctx context.Context, name string) syscall.Errno {
+	// A tombstone is already deleted; there is nothing left to delete.
+	if c, err := n.collection().resolve(ctx, name); err == nil && c != nil && c.DeletedAt != nil {
+		return syscall.EPERM
+	}
 	return n.collection().unlink(ctx, name)
 }
 
@@ -98,6 +152,7 @@ type CommentNode struct {
 	BaseNode
 	editBuffer
 	issueID string
+	teamKey string
 	comment api.Comment
 }
 
@@ -124,7 +179,7 @@ func (n *CommentNode) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.At
 // edit is in flight — the dirty buffer always wins (refresh.go).
 func (n *CommentNode) refreshFrom(fresh fs.InodeEmbedder) {
 	if f, ok := fresh.(*CommentNode); ok {
-		n.refresh(f.content, func() { n.comment, n.issueID = f.comment, f.issueID })
+		n.refresh(f.content, func() { n.comment, n.issueID, n.teamKey = f.comment, f.issueID, f.teamKey })
 	}
 }
 
@@ -136,8 +191,11 @@ func (n *CommentNode) Flush(ctx context.Context, f fs.FileHandle) syscall.Errno
 	var updatedComment *api.Comment
 	return editFlush(ctx, n.lfs, &n.editBuffer, editFlushSpec[api.Comment]{
 		mutate: func(ctx context.Context) (bool, syscall.Errno) {
-			// Extract body from the markdown (skip frontmatter).
-			body = extractCommentBody(n.content)
+			// Extract body from the markdown (skip frontmatter), cross-links
+			// turned back into the references they render.
+			// A body shown cut gets its hidden remainder back (truncatedview.go).
+			body = n.lfs.crossLinker(ctx, n.teamKey, n.issueID, 2).Unlink(extractCommentBody(n.content))
+			body = marshal.RestoreTruncatedBody(body, n.comment.Body)
 			if body == "" {
 				if n.lfs.debug {
 					log.Printf("Flush comment %s: empty body, skipping", n.comment.ID)
@@ -208,26 +266,42 @@ func (n *CommentsNode) createComment(ctx context.Context, content []byte) syscal
 	if body == "" {
 		return 0
 	}
+	return n.lfs.postComment(ctx, n.issueID, body, collectionErrorKey("comments", n.issueID))
+}
 
-	_, errno := commitCreate(ctx, n.lfs, createSpec[api.Comment]{
+// postComment runs the comment create tail for an issue, reporting to the
+// .error/.last pair under key: comments/ for a direct create, drafts/ for a
+// published draft.
+func (lfs *LinearFS) postComment(ctx context.Context, issueID, body, key string) syscall.Errno {
+	_, errno := commitCreate(ctx, lfs, createSpec[api.Comment]{
 		op:  "create comment",
-		key: collectionErrorKey("comments", n.issueID),
+		key: key,
 		mutate: func(ctx context.Context) (*api.Comment, error) {
-			return n.lfs.mutator().CreateComment(ctx, n.issueID, body)
+			// Offline (or behind the issue's queued writes) the comment is
+			// queued and reported under its pending ID (offline.go).
+			if !lfs.mustQueue(ctx, issueID) {
+				comment, err := lfs.mutator().CreateComment(ctx, issueID, body)
+				if !api.IsUnreachable(err) {
+					return comment, err
+				}
+			}
+			return lfs.queueComment(ctx, issueID, body)
 		},
-		// Comments are addressed by an index-derived filename (not knowable
-		// without re-listing), so .last reports the comment id + a body
-		// snippet as the handle, and entryName stays unknowable.
+		// A comment has no identifier, so .last reports the comment id, its
+		// comments/ filename, and a body snippet as the handle.
 		result: func(c *api.Comment) WriteResult {
 			return WriteResult{
 				Identifier: c.ID,
+				URL:        c.URL,
+				Path:       commentFilename(*c),
 				Title:      firstLine(c.Body),
 			}
 		},
 		persist: func(ctx context.Context, c *api.Comment) error {
-			return n.lfs.UpsertComment(ctx, n.issueID, *c)
+			return lfs.UpsertComment(ctx, issueID, *c)
 		},
-		dir: commentsDirIno(n.issueID),
+		dir:       commentsDirIno(issueID),
+		entryName: func(c *api.Comment) string { return commentFilename(*c) },
 	})
 	return errno
 }
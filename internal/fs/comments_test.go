@@ -1,6 +1,9 @@
 package fs
 
 import (
+	"context"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -112,3 +115,119 @@ func TestCommentRenderExtractRoundTrip(t *testing.T) {
 		t.Errorf("Old-format extract = %q, want %q", got, originalBody)
 	}
 }
+
+// TestCommentFilename pins the stable comment name: creation minute plus the
+// UUID's first group (Linear's permalink anchor), whole IDs otherwise.
+func TestCommentFilename(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2025, 1, 10, 14, 30, 59, 0, time.UTC)
+	tests := []struct {
+		id, want string
+	}{
+		{"1a2b3c4d-0000-4000-8000-000000000000", "2025-01-10T14-30-1a2b3c4d.md"},
+		{"comment-12", "2025-01-10T14-30-comment-12.md"}, // not a UUID: whole
+		{"1A2B3C4D-0000-4000-8000-000000000000", "2025-01-10T14-30-1A2B3C4D-0000-4000-8000-000000000000.md"},
+	}
+	for _, tt := range tests {
+		if got := commentFilename(api.Comment{ID: tt.id, CreatedAt: at}); got != tt.want {
+			t.Errorf("commentFilename(%q) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+}
+
+// TestCommentsListingTombstones: a comment's name does not depend on its
+// neighbours, so deleting one never renames another; display.
+// show_deleted_comments only decides whether a tombstone is listed.
+func TestCommentsListingTombstones(t *testing.T) {
+	t.Parallel()
+	base := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	deleted := base.Add(24 * time.Hour)
+	comments := []api.Comment{
+		{ID: "aaaaaaaa-1", CreatedAt: base},
+		{ID: "bbbbbbbb-2", CreatedAt: base.Add(time.Hour), DeletedAt: &deleted},
+		{ID: "cccccccc-3", CreatedAt: base.Add(2 * time.Hour)},
+	}
+
+	for _, show := range []bool{false, true} {
+		n := &CommentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: &LinearFS{tombstones: show}}}}
+		l := n.listing(comments)
+		var names []string
+		for _, e := range l.entries() {
+			names = append(names, e.Name)
+		}
+		want := []string{"2025-01-15T10-00-aaaaaaaa.md", "2025-01-15T12-00-cccccccc.md"}
+		if show {
+			want = []string{"2025-01-15T10-00-aaaaaaaa.md", "2025-01-15T11-00-bbbbbbbb.md", "2025-01-15T12-00-cccccccc.md"}
+		}
+		if strings.Join(names, ",") != strings.Join(want, ",") {
+			t.Errorf("show=%v: entries = %v, want %v", show, names, want)
+		}
+		if got, ok := l.find("2025-01-15T12-00-cccccccc.md"); !ok || got.ID != "cccccccc-3" {
+			t.Errorf("show=%v: find = %q, want cccccccc-3", show, got.ID)
+		}
+	}
+}
+
+// TestCommentsListingOrderUnderSync: comments/ lists in (createdAt, id) order
+// whatever order sync writes the rows in, so a listing taken while a sync is
+// upserting comments is always a consistent slice of the final one — never a
+// reshuffle. Comments sharing a timestamp (a bulk import) are written in
+// reverse id order, which a creation-time-only sort would list as written.
+func TestCommentsListingOrderUnderSync(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	ctx := context.Background()
+
+	base := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	var comments []api.Comment
+	for i := 0; i < 40; i++ {
+		comments = append(comments, api.Comment{
+			ID:        fmt.Sprintf("%08x-%d", 0xa0000000+i, i),
+			Body:      fmt.Sprintf("comment %d", i),
+			CreatedAt: base.Add(time.Duration(i/10) * time.Minute), // ten per timestamp
+			UpdatedAt: base,
+		})
+	}
+	rank := make(map[string]int, len(comments))
+	for i, c := range comments {
+		rank[commentFilename(c)] = i
+	}
+
+	n := &CommentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issueID: "issue-1"}
+	listed := func() []string {
+		var names []string
+		for _, name := range readdirNames(t, n) {
+			if _, ok := rank[name]; ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	inOrder := func(names []string) bool {
+		return slices.IsSortedFunc(names, func(a, b string) int { return rank[a] - rank[b] })
+	}
+
+	synced := make(chan struct{})
+	go func() {
+		defer close(synced)
+		for i := len(comments) - 1; i >= 0; i-- {
+			if err := lfs.UpsertComment(ctx, "issue-1", comments[i]); err != nil {
+				t.Errorf("UpsertComment: %v", err)
+				return
+			}
+		}
+	}()
+	for running := true; running; {
+		select {
+		case <-synced:
+			running = false
+		default:
+		}
+		if names := listed(); !inOrder(names) {
+			t.Fatalf("listing during sync out of (createdAt, id) order: %v", names)
+		}
+	}
+	if names := listed(); !inOrder(names) || len(names) != len(comments) {
+		t.Fatalf("listing after sync = %v, want all %d in (createdAt, id) order", names, len(comments))
+	}
+}
@@ -0,0 +1,163 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/webhook"
+)
+
+// controlDirName is the root directory of mount-level data files meant for
+// tools rather than people (editor plugins, renderers). Hidden, like .events.
+const controlDirName = ".linearfs"
+
+const emojiMapName = "emoji.json"
+
+// ControlDirNode is /.linearfs/: emoji.json, the pending/ write queue, the
+// runtime controls (controlfiles.go), with a webhook listener its failure
+// log, and with {flags} in mount.issue_dir_template the dircolors snippet.
+type ControlDirNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*ControlDirNode)(nil)
+var _ fs.NodeLookuper = (*ControlDirNode)(nil)
+var _ fs.NodeGetattrer = (*ControlDirNode)(nil)
+
+func (n *ControlDirNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	entries := []fuse.DirEntry{
+		{Name: emojiMapName, Mode: syscall.S_IFREG},
+		{Name: pendingDirName, Mode: syscall.S_IFDIR},
+		{Name: controlStatusName, Mode: syscall.S_IFREG},
+		{Name: controlSyncName, Mode: syscall.S_IFREG},
+		{Name: controlLogLevelName, Mode: syscall.S_IFREG},
+	}
+	if n.lfs.webhookLog != nil {
+		entries = append(entries, fuse.DirEntry{Name: webhookFailuresName, Mode: syscall.S_IFREG})
+	}
+	if n.lfs.issueDirs.flags() {
+		entries = append(entries, fuse.DirEntry{Name: dircolorsName, Mode: syscall.S_IFREG})
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *ControlDirNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	switch name {
+	case emojiMapName:
+		// The custom workspace emojis, cached in SQLite and refreshed on read
+		// (SWR). A failed read or an empty workspace renders {} — the file
+		// never ENOENTs.
+		lfs := n.lfs
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			emojis, _ := lfs.repo.GetEmojis(ctx)
+			mtime, ctime := emojiMapTimes(emojis)
+			return emojiMapJSON(emojis), mtime, ctime
+		}, emojiMapIno(), inheritTimeout), 0
+	case pendingDirName:
+		node := &PendingDirNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}}
+		return n.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), pendingDirIno(), inheritTimeout), 0
+	case controlStatusName:
+		// Rendered on every read, and never attr-cached: the size moves with
+		// each sync.
+		lfs := n.lfs
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			content, mtime := controlStatusJSON(lfs.controlStatus(ctx))
+			return content, mtime, time.Time{}
+		}, controlStatusIno(), 0), 0
+	case controlSyncName:
+		return n.lfs.lookupTriggerFile(ctx, n, n.lfs.triggerSync, out), 0
+	case controlLogLevelName:
+		node := newLogLevelNode(n.lfs)
+		return n.newFileInode(ctx, out, name, node, fileAttr(node.size(), time.Time{}, time.Time{}), controlLogLevelIno(), 0), 0
+	case webhookFailuresName:
+		handler := n.lfs.webhookLog
+		if handler == nil {
+			return nil, syscall.ENOENT
+		}
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			return webhookFailuresJSONL(handler.Failures())
+		}, webhookFailuresIno(), 0), 0
+	case dircolorsName:
+		if !n.lfs.issueDirs.flags() {
+			return nil, syscall.ENOENT
+		}
+		return n.lookupRenderFile(ctx, out, name, func(context.Context) ([]byte, time.Time, time.Time) {
+			return []byte(dircolorsSnippet), time.Time{}, time.Time{}
+		}, dircolorsIno(), inheritTimeout), 0
+	default:
+		return nil, syscall.ENOENT
+	}
+}
+
+// dircolorsName is the dircolors(1) snippet coloring the {flags} suffixes.
+// Present only when mount.issue_dir_template carries {flags}.
+const dircolorsName = "dircolors"
+
+// dircolorsSnippet colors urgent issues bold red and blocked ones yellow.
+// GNU ls colors a name by suffix only for regular files, and issue
+// directories take the directory color; listers that apply suffix rules to
+// every entry pick it up.
+const dircolorsSnippet = `# linearfs: colors for the {flags} suffixes of mount.issue_dir_template.
+# Append to your dircolors database:
+#   eval "$( (dircolors -p; cat ~/linear/.linearfs/dircolors) | dircolors -b -)"
+*` + issueFlagUrgent + ` 01;31
+*` + issueFlagBlocked + ` 00;33
+`
+
+// webhookFailuresName lists the webhook deliveries the listener refused,
+// failed to apply, or ignored as repeats. Present only with webhook.listen.
+const webhookFailuresName = "webhook-failures"
+
+// webhookFailuresJSONL renders webhook-failures: one JSON object per line,
+// oldest first, with the newest failure's time as mtime and the oldest's as
+// ctime.
+func webhookFailuresJSONL(failures []webhook.Failure) ([]byte, time.Time, time.Time) {
+	var b bytes.Buffer
+	for _, f := range failures {
+		line, err := json.Marshal(f)
+		if err != nil {
+			continue
+		}
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	if len(failures) == 0 {
+		return nil, time.Time{}, time.Time{}
+	}
+	return b.Bytes(), failures[len(failures)-1].At, failures[0].At
+}
+
+// emojiMapJSON renders emoji.json: each custom emoji's name (as written
+// between colons) mapped to its image URL, keys sorted, so a renderer can
+// swap :name: for the image.
+func emojiMapJSON(emojis []api.Emoji) []byte {
+	m := make(map[string]string, len(emojis))
+	for _, e := range emojis {
+		m[e.Name] = e.URL
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return []byte("{}\n")
+	}
+	return append(data, '\n')
+}
+
+// emojiMapTimes is emoji.json's mtime/ctime: the newest update and the oldest
+// creation across the emojis, zero when there are none.
+func emojiMapTimes(emojis []api.Emoji) (mtime, ctime time.Time) {
+	for _, e := range emojis {
+		if e.UpdatedAt.After(mtime) {
+			mtime = e.UpdatedAt
+		}
+		if !e.CreatedAt.IsZero() && (ctime.IsZero() || e.CreatedAt.Before(ctime)) {
+			ctime = e.CreatedAt
+		}
+	}
+	return mtime, ctime
+}
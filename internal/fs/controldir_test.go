@@ -0,0 +1,158 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/webhook"
+)
+
+func TestEmojiMapJSON(t *testing.T) {
+	t.Parallel()
+	if got := string(emojiMapJSON(nil)); got != "{}\n" {
+		t.Errorf("empty map = %q, want {}", got)
+	}
+	got := string(emojiMapJSON([]api.Emoji{
+		{Name: "shipit", URL: "https://uploads.linear.app/e/shipit.png"},
+		{Name: "party-parrot", URL: "https://uploads.linear.app/e/parrot.gif"},
+	}))
+	want := "{\n  \"party-parrot\": \"https://uploads.linear.app/e/parrot.gif\",\n  \"shipit\": \"https://uploads.linear.app/e/shipit.png\"\n}\n"
+	if got != want {
+		t.Errorf("emojiMapJSON =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEmojiMapTimes(t *testing.T) {
+	t.Parallel()
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(48 * time.Hour)
+	mtime, ctime := emojiMapTimes([]api.Emoji{
+		{CreatedAt: t2, UpdatedAt: t2},
+		{CreatedAt: t1, UpdatedAt: t1.Add(time.Hour)},
+	})
+	if !mtime.Equal(t2) || !ctime.Equal(t1) {
+		t.Errorf("times = %v, %v; want %v, %v", mtime, ctime, t2, t1)
+	}
+}
+
+// TestControlStatus renders status from the cache: a synced team carries its
+// last sync and issue count, a team never synced reads null.
+func TestControlStatus(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	q := store.Queries()
+
+	synced := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	for _, team := range []db.UpsertTeamParams{
+		{ID: "team-1", Key: "ENG", Name: "Engineering", SyncedAt: synced},
+		{ID: "team-2", Key: "OPS", Name: "Operations", SyncedAt: synced},
+	} {
+		if err := q.UpsertTeam(ctx, team); err != nil {
+			t.Fatalf("UpsertTeam: %v", err)
+		}
+	}
+	if err := q.UpsertSyncMeta(ctx, db.UpsertSyncMetaParams{TeamID: "team-1", LastSyncedAt: synced, IssueCount: db.ToNullInt64(42)}); err != nil {
+		t.Fatalf("UpsertSyncMeta: %v", err)
+	}
+
+	content, mtime := controlStatusJSON(lfs.controlStatus(ctx))
+	if !mtime.Equal(synced) {
+		t.Errorf("mtime = %v, want the newest team sync %v", mtime, synced)
+	}
+	var got struct {
+		LogLevel string `json:"log_level"`
+		Sync     struct {
+			Teams []struct {
+				Key          string     `json:"key"`
+				LastSyncedAt *time.Time `json:"last_synced_at"`
+				Issues       int64      `json:"issues"`
+			} `json:"teams"`
+		} `json:"sync"`
+		RateLimit []any `json:"rate_limit"`
+	}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatalf("status is not JSON: %v\n%s", err, content)
+	}
+	teams := got.Sync.Teams
+	if len(teams) != 2 || teams[0].Key != "ENG" || teams[1].Key != "OPS" {
+		t.Fatalf("teams = %+v, want ENG and OPS", teams)
+	}
+	if teams[0].LastSyncedAt == nil || !teams[0].LastSyncedAt.Equal(synced) || teams[0].Issues != 42 {
+		t.Errorf("ENG = %+v, want synced %v with 42 issues", teams[0], synced)
+	}
+	if teams[1].LastSyncedAt != nil {
+		t.Errorf("OPS last_synced_at = %v, want null for a team never synced", teams[1].LastSyncedAt)
+	}
+	if got.LogLevel != currentLogLevel() || got.RateLimit == nil {
+		t.Errorf("status = %s, want log_level %q and a rate_limit array", content, currentLogLevel())
+	}
+}
+
+// TestTriggerSyncCommands: only "now" is a command, and a mount without a
+// sync worker says so rather than pretending.
+func TestTriggerSyncCommands(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	ctx := context.Background()
+	if errno := lfs.triggerSync(ctx, []byte("later\n")); errno != syscall.EINVAL {
+		t.Errorf("triggerSync(later) = %v, want EINVAL", errno)
+	}
+	if errno := lfs.triggerSync(ctx, []byte("now\n")); errno != syscall.ENOTSUP {
+		t.Errorf("triggerSync(now) without a worker = %v, want ENOTSUP", errno)
+	}
+}
+
+// TestLogLevelFlush switches the API debug traces through log-level, and a
+// level it does not know is rejected with the buffer reset to the one in
+// force. Not parallel: the level is process-wide.
+func TestLogLevelFlush(t *testing.T) {
+	was := api.DebugLogging()
+	t.Cleanup(func() { api.SetDebugLogging(was) })
+	api.SetDebugLogging(false)
+	ctx := context.Background()
+
+	flush := func(content string) (syscall.Errno, string) {
+		n := newLogLevelNode(nil)
+		n.truncateBuffer() // the O_TRUNC of a `>` redirect
+		n.Write(ctx, nil, []byte(content), 0)
+		errno := n.Flush(ctx, nil)
+		return errno, string(n.content)
+	}
+	if errno, content := flush("debug\n"); errno != 0 || content != "debug\n" || !api.DebugLogging() {
+		t.Errorf("flush(debug) = %v, %q, debug on = %v", errno, content, api.DebugLogging())
+	}
+	if errno, content := flush("verbose\n"); errno != syscall.EINVAL || content != "debug\n" || !api.DebugLogging() {
+		t.Errorf("flush(verbose) = %v, %q, debug on = %v; want EINVAL and debug kept", errno, content, api.DebugLogging())
+	}
+	if errno, content := flush("info"); errno != 0 || content != "info\n" || api.DebugLogging() {
+		t.Errorf("flush(info) = %v, %q, debug on = %v", errno, content, api.DebugLogging())
+	}
+}
+
+func TestWebhookFailuresJSONL(t *testing.T) {
+	t.Parallel()
+	if content, mtime, _ := webhookFailuresJSONL(nil); len(content) != 0 || !mtime.IsZero() {
+		t.Errorf("empty log = %q, %v; want empty with no time", content, mtime)
+	}
+	t1 := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+	content, mtime, ctime := webhookFailuresJSONL([]webhook.Failure{
+		{At: t1, Delivery: "d-1", Status: 401, Error: "bad signature"},
+		{At: t2, Delivery: "d-2", Type: "Issue", Action: "update", Status: 500, Error: "apply failed: no id"},
+	})
+	want := `{"at":"2026-10-01T09:00:00Z","delivery":"d-1","status":401,"error":"bad signature"}
+{"at":"2026-10-01T09:01:00Z","delivery":"d-2","type":"Issue","action":"update","status":500,"error":"apply failed: no id"}
+`
+	if string(content) != want {
+		t.Errorf("webhookFailuresJSONL =\n%s\nwant\n%s", content, want)
+	}
+	if !mtime.Equal(t2) || !ctime.Equal(t1) {
+		t.Errorf("times = %v, %v; want %v, %v", mtime, ctime, t2, t1)
+	}
+}
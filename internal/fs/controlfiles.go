@@ -0,0 +1,221 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// The daemon's runtime controls under /.linearfs/.
+//
+// Inspecting or steering a running mount used to mean reading the journal and
+// restarting the service with different flags. Three files do it in place:
+//
+//	cat .linearfs/status            # per-team last sync, cache size, rate-limit windows
+//	echo now > .linearfs/sync       # run a full sync cycle on the worker
+//	echo debug > .linearfs/log-level  # turn on the [API]/[ratelimit] traces
+//
+// status renders from the cache and the client's in-memory budget on every
+// read. sync queues the request on the worker's loop (Worker.TriggerSync), so
+// the write returns at once and the cycle never overlaps a scheduled one.
+// log-level switches the API package's debug traces; FUSE op tracing stays a
+// mount-time --debug flag.
+
+const (
+	controlStatusName   = "status"
+	controlSyncName     = "sync"
+	controlLogLevelName = "log-level"
+)
+
+// The log levels log-level accepts. info is the daemon's normal log; debug
+// adds the per-request [API] and [ratelimit] wait traces.
+const (
+	logLevelInfo  = "info"
+	logLevelDebug = "debug"
+)
+
+// controlStatus is the status file's document.
+type controlStatus struct {
+	LogLevel string             `json:"log_level"`
+	Sync     controlSyncStatus  `json:"sync"`
+	Database controlDBStatus    `json:"database"`
+	Budget   []controlRateLimit `json:"rate_limit"`
+}
+
+type controlSyncStatus struct {
+	Running       bool                `json:"running"`
+	LastFullCycle *time.Time          `json:"last_full_cycle"` // null until the first full cycle
+	Teams         []controlTeamStatus `json:"teams"`
+}
+
+type controlTeamStatus struct {
+	Key          string     `json:"key"`
+	Name         string     `json:"name"`
+	LastSyncedAt *time.Time `json:"last_synced_at"` // null until the team's first sync
+	Issues       int64      `json:"issues"`
+}
+
+type controlDBStatus struct {
+	Path  string `json:"path,omitempty"`
+	Bytes int64  `json:"bytes"` // the file plus its -wal and -shm
+}
+
+// controlRateLimit is one budget axis as Linear last reported it.
+type controlRateLimit struct {
+	Axis      string     `json:"axis"`
+	Limit     float64    `json:"limit"`
+	Remaining float64    `json:"remaining"`
+	ResetAt   *time.Time `json:"reset_at"`
+}
+
+// controlStatus gathers the status document. A cache read that fails leaves
+// its part empty: the file is a diagnostic and always renders.
+func (lfs *LinearFS) controlStatus(ctx context.Context) controlStatus {
+	s := controlStatus{
+		LogLevel: currentLogLevel(),
+		Sync:     controlSyncStatus{Teams: []controlTeamStatus{}},
+		Database: controlDBStatus{Path: lfs.dbPath},
+		Budget:   []controlRateLimit{},
+	}
+	if lfs.syncWorker != nil {
+		s.Sync.Running = lfs.syncWorker.Running()
+	}
+	if lfs.store != nil {
+		q := lfs.store.Queries()
+		if last, err := q.GetSyncSchedule(ctx, "full_cycle"); err == nil {
+			s.Sync.LastFullCycle = &last
+		}
+		teams, _ := lfs.repo.GetTeams(ctx)
+		for _, team := range teams {
+			ts := controlTeamStatus{Key: team.Key, Name: team.Name}
+			if meta, err := q.GetSyncMeta(ctx, team.ID); err == nil {
+				ts.LastSyncedAt = &meta.LastSyncedAt
+				ts.Issues = meta.IssueCount.Int64
+			}
+			s.Sync.Teams = append(s.Sync.Teams, ts)
+		}
+	}
+	if lfs.dbPath != "" {
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			if info, err := os.Stat(lfs.dbPath + suffix); err == nil {
+				s.Database.Bytes += info.Size()
+			}
+		}
+	}
+	if lfs.client != nil {
+		for _, w := range lfs.client.BudgetWindows() {
+			rl := controlRateLimit{Axis: w.Axis, Limit: w.Limit, Remaining: w.Remaining}
+			if !w.ResetAt.IsZero() {
+				rl.ResetAt = &w.ResetAt
+			}
+			s.Budget = append(s.Budget, rl)
+		}
+	}
+	return s
+}
+
+// controlStatusJSON renders the status file, and its mtime: the newest team
+// sync.
+func controlStatusJSON(s controlStatus) ([]byte, time.Time) {
+	var mtime time.Time
+	for _, t := range s.Sync.Teams {
+		if t.LastSyncedAt != nil && t.LastSyncedAt.After(mtime) {
+			mtime = *t.LastSyncedAt
+		}
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return []byte("{}\n"), mtime
+	}
+	return append(data, '\n'), mtime
+}
+
+// triggerSync is the sync file's onFlush: "now" queues a full cycle.
+func (lfs *LinearFS) triggerSync(ctx context.Context, content []byte) syscall.Errno {
+	if cmd := strings.TrimSpace(string(content)); cmd != "now" {
+		log.Printf("[control] sync: unknown command %q (write \"now\")", cmd)
+		return syscall.EINVAL
+	}
+	if lfs.syncWorker == nil {
+		log.Printf("[control] sync: no sync worker on this mount")
+		return syscall.ENOTSUP
+	}
+	if !lfs.syncWorker.TriggerSync() {
+		log.Printf("[control] sync: a requested sync is already pending")
+	}
+	return 0
+}
+
+// currentLogLevel is log-level's content, without the newline.
+func currentLogLevel() string {
+	if api.DebugLogging() {
+		return logLevelDebug
+	}
+	return logLevelInfo
+}
+
+// LogLevelNode is /.linearfs/log-level: a read/write buffer holding the
+// current level, whose Flush applies a new one.
+type LogLevelNode struct {
+	BaseNode
+	editBuffer
+}
+
+var _ fs.NodeGetattrer = (*LogLevelNode)(nil)
+var _ fs.NodeOpener = (*LogLevelNode)(nil)
+var _ fs.NodeReader = (*LogLevelNode)(nil)
+var _ fs.NodeWriter = (*LogLevelNode)(nil)
+var _ fs.NodeFlusher = (*LogLevelNode)(nil)
+var _ fs.NodeFsyncer = (*LogLevelNode)(nil)
+var _ fs.NodeSetattrer = (*LogLevelNode)(nil)
+
+func newLogLevelNode(lfs *LinearFS) *LogLevelNode {
+	return &LogLevelNode{BaseNode: BaseNode{lfs: lfs}, editBuffer: editBuffer{content: []byte(currentLogLevel() + "\n")}}
+}
+
+func (n *LogLevelNode) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	fileAttr(n.size(), time.Time{}, time.Time{}).fill(&out.Attr, &n.BaseNode)
+	return 0
+}
+
+// refreshFrom adopts the current level unless an edit is in flight
+// (refresh.go).
+func (n *LogLevelNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*LogLevelNode); ok {
+		n.refresh(f.content, func() {})
+	}
+}
+
+// Flush applies the written level. Anything but info or debug is EINVAL, and
+// the buffer goes back to the level in force either way.
+func (n *LogLevelNode) Flush(ctx context.Context, f fs.FileHandle) (errno syscall.Errno) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.dirty {
+		return 0
+	}
+	start := time.Now()
+	defer func() { recordFuseOp(ctx, "flush", start, errno) }()
+
+	switch level := strings.TrimSpace(string(n.content)); level {
+	case logLevelInfo, logLevelDebug:
+		if level != currentLogLevel() {
+			api.SetDebugLogging(level == logLevelDebug)
+			log.Printf("[control] log level set to %s", level)
+		}
+	default:
+		log.Printf("[control] log-level: unknown level %q (want %s or %s)", level, logLevelInfo, logLevelDebug)
+		errno = syscall.EINVAL
+	}
+	n.content = []byte(currentLogLevel() + "\n")
+	n.dirty = false
+	return errno
+}
@@ -83,7 +83,7 @@ type createSpec[T any] struct {
 	// a spec cannot forget it.
 	dir uint64
 	// entryName returns the created entity's on-disk name, or "" when it is not
-	// knowable without re-listing (comments, relations). nil means "".
+	// knowable without re-listing (relations). nil means "".
 	entryName func(created *T) string
 	// invalidateExtra covers per-entity internal caches and dependent views
 	// (team/my/filtered issue caches, recent/). nil when the collection has none.
@@ -0,0 +1,73 @@
+package fs
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// crossLinker returns the rewriter for a body rendered depth directories below
+// teamKey's issues/ (marshal.CrossLinker): issue references as relative links
+// under display.cross_links, and issueID's CDN images as paths into its
+// attachments/ under display.local_images. With both options off it is the
+// disabled zero value. A failed lookup links nothing but still unlinks, so a
+// save never pushes a relative path to Linear.
+func (lfs *LinearFS) crossLinker(ctx context.Context, teamKey, issueID string, depth int) marshal.CrossLinker {
+	l := marshal.CrossLinker{Team: teamKey, Depth: depth}
+	if lfs.repo == nil {
+		return l
+	}
+	if lfs.crossLinks {
+		keys := map[string]bool{}
+		if teams, err := lfs.repo.GetTeams(ctx); err == nil {
+			for _, t := range teams {
+				keys[t.Key] = true
+			}
+		}
+		l.Known = func(key string) bool { return keys[key] }
+	}
+	if lfs.imagePaths {
+		l.Images = map[string]string{}
+		if files, err := lfs.repo.GetIssueEmbeddedFiles(ctx, issueID); err == nil {
+			// The names attachments/ lists them under, so each path resolves.
+			prefix := strings.Repeat("../", depth-1) + "attachments/"
+			for _, e := range (attachmentListing{embedded: files}).entries() {
+				l.Images[e.embedded.URL] = prefix + (&url.URL{Path: e.name}).EscapedPath()
+			}
+		}
+	}
+	return l
+}
+
+// issueLinker is the cross-linker for an issue's issue.md.
+func (lfs *LinearFS) issueLinker(ctx context.Context, issue *api.Issue) marshal.CrossLinker {
+	return lfs.crossLinker(ctx, issueTeamKey(issue), issue.ID, 1)
+}
+
+// renderIssueFile renders issue.md, its body run through issueLinker and cut
+// if it is over the view limit (truncatedview.go).
+func (lfs *LinearFS) renderIssueFile(ctx context.Context, issue *api.Issue) ([]byte, error) {
+	linked := *issue
+	linked.Description = bodyView(lfs.issueLinker(ctx, issue), issue.Description, issueFullName)
+	return marshal.IssueToMarkdown(&linked)
+}
+
+// renderIssueFull renders issue.full.md: issue.md with the whole body.
+func (lfs *LinearFS) renderIssueFull(ctx context.Context, issue *api.Issue) ([]byte, error) {
+	linked := *issue
+	linked.Description = lfs.issueLinker(ctx, issue).Link(issue.Description)
+	return marshal.IssueToMarkdown(&linked)
+}
+
+// issueTeamKey is the key of the team directory an issue lives under: its
+// team's, or its identifier's prefix on a row synced without the team.
+func issueTeamKey(issue *api.Issue) string {
+	if issue.Team != nil && issue.Team.Key != "" {
+		return issue.Team.Key
+	}
+	key, _, _ := strings.Cut(issue.Identifier, "-")
+	return key
+}
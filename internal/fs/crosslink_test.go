@@ -0,0 +1,121 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// TestIssueFileCrossLinks: with display.cross_links on, issue.md renders a
+// mounted team's identifiers as relative links, an unchanged save is a no-op,
+// and an edited body reaches Linear with the links turned back.
+func TestIssueFileCrossLinks(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	lfs.crossLinks = true
+	ctx := context.Background()
+
+	if err := store.Queries().UpsertTeam(ctx, db.APITeamToDBTeam(api.Team{ID: "team-1", Key: "ENG", Name: "Engineering"})); err != nil {
+		t.Fatalf("UpsertTeam: %v", err)
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	issue := api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Linked", Description: "Blocked on ENG-42, not SEC-3.",
+		Team: &api.Team{ID: "team-1", Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := lfs.UpsertIssue(ctx, issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+
+	content, err := lfs.renderIssueFile(ctx, &issue)
+	if err != nil {
+		t.Fatalf("renderIssueFile: %v", err)
+	}
+	if !strings.Contains(string(content), "Blocked on [ENG-42](../ENG-42/issue.md), not SEC-3.") {
+		t.Fatalf("issue.md body not cross-linked:\n%s", content)
+	}
+	unlinked := lfs.issueLinker(ctx, &issue).Unlink(string(content))
+	if updates, err := marshal.MarkdownToIssueUpdate([]byte(unlinked), &issue); err != nil || len(updates) != 0 {
+		t.Fatalf("unchanged save = %v, %v; want no updates", updates, err)
+	}
+
+	node := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: issue, editBuffer: editBuffer{content: content}}
+	edited := []byte(strings.Replace(string(content), "not SEC-3.", "not SEC-3. Fixed by ENG-43.", 1))
+	fh, _, errno := node.Open(ctx, syscall.O_WRONLY|syscall.O_TRUNC)
+	if errno != 0 {
+		t.Fatalf("Open = %v", errno)
+	}
+	if _, errno := node.Write(ctx, fh, edited, 0); errno != 0 {
+		t.Fatalf("Write = %v", errno)
+	}
+	if errno := node.Flush(ctx, fh); errno != 0 {
+		t.Fatalf("Flush = %v", errno)
+	}
+	cached, err := lfs.repo.GetIssueByID(ctx, issue.ID)
+	if err != nil || cached == nil {
+		t.Fatalf("GetIssueByID: %v", err)
+	}
+	if want := "Blocked on ENG-42, not SEC-3. Fixed by ENG-43."; strings.TrimSpace(cached.Description) != want {
+		t.Errorf("saved description = %q, want %q", cached.Description, want)
+	}
+}
+
+// TestCrossLinksOffByDefault: without display.cross_links the body renders as
+// Linear stores it.
+func TestCrossLinksOffByDefault(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	issue := api.Issue{ID: "issue-1", Identifier: "ENG-1", Title: "Plain", Description: "See ENG-42.", Team: &api.Team{Key: "ENG"}}
+	content, err := lfs.renderIssueFile(context.Background(), &issue)
+	if err != nil {
+		t.Fatalf("renderIssueFile: %v", err)
+	}
+	if strings.Contains(string(content), "issue.md") {
+		t.Errorf("body cross-linked with the option off:\n%s", content)
+	}
+}
+
+// TestIssueFileLocalImages: with display.local_images on, a CDN image in
+// issue.md and in a comment points at the file attachments/ lists, and the
+// issue.md path turns back into the URL on save.
+func TestIssueFileLocalImages(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	lfs.imagePaths = true
+	ctx := context.Background()
+
+	const cdn = "https://uploads.linear.app/org/abc/login%20page.png"
+	now := time.Now().UTC().Truncate(time.Second)
+	if err := store.Queries().UpsertEmbeddedFile(ctx, db.UpsertEmbeddedFileParams{
+		ID: "file-1", IssueID: "issue-1", Url: cdn, Filename: "login page.png",
+		Source: "description", CreatedAt: now, SyncedAt: now,
+	}); err != nil {
+		t.Fatalf("UpsertEmbeddedFile: %v", err)
+	}
+	issue := api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Shot", Description: "![login](" + cdn + ")",
+		Team: &api.Team{Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	content, err := lfs.renderIssueFile(ctx, &issue)
+	if err != nil {
+		t.Fatalf("renderIssueFile: %v", err)
+	}
+	if !strings.Contains(string(content), "![login](attachments/login%20page.png)") {
+		t.Fatalf("issue.md image not local:\n%s", content)
+	}
+	unlinked := lfs.issueLinker(ctx, &issue).Unlink(string(content))
+	if updates, err := marshal.MarkdownToIssueUpdate([]byte(unlinked), &issue); err != nil || len(updates) != 0 {
+		t.Fatalf("unchanged save = %v, %v; want no updates", updates, err)
+	}
+
+	comments := lfs.crossLinker(ctx, "ENG", issue.ID, 2)
+	if got := comments.Link("see ![login](" + cdn + ")"); got != "see ![login](../attachments/login%20page.png)" {
+		t.Errorf("comment image = %q", got)
+	}
+}
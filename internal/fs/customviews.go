@@ -0,0 +1,114 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// CustomViewsNode is /views/: one directory per saved view ("custom view")
+// from Linear's UI, so a filter built on the web shows up here without any
+// config. Contrast teams/{KEY}/views/, whose filters come from the mount
+// config and are evaluated locally; these are evaluated by Linear.
+type CustomViewsNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*CustomViewsNode)(nil)
+var _ fs.NodeLookuper = (*CustomViewsNode)(nil)
+var _ fs.NodeGetattrer = (*CustomViewsNode)(nil)
+
+func (n *CustomViewsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	views, err := n.lfs.repo.GetCustomViews(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	// Linear allows two views with one name; customViewDirNames gives each
+	// its own.
+	names := customViewDirNames(views)
+	entries := make([]fuse.DirEntry, len(views))
+	for i, name := range names {
+		entries[i] = fuse.DirEntry{Name: name, Mode: syscall.S_IFDIR}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *CustomViewsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	views, err := n.lfs.repo.GetCustomViews(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	if i := slices.Index(customViewDirNames(views), name); i >= 0 {
+		v := views[i]
+		node := &CustomViewNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.CustomView]{val: v}}
+		// 0555: membership is Linear's to decide; nothing here is writable.
+		na := nodeAttr{mode: 0555 | syscall.S_IFDIR, created: v.CreatedAt, updated: v.UpdatedAt}
+		return n.newDirInode(ctx, out, name, node, na, customViewDirIno(v.ID), inheritTimeout), 0
+	}
+	return nil, syscall.ENOENT
+}
+
+// customViewDirName is a saved view's directory name: its name as shown in
+// Linear, through the safeName chokepoint.
+func customViewDirName(v api.CustomView) string {
+	return safeName(v.Name, v.ID)
+}
+
+// customViewDirNames is views/'s listing: customViewDirName of each view,
+// with names two views share made unique by uniqueNames.
+func customViewDirNames(views []api.CustomView) []string {
+	return uniqueNames(views, func(v api.CustomView) (string, string) { return v.Name, v.ID }) // safename:ok uniqueNames safeNames it
+}
+
+// CustomViewNode is /views/{name}/: symlinks to the issues the saved view
+// matches, named by identifier.
+type CustomViewNode struct {
+	attrNode
+	entityCell[api.CustomView]
+}
+
+var _ fs.NodeReaddirer = (*CustomViewNode)(nil)
+var _ fs.NodeLookuper = (*CustomViewNode)(nil)
+var _ fs.NodeGetattrer = (*CustomViewNode)(nil)
+
+// entity()/setEntity() are promoted from the embedded entityCell[api.CustomView].
+// refreshFrom is the nodeRefresher seam (refresh.go).
+func (n *CustomViewNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*CustomViewNode); ok {
+		n.setEntity(f.entity())
+	}
+}
+
+func (n *CustomViewNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.lfs.repo.GetCustomViewIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(issues))
+	for i, issue := range issues {
+		entries[i] = fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *CustomViewNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.lfs.repo.GetCustomViewIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			// views/{name}/ is two levels below the mount root, like users/{name}/.
+			target, errno := teamIssueTarget(issue)
+			if errno != 0 {
+				return nil, errno
+			}
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
@@ -0,0 +1,191 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// cycleReportName is the completed-cycle summary under cycles/{name}/.
+const cycleReportName = "report.md"
+
+// validateCycleReports checks the `cycle_reports:` config: each entry names a
+// team and at least one place to post. Like compileRecurring, a bad entry
+// fails the mount. Targets are resolved at post time — the projects and
+// initiatives may not be synced yet when the mount starts.
+func validateCycleReports(cfgs []config.CycleReportConfig) error {
+	for i, rc := range cfgs {
+		if rc.Team == "" {
+			return fmt.Errorf("cycle_reports[%d]: team is required", i)
+		}
+		if rc.Project == "" && rc.Initiative == "" {
+			return fmt.Errorf("cycle_reports[%d] %s: project or initiative is required", i, rc.Team)
+		}
+	}
+	return nil
+}
+
+// renderCycleReport renders a completed cycle's summary: what shipped, what
+// was canceled, and what carries over, from the cycle's issues as SQLite holds
+// them. The same text is what ReportCompletedCycle posts as an update, so it
+// carries no frontmatter.
+func renderCycleReport(team api.Team, cycle api.Cycle, issues []api.Issue) []byte {
+	var shipped, canceled, carried []api.Issue
+	for _, issue := range issues {
+		switch issue.State.Type {
+		case "completed":
+			shipped = append(shipped, issue)
+		case "canceled":
+			canceled = append(canceled, issue)
+		default:
+			carried = append(carried, issue)
+		}
+	}
+
+	name := cycle.Name
+	if name == "" {
+		name = fmt.Sprintf("Cycle %d", cycle.Number)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s %s report\n\n", team.Key, name)
+	fmt.Fprintf(&b, "%s - %s", cycle.StartsAt.Format("Jan 2, 2006"), cycle.EndsAt.Format("Jan 2, 2006"))
+	if cycle.CompletedAt != nil {
+		fmt.Fprintf(&b, ", completed %s", cycle.CompletedAt.Format("Jan 2, 2006"))
+	}
+	fmt.Fprintf(&b, ". Shipped %d of %d issues", len(shipped), len(issues))
+	if points := sumEstimates(shipped); points > 0 {
+		fmt.Fprintf(&b, " (%g points)", points)
+	}
+	b.WriteString(".\n")
+
+	section := func(title string, issues []api.Issue, withState bool) {
+		if len(issues) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", title, len(issues))
+		for _, issue := range issues {
+			fmt.Fprintf(&b, "- %s %s", issue.Identifier, issue.Title)
+			if withState && issue.State.Name != "" {
+				fmt.Fprintf(&b, " (%s)", issue.State.Name)
+			}
+			b.WriteString("\n")
+		}
+	}
+	section("Shipped", shipped, false)
+	section("Canceled", canceled, false)
+	section("Carried over", carried, true)
+	return []byte(b.String())
+}
+
+// sumEstimates totals the estimated issues' points.
+func sumEstimates(issues []api.Issue) float64 {
+	var total float64
+	for _, issue := range issues {
+		if issue.Estimate != nil {
+			total += *issue.Estimate
+		}
+	}
+	return total
+}
+
+// cycleReport renders a cycle's report from SQLite.
+func (lfs *LinearFS) cycleReport(ctx context.Context, team api.Team, cycle api.Cycle) ([]byte, error) {
+	issues, err := lfs.repo.GetIssuesByCycle(ctx, cycle.ID)
+	if err != nil {
+		return nil, err
+	}
+	return renderCycleReport(team, cycle, issues), nil
+}
+
+// ReportCompletedCycle is the sync worker's CycleCompletionReporter: it posts
+// the cycle's report as a status update on every project and initiative the
+// team's `cycle_reports:` entries name, through the same create tail as
+// writing updates/_create — so the update is persisted and a failure lands in
+// that updates/.error. A failure of any post fails the report (the worker
+// retries it, so an earlier target may see the update twice).
+func (lfs *LinearFS) ReportCompletedCycle(ctx context.Context, team api.Team, cycle api.Cycle) error {
+	var report []byte
+	for _, rc := range lfs.cycleReports {
+		if rc.Team != team.Key {
+			continue
+		}
+		if report == nil {
+			var err error
+			if report, err = lfs.cycleReport(ctx, team, cycle); err != nil {
+				return err
+			}
+		}
+		if rc.Project != "" {
+			if err := lfs.postCycleReportToProject(ctx, team, rc.Project, report); err != nil {
+				return err
+			}
+		}
+		if rc.Initiative != "" {
+			if err := lfs.postCycleReportToInitiative(ctx, rc.Initiative, report); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// postCycleReportToProject posts a report on the team project whose directory
+// name (or ID) is ref.
+func (lfs *LinearFS) postCycleReportToProject(ctx context.Context, team api.Team, ref string, report []byte) error {
+	projects, err := lfs.repo.GetTeamProjects(ctx, team.ID)
+	if err != nil {
+		return err
+	}
+	for _, p := range projects {
+		if projectDirName(p) == ref || p.ID == ref {
+			n := &UpdatesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: p.ID}
+			return cycleReportPostErr(n.createUpdate(ctx, report), "project "+ref)
+		}
+	}
+	return fmt.Errorf("cycle report: no project %q in team %s", ref, team.Key)
+}
+
+// postCycleReportToInitiative posts a report on the initiative whose directory
+// name (or ID) is ref.
+func (lfs *LinearFS) postCycleReportToInitiative(ctx context.Context, ref string, report []byte) error {
+	initiatives, err := lfs.repo.GetInitiatives(ctx)
+	if err != nil {
+		return err
+	}
+	for _, in := range initiatives {
+		if initiativeDirName(in) == ref || in.ID == ref {
+			n := &InitiativeUpdatesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, initiativeID: in.ID}
+			return cycleReportPostErr(n.createUpdate(ctx, report), "initiative "+ref)
+		}
+	}
+	return fmt.Errorf("cycle report: no initiative %q", ref)
+}
+
+// cycleReportPostErr turns a create tail's errno into an error naming where the
+// details are.
+func cycleReportPostErr(errno syscall.Errno, target string) error {
+	if errno == 0 {
+		return nil
+	}
+	return fmt.Errorf("cycle report: post to %s failed (%v); see its updates/.error", target, errno)
+}
+
+// lookupCycleReport mounts a completed cycle's report.md. It renders on read,
+// so it follows the cycle's issues as sync moves them.
+func (c *CycleDirNode) lookupCycleReport(ctx context.Context, out *fuse.EntryOut, team api.Team, cycle api.Cycle) *fs.Inode {
+	completed := *cycle.CompletedAt
+	return c.lookupRenderFile(ctx, out, cycleReportName, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		report, err := c.lfs.cycleReport(ctx, team, cycle)
+		if err != nil {
+			return []byte("# Error loading cycle report\n"), completed, completed
+		}
+		return report, completed, completed
+	}, 0, inheritTimeout)
+}
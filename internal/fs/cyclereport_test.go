@@ -0,0 +1,110 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+func TestRenderCycleReport(t *testing.T) {
+	t.Parallel()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+	completed := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	cycle := api.Cycle{
+		ID: "cycle-1", Number: 42,
+		StartsAt:    time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+		EndsAt:      time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+		CompletedAt: &completed,
+	}
+	three, two := 3.0, 2.0
+	issues := []api.Issue{
+		{Identifier: "ENG-1", Title: "Ship it", State: api.State{Name: "Done", Type: "completed"}, Estimate: &three},
+		{Identifier: "ENG-2", Title: "Also shipped", State: api.State{Name: "Done", Type: "completed"}, Estimate: &two},
+		{Identifier: "ENG-3", Title: "Dropped", State: api.State{Name: "Canceled", Type: "canceled"}},
+		{Identifier: "ENG-4", Title: "Not yet", State: api.State{Name: "In Progress", Type: "started"}},
+	}
+
+	got := string(renderCycleReport(team, cycle, issues))
+	for _, want := range []string{
+		"# ENG Cycle 42 report",
+		"Oct 2, 2026 - Oct 16, 2026, completed Oct 16, 2026. Shipped 2 of 4 issues (5 points).",
+		"## Shipped (2)\n\n- ENG-1 Ship it\n- ENG-2 Also shipped\n",
+		"## Canceled (1)\n\n- ENG-3 Dropped\n",
+		"## Carried over (1)\n\n- ENG-4 Not yet (In Progress)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("report missing %q:\n%s", want, got)
+		}
+	}
+	if strings.HasPrefix(got, "---") {
+		t.Error("report has frontmatter; it is posted verbatim as an update body")
+	}
+}
+
+func TestValidateCycleReports(t *testing.T) {
+	t.Parallel()
+	for name, rc := range map[string]config.CycleReportConfig{
+		"no team":   {Project: "q1-launch"},
+		"no target": {Team: "ENG"},
+	} {
+		if err := validateCycleReports([]config.CycleReportConfig{rc}); err == nil {
+			t.Errorf("%s: validateCycleReports = nil error", name)
+		}
+	}
+	if err := validateCycleReports([]config.CycleReportConfig{{Team: "ENG", Initiative: "platform"}}); err != nil {
+		t.Errorf("valid entry: %v", err)
+	}
+}
+
+// TestReportCompletedCycle drives the worker's reporter seam: the configured
+// team's completed cycle posts its report to the named project through the
+// update create tail, and an unknown target fails the report so the worker
+// retries it.
+func TestReportCompletedCycle(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+	if err := fixtures.PopulateProject(ctx, store, api.Project{ID: "proj-1", Name: "Q1 Launch", Slug: "q1-launch-abc"}, team.ID); err != nil {
+		t.Fatalf("PopulateProject: %v", err)
+	}
+	completed := time.Now().UTC().Truncate(time.Second)
+	cycle := api.Cycle{ID: "cycle-1", Number: 7, CompletedAt: &completed}
+	if err := lfs.UpsertIssue(ctx, api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Ship it", Team: &team,
+		State: api.State{Name: "Done", Type: "completed"}, Cycle: &api.IssueCycle{ID: cycle.ID},
+		CreatedAt: completed, UpdatedAt: completed,
+	}); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+
+	lfs.cycleReports = []config.CycleReportConfig{{Team: "OPS", Project: "q1-launch"}}
+	if err := lfs.ReportCompletedCycle(ctx, team, cycle); err != nil {
+		t.Fatalf("other team's entry: %v", err)
+	}
+	if got := lfs.GetWriteSuccess(collectionErrorKey("updates", "proj-1")); len(got) != 0 {
+		t.Fatalf("other team's entry posted %+v", got)
+	}
+
+	lfs.cycleReports = []config.CycleReportConfig{{Team: "ENG", Project: "q1-launch"}}
+	if err := lfs.ReportCompletedCycle(ctx, team, cycle); err != nil {
+		t.Fatalf("ReportCompletedCycle: %v", err)
+	}
+	updates, err := lfs.repo.GetProjectUpdates(ctx, "proj-1")
+	if err != nil {
+		t.Fatalf("GetProjectUpdates: %v", err)
+	}
+	if len(updates) != 1 || !strings.Contains(updates[0].Body, "- ENG-1 Ship it") {
+		t.Fatalf("project updates = %+v, want the posted report", updates)
+	}
+
+	lfs.cycleReports = []config.CycleReportConfig{{Team: "ENG", Initiative: "no-such-initiative"}}
+	if err := lfs.ReportCompletedCycle(ctx, team, cycle); err == nil {
+		t.Error("unknown initiative: ReportCompletedCycle = nil error, want a retryable failure")
+	}
+}
@@ -11,17 +11,23 @@ import (
 	"github.com/hanwen/go-fuse/v2/fs"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
 // cycleDirName returns the directory name for a cycle (name with spaces as
 // hyphens). The cosmetic transform stays; safeName is the final safety pass
-// (traversal/control chars, and escaping the reserved "current" alias).
+// (traversal/control chars, reserved literals). A name landing on one of the
+// cycleAliases is escaped the same way, with -<id>.
 func cycleDirName(cycle api.Cycle) string {
 	name := cycle.Name
 	if name == "" {
 		name = fmt.Sprintf("Cycle %d", cycle.Number)
 	}
-	return safeName(strings.ReplaceAll(name, " ", "-"), cycle.ID)
+	name = safeName(strings.ReplaceAll(name, " ", "-"), cycle.ID)
+	if isCycleAlias(name) {
+		return name + "-" + cycle.ID
+	}
+	return name
 }
 
 // CyclesNode represents the /teams/{KEY}/cycles directory. It holds a team
@@ -50,24 +56,23 @@ func (c *CyclesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno)
 	}
 
 	// Start with cycle directories
-	entries := make([]fuse.DirEntry, 0, len(cycles)+1)
-	var hasCurrent bool
+	entries := make([]fuse.DirEntry, 0, len(cycles)+len(cycleAliases))
 	for _, cycle := range cycles {
 		entries = append(entries, fuse.DirEntry{
 			Name: cycleDirName(cycle),
 			Mode: syscall.S_IFDIR,
 		})
-		if isCurrent(cycle) {
-			hasCurrent = true
-		}
 	}
 
-	// Add "current" symlink if there's an active cycle
-	if hasCurrent {
-		entries = append(entries, fuse.DirEntry{
-			Name: "current",
-			Mode: syscall.S_IFLNK,
-		})
+	// Then each alias that resolves right now
+	now := time.Now()
+	for _, alias := range cycleAliases {
+		if _, ok := resolveCycleAlias(cycles, alias, now); ok {
+			entries = append(entries, fuse.DirEntry{
+				Name: alias,
+				Mode: syscall.S_IFLNK,
+			})
+		}
 	}
 
 	return fs.NewListDirStream(entries), 0
@@ -80,13 +85,11 @@ func (c *CyclesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut
 		return nil, syscall.EIO
 	}
 
-	// Handle "current" symlink
-	if name == "current" {
-		for _, cycle := range cycles {
-			if isCurrent(cycle) {
-				// atime=EndsAt matches the target CycleDirNode's convention.
-				return c.newSymlinkInodeAtime(ctx, out, cycleDirName(cycle), cycle.StartsAt, cycle.StartsAt, cycle.EndsAt), 0
-			}
+	// Handle the alias symlinks, resolved against the clock on every Lookup
+	if isCycleAlias(name) {
+		if cycle, ok := resolveCycleAlias(cycles, name, time.Now()); ok {
+			// atime=EndsAt matches the target CycleDirNode's convention.
+			return c.newSymlinkInodeAtime(ctx, out, cycleDirName(cycle), cycle.StartsAt, cycle.StartsAt, cycle.EndsAt), 0
 		}
 		return nil, syscall.ENOENT
 	}
@@ -145,12 +148,19 @@ func (c *CycleDirNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno
 		return nil, syscall.EIO
 	}
 
-	// cycle.md + issue symlinks
-	entries := make([]fuse.DirEntry, 0, len(issues)+1)
+	// cycle.md (+ scope-changes.md once started, report.md once completed)
+	// + issue symlinks
+	entries := make([]fuse.DirEntry, 0, len(issues)+3)
 	entries = append(entries, fuse.DirEntry{
 		Name: "cycle.md",
 		Mode: syscall.S_IFREG,
 	})
+	if !time.Now().Before(cycle.StartsAt) {
+		entries = append(entries, fuse.DirEntry{Name: scopeChangesName, Mode: syscall.S_IFREG})
+	}
+	if cycle.CompletedAt != nil {
+		entries = append(entries, fuse.DirEntry{Name: cycleReportName, Mode: syscall.S_IFREG})
+	}
 
 	for _, issue := range issues {
 		entries = append(entries, fuse.DirEntry{
@@ -171,6 +181,12 @@ func (c *CycleDirNode) Lookup(ctx context.Context, name string, out *fuse.EntryO
 			return cycleMarkdown(team, cycle), cycle.StartsAt, cycle.StartsAt
 		}, 0, inheritTimeout), 0
 	}
+	if name == cycleReportName && cycle.CompletedAt != nil {
+		return c.lookupCycleReport(ctx, out, team, cycle), 0
+	}
+	if name == scopeChangesName && !time.Now().Before(cycle.StartsAt) {
+		return c.lookupScopeChanges(ctx, out, team, cycle), 0
+	}
 
 	// Handle issue symlinks (e.g., "ENG-123")
 	issues, err := c.lfs.GetCycleIssues(ctx, cycle.ID)
@@ -194,7 +210,6 @@ func (c *CycleDirNode) Lookup(ctx context.Context, name string, out *fuse.EntryO
 // computed at render time, so a read reflects the cycle's live state.
 func cycleMarkdown(team api.Team, cycle api.Cycle) []byte {
 	now := time.Now()
-	isCurrent := now.After(cycle.StartsAt) && now.Before(cycle.EndsAt)
 
 	// Calculate progress from history arrays
 	var completed, total int
@@ -211,7 +226,7 @@ func cycleMarkdown(team api.Team, cycle api.Cycle) []byte {
 	}
 
 	status := "upcoming"
-	if isCurrent {
+	if isCurrent(cycle, now) {
 		status = "current"
 	} else if now.After(cycle.EndsAt) {
 		status = "completed"
@@ -230,8 +245,8 @@ func cycleMarkdown(team api.Team, cycle api.Cycle) []byte {
 		"number":   cycle.Number,
 		"name":     cycleName,
 		"team":     team.Key,
-		"startsAt": cycle.StartsAt.Format(time.RFC3339),
-		"endsAt":   cycle.EndsAt.Format(time.RFC3339),
+		"startsAt": marshal.FormatTimestamp(cycle.StartsAt),
+		"endsAt":   marshal.FormatTimestamp(cycle.EndsAt),
 		"status":   status,
 		"progress": map[string]any{
 			"completed":  completed,
@@ -257,8 +272,48 @@ func cycleMarkdown(team api.Team, cycle api.Cycle) []byte {
 	return renderWithFrontmatter(fm, body)
 }
 
-// isCurrent checks if a cycle is the current active cycle
-func isCurrent(cycle api.Cycle) bool {
-	now := time.Now()
+// cycleAliases are the cycles/ symlinks named for where a cycle sits relative
+// to now, in listing order. cycleDirName escapes a cycle named like one, so
+// a cycle can't be named over one.
+var cycleAliases = []string{"current", "next", "previous"}
+
+func isCycleAlias(name string) bool {
+	for _, alias := range cycleAliases {
+		if name == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveCycleAlias picks the cycle an alias names at now: current is the one
+// in progress, next the soonest to start, previous the latest to have ended.
+// A cycle boundary falling exactly on now is previous for the cycle ending and
+// next for the one starting. ok is false when no cycle fits (no active cycle
+// between sprints, nothing scheduled yet, a team's first cycle).
+func resolveCycleAlias(cycles []api.Cycle, alias string, now time.Time) (api.Cycle, bool) {
+	var best api.Cycle
+	found := false
+	for _, cycle := range cycles {
+		switch alias {
+		case "current":
+			if isCurrent(cycle, now) {
+				return cycle, true
+			}
+		case "next":
+			if !cycle.StartsAt.Before(now) && (!found || cycle.StartsAt.Before(best.StartsAt)) {
+				best, found = cycle, true
+			}
+		case "previous":
+			if !cycle.EndsAt.After(now) && (!found || cycle.EndsAt.After(best.EndsAt)) {
+				best, found = cycle, true
+			}
+		}
+	}
+	return best, found
+}
+
+// isCurrent checks if a cycle is the active cycle at now
+func isCurrent(cycle api.Cycle, now time.Time) bool {
 	return now.After(cycle.StartsAt) && now.Before(cycle.EndsAt)
 }
@@ -46,6 +46,15 @@ func TestCycleDirName(t *testing.T) {
 			},
 			want: "Sprint--Two",
 		},
+		{
+			name: "cycle named like an alias is escaped",
+			cycle: api.Cycle{
+				ID:     "c1",
+				Name:   "next",
+				Number: 3,
+			},
+			want: "next-c1",
+		},
 	}
 
 	for _, tt := range tests {
@@ -111,7 +120,7 @@ func TestIsCurrent(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := isCurrent(tt.cycle)
+			got := isCurrent(tt.cycle, now)
 			if got != tt.want {
 				t.Errorf("isCurrent() = %v, want %v", got, tt.want)
 			}
@@ -119,6 +128,63 @@ func TestIsCurrent(t *testing.T) {
 	}
 }
 
+func TestResolveCycleAlias(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	day := 24 * time.Hour
+	cycle := func(id string, start, end time.Duration) api.Cycle {
+		return api.Cycle{ID: id, StartsAt: now.Add(start), EndsAt: now.Add(end)}
+	}
+	running := []api.Cycle{
+		cycle("c3", 14*day, 28*day),
+		cycle("c1", -28*day, -14*day),
+		cycle("c4", 28*day, 42*day),
+		cycle("c2", -14*day, 14*day),
+		cycle("c0", -42*day, -28*day),
+	}
+	between := []api.Cycle{
+		cycle("past", -14*day, -1*day),
+		cycle("soon", 1*day, 14*day),
+	}
+	boundary := []api.Cycle{
+		cycle("ending", -14*day, 0),
+		cycle("starting", 0, 14*day),
+	}
+
+	tests := []struct {
+		name   string
+		cycles []api.Cycle
+		alias  string
+		want   string // "" for no cycle
+	}{
+		{"current mid-cycle", running, "current", "c2"},
+		{"next is the soonest upcoming", running, "next", "c3"},
+		{"previous is the latest ended", running, "previous", "c1"},
+		{"no current between cycles", between, "current", ""},
+		{"next between cycles", between, "next", "soon"},
+		{"previous between cycles", between, "previous", "past"},
+		{"boundary: previous is the ending cycle", boundary, "previous", "ending"},
+		{"boundary: next is the starting cycle", boundary, "next", "starting"},
+		{"first cycle has no previous", running[3:4], "previous", ""},
+		{"last cycle has no next", running[3:4], "next", ""},
+		{"no cycles", nil, "current", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := resolveCycleAlias(tt.cycles, tt.alias, now)
+			if tt.want == "" {
+				if ok {
+					t.Errorf("resolveCycleAlias(%q) = %s, want none", tt.alias, got.ID)
+				}
+				return
+			}
+			if !ok || got.ID != tt.want {
+				t.Errorf("resolveCycleAlias(%q) = %s (ok=%v), want %s", tt.alias, got.ID, ok, tt.want)
+			}
+		})
+	}
+}
+
 func TestCycleFileNode_GenerateContent(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
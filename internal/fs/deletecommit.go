@@ -70,6 +70,9 @@ type deleteSpec[T any] struct {
 // Contract:
 //   - find fails          -> .error gets the cause, classified errno.
 //   - find returns nil    -> .error notes the unknown name, ENOENT.
+//   - held for confirmation (the sink is a deleteConfirmer with
+//     confirm_deletes on) -> .error names the token, EPERM; the rest runs
+//     when the token is written to .confirm.
 //   - mutate fails        -> .error gets the cause, EAGAIN if transient else EIO.
 //   - forget fails (retried) -> .error names the self-heal (re-run rm), EIO; the
 //     coherence policy is skipped since the phantom row is still present.
@@ -95,10 +98,27 @@ func commitDelete[T any](ctx context.Context, sink deleteSink, spec deleteSpec[T
 		return syscall.ENOENT
 	}
 
+	// Under mount.confirm_deletes the rest of the delete waits for its token
+	// (deleteconfirm.go); the staged tail runs later on the .confirm write's ctx.
+	if c, ok := sink.(deleteConfirmer); ok {
+		msg, held := c.holdDelete(spec.op, func(ctx context.Context) syscall.Errno {
+			ctx, cancel := context.WithTimeout(ctx, createTimeout)
+			defer cancel()
+			return finishDelete(ctx, sink, spec, target)
+		})
+		if held {
+			sink.SetWriteError(spec.key, msg)
+			return syscall.EPERM
+		}
+	}
+	return finishDelete(ctx, sink, spec, target)
+}
+
+// finishDelete is commitDelete past find: mutate, forget, and re-coher.
+func finishDelete[T any](ctx context.Context, sink deleteSink, spec deleteSpec[T], target *T) syscall.Errno {
 	if err := spec.mutate(ctx, target); err != nil {
 		if !remoteAlreadyGone(err) {
-			var msg string
-			msg, errno = classifyMutationErr(spec.op, err)
+			msg, errno := classifyMutationErr(spec.op, err)
 			log.Printf("Failed to %s: %v", spec.op, err)
 			sink.SetWriteError(spec.key, msg)
 			return errno
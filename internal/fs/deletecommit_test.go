@@ -224,3 +224,43 @@ func TestCommitDelete_RemoteAlreadyGone(t *testing.T) {
 		t.Errorf("InvalidateDeleted calls = %d, want 1", sink.invalidates)
 	}
 }
+
+// confirmingDeleteSink holds every delete, as the mount does under
+// mount.confirm_deletes; run is the staged remainder.
+type confirmingDeleteSink struct {
+	fakeDeleteSink
+	run func(context.Context) syscall.Errno
+}
+
+func (f *confirmingDeleteSink) holdDelete(op string, run func(context.Context) syscall.Errno) (string, bool) {
+	f.run = run
+	return "confirm " + op, true
+}
+
+// TestCommitDelete_HeldForConfirmation: a confirming sink stops the delete
+// after find — EPERM, the hold message in .error, nothing mutated — and the
+// staged remainder runs the normal tail.
+func TestCommitDelete_HeldForConfirmation(t *testing.T) {
+	sink := &confirmingDeleteSink{}
+	mutations, forgets, extras := 0, 0, 0
+
+	errno := commitDelete(context.Background(), sink, okDeleteSpec(&ent{title: "x"}, &mutations, &forgets, &extras))
+
+	if errno != syscall.EPERM {
+		t.Fatalf("errno = %v, want EPERM", errno)
+	}
+	if mutations != 0 || forgets != 0 || sink.invalidates != 0 {
+		t.Errorf("held delete ran: mutations=%d forgets=%d invalidates=%d", mutations, forgets, sink.invalidates)
+	}
+	if sink.setKey != "K" || sink.setMsg != "confirm delete ent" {
+		t.Errorf(".error = %q/%q, want the hold message", sink.setKey, sink.setMsg)
+	}
+
+	if errno := sink.run(context.Background()); errno != 0 {
+		t.Fatalf("confirmed run errno = %v, want 0", errno)
+	}
+	if mutations != 1 || forgets != 1 || extras != 1 || sink.invalidates != 1 {
+		t.Errorf("after confirm: mutations=%d forgets=%d extras=%d invalidates=%d, want 1 each",
+			mutations, forgets, extras, sink.invalidates)
+	}
+}
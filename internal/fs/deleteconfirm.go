@@ -0,0 +1,113 @@
+package fs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	gosync "sync"
+	"syscall"
+	"time"
+)
+
+// Confirmed deletes (mount.confirm_deletes).
+//
+// On a shared mount a script with a bad glob can archive or delete a whole
+// directory's worth of entities before anyone notices. With confirmation on,
+// every delete surface — anything that goes through commitDelete: rm of a
+// comment/doc/label/…, rmdir of an issue or project — stops after locating
+// its target: the operation is staged under a random token and refused with
+// EPERM, and the surface's .error names the token. Writing that token to the
+// mount's root .confirm file within the window runs the staged operation
+// (its outcome is the write's errno and the surface's .error, exactly as an
+// unconfirmed delete would report). A script that never reads .error never
+// confirms anything; an expired token confirms nothing.
+
+// deleteConfirmer is the optional sink seam commitDelete checks: a sink that
+// implements it and reports held has staged run for confirmation, and msg is
+// the .error that says how to confirm it.
+type deleteConfirmer interface {
+	holdDelete(op string, run func(context.Context) syscall.Errno) (msg string, held bool)
+}
+
+// confirmName is the root trigger file a staged delete's token is written to.
+const confirmName = ".confirm"
+
+// stagedDelete is one delete awaiting its token.
+type stagedDelete struct {
+	run     func(context.Context) syscall.Errno
+	expires time.Time
+}
+
+// deleteConfirmations holds the staged deletes. A nil *deleteConfirmations
+// (confirm_deletes unset) stages nothing.
+type deleteConfirmations struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu     gosync.Mutex
+	staged map[string]stagedDelete // token -> staged delete
+}
+
+func newDeleteConfirmations(window time.Duration) *deleteConfirmations {
+	return &deleteConfirmations{window: window, now: time.Now, staged: make(map[string]stagedDelete)}
+}
+
+// stage records run under a fresh token, dropping any expired entries.
+func (c *deleteConfirmations) stage(run func(context.Context) syscall.Errno) (string, error) {
+	var b [3]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b[:])
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for t, s := range c.staged {
+		if !now.Before(s.expires) {
+			delete(c.staged, t)
+		}
+	}
+	c.staged[token] = stagedDelete{run: run, expires: now.Add(c.window)}
+	return token, nil
+}
+
+// take removes and returns the delete staged under token, if it has not
+// expired. A token confirms at most once.
+func (c *deleteConfirmations) take(token string) (stagedDelete, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.staged[token]
+	delete(c.staged, token)
+	if !ok || !c.now().Before(s.expires) {
+		return stagedDelete{}, false
+	}
+	return s, true
+}
+
+// holdDelete implements deleteConfirmer for the mount.
+func (lfs *LinearFS) holdDelete(op string, run func(context.Context) syscall.Errno) (string, bool) {
+	if lfs.confirms == nil {
+		return "", false
+	}
+	token, err := lfs.confirms.stage(run)
+	if err != nil {
+		// Fail closed: without a token the delete cannot be confirmed, so it
+		// is refused rather than run unconfirmed.
+		return "Operation: " + op + "\nError: confirmation required (mount.confirm_deletes), but no token could be issued: " + err.Error(), true
+	}
+	return fmt.Sprintf("Operation: %s\nError: confirmation required (mount.confirm_deletes). To proceed, within %s run:\n  echo %s > %s/%s",
+		op, lfs.confirms.window, token, lfs.MountPoint(), confirmName), true
+}
+
+// confirmDelete is the root .confirm trigger's onFlush: run the delete staged
+// under the written token.
+func (lfs *LinearFS) confirmDelete(ctx context.Context, content []byte) syscall.Errno {
+	token := strings.TrimSpace(string(content))
+	staged, ok := lfs.confirms.take(token)
+	if !ok {
+		return syscall.ENOENT
+	}
+	return staged.run(ctx)
+}
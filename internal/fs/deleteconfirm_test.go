@@ -0,0 +1,65 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestDeleteConfirmations_TokenOnceWithinWindow: a token runs its delete at
+// most once, and not at all after the window closes.
+func TestDeleteConfirmations_TokenOnceWithinWindow(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 7, 6, 12, 0, 0, 0, time.UTC)
+	c := newDeleteConfirmations(30 * time.Second)
+	c.now = func() time.Time { return now }
+	noop := func(context.Context) syscall.Errno { return 0 }
+
+	token, err := c.stage(noop)
+	if err != nil {
+		t.Fatalf("stage: %v", err)
+	}
+	if _, ok := c.take("bogus"); ok {
+		t.Error("an unknown token confirmed a delete")
+	}
+	if _, ok := c.take(token); !ok {
+		t.Fatal("a fresh token did not confirm")
+	}
+	if _, ok := c.take(token); ok {
+		t.Error("a token confirmed twice")
+	}
+
+	late, _ := c.stage(noop)
+	now = now.Add(30 * time.Second)
+	if _, ok := c.take(late); ok {
+		t.Error("an expired token confirmed")
+	}
+}
+
+// TestConfirmDelete_RunsStagedDelete: the mount's hold message names a token
+// that, written to .confirm, runs the staged delete.
+func TestConfirmDelete_RunsStagedDelete(t *testing.T) {
+	t.Parallel()
+	lfs := testLFS(t)
+	lfs.confirms = newDeleteConfirmations(time.Minute)
+
+	ran := 0
+	msg, held := lfs.holdDelete(`archive issue "TST-1"`, func(context.Context) syscall.Errno {
+		ran++
+		return 0
+	})
+	if !held || !strings.Contains(msg, "/.confirm") {
+		t.Fatalf("holdDelete = %q, %v; want a held delete naming .confirm", msg, held)
+	}
+	fields := strings.Fields(msg[strings.Index(msg, "echo "):])
+	token := fields[1]
+
+	if errno := lfs.confirmDelete(context.Background(), []byte("nope\n")); errno != syscall.ENOENT {
+		t.Errorf("wrong token errno = %v, want ENOENT", errno)
+	}
+	if errno := lfs.confirmDelete(context.Background(), []byte(token+"\n")); errno != 0 || ran != 1 {
+		t.Errorf("confirm errno = %v, ran = %d; want 0, 1", errno, ran)
+	}
+}
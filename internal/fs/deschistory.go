@@ -0,0 +1,85 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// diffLatestName is the history/ entry holding the newest description change.
+const diffLatestName = "diff-latest.patch"
+
+// DescriptionHistoryNode is issues/{ID}/history/: the issue's description as
+// sync has observed it, one {timestamp}.md per version (the newest
+// db.DescriptionVersionsKept), plus diff-latest.patch, the last change as a
+// unified diff. Linear exposes no description history, so it starts when the
+// mount first sees the issue. Read-only; contrast history.md, Linear's own
+// activity log.
+type DescriptionHistoryNode struct {
+	attrNode
+	issueID string
+}
+
+var _ fs.NodeReaddirer = (*DescriptionHistoryNode)(nil)
+var _ fs.NodeLookuper = (*DescriptionHistoryNode)(nil)
+var _ fs.NodeGetattrer = (*DescriptionHistoryNode)(nil)
+
+func (n *DescriptionHistoryNode) versions(ctx context.Context) []api.DescriptionVersion {
+	versions, err := n.lfs.repo.GetIssueDescriptionVersions(ctx, n.issueID)
+	if err != nil {
+		log.Printf("Failed to list description versions for %s: %v", n.issueID, err)
+	}
+	return versions
+}
+
+func (n *DescriptionHistoryNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	versions := n.versions(ctx)
+	entries := make([]fuse.DirEntry, 0, len(versions)+1)
+	for _, v := range versions {
+		entries = append(entries, fuse.DirEntry{Name: descriptionVersionName(v.ObservedAt), Mode: syscall.S_IFREG})
+	}
+	entries = append(entries, fuse.DirEntry{Name: diffLatestName, Mode: syscall.S_IFREG})
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *DescriptionHistoryNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if name == diffLatestName {
+		// Rendered from the versions on each read, so it follows new ones.
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			return diffLatest(n.versions(ctx))
+		}, descriptionVersionIno(n.issueID, name), inheritTimeout), 0
+	}
+	for _, v := range n.versions(ctx) {
+		if descriptionVersionName(v.ObservedAt) == name {
+			return n.lookupRenderFile(ctx, out, name, func(context.Context) ([]byte, time.Time, time.Time) {
+				return []byte(v.Description), v.ObservedAt, v.ObservedAt
+			}, descriptionVersionIno(n.issueID, name), inheritTimeout), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
+
+// diffLatest renders diff-latest.patch from the versions, oldest first: the
+// last one against the one before, timed at the last. A single version has no
+// change to show and renders empty.
+func diffLatest(versions []api.DescriptionVersion) ([]byte, time.Time, time.Time) {
+	if len(versions) < 2 {
+		return nil, time.Time{}, time.Time{}
+	}
+	prev, last := versions[len(versions)-2], versions[len(versions)-1]
+	patch := marshal.UnifiedDiff(descriptionVersionName(prev.ObservedAt), descriptionVersionName(last.ObservedAt),
+		prev.Description, last.Description)
+	return patch, last.ObservedAt, last.ObservedAt
+}
+
+// descriptionVersionName is a version's filename: its time in UTC, with the
+// colons of RFC 3339 swapped for dashes so every tool takes the name.
+func descriptionVersionName(at time.Time) string {
+	return at.UTC().Format("2006-01-02T15-04-05Z") + ".md"
+}
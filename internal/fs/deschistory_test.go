@@ -0,0 +1,44 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestDescriptionHistoryDir: history/ lists one file per recorded version,
+// oldest first, then diff-latest.patch with the newest change.
+func TestDescriptionHistoryDir(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	t1 := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	t2 := t1.Add(90 * time.Minute)
+	for _, v := range []api.DescriptionVersion{{ObservedAt: t1, Description: "Steps:\n1. log in\n"}, {ObservedAt: t2, Description: "Steps:\n1. log in\n2. click save\n"}} {
+		if err := store.RecordDescriptionVersion(ctx, "issue-1", v.Description, v.ObservedAt); err != nil {
+			t.Fatalf("RecordDescriptionVersion: %v", err)
+		}
+	}
+
+	node := &DescriptionHistoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issueID: "issue-1"}
+	want := []string{"2026-10-01T09-00-00Z.md", "2026-10-01T10-30-00Z.md", "diff-latest.patch"}
+	if got := readdirNames(t, node); !slices.Equal(got, want) {
+		t.Errorf("history/ = %v, want %v", got, want)
+	}
+
+	patch, mtime, _ := diffLatest(node.versions(ctx))
+	if !strings.Contains(string(patch), "--- 2026-10-01T09-00-00Z.md\n+++ 2026-10-01T10-30-00Z.md\n") ||
+		!strings.Contains(string(patch), "\n+2. click save\n") {
+		t.Errorf("diff-latest.patch =\n%s", patch)
+	}
+	if !mtime.Equal(t2) {
+		t.Errorf("diff-latest.patch mtime = %v, want %v", mtime, t2)
+	}
+	if patch, _, _ := diffLatest(node.versions(ctx)[:1]); patch != nil {
+		t.Errorf("single version diff = %q, want empty", patch)
+	}
+}
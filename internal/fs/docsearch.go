@@ -0,0 +1,223 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// docSearchLimit caps one docs/search/{query}/ listing, best matches first.
+const docSearchLimit = 50
+
+// WorkspaceDocsNode is the root docs/ directory. Its files are the standalone
+// documents — owned by no issue, team, project, or initiative — as
+// read/write {slug}.md files with .meta sidecars, exactly like an owner's
+// docs/; owned documents live under their owner. Beside them sit the
+// workspace-wide search/ and the new.md create surface.
+// Stateless container: zero times; Getattr comes from the attrNode mixin.
+type WorkspaceDocsNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*WorkspaceDocsNode)(nil)
+var _ fs.NodeLookuper = (*WorkspaceDocsNode)(nil)
+var _ fs.NodeCreater = (*WorkspaceDocsNode)(nil)
+var _ fs.NodeUnlinker = (*WorkspaceDocsNode)(nil)
+var _ fs.NodeRenamer = (*WorkspaceDocsNode)(nil)
+var _ fs.NodeGetattrer = (*WorkspaceDocsNode)(nil)
+
+// collection is the standalone documents' item-file surface. The trio has
+// no _create: new.md is the root's create surface, and it needs an owner.
+func (n *WorkspaceDocsNode) collection() collectionDir[api.Document] {
+	return documentCollection(n, n.lfs, collectionTrio{kind: "docs", parentID: workspaceDocsParent},
+		n.lfs.repo.GetStandaloneDocuments, n.newDocumentInode)
+}
+
+// newDocumentInode mounts a standalone document's read/write file (no owner).
+func (n *WorkspaceDocsNode) newDocumentInode(ctx context.Context, name string, doc api.Document, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	return n.mountDocumentFile(ctx, name, &DocumentFileNode{document: doc}, out)
+}
+
+func (n *WorkspaceDocsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	c := n.collection()
+	// A fetch error degrades to the fixed entries, as collectionDir.readdir does.
+	docs, err := c.fetch(ctx)
+	if err != nil {
+		docs = nil
+	}
+	entries := []fuse.DirEntry{
+		{Name: "search", Mode: syscall.S_IFDIR},
+		{Name: newDocName, Mode: syscall.S_IFREG},
+	}
+	return fs.NewListDirStream(append(entries, c.entries(docs)...)), 0
+}
+
+// Lookup serves search/, the create surface — new.md takes a document whose
+// frontmatter names its owner (createWorkspaceDocument) — and the standalone
+// documents with .error/.last.
+func (n *WorkspaceDocsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	switch name {
+	case newDocName:
+		return n.lfs.lookupTriggerFile(ctx, n, n.lfs.createWorkspaceDocument, out), 0
+	case "search":
+		node := &DocSearchNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}}
+		return n.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), docSearchDirIno(), inheritTimeout), 0
+	}
+	return n.collection().lookup(ctx, name, out)
+}
+
+// Create overwrites a standalone document saved over by name; any other name
+// creates through new.md's owner-from-frontmatter path.
+func (n *WorkspaceDocsNode) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	return n.collection().create(ctx, name, flags, out, n.lfs.createWorkspaceDocument)
+}
+
+func (n *WorkspaceDocsNode) Unlink(ctx context.Context, name string) syscall.Errno {
+	return n.collection().unlink(ctx, name)
+}
+
+// Rename retitles a standalone document, as DocsNode.Rename does for an
+// owner's.
+func (n *WorkspaceDocsNode) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	return commitRename(ctx, n.lfs, name, newParent, newName, renameSpec[api.Document]{
+		kind:   "document",
+		errKey: workspaceDocsKey,
+		dirIno: docsDirIno(workspaceDocsParent),
+		find:   func(ctx context.Context) (*api.Document, error) { return n.collection().resolve(ctx, name) },
+		mutate: func(ctx context.Context, target *api.Document, newName string) (*api.Document, error) {
+			return n.lfs.UpdateDocument(ctx, target.ID, map[string]any{"title": newName}, "", "", "")
+		},
+		persist: func(ctx context.Context, fresh *api.Document) error {
+			return n.lfs.UpsertDocument(ctx, *fresh)
+		},
+	})
+}
+
+// DocSearchNode is docs/search/. It lists nothing — queries are not
+// enumerable — but any name looks up as a query directory: `ls
+// docs/search/onboarding/` searches for "onboarding".
+type DocSearchNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*DocSearchNode)(nil)
+var _ fs.NodeLookuper = (*DocSearchNode)(nil)
+var _ fs.NodeGetattrer = (*DocSearchNode)(nil)
+
+func (n *DocSearchNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	return fs.NewListDirStream(nil), 0
+}
+
+func (n *DocSearchNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	node := &DocSearchResultsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, query: name}
+	return n.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), docSearchResultsIno(name), inheritTimeout), 0
+}
+
+// DocSearchResultsNode is docs/search/{query}/: the synced documents whose
+// title or content matches every term of query (full-text, prefix-matched),
+// as symlinks to each document's file in its home docs/ directory. Results
+// are re-run against SQLite on every listing.
+type DocSearchResultsNode struct {
+	attrNode
+	query string
+}
+
+var _ fs.NodeReaddirer = (*DocSearchResultsNode)(nil)
+var _ fs.NodeLookuper = (*DocSearchResultsNode)(nil)
+var _ fs.NodeGetattrer = (*DocSearchResultsNode)(nil)
+
+func (n *DocSearchResultsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	docs, err := n.lfs.repo.SearchDocuments(ctx, n.query, docSearchLimit)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	names := docSearchNames(docs)
+	entries := make([]fuse.DirEntry, len(names))
+	for i, name := range names {
+		entries[i] = fuse.DirEntry{Name: name, Mode: syscall.S_IFLNK}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *DocSearchResultsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	docs, err := n.lfs.repo.SearchDocuments(ctx, n.query, docSearchLimit)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for i, linkName := range docSearchNames(docs) {
+		if linkName != name {
+			continue
+		}
+		doc := docs[i]
+		home, errno := documentHomePath(ctx, n.lfs, doc)
+		if errno != 0 {
+			return nil, errno
+		}
+		// From docs/search/{query}/ go up 3 levels to the mount root.
+		return n.newSymlinkInode(ctx, out, "../../../"+home, doc.CreatedAt, doc.UpdatedAt), 0
+	}
+	return nil, syscall.ENOENT
+}
+
+// docSearchNames are the result links' names, in the order given: each
+// document's documentFilename, with names two matches share (documents from
+// different owners can share a slug-less title) made unique by uniqueNames.
+func docSearchNames(docs []api.Document) []string {
+	names := uniqueNames(docs, func(d api.Document) (string, string) {
+		return strings.TrimSuffix(documentFilename(d), ".md"), d.ID
+	})
+	for i := range names {
+		names[i] += ".md"
+	}
+	return names
+}
+
+// documentHomePath returns a document's file path relative to the mount root,
+// under its owner's docs/ directory, taking owners in docParentID's precedence
+// (issue, team, project, initiative). Every component is a remote string, so
+// each passes through safeName (or a dir-name helper that ends in it). An
+// owner sync has not caught up with yet is ENOENT, as for other symlinks.
+func documentHomePath(ctx context.Context, lfs *LinearFS, doc api.Document) (string, syscall.Errno) {
+	file := documentFilename(doc)
+	switch {
+	case doc.Issue != nil:
+		issue, err := lfs.repo.GetIssueByID(ctx, doc.Issue.ID)
+		if err != nil {
+			return "", syscall.EIO
+		}
+		if issue == nil || issue.Team == nil {
+			return "", syscall.ENOENT
+		}
+		return fmt.Sprintf("teams/%s/issues/%s/docs/%s",
+			safeName(issue.Team.Key, issue.Team.ID), safeName(issue.Identifier, issue.ID), file), 0
+	case doc.Team != nil:
+		return fmt.Sprintf("teams/%s/docs/%s", safeName(doc.Team.Key, doc.Team.ID), file), 0
+	case doc.Project != nil:
+		project, err := lfs.repo.GetProjectByID(ctx, doc.Project.ID)
+		if err != nil {
+			return "", syscall.EIO
+		}
+		if project == nil {
+			return "", syscall.ENOENT
+		}
+		teamKey, err := lfs.repo.GetProjectPrimaryTeamKey(ctx, project.ID)
+		if err != nil {
+			return "", syscall.EIO
+		}
+		if teamKey == "" {
+			return "", syscall.ENOENT
+		}
+		return fmt.Sprintf("teams/%s/projects/%s/docs/%s",
+			safeName(teamKey, project.ID), projectDirName(*project), file), 0
+	case doc.Initiative != nil:
+		return fmt.Sprintf("initiatives/%s/docs/%s", initiativeDirName(*doc.Initiative), file), 0
+	default:
+		return "", syscall.ENOENT
+	}
+}
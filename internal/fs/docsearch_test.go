@@ -0,0 +1,74 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// TestDocSearchResults pins docs/search/{query}/: matches list by their docs/
+// filename, and each resolves to its owner's docs/ directory three levels up.
+func TestDocSearchResults(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, store := linkTestLFS(t)
+
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test"}
+	issue := fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-1", "TST-1"), fixtures.WithTeam(&team))
+	if err := fixtures.PopulateTeam(ctx, store, team, nil, nil, []api.Issue{issue}); err != nil {
+		t.Fatalf("populate team: %v", err)
+	}
+	if err := fixtures.PopulateProject(ctx, store, api.Project{ID: "project-1", Name: "Launch", Slug: "launch"}, team.ID); err != nil {
+		t.Fatalf("populate project: %v", err)
+	}
+
+	teamDoc := fixtures.FixtureAPITeamDocument(team.ID, 1)
+	teamDoc.Team = &team
+	teamDoc.Content = "Runbook for the rollout."
+	issueDoc := fixtures.FixtureAPIIssueDocument(issue.ID, 1)
+	issueDoc.Content = "Rollout checklist."
+	projectDoc := fixtures.FixtureAPIProjectDocument("project-1", 1)
+	projectDoc.Content = "Rollout plan."
+	initiativeDoc := api.Document{ID: "doc-init", SlugID: "init-doc", Title: "Strategy", Content: "Rollout strategy.",
+		Initiative: &api.Initiative{ID: "init-1", Name: "Grow Revenue"}}
+	other := fixtures.FixtureAPITeamDocument(team.ID, 2)
+	other.Content = "Unrelated."
+	if err := fixtures.PopulateDocuments(ctx, store, []api.Document{teamDoc, issueDoc, projectDoc, initiativeDoc, other}); err != nil {
+		t.Fatalf("populate documents: %v", err)
+	}
+
+	node := &DocSearchResultsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, query: "rollout"}
+	stream, errno := node.Readdir(ctx)
+	if errno != 0 {
+		t.Fatalf("Readdir errno = %v", errno)
+	}
+	listed := map[string]bool{}
+	for stream.HasNext() {
+		e, _ := stream.Next()
+		listed[e.Name] = true
+	}
+	if len(listed) != 4 || listed[documentFilename(other)] {
+		t.Errorf("listing = %v, want the four rollout documents", listed)
+	}
+
+	for doc, want := range map[*api.Document]string{
+		&teamDoc:       "teams/TST/docs/team-doc-team-1-1.md",
+		&issueDoc:      "teams/TST/issues/TST-1/docs/issue-doc-issue-1-1.md",
+		&projectDoc:    "teams/TST/projects/launch/docs/project-doc-project-1-1.md",
+		&initiativeDoc: "initiatives/grow-revenue/docs/init-doc.md",
+	} {
+		got, errno := documentHomePath(ctx, lfs, *doc)
+		if errno != 0 || got != want {
+			t.Errorf("documentHomePath(%s) = %q, %v; want %q", doc.ID, got, errno, want)
+		}
+	}
+
+	// An issue document whose issue has not synced yet has no home: ENOENT.
+	orphan := fixtures.FixtureAPIIssueDocument("issue-unsynced", 1)
+	if _, errno := documentHomePath(ctx, lfs, orphan); errno != syscall.ENOENT {
+		t.Errorf("unsynced owner errno = %v, want ENOENT", errno)
+	}
+}
@@ -52,8 +52,9 @@ func (n *DocsNode) parentID() string {
 }
 
 // docParentID returns the single non-empty parent ID for a docs surface, in
-// precedence order (issue, team, project, initiative). Used both for kernel
-// cache inodes and as the parent for the docs/ .error key.
+// precedence order (issue, team, project, initiative), or workspaceDocsParent
+// for a standalone document (the root docs/). Used both for kernel cache
+// inodes and as the parent for the docs/ .error key.
 func docParentID(issueID, teamID, projectID, initiativeID string) string {
 	switch {
 	case issueID != "":
@@ -62,8 +63,10 @@ func docParentID(issueID, teamID, projectID, initiativeID string) string {
 		return teamID
 	case projectID != "":
 		return projectID
-	default:
+	case initiativeID != "":
 		return initiativeID
+	default:
+		return workspaceDocsParent
 	}
 }
 
@@ -75,22 +78,42 @@ func (n *DocsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 // refresh is nil: getDocuments already triggers MaybeRefreshIssueDetails for
 // issue docs internally.
 func (n *DocsNode) collection() collectionDir[api.Document] {
+	return documentCollection(n, n.lfs, n.trio(), n.getDocuments, n.newDocumentInode)
+}
+
+// documentCollection is the item-file surface every docs/ directory shares —
+// an owner's (DocsNode) and the root's standalone documents
+// (WorkspaceDocsNode). They differ only in the directory, its trio, the
+// fetch, and the owner a file is mounted under (buildFile).
+func documentCollection(parent fs.InodeEmbedder, lfs *LinearFS, trio collectionTrio, fetch func(context.Context) ([]api.Document, error),
+	buildFile func(context.Context, string, api.Document, *fuse.EntryOut) (*fs.Inode, syscall.Errno)) collectionDir[api.Document] {
 	return collectionDir[api.Document]{
-		parent:       n,
-		lfs:          n.lfs,
-		trio:         n.trio(),
+		parent:       parent,
+		lfs:          lfs,
+		trio:         trio,
 		noun:         "document",
-		fetch:        n.getDocuments,
-		listing:      func(items []api.Document) collectionListing[api.Document] { return n.listing(items) },
+		fetch:        fetch,
+		listing:      func(items []api.Document) collectionListing[api.Document] { return documentListing(items) },
 		idOf:         func(d api.Document) string { return d.ID },
-		buildFile:    n.newDocumentInode,
+		buildFile:    buildFile,
 		metaMarshal:  marshal.DocumentMetaToMarkdown,
 		metaTimes:    func(d api.Document) (time.Time, time.Time) { return d.UpdatedAt, d.CreatedAt },
 		metaIno:      func(d api.Document) uint64 { return documentMetaIno(d.ID) },
-		deleteMutate: func(ctx context.Context, d *api.Document) error { return n.lfs.mutator().DeleteDocument(ctx, d.ID) },
+		deleteMutate: func(ctx context.Context, d *api.Document) error { return lfs.mutator().DeleteDocument(ctx, d.ID) },
 		deleteForget: func(ctx context.Context, d *api.Document) error {
-			return n.lfs.store.Queries().DeleteDocument(ctx, d.ID)
+			return lfs.store.Queries().DeleteDocument(ctx, d.ID)
 		},
+		// {slug}.backlinks.md: the cached texts that link to the document's
+		// URL, read from the mention index sync maintains.
+		backlinksMarshal: func(ctx context.Context, d *api.Document) []byte {
+			links, err := lfs.repo.GetDocumentBacklinks(ctx, *d)
+			if err != nil {
+				log.Printf("Failed to read backlinks for document %s: %v", d.ID, err)
+				return nil
+			}
+			return marshal.BacklinksToMarkdown(d.Title, links)
+		},
+		backlinksIno: func(d api.Document) uint64 { return documentBacklinksIno(d.ID) },
 	}
 }
 
@@ -100,10 +123,10 @@ func (n *DocsNode) trio() collectionTrio {
 	return collectionTrio{kind: "docs", parentID: n.parentID(), onFlush: n.createDocument("")}
 }
 
-// listing declares the docs collection's item files: one per document, named by
-// documentFilename. Backs Readdir/Lookup/Unlink/Rename/Create-overwrite so they
-// derive and match names through one place. See namedListing.
-func (n *DocsNode) listing(docs []api.Document) namedListing[api.Document] {
+// documentListing declares a docs collection's item files: one per document,
+// named by documentFilename. Backs Readdir/Lookup/Unlink/Rename/Create-overwrite
+// so they derive and match names through one place. See namedListing.
+func documentListing(docs []api.Document) namedListing[api.Document] {
 	return namedListing[api.Document]{items: docs, nameOf: documentFilename}
 }
 
@@ -139,26 +162,35 @@ func (n *DocsNode) Rename(ctx context.Context, name string, newParent fs.InodeEm
 // newDocumentInode builds the read/write DocumentFileNode inode for an existing
 // document, populated with its current content. Shared by Lookup and Create.
 func (n *DocsNode) newDocumentInode(ctx context.Context, name string, doc api.Document, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
-	content, err := marshal.DocumentToMarkdown(&doc)
-	if err != nil {
-		log.Printf("Failed to marshal document: %v", err)
-		return nil, syscall.EIO
-	}
-	node := &DocumentFileNode{
-		BaseNode:     BaseNode{lfs: n.lfs},
+	return n.mountDocumentFile(ctx, name, &DocumentFileNode{
 		document:     doc,
 		issueID:      n.issueID,
 		teamID:       n.teamID,
 		projectID:    n.projectID,
 		initiativeID: n.initiativeID,
-		editBuffer:   editBuffer{content: content},
+	}, out)
+}
+
+// mountDocumentFile renders node's document into its edit buffer and mounts
+// it as name under the directory b. node carries the document and its owner.
+func (b *BaseNode) mountDocumentFile(ctx context.Context, name string, node *DocumentFileNode, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	content, err := marshal.DocumentToMarkdown(&node.document)
+	if err != nil {
+		log.Printf("Failed to marshal document: %v", err)
+		return nil, syscall.EIO
 	}
+	node.BaseNode = BaseNode{lfs: b.lfs}
+	node.editBuffer = editBuffer{content: content}
 	// Shorter timeout for writable files.
-	return n.newFileInode(ctx, out, name, node, fileAttr(len(content), doc.CreatedAt, doc.UpdatedAt), documentIno(doc.ID), 5*time.Second), 0
+	return b.newFileInode(ctx, out, name, node, fileAttr(len(content), node.document.CreatedAt, node.document.UpdatedAt), documentIno(node.document.ID), 5*time.Second), 0
 }
 
 func (n *DocsNode) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
-	// The user-chosen filename feeds the title fallback (createDocument(name)).
+	// The user-chosen filename feeds the title fallback (createDocument(name)),
+	// except new.md: the title comes from the content alone.
+	if name == newDocName {
+		return n.collection().create(ctx, name, flags, out, n.createDocument(""))
+	}
 	return n.collection().create(ctx, name, flags, out, n.createDocument(name))
 }
 
@@ -272,37 +304,22 @@ func (n *DocumentFileNode) Flush(ctx context.Context, f fs.FileHandle) syscall.E
 	})
 }
 
+// newDocName is the create-by-write name every docs/ directory accepts:
+// `cat > docs/new.md` creates a document titled by its frontmatter (or first
+// heading) — never "new" — and the server-assigned {slug}.md appears at once,
+// with the slug reported in .last.
+const newDocName = "new.md"
+
 // createDocument returns the docs create surface's onFlush for one write
 // cycle. filename is the user-chosen name from a named Create ("" for the
-// _create trigger); it becomes the title fallback when the content carries no
-// '# Title' heading.
+// _create trigger and new.md); it becomes the title fallback when the content
+// carries no '# Title' heading.
 func (n *DocsNode) createDocument(filename string) func(ctx context.Context, content []byte) syscall.Errno {
 	return func(ctx context.Context, content []byte) syscall.Errno {
 		parentID := n.parentID()
-		_, errno := commitCreate(ctx, n.lfs, createSpec[api.Document]{
-			op:  "create document",
-			key: collectionErrorKey("docs", parentID),
-			mutate: func(ctx context.Context) (*api.Document, error) {
-				title, body, err := marshal.ParseNewDocument(content)
-				if err != nil {
-					return nil, &FieldError{Field: "content", Message: "parse error: " + err.Error()}
-				}
-				// If no title in content, use filename: remove .md, replace
-				// dashes with spaces.
-				if title == "" || title == "Untitled" {
-					if filename != "" {
-						title = strings.TrimSuffix(filename, ".md")
-						title = strings.ReplaceAll(title, "-", " ")
-					}
-				}
-				if title == "" {
-					return nil, &FieldError{Field: "title", Message: "document has no title. Add a '# Title' heading or name the file <title>.md."}
-				}
-
-				input := map[string]any{
-					"title":   title,
-					"content": body,
-				}
+		spec := documentCreateSpec(n.lfs, collectionErrorKey("docs", parentID), docsDirIno(parentID), filename, content,
+			func(context.Context) (map[string]any, error) {
+				input := map[string]any{}
 				if n.issueID != "" {
 					input["issueId"] = n.issueID
 				}
@@ -315,21 +332,158 @@ func (n *DocsNode) createDocument(filename string) func(ctx context.Context, con
 				if n.initiativeID != "" {
 					input["initiativeId"] = n.initiativeID
 				}
-				return n.lfs.mutator().CreateDocument(ctx, input)
-			},
-			result: func(d *api.Document) WriteResult {
-				return WriteResult{
-					URL:   d.URL,
-					Path:  documentFilename(*d),
-					Title: d.Title,
+				return input, nil
+			})
+		_, errno := commitCreate(ctx, n.lfs, spec)
+		return errno
+	}
+}
+
+// documentCreateSpec is the createSpec shared by every document create
+// surface: a docs/ directory's _create, named files, and new.md, and the
+// workspace docs/new.md. owner returns the owner half of the API input (the
+// parent ID field); everything else — title/body parsing, the .last entry,
+// the persist, and the {slug}.md entry in the owner's docs/ — is invariant.
+func documentCreateSpec(lfs *LinearFS, key string, dir uint64, filename string, content []byte, owner func(context.Context) (map[string]any, error)) createSpec[api.Document] {
+	return createSpec[api.Document]{
+		op:  "create document",
+		key: key,
+		mutate: func(ctx context.Context) (*api.Document, error) {
+			title, body, err := marshal.ParseNewDocument(content)
+			if err != nil {
+				return nil, &FieldError{Field: "content", Message: "parse error: " + err.Error()}
+			}
+			// If no title in content, use filename: remove .md, replace
+			// dashes with spaces.
+			if title == "" || title == "Untitled" {
+				if filename != "" {
+					title = strings.TrimSuffix(filename, ".md")
+					title = strings.ReplaceAll(title, "-", " ")
 				}
-			},
-			persist: func(ctx context.Context, d *api.Document) error {
-				return n.lfs.UpsertDocument(ctx, *d)
-			},
-			dir:       docsDirIno(parentID),
-			entryName: func(d *api.Document) string { return documentFilename(*d) },
+			}
+			if title == "" {
+				return nil, &FieldError{Field: "title", Message: "document has no title. Add a '# Title' heading or name the file <title>.md."}
+			}
+
+			input, err := owner(ctx)
+			if err != nil {
+				return nil, err
+			}
+			input["title"] = title
+			input["content"] = body
+			return lfs.mutator().CreateDocument(ctx, input)
+		},
+		result: func(d *api.Document) WriteResult {
+			return WriteResult{
+				Identifier: d.SlugID,
+				URL:        d.URL,
+				Path:       documentFilename(*d),
+				Title:      d.Title,
+			}
+		},
+		persist: func(ctx context.Context, d *api.Document) error {
+			return lfs.UpsertDocument(ctx, *d)
+		},
+		dir:       dir,
+		entryName: func(d *api.Document) string { return documentFilename(*d) },
+		invalidateExtra: func(d *api.Document) {
+			// new.md is a write trigger, not the document: drop its entry so
+			// the next write looks it up afresh.
+			lfs.InvalidateDeleted(dir, newDocName)
+		},
+	}
+}
+
+// workspaceDocsParent is the docParentID of the root docs/ directory, which
+// owns the standalone documents.
+const workspaceDocsParent = "workspace"
+
+// workspaceDocsKey is the .error/.last key of the root docs/ directory.
+var workspaceDocsKey = collectionErrorKey("docs", workspaceDocsParent)
+
+// createWorkspaceDocument is the root docs/new.md onFlush: the frontmatter
+// names the owner (team key, project slug or name, or initiative name), and
+// the document lands in that owner's docs/ directory.
+func (lfs *LinearFS) createWorkspaceDocument(ctx context.Context, content []byte) syscall.Errno {
+	spec := documentCreateSpec(lfs, workspaceDocsKey, docsDirIno(workspaceDocsParent), "", content,
+		func(ctx context.Context) (map[string]any, error) {
+			owner, err := marshal.ParseNewDocumentOwner(content)
+			if err != nil {
+				return nil, err
+			}
+			return lfs.resolveDocumentOwner(ctx, owner)
 		})
-		return errno
+	// The entry appears in the owner's docs/, not the root's.
+	spec.invalidateExtra = func(d *api.Document) {
+		lfs.InvalidateCreated(docsDirIno(documentParentID(*d)), documentFilename(*d))
+	}
+	_, errno := commitCreate(ctx, lfs, spec)
+	return errno
+}
+
+// documentParentID is docParentID over a document's own owner edges.
+func documentParentID(d api.Document) string {
+	var issueID, teamID, projectID, initiativeID string
+	if d.Issue != nil {
+		issueID = d.Issue.ID
+	}
+	if d.Team != nil {
+		teamID = d.Team.ID
+	}
+	if d.Project != nil {
+		projectID = d.Project.ID
+	}
+	if d.Initiative != nil {
+		initiativeID = d.Initiative.ID
+	}
+	return docParentID(issueID, teamID, projectID, initiativeID)
+}
+
+// resolveDocumentOwner turns a parsed owner into the documentCreate input's
+// parent field. A project resolves by slug first, then by name, across every
+// team's projects (the frontmatter names no team).
+func (lfs *LinearFS) resolveDocumentOwner(ctx context.Context, owner marshal.DocumentOwner) (map[string]any, error) {
+	switch {
+	case owner.Team != "":
+		teams, err := lfs.repo.GetTeams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		id, err := resolveByName(teams, owner.Team, "team",
+			func(t api.Team) string { return t.Key /* safename:ok resolution key */ }, func(t api.Team) string { return t.ID })
+		if err != nil {
+			return nil, &FieldError{Field: "team", Value: owner.Team, Message: err.Error()}
+		}
+		return map[string]any{"teamId": id}, nil
+	case owner.Project != "":
+		teams, err := lfs.repo.GetTeams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var projects []api.Project
+		for _, team := range teams {
+			tp, err := lfs.repo.GetTeamProjects(ctx, team.ID)
+			if err != nil {
+				return nil, err
+			}
+			projects = append(projects, tp...)
+		}
+		for _, p := range projects {
+			if p.Slug == owner.Project {
+				return map[string]any{"projectId": p.ID}, nil
+			}
+		}
+		id, err := resolveByName(projects, owner.Project, "project",
+			func(p api.Project) string { return p.Name /* safename:ok resolution key */ }, func(p api.Project) string { return p.ID })
+		if err != nil {
+			return nil, &FieldError{Field: "project", Value: owner.Project, Message: err.Error()}
+		}
+		return map[string]any{"projectId": id}, nil
+	default:
+		id, err := lfs.ResolveInitiativeID(ctx, owner.Initiative)
+		if err != nil {
+			return nil, &FieldError{Field: "initiative", Value: owner.Initiative, Message: err.Error()}
+		}
+		return map[string]any{"initiativeId": id}, nil
 	}
 }
@@ -1,9 +1,13 @@
 package fs
 
 import (
+	"context"
+	"slices"
+	"syscall"
 	"testing"
 
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
 )
 
 func TestDocumentFilename(t *testing.T) {
@@ -115,9 +119,9 @@ func TestDocsNode_parentID(t *testing.T) {
 			wantID: "team-2",
 		},
 		{
-			name:   "empty node",
+			name:   "no owner is the root docs/ (standalone documents)",
 			node:   DocsNode{},
-			wantID: "",
+			wantID: workspaceDocsParent,
 		},
 	}
 
@@ -131,3 +135,93 @@ func TestDocsNode_parentID(t *testing.T) {
 		})
 	}
 }
+
+// TestCreateDocument_NewMD: docs/new.md takes its title from the frontmatter
+// (never "new"), and .last reports the server-assigned slug and {slug}.md.
+func TestCreateDocument_NewMD(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, _ := linkTestLFS(t)
+	docs := &DocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: "project-1"}
+
+	if errno := docs.createDocument("")(ctx, []byte("---\ntitle: Launch plan\n---\nSteps.")); errno != 0 {
+		t.Fatalf("create = %v", errno)
+	}
+	got, err := lfs.repo.GetProjectDocuments(ctx, "project-1")
+	if err != nil || len(got) != 1 || got[0].Title != "Launch plan" {
+		t.Fatalf("project docs = %+v (%v), want one titled Launch plan", got, err)
+	}
+	last := lfs.GetWriteSuccess(collectionSuccessKey("docs", "project-1"))
+	if len(last) != 1 || last[0].Identifier != got[0].SlugID || last[0].Path != documentFilename(got[0]) {
+		t.Errorf(".last = %+v, want slug %q and path %q", last, got[0].SlugID, documentFilename(got[0]))
+	}
+}
+
+// TestCreateWorkspaceDocument: the root docs/new.md files the document under
+// the owner its frontmatter names, and refuses content that names none.
+func TestCreateWorkspaceDocument(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, store := linkTestLFS(t)
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test"}
+	if err := fixtures.PopulateTeam(ctx, store, team, nil, nil, nil); err != nil {
+		t.Fatalf("populate team: %v", err)
+	}
+	if err := fixtures.PopulateProject(ctx, store, api.Project{ID: "project-1", Name: "Launch", Slug: "launch"}, team.ID); err != nil {
+		t.Fatalf("populate project: %v", err)
+	}
+
+	if errno := lfs.createWorkspaceDocument(ctx, []byte("---\ntitle: Runbook\nteam: TST\n---\n")); errno != 0 {
+		t.Fatalf("team create = %v", errno)
+	}
+	if got, _ := lfs.repo.GetTeamDocuments(ctx, team.ID); len(got) != 1 || got[0].Title != "Runbook" {
+		t.Errorf("team docs = %+v, want Runbook", got)
+	}
+	for _, project := range []string{"launch", "Launch"} {
+		if errno := lfs.createWorkspaceDocument(ctx, []byte("---\ntitle: Plan\nproject: "+project+"\n---\n")); errno != 0 {
+			t.Fatalf("project %q create = %v", project, errno)
+		}
+	}
+	if got, _ := lfs.repo.GetProjectDocuments(ctx, "project-1"); len(got) != 2 {
+		t.Errorf("project docs = %+v, want both (by slug and by name)", got)
+	}
+
+	if errno := lfs.createWorkspaceDocument(ctx, []byte("---\ntitle: Orphan\n---\n")); errno != syscall.EINVAL {
+		t.Errorf("ownerless create = %v, want EINVAL", errno)
+	}
+	if e := lfs.GetWriteError(workspaceDocsKey); e == nil {
+		t.Error("ownerless create left no .error")
+	}
+}
+
+// TestWorkspaceDocs_Standalone: the root docs/ lists only the documents no
+// issue, team, project, or initiative owns, beside search/ and new.md, and
+// rm of one forgets it.
+func TestWorkspaceDocs_Standalone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, store := linkTestLFS(t)
+	docs := fixtures.FixtureAPIDocuments(2)
+	docs[1].Team = &api.Team{ID: "team-1", Key: "TST"}
+	if err := fixtures.PopulateDocuments(ctx, store, docs); err != nil {
+		t.Fatalf("populate documents: %v", err)
+	}
+
+	node := &WorkspaceDocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}}
+	names := readdirNames(t, node)
+	file := documentFilename(docs[0])
+	want := []string{"search", newDocName, ".error", ".last", file, metaSidecarName(file), backlinksSiblingName(file)}
+	if !slices.Equal(names, want) {
+		t.Errorf("docs/ = %v, want %v", names, want)
+	}
+
+	if errno := node.Unlink(ctx, documentFilename(docs[0])); errno != 0 {
+		t.Fatalf("rm standalone document = %v", errno)
+	}
+	if got, _ := lfs.repo.GetStandaloneDocuments(ctx); len(got) != 0 {
+		t.Errorf("standalone documents after rm = %+v", got)
+	}
+	if got, _ := lfs.repo.GetTeamDocuments(ctx, "team-1"); len(got) != 1 {
+		t.Errorf("team document lost: %+v", got)
+	}
+}
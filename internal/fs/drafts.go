@@ -0,0 +1,288 @@
+package fs
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// draftPublishName is the rename target that posts a draft: `mv reply.md
+// publish` inside drafts/. It never exists as a file.
+const draftPublishName = "publish"
+
+// DraftsNode is /teams/{KEY}/issues/{ID}/drafts/: local-only comment drafts.
+// Any file created here is stored in SQLite (comment_drafts) and never sent to
+// Linear until it is renamed onto "publish", which posts its body as a comment
+// and removes the draft. Drafts are user data, not a cache — the sync worker
+// never touches them, so a long reply survives remounts while it is written.
+type DraftsNode struct {
+	attrNode
+	issueID string
+}
+
+var _ fs.NodeReaddirer = (*DraftsNode)(nil)
+var _ fs.NodeLookuper = (*DraftsNode)(nil)
+var _ fs.NodeCreater = (*DraftsNode)(nil)
+var _ fs.NodeUnlinker = (*DraftsNode)(nil)
+var _ fs.NodeRenamer = (*DraftsNode)(nil)
+var _ fs.NodeGetattrer = (*DraftsNode)(nil)
+
+// trio declares the drafts directory's feedback surfaces: .error and .last
+// report the publish gesture. There is no _create — any filename is a draft.
+func (n *DraftsNode) trio() collectionTrio {
+	return collectionTrio{kind: "drafts", parentID: n.issueID}
+}
+
+// errKey is the .error/.last key publish failures and successes land under.
+func (n *DraftsNode) errKey() string {
+	return collectionErrorKey("drafts", n.issueID)
+}
+
+// reservedDraftName reports names a draft may not take: the publish trigger and
+// the trio's sidecars.
+func reservedDraftName(name string) bool {
+	return name == draftPublishName || name == ".error" || name == ".last"
+}
+
+func (n *DraftsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	entries := n.trio().entries()
+	drafts, err := n.lfs.store.Queries().ListCommentDrafts(ctx, n.issueID)
+	if err != nil {
+		// Degrade to the trio alone, like collectionDir.readdir.
+		return fs.NewListDirStream(entries), 0
+	}
+	for _, d := range drafts {
+		entries = append(entries, fuse.DirEntry{Name: d.Name, Mode: syscall.S_IFREG})
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *DraftsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if inode, ok := n.lfs.lookupCollectionTrio(ctx, n, n.trio(), name, out); ok {
+		return inode, 0
+	}
+	if reservedDraftName(name) {
+		return nil, syscall.ENOENT
+	}
+	d, err := n.lfs.store.Queries().GetCommentDraft(ctx, db.GetCommentDraftParams{IssueID: n.issueID, Name: name})
+	if err == sql.ErrNoRows {
+		return nil, syscall.ENOENT
+	}
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	return n.buildDraft(ctx, out, d), 0
+}
+
+// buildDraft mounts the read/write node for a stored draft.
+func (n *DraftsNode) buildDraft(ctx context.Context, out *fuse.EntryOut, d db.CommentDraft) *fs.Inode {
+	content := []byte(d.Body)
+	node := &DraftNode{
+		BaseNode:   BaseNode{lfs: n.lfs},
+		editBuffer: editBuffer{content: content},
+		issueID:    n.issueID,
+		name:       d.Name,
+		created:    d.CreatedAt,
+		updated:    d.UpdatedAt,
+	}
+	// Drafts change only through this mount, but keep the writable-file timeout.
+	return n.newFileInode(ctx, out, d.Name, node, fileAttr(len(content), d.CreatedAt, d.UpdatedAt), draftIno(n.issueID, d.Name), 5*time.Second)
+}
+
+// Create stores an empty draft immediately, so the file is listed (and
+// survives a remount) before its first save.
+func (n *DraftsNode) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	if reservedDraftName(name) {
+		return nil, nil, 0, syscall.EPERM
+	}
+	now := db.Now()
+	d := db.CommentDraft{IssueID: n.issueID, Name: name, CreatedAt: now, UpdatedAt: now}
+	if err := retrySQLite(ctx, saveDraft(n.lfs), &d); err != nil {
+		log.Printf("Failed to create draft %s: %v", name, err)
+		return nil, nil, 0, syscall.EIO
+	}
+	return n.buildDraft(ctx, out, d), nil, 0, 0
+}
+
+// Unlink discards a draft. Nothing on Linear is touched.
+func (n *DraftsNode) Unlink(ctx context.Context, name string) syscall.Errno {
+	if reservedDraftName(name) {
+		return syscall.EPERM
+	}
+	q := n.lfs.store.Queries()
+	if _, err := q.GetCommentDraft(ctx, db.GetCommentDraftParams{IssueID: n.issueID, Name: name}); err == sql.ErrNoRows {
+		return syscall.ENOENT
+	} else if err != nil {
+		return syscall.EIO
+	}
+	if err := q.DeleteCommentDraft(ctx, db.DeleteCommentDraftParams{IssueID: n.issueID, Name: name}); err != nil {
+		log.Printf("Failed to delete draft %s: %v", name, err)
+		return syscall.EIO
+	}
+	return 0
+}
+
+// Rename either publishes a draft (newName == "publish") or renames it locally,
+// replacing any draft already at newName — which is also how an editor's
+// atomic save lands, since its temp file is just another draft.
+func (n *DraftsNode) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) (errno syscall.Errno) {
+	start := time.Now()
+	defer func() { recordFuseOp(ctx, "rename", start, errno) }()
+
+	if newParent.EmbeddedInode().StableAttr().Ino != draftsDirIno(n.issueID) {
+		return syscall.EXDEV
+	}
+	if reservedDraftName(name) {
+		return syscall.EPERM
+	}
+	q := n.lfs.store.Queries()
+	d, err := q.GetCommentDraft(ctx, db.GetCommentDraftParams{IssueID: n.issueID, Name: name})
+	if err == sql.ErrNoRows {
+		return syscall.ENOENT
+	}
+	if err != nil {
+		return syscall.EIO
+	}
+
+	if newName == draftPublishName {
+		return n.publish(ctx, d)
+	}
+	if reservedDraftName(newName) {
+		return syscall.EPERM
+	}
+
+	if err := q.DeleteCommentDraft(ctx, db.DeleteCommentDraftParams{IssueID: n.issueID, Name: newName}); err != nil {
+		return syscall.EIO
+	}
+	if err := q.RenameCommentDraft(ctx, db.RenameCommentDraftParams{
+		Name:      newName,
+		UpdatedAt: db.Now(),
+		IssueID:   n.issueID,
+		Name_2:    name,
+	}); err != nil {
+		log.Printf("Failed to rename draft %s -> %s: %v", name, newName, err)
+		return syscall.EIO
+	}
+	// go-fuse moves the node to newName on success; its later saves must
+	// land on the renamed row.
+	if child := n.EmbeddedInode().GetChild(name); child != nil {
+		if draft, ok := child.Operations().(*DraftNode); ok {
+			draft.setName(newName)
+		}
+	}
+	return 0
+}
+
+// publish posts the draft's body as a comment on the issue, then removes the
+// draft. A failed post keeps the draft (the .error says why) so the gesture can
+// simply be retried.
+func (n *DraftsNode) publish(ctx context.Context, d db.CommentDraft) syscall.Errno {
+	body := strings.TrimSpace(d.Body)
+	if body == "" {
+		n.lfs.SetWriteError(n.errKey(), "Operation: publish draft "+d.Name+"\nError: the draft is empty; write the comment body first.")
+		return syscall.EINVAL
+	}
+	if errno := n.lfs.postComment(ctx, n.issueID, body, n.errKey()); errno != 0 {
+		return errno
+	}
+	forget := func(ctx context.Context, d *db.CommentDraft) error {
+		return n.lfs.store.Queries().DeleteCommentDraft(ctx, db.DeleteCommentDraftParams{IssueID: d.IssueID, Name: d.Name})
+	}
+	if err := retrySQLite(ctx, forget, &d); err != nil {
+		// The comment is live: re-publishing would post it twice.
+		log.Printf("Failed to remove published draft %s: %v", d.Name, err)
+		n.lfs.SetWriteError(n.errKey(), "Operation: publish draft "+d.Name+
+			"\nError: the comment was posted, but the draft could not be removed. Delete it with rm; do not publish it again.")
+		return syscall.EIO
+	}
+	// go-fuse moves the published node onto "publish"; drop both names from
+	// the kernel's cache so neither lingers in listings.
+	n.lfs.InvalidateDeleted(draftsDirIno(n.issueID), d.Name)
+	n.lfs.InvalidateDeleted(draftsDirIno(n.issueID), draftPublishName)
+	return 0
+}
+
+// saveDraft upserts a draft row, in retrySQLite's shape: the sync worker's
+// writes can make a save see SQLITE_BUSY.
+func saveDraft(lfs *LinearFS) func(ctx context.Context, d *db.CommentDraft) error {
+	return func(ctx context.Context, d *db.CommentDraft) error {
+		return lfs.store.Queries().UpsertCommentDraft(ctx, db.UpsertCommentDraftParams{
+			IssueID:   d.IssueID,
+			Name:      d.Name,
+			Body:      d.Body,
+			CreatedAt: d.CreatedAt,
+			UpdatedAt: d.UpdatedAt,
+		})
+	}
+}
+
+// DraftNode is one draft file: a plain read/write buffer whose Flush saves the
+// bytes verbatim to SQLite. No frontmatter, no API call.
+type DraftNode struct {
+	BaseNode
+	editBuffer
+	issueID string
+	// name, created, and updated are guarded by editBuffer.mu: a rename or
+	// save rewrites them while a Getattr may be reading.
+	name    string
+	created time.Time
+	updated time.Time
+}
+
+var _ fs.NodeGetattrer = (*DraftNode)(nil)
+var _ fs.NodeOpener = (*DraftNode)(nil)
+var _ fs.NodeReader = (*DraftNode)(nil)
+var _ fs.NodeWriter = (*DraftNode)(nil)
+var _ fs.NodeFlusher = (*DraftNode)(nil)
+var _ fs.NodeFsyncer = (*DraftNode)(nil)
+var _ fs.NodeSetattrer = (*DraftNode)(nil)
+
+func (n *DraftNode) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	n.mu.Lock()
+	size := len(n.content)
+	created, updated := n.created, n.updated
+	n.mu.Unlock()
+	fileAttr(size, created, updated).fill(&out.Attr, &n.BaseNode)
+	return 0
+}
+
+// refreshFrom adopts a fresh twin's stored draft unless an edit is in flight
+// (refresh.go).
+func (n *DraftNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*DraftNode); ok {
+		n.refresh(f.content, func() { n.name, n.created, n.updated = f.name, f.created, f.updated })
+	}
+}
+
+func (n *DraftNode) setName(name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.name = name
+}
+
+// Flush saves the buffer. A failed save leaves it dirty for the next close.
+func (n *DraftNode) Flush(ctx context.Context, f fs.FileHandle) (errno syscall.Errno) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.dirty {
+		return 0
+	}
+	start := time.Now()
+	defer func() { recordFuseOp(ctx, "flush", start, errno) }()
+
+	d := db.CommentDraft{IssueID: n.issueID, Name: n.name, Body: string(n.content), CreatedAt: n.created, UpdatedAt: db.Now()}
+	if err := retrySQLite(ctx, saveDraft(n.lfs), &d); err != nil {
+		log.Printf("Failed to save draft %s: %v", n.name, err)
+		return syscall.EIO
+	}
+	n.updated = d.UpdatedAt
+	n.dirty = false
+	return 0
+}
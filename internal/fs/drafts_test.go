@@ -0,0 +1,57 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// TestDraftPublish drives the publish gesture's tail: the draft's body is
+// posted as a comment and the draft row removed, while an empty draft is
+// refused and kept.
+func TestDraftPublish(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	q := store.Queries()
+	n := &DraftsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issueID: "issue-1"}
+
+	now := db.Now()
+	empty := db.CommentDraft{IssueID: "issue-1", Name: "empty.md", Body: " \n", CreatedAt: now, UpdatedAt: now}
+	reply := db.CommentDraft{IssueID: "issue-1", Name: "reply.md", Body: "Long reply\n\nwritten over time\n", CreatedAt: now, UpdatedAt: now}
+	for _, d := range []db.CommentDraft{empty, reply} {
+		if err := saveDraft(lfs)(ctx, &d); err != nil {
+			t.Fatalf("saveDraft(%s): %v", d.Name, err)
+		}
+	}
+
+	if errno := n.publish(ctx, empty); errno != syscall.EINVAL {
+		t.Errorf("publish(empty) = %v, want EINVAL", errno)
+	}
+	if we := lfs.GetWriteError(n.errKey()); we == nil {
+		t.Error("publish(empty) left no .error")
+	}
+
+	if errno := n.publish(ctx, reply); errno != 0 {
+		t.Fatalf("publish(reply) = %v", errno)
+	}
+	drafts, err := q.ListCommentDrafts(ctx, "issue-1")
+	if err != nil {
+		t.Fatalf("ListCommentDrafts: %v", err)
+	}
+	if len(drafts) != 1 || drafts[0].Name != "empty.md" {
+		t.Errorf("drafts after publish = %+v, want only empty.md", drafts)
+	}
+	comments, err := q.ListIssueComments(ctx, "issue-1")
+	if err != nil {
+		t.Fatalf("ListIssueComments: %v", err)
+	}
+	if len(comments) != 1 || comments[0].Body != "Long reply\n\nwritten over time" {
+		t.Errorf("comments after publish = %+v, want the trimmed draft body", comments)
+	}
+	if we := lfs.GetWriteError(n.errKey()); we != nil {
+		t.Errorf("publish(reply) left .error %q", we.Message)
+	}
+}
@@ -0,0 +1,233 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	gosync "sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/marshal"
+	"github.com/jra3/linear-fuse/internal/sync"
+)
+
+// The /.events long-poll file.
+//
+// A read blocks until the sync worker reports a change, then returns one JSON
+// line per change — so `cat .events` or `tail -n +1 -f .events` drives an
+// automation off Linear changes without inotify (which FUSE cannot deliver
+// for remote changes anyway). Each open starts at "now": a reader sees the
+// changes after its open, never a backlog.
+
+// eventsName is the root long-poll file.
+const eventsName = ".events"
+
+// eventLogCap bounds the lines kept for readers that fall behind. A reader
+// further behind than this skips ahead to the oldest kept line — the file is a
+// trigger feed, not an audit log (history.md and the cache have the state).
+const eventLogCap = 1024
+
+// eventLine is one .events line.
+type eventLine struct {
+	Entity     string `json:"entity"`
+	ID         string `json:"id"`
+	Identifier string `json:"identifier,omitempty"`
+	Team       string `json:"team,omitempty"`
+	Action     string `json:"action"`
+	At         string `json:"at"`
+}
+
+// eventLog is the bounded, sequence-numbered buffer the sync worker appends
+// to and every open .events handle reads from.
+type eventLog struct {
+	mu    gosync.Mutex
+	lines [][]byte // ring; lines[seq%eventLogCap] holds line seq
+	next  uint64   // sequence number of the next line appended
+	wake  chan struct{}
+}
+
+func newEventLog() *eventLog {
+	return &eventLog{lines: make([][]byte, eventLogCap), wake: make(chan struct{})}
+}
+
+// append adds a line and wakes every blocked reader.
+func (l *eventLog) append(line []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines[l.next%eventLogCap] = line
+	l.next++
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
+
+// head is the sequence number a new reader starts at.
+func (l *eventLog) head() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.next
+}
+
+// since returns the lines from seq on, the sequence number of the first of
+// them (later than seq when seq has been overwritten), and a channel closed by
+// the next append (for a caller that got nothing).
+func (l *eventLog) since(seq uint64) (lines [][]byte, first uint64, wake <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.next > eventLogCap && seq < l.next-eventLogCap {
+		seq = l.next - eventLogCap
+	}
+	for s := seq; s < l.next; s++ {
+		lines = append(lines, l.lines[s%eventLogCap])
+	}
+	return lines, seq, l.wake
+}
+
+// Changed implements sync.ChangeListener: it drops the kernel's cached view
+// of what changed (see invalidateIssueSynced), then renders the change as an
+// .events line. Neither step can stall the sync goroutine: notifies are
+// bounded (boundedNotify) and append only takes the log's lock.
+func (lfs *LinearFS) Changed(c sync.Change) {
+	switch {
+	case c.Issue != nil:
+		invalidateIssueSynced(lfs, lfs.issueDirs, c.Previous, c.Issue)
+	case c.Entity == "issue" && c.Previous != nil:
+		invalidateIssueMoved(lfs, lfs.issueDirs, c.Previous, nil)
+	case c.Comment != nil:
+		lfs.invalidateCommentChanged(c)
+	case c.Project != nil:
+		lfs.invalidateProjectChanged(c)
+	}
+	line, err := json.Marshal(eventLine{
+		Entity:     c.Entity,
+		ID:         c.ID,
+		Identifier: c.Identifier,
+		Team:       c.Team,
+		Action:     c.Action,
+		At:         marshal.FormatTimestamp(db.Now()),
+	})
+	if err != nil {
+		return // intentionally best-effort: a string-only struct cannot fail to marshal (recovers via the next change)
+	}
+	lfs.events.append(append(line, '\n'))
+}
+
+// invalidateCommentChanged drops the kernel's view of a comment the webhook
+// listener wrote. A comment's file name is fixed at creation, so an edit only
+// replaces the entry's content and a delete only drops the entry (a tombstone
+// re-Lookups under the same name when deleted comments are shown).
+func (lfs *LinearFS) invalidateCommentChanged(c sync.Change) {
+	dir, name := commentsDirIno(c.IssueID), commentFilename(*c.Comment)
+	switch c.Action {
+	case "created":
+		lfs.InvalidateCreated(dir, name)
+	case "removed":
+		lfs.InvalidateDeleted(dir, name)
+	default:
+		lfs.InvalidateReplaced(dir, name, commentIno(c.Comment.ID))
+		lfs.InvalidateUpdated(commentMetaIno(c.Comment.ID))
+	}
+}
+
+// invalidateProjectChanged drops the kernel's view of a project the webhook
+// listener wrote, in each of its teams' projects/ and the root projects/.
+func (lfs *LinearFS) invalidateProjectChanged(c sync.Change) {
+	name := projectDirName(*c.Project)
+	dirs := []uint64{viewDirIno(workspaceProjectsName)}
+	for _, teamID := range c.TeamIDs {
+		dirs = append(dirs, projectsDirIno(teamID))
+	}
+	for _, dir := range dirs {
+		if c.Action == "removed" {
+			lfs.InvalidateDeleted(dir, name)
+		} else {
+			lfs.InvalidateCreated(dir, name)
+		}
+	}
+	if c.Action == "updated" {
+		lfs.InvalidateUpdated(projectInfoIno(c.Project.ID))
+	}
+}
+
+// EventsNode is /.events. It has no size (its content is unbounded) and no
+// times; Read blocks per open handle, never through the page cache.
+type EventsNode struct {
+	BaseNode
+}
+
+var _ fs.NodeGetattrer = (*EventsNode)(nil)
+var _ fs.NodeOpener = (*EventsNode)(nil)
+var _ fs.NodeReader = (*EventsNode)(nil)
+
+// eventsHandle is one open's cursor into the event log.
+type eventsHandle struct {
+	mu   gosync.Mutex
+	next uint64
+}
+
+func (e *EventsNode) attr() nodeAttr {
+	return nodeAttr{mode: 0444 | syscall.S_IFREG}
+}
+
+func (e *EventsNode) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	e.attr().fill(&out.Attr, &e.BaseNode)
+	return 0
+}
+
+func (e *EventsNode) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR) != 0 {
+		return nil, 0, syscall.EACCES
+	}
+	// DIRECT_IO: reads reach Read past the zero size. NONSEEKABLE: the
+	// stream has no offsets, which also tells tail(1) not to seek to an end.
+	return &eventsHandle{next: e.lfs.events.head()}, fuse.FOPEN_DIRECT_IO | fuse.FOPEN_NONSEEKABLE, 0
+}
+
+// Read returns the whole lines that fit in dest, blocking until there is at
+// least one. An interrupted read (Ctrl-C) returns EINTR; unmount ends the
+// stream with EOF so a blocked reader never pins the mount.
+func (e *EventsNode) Read(ctx context.Context, f fs.FileHandle, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	h, ok := f.(*eventsHandle)
+	if !ok {
+		return nil, syscall.EBADF
+	}
+	start := time.Now()
+	defer func() { recordFuseOp(ctx, "read", start, 0) }()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for {
+		lines, first, wake := e.lfs.events.since(h.next)
+		if len(lines) > 0 {
+			h.next = first
+			var out []byte
+			for _, line := range lines {
+				if len(out)+len(line) > len(dest) && len(out) > 0 {
+					break // the rest waits for the next read
+				}
+				out = append(out, line...)
+				h.next++
+			}
+			return fuse.ReadResultData(truncateRead(out, len(dest))), 0
+		}
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return nil, syscall.EINTR
+		case <-e.lfs.lifeCtx.Done():
+			return fuse.ReadResultData(nil), 0
+		}
+	}
+}
+
+// truncateRead caps a read at the kernel's buffer. Only a single line longer
+// than the whole buffer (never in practice: lines are ~200 bytes, buffers
+// ≥4 KiB) is cut.
+func truncateRead(out []byte, n int) []byte {
+	if len(out) > n {
+		return out[:n]
+	}
+	return out
+}
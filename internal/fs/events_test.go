@@ -0,0 +1,89 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/sync"
+)
+
+// readEvents runs one EventsNode.Read and returns its bytes.
+func readEvents(t *testing.T, ctx context.Context, node *EventsNode, h *eventsHandle, size int) (string, syscall.Errno) {
+	t.Helper()
+	res, errno := node.Read(ctx, h, make([]byte, size), 0)
+	if errno != 0 {
+		return "", errno
+	}
+	data, _ := res.Bytes(nil)
+	return string(data), 0
+}
+
+// TestEventsReadBlocksForChanges pins the long-poll contract: an open sees
+// only changes after it, a read blocks until one lands, lines come out whole
+// and in order, and an interrupted read returns EINTR.
+func TestEventsReadBlocksForChanges(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	node := &EventsNode{BaseNode: BaseNode{lfs: lfs}}
+
+	lfs.Changed(sync.Change{Entity: "issue", ID: "before-open", Action: "updated"})
+	fh, flags, errno := node.Open(context.Background(), syscall.O_RDONLY)
+	if errno != 0 || flags&fuse.FOPEN_NONSEEKABLE == 0 {
+		t.Fatalf("Open = flags %#x, errno %v", flags, errno)
+	}
+	h := fh.(*eventsHandle)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		lfs.Changed(sync.Change{Entity: "issue", ID: "i1", Identifier: "ENG-1", Team: "ENG", Action: "created"})
+	}()
+	got, errno := readEvents(t, context.Background(), node, h, 4096)
+	if errno != 0 {
+		t.Fatalf("Read: %v", errno)
+	}
+	var line eventLine
+	if err := json.Unmarshal([]byte(got), &line); err != nil || !strings.HasSuffix(got, "\n") {
+		t.Fatalf("line %q: %v", got, err)
+	}
+	if line.ID != "i1" || line.Identifier != "ENG-1" || line.Team != "ENG" || line.Action != "created" || line.At == "" {
+		t.Errorf("line = %+v", line)
+	}
+
+	// Two queued lines and a buffer that holds one: whole lines, in order.
+	lfs.Changed(sync.Change{Entity: "issue", ID: "i2", Action: "updated"})
+	lfs.Changed(sync.Change{Entity: "issue", ID: "i3", Action: "updated"})
+	first, _ := readEvents(t, context.Background(), node, h, 100)
+	second, _ := readEvents(t, context.Background(), node, h, 100)
+	if !strings.Contains(first, `"id":"i2"`) || !strings.Contains(second, `"id":"i3"`) {
+		t.Errorf("reads = %q, %q", first, second)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, errno := readEvents(t, ctx, node, h, 4096); errno != syscall.EINTR {
+		t.Errorf("interrupted read errno = %v, want EINTR", errno)
+	}
+}
+
+// TestEventLogOverflowSkipsAhead pins the bounded buffer: a reader further
+// behind than eventLogCap resumes at the oldest kept line.
+func TestEventLogOverflowSkipsAhead(t *testing.T) {
+	t.Parallel()
+	log := newEventLog()
+	for i := 0; i < eventLogCap+5; i++ {
+		log.append([]byte(fmt.Sprintf("%d\n", i)))
+	}
+	lines, first, _ := log.since(0)
+	if first != 5 || len(lines) != eventLogCap || string(lines[0]) != "5\n" {
+		t.Errorf("since(0) = %d lines from %d (%q)", len(lines), first, lines[0])
+	}
+	if lines, _, _ := log.since(log.head()); len(lines) != 0 {
+		t.Errorf("since(head) = %d lines, want none", len(lines))
+	}
+}
@@ -3,8 +3,9 @@ package fs
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
-	"strings"
+	"strconv"
 	"syscall"
 
 	"github.com/hanwen/go-fuse/v2/fs"
@@ -12,27 +13,48 @@ import (
 	"github.com/jra3/linear-fuse/internal/api"
 )
 
-// assigneeHandle returns the handle for an assignee (prefers DisplayName, falls
-// back to email local part). safeName is the final safety pass: it strips
-// traversal/control chars from the handle and, critically for #332, escapes a
-// handle that lands exactly on the "unassigned" bucket literal so a real user
-// named "unassigned" cannot shadow the unassigned view. Both the by/assignee
-// value list and resolveAssigneeID derive through this one function, so the
-// sanitized handle stays a consistent resolution key.
+// assigneeHandle returns the handle for an assignee: its users/ directory
+// name. safeName is the final safety pass: it strips traversal/control chars
+// from the handle and, critically for #332, escapes a handle that lands
+// exactly on the "unassigned" bucket literal so a real user named
+// "unassigned" cannot shadow the unassigned view. The by/assignee value list
+// and resolveAssignee derive the same name through userDirNames, which differs
+// only for members whose handles collide.
 func assigneeHandle(user *api.User) string {
 	if user == nil {
 		return ""
 	}
-	handle := user.DisplayName
-	if handle == "" {
-		// Fallback to email local part
-		if idx := strings.Index(user.Email, "@"); idx != -1 {
-			handle = user.Email[:idx]
-		} else {
-			handle = user.Email
-		}
+	return userDirName(*user)
+}
+
+// stateNames and labelNames are the by/status and by/label values of a team's
+// states and labels, in the order given: safeName'd, with collisions suffixed
+// by uniqueNames. The value lists and the resolvers both derive them here, as
+// by/assignee does through userDirNames.
+func stateNames(states []api.State) []string {
+	return uniqueNames(states, func(s api.State) (string, string) { return s.Name, s.ID }) // safename:ok uniqueNames safeNames it
+}
+
+func labelNames(labels []api.Label) []string {
+	return uniqueNames(labels, func(l api.Label) (string, string) { return l.Name, l.ID }) // safename:ok uniqueNames safeNames it
+}
+
+// projectFilterNames are the by/project values of a team's projects, in the
+// order given: each its projectDirName, with names two projects share made
+// unique by uniqueNames.
+func projectFilterNames(projects []api.Project) []string {
+	return uniqueNames(projects, func(p api.Project) (string, string) { return projectDirName(p), p.ID })
+}
+
+// creatorHandle returns the by/creator/ value for an issue's creator: the
+// email, through safeName like every remote string that becomes a name. The
+// issues row carries only the creator's id and email, so there is no display
+// name to prefer.
+func creatorHandle(user *api.User) string {
+	if user == nil || user.Email == "" {
+		return ""
 	}
-	return safeName(handle, user.ID)
+	return safeName(user.Email, user.ID)
 }
 
 // FilterRootNode represents the by/ directory. It holds a team snapshot and
@@ -46,7 +68,11 @@ var _ fs.NodeReaddirer = (*FilterRootNode)(nil)
 var _ fs.NodeLookuper = (*FilterRootNode)(nil)
 var _ fs.NodeGetattrer = (*FilterRootNode)(nil)
 
-var filterCategories = []string{"status", "label", "assignee"}
+var filterCategories = []string{"status", "label", "assignee", "creator", "priority", "project", "cycle"}
+
+// priorityBuckets are the by/priority/ values in urgency order. They are the
+// api.PriorityName vocabulary, so each resolves back through ValidatePriority.
+var priorityBuckets = []string{"urgent", "high", "medium", "low", "none"}
 
 // entity()/setEntity() are promoted from the embedded entityCell[api.Team].
 // refreshFrom is the nodeRefresher seam (refresh.go).
@@ -57,18 +83,23 @@ func (f *FilterRootNode) refreshFrom(fresh fs.InodeEmbedder) {
 }
 
 func (f *FilterRootNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
-	entries := make([]fuse.DirEntry, len(filterCategories))
+	entries := make([]fuse.DirEntry, len(filterCategories), len(filterCategories)+1)
 	for i, cat := range filterCategories {
 		entries[i] = fuse.DirEntry{
 			Name: cat,
 			Mode: syscall.S_IFDIR,
 		}
 	}
+	entries = append(entries, fuse.DirEntry{Name: blockedViewName, Mode: syscall.S_IFDIR})
 	return fs.NewListDirStream(entries), 0
 }
 
 func (f *FilterRootNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
 	team := f.entity()
+	if name == blockedViewName {
+		node := &BlockedNode{attrNode: attrNode{BaseNode: BaseNode{lfs: f.lfs}}, entityCell: entityCell[api.Team]{val: team}}
+		return f.newDirInode(ctx, out, name, node, dirAttr(team.CreatedAt, team.UpdatedAt), byCategoryIno(team.ID, name), inheritTimeout), 0
+	}
 	for _, cat := range filterCategories {
 		if cat == name {
 			node := &FilterCategoryNode{
@@ -150,10 +181,7 @@ func (f *FilterCategoryNode) getUniqueValues(ctx context.Context) ([]string, err
 		if err != nil {
 			return nil, err
 		}
-		values := make([]string, len(states))
-		for i, state := range states {
-			values[i] = safeName(state.Name, state.ID)
-		}
+		values := stateNames(states)
 		sort.Strings(values)
 		return values, nil
 
@@ -164,10 +192,7 @@ func (f *FilterCategoryNode) getUniqueValues(ctx context.Context) ([]string, err
 		if err != nil {
 			return nil, err
 		}
-		values := make([]string, len(labels))
-		for i, label := range labels {
-			values[i] = safeName(label.Name, label.ID)
-		}
+		values := labelNames(labels)
 		sort.Strings(values)
 		return values, nil
 
@@ -177,13 +202,51 @@ func (f *FilterCategoryNode) getUniqueValues(ctx context.Context) ([]string, err
 		if err != nil {
 			return nil, err
 		}
-		values := make([]string, 0, len(users)+1)
-		values = append(values, "unassigned")
-		for _, user := range users {
-			values = append(values, assigneeHandle(&user))
+		values := append(userDirNames(users), "unassigned")
+		sort.Strings(values)
+		return values, nil
+
+	case "creator":
+		// Everyone who filed one of the team's synced issues, members or not:
+		// "everything filed by X" matters most for reporters outside the team.
+		creators, err := f.lfs.repo.GetTeamIssueCreators(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, 0, len(creators))
+		for _, user := range creators {
+			values = append(values, creatorHandle(&user))
+		}
+		sort.Strings(values)
+		return values, nil
+
+	case "priority":
+		// Fixed vocabulary, listed in urgency order rather than sorted.
+		return priorityBuckets, nil
+
+	case "project":
+		// The team's projects, under the same sanitized name projects/ uses.
+		projects, err := f.lfs.repo.GetTeamProjects(ctx, teamID)
+		if err != nil {
+			return nil, err
 		}
+		values := projectFilterNames(projects)
 		sort.Strings(values)
 		return values, nil
+
+	case "cycle":
+		// Cycle numbers, newest first — the order a "this cycle, last cycle"
+		// reader wants.
+		cycles, err := f.lfs.repo.GetTeamCycles(ctx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(cycles, func(i, j int) bool { return cycles[i].Number > cycles[j].Number })
+		values := make([]string, len(cycles))
+		for i, cycle := range cycles {
+			values[i] = strconv.Itoa(cycle.Number)
+		}
+		return values, nil
 	}
 
 	return nil, nil
@@ -245,8 +308,9 @@ func (f *FilterValueNode) Lookup(ctx context.Context, name string, out *fuse.Ent
 func (f *FilterValueNode) getFilteredIssues(ctx context.Context) ([]api.Issue, error) {
 	teamID := f.entity().ID
 	// Use server-side filtering for much better performance. f.value is the
-	// safeName'd directory name, so resolve it back to the entity's real name
-	// (GetStateByName/GetLabelByName match the raw remote name) before filtering.
+	// safeName'd directory name, so resolve it back to the entity (by its real
+	// name for GetStateByName, by ID for labels, which can share a name) before
+	// filtering.
 	switch f.category {
 	case "status":
 		name, err := f.resolveStateName(ctx)
@@ -255,11 +319,11 @@ func (f *FilterValueNode) getFilteredIssues(ctx context.Context) ([]api.Issue, e
 		}
 		return f.lfs.GetFilteredIssuesByStatus(ctx, teamID, name)
 	case "label":
-		name, err := f.resolveLabelName(ctx)
-		if err != nil {
+		label, err := f.resolveLabel(ctx)
+		if err != nil || label == nil {
 			return nil, err
 		}
-		return f.lfs.GetFilteredIssuesByLabel(ctx, teamID, name)
+		return f.lfs.repo.GetIssuesByLabel(ctx, teamID, label.ID)
 	case "assignee":
 		if f.value == "unassigned" {
 			return f.lfs.repo.GetUnassignedIssues(ctx, teamID)
@@ -270,6 +334,26 @@ func (f *FilterValueNode) getFilteredIssues(ctx context.Context) ([]api.Issue, e
 			return nil, err
 		}
 		return f.lfs.repo.GetIssuesByAssignee(ctx, teamID, assigneeID)
+	case "creator":
+		creatorID, err := f.resolveCreatorID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return f.lfs.repo.GetIssuesByCreator(ctx, teamID, creatorID)
+	case "priority":
+		priority, err := api.ValidatePriority(f.value)
+		if err != nil {
+			return nil, err
+		}
+		return f.lfs.repo.GetIssuesByPriority(ctx, teamID, priority)
+	case "project":
+		return f.projectIssues(ctx)
+	case "cycle":
+		cycleID, err := f.resolveCycleID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return f.lfs.repo.GetIssuesByCycle(ctx, cycleID)
 	default:
 		return nil, fmt.Errorf("unknown filter category: %s", f.category)
 	}
@@ -284,40 +368,99 @@ func (f *FilterValueNode) resolveStateName(ctx context.Context) (string, error)
 	if err != nil {
 		return "", err
 	}
-	for _, state := range states {
-		if safeName(state.Name, state.ID) == f.value {
-			return state.Name, nil // safename:ok resolution key (feeds GetStateByName, not a path)
-		}
+	if i := slices.Index(stateNames(states), f.value); i >= 0 {
+		return states[i].Name, nil // safename:ok resolution key (feeds GetStateByName, not a path)
 	}
 	return f.value, nil
 }
 
-// resolveLabelName maps the safeName'd label directory value back to a label's
-// real remote name for the name-keyed filter query, mirroring resolveStateName.
-func (f *FilterValueNode) resolveLabelName(ctx context.Context) (string, error) {
+// resolveLabel maps the label directory value back to its label, or nil when
+// no label has that name any more. Two labels can share a name (a team label
+// and a workspace one); each gets its own directory through labelNames, so the
+// filter goes by ID rather than name.
+func (f *FilterValueNode) resolveLabel(ctx context.Context) (*api.Label, error) {
 	labels, err := f.lfs.repo.GetTeamLabels(ctx, f.entity().ID)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	for _, label := range labels {
-		if safeName(label.Name, label.ID) == f.value {
-			return label.Name, nil // safename:ok resolution key (feeds GetLabelByName, not a path)
-		}
+	if i := slices.Index(labelNames(labels), f.value); i >= 0 {
+		return &labels[i], nil
 	}
-	return f.value, nil
+	return nil, nil
 }
 
 // resolveAssigneeID converts an assignee handle (display name or email prefix) to user ID
 func (f *FilterValueNode) resolveAssigneeID(ctx context.Context) (string, error) {
-	users, err := f.lfs.repo.GetTeamMembers(ctx, f.entity().ID)
+	user, err := f.resolveAssignee(ctx)
 	if err != nil {
 		return "", err
 	}
+	return user.ID, nil
+}
 
-	for _, user := range users {
-		if assigneeHandle(&user) == f.value {
+// resolveAssignee finds the team member whose assignee handle is the value.
+func (f *FilterValueNode) resolveAssignee(ctx context.Context) (*api.User, error) {
+	users, err := f.lfs.repo.GetTeamMembers(ctx, f.entity().ID)
+	if err != nil {
+		return nil, err
+	}
+	if i := slices.Index(userDirNames(users), f.value); i >= 0 {
+		return &users[i], nil
+	}
+	return nil, fmt.Errorf("unknown assignee: %s", f.value)
+}
+
+// resolveCreatorID converts a by/creator/ email value back to the user ID.
+func (f *FilterValueNode) resolveCreatorID(ctx context.Context) (string, error) {
+	creators, err := f.lfs.repo.GetTeamIssueCreators(ctx, f.entity().ID)
+	if err != nil {
+		return "", err
+	}
+	for _, user := range creators {
+		if creatorHandle(&user) == f.value {
 			return user.ID, nil
 		}
 	}
-	return "", fmt.Errorf("unknown assignee: %s", f.value)
+	return "", fmt.Errorf("unknown creator: %s", f.value)
+}
+
+// projectIssues lists the team's issues in the project whose by/project
+// value is f.value. A project spans teams, so its issues are narrowed to this
+// team's. A value no project has (one that vanished since the listing) is
+// empty.
+func (f *FilterValueNode) projectIssues(ctx context.Context) ([]api.Issue, error) {
+	teamID := f.entity().ID
+	projects, err := f.lfs.repo.GetTeamProjects(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.Index(projectFilterNames(projects), f.value)
+	if i < 0 {
+		return nil, nil
+	}
+	all, err := f.lfs.repo.GetIssuesByProject(ctx, projects[i].ID)
+	if err != nil {
+		return nil, err
+	}
+	var issues []api.Issue
+	for _, issue := range all {
+		if issue.Team != nil && issue.Team.ID == teamID {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// resolveCycleID converts a by/cycle/ number back to the team's cycle ID.
+func (f *FilterValueNode) resolveCycleID(ctx context.Context) (string, error) {
+	cycles, err := f.lfs.repo.GetTeamCycles(ctx, f.entity().ID)
+	if err != nil {
+		return "", err
+	}
+	for _, cycle := range cycles {
+		if strconv.Itoa(cycle.Number) == f.value {
+			return cycle.ID, nil
+		}
+	}
+	return "", fmt.Errorf("unknown cycle: %s", f.value)
 }
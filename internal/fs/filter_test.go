@@ -1,9 +1,12 @@
 package fs
 
 import (
+	"context"
+	"slices"
 	"testing"
 
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
 )
 
 func TestAssigneeHandle(t *testing.T) {
@@ -71,3 +74,116 @@ func TestAssigneeHandle(t *testing.T) {
 		})
 	}
 }
+
+// TestFilterByCreator: by/creator/ lists everyone who filed a team issue —
+// including a reporter outside the team — and each value holds exactly the
+// issues that person filed.
+func TestFilterByCreator(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, store := linkTestLFS(t)
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test"}
+	member := &api.User{ID: "user-1", Email: "dev@example.com"}
+	outsider := &api.User{ID: "user-9", Email: "support@example.com"}
+	issues := []api.Issue{
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-1", "TST-1"), fixtures.WithTeam(&team), fixtures.WithCreator(member)),
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-2", "TST-2"), fixtures.WithTeam(&team), fixtures.WithCreator(outsider)),
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-3", "TST-3"), fixtures.WithTeam(&team), fixtures.WithCreator(outsider)),
+	}
+	if err := fixtures.PopulateTeam(ctx, store, team, nil, nil, issues); err != nil {
+		t.Fatalf("populate team: %v", err)
+	}
+
+	category := &FilterCategoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "creator"}
+	if got := readdirNames(t, category); !slices.Equal(got, []string{"dev@example.com", "support@example.com"}) {
+		t.Errorf("by/creator/ = %v, want both reporters", got)
+	}
+	value := &FilterValueNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "creator", value: "support@example.com"}
+	got := readdirNames(t, value)
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"TST-2", "TST-3"}) {
+		t.Errorf("by/creator/support@example.com/ = %v, want [TST-2 TST-3]", got)
+	}
+}
+
+// TestFilterLabelNameCollision: two labels with one name (a team label and a
+// workspace one) get a directory each under by/label/, and each lists only
+// its own issues.
+func TestFilterLabelNameCollision(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, store := linkTestLFS(t)
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test"}
+	teamBug := api.Label{ID: "label-a", Name: "Bug"}
+	workspaceBug := api.Label{ID: "label-b", Name: "Bug"}
+	issues := []api.Issue{
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-1", "TST-1"), fixtures.WithTeam(&team), fixtures.WithLabels(teamBug)),
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-2", "TST-2"), fixtures.WithTeam(&team), fixtures.WithLabels(workspaceBug)),
+	}
+	if err := fixtures.PopulateTeam(ctx, store, team, nil, []api.Label{teamBug, workspaceBug}, issues); err != nil {
+		t.Fatalf("populate team: %v", err)
+	}
+
+	category := &FilterCategoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "label"}
+	if got := readdirNames(t, category); !slices.Equal(got, []string{"Bug", "Bug-label-b"}) {
+		t.Fatalf("by/label/ = %v, want [Bug Bug-label-b]", got)
+	}
+	for value, want := range map[string]string{"Bug": "TST-1", "Bug-label-b": "TST-2"} {
+		if got := readdirNames(t, filterValueNode(lfs, team, "label", value)); !slices.Equal(got, []string{want}) {
+			t.Errorf("by/label/%s/ = %v, want [%s]", value, got, want)
+		}
+	}
+}
+
+// TestFilterByProjectAndCycle: by/project/ names projects as projects/ does,
+// lists two projects that share a name under distinct values, and holds only
+// this team's issues of a cross-team project; by/cycle/ lists
+// cycle numbers newest first and resolves a number back to its cycle.
+func TestFilterByProjectAndCycle(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lfs, store := linkTestLFS(t)
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test"}
+	other := api.Team{ID: "team-2", Key: "OPS", Name: "Ops"}
+	project := api.Project{ID: "proj-1", Name: "Q3 Launch", Slug: "q3-launch"}
+	cycle := api.IssueCycle{ID: "cycle-2", Name: "Sprint 2", Number: 2}
+	issues := []api.Issue{
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-1", "TST-1"), fixtures.WithTeam(&team), fixtures.WithProject(&project), fixtures.WithCycle(&cycle)),
+		fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-2", "TST-2"), fixtures.WithTeam(&team)),
+	}
+	if err := fixtures.PopulateTeam(ctx, store, team, nil, nil, issues); err != nil {
+		t.Fatalf("populate team: %v", err)
+	}
+	foreign := []api.Issue{fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-9", "OPS-9"), fixtures.WithTeam(&other), fixtures.WithProject(&project))}
+	if err := fixtures.PopulateTeam(ctx, store, other, nil, nil, foreign); err != nil {
+		t.Fatalf("populate other team: %v", err)
+	}
+	for _, p := range []api.Project{project, {ID: "proj-2", Name: "Q3 Launch", Slug: "q3-launch-2"}} {
+		if err := fixtures.PopulateProject(ctx, store, p, team.ID); err != nil {
+			t.Fatalf("populate project: %v", err)
+		}
+	}
+	for _, c := range []api.Cycle{{ID: "cycle-1", Number: 1}, {ID: "cycle-2", Number: 2}} {
+		if err := fixtures.PopulateCycle(ctx, store, c, team.ID); err != nil {
+			t.Fatalf("populate cycle: %v", err)
+		}
+	}
+
+	byProject := &FilterCategoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "project"}
+	if got := readdirNames(t, byProject); !slices.Equal(got, []string{"q3-launch", "q3-launch-proj-2"}) {
+		t.Errorf("by/project/ = %v, want [q3-launch q3-launch-proj-2]", got)
+	}
+	inProject := &FilterValueNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "project", value: "q3-launch"}
+	if got := readdirNames(t, inProject); !slices.Equal(got, []string{"TST-1"}) {
+		t.Errorf("by/project/q3-launch/ = %v, want [TST-1] (no OPS issues)", got)
+	}
+
+	byCycle := &FilterCategoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "cycle"}
+	if got := readdirNames(t, byCycle); !slices.Equal(got, []string{"2", "1"}) {
+		t.Errorf("by/cycle/ = %v, want [2 1]", got)
+	}
+	inCycle := &FilterValueNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: "cycle", value: "2"}
+	if got := readdirNames(t, inCycle); !slices.Equal(got, []string{"TST-1"}) {
+		t.Errorf("by/cycle/2/ = %v, want [TST-1]", got)
+	}
+}
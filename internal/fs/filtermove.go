@@ -0,0 +1,143 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// Moving an issue between by/status/ or by/assignee/ directories.
+//
+// by/status/{state}/ and by/assignee/{handle}/ list issues as symlinks.
+// Renaming a symlink into another directory of the same category sets that
+// field, so triage is a matter of mv:
+//
+//	mv by/status/Backlog/ENG-12 by/status/In\ Progress/
+//	mv by/status/Todo/ENG-{3,4,5} by/status/Done/
+//	mv by/assignee/unassigned/ENG-12 by/assignee/ada/
+//	mv by/assignee/ada/ENG-12 by/assignee/unassigned/
+//
+// The move is an issue update (stateId or assigneeId), sent through the same
+// resolver and UpdateIssue as an issue.md save. The SQLite row is updated
+// before the rename returns, so both directories list the issue where it now
+// is without waiting for sync. The write-back re-fetch is tried first; if it
+// fails, the update is applied to the cached row locally. The entry name is
+// the identifier and cannot change. A move to any other directory is EXDEV;
+// the other by/ categories are read-only views.
+
+var _ fs.NodeRenamer = (*FilterValueNode)(nil)
+
+// movableFilters are the by/ categories whose directories an issue can be
+// moved between.
+var movableFilters = map[string]bool{"status": true, "assignee": true}
+
+// Rename moves issue name into newParent's by/ directory of the same category.
+func (f *FilterValueNode) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	if !movableFilters[f.category] {
+		return syscall.EPERM
+	}
+	team := f.entity()
+	dest, ok := newParent.(*FilterValueNode)
+	if !ok || dest.category != f.category || dest.entity().ID != team.ID {
+		return syscall.EXDEV
+	}
+	if newName != name {
+		return syscall.EINVAL
+	}
+	issues, err := f.getFilteredIssues(ctx)
+	if err != nil {
+		return syscall.EIO
+	}
+	var issue *api.Issue
+	for i := range issues {
+		if issues[i].Identifier == name {
+			issue = &issues[i]
+			break
+		}
+	}
+	if issue == nil {
+		return syscall.ENOENT
+	}
+	if dest.value == f.value {
+		return 0
+	}
+	updates, err := dest.moveUpdate(ctx)
+	if err != nil {
+		log.Printf("Failed to resolve %s/%s for a move of %s: %v", dest.category, dest.value, issue.Identifier, err)
+		return syscall.EIO
+	}
+	if f.lfs.debug {
+		log.Printf("Rename: %s from %s %q to %q", issue.Identifier, f.category, f.value, dest.value)
+	}
+	return f.lfs.moveIssue(ctx, issue, updates, "move issue "+issue.Identifier+" to "+f.category+" "+dest.value)
+}
+
+// moveUpdate is the update that puts an issue in this directory, in the
+// resolver's terms: the state's name, or the assignee's email (nil for
+// unassigned).
+func (f *FilterValueNode) moveUpdate(ctx context.Context) (map[string]any, error) {
+	switch f.category {
+	case "status":
+		state, err := f.resolveStateName(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"stateId": state}, nil
+	case "assignee":
+		if f.value == "unassigned" {
+			return map[string]any{"assigneeId": nil}, nil
+		}
+		user, err := f.resolveAssignee(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"assigneeId": user.Email}, nil
+	}
+	return nil, fmt.Errorf("by/%s is not movable", f.category)
+}
+
+// moveIssue applies a move's update to issue and brings the cached row and
+// the kernel's view of every listing up to date.
+func (lfs *LinearFS) moveIssue(ctx context.Context, issue *api.Issue, updates map[string]any, op string) syscall.Errno {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if ferr := resolveIssueUpdate(ctx, lfs, issue, updates); ferr != nil {
+		log.Printf("Failed to resolve move of %s: %s", issue.Identifier, ferr.Message)
+		lfs.SetIssueError(issue.ID, ferr.Detail())
+		return syscall.EINVAL
+	}
+	base := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: *issue}
+	if lfs.mustQueue(ctx, issue.ID) {
+		return base.queueUpdate(ctx, updates)
+	}
+	if err := lfs.mutator().UpdateIssue(ctx, issue.ID, updates); err != nil {
+		if api.IsUnreachable(err) {
+			return base.queueUpdate(ctx, updates)
+		}
+		log.Printf("Failed to move issue %s: %v", issue.Identifier, err)
+		msg, errno := classifyMutationErr(op, err)
+		lfs.SetIssueError(issue.ID, msg)
+		return errno
+	}
+
+	fresh, errno := commitWriteBack(ctx, lfs, base.writeBack(&updates))
+	if fresh == nil {
+		// The re-fetch failed but the move landed: cache the local result so
+		// the directories agree with Linear now rather than at the next sync.
+		local := lfs.applyIssueUpdate(ctx, *issue, updates)
+		if err := lfs.UpsertIssue(ctx, local); err != nil {
+			log.Printf("Failed to cache move of %s: %v", issue.Identifier, err)
+		}
+		fresh = &local
+	}
+	invalidateIssueMoved(lfs, lfs.issueDirs, issue, fresh)
+	lfs.InvalidateUpdated(issueIno(issue.ID))
+	lfs.InvalidateUpdated(metaIno(issue.ID))
+	return errno
+}
@@ -0,0 +1,124 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	dbfixtures "github.com/jra3/linear-fuse/internal/db/fixtures"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// moveDirs seeds the fixture team with TST-12, unassigned in Backlog, and
+// returns by/{category}/ value nodes for the named values.
+func moveDirs(t *testing.T, category string, values ...string) (*LinearFS, []*FilterValueNode) {
+	t.Helper()
+	lfs, store := linkTestLFS(t)
+	team := fixtures.FixtureAPITeam()
+	backlog := fixtures.FixtureAPIStates()[0]
+	issue := fixtures.FixtureAPIIssue(fixtures.WithIssueID("issue-12", "TST-12"), fixtures.WithState(backlog), fixtures.WithAssignee(nil))
+	if err := dbfixtures.SeedTeam(context.Background(), store, team, issue); err != nil {
+		t.Fatalf("SeedTeam: %v", err)
+	}
+	nodes := make([]*FilterValueNode, len(values))
+	for i, v := range values {
+		nodes[i] = filterValueNode(lfs, team, category, v)
+	}
+	return lfs, nodes
+}
+
+func filterValueNode(lfs *LinearFS, team api.Team, category, value string) *FilterValueNode {
+	return &FilterValueNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}, category: category, value: value}
+}
+
+func listed(t *testing.T, n *FilterValueNode) []string {
+	t.Helper()
+	issues, err := n.getFilteredIssues(context.Background())
+	if err != nil {
+		t.Fatalf("getFilteredIssues(%s): %v", n.value, err)
+	}
+	var idents []string
+	for _, i := range issues {
+		idents = append(idents, i.Identifier)
+	}
+	return idents
+}
+
+// TestStatusMove: mv by/status/Backlog/TST-12 by/status/In Progress/ moves
+// the issue on Linear, and both directories list it where it now is at once.
+func TestStatusMove(t *testing.T) {
+	lfs, dirs := moveDirs(t, "status", "Backlog", "In Progress")
+	from, to := dirs[0], dirs[1]
+
+	if errno := from.Rename(context.Background(), "TST-12", to, "TST-12", 0); errno != 0 {
+		t.Fatalf("Rename: errno = %v (.error: %+v)", errno, lfs.GetWriteError("issue-12"))
+	}
+	if got := listed(t, from); len(got) != 0 {
+		t.Errorf("Backlog lists %v after the move", got)
+	}
+	if got := listed(t, to); len(got) != 1 || got[0] != "TST-12" {
+		t.Errorf("In Progress lists %v, want [TST-12]", got)
+	}
+}
+
+// TestAssigneeMove: mv by/assignee/unassigned/TST-12 by/assignee/Jane/
+// assigns the issue, and moving it back unassigns it.
+func TestAssigneeMove(t *testing.T) {
+	jane := fixtures.FixtureAPIUsers()[1]
+	lfs, dirs := moveDirs(t, "assignee", "unassigned", assigneeHandle(&jane))
+	unassigned, janes := dirs[0], dirs[1]
+	ctx := context.Background()
+
+	if errno := unassigned.Rename(ctx, "TST-12", janes, "TST-12", 0); errno != 0 {
+		t.Fatalf("Rename: errno = %v (.error: %+v)", errno, lfs.GetWriteError("issue-12"))
+	}
+	if got := listed(t, unassigned); len(got) != 0 {
+		t.Errorf("unassigned lists %v after the move", got)
+	}
+	if got := listed(t, janes); len(got) != 1 || got[0] != "TST-12" {
+		t.Errorf("%s lists %v, want [TST-12]", janes.value, got)
+	}
+
+	if errno := janes.Rename(ctx, "TST-12", unassigned, "TST-12", 0); errno != 0 {
+		t.Fatalf("Rename back: errno = %v", errno)
+	}
+	if got := listed(t, unassigned); len(got) != 1 || got[0] != "TST-12" {
+		t.Errorf("unassigned lists %v after the move back, want [TST-12]", got)
+	}
+}
+
+// TestFilterMoveRefused: a rename that is not a move between two values of
+// one movable category in one team, or that renames the entry, changes
+// nothing.
+func TestFilterMoveRefused(t *testing.T) {
+	lfs, dirs := moveDirs(t, "status", "Backlog", "Done")
+	from, to := dirs[0], dirs[1]
+	ctx := context.Background()
+	label := filterValueNode(lfs, from.entity(), "label", "Bug")
+	otherTeam := filterValueNode(lfs, api.Team{ID: "team-2"}, "status", "Done")
+	assignee := filterValueNode(lfs, from.entity(), "assignee", "unassigned")
+
+	cases := []struct {
+		name      string
+		src       *FilterValueNode
+		dest      *FilterValueNode
+		ent, dent string
+		want      syscall.Errno
+	}{
+		{"into a label dir", from, label, "TST-12", "TST-12", syscall.EXDEV},
+		{"within a label dir", label, label, "TST-12", "TST-12", syscall.EPERM},
+		{"into an assignee dir", from, assignee, "TST-12", "TST-12", syscall.EXDEV},
+		{"into another team", from, otherTeam, "TST-12", "TST-12", syscall.EXDEV},
+		{"renamed entry", from, to, "TST-12", "TST-13", syscall.EINVAL},
+		{"not listed", to, from, "TST-12", "TST-12", syscall.ENOENT},
+	}
+	for _, tc := range cases {
+		if errno := tc.src.Rename(ctx, tc.ent, tc.dest, tc.dent, 0); errno != tc.want {
+			t.Errorf("%s: errno = %v, want %v", tc.name, errno, tc.want)
+		}
+	}
+	if got := listed(t, from); len(got) != 1 {
+		t.Errorf("Backlog lists %v, want TST-12 untouched", got)
+	}
+}
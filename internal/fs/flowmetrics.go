@@ -0,0 +1,85 @@
+package fs
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// teamMetricsName is the per-team flow-metrics report in teams/{KEY}/.
+const teamMetricsName = "metrics.md"
+
+// metricsMonths caps metrics.md at the most recent months with completions.
+const metricsMonths = 12
+
+// monthFlow is one month's completed issues: lead times for all of them,
+// cycle times for those that recorded a start.
+type monthFlow struct {
+	lead, cycle []time.Duration
+}
+
+// renderTeamMetrics renders a team's metrics.md from its issues as SQLite
+// holds them: per month of completion (UTC), the median and p90 lead time
+// (created to completed) and cycle time (started to completed), newest month
+// first. Canceled issues are not completions and are left out.
+func renderTeamMetrics(team api.Team, issues []api.Issue) []byte {
+	months := make(map[string]*monthFlow)
+	for _, issue := range issues {
+		if issue.CompletedAt == nil {
+			continue
+		}
+		done := *issue.CompletedAt
+		key := done.UTC().Format("2006-01")
+		m := months[key]
+		if m == nil {
+			m = &monthFlow{}
+			months[key] = m
+		}
+		m.lead = append(m.lead, done.Sub(issue.CreatedAt))
+		if issue.StartedAt != nil {
+			m.cycle = append(m.cycle, done.Sub(*issue.StartedAt))
+		}
+	}
+	keys := make([]string, 0, len(months))
+	for k := range months {
+		keys = append(keys, k)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	if len(keys) > metricsMonths {
+		keys = keys[:metricsMonths]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s: flow metrics\n\n", team.Key)
+	b.WriteString("Lead time runs from creation to completion, cycle time from start to completion, in days, by month of completion (UTC).\n")
+	if len(keys) == 0 {
+		b.WriteString("\nNo completed issues yet.\n")
+		return []byte(b.String())
+	}
+	b.WriteString("\n| Month | Completed | Lead median | Lead p90 | Cycle median | Cycle p90 |\n")
+	b.WriteString("|-------|-----------|-------------|----------|--------------|-----------|\n")
+	for _, k := range keys {
+		m := months[k]
+		fmt.Fprintf(&b, "| %s | %d | %s | %s | %s | %s |\n", k, len(m.lead),
+			percentileDays(m.lead, 50), percentileDays(m.lead, 90),
+			percentileDays(m.cycle, 50), percentileDays(m.cycle, 90))
+	}
+	return []byte(b.String())
+}
+
+// percentileDays is the nearest-rank p-th percentile of ds in days to one
+// decimal, or "-" when ds is empty.
+func percentileDays(ds []time.Duration, p int) string {
+	if len(ds) == 0 {
+		return "-"
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	d := sorted[max(rank, 1)-1]
+	return fmt.Sprintf("%.1f", d.Hours()/24)
+}
@@ -0,0 +1,65 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestRenderTeamMetrics(t *testing.T) {
+	t.Parallel()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+	day := 24 * time.Hour
+	at := func(ts time.Time) *time.Time { return &ts }
+	sep := time.Date(2026, 9, 20, 12, 0, 0, 0, time.UTC)
+	oct := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+	issues := []api.Issue{
+		// September: lead 2, 4, 10 days; cycle 1 and 3 days (one never started).
+		{Identifier: "ENG-1", CreatedAt: sep.Add(-2 * day), StartedAt: at(sep.Add(-day)), CompletedAt: at(sep)},
+		{Identifier: "ENG-2", CreatedAt: sep.Add(-4 * day), StartedAt: at(sep.Add(-3 * day)), CompletedAt: at(sep)},
+		{Identifier: "ENG-3", CreatedAt: sep.Add(-10 * day), CompletedAt: at(sep)},
+		// October: a single issue with lead 6, cycle 1.5 days.
+		{Identifier: "ENG-4", CreatedAt: oct.Add(-6 * day), StartedAt: at(oct.Add(-36 * time.Hour)), CompletedAt: at(oct)},
+		// Open and canceled issues are not completions.
+		{Identifier: "ENG-5", CreatedAt: oct.Add(-30 * day)},
+		{Identifier: "ENG-6", CreatedAt: oct.Add(-30 * day), CanceledAt: at(oct)},
+	}
+
+	got := string(renderTeamMetrics(team, issues))
+	for _, want := range []string{
+		"# ENG: flow metrics",
+		"| 2026-10 | 1 | 6.0 | 6.0 | 1.5 | 1.5 |\n| 2026-09 | 3 | 4.0 | 10.0 | 1.0 | 3.0 |\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("metrics.md missing %q:\n%s", want, got)
+		}
+	}
+
+	// A month with completions but no recorded starts has no cycle time.
+	got = string(renderTeamMetrics(team, issues[2:3]))
+	if !strings.Contains(got, "| 2026-09 | 1 | 10.0 | 10.0 | - | - |") {
+		t.Errorf("no cycle data:\n%s", got)
+	}
+
+	if got := string(renderTeamMetrics(team, nil)); !strings.Contains(got, "No completed issues yet.") {
+		t.Errorf("empty team:\n%s", got)
+	}
+}
+
+func TestPercentileDays(t *testing.T) {
+	t.Parallel()
+	var ds []time.Duration
+	for i := 1; i <= 10; i++ {
+		ds = append(ds, time.Duration(i)*24*time.Hour)
+	}
+	for _, tc := range []struct {
+		p    int
+		want string
+	}{{50, "5.0"}, {90, "9.0"}, {100, "10.0"}, {0, "1.0"}} {
+		if got := percentileDays(ds, tc.p); got != tc.want {
+			t.Errorf("p%d = %s, want %s", tc.p, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// graphFiles are the dependency-graph exports a team or project directory
+// carries, one per format. Both render the same marshal.IssueGraph.
+var graphFiles = []string{"graph.dot", "graph.json"}
+
+// renderIssueGraph loads a scope's issues and relations and renders the graph
+// in the format named by file (graph.dot or graph.json). A load failure renders
+// as a comment/error object in the requested format, like states.md's
+// "# Error loading states" — the file stays readable and says why it is empty.
+func renderIssueGraph(
+	ctx context.Context,
+	file, name string,
+	loadIssues func(context.Context) ([]api.Issue, error),
+	loadRelations func(context.Context) ([]api.IssueRelation, error),
+) []byte {
+	issues, err := loadIssues(ctx)
+	if err == nil {
+		var relations []api.IssueRelation
+		if relations, err = loadRelations(ctx); err == nil {
+			return formatIssueGraph(file, marshal.BuildIssueGraph(name, issues, relations))
+		}
+	}
+	if path.Ext(file) == ".json" {
+		return jsonErrorBody("loading graph: " + err.Error())
+	}
+	return []byte(fmt.Sprintf("// Error loading graph: %s\n", err))
+}
+
+// formatIssueGraph renders g as DOT or JSON by file extension.
+func formatIssueGraph(file string, g marshal.IssueGraph) []byte {
+	if path.Ext(file) == ".json" {
+		data, err := marshal.IssueGraphToJSON(g)
+		if err != nil {
+			return jsonErrorBody(err.Error())
+		}
+		return data
+	}
+	return marshal.IssueGraphToDOT(g)
+}
+
+// jsonErrorBody is the error body of a generated *.json file: a JSON object,
+// so consumers piping the file into jq see a parseable error rather than a
+// syntax error.
+func jsonErrorBody(msg string) []byte {
+	data, _ := json.Marshal(map[string]string{"error": msg}) // intentionally best-effort: a string map always marshals (recovers via next read)
+	return append(data, '\n')
+}
@@ -0,0 +1,52 @@
+package fs
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// iconEmojiMaxRunes bounds what counts as one emoji icon: a ZWJ family or a
+// flag with variation selectors is a handful of runes, never a sentence.
+const iconEmojiMaxRunes = 8
+
+// iconEmoji returns icon when it is an emoji, else "". Linear stores either an
+// emoji or the name of one of its built-in glyphs ("Rocket", "Database"); only
+// the former is something a terminal can draw, so a named glyph stays in
+// frontmatter and never reaches a directory name.
+func iconEmoji(icon string) string {
+	if icon == "" || utf8.RuneCountInString(icon) > iconEmojiMaxRunes {
+		return ""
+	}
+	for _, r := range icon {
+		if r < utf8.RuneSelf || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return ""
+		}
+	}
+	return icon
+}
+
+// iconDirName renders the listed name of a team or project directory: bare
+// (the builder's already-safe name) unless mount.icon_prefix is on and the
+// entity has an emoji icon, in which case the emoji leads ("🚀 ENG").
+//
+// Like issue_dir_template, the prefix is display only: the bare name always
+// resolves too (iconDirNameMatches), and every symlink target keeps the bare
+// form, since an icon edit would otherwise break links.
+func (lfs *LinearFS) iconDirName(icon, bare string) string {
+	emoji := iconEmoji(icon)
+	if !lfs.iconPrefix || emoji == "" {
+		return bare
+	}
+	return safeName(emoji+" "+bare, bare)
+}
+
+// iconDirNameMatches reports whether name refers to the directory whose bare
+// name is bare — either form, whatever the toggle says, so scripts written
+// against bare names keep working when the prefix is turned on.
+func (lfs *LinearFS) iconDirNameMatches(name, icon, bare string) bool {
+	if name == bare {
+		return true
+	}
+	emoji := iconEmoji(icon)
+	return emoji != "" && name == emoji+" "+bare
+}
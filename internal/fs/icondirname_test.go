@@ -0,0 +1,40 @@
+package fs
+
+import "testing"
+
+// TestIconDirName pins mount.icon_prefix naming: only a real emoji prefixes,
+// named Linear glyphs never do, and the bare name resolves either way.
+func TestIconDirName(t *testing.T) {
+	t.Parallel()
+	off := &LinearFS{}
+	on := &LinearFS{iconPrefix: true}
+
+	cases := []struct {
+		icon, want string
+	}{
+		{"🚀", "🚀 ENG"},
+		{"👩‍💻", "👩‍💻 ENG"},
+		{"Rocket", "ENG"},   // built-in glyph name
+		{":rocket:", "ENG"}, // shortcode
+		{"", "ENG"},         // no icon
+		{"🚀 launch", "ENG"}, // not a single emoji
+	}
+	for _, tc := range cases {
+		if got := on.iconDirName(tc.icon, "ENG"); got != tc.want {
+			t.Errorf("iconDirName(%q) = %q, want %q", tc.icon, got, tc.want)
+		}
+		if got := off.iconDirName(tc.icon, "ENG"); got != "ENG" {
+			t.Errorf("prefix off: iconDirName(%q) = %q, want bare", tc.icon, got)
+		}
+		for _, lfs := range []*LinearFS{on, off} {
+			if !lfs.iconDirNameMatches("ENG", tc.icon, "ENG") || !lfs.iconDirNameMatches(tc.want, tc.icon, "ENG") {
+				t.Errorf("icon %q: bare and listed names must both resolve", tc.icon)
+			}
+		}
+	}
+	for _, name := range []string{"🚀ENG", "🚀 ENGX", "🛸 ENG"} {
+		if on.iconDirNameMatches(name, "🚀", "ENG") {
+			t.Errorf("iconDirNameMatches(%q) = true", name)
+		}
+	}
+}
@@ -11,8 +11,8 @@ import (
 )
 
 // indexedListing owns the index-derived filenames of a collection whose entries
-// are named <NNNN>-<date>…md by creation order (comments, project and
-// initiative updates). Fixing the sort and the name derivation in one place is
+// are named <NNNN>-<date>…md by creation order (project and initiative
+// updates). Fixing the sort and the name derivation in one place is
 // what lets a collection's Readdir, Lookup, and Unlink agree on every name — a
 // file you can `ls` you can also open and `rm`. Before this, each surface
 // re-sorted and re-formatted independently, so a change to one (a timestamp
@@ -61,8 +61,7 @@ func (l indexedListing[T]) find(name string) (T, bool) {
 
 // updateEntryName is the filename for a project or initiative status update:
 // <NNNN>-<date>-<health>.md by creation order. Shared by both update
-// collections (their convention is identical); comments own a different format
-// (a per-minute timestamp, no health).
+// collections (their convention is identical).
 func updateEntryName(i int, createdAt time.Time, health string) string {
 	return fmt.Sprintf("%04d-%s-%s.md", i+1, createdAt.Format("2006-01-02"), strings.ToLower(health))
 }
@@ -0,0 +1,73 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// InitiativeIssuesNode represents /initiatives/{slug}/issues/: a symlink per
+// issue in any of the initiative's linked projects, into the issue's own
+// team — the projects/ links followed one level down, without visiting each
+// project. Sub-initiatives' projects are not included (rollup.md counts
+// those).
+type InitiativeIssuesNode struct {
+	attrNode
+	initiative api.Initiative
+}
+
+var _ fs.NodeReaddirer = (*InitiativeIssuesNode)(nil)
+var _ fs.NodeLookuper = (*InitiativeIssuesNode)(nil)
+var _ fs.NodeGetattrer = (*InitiativeIssuesNode)(nil)
+
+// issues gathers the issues of the initiative's linked projects, by project
+// in link order. The links are read fresh, so a project linked or unlinked
+// since the directory was looked up shows on the next listing.
+func (n *InitiativeIssuesNode) issues(ctx context.Context) ([]api.Issue, error) {
+	initiative := n.initiative
+	if inits, err := n.lfs.repo.GetInitiatives(ctx); err == nil {
+		initiative = freshestByID(inits, initiative.ID, func(i api.Initiative) string { return i.ID }, initiative)
+	}
+	var all []api.Issue
+	for _, ref := range initiative.Projects.Nodes {
+		issues, err := n.lfs.repo.GetIssuesByProject(ctx, ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, issues...)
+	}
+	return all, nil
+}
+
+func (n *InitiativeIssuesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.issues(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, 0, len(issues))
+	for _, issue := range issues {
+		entries = append(entries, fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK})
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *InitiativeIssuesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.issues(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			// initiatives/{slug}/issues/ sits as deep as projects/{name}/issues/.
+			target, errno := projectIssueTarget(issue)
+			if errno != 0 {
+				return nil, errno
+			}
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
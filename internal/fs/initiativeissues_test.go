@@ -0,0 +1,63 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"syscall"
+	"testing"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// TestInitiativeIssues: initiatives/{slug}/issues/ lists the issues of every
+// linked project, across teams, and follows links made since it was looked
+// up; an issue of an unlinked project does not resolve.
+func TestInitiativeIssues(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := fixtures.NewTestSQLiteStore(t)
+
+	eng, ops := api.Team{ID: "team-eng", Key: "ENG"}, api.Team{ID: "team-ops", Key: "OPS"}
+	apollo := api.Project{ID: "p-apollo", Name: "Apollo", Slug: "apollo"}
+	zephyr := api.Project{ID: "p-zephyr", Name: "Zephyr", Slug: "zephyr"}
+	other := api.Project{ID: "p-other", Name: "Other", Slug: "other"}
+	issue := func(id, ident string, team api.Team, project api.Project) api.Issue {
+		return api.Issue{ID: id, Identifier: ident, Team: &team, Project: &project}
+	}
+	if err := fixtures.PopulateTeam(ctx, store, eng, nil, nil, []api.Issue{
+		issue("i1", "ENG-1", eng, apollo), issue("i2", "ENG-2", eng, other),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := fixtures.PopulateTeam(ctx, store, ops, nil, nil, []api.Issue{issue("i3", "OPS-1", ops, zephyr)}); err != nil {
+		t.Fatal(err)
+	}
+	initiative := api.Initiative{ID: "init-1", Name: "Platform", Slug: "platform"}
+	initiative.Projects.Nodes = []api.InitiativeProject{{ID: apollo.ID, Name: apollo.Name}}
+	if err := fixtures.PopulateInitiative(ctx, store, initiative); err != nil {
+		t.Fatal(err)
+	}
+
+	lfs := &LinearFS{}
+	if err := lfs.InjectTestStore(store); err != nil {
+		t.Fatalf("inject store: %v", err)
+	}
+	node := &InitiativeIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, initiative: initiative}
+	if got := readdirNames(t, node); !slices.Equal(got, []string{"ENG-1"}) {
+		t.Errorf("issues/ = %v, want ENG-1", got)
+	}
+
+	// Linking Zephyr shows its OPS issue without a fresh lookup.
+	initiative.Projects.Nodes = append(initiative.Projects.Nodes, api.InitiativeProject{ID: zephyr.ID, Name: zephyr.Name})
+	if err := fixtures.PopulateInitiative(ctx, store, initiative); err != nil {
+		t.Fatal(err)
+	}
+	if got := readdirNames(t, node); !slices.Equal(got, []string{"ENG-1", "OPS-1"}) {
+		t.Errorf("issues/ after linking Zephyr = %v, want ENG-1, OPS-1", got)
+	}
+	if _, errno := node.Lookup(ctx, "ENG-2", &fuse.EntryOut{}); errno != syscall.ENOENT {
+		t.Errorf("Lookup(ENG-2) = %v, want ENOENT for an unlinked project's issue", errno)
+	}
+}
@@ -96,8 +96,8 @@ func (i *InitiativeNode) Lookup(ctx context.Context, name string, out *fuse.Entr
 }
 
 // manifest declares an initiative directory's static children: the editable
-// initiative.md, the read-through initiative.meta, the .error sidecar, and the
-// docs/projects/updates subdirs. Initiative children have no dynamic tail and a
+// initiative.md, the read-through initiative.meta, the generated rollup.md, the
+// .error sidecar, and the docs/projects/issues/sub-initiatives/updates/links subdirs. Initiative children have no dynamic tail and a
 // 0 timeout.
 // entity()/setEntity() are promoted from the embedded entityCell[api.Initiative].
 // setEntity is written by the Rename write-back and the nodeRefresher seam
@@ -132,6 +132,12 @@ func (i *InitiativeNode) manifest() *dirManifest {
 		return node.metaContent(), init.UpdatedAt, init.CreatedAt
 	})
 
+	// rollup.md: project and issue counts over this initiative and its
+	// sub-initiatives, read from SQLite on every open.
+	m.renderFile("rollup.md", initiativeRollupIno(initiative.ID), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		return lfs.renderInitiativeRollup(ctx, initiative)
+	})
+
 	m.errorFile(".error")
 
 	m.subdir("docs", docsDirIno(initiative.ID), func() dirChild {
@@ -140,6 +146,12 @@ func (i *InitiativeNode) manifest() *dirManifest {
 	m.subdir("projects", initiativeProjectsIno(initiative.ID), func() dirChild {
 		return &InitiativeProjectsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, initiative: initiative}
 	})
+	m.subdir("issues", initiativeIssuesIno(initiative.ID), func() dirChild {
+		return &InitiativeIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, initiative: initiative}
+	})
+	m.subdir("sub-initiatives", subInitiativesDirIno(initiative.ID), func() dirChild {
+		return &SubInitiativesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, initiativeID: initiative.ID}
+	})
 	m.subdir("updates", initiativeUpdatesDirIno(initiative.ID), func() dirChild {
 		return &InitiativeUpdatesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, initiativeID: initiative.ID}
 	})
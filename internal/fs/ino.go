@@ -20,12 +20,29 @@ func ino(kind, id string) uint64 {
 
 // Issue tree ---------------------------------------------------------------
 
-func issueIno(issueID string) uint64       { return ino("issue", issueID) }
-func issueDirIno(issueID string) uint64    { return ino("issuedir", issueID) }
-func issuesDirIno(teamID string) uint64    { return ino("issues", teamID) }
-func childrenDirIno(issueID string) uint64 { return ino("children", issueID) }
-func historyIno(issueID string) uint64     { return ino("history", issueID) }
-func errorIno(issueID string) uint64       { return ino("error", issueID) }
+func issueIno(issueID string) uint64         { return ino("issue", issueID) }
+func issueDirIno(issueID string) uint64      { return ino("issuedir", issueID) }
+func issuesDirIno(teamID string) uint64      { return ino("issues", teamID) }
+func issueTrashDirIno(teamID string) uint64  { return ino("issue-trash", teamID) }
+func childrenDirIno(issueID string) uint64   { return ino("children", issueID) }
+func historyIno(issueID string) uint64       { return ino("history", issueID) }
+func backlinksIno(issueID string) uint64     { return ino("backlinks", issueID) }
+func attachmentsMdIno(issueID string) uint64 { return ino("attachments-md", issueID) }
+func issuePDFIno(issueID string) uint64      { return ino("issue-pdf", issueID) }
+func issueFullIno(issueID string) uint64     { return ino("issue-full", issueID) }
+func errorIno(issueID string) uint64         { return ino("error", issueID) }
+func descHistoryDirIno(issueID string) uint64 {
+	return ino("desc-history", issueID)
+}
+func descriptionVersionIno(issueID, name string) uint64 {
+	return ino("desc-version", issueID+"/"+name)
+}
+
+// Archived issues (issues/.archived/) --------------------------------------
+
+func archivedIssuesDirIno(teamID string) uint64 { return ino("archived-issues", teamID) }
+func archivedIssueDirIno(issueID string) uint64 { return ino("archived-issuedir", issueID) }
+func archivedIssueIno(issueID string) uint64    { return ino("archived-issue", issueID) }
 
 // Comments -----------------------------------------------------------------
 
@@ -34,12 +51,25 @@ func commentIno(commentID string) uint64   { return ino("comment", commentID) }
 func commentMetaIno(commentID string) uint64 {
 	return ino("comment-meta", commentID)
 }
+func commentTombstoneIno(commentID string) uint64 {
+	return ino("comment-tombstone", commentID)
+}
+func commentFullIno(commentID string) uint64 {
+	return ino("comment-full", commentID)
+}
+func draftsDirIno(issueID string) uint64 { return ino("drafts", issueID) }
+func draftIno(issueID, name string) uint64 {
+	return ino("draft", issueID+"/"+name)
+}
 
 // Documents ----------------------------------------------------------------
 
 func docsDirIno(parentID string) uint64   { return ino("docs", parentID) }
 func documentIno(docID string) uint64     { return ino("doc", docID) }
 func documentMetaIno(docID string) uint64 { return ino("doc-meta", docID) }
+func documentBacklinksIno(docID string) uint64 {
+	return ino("doc-backlinks", docID)
+}
 
 // Attachments --------------------------------------------------------------
 
@@ -57,6 +87,12 @@ func externalLinkIno(linkID string) uint64 { return ino("extlink", linkID) }
 func relationsDirIno(issueID string) uint64 { return ino("relations", issueID) }
 func relationIno(relationID string) uint64  { return ino("relation", relationID) }
 
+// relationLinksDirIno is one of an issue's relation link directories
+// (relates/, blocks/, blocked-by/), keyed by the view's name.
+func relationLinksDirIno(issueID, view string) uint64 {
+	return ino("relation-links", issueID+"/"+view)
+}
+
 // Labels -------------------------------------------------------------------
 
 func labelsDirIno(teamID string) uint64  { return ino("labels", teamID) }
@@ -67,6 +103,32 @@ func labelMetaIno(labelID string) uint64 { return ino("label-meta", labelID) }
 // workspace singleton, so the id is a constant.
 func projectLabelsCatalogIno() uint64 { return ino("project-labels-catalog", "workspace") }
 
+// organizationIno is the root organization.md — also a workspace singleton.
+func organizationIno() uint64 { return ino("organization", "workspace") }
+
+// eventsIno is the root .events long-poll file — a workspace singleton.
+func eventsIno() uint64 { return ino("events", "workspace") }
+
+// emojiMapIno is /.linearfs/emoji.json — a workspace singleton.
+func emojiMapIno() uint64 { return ino("emoji-map", "workspace") }
+
+// pendingDirIno is /.linearfs/pending/ — a workspace singleton.
+func pendingDirIno() uint64 { return ino("pending-dir", "workspace") }
+
+// pendingMutationIno is one queued write's file under /.linearfs/pending/.
+func pendingMutationIno(name string) uint64 { return ino("pending-mutation", name) }
+
+// controlStatusIno and controlLogLevelIno are /.linearfs/status and
+// /.linearfs/log-level — workspace singletons.
+func controlStatusIno() uint64   { return ino("control-status", "workspace") }
+func controlLogLevelIno() uint64 { return ino("control-log-level", "workspace") }
+
+// webhookFailuresIno is /.linearfs/webhook-failures — a workspace singleton.
+func webhookFailuresIno() uint64 { return ino("webhook-failures", "workspace") }
+
+// dircolorsIno is /.linearfs/dircolors — a workspace singleton.
+func dircolorsIno() uint64 { return ino("dircolors", "workspace") }
+
 // Projects -----------------------------------------------------------------
 
 func projectsDirIno(teamID string) uint64     { return ino("projects", teamID) }
@@ -75,6 +137,22 @@ func projectInfoIno(projectID string) uint64  { return ino("project-info", proje
 func updatesDirIno(projectID string) uint64   { return ino("updates", projectID) }
 func projectUpdateIno(updateID string) uint64 { return ino("project-update", updateID) }
 
+// projectExportIno is one of a project's generated exports (graph.dot,
+// graph.json, timeline.csv, timeline.json); the filename is part of the kind,
+// so no two exports share an inode.
+func projectExportIno(projectID, file string) uint64 {
+	return ino("project-export-"+file, projectID)
+}
+
+// The workspace projects/ tree shows the same project as teams/{KEY}/projects/.
+// Its files share the team tree's inodes (a hard link), but a directory can't
+// have two parents in the kernel's dcache, so each directory keys its own:
+// the project dir, and its subdirs by name.
+func workspaceProjectDirIno(projectID string) uint64 { return ino("ws-projectdir", projectID) }
+func workspaceProjectSubdirIno(projectID, name string) uint64 {
+	return ino("ws-project-"+name, projectID)
+}
+
 // Milestones ---------------------------------------------------------------
 
 func milestonesDirIno(projectID string) uint64 { return ino("milestones", projectID) }
@@ -83,6 +161,15 @@ func milestoneMetaIno(milestoneID string) uint64 {
 	return ino("milestone-meta", milestoneID)
 }
 
+// milestoneIssuesDirIno is a milestone's directory of issue links; the
+// workspace tree keys its own, as for workspaceProjectSubdirIno.
+func milestoneIssuesDirIno(milestoneID string) uint64 {
+	return ino("milestone-issues", milestoneID)
+}
+func workspaceMilestoneIssuesDirIno(milestoneID string) uint64 {
+	return ino("ws-milestone-issues", milestoneID)
+}
+
 // Initiatives --------------------------------------------------------------
 
 func initiativeDirIno(initiativeID string) uint64  { return ino("initiativedir", initiativeID) }
@@ -90,19 +177,37 @@ func initiativeInfoIno(initiativeID string) uint64 { return ino("initiative-info
 func initiativeProjectsIno(initiativeID string) uint64 {
 	return ino("initiative-projects", initiativeID)
 }
+func initiativeIssuesIno(initiativeID string) uint64 {
+	return ino("initiative-issues", initiativeID)
+}
+func subInitiativesDirIno(initiativeID string) uint64 {
+	return ino("sub-initiatives", initiativeID)
+}
+func initiativeRollupIno(initiativeID string) uint64 {
+	return ino("initiative-rollup", initiativeID)
+}
 func initiativeUpdatesDirIno(initiativeID string) uint64 {
 	return ino("initiative-updates", initiativeID)
 }
 func initiativeUpdateIno(updateID string) uint64 { return ino("initiative-update", updateID) }
 
 // Root views ----------------------------------------------------------------
-// The stateless top-level containers (teams/, users/, my/, initiatives/) and
-// the my/ subdirs are keyed by their fixed directory name — there is exactly
-// one of each per mount.
+// The stateless top-level containers (teams/, users/, my/, initiatives/,
+// projects/) and the my/ subdirs are keyed by their fixed directory name —
+// there is exactly one of each per mount.
 
 func viewDirIno(name string) uint64 { return ino("viewdir", name) }
 func myDirIno(name string) uint64   { return ino("mydir", name) }
 
+// activity/ is a root view; a day file is keyed by its date.
+func activityDayIno(day string) uint64 { return ino("activity-day", day) }
+
+// Document search (docs/search/) ----------------------------------------------
+// The search dir is a singleton; a results dir is keyed by its query text.
+
+func docSearchDirIno() uint64                 { return ino("docsearch", "workspace") }
+func docSearchResultsIno(query string) uint64 { return ino("docsearch-results", query) }
+
 // Team tree -----------------------------------------------------------------
 
 func teamDirIno(teamID string) uint64   { return ino("teamdir", teamID) }
@@ -125,11 +230,25 @@ func byValueIno(teamID, category, value string) uint64 {
 
 func userDirIno(userID string) uint64 { return ino("userdir", userID) }
 
+// Templates ----------------------------------------------------------------
+
+func templatesDirIno(teamID string) uint64 { return ino("templates", teamID) }
+func templateIno(templateID string) uint64 { return ino("template", templateID) }
+
+// Saved views (/views/) --------------------------------------------------------
+
+func customViewDirIno(viewID string) uint64 { return ino("customview", viewID) }
+
 // Team views ---------------------------------------------------------------
 
-func recentDirIno(teamID string) uint64 { return ino("recentdir", teamID) }
+func recentDirIno(teamID string) uint64    { return ino("recentdir", teamID) }
+func teamViewsDirIno(teamID string) uint64 { return ino("teamviews", teamID) }
+func teamViewIno(teamID, name string) uint64 {
+	return ino("teamview", teamID+"/"+name)
+}
 
 // Sidecars -----------------------------------------------------------------
 
-func metaIno(key string) uint64    { return ino("meta", key) }
-func successIno(key string) uint64 { return ino("last", key) }
+func metaIno(key string) uint64        { return ino("meta", key) }
+func successIno(key string) uint64     { return ino("last", key) }
+func lastCreatedIno(key string) uint64 { return ino("lastcreated", key) }
@@ -1,6 +1,9 @@
 package fs
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 // TestInoStableAndKeyed pins the three properties every inode number relies on:
 // it is stable for a given (kind, id), it varies with the id, and it varies with
@@ -36,15 +39,28 @@ func TestInodeNamespaceDistinct(t *testing.T) {
 		"issueIno":                issueIno(id),
 		"issueDirIno":             issueDirIno(id),
 		"issuesDirIno":            issuesDirIno(id),
+		"issueTrashDirIno":        issueTrashDirIno(id),
+		"archivedIssuesDirIno":    archivedIssuesDirIno(id),
+		"archivedIssueDirIno":     archivedIssueDirIno(id),
+		"archivedIssueIno":        archivedIssueIno(id),
 		"childrenDirIno":          childrenDirIno(id),
 		"historyIno":              historyIno(id),
+		"backlinksIno":            backlinksIno(id),
+		"attachmentsMdIno":        attachmentsMdIno(id),
+		"issuePDFIno":             issuePDFIno(id),
+		"issueFullIno":            issueFullIno(id),
 		"errorIno":                errorIno(id),
+		"descHistoryDirIno":       descHistoryDirIno(id),
+		"descriptionVersionIno":   descriptionVersionIno(id, "diff-latest.patch"),
 		"commentsDirIno":          commentsDirIno(id),
 		"commentIno":              commentIno(id),
 		"commentMetaIno":          commentMetaIno(id),
+		"commentTombstoneIno":     commentTombstoneIno(id),
+		"commentFullIno":          commentFullIno(id),
 		"docsDirIno":              docsDirIno(id),
 		"documentIno":             documentIno(id),
 		"documentMetaIno":         documentMetaIno(id),
+		"documentBacklinksIno":    documentBacklinksIno(id),
 		"attachmentsDirIno":       attachmentsDirIno(id),
 		"embeddedFileIno":         embeddedFileIno(id),
 		"externalAttachmentIno":   externalAttachmentIno(id),
@@ -52,10 +68,17 @@ func TestInodeNamespaceDistinct(t *testing.T) {
 		"externalLinkIno":         externalLinkIno(id),
 		"relationsDirIno":         relationsDirIno(id),
 		"relationIno":             relationIno(id),
+		"relationLinksDirIno":     relationLinksDirIno(id, "blocks"),
 		"labelsDirIno":            labelsDirIno(id),
 		"labelIno":                labelIno(id),
 		"labelMetaIno":            labelMetaIno(id),
 		"projectLabelsCatalogIno": projectLabelsCatalogIno(), // workspace singleton (no id)
+		"organizationIno":         organizationIno(),         // workspace singleton (no id)
+		"eventsIno":               eventsIno(),               // workspace singleton (no id)
+		"dircolorsIno":            dircolorsIno(),            // workspace singleton (no id)
+		"emojiMapIno":             emojiMapIno(),             // workspace singleton (no id)
+		"pendingDirIno":           pendingDirIno(),           // workspace singleton (no id)
+		"pendingMutationIno":      pendingMutationIno(id),
 		"projectsDirIno":          projectsDirIno(id),
 		"projectDirIno":           projectDirIno(id),
 		"projectInfoIno":          projectInfoIno(id),
@@ -66,12 +89,23 @@ func TestInodeNamespaceDistinct(t *testing.T) {
 		"milestoneIno":            milestoneIno(id),
 		"milestoneMetaIno":        milestoneMetaIno(id),
 		"initiativeDirIno":        initiativeDirIno(id),
+		"draftsDirIno":            draftsDirIno(id),
+		"draftIno":                draftIno(id, "reply.md"),
+		"subInitiativesDirIno":    subInitiativesDirIno(id),
+		"initiativeRollupIno":     initiativeRollupIno(id),
 		"initiativeInfoIno":       initiativeInfoIno(id),
 		"initiativeProjectsIno":   initiativeProjectsIno(id),
+		"initiativeIssuesIno":     initiativeIssuesIno(id),
 		"initiativeUpdatesDirIno": initiativeUpdatesDirIno(id),
 		"recentDirIno":            recentDirIno(id),
+		"customViewDirIno":        customViewDirIno(id),
+		"templatesDirIno":         templatesDirIno(id),
+		"templateIno":             templateIno(id),
+		"teamViewsDirIno":         teamViewsDirIno(id),
+		"teamViewIno":             teamViewIno(id, "x"),
 		"metaIno":                 metaIno(id),
 		"successIno":              successIno(id),
+		"lastCreatedIno":          lastCreatedIno(id),
 		// View/entity directory kinds (composite keys get the shared id for
 		// every part — distinctness must hold regardless).
 		"viewDirIno":    viewDirIno(id),
@@ -83,6 +117,21 @@ func TestInodeNamespaceDistinct(t *testing.T) {
 		"byCategoryIno": byCategoryIno(id, id),
 		"byValueIno":    byValueIno(id, id, id),
 		"userDirIno":    userDirIno(id),
+		// Document search: a singleton dir and per-query results.
+		"docSearchDirIno":     docSearchDirIno(),
+		"docSearchResultsIno": docSearchResultsIno(id),
+		// The workspace projects/ tree's own directory inodes.
+		"workspaceProjectDirIno":    workspaceProjectDirIno(id),
+		"workspaceProjectSubdirIno": workspaceProjectSubdirIno(id, "docs"),
+		// A milestone's issue links, in each tree.
+		"milestoneIssuesDirIno":          milestoneIssuesDirIno(id),
+		"workspaceMilestoneIssuesDirIno": workspaceMilestoneIssuesDirIno(id),
+		// The root activity/ changelog's day files.
+		"activityDayIno": activityDayIno(id),
+	}
+	// A project's generated exports, one inode per file.
+	for _, file := range slices.Concat(graphFiles, timelineFiles) {
+		namespace["projectExportIno("+file+")"] = projectExportIno(id, file)
 	}
 
 	seen := make(map[uint64]string, len(namespace))
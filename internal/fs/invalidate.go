@@ -2,6 +2,7 @@ package fs
 
 import (
 	"log"
+	"sync/atomic"
 	"time"
 
 	"github.com/hanwen/go-fuse/v2/fuse"
@@ -55,56 +56,65 @@ func boundedNotify(intent string, run func()) {
 // lfs.InvalidateUpdated / … keep working by promotion, and it satisfies
 // kernelNotifier itself.
 type kernelNotify struct {
-	server *fuse.Server
+	// server is swapped, not just set once: a watchdog remount (cmd/watchdog.go)
+	// replaces it while handlers and background refreshes are notifying.
+	server atomic.Pointer[fuse.Server]
 }
 
-// SetServer wires the FUSE server (known only after mount).
-func (k *kernelNotify) SetServer(server *fuse.Server) { k.server = server }
+// SetServer wires the FUSE server (known only after mount, and again after a
+// remount).
+func (k *kernelNotify) SetServer(server *fuse.Server) { k.server.Store(server) }
 
 // InvalidateKernelInode tells the kernel to drop cached data for an inode.
 func (k *kernelNotify) InvalidateKernelInode(ino uint64) {
-	if k.server != nil {
-		k.server.InodeNotify(ino, 0, -1) // -1 = entire file
+	if server := k.server.Load(); server != nil {
+		server.InodeNotify(ino, 0, -1) // -1 = entire file
 	}
 }
 
 // InvalidateKernelEntry tells the kernel to drop a cached directory entry.
 func (k *kernelNotify) InvalidateKernelEntry(parent uint64, name string) {
-	if k.server != nil {
-		k.server.EntryNotify(parent, name)
+	if server := k.server.Load(); server != nil {
+		server.EntryNotify(parent, name)
 	}
 }
 
-// InvalidateCreated / Deleted / Updated / Renamed name what happened; the
+// InvalidateCreated / Deleted / Updated / Renamed / Replaced name what happened; the
 // coherence policy (below) picks the correct notifies. fileIno/name may be zero
 // where the policy allows. Each runs its notify sequence through boundedNotify,
 // so a wedged InodeNotify/EntryNotify can no longer hang the calling handler
 // (#277). The nil-server short-circuit keeps the pre-mount / fixture no-op AND
 // avoids spawning a guard goroutine when there is nothing to notify.
 func (k *kernelNotify) InvalidateCreated(dirIno uint64, name string) {
-	if k.server == nil {
+	if k.server.Load() == nil {
 		return
 	}
 	boundedNotify("created", func() { invalidateCreated(k, dirIno, name) })
 }
 func (k *kernelNotify) InvalidateDeleted(dirIno uint64, name string) {
-	if k.server == nil {
+	if k.server.Load() == nil {
 		return
 	}
 	boundedNotify("deleted", func() { invalidateDeleted(k, dirIno, name) })
 }
 func (k *kernelNotify) InvalidateUpdated(fileIno uint64) {
-	if k.server == nil {
+	if k.server.Load() == nil {
 		return
 	}
 	boundedNotify("updated", func() { invalidateUpdated(k, fileIno) })
 }
 func (k *kernelNotify) InvalidateRenamed(dirIno uint64, oldName, newName string, fileIno uint64) {
-	if k.server == nil {
+	if k.server.Load() == nil {
 		return
 	}
 	boundedNotify("renamed", func() { invalidateRenamed(k, dirIno, oldName, newName, fileIno) })
 }
+func (k *kernelNotify) InvalidateReplaced(dirIno uint64, name string, fileIno uint64) {
+	if k.server.Load() == nil {
+		return
+	}
+	boundedNotify("replaced", func() { invalidateReplaced(k, dirIno, name, fileIno) })
+}
 
 // Kernel-cache coherence policy.
 //
@@ -172,3 +182,17 @@ func invalidateRenamed(n kernelNotifier, dirIno uint64, oldName, newName string,
 		n.InvalidateKernelInode(fileIno)
 	}
 }
+
+// invalidateReplaced refreshes an entry whose entity changed behind the
+// kernel's back (a remote edit the sync worker brought in): the cached lookup,
+// so the next path walk re-Lookups and the nodeRefresher seam pushes the fresh
+// entity into the node the kernel already knows, and — when fileIno is
+// nonzero — that node's cached bytes and attrs. Invalidating the inode alone
+// is not enough for a node that bakes its content (an editBuffer): without
+// the re-Lookup, the next read re-serves the baked bytes.
+func invalidateReplaced(n kernelNotifier, dirIno uint64, name string, fileIno uint64) {
+	n.InvalidateKernelEntry(dirIno, name)
+	if fileIno != 0 {
+		n.InvalidateKernelInode(fileIno)
+	}
+}
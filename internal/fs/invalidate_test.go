@@ -72,6 +72,19 @@ func TestInvalidateRenamed(t *testing.T) {
 	})
 }
 
+func TestInvalidateReplaced(t *testing.T) {
+	t.Run("baked file drops its entry and its cached bytes", func(t *testing.T) {
+		r := &recordingNotifier{}
+		invalidateReplaced(r, 3, "issue.md", 99)
+		eq(t, r.calls, []string{`entry(3,"issue.md")`, `inode(99)`})
+	})
+	t.Run("alias entry only", func(t *testing.T) {
+		r := &recordingNotifier{}
+		invalidateReplaced(r, 3, "ENG-1", 0)
+		eq(t, r.calls, []string{`entry(3,"ENG-1")`})
+	})
+}
+
 // TestBoundedNotify_FastPathRunsSynchronously: a notify that returns promptly is
 // run to completion before boundedNotify returns — the guard adds only a
 // goroutine hop on the happy path, so callers still see synchronous coherence.
@@ -0,0 +1,156 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// The issue.md append path.
+//
+// `echo "note" >> issue.md` opens with O_APPEND. Through the ordinary edit
+// buffer that would splice the bytes onto the rendered markdown at whatever
+// size the kernel last cached, then re-parse and diff the whole file — correct
+// only while that size is current, and a full frontmatter round-trip for what
+// is really "add a paragraph". An O_APPEND open instead gets its own per-open
+// buffer (the createFileHandle pattern): writes collect there regardless of
+// offset, and the close-time Flush appends them to the issue's CURRENT
+// description as a single description-only update, leaving every frontmatter
+// field untouched.
+
+// appendHandle is the per-open buffer of an O_APPEND issue.md open.
+type appendHandle struct {
+	mu      sync.Mutex
+	content []byte
+}
+
+// take consumes the buffer, so a dup'd descriptor's second flush no-ops.
+func (h *appendHandle) take() []byte {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	content := h.content
+	h.content = nil
+	return content
+}
+
+// Open hands O_APPEND opens an appendHandle; every other open is the plain
+// edit buffer, refetched first under cache.staleness.revalidate_issue_on_open.
+// A restricted issue (one Linear refuses this token) is EACCES rather than an
+// empty file that reads as a blank description.
+func (i *IssueFileNode) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	revalidate := i.lfs.revalidateOnOpen && flags&syscall.O_APPEND == 0
+	if revalidate {
+		i.revalidate(ctx)
+	}
+	i.mu.Lock()
+	restricted := i.issue.Restricted
+	i.mu.Unlock()
+	if restricted {
+		return nil, 0, syscall.EACCES
+	}
+	if flags&syscall.O_APPEND != 0 {
+		// DIRECT_IO: the appended bytes never belong in the page cache — the
+		// file's content only changes once the flush lands.
+		return &appendHandle{}, fuse.FOPEN_DIRECT_IO, 0
+	}
+	if revalidate {
+		// No KEEP_CACHE: pages cached by an earlier open may predate the fetch.
+		return nil, 0, 0
+	}
+	return i.editBuffer.Open(ctx, flags)
+}
+
+// revalidate refetches the issue and adopts its re-rendered body, unless an
+// edit is in flight — the dirty buffer always wins (refresh.go). No fetch, or
+// a failed render, leaves the buffer as it was.
+func (i *IssueFileNode) revalidate(ctx context.Context) {
+	i.mu.Lock()
+	issue, dirty := i.issue, i.dirty
+	i.mu.Unlock()
+	if dirty {
+		return
+	}
+	fresh, ok := i.lfs.revalidateIssue(ctx, issue)
+	if !ok {
+		return
+	}
+	var content []byte
+	if !fresh.Restricted {
+		var err error
+		if content, err = i.lfs.renderIssueFile(ctx, &fresh); err != nil {
+			return
+		}
+	}
+	i.refresh(content, func() { i.issue = fresh })
+}
+
+func (i *IssueFileNode) Write(ctx context.Context, f fs.FileHandle, data []byte, off int64) (uint32, syscall.Errno) {
+	h, ok := f.(*appendHandle)
+	if !ok {
+		return i.editBuffer.Write(ctx, f, data, off)
+	}
+	start := time.Now()
+	defer func() { recordFuseOp(ctx, "write", start, 0) }()
+	// The offset is the kernel's idea of end-of-file; an append is always
+	// relative to the description, so it is ignored.
+	h.mu.Lock()
+	h.content = append(h.content, data...)
+	h.mu.Unlock()
+	return uint32(len(data)), 0
+}
+
+// flushAppend appends the text written through an O_APPEND open to the issue's
+// description. The base is re-read from the API first, so a note never
+// clobbers a description edited elsewhere since this node was built; the save
+// then runs the same write-back tail as a full rewrite.
+func (i *IssueFileNode) flushAppend(ctx context.Context, appended []byte) syscall.Errno {
+	if len(appended) == 0 {
+		return 0
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	current, err := i.lfs.verify().GetIssue(ctx, i.issue.ID)
+	if err != nil {
+		log.Printf("Failed to read %s before append: %v", i.issue.Identifier, err)
+		msg, errno := classifyMutationErr("append to issue "+i.issue.Identifier, err)
+		i.lfs.SetIssueError(i.issue.ID, msg)
+		return errno
+	}
+	description, ok := marshal.AppendToDescription(current.Description, string(appended))
+	if !ok {
+		return 0
+	}
+	updates := map[string]any{"description": description}
+	if err := i.lfs.mutator().UpdateIssue(ctx, i.issue.ID, updates); err != nil {
+		log.Printf("Failed to append to issue %s: %v", i.issue.Identifier, err)
+		msg, errno := classifyMutationErr("append to issue "+i.issue.Identifier, err)
+		i.lfs.SetIssueError(i.issue.ID, msg)
+		return errno
+	}
+
+	fresh, errno := commitWriteBack(ctx, i.lfs, i.writeBack(&updates))
+	if fresh != nil {
+		invalidateIssueMoved(i.lfs, i.lfs.issueDirs, &i.issue, fresh)
+		i.issue = *fresh
+		// A dirty buffer is an in-flight full rewrite and stays the user's;
+		// otherwise the file now reads back with the note in its body.
+		if !i.dirty {
+			if content, err := i.lfs.renderIssueFile(ctx, fresh); err == nil {
+				i.content = content
+			}
+		}
+	}
+	i.lfs.InvalidateUpdated(issueIno(i.issue.ID))
+	i.lfs.InvalidateUpdated(metaIno(i.issue.ID))
+	return errno
+}
@@ -0,0 +1,94 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// TestIssueFileAppend drives an O_APPEND save: the written note lands as a new
+// description paragraph (the kernel's offset ignored), nothing else is sent,
+// and the node re-renders with the note in its body.
+func TestIssueFileAppend(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	issue := api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Append target", Description: "Original body.",
+		Priority: 2, Team: &api.Team{ID: "team-1", Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := lfs.UpsertIssue(ctx, issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	content, err := marshal.IssueToMarkdown(&issue)
+	if err != nil {
+		t.Fatalf("IssueToMarkdown: %v", err)
+	}
+	node := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: issue, editBuffer: editBuffer{content: content}}
+
+	fh, _, errno := node.Open(ctx, syscall.O_WRONLY|syscall.O_APPEND)
+	if errno != 0 {
+		t.Fatalf("Open(O_APPEND) = %v", errno)
+	}
+	if _, ok := fh.(*appendHandle); !ok {
+		t.Fatalf("Open(O_APPEND) handle = %T, want *appendHandle", fh)
+	}
+	// The offset is whatever size the kernel cached; it must not matter.
+	if _, errno := node.Write(ctx, fh, []byte("follow-up note\n"), 3); errno != 0 {
+		t.Fatalf("Write = %v", errno)
+	}
+	if errno := node.Flush(ctx, fh); errno != 0 {
+		t.Fatalf("Flush = %v", errno)
+	}
+
+	if want := "Original body.\n\nfollow-up note"; node.issue.Description != want {
+		t.Errorf("description = %q, want %q", node.issue.Description, want)
+	}
+	if node.issue.Priority != 2 || node.issue.Title != "Append target" {
+		t.Errorf("append touched other fields: %+v", node.issue)
+	}
+	if !strings.Contains(string(node.content), "follow-up note") {
+		t.Errorf("issue.md not re-rendered after append:\n%s", node.content)
+	}
+
+	// A second flush of the same (dup'd) descriptor appends nothing more.
+	if errno := node.Flush(ctx, fh); errno != 0 {
+		t.Fatalf("second Flush = %v", errno)
+	}
+	if strings.Count(node.issue.Description, "follow-up note") != 1 {
+		t.Errorf("note appended twice: %q", node.issue.Description)
+	}
+}
+
+// TestIssueFileOpenRevalidate: under cache.staleness.revalidate_issue_on_open
+// a plain open gives up KEEP_CACHE, so no page cached before the refetch is
+// served; without it the edit buffer's KEEP_CACHE stands. (The fixture repo
+// has no client, so the refetch itself is a no-op that keeps the body.)
+func TestIssueFileOpenRevalidate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	issue := api.Issue{ID: "issue-1", Identifier: "ENG-1", Title: "Open target", Team: &api.Team{ID: "team-1", Key: "ENG"}}
+	for _, revalidate := range []bool{false, true} {
+		lfs, _ := linkTestLFS(t)
+		lfs.revalidateOnOpen = revalidate
+		node := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: issue, editBuffer: editBuffer{content: []byte("body")}}
+		_, flags, errno := node.Open(ctx, syscall.O_RDONLY)
+		if errno != 0 {
+			t.Fatalf("revalidate=%v: Open = %v", revalidate, errno)
+		}
+		if keep := flags&fuse.FOPEN_KEEP_CACHE != 0; keep == revalidate {
+			t.Errorf("revalidate=%v: KEEP_CACHE = %v", revalidate, keep)
+		}
+		if string(node.content) != "body" {
+			t.Errorf("revalidate=%v: content = %q, want the cached body", revalidate, node.content)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// issues/.archived/ lists the team's issues archived on Linear, which the
+// live issues/ listing leaves out. The full sync keeps them in SQLite
+// (archived_issues, sync/archived.go) so they stay greppable: each {ID}/
+// holds a read-only issue.md, rendered as the live one is. Not to be
+// confused with .archive/, the rmdir trash of issues not yet archived
+// (issuetrash.go).
+//
+// `mv .archived/ENG-123 .` from issues/ unarchives the issue on Linear: the
+// inverse of rmdir's archive.
+
+// archivedIssuesDirName is the archived view's name inside issues/.
+const archivedIssuesDirName = ".archived"
+
+// ArchivedIssuesNode is /teams/{KEY}/issues/.archived/.
+type ArchivedIssuesNode struct {
+	attrNode
+	entityCell[api.Team]
+}
+
+var _ fs.NodeReaddirer = (*ArchivedIssuesNode)(nil)
+var _ fs.NodeLookuper = (*ArchivedIssuesNode)(nil)
+var _ fs.NodeRenamer = (*ArchivedIssuesNode)(nil)
+
+func (n *ArchivedIssuesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.lfs.repo.GetTeamArchivedIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(issues))
+	for i, issue := range issues {
+		entries[i] = fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFDIR}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+// find resolves a listed name to the team's archived issue, or nil.
+func (n *ArchivedIssuesNode) find(ctx context.Context, name string) (*api.Issue, error) {
+	if !looksLikeIdentifier(name) {
+		return nil, nil
+	}
+	issue, err := n.lfs.repo.GetArchivedIssueByIdentifier(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// Identifiers are global; one archived in another team is not this one's.
+	if issue == nil || issue.Team == nil || issue.Team.ID != n.entity().ID {
+		return nil, nil
+	}
+	return issue, nil
+}
+
+func (n *ArchivedIssuesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issue, err := n.find(ctx, name)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	if issue == nil {
+		return nil, syscall.ENOENT
+	}
+	node := &ArchivedIssueNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Issue]{val: *issue}}
+	na := nodeAttr{mode: 0555 | syscall.S_IFDIR, created: issue.CreatedAt, updated: issue.UpdatedAt}
+	return n.newDirInode(ctx, out, name, node, na, archivedIssueDirIno(issue.ID), 30*time.Second), 0
+}
+
+// Rename unarchives an issue: `mv .archived/ENG-123 .` from issues/ (any
+// target name issues/ resolves to the same issue). Like rmdir's archive it
+// reports failures in issues/.error. On success the issue moves from
+// archived_issues back to issues before the rename returns, marked for a full
+// fetch (its row has the sync's lite fields), so both listings show it where
+// it now is without waiting for sync.
+func (n *ArchivedIssuesNode) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	team := n.entity()
+	dest, ok := newParent.(*IssuesNode)
+	if !ok || dest.entity().ID != team.ID {
+		return syscall.EXDEV
+	}
+	key := collectionErrorKey("issues", team.ID)
+	op := `unarchive issue "` + name + `"`
+	issue, err := n.find(ctx, name)
+	if err != nil {
+		msg, errno := classifyMutationErr(op, err)
+		n.lfs.SetWriteError(key, msg)
+		return errno
+	}
+	if issue == nil {
+		return syscall.ENOENT
+	}
+	if ident, _ := n.lfs.issueDirs.identifier(newName); ident != issue.Identifier {
+		return syscall.EINVAL
+	}
+	if n.lfs.debug {
+		log.Printf("Rename: %s out of team %s .archived (unarchiving issue)", name, team.Key)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	if err := n.lfs.mutator().UnarchiveIssue(ctx, issue.ID); err != nil {
+		msg, errno := classifyMutationErr(op, err)
+		log.Printf("Failed to %s: %v", op, err)
+		n.lfs.SetWriteError(key, msg)
+		return errno
+	}
+	issue.ArchivedAt = nil
+	q := n.lfs.store.Queries()
+	if err := n.lfs.UpsertIssue(ctx, *issue); err != nil {
+		log.Printf("Failed to cache unarchived issue %s: %v", issue.Identifier, err)
+	} else if err := q.UpsertPendingIssueFields(ctx, db.UpsertPendingIssueFieldsParams{IssueID: issue.ID, QueuedAt: db.Now()}); err != nil {
+		log.Printf("Failed to mark unarchived issue %s for a full fetch: %v", issue.Identifier, err)
+	}
+	if err := q.DeleteArchivedIssue(ctx, issue.ID); err != nil {
+		log.Printf("Failed to drop unarchived issue %s from .archived: %v", issue.Identifier, err)
+	}
+	n.lfs.ClearWriteError(key)
+	n.lfs.InvalidateDeleted(archivedIssuesDirIno(team.ID), name)
+	invalidateIssueMoved(n.lfs, n.lfs.issueDirs, nil, issue)
+	return 0
+}
+
+// ArchivedIssueNode is issues/.archived/{ID}/: an archived issue's issue.md.
+type ArchivedIssueNode struct {
+	attrNode
+	entityCell[api.Issue]
+}
+
+var _ fs.NodeReaddirer = (*ArchivedIssueNode)(nil)
+var _ fs.NodeLookuper = (*ArchivedIssueNode)(nil)
+
+func (n *ArchivedIssueNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	return fs.NewListDirStream([]fuse.DirEntry{{Name: "issue.md", Mode: syscall.S_IFREG}}), 0
+}
+
+func (n *ArchivedIssueNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if name != "issue.md" {
+		return nil, syscall.ENOENT
+	}
+	issue := n.entity()
+	// Read from archived_issues on each read, so a later full sync's copy
+	// shows; the Lookup snapshot stands in once the issue is unarchived.
+	return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		current := &issue
+		if fresh, err := n.lfs.repo.GetArchivedIssueByIdentifier(ctx, issue.Identifier); err == nil && fresh != nil {
+			current = fresh
+		}
+		content, err := n.lfs.renderIssueFile(ctx, current)
+		if err != nil {
+			log.Printf("Failed to render archived issue %s: %v", issue.Identifier, err)
+		}
+		return content, current.UpdatedAt, current.CreatedAt
+	}, archivedIssueIno(issue.ID), 30*time.Second), 0
+}
+
+// lookupArchivedIssues serves issues/.archived/.
+func (n *IssuesNode) lookupArchivedIssues(ctx context.Context, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	team := n.entity()
+	node := &ArchivedIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Team]{val: team}}
+	// Writable only as far as mv out of it (Rename); each {ID}/ is 0555.
+	return n.newDirInode(ctx, out, archivedIssuesDirName, node, dirAttr(team.CreatedAt, team.UpdatedAt), archivedIssuesDirIno(team.ID), inheritTimeout), 0
+}
@@ -0,0 +1,127 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// TestArchivedIssuesDir: issues/ lists .archived/, which lists the team's
+// archived issues newest first and resolves no other team's.
+func TestArchivedIssuesDir(t *testing.T) {
+	t.Parallel()
+	lfs, issues := issueRmdirTestNode(t, "")
+	ctx := context.Background()
+	team := issues.entity()
+	other := api.Team{ID: "team-2", Key: "OPS"}
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	for i, issue := range []api.Issue{
+		{ID: "a1", Identifier: "TST-7", Team: &team},
+		{ID: "a2", Identifier: "TST-8", Team: &team},
+		{ID: "a3", Identifier: "OPS-1", Team: &other},
+	} {
+		archivedAt := base.Add(time.Duration(i) * time.Minute)
+		issue.ArchivedAt = &archivedAt
+		params, err := db.APIIssueToDBArchivedIssue(issue)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := lfs.store.Queries().UpsertArchivedIssue(ctx, params); err != nil {
+			t.Fatalf("UpsertArchivedIssue: %v", err)
+		}
+	}
+
+	if names := readdirNames(t, issues); !slices.Contains(names, archivedIssuesDirName) {
+		t.Errorf("issues/ = %v, want %s listed", names, archivedIssuesDirName)
+	}
+	archived := &ArchivedIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}}
+	if got, want := readdirNames(t, archived), []string{"TST-8", "TST-7"}; !slices.Equal(got, want) {
+		t.Errorf(".archived/ = %v, want %v", got, want)
+	}
+	for _, name := range []string{"OPS-1", "TST-1", "issue.md"} {
+		if _, errno := archived.Lookup(ctx, name, &fuse.EntryOut{}); errno != syscall.ENOENT {
+			t.Errorf("Lookup(%s) = %v, want ENOENT", name, errno)
+		}
+	}
+}
+
+// unarchiveMutator records UnarchiveIssue calls, failing with err when set.
+type unarchiveMutator struct {
+	MutationClient
+	err        error
+	unarchived *[]string
+}
+
+func (m unarchiveMutator) UnarchiveIssue(ctx context.Context, issueID string) error {
+	if m.err != nil {
+		return m.err
+	}
+	*m.unarchived = append(*m.unarchived, issueID)
+	return nil
+}
+
+// TestArchivedIssueRename: mv out of .archived/ into the team's issues/
+// unarchives the issue and moves its row back; a failed mutation leaves it
+// archived and says why in issues/.error, and any other destination is
+// refused.
+func TestArchivedIssueRename(t *testing.T) {
+	t.Parallel()
+	lfs, issues := issueRmdirTestNode(t, "")
+	ctx := context.Background()
+	team := issues.entity()
+	archivedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	params, err := db.APIIssueToDBArchivedIssue(api.Issue{ID: "a1", Identifier: "TST-7", Title: "Old", Team: &team, ArchivedAt: &archivedAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lfs.store.Queries().UpsertArchivedIssue(ctx, params); err != nil {
+		t.Fatalf("UpsertArchivedIssue: %v", err)
+	}
+	archived := &ArchivedIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}}
+	key := collectionErrorKey("issues", team.ID)
+
+	other := &IssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: api.Team{ID: "team-2", Key: "OPS"}}}
+	if errno := archived.Rename(ctx, "TST-7", other, "TST-7", 0); errno != syscall.EXDEV {
+		t.Errorf("Rename to another team = %v, want EXDEV", errno)
+	}
+	if errno := archived.Rename(ctx, "TST-7", issues, "TST-8", 0); errno != syscall.EINVAL {
+		t.Errorf("Rename to another identifier = %v, want EINVAL", errno)
+	}
+
+	var unarchived []string
+	lfs.InjectTestMutationClient(unarchiveMutator{err: errors.New("Entity not found"), unarchived: &unarchived})
+	if errno := archived.Rename(ctx, "TST-7", issues, "TST-7", 0); errno == 0 {
+		t.Fatal("Rename succeeded with a failing mutation")
+	}
+	if e := lfs.GetWriteError(key); e == nil || !strings.Contains(e.Message, `unarchive issue "TST-7"`) {
+		t.Errorf(".error = %+v, want the failed unarchive", e)
+	}
+	if got := readdirNames(t, archived); !slices.Equal(got, []string{"TST-7"}) {
+		t.Errorf(".archived/ after a failed unarchive = %v", got)
+	}
+
+	lfs.InjectTestMutationClient(unarchiveMutator{unarchived: &unarchived})
+	if errno := archived.Rename(ctx, "TST-7", issues, "TST-7", 0); errno != 0 {
+		t.Fatalf("Rename = %v", errno)
+	}
+	if !slices.Equal(unarchived, []string{"a1"}) {
+		t.Errorf("unarchived = %v, want a1", unarchived)
+	}
+	if got := readdirNames(t, archived); len(got) != 0 {
+		t.Errorf(".archived/ after unarchive = %v, want empty", got)
+	}
+	if names := readdirNames(t, issues); !slices.Contains(names, "TST-7") {
+		t.Errorf("issues/ = %v, want TST-7 back", names)
+	}
+	if lfs.GetWriteError(key) != nil {
+		t.Error(".error not cleared by the unarchive")
+	}
+}
@@ -0,0 +1,90 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// TestIssueFileBodyEdit drives the $EDITOR save of issue.md: the whole file
+// rewritten with a new body below untouched frontmatter. The body goes out as
+// the description and nothing else, and the write-back lands it in SQLite at
+// once, so a fresh read of the cache (not just this node) sees it.
+func TestIssueFileBodyEdit(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	issue := api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Body target", Description: "Old body.",
+		Priority: 2, Team: &api.Team{ID: "team-1", Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := lfs.UpsertIssue(ctx, issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	content, err := marshal.IssueToMarkdown(&issue)
+	if err != nil {
+		t.Fatalf("IssueToMarkdown: %v", err)
+	}
+	node := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: issue, editBuffer: editBuffer{content: content}}
+
+	doc, err := marshal.Parse(content)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	const body = "## Steps\n\n1. Open the app\n2. Log in\n\n```go\nfmt.Println(\"---\")\n```"
+	doc.Body = body
+	edited, err := marshal.Render(doc)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	fh, _, errno := node.Open(ctx, syscall.O_WRONLY|syscall.O_TRUNC)
+	if errno != 0 {
+		t.Fatalf("Open = %v", errno)
+	}
+	if _, errno := node.Write(ctx, fh, edited, 0); errno != 0 {
+		t.Fatalf("Write = %v", errno)
+	}
+	if errno := node.Flush(ctx, fh); errno != 0 {
+		t.Fatalf("Flush = %v", errno)
+	}
+
+	if strings.TrimSpace(node.issue.Description) != body {
+		t.Errorf("node description = %q, want %q", node.issue.Description, body)
+	}
+	if node.issue.Priority != 2 || node.issue.Title != "Body target" {
+		t.Errorf("body edit touched other fields: %+v", node.issue)
+	}
+	cached, err := lfs.repo.GetIssueByID(ctx, issue.ID)
+	if err != nil || cached == nil {
+		t.Fatalf("GetIssueByID: %v", err)
+	}
+	if strings.TrimSpace(cached.Description) != body {
+		t.Errorf("SQLite description = %q, want %q", cached.Description, body)
+	}
+	if werr := lfs.GetIssueError(issue.ID); werr != nil {
+		t.Errorf("save left an error: %+v", werr)
+	}
+}
+
+// TestIssueFileRestrictedOpen: an issue Linear refuses this token is EACCES
+// for every open — read, rewrite, and append — instead of an empty body.
+func TestIssueFileRestrictedOpen(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	issue := api.Issue{ID: "issue-1", Identifier: "ENG-1", Title: "Private", Restricted: true}
+	node := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: issue}
+
+	for _, flags := range []uint32{syscall.O_RDONLY, syscall.O_WRONLY | syscall.O_TRUNC, syscall.O_WRONLY | syscall.O_APPEND} {
+		if _, _, errno := node.Open(context.Background(), flags); errno != syscall.EACCES {
+			t.Errorf("Open(%#x) = %v, want EACCES", flags, errno)
+		}
+	}
+}
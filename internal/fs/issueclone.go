@@ -0,0 +1,75 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// The issues/_clone trigger.
+//
+// Triage often means "the same issue again": a recurring bug, the same task
+// for another service. `cp -r issues/ENG-12 …` cannot express that — each
+// copied file would be a separate write, and mkdir already means "new issue
+// with this title" — so cloning is a trigger file beside _create:
+//
+//	echo ENG-12 > issues/_clone
+//
+// creates a new issue in this team carrying the source's title, description,
+// labels, and project, with a fresh identifier. Status, assignee, estimate,
+// and relations are deliberately not copied: a clone starts untriaged. It
+// reports like any issue create — issues/.error, .last, and .last-created.
+
+// cloneTriggerName is the write-only trigger file in issues/.
+const cloneTriggerName = "_clone"
+
+// cloneIssue is the issues/_clone surface's onFlush.
+func (n *IssuesNode) cloneIssue(ctx context.Context, content []byte) syscall.Errno {
+	team := n.entity()
+	source := strings.TrimSpace(string(content))
+	_, errno := commitCreate(ctx, n.lfs, n.lfs.issueCreateSpec(
+		team.ID,
+		`clone issue "`+source+`"`,
+		collectionErrorKey("issues", team.ID),
+		issuesDirIno(team.ID),
+		func(ctx context.Context) (*api.Issue, error) {
+			if !looksLikeIdentifier(source) {
+				return nil, &FieldError{Field: "source", Value: source, Message: "write the identifier of the issue to clone (e.g. ENG-12)"}
+			}
+			src, err := n.lfs.repo.GetIssueByIdentifier(ctx, source)
+			if err != nil {
+				return nil, err
+			}
+			if src == nil {
+				return nil, &FieldError{Field: "source", Value: source, Message: "no such issue"}
+			}
+			return n.lfs.mutator().CreateIssue(ctx, cloneIssueInput(src, team.ID))
+		},
+	))
+	return errno
+}
+
+// cloneIssueInput builds the create input for a clone of src in teamID. A
+// label owned by another team cannot be applied here, so a cross-team clone
+// keeps only the source's workspace labels.
+func cloneIssueInput(src *api.Issue, teamID string) map[string]any {
+	input := map[string]any{"teamId": teamID, "title": src.Title}
+	if src.Description != "" {
+		input["description"] = src.Description
+	}
+	var labelIDs []string
+	for _, l := range src.Labels.Nodes {
+		if l.Team == nil || l.Team.ID == teamID || (src.Team != nil && src.Team.ID == teamID) {
+			labelIDs = append(labelIDs, l.ID)
+		}
+	}
+	if len(labelIDs) > 0 {
+		input["labelIds"] = labelIDs
+	}
+	if src.Project != nil {
+		input["projectId"] = src.Project.ID
+	}
+	return input
+}
@@ -0,0 +1,68 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestCloneIssue drives issues/_clone: the clone carries the source's title,
+// description, labels, and project under a fresh identifier and reports to
+// .last; an unknown source is a field error that creates nothing.
+func TestCloneIssue(t *testing.T) {
+	t.Parallel()
+	lfs, _ := linkTestLFS(t)
+	ctx := context.Background()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	source := api.Issue{
+		ID: "issue-src", Identifier: "ENG-12", Title: "Flaky deploy", Description: "Retry the rollout.",
+		Priority: 1, Team: &team, CreatedAt: now, UpdatedAt: now,
+		State:   api.State{ID: "state-done", Name: "Done"},
+		Labels:  api.Labels{Nodes: []api.Label{{ID: "label-bug", Name: "Bug"}}},
+		Project: &api.Project{ID: "proj-1", Name: "Infra"},
+	}
+	if err := lfs.UpsertIssue(ctx, source); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	n := &IssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}}
+	key := collectionErrorKey("issues", team.ID)
+
+	if errno := n.cloneIssue(ctx, []byte("ENG-12\n")); errno != 0 {
+		t.Fatalf("cloneIssue = %v (.error: %+v)", errno, lfs.GetWriteError(key))
+	}
+	results := lfs.GetWriteSuccess(key)
+	if len(results) != 1 || results[0].Identifier == "ENG-12" {
+		t.Fatalf(".last = %+v, want one fresh identifier", results)
+	}
+	clone, err := lfs.FetchIssueByIdentifier(ctx, results[0].Identifier)
+	if err != nil {
+		t.Fatalf("clone not readable back: %v", err)
+	}
+	if clone.Title != source.Title || clone.Description != source.Description {
+		t.Errorf("clone = %q / %q, want the source's title and description", clone.Title, clone.Description)
+	}
+	if len(clone.Labels.Nodes) != 1 || clone.Labels.Nodes[0].ID != "label-bug" {
+		t.Errorf("clone labels = %+v, want [label-bug]", clone.Labels.Nodes)
+	}
+	if clone.Project == nil || clone.Project.ID != "proj-1" {
+		t.Errorf("clone project = %+v, want proj-1", clone.Project)
+	}
+	if clone.State.ID == "state-done" {
+		t.Error("clone copied the source's status; a clone starts untriaged")
+	}
+
+	if errno := n.cloneIssue(ctx, []byte("ENG-999")); errno != syscall.EINVAL {
+		t.Errorf("clone of unknown issue = %v, want EINVAL", errno)
+	}
+	if we := lfs.GetWriteError(key); we == nil {
+		t.Error("clone of unknown issue left no .error")
+	}
+	if got := lfs.GetWriteSuccess(key); len(got) != 1 {
+		t.Errorf(".last after failed clone = %+v, want unchanged", got)
+	}
+}
@@ -0,0 +1,141 @@
+package fs
+
+import (
+	"strconv"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// Issue view coherence.
+//
+// An issue is listed in many directories besides its own: issues/ (under the
+// configured dir name), by/status|label|assignee|priority|project|cycle, its
+// parent's children/, its cycle, its project, its assignee's users/ dir, and
+// my/. SQLite is already current the moment a write tail persists, so every
+// Readdir is right — but the kernel keeps the old dentries (by/status/Todo/ENG-1
+// after the issue moved to Done) until their entry timeout. Every issue write
+// tail (create, edit, append, archive) therefore diffs where the issue was
+// listed against where it is now and notifies exactly the entries that
+// appeared, vanished, or were renamed.
+
+// membershipSink is the notify surface invalidateIssueMoved drives. *LinearFS
+// satisfies it through kernelNotify.
+type membershipSink interface {
+	InvalidateCreated(dirIno uint64, name string)
+	InvalidateDeleted(dirIno uint64, name string)
+	InvalidateRenamed(dirIno uint64, oldName, newName string, fileIno uint64)
+}
+
+// issueMemberships maps each listing directory (by inode) the issue appears in
+// to the entry name it appears under there. A nil or team-less issue is listed
+// nowhere, which lets create and archive diff against nothing.
+func issueMemberships(namer *issueDirNamer, issue *api.Issue) map[uint64]string {
+	m := make(map[uint64]string)
+	if issue == nil || issue.Team == nil {
+		return m
+	}
+	team, ident := issue.Team.ID, issue.Identifier // safename:ok structured id
+	m[issuesDirIno(team)] = namer.name(issue)
+	// by/ values are the unsuffixed names: a value uniqueNames suffixed for a
+	// collision is not notified and catches up when its entry times out.
+	if issue.State.ID != "" {
+		m[byValueIno(team, "status", safeName(issue.State.Name, issue.State.ID))] = ident
+	}
+	for _, l := range issue.Labels.Nodes {
+		m[byValueIno(team, "label", safeName(l.Name, l.ID))] = ident
+	}
+	// my/ is the viewer's slice of the users/ views. issueMemberships does not
+	// know who the viewer is, so every assigned or created issue counts as
+	// listed there: a notify for a name my/ never listed is a no-op.
+	assignee := "unassigned"
+	if issue.Assignee != nil {
+		assignee = assigneeHandle(issue.Assignee)
+		m[userDirIno(issue.Assignee.ID)] = ident
+		m[myDirIno("assigned")] = ident
+		if issue.State.Type != "completed" && issue.State.Type != "canceled" {
+			m[myDirIno("active")] = ident
+		}
+	}
+	if issue.Creator != nil {
+		m[myDirIno("created")] = ident
+		if handle := creatorHandle(issue.Creator); handle != "" {
+			m[byValueIno(team, "creator", handle)] = ident
+		}
+	}
+	m[byValueIno(team, "assignee", assignee)] = ident
+	m[byValueIno(team, "priority", api.PriorityName(issue.Priority))] = ident
+	if issue.Parent != nil {
+		m[childrenDirIno(issue.Parent.ID)] = ident
+	}
+	if issue.Cycle != nil {
+		m[cycleDirIno(issue.Cycle.ID)] = ident
+		m[byValueIno(team, "cycle", strconv.Itoa(issue.Cycle.Number))] = ident
+	}
+	if issue.Project != nil {
+		m[projectDirIno(issue.Project.ID)] = ident
+		m[workspaceProjectSubdirIno(issue.Project.ID, "issues")] = ident
+		m[byValueIno(team, "project", projectDirName(*issue.Project))] = ident
+		if issue.ProjectMilestone != nil {
+			m[milestoneIssuesDirIno(issue.ProjectMilestone.ID)] = ident
+			m[workspaceMilestoneIssuesDirIno(issue.ProjectMilestone.ID)] = ident
+		}
+	}
+	return m
+}
+
+// invalidateIssueMoved notifies the kernel of every listing entry that changed
+// between before and after (either may be nil: a create or an archive). Any
+// write also bumps updatedAt, which reorders recent/.
+func invalidateIssueMoved(sink membershipSink, namer *issueDirNamer, before, after *api.Issue) {
+	was, is := issueMemberships(namer, before), issueMemberships(namer, after)
+	for dir, name := range was {
+		newName, still := is[dir]
+		switch {
+		case !still:
+			sink.InvalidateDeleted(dir, name)
+		case newName != name:
+			sink.InvalidateRenamed(dir, name, newName, 0)
+		}
+	}
+	for dir, name := range is {
+		if _, had := was[dir]; !had {
+			sink.InvalidateCreated(dir, name)
+		}
+	}
+	switch {
+	case after != nil && after.Team != nil:
+		sink.InvalidateCreated(recentDirIno(after.Team.ID), after.Identifier)
+	case before != nil && before.Team != nil:
+		sink.InvalidateDeleted(recentDirIno(before.Team.ID), before.Identifier)
+	}
+}
+
+// issueChangeSink is membershipSink plus the content intents: what a
+// sync-observed change needs. *LinearFS satisfies it through kernelNotify.
+type issueChangeSink interface {
+	membershipSink
+	InvalidateUpdated(fileIno uint64)
+	InvalidateReplaced(dirIno uint64, name string, fileIno uint64)
+}
+
+// invalidateIssueSynced is invalidateIssueMoved for a change the sync worker
+// brought in rather than a local write: the same listing diff, plus the
+// issue's own directory and files, which no write tail refreshed. The issue
+// directory and issue.md bake the entity at Lookup, so their entries are
+// dropped to force the re-Lookup that refreshes them (both the listed and
+// the bare directory name, either of which a path walk may have cached);
+// issue.meta renders on read and only needs its attrs dropped. Without this
+// a remote edit stayed invisible to an open editor or a stat-polling watcher
+// until the kernel's cache timeouts ran out.
+func invalidateIssueSynced(sink issueChangeSink, namer *issueDirNamer, before, after *api.Issue) {
+	invalidateIssueMoved(sink, namer, before, after)
+	if after.Team != nil {
+		dir, name := issuesDirIno(after.Team.ID), namer.name(after)
+		sink.InvalidateReplaced(dir, name, issueDirIno(after.ID))
+		if after.Identifier != name {
+			sink.InvalidateReplaced(dir, after.Identifier, 0) // safename:ok structured id
+		}
+	}
+	sink.InvalidateReplaced(issueDirIno(after.ID), "issue.md", issueIno(after.ID))
+	sink.InvalidateUpdated(metaIno(after.ID))
+}
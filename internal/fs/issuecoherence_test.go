@@ -0,0 +1,153 @@
+package fs
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// recordingSink records the notifications invalidateIssueMoved sends.
+type recordingSink struct{ got []string }
+
+func (s *recordingSink) InvalidateCreated(dir uint64, name string) {
+	s.got = append(s.got, fmt.Sprintf("created %d %s", dir, name))
+}
+
+func (s *recordingSink) InvalidateDeleted(dir uint64, name string) {
+	s.got = append(s.got, fmt.Sprintf("deleted %d %s", dir, name))
+}
+
+func (s *recordingSink) InvalidateRenamed(dir uint64, oldName, newName string, _ uint64) {
+	s.got = append(s.got, fmt.Sprintf("renamed %d %s %s", dir, oldName, newName))
+}
+
+func (s *recordingSink) InvalidateUpdated(ino uint64) {
+	s.got = append(s.got, fmt.Sprintf("updated %d", ino))
+}
+
+func (s *recordingSink) InvalidateReplaced(dir uint64, name string, ino uint64) {
+	s.got = append(s.got, fmt.Sprintf("replaced %d %s %d", dir, name, ino))
+}
+
+// TestInvalidateIssueMoved pins which listing entries an issue write notifies:
+// a status move drops the old by/status entry and adds the new one, a title
+// edit renames a templated issues/ entry, and create/archive notify every
+// view the issue is (or was) listed in.
+func TestInvalidateIssueMoved(t *testing.T) {
+	t.Parallel()
+	namer := newIssueDirNamer("{identifier}-{slugified-title}")
+	before := &api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Old title", Priority: 2,
+		Team:  &api.Team{ID: "team-1", Key: "ENG"},
+		State: api.State{ID: "state-todo", Name: "Todo"},
+	}
+	after := *before
+	after.Title = "New title"
+	after.State = api.State{ID: "state-done", Name: "Done"}
+
+	run := func(before, after *api.Issue) []string {
+		var sink recordingSink
+		invalidateIssueMoved(&sink, namer, before, after)
+		sort.Strings(sink.got)
+		return sink.got
+	}
+	contains := func(got []string, want string) bool {
+		for _, g := range got {
+			if g == want {
+				return true
+			}
+		}
+		return false
+	}
+
+	moved := run(before, &after)
+	for _, want := range []string{
+		fmt.Sprintf("deleted %d ENG-1", byValueIno("team-1", "status", "Todo")),
+		fmt.Sprintf("created %d ENG-1", byValueIno("team-1", "status", "Done")),
+		fmt.Sprintf("renamed %d ENG-1-old-title ENG-1-new-title", issuesDirIno("team-1")),
+		fmt.Sprintf("created %d ENG-1", recentDirIno("team-1")),
+	} {
+		if !contains(moved, want) {
+			t.Errorf("edit: missing %q in %v", want, moved)
+		}
+	}
+	// Views the edit did not change are left alone.
+	if unchanged := fmt.Sprintf("%d", byValueIno("team-1", "priority", api.PriorityName(2))); contains(moved, "created "+unchanged+" ENG-1") || contains(moved, "deleted "+unchanged+" ENG-1") {
+		t.Errorf("edit notified the unchanged priority view: %v", moved)
+	}
+
+	created := run(nil, before)
+	for _, want := range []string{
+		fmt.Sprintf("created %d ENG-1-old-title", issuesDirIno("team-1")),
+		fmt.Sprintf("created %d ENG-1", byValueIno("team-1", "assignee", "unassigned")),
+		fmt.Sprintf("created %d ENG-1", recentDirIno("team-1")),
+	} {
+		if !contains(created, want) {
+			t.Errorf("create: missing %q in %v", want, created)
+		}
+	}
+
+	archived := run(before, nil)
+	for _, want := range []string{
+		fmt.Sprintf("deleted %d ENG-1-old-title", issuesDirIno("team-1")),
+		fmt.Sprintf("deleted %d ENG-1", byValueIno("team-1", "status", "Todo")),
+		fmt.Sprintf("deleted %d ENG-1", recentDirIno("team-1")),
+	} {
+		if !contains(archived, want) {
+			t.Errorf("archive: missing %q in %v", want, archived)
+		}
+	}
+
+	// my/: completing an assigned issue drops it from my/active but not from
+	// my/assigned; taking it over adds it to my/assigned.
+	mine := *before
+	mine.Assignee = &api.User{ID: "user-1", Email: "me@example.com"}
+	mine.State = api.State{ID: "state-todo", Name: "Todo", Type: "unstarted"}
+	done := mine
+	done.State = api.State{ID: "state-done", Name: "Done", Type: "completed"}
+	completed := run(&mine, &done)
+	if want := fmt.Sprintf("deleted %d ENG-1", myDirIno("active")); !contains(completed, want) {
+		t.Errorf("complete: missing %q in %v", want, completed)
+	}
+	if contains(completed, fmt.Sprintf("deleted %d ENG-1", myDirIno("assigned"))) {
+		t.Errorf("complete: dropped my/assigned: %v", completed)
+	}
+	if want := fmt.Sprintf("created %d ENG-1", myDirIno("assigned")); !contains(run(before, &mine), want) {
+		t.Errorf("assign: missing %q", want)
+	}
+}
+
+// TestInvalidateIssueSynced pins the sync-side notify set: the listing diff
+// of a local write, plus the issue's own files and directory, which a remote
+// change leaves stale in the kernel.
+func TestInvalidateIssueSynced(t *testing.T) {
+	t.Parallel()
+	before := &api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Title",
+		Team:  &api.Team{ID: "team-1", Key: "ENG"},
+		State: api.State{ID: "state-todo", Name: "Todo"},
+	}
+	after := *before
+	after.State = api.State{ID: "state-done", Name: "Done"}
+
+	var sink recordingSink
+	invalidateIssueSynced(&sink, newIssueDirNamer("{identifier}-{slugified-title}"), before, &after)
+	for _, want := range []string{
+		fmt.Sprintf("deleted %d ENG-1", byValueIno("team-1", "status", "Todo")),
+		fmt.Sprintf("created %d ENG-1", byValueIno("team-1", "status", "Done")),
+		fmt.Sprintf("replaced %d ENG-1-title %d", issuesDirIno("team-1"), issueDirIno("issue-1")),
+		fmt.Sprintf("replaced %d ENG-1 0", issuesDirIno("team-1")),
+		fmt.Sprintf("replaced %d issue.md %d", issueDirIno("issue-1"), issueIno("issue-1")),
+		fmt.Sprintf("updated %d", metaIno("issue-1")),
+	} {
+		found := false
+		for _, g := range sink.got {
+			found = found || g == want
+		}
+		if !found {
+			t.Errorf("missing %q in %v", want, sink.got)
+		}
+	}
+}
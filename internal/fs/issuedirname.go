@@ -0,0 +1,170 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// issueDirNamer renders the names issues/ lists its issue directories under,
+// from the mount.issue_dir_template config (e.g. "{identifier}-{slugified-title}"),
+// and maps a rendered name back to its identifier for Lookup/Rmdir. A nil
+// namer is the default: bare identifiers.
+//
+// The bare identifier always resolves too, and it stays the form every
+// symlink view (by/, recent/, cycles/, children/, …) targets: a templated
+// name changes whenever the title does, the identifier never does.
+//
+// {flags} marks urgent and blocked issues with a suffix (ENG-42.urgent), so
+// `ls` and globs pick them out; .linearfs/dircolors colors the suffixes.
+type issueDirNamer struct {
+	tmpl string
+	// re matches a rendered name, capturing the identifier. The title part
+	// matches anything; Lookup re-renders the fetched issue to confirm.
+	re *regexp.Regexp
+	// blocked reports whether an open issue blocks issue, for {flags}; nil
+	// counts nothing as blocked. Set by NewLinearFS (issueBlocked), and
+	// swapped for a team's precomputed set by forListing.
+	blocked func(issue *api.Issue) bool
+}
+
+// newIssueDirNamer compiles tmpl (already validated by config), returning nil
+// for the empty/default template.
+func newIssueDirNamer(tmpl string) *issueDirNamer {
+	if tmpl == "" || tmpl == config.IssueDirIdentifier {
+		return nil
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	rest := tmpl
+	for rest != "" {
+		i := strings.IndexByte(rest, '{')
+		if i < 0 {
+			pattern.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		pattern.WriteString(regexp.QuoteMeta(rest[:i]))
+		j := strings.IndexByte(rest[i:], '}') + i
+		switch rest[i : j+1] {
+		case config.IssueDirIdentifier:
+			pattern.WriteString(`([A-Z][A-Z0-9]*-[0-9]+)`)
+		case config.IssueDirFlags:
+			pattern.WriteString(`(?:` + regexp.QuoteMeta(issueFlagUrgent) + `|` + regexp.QuoteMeta(issueFlagBlocked) + `)?`)
+		default: // config.IssueDirSlugTitle
+			pattern.WriteString(`.*?`)
+		}
+		rest = rest[j+1:]
+	}
+	pattern.WriteString("$")
+	return &issueDirNamer{tmpl: tmpl, re: regexp.MustCompile(pattern.String())}
+}
+
+// name renders an issue's directory name.
+func (n *issueDirNamer) name(issue *api.Issue) string {
+	if n == nil {
+		return issue.Identifier // safename:ok structured id
+	}
+	raw := strings.ReplaceAll(n.tmpl, config.IssueDirIdentifier, issue.Identifier)
+	raw = strings.ReplaceAll(raw, config.IssueDirSlugTitle, slugifyTitle(issue.Title))
+	if n.flags() {
+		raw = strings.ReplaceAll(raw, config.IssueDirFlags, issueFlag(issue, n.blocked != nil && n.blocked(issue)))
+	}
+	// An empty title leaves a dangling separator ("ENG-1-"); trim it.
+	raw = strings.Trim(raw, "-_ ")
+	return safeName(raw, issue.Identifier)
+}
+
+// The {flags} suffixes, and their colors in .linearfs/dircolors.
+const (
+	issueFlagUrgent  = ".urgent"
+	issueFlagBlocked = ".blocked"
+)
+
+// issueFlag is an issue's {flags} suffix: urgent wins over blocked, since an
+// issue carries one suffix and priority is what triage sorts by.
+func issueFlag(issue *api.Issue, blocked bool) string {
+	switch {
+	case issue.Priority == 1:
+		return issueFlagUrgent
+	case blocked:
+		return issueFlagBlocked
+	}
+	return ""
+}
+
+// flags reports whether the template carries {flags}.
+func (n *issueDirNamer) flags() bool {
+	return n != nil && strings.Contains(n.tmpl, config.IssueDirFlags)
+}
+
+// forListing is the namer for listing a team's issues: under {flags} it reads
+// the team's blocked issues once instead of once per issue.
+func (n *issueDirNamer) forListing(ctx context.Context, lfs *LinearFS, teamID string) *issueDirNamer {
+	if !n.flags() {
+		return n
+	}
+	blocked, err := lfs.repo.GetBlockedIssues(ctx, teamID)
+	if err != nil {
+		log.Printf("Failed to list blocked issues for %s: %v", teamID, err)
+		return n
+	}
+	ids := make(map[string]bool, len(blocked))
+	for _, issue := range blocked {
+		ids[issue.ID] = true
+	}
+	listing := *n
+	listing.blocked = func(issue *api.Issue) bool { return ids[issue.ID] }
+	return &listing
+}
+
+// issueBlocked reports whether an open issue blocks issue, as by/blocked/
+// and issue.meta's blocked: count it.
+func (lfs *LinearFS) issueBlocked(issue *api.Issue) bool {
+	blockers, err := lfs.repo.GetIssueOpenBlockers(context.Background(), issue.ID)
+	if err != nil {
+		log.Printf("Failed to read blockers of %s: %v", issue.Identifier, err)
+		return false
+	}
+	return len(blockers) > 0
+}
+
+// identifier extracts the identifier a directory name refers to: the name
+// itself when it is a bare identifier, else the template's identifier slot.
+// The caller must still confirm a templated name against the fetched issue
+// (name(issue) == name), since the title part matched anything.
+func (n *issueDirNamer) identifier(name string) (ident string, templated bool) {
+	if looksLikeIdentifier(name) {
+		return name, false
+	}
+	if n == nil {
+		return "", false
+	}
+	m := n.re.FindStringSubmatch(name)
+	if m == nil || !looksLikeIdentifier(m[1]) {
+		return "", false
+	}
+	return m[1], true
+}
+
+// slugTitleMax caps the title slug so a long title cannot push a directory
+// name past common filename limits or make `ls` unreadable.
+const slugTitleMax = 50
+
+// slugifyTitle is projectDirName's cosmetic slug-casing (lowercase,
+// space→hyphen, strip non-[a-z0-9-]) plus hyphen collapsing and a length cap.
+func slugifyTitle(title string) string {
+	s := strings.ToLower(title)
+	s = strings.ReplaceAll(s, " ", "-")
+	s = dirNameUnsafe.ReplaceAllString(s, "")
+	for strings.Contains(s, "--") {
+		s = strings.ReplaceAll(s, "--", "-")
+	}
+	if len(s) > slugTitleMax {
+		s = s[:slugTitleMax]
+	}
+	return strings.Trim(s, "-")
+}
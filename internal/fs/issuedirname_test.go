@@ -0,0 +1,91 @@
+package fs
+
+import (
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestIssueDirNamer pins the templated issues/ names and the reverse mapping
+// Lookup depends on: every rendered name maps back to its identifier, and the
+// bare identifier keeps resolving under any template.
+func TestIssueDirNamer(t *testing.T) {
+	t.Parallel()
+	issue := &api.Issue{Identifier: "ENG-42", Title: "Fix login: OAuth  redirect (prod)!"}
+
+	cases := []struct {
+		tmpl string
+		want string
+	}{
+		{"", "ENG-42"},
+		{"{identifier}", "ENG-42"},
+		{"{identifier}-{slugified-title}", "ENG-42-fix-login-oauth-redirect-prod"},
+		{"{slugified-title}_{identifier}", "fix-login-oauth-redirect-prod_ENG-42"},
+	}
+	for _, tc := range cases {
+		namer := newIssueDirNamer(tc.tmpl)
+		got := namer.name(issue)
+		if got != tc.want {
+			t.Errorf("template %q: name = %q, want %q", tc.tmpl, got, tc.want)
+		}
+		if ident, _ := namer.identifier(got); ident != "ENG-42" {
+			t.Errorf("template %q: identifier(%q) = %q, want ENG-42", tc.tmpl, got, ident)
+		}
+		if ident, templated := namer.identifier("ENG-42"); ident != "ENG-42" || templated {
+			t.Errorf("template %q: bare identifier = %q (templated %v)", tc.tmpl, ident, templated)
+		}
+	}
+
+	namer := newIssueDirNamer("{identifier}-{slugified-title}")
+	if got := namer.name(&api.Issue{Identifier: "ENG-7"}); got != "ENG-7" {
+		t.Errorf("untitled issue name = %q, want trailing separator trimmed", got)
+	}
+	for _, name := range []string{"fix-login", "ENG-x-title", "_create"} {
+		if ident, _ := namer.identifier(name); ident != "" {
+			t.Errorf("identifier(%q) = %q, want none", name, ident)
+		}
+	}
+	var none *issueDirNamer
+	if ident, _ := none.identifier("ENG-42-fix-login"); ident != "" {
+		t.Errorf("default namer resolved templated name to %q", ident)
+	}
+}
+
+// TestIssueDirNamerFlags: {flags} marks urgent issues, then blocked ones, and
+// a flagged name maps back to its identifier only while the flag is current.
+func TestIssueDirNamerFlags(t *testing.T) {
+	t.Parallel()
+	namer := newIssueDirNamer("{identifier}{flags}")
+	blocked := map[string]bool{"ENG-2": true, "ENG-3": true}
+	namer.blocked = func(issue *api.Issue) bool { return blocked[issue.Identifier] }
+
+	cases := []struct {
+		issue api.Issue
+		want  string
+	}{
+		{api.Issue{Identifier: "ENG-1", Priority: 1}, "ENG-1.urgent"},
+		{api.Issue{Identifier: "ENG-2", Priority: 3}, "ENG-2.blocked"},
+		{api.Issue{Identifier: "ENG-3", Priority: 1}, "ENG-3.urgent"},
+		{api.Issue{Identifier: "ENG-4", Priority: 2}, "ENG-4"},
+	}
+	for _, tc := range cases {
+		got := namer.name(&tc.issue)
+		if got != tc.want {
+			t.Errorf("%s: name = %q, want %q", tc.issue.Identifier, got, tc.want)
+		}
+		if ident, _ := namer.identifier(got); ident != tc.issue.Identifier {
+			t.Errorf("identifier(%q) = %q, want %s", got, ident, tc.issue.Identifier)
+		}
+	}
+	if ident, _ := namer.identifier("ENG-4.stale"); ident != "" {
+		t.Errorf("identifier of an unknown flag = %q, want none", ident)
+	}
+	if !namer.flags() || newIssueDirNamer("{identifier}-{slugified-title}").flags() {
+		t.Error("flags() does not follow the template")
+	}
+
+	titled := newIssueDirNamer("{identifier}-{slugified-title}{flags}")
+	if got := titled.name(&api.Issue{Identifier: "ENG-1", Title: "Fix login", Priority: 1}); got != "ENG-1-fix-login.urgent" {
+		t.Errorf("titled flagged name = %q", got)
+	}
+}
@@ -0,0 +1,80 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"syscall"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// The issues/{ID}/issue.patch trigger.
+//
+// An agent that wants to move one field — the status, a label — should not
+// have to read issue.md, edit its frontmatter, and write the whole file back,
+// where a stale render or a stray reformat becomes an unintended change. It
+// writes an RFC 6902 JSON Patch (marshal.IssuePatchToUpdate) instead:
+//
+//	echo '[{"op":"test","path":"/status","value":"Todo"},
+//	       {"op":"replace","path":"/status","value":"In Progress"}]' > issue.patch
+//
+// The patch applies to the issue as Linear has it now, so a `test` op guards
+// against an edit made elsewhere. It sends only the fields that changed,
+// through the same resolver and UpdateIssue as an issue.md save, and runs the
+// same write-back tail. A rejected patch — malformed, a failed op or test, an
+// invalid result, a name that does not resolve — changes nothing and leaves
+// the reason in the issue's .error.
+
+// issuePatchName is the write-only trigger file in an issue directory.
+const issuePatchName = "issue.patch"
+
+// applyPatch is the issue.patch surface's onFlush.
+func (n *IssueDirectoryNode) applyPatch(ctx context.Context, content []byte) syscall.Errno {
+	issue := n.entity()
+	op := "apply issue.patch to " + issue.Identifier
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	current, err := n.lfs.verify().GetIssue(ctx, issue.ID)
+	if err != nil {
+		log.Printf("Failed to read %s before patch: %v", issue.Identifier, err)
+		msg, errno := classifyMutationErr(op, err)
+		n.lfs.SetIssueError(issue.ID, msg)
+		return errno
+	}
+	updates, err := marshal.IssuePatchToUpdate(content, current)
+	if err != nil {
+		log.Printf("Rejected patch for %s: %v", issue.Identifier, err)
+		msg, errno := classifyMutationErr(op, err)
+		n.lfs.SetIssueError(issue.ID, msg)
+		return errno
+	}
+	if len(updates) == 0 {
+		n.lfs.ClearIssueError(issue.ID)
+		return 0
+	}
+	if ferr := resolveIssueUpdate(ctx, n.lfs, current, updates); ferr != nil {
+		log.Printf("Failed to resolve patch for %s: %s", issue.Identifier, ferr.Message)
+		n.lfs.SetIssueError(issue.ID, ferr.Detail())
+		return syscall.EINVAL
+	}
+	if err := n.lfs.mutator().UpdateIssue(ctx, issue.ID, updates); err != nil {
+		log.Printf("Failed to patch issue %s: %v", issue.Identifier, err)
+		msg, errno := classifyMutationErr(op, err)
+		n.lfs.SetIssueError(issue.ID, msg)
+		return errno
+	}
+
+	// The write-back tail is issue.md's, verified against the patched base.
+	base := &IssueFileNode{BaseNode: BaseNode{lfs: n.lfs}, issue: *current}
+	fresh, errno := commitWriteBack(ctx, n.lfs, base.writeBack(&updates))
+	if fresh != nil {
+		invalidateIssueMoved(n.lfs, n.lfs.issueDirs, current, fresh)
+		n.setEntity(*fresh)
+	}
+	n.lfs.InvalidateUpdated(issueIno(issue.ID))
+	n.lfs.InvalidateUpdated(metaIno(issue.ID))
+	return errno
+}
@@ -0,0 +1,74 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// TestIssuePatch writes JSON Patches to issue.patch: a guarded status and
+// title change lands and clears .error, and a patch whose test fails or whose
+// status does not resolve changes nothing and reports in .error.
+func TestIssuePatch(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, st := range []db.UpsertStateParams{
+		{ID: "st-todo", TeamID: "team-1", Name: "Todo", Type: "unstarted", SyncedAt: now, Data: []byte(`{"id":"st-todo","name":"Todo","type":"unstarted"}`)},
+		{ID: "st-doing", TeamID: "team-1", Name: "In Progress", Type: "started", SyncedAt: now, Data: []byte(`{"id":"st-doing","name":"In Progress","type":"started"}`)},
+	} {
+		if err := store.Queries().UpsertState(ctx, st); err != nil {
+			t.Fatalf("UpsertState: %v", err)
+		}
+	}
+	issue := api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Before", Description: "Body.", Priority: 3,
+		State: api.State{ID: "st-todo", Name: "Todo", Type: "unstarted"},
+		Team:  &api.Team{ID: "team-1", Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := lfs.UpsertIssue(ctx, issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	dir := &IssueDirectoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Issue]{val: issue}}
+
+	patch := `[{"op":"test","path":"/status","value":"Todo"},
+		{"op":"replace","path":"/status","value":"In Progress"},
+		{"op":"replace","path":"/title","value":"After"}]`
+	if errno := dir.applyPatch(ctx, []byte(patch)); errno != 0 {
+		t.Fatalf("applyPatch = %v (.error: %+v)", errno, lfs.GetIssueError(issue.ID))
+	}
+	patched := dir.entity()
+	if patched.Title != "After" || patched.State.ID != "st-doing" || patched.Description != "Body." || patched.Priority != 3 {
+		t.Errorf("patched issue = %+v, want only title and status changed", patched)
+	}
+	if e := lfs.GetIssueError(issue.ID); e != nil {
+		t.Errorf(".error = %q after a good patch", e.Message)
+	}
+
+	rejects := []struct {
+		patch string
+		want  string
+	}{
+		{`[{"op":"test","path":"/status","value":"Todo"},{"op":"replace","path":"/title","value":"Again"}]`, "patch[0] (test /status)"},
+		{`[{"op":"replace","path":"/status","value":"Nowhere"}]`, "Nowhere"},
+	}
+	for _, r := range rejects {
+		if errno := dir.applyPatch(ctx, []byte(r.patch)); errno != syscall.EINVAL {
+			t.Errorf("applyPatch(%s) = %v, want EINVAL", r.patch, errno)
+		}
+		if e := lfs.GetIssueError(issue.ID); e == nil || !strings.Contains(e.Message, r.want) {
+			t.Errorf(".error = %+v, want it to mention %q", e, r.want)
+		}
+	}
+	cached, err := lfs.repo.GetIssueByID(ctx, issue.ID)
+	if err != nil || cached.Title != "After" || cached.State.ID != "st-doing" {
+		t.Errorf("cached issue = %+v (%v), want the rejected patches to change nothing", cached, err)
+	}
+}
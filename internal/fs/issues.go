@@ -11,6 +11,7 @@ import (
 	"github.com/hanwen/go-fuse/v2/fs"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
 	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
@@ -62,13 +63,14 @@ func (lfs *LinearFS) issueCreateSpec(teamID, op, key string, dir uint64, mutate
 		// key; the issue-dir listing renders the same identifier verbatim.
 		entryName: func(i *api.Issue) string { return i.Identifier }, // safename:ok structured id
 		invalidateExtra: func(i *api.Issue) {
-			// A fresh issue must appear in recent/ immediately, not after the
-			// dir cache TTL (the #148 design's known staleness bound).
-			lfs.InvalidateCreated(recentDirIno(teamID), i.Identifier)
-			// A sub-issue lands in children/ (spec.dir) and the team's issues/.
-			if dir != issuesDirIno(teamID) {
-				lfs.InvalidateCreated(issuesDirIno(teamID), i.Identifier)
+			// A fresh issue must appear in recent/, issues/ (under its
+			// configured dir name), and every by/ view immediately, not after
+			// the dir cache TTL (the #148 design's known staleness bound).
+			listed := *i
+			if listed.Team == nil {
+				listed.Team = &api.Team{ID: teamID}
 			}
+			invalidateIssueMoved(lfs, lfs.issueDirs, nil, &listed)
 		},
 	}
 }
@@ -100,11 +102,22 @@ func (n *IssuesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno)
 		return nil, syscall.EIO
 	}
 
-	// _create accepts a full issue spec (#149/#151).
-	entries := n.trio().entries()
+	// _create accepts a full issue spec (#149/#151); _clone an identifier.
+	entries := append(n.trio().entries(),
+		fuse.DirEntry{Name: cloneTriggerName, Mode: syscall.S_IFREG},
+		fuse.DirEntry{Name: lastCreatedName, Mode: syscall.S_IFREG},
+		fuse.DirEntry{Name: archivedIssuesDirName, Mode: syscall.S_IFDIR},
+	)
+	if n.lfs.issueRmdir == config.IssueRmdirTrash {
+		entries = append(entries, fuse.DirEntry{Name: issueTrashDirName, Mode: syscall.S_IFDIR})
+	}
+	namer := n.lfs.issueDirs.forListing(ctx, n.lfs, n.entity().ID)
 	for _, issue := range issues {
+		if n.lfs.trash.has(n.entity().ID, issue.ID) {
+			continue // listed in .archive/ instead (issuetrash.go)
+		}
 		entries = append(entries, fuse.DirEntry{
-			Name: issue.Identifier,
+			Name: namer.name(&issue),
 			Mode: syscall.S_IFDIR,
 		})
 	}
@@ -122,23 +135,47 @@ func (n *IssuesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut
 	if inode, ok := n.lfs.lookupCollectionTrio(ctx, n, n.trio(), name, out); ok {
 		return inode, 0
 	}
-
-	// Check if name looks like a valid issue identifier (e.g., "ENG-123")
-	// to avoid unnecessary API calls for invalid names
-	if !looksLikeIdentifier(name) {
+	switch name {
+	case cloneTriggerName:
+		return n.lfs.lookupTriggerFile(ctx, n, n.cloneIssue, out), 0
+	case lastCreatedName:
+		team := n.entity()
+		return n.lfs.lookupLastCreated(ctx, n, collectionSuccessKey("issues", team.ID), safeName(team.Key, team.ID), out), 0
+	case issueTrashDirName:
+		return n.lookupIssueTrash(ctx, out)
+	case archivedIssuesDirName:
+		return n.lookupArchivedIssues(ctx, out)
+	}
+
+	// Check if name looks like a valid issue identifier (e.g., "ENG-123") or
+	// a templated name carrying one, to avoid unnecessary API calls for
+	// invalid names
+	ident, templated := n.lfs.issueDirs.identifier(name)
+	if ident == "" {
 		return nil, syscall.ENOENT
 	}
 
 	// Use FetchIssueByIdentifier which checks: cache -> SQLite -> direct API
 	// This avoids loading ALL team issues just to access a single issue
-	issue, err := n.lfs.FetchIssueByIdentifier(ctx, name)
+	issue, err := n.lfs.FetchIssueByIdentifier(ctx, ident)
 	if err != nil {
 		// If API returns not found, return ENOENT
 		return nil, syscall.ENOENT
 	}
+	// A templated name must be the issue's current one: a stale title slug
+	// (the issue was renamed) is not a second alias.
+	if templated && n.lfs.issueDirs.name(issue) != name {
+		return nil, syscall.ENOENT
+	}
+	// A trashed issue resolves only under .archive/.
+	if n.lfs.trash.has(n.entity().ID, issue.ID) {
+		return nil, syscall.ENOENT
+	}
 
+	// Both names share the issue's inode, so writes and invalidations keyed on
+	// issueDirIno reach whichever one the caller used.
 	node := &IssueDirectoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Issue]{val: *issue}}
-	return n.newDirInode(ctx, out, issue.Identifier, node, dirAttr(issue.CreatedAt, issue.UpdatedAt), issueDirIno(issue.ID), 30*time.Second), 0
+	return n.newDirInode(ctx, out, name, node, dirAttr(issue.CreatedAt, issue.UpdatedAt), issueDirIno(issue.ID), 30*time.Second), 0
 }
 
 // looksLikeIdentifier checks if a name looks like a Linear issue identifier
@@ -185,8 +222,9 @@ func retryableCreateErr(err error) bool {
 	// not the server rate limiting us, so api.IsRateLimited excludes it. A local
 	// budget deferral (api.IsDeferred) is also transient — it clears next cycle,
 	// so a deferred write should retry (EAGAIN), not fail hard (#257). Now that
-	// IsRateLimited excludes deferrals, it must be checked explicitly.
-	return api.IsRateLimited(err) || api.IsDeferred(err) || strings.Contains(err.Error(), "circuit breaker")
+	// IsRateLimited excludes deferrals, it must be checked explicitly. A
+	// request that never reached Linear (api.IsUnreachable) is safe to retry.
+	return api.IsRateLimited(err) || api.IsDeferred(err) || api.IsUnreachable(err) || strings.Contains(err.Error(), "circuit breaker")
 }
 
 // Mkdir creates a new issue from a directory name
@@ -225,18 +263,9 @@ func (n *IssuesNode) createIssue(ctx context.Context, content []byte) syscall.Er
 		collectionErrorKey("issues", team.ID),
 		issuesDirIno(team.ID),
 		func(ctx context.Context) (*api.Issue, error) {
-			spec, err := marshal.MarkdownToIssueCreate(content)
+			spec, err := parseIssueCreate(content)
 			if err != nil {
-				// Normalize the marshal parse/validation error to the
-				// Field/Value/Error shape so it matches the resolver's
-				// EINVAL errors.
-				field := "frontmatter"
-				msg := err.Error()
-				if strings.HasPrefix(msg, "priority:") {
-					field = "priority"
-					msg = strings.TrimSpace(strings.TrimPrefix(msg, "priority:"))
-				}
-				return nil, &FieldError{Field: field, Message: msg}
+				return nil, err
 			}
 			return n.lfs.createIssueFromSpec(ctx, team, spec)
 		},
@@ -244,28 +273,41 @@ func (n *IssuesNode) createIssue(ctx context.Context, content []byte) syscall.Er
 	return errno
 }
 
-// Rmdir archives an issue (soft delete)
+// parseIssueCreate parses an issue spec (frontmatter + body) for the _create
+// surfaces, normalizing a marshal parse/validation error to the
+// Field/Value/Error shape so it matches the resolver's EINVAL errors.
+func parseIssueCreate(content []byte) (map[string]any, error) {
+	spec, err := marshal.MarkdownToIssueCreate(content)
+	if err != nil {
+		field := "frontmatter"
+		msg := err.Error()
+		if strings.HasPrefix(msg, "priority:") {
+			field = "priority"
+			msg = strings.TrimSpace(strings.TrimPrefix(msg, "priority:"))
+		}
+		return nil, &FieldError{Field: field, Message: msg}
+	}
+	return spec, nil
+}
+
+// Rmdir archives an issue (soft delete), or — per mount.issue_rmdir — moves it
+// into .archive/ or refuses (see issuetrash.go).
 func (n *IssuesNode) Rmdir(ctx context.Context, name string) syscall.Errno {
 	team := n.entity()
+	switch n.lfs.issueRmdir {
+	case config.IssueRmdirDeny:
+		return n.denyIssueRmdir(name)
+	case config.IssueRmdirTrash:
+		return n.trashIssue(ctx, name)
+	}
 	if n.lfs.debug {
 		log.Printf("Rmdir: %s in team %s (archiving issue)", name, team.Key)
 	}
 
 	return commitDelete(ctx, n.lfs, deleteSpec[api.Issue]{
-		op:  `archive issue "` + name + `"`,
-		key: collectionErrorKey("issues", team.ID),
-		find: func(ctx context.Context) (*api.Issue, error) {
-			issues, err := n.lfs.repo.GetTeamIssues(ctx, team.ID)
-			if err != nil {
-				return nil, err
-			}
-			for _, issue := range issues {
-				if issue.Identifier == name {
-					return &issue, nil
-				}
-			}
-			return nil, nil
-		},
+		op:   `archive issue "` + name + `"`,
+		key:  collectionErrorKey("issues", team.ID),
+		find: func(ctx context.Context) (*api.Issue, error) { return n.findIssue(ctx, name) },
 		mutate: func(ctx context.Context, i *api.Issue) error {
 			return n.lfs.mutator().ArchiveIssue(ctx, i.ID)
 		},
@@ -278,9 +320,9 @@ func (n *IssuesNode) Rmdir(ctx context.Context, name string) syscall.Errno {
 		dir:  issuesDirIno(team.ID),
 		name: name,
 		invalidateExtra: func(i *api.Issue) {
-			// The archived issue must also vanish from recent/ immediately
-			// (symmetric with the create tail's recent/ coherence).
-			n.lfs.InvalidateDeleted(recentDirIno(team.ID), name)
+			// The archived issue must also vanish from recent/ and every
+			// other view immediately (symmetric with the create tail).
+			invalidateIssueMoved(n.lfs, n.lfs.issueDirs, i, nil)
 		},
 	})
 }
@@ -309,8 +351,10 @@ func (n *IssueDirectoryNode) Lookup(ctx context.Context, name string, out *fuse.
 }
 
 // manifest declares an issue directory's static children: the editable issue.md,
-// the read-through issue.meta, the generated history.md, the .error/.last
-// sidecars, and the comments/docs/children/attachments/relations subdirs. Issue
+// the read-through issue.meta, the generated history.md/backlinks.md/
+// attachments.md/issue.pdf, the issue.patch trigger, the .error/.last
+// sidecars, the comments/drafts/docs/children/attachments/history/relations subdirs,
+// and the relates/blocks/blocked-by link dirs. Issue
 // children have no dynamic tail and a uniform 30s timeout.
 // entity()/setEntity() are promoted from the embedded entityCell[api.Issue].
 // setEntity is written by the Rename write-back and the nodeRefresher seam
@@ -331,14 +375,22 @@ func (n *IssueDirectoryNode) manifest() *dirManifest {
 	m := newDirManifest(&n.BaseNode, issue.ID, issue.CreatedAt, issue.UpdatedAt, 30*time.Second)
 
 	// issue.md is editable-only; identity/links/relations live in issue.meta.
+	// Its lookup is the "issue open" that fetches any fields bulk sync
+	// projected out (api.lazy_issue_fields). A restricted issue keeps the
+	// entry but renders nothing: its cached body is not the real one, and
+	// opening it is refused (IssueFileNode.Open).
 	m.file("issue.md", issueIno(issue.ID), func(ctx context.Context) (fs.InodeEmbedder, []byte, syscall.Errno) {
-		content, err := marshal.IssueToMarkdown(&issue)
-		if err != nil {
-			return nil, nil, syscall.EIO
+		full := n.lfs.completeIssue(ctx, issue)
+		var content []byte
+		if !full.Restricted {
+			var err error
+			if content, err = n.lfs.renderIssueFile(ctx, &full); err != nil {
+				return nil, nil, syscall.EIO
+			}
 		}
 		return &IssueFileNode{
 			BaseNode:   BaseNode{lfs: n.lfs},
-			issue:      issue,
+			issue:      full,
 			editBuffer: editBuffer{content: content},
 		}, content, 0
 	})
@@ -353,13 +405,31 @@ func (n *IssueDirectoryNode) manifest() *dirManifest {
 			iss = fresh
 		}
 		att, _ := lfs.repo.GetIssueAttachments(ctx, iss.ID)
-		b, err := marshal.IssueMetaToMarkdown(iss, att...)
+		blockers, _ := lfs.repo.GetIssueOpenBlockers(ctx, iss.ID)
+		children, _ := lfs.repo.GetIssueChildren(ctx, iss.ID)
+		b, err := marshal.IssueMetaToMarkdown(iss, time.Now(), blockers, children, att...)
 		if err != nil {
 			return nil, iss.UpdatedAt, iss.CreatedAt
 		}
 		return b, iss.UpdatedAt, iss.CreatedAt
 	})
 
+	// issue.full.md: issue.md with the whole description, listed while the
+	// description is too big to show whole (truncatedview.go).
+	if len(issue.Description) > marshal.BodyViewLimit {
+		m.renderFile(issueFullName, issueFullIno(issue.ID), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			iss := &issue
+			if fresh, err := lfs.FetchIssueByIdentifier(ctx, ident); err == nil && fresh != nil {
+				iss = fresh
+			}
+			b, err := lfs.renderIssueFull(ctx, iss)
+			if err != nil {
+				return nil, iss.UpdatedAt, iss.CreatedAt
+			}
+			return b, iss.UpdatedAt, iss.CreatedAt
+		})
+	}
+
 	// history.md: a read-only generated file, rendered fresh from the issue's
 	// activity history on each read. It reports the issue's own times; a transient
 	// fetch failure renders an empty file rather than making the entry vanish.
@@ -372,11 +442,54 @@ func (n *IssueDirectoryNode) manifest() *dirManifest {
 		return marshal.HistoryToMarkdown(issue.Identifier, entries), issue.UpdatedAt, issue.CreatedAt
 	})
 
+	// backlinks.md: the cached issues, comments, and documents that mention this
+	// issue, read from the mention index sync maintains — a wiki-style "what
+	// links here".
+	m.renderFile("backlinks.md", backlinksIno(issue.ID), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		links, err := lfs.repo.GetIssueBacklinks(ctx, issue.ID, issue.Identifier)
+		if err != nil {
+			log.Printf("Failed to read backlinks for %s: %v", issue.Identifier, err)
+			return nil, issue.UpdatedAt, issue.CreatedAt
+		}
+		return marshal.BacklinksToMarkdown(issue.Identifier, links), issue.UpdatedAt, issue.CreatedAt
+	})
+
+	// attachments.md: every attachment in one greppable table, alongside the
+	// per-file entries under attachments/.
+	m.renderFile("attachments.md", attachmentsMdIno(issue.ID), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		atts, err := lfs.repo.GetIssueAttachments(ctx, issue.ID)
+		if err != nil {
+			log.Printf("Failed to fetch attachments for %s: %v", issue.Identifier, err)
+			return nil, issue.UpdatedAt, issue.CreatedAt
+		}
+		return marshal.AttachmentsToMarkdown(issue.Identifier, atts), issue.UpdatedAt, issue.CreatedAt
+	})
+
+	// issue.pdf: a printable export of the issue, its metadata, and its
+	// comments, rendered from the freshest cached issue like issue.meta.
+	m.renderFile("issue.pdf", issuePDFIno(issue.ID), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		iss := &issue
+		if fresh, err := lfs.FetchIssueByIdentifier(ctx, ident); err == nil && fresh != nil {
+			iss = fresh
+		}
+		comments, err := lfs.repo.GetIssueComments(ctx, iss.ID)
+		if err != nil {
+			log.Printf("Failed to fetch comments for %s: %v", iss.Identifier, err)
+		}
+		return marshal.IssueToPDF(iss, comments), iss.UpdatedAt, iss.CreatedAt
+	})
+
+	// issue.patch: write a JSON Patch to change single fields (issuepatch.go).
+	m.triggerFile(issuePatchName, n.applyPatch)
+
 	m.errorFile(".error")
 	m.lastFile(".last") // successes of sub-issues created under this issue (via children/)
 
 	m.subdir("comments", commentsDirIno(issue.ID), func() dirChild {
-		return &CommentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID, teamID: teamID}
+		return &CommentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID, teamID: teamID, teamKey: issueTeamKey(&issue)}
+	})
+	m.subdir("drafts", draftsDirIno(issue.ID), func() dirChild {
+		return &DraftsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID}
 	})
 	m.subdir("docs", docsDirIno(issue.ID), func() dirChild {
 		return &DocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID}
@@ -387,9 +500,17 @@ func (n *IssueDirectoryNode) manifest() *dirManifest {
 	m.subdir("attachments", attachmentsDirIno(issue.ID), func() dirChild {
 		return &AttachmentsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID}
 	})
+	m.subdir("history", descHistoryDirIno(issue.ID), func() dirChild {
+		return &DescriptionHistoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID}
+	})
 	m.subdir("relations", relationsDirIno(issue.ID), func() dirChild {
-		return &RelationsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID, teamID: teamID}
+		return &RelationsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID, identifier: issue.Identifier, teamID: teamID}
 	})
+	for _, view := range relationLinkViews {
+		m.subdir(view.name, relationLinksDirIno(issue.ID, view.name), func() dirChild {
+			return &RelationLinksNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, issueID: issue.ID, view: view}
+		})
+	}
 
 	return m
 }
@@ -482,6 +603,9 @@ func (i *IssueFileNode) refreshFrom(fresh fs.InodeEmbedder) {
 }
 
 func (i *IssueFileNode) Flush(ctx context.Context, f fs.FileHandle) syscall.Errno {
+	if h, ok := f.(*appendHandle); ok {
+		return i.flushAppend(ctx, h.take())
+	}
 	// updates bridges the front half (which computes it) and the commit-tail
 	// compare (which reads it against the pre-write i.issue); mutate runs first.
 	var updates map[string]any
@@ -491,7 +615,9 @@ func (i *IssueFileNode) Flush(ctx context.Context, f fs.FileHandle) syscall.Errn
 				log.Printf("Flush: %s (saving changes)", i.issue.Identifier)
 			}
 			var err error
-			updates, err = marshal.MarkdownToIssueUpdate(i.content, &i.issue)
+			// A cross-linked body goes back as the references it renders.
+			content := []byte(i.lfs.issueLinker(ctx, &i.issue).Unlink(string(i.content)))
+			updates, err = marshal.MarkdownToIssueUpdate(content, &i.issue)
 			if err != nil {
 				log.Printf("Failed to parse changes for %s: %v", i.issue.Identifier, err)
 				i.lfs.SetIssueError(i.issue.ID, "Parse error: "+err.Error())
@@ -512,7 +638,13 @@ func (i *IssueFileNode) Flush(ctx context.Context, f fs.FileHandle) syscall.Errn
 				i.lfs.SetIssueError(i.issue.ID, ferr.Detail())
 				return false, syscall.EINVAL
 			}
+			if i.lfs.mustQueue(ctx, i.issue.ID) {
+				return false, i.queueUpdate(ctx, updates)
+			}
 			if err := i.lfs.mutator().UpdateIssue(ctx, i.issue.ID, updates); err != nil {
+				if api.IsUnreachable(err) {
+					return false, i.queueUpdate(ctx, updates)
+				}
 				log.Printf("Failed to update issue %s: %v", i.issue.Identifier, err)
 				msg, errno := classifyMutationErr("update issue", err)
 				i.lfs.SetIssueError(i.issue.ID, msg)
@@ -523,32 +655,64 @@ func (i *IssueFileNode) Flush(ctx context.Context, f fs.FileHandle) syscall.Errn
 			}
 			return true, 0
 		},
-		// Edit-commit tail: re-fetch from the API (an independent read catches
-		// #136, where a large body silently reverts), verify read-your-writes
-		// against the pre-write values still on i.issue, upsert the fresh value,
-		// and surface any divergence via .error.
-		writeBack: writeBackSpec[api.Issue]{
-			errKey:  i.issue.ID,
-			op:      "save issue " + i.issue.Identifier,
-			fetch:   func(ctx context.Context) (*api.Issue, error) { return i.lfs.verify().GetIssue(ctx, i.issue.ID) },
-			persist: func(ctx context.Context, fresh *api.Issue) error { return i.lfs.UpsertIssue(ctx, *fresh) },
-			compare: func(fresh *api.Issue) []writeBackResult {
-				var results []writeBackResult
-				if want, ok := updates["title"].(string); ok {
-					results = append(results, writeBackDivergence("title", want, fresh.Title, i.issue.Title))
-				}
-				if want, ok := updates["description"].(string); ok {
-					results = append(results, writeBackDivergence("description (body)", want, fresh.Description, i.issue.Description))
-				}
-				return results
-			},
+		writeBack: i.writeBack(&updates),
+		adopt: func(fresh *api.Issue) {
+			// A status/assignee/label/... change moves the issue between views.
+			invalidateIssueMoved(i.lfs, i.lfs.issueDirs, &i.issue, fresh)
+			i.issue = *fresh
 		},
-		adopt:     func(fresh *api.Issue) { i.issue = *fresh },
 		coherence: []uint64{issueIno(i.issue.ID), metaIno(i.issue.ID)}, // issue.meta reflects the edit
 	})
 }
 
-// ChildrenNode represents the /teams/{KEY}/issues/{ID}/children/ directory
+// queueUpdate is the offline save (offline.go): the update joins the mutation
+// queue and the node adopts its local result, which no re-fetch can verify
+// yet — so the shell's commit tail is skipped and the node re-coheres here.
+func (i *IssueFileNode) queueUpdate(ctx context.Context, updates map[string]any) syscall.Errno {
+	local, err := i.lfs.queueIssueUpdate(ctx, &i.issue, updates)
+	if err != nil {
+		log.Printf("Failed to queue update of %s: %v", i.issue.Identifier, err)
+		i.lfs.SetIssueError(i.issue.ID, "Operation: queue update of issue "+i.issue.Identifier+
+			"\nError: Linear is unreachable and the update could not be queued: "+err.Error())
+		return syscall.EIO
+	}
+	log.Printf("Queued update of %s until Linear is reachable", i.issue.Identifier)
+	invalidateIssueMoved(i.lfs, i.lfs.issueDirs, &i.issue, local)
+	i.issue = *local
+	i.lfs.ClearWriteError(i.issue.ID)
+	i.lfs.InvalidateUpdated(issueIno(i.issue.ID))
+	i.lfs.InvalidateUpdated(metaIno(i.issue.ID))
+	return 0
+}
+
+// writeBack is the edit-commit tail both issue.md save paths (full rewrite and
+// O_APPEND) share: re-fetch from the API (an independent read catches #136,
+// where a large body silently reverts), verify read-your-writes against the
+// pre-write values still on i.issue, upsert the fresh value, and surface any
+// divergence via .error. updates is read at compare time — the front half
+// fills it in first.
+func (i *IssueFileNode) writeBack(updates *map[string]any) writeBackSpec[api.Issue] {
+	return writeBackSpec[api.Issue]{
+		errKey:  i.issue.ID,
+		op:      "save issue " + i.issue.Identifier,
+		fetch:   func(ctx context.Context) (*api.Issue, error) { return i.lfs.verify().GetIssue(ctx, i.issue.ID) },
+		persist: func(ctx context.Context, fresh *api.Issue) error { return i.lfs.UpsertIssue(ctx, *fresh) },
+		compare: func(fresh *api.Issue) []writeBackResult {
+			var results []writeBackResult
+			if want, ok := (*updates)["title"].(string); ok {
+				results = append(results, writeBackDivergence("title", want, fresh.Title, i.issue.Title))
+			}
+			if want, ok := (*updates)["description"].(string); ok {
+				results = append(results, writeBackDivergence("description (body)", want, fresh.Description, i.issue.Description))
+			}
+			return results
+		},
+	}
+}
+
+// ChildrenNode represents the /teams/{KEY}/issues/{ID}/children/ directory:
+// symlinks to the sub-issues, plus _create (a full issue spec) and mkdir (a
+// title) to add one.
 type ChildrenNode struct {
 	attrNode
 	issue api.Issue
@@ -565,17 +729,21 @@ func (n *ChildrenNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno
 	if err != nil {
 		return nil, syscall.EIO
 	}
-	entries := make([]fuse.DirEntry, len(children))
-	for i, child := range children {
-		entries[i] = fuse.DirEntry{
+	entries := make([]fuse.DirEntry, 0, len(children)+1)
+	entries = append(entries, fuse.DirEntry{Name: createTriggerName, Mode: syscall.S_IFREG})
+	for _, child := range children {
+		entries = append(entries, fuse.DirEntry{
 			Name: child.Identifier,
 			Mode: syscall.S_IFLNK,
-		}
+		})
 	}
 	return fs.NewListDirStream(entries), 0
 }
 
 func (n *ChildrenNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if name == createTriggerName {
+		return n.lfs.lookupTriggerFile(ctx, n, n.createChild, out), 0
+	}
 	// Query children from database by parent_id
 	children, err := n.lfs.repo.GetIssueChildren(ctx, n.issue.ID)
 	if err != nil {
@@ -634,3 +802,34 @@ func (n *ChildrenNode) Mkdir(ctx context.Context, name string, mode uint32, out
 	node := &IssueDirectoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Issue]{val: *issue}}
 	return n.newDirInode(ctx, out, issue.Identifier, node, dirAttr(issue.CreatedAt, issue.UpdatedAt), issueDirIno(issue.ID), 30*time.Second), 0
 }
+
+// createChild is children/_create's onFlush: the issues/_create spec with the
+// parent preset to this issue. A spec naming a different parent is refused
+// rather than silently overridden. Feedback goes to the parent issue's
+// .error/.last, as for Mkdir.
+func (n *ChildrenNode) createChild(ctx context.Context, content []byte) syscall.Errno {
+	if n.issue.Team == nil || n.issue.Team.ID == "" {
+		log.Printf("Cannot create sub-issue: parent issue %s has no team", n.issue.Identifier)
+		return syscall.EIO
+	}
+	team := *n.issue.Team
+	_, errno := commitCreate(ctx, n.lfs, n.lfs.issueCreateSpec(
+		team.ID,
+		"create sub-issue from spec",
+		n.issue.ID,
+		childrenDirIno(n.issue.ID),
+		func(ctx context.Context) (*api.Issue, error) {
+			spec, err := parseIssueCreate(content)
+			if err != nil {
+				return nil, err
+			}
+			if parent, ok := spec["parentId"].(string); ok && parent != n.issue.Identifier {
+				return nil, &FieldError{Field: "parent", Value: parent, Message: "children/_create files under " + n.issue.Identifier + "; drop parent: or use issues/_create"}
+			}
+			// The resolver maps the identifier to the parent's ID.
+			spec["parentId"] = n.issue.Identifier
+			return n.lfs.createIssueFromSpec(ctx, team, spec)
+		},
+	))
+	return errno
+}
@@ -0,0 +1,239 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+	gosync "sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// Configurable issue rmdir (mount.issue_rmdir).
+//
+// rmdir of an issue directory archives the issue — which an errant `rm -r`
+// over a team's issues/ does to every issue it reaches. The mount picks the
+// behavior: "archive" (the default) keeps it; "deny" refuses with EPERM and a
+// .error naming the setting; "trash" moves the issue into issues/.archive/
+// without touching Linear. From there `rmdir .archive/ENG-123` archives it
+// for real and `mv .archive/ENG-123 .` puts it back.
+//
+// The trash is in memory on purpose: it holds nothing Linear does not still
+// have, so a remount simply empties it back into issues/ — the safe direction.
+// Only issues/ hides a trashed issue; by/, recent/, and the other views keep
+// listing it, since it is still open on Linear.
+
+// issueTrash is the per-team set of trashed issues, snapshotted at rmdir so
+// .archive/ lists and resolves them without a query.
+type issueTrash struct {
+	mu     gosync.Mutex
+	byTeam map[string]map[string]api.Issue // teamID -> issueID -> snapshot
+}
+
+func newIssueTrash() *issueTrash {
+	return &issueTrash{byTeam: make(map[string]map[string]api.Issue)}
+}
+
+func (t *issueTrash) put(teamID string, issue api.Issue) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.byTeam[teamID] == nil {
+		t.byTeam[teamID] = make(map[string]api.Issue)
+	}
+	t.byTeam[teamID][issue.ID] = issue
+}
+
+// take removes an issue from the trash, reporting whether it was there.
+func (t *issueTrash) take(teamID, issueID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.byTeam[teamID][issueID]
+	delete(t.byTeam[teamID], issueID)
+	return ok
+}
+
+// has reports whether the issue is trashed. A nil trash (any mode but trash)
+// holds nothing.
+func (t *issueTrash) has(teamID, issueID string) bool {
+	if t == nil {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.byTeam[teamID][issueID]
+	return ok
+}
+
+// list returns the team's trashed issues, by identifier.
+func (t *issueTrash) list(teamID string) []api.Issue {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := make([]api.Issue, 0, len(t.byTeam[teamID]))
+	for _, issue := range t.byTeam[teamID] {
+		out = append(out, issue)
+	}
+	slices.SortFunc(out, func(a, b api.Issue) int { return strings.Compare(a.Identifier, b.Identifier) })
+	return out
+}
+
+// issueTrashDirName is the trash directory's name inside issues/.
+const issueTrashDirName = ".archive"
+
+// findIssue resolves a name issues/ lists (bare identifier or templated dir
+// name) to the team's issue, or nil when no issue has it.
+func (n *IssuesNode) findIssue(ctx context.Context, name string) (*api.Issue, error) {
+	issues, err := n.lfs.repo.GetTeamIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name || n.lfs.issueDirs.name(&issue) == name {
+			return &issue, nil
+		}
+	}
+	return nil, nil
+}
+
+// denyIssueRmdir is rmdir under mount.issue_rmdir: deny — a loud refusal that
+// names the setting, so the user knows the archive path is closed on purpose.
+func (n *IssuesNode) denyIssueRmdir(name string) syscall.Errno {
+	n.lfs.SetWriteError(collectionErrorKey("issues", n.entity().ID),
+		`Operation: archive issue "`+name+`"`+"\nError: rmdir does not archive issues on this mount (mount.issue_rmdir: deny). Archive it in Linear instead.")
+	return syscall.EPERM
+}
+
+// trashIssue is rmdir under mount.issue_rmdir: trash — move the issue into
+// issues/.archive/ and leave Linear alone.
+func (n *IssuesNode) trashIssue(ctx context.Context, name string) syscall.Errno {
+	team := n.entity()
+	key := collectionErrorKey("issues", team.ID)
+	issue, err := n.findIssue(ctx, name)
+	if err != nil {
+		msg, errno := classifyMutationErr(`trash issue "`+name+`"`, err)
+		n.lfs.SetWriteError(key, msg)
+		return errno
+	}
+	if issue == nil || n.lfs.trash.has(team.ID, issue.ID) {
+		n.lfs.SetWriteError(key, `Operation: trash issue "`+name+`"`+"\nError: no such entry. It may already be archived or in .archive/; list the directory for current names.")
+		return syscall.ENOENT
+	}
+	n.lfs.trash.put(team.ID, *issue)
+	n.lfs.ClearWriteError(key)
+	n.lfs.InvalidateDeleted(issuesDirIno(team.ID), name)
+	if name != issue.Identifier {
+		n.lfs.InvalidateDeleted(issuesDirIno(team.ID), issue.Identifier) // safename:ok structured id
+	}
+	n.lfs.InvalidateCreated(issueTrashDirIno(team.ID), n.lfs.issueDirs.name(issue))
+	return 0
+}
+
+// IssueTrashNode is /teams/{KEY}/issues/.archive/ (mount.issue_rmdir: trash):
+// issues rmdir'd from issues/ but not yet archived on Linear.
+type IssueTrashNode struct {
+	attrNode
+	entityCell[api.Team]
+}
+
+var _ fs.NodeReaddirer = (*IssueTrashNode)(nil)
+var _ fs.NodeLookuper = (*IssueTrashNode)(nil)
+var _ fs.NodeRmdirer = (*IssueTrashNode)(nil)
+var _ fs.NodeRenamer = (*IssueTrashNode)(nil)
+var _ fs.NodeUnlinker = (*IssueTrashNode)(nil)
+
+func (n *IssueTrashNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	var entries []fuse.DirEntry
+	for _, issue := range n.lfs.trash.list(n.entity().ID) {
+		entries = append(entries, fuse.DirEntry{Name: n.lfs.issueDirs.name(&issue), Mode: syscall.S_IFDIR})
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+// find resolves a listed name to its trashed issue.
+func (n *IssueTrashNode) find(name string) *api.Issue {
+	for _, issue := range n.lfs.trash.list(n.entity().ID) {
+		if issue.Identifier == name || n.lfs.issueDirs.name(&issue) == name {
+			return &issue
+		}
+	}
+	return nil
+}
+
+// Lookup serves a trashed issue's directory as a snapshot of when it was
+// trashed, so it can be inspected before it is archived or restored.
+func (n *IssueTrashNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issue := n.find(name)
+	if issue == nil {
+		return nil, syscall.ENOENT
+	}
+	node := &IssueDirectoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Issue]{val: *issue}}
+	return n.newDirInode(ctx, out, name, node, dirAttr(issue.CreatedAt, issue.UpdatedAt), issueDirIno(issue.ID), 30*time.Second), 0
+}
+
+// Rmdir archives a trashed issue on Linear: the archive that rmdir in issues/
+// performs under the default mode.
+func (n *IssueTrashNode) Rmdir(ctx context.Context, name string) syscall.Errno {
+	team := n.entity()
+	if n.lfs.debug {
+		log.Printf("Rmdir: %s in team %s trash (archiving issue)", name, team.Key)
+	}
+	return commitDelete(ctx, n.lfs, deleteSpec[api.Issue]{
+		op:   `archive issue "` + name + `"`,
+		key:  collectionErrorKey("issues", team.ID),
+		find: func(context.Context) (*api.Issue, error) { return n.find(name), nil },
+		mutate: func(ctx context.Context, i *api.Issue) error {
+			return n.lfs.mutator().ArchiveIssue(ctx, i.ID)
+		},
+		forget: func(ctx context.Context, i *api.Issue) error {
+			if err := n.lfs.store.Queries().DeleteIssue(ctx, i.ID); err != nil {
+				return err
+			}
+			n.lfs.trash.take(team.ID, i.ID)
+			return nil
+		},
+		dir:  issueTrashDirIno(team.ID),
+		name: name,
+		invalidateExtra: func(i *api.Issue) {
+			invalidateIssueMoved(n.lfs, n.lfs.issueDirs, i, nil)
+		},
+	})
+}
+
+// Rename restores a trashed issue: `mv .archive/ENG-123 .` from issues/ (any
+// target name that resolves to the same issue in the same team's issues/). Anything else
+// is refused — the trash is not a place to rename issues.
+func (n *IssueTrashNode) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	team := n.entity()
+	issue := n.find(name)
+	if issue == nil {
+		return syscall.ENOENT
+	}
+	dest, ok := newParent.(*IssuesNode)
+	if !ok || dest.entity().ID != team.ID {
+		return syscall.EXDEV
+	}
+	if ident, _ := n.lfs.issueDirs.identifier(newName); ident != issue.Identifier {
+		return syscall.EINVAL
+	}
+	n.lfs.trash.take(team.ID, issue.ID)
+	n.lfs.InvalidateDeleted(issueTrashDirIno(team.ID), name)
+	n.lfs.InvalidateCreated(issuesDirIno(team.ID), n.lfs.issueDirs.name(issue))
+	return 0
+}
+
+func (*IssueTrashNode) Unlink(context.Context, string) syscall.Errno { return removalRejected() }
+
+// lookupIssueTrash serves issues/.archive/ when the mount trashes on rmdir.
+func (n *IssuesNode) lookupIssueTrash(ctx context.Context, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if n.lfs.issueRmdir != config.IssueRmdirTrash {
+		return nil, syscall.ENOENT
+	}
+	team := n.entity()
+	node := &IssueTrashNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Team]{val: team}}
+	return n.newDirInode(ctx, out, issueTrashDirName, node, dirAttr(team.CreatedAt, team.UpdatedAt), issueTrashDirIno(team.ID), inheritTimeout), 0
+}
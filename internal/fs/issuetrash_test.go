@@ -0,0 +1,110 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// issueRmdirTestNode seeds one issue and returns its team's issues/ node on a
+// mount whose rmdir mode is mode.
+func issueRmdirTestNode(t *testing.T, mode string) (*LinearFS, *IssuesNode) {
+	t.Helper()
+	lfs, _ := linkTestLFS(t)
+	lfs.issueRmdir = mode
+	if mode == config.IssueRmdirTrash {
+		lfs.trash = newIssueTrash()
+	}
+	team := api.Team{ID: "team-1", Key: "TST"}
+	now := time.Now()
+	issue := api.Issue{ID: "issue-1", Identifier: "TST-1", Title: "Trash me", Team: &team, CreatedAt: now, UpdatedAt: now}
+	if err := lfs.UpsertIssue(context.Background(), issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	return lfs, &IssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}}
+}
+
+func readdirNames(t *testing.T, n fs.NodeReaddirer) []string {
+	t.Helper()
+	stream, errno := n.Readdir(context.Background())
+	if errno != 0 {
+		t.Fatalf("Readdir: %v", errno)
+	}
+	var names []string
+	for stream.HasNext() {
+		e, _ := stream.Next()
+		names = append(names, e.Name)
+	}
+	return names
+}
+
+// TestIssueRmdir_Deny: rmdir refuses with EPERM, says why in issues/.error,
+// and the issue stays listed.
+func TestIssueRmdir_Deny(t *testing.T) {
+	t.Parallel()
+	lfs, issues := issueRmdirTestNode(t, config.IssueRmdirDeny)
+
+	if errno := issues.Rmdir(context.Background(), "TST-1"); errno != syscall.EPERM {
+		t.Fatalf("Rmdir = %v, want EPERM", errno)
+	}
+	e := lfs.GetWriteError(collectionErrorKey("issues", "team-1"))
+	if e == nil || !strings.Contains(e.Message, "mount.issue_rmdir: deny") {
+		t.Errorf(".error = %+v, want it to name the setting", e)
+	}
+	if names := readdirNames(t, issues); !slices.Contains(names, "TST-1") || slices.Contains(names, issueTrashDirName) {
+		t.Errorf("issues/ = %v, want TST-1 and no .archive", names)
+	}
+}
+
+// TestIssueRmdir_TrashRestoreArchive drives the trash round trip: rmdir moves
+// the issue into .archive/, mv puts it back, and rmdir from .archive/ archives
+// it for real (the row is forgotten).
+func TestIssueRmdir_TrashRestoreArchive(t *testing.T) {
+	t.Parallel()
+	lfs, issues := issueRmdirTestNode(t, config.IssueRmdirTrash)
+	ctx := context.Background()
+	trash := &IssueTrashNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: issues.entity()}}
+
+	if errno := issues.Rmdir(ctx, "TST-1"); errno != 0 {
+		t.Fatalf("Rmdir = %v", errno)
+	}
+	if names := readdirNames(t, issues); slices.Contains(names, "TST-1") || !slices.Contains(names, issueTrashDirName) {
+		t.Errorf("issues/ after trash = %v, want .archive and no TST-1", names)
+	}
+	if names := readdirNames(t, trash); !slices.Equal(names, []string{"TST-1"}) {
+		t.Errorf(".archive/ = %v, want [TST-1]", names)
+	}
+	if _, err := lfs.store.Queries().GetIssueByID(ctx, "issue-1"); err != nil {
+		t.Errorf("trashing must not touch the issue row: %v", err)
+	}
+
+	if errno := trash.Rename(ctx, "TST-1", issues, "TST-2", 0); errno != syscall.EINVAL {
+		t.Errorf("restore onto another issue's name = %v, want EINVAL", errno)
+	}
+	if errno := trash.Rename(ctx, "TST-1", issues, "TST-1", 0); errno != 0 {
+		t.Fatalf("restore = %v", errno)
+	}
+	if names := readdirNames(t, issues); !slices.Contains(names, "TST-1") {
+		t.Errorf("issues/ after restore = %v, want TST-1", names)
+	}
+
+	if errno := issues.Rmdir(ctx, "TST-1"); errno != 0 {
+		t.Fatalf("second Rmdir = %v", errno)
+	}
+	if errno := trash.Rmdir(ctx, "TST-1"); errno != 0 {
+		t.Fatalf("archive from .archive/ = %v", errno)
+	}
+	if names := readdirNames(t, trash); len(names) != 0 {
+		t.Errorf(".archive/ after archive = %v, want empty", names)
+	}
+	if _, err := lfs.store.Queries().GetIssueByID(ctx, "issue-1"); err == nil {
+		t.Error("archived issue row still in the store")
+	}
+}
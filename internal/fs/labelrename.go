@@ -0,0 +1,89 @@
+package fs
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// Label rename propagation.
+//
+// A label's name is copied into every issue that carries it (the labels
+// embedded in the cached issue data), and by/label/ both lists and matches by
+// that name. Renaming the label in the catalog alone left by/label/{new}/
+// empty and issue.md showing the old name until each issue happened to be
+// refetched, which a rename never triggers (it does not touch the issues'
+// updatedAt). So a local rename rewrites the embedded copies too, then tells
+// the kernel about every listing and file that moved.
+
+// propagateLabelRename carries a label rename (from oldName to label.Name)
+// into the cached issues and the kernel's view of them.
+func (lfs *LinearFS) propagateLabelRename(ctx context.Context, oldName string, label api.Label) {
+	rows, err := lfs.store.RenameIssueLabel(ctx, label.ID, label.Name)
+	if err != nil {
+		log.Printf("Failed to propagate label rename %q -> %q: %v", oldName, label.Name, err)
+		return // intentionally best-effort: the rename itself is saved (recovers via the next full issue sync)
+	}
+	before, err := db.DBIssuesToAPIIssues(rows)
+	if err != nil {
+		log.Printf("Failed to decode issues for label rename %q -> %q: %v", oldName, label.Name, err)
+		return // intentionally best-effort: SQLite is already rewritten; only the notifies are lost (recovers via the kernel's entry timeouts)
+	}
+	teamLabels := func(teamID string) []api.Label {
+		labels, err := lfs.repo.GetTeamLabels(ctx, teamID)
+		if err != nil {
+			log.Printf("Failed to read labels of team %s for label rename %q -> %q: %v", teamID, oldName, label.Name, err)
+		}
+		return labels
+	}
+	invalidateLabelRenamed(lfs, lfs.issueDirs, oldName, label, before, teamLabels)
+}
+
+// invalidateLabelRenamed notifies the kernel of a label rename: the by/label/
+// value directory in each team that lists it, and for every issue carrying
+// the label, its moved by/label entries and its re-rendered issue.md (as for
+// a sync-observed change). teamLabels gives a team's labels after the rename;
+// the directory's old and new names come from labelNames over them with and
+// without the rename, as by/label/ lists them, so a name another label
+// shares renames under its suffixed form.
+func invalidateLabelRenamed(sink issueChangeSink, namer *issueDirNamer, oldName string, label api.Label, before []api.Issue, teamLabels func(teamID string) []api.Label) {
+	teams := make(map[string]bool)
+	if label.Team != nil && label.Team.ID != "" {
+		teams[label.Team.ID] = true
+	}
+	for i := range before {
+		after := withLabelName(before[i], label.ID, label.Name)
+		invalidateIssueSynced(sink, namer, &before[i], &after)
+		if before[i].Team != nil {
+			teams[before[i].Team.ID] = true
+		}
+	}
+	for team := range teams {
+		labels := teamLabels(team)
+		i := slices.IndexFunc(labels, func(l api.Label) bool { return l.ID == label.ID })
+		if i < 0 {
+			continue // not listed in this team's by/label/
+		}
+		was := slices.Clone(labels)
+		was[i].Name = oldName
+		oldDir, newDir := labelNames(was)[i], labelNames(labels)[i]
+		sink.InvalidateRenamed(byCategoryIno(team, "label"), oldDir, newDir, 0)
+	}
+}
+
+// withLabelName returns a copy of issue whose embedded label labelID is named
+// name. The label slice is copied, so the original issue keeps the old name.
+func withLabelName(issue api.Issue, labelID, name string) api.Issue {
+	nodes := make([]api.Label, len(issue.Labels.Nodes))
+	copy(nodes, issue.Labels.Nodes)
+	for i := range nodes {
+		if nodes[i].ID == labelID {
+			nodes[i].Name = name
+		}
+	}
+	issue.Labels.Nodes = nodes
+	return issue
+}
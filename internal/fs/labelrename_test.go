@@ -0,0 +1,56 @@
+package fs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestInvalidateLabelRenamed pins the notifies a label rename sends: the
+// by/label value directory renamed in the label's team and in every team an
+// affected issue lives in, each issue's entry moved between the old and new
+// value directories, and its issue.md replaced. The value directory is named
+// as by/label/ lists it: in team-2 another label is already called Defect and
+// keeps the name, so the renamed label's directory becomes Defect-label-bug.
+func TestInvalidateLabelRenamed(t *testing.T) {
+	t.Parallel()
+	namer := newIssueDirNamer("")
+	label := api.Label{ID: "label-bug", Name: "Defect", Team: &api.Team{ID: "team-1"}}
+	issue := api.Issue{ID: "issue-1", Identifier: "ENG-1", Team: &api.Team{ID: "team-2"}}
+	issue.Labels.Nodes = []api.Label{{ID: "label-ui", Name: "UI"}, {ID: "label-bug", Name: "Bug"}}
+
+	teamLabels := map[string][]api.Label{
+		"team-1": {label},
+		"team-2": {label, {ID: "label-aaa", Name: "Defect"}, {ID: "label-ui", Name: "UI"}},
+	}
+
+	var sink recordingSink
+	invalidateLabelRenamed(&sink, namer, "Bug", label, []api.Issue{issue}, func(team string) []api.Label { return teamLabels[team] })
+
+	want := []string{
+		fmt.Sprintf("deleted %d ENG-1", byValueIno("team-2", "label", "Bug")),
+		fmt.Sprintf("created %d ENG-1", byValueIno("team-2", "label", "Defect")),
+		fmt.Sprintf("replaced %d issue.md %d", issueDirIno("issue-1"), issueIno("issue-1")),
+		fmt.Sprintf("renamed %d Bug Defect", byCategoryIno("team-1", "label")),
+		fmt.Sprintf("renamed %d Bug Defect-label-bug", byCategoryIno("team-2", "label")),
+	}
+	for _, w := range want {
+		found := false
+		for _, g := range sink.got {
+			found = found || g == w
+		}
+		if !found {
+			t.Errorf("missing notify %q in %v", w, sink.got)
+		}
+	}
+	for _, g := range sink.got {
+		if g == fmt.Sprintf("deleted %d ENG-1", byValueIno("team-2", "label", "UI")) {
+			t.Error("the untouched label's listing was notified")
+		}
+	}
+	// The caller's issue keeps the old name (withLabelName copies the slice).
+	if issue.Labels.Nodes[1].Name != "Bug" {
+		t.Errorf("before issue mutated: %v", issue.Labels.Nodes)
+	}
+}
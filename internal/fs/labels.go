@@ -27,8 +27,16 @@ var _ fs.NodeCreater = (*LabelsNode)(nil)
 var _ fs.NodeUnlinker = (*LabelsNode)(nil)
 var _ fs.NodeRenamer = (*LabelsNode)(nil)
 
+// Readdir lists usage.md ahead of the collection (trio, labels, .meta).
 func (n *LabelsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
-	return n.collection().readdir(ctx)
+	c := n.collection()
+	// A fetch error degrades to the fixed entries, as collectionDir.readdir does.
+	labels, err := c.fetch(ctx)
+	if err != nil {
+		labels = nil
+	}
+	entries := []fuse.DirEntry{{Name: labelUsageName, Mode: syscall.S_IFREG}}
+	return fs.NewListDirStream(append(entries, c.entries(labels)...)), 0
 }
 
 // collection is the item-file surface (Readdir/Lookup/Unlink) for labels/.
@@ -65,6 +73,19 @@ func (n *LabelsNode) listing(labels []api.Label) namedListing[api.Label] {
 }
 
 func (n *LabelsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if name == labelUsageName {
+		// Recomputed from the cache on each read. Labels carry no timestamps,
+		// so the file reports none.
+		lfs, teamID := n.lfs, n.teamID
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			labels, lerr := lfs.repo.GetTeamLabels(ctx, teamID)
+			issues, ierr := lfs.repo.GetTeamIssues(ctx, teamID)
+			if lerr != nil || ierr != nil {
+				return []byte("# Error loading labels\n"), time.Time{}, time.Time{}
+			}
+			return renderLabelUsage(labels, issues), time.Time{}, time.Time{}
+		}, 0, inheritTimeout), 0
+	}
 	return n.collection().lookup(ctx, name, out)
 }
 
@@ -90,6 +111,9 @@ func (n *LabelsNode) newLabelInode(ctx context.Context, name string, label api.L
 }
 
 func (n *LabelsNode) Unlink(ctx context.Context, name string) syscall.Errno {
+	if name == labelUsageName {
+		return syscall.EPERM
+	}
 	return n.collection().unlink(ctx, name)
 }
 
@@ -123,7 +147,13 @@ func (n *LabelsNode) Create(ctx context.Context, name string, flags uint32, mode
 // before the .md suffix (traversal/control chars, empty fallback to label ID).
 func labelFilename(label api.Label) string {
 	name := strings.ReplaceAll(label.Name, " ", "-")
-	return safeName(name, label.ID) + ".md"
+	file := safeName(name, label.ID) + ".md"
+	if file == labelUsageName {
+		// The report owns usage.md; escape the label as safeName escapes a
+		// reserved literal.
+		file = safeName(name+"-"+label.ID, label.ID) + ".md"
+	}
+	return file
 }
 
 // LabelFileNode represents a single label file (read-write)
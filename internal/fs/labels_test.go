@@ -93,6 +93,11 @@ func TestLabelFilename(t *testing.T) {
 			// real label has an ID to fall back to.
 			want: "unnamed.md",
 		},
+		{
+			name:  "name shadowing usage.md",
+			label: api.Label{ID: "label-1", Name: "usage"},
+			want:  "usage-label-1.md",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,80 @@
+package fs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// labelUsageName is the read-only usage report in teams/{KEY}/labels/. A
+// label named "usage" is escaped by labelFilename so it cannot shadow it.
+const labelUsageName = "usage.md"
+
+// labelUsage is one label's row in usage.md.
+type labelUsage struct {
+	name         string
+	open, closed int
+	lastUsed     time.Time
+}
+
+// renderLabelUsage renders a team's labels/usage.md: every label the team can
+// apply (its own and the workspace's) plus any other label its issues carry,
+// with open and closed (completed or canceled) issue counts and the date the
+// newest issue carrying it was created — Linear records no apply time, so
+// that is the last use the cache can see. Most-used first, so the unused
+// labels a gardener is looking for collect at the bottom.
+func renderLabelUsage(labels []api.Label, issues []api.Issue) []byte {
+	usage := make(map[string]*labelUsage, len(labels))
+	for _, l := range labels {
+		usage[l.ID] = &labelUsage{name: l.Name}
+	}
+	for _, issue := range issues {
+		closed := issue.State.Type == "completed" || issue.State.Type == "canceled"
+		for _, l := range issue.Labels.Nodes {
+			u := usage[l.ID]
+			if u == nil {
+				u = &labelUsage{name: l.Name}
+				usage[l.ID] = u
+			}
+			if closed {
+				u.closed++
+			} else {
+				u.open++
+			}
+			if issue.CreatedAt.After(u.lastUsed) {
+				u.lastUsed = issue.CreatedAt
+			}
+		}
+	}
+	rows := make([]*labelUsage, 0, len(usage))
+	for _, u := range usage {
+		rows = append(rows, u)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		ti, tj := rows[i].open+rows[i].closed, rows[j].open+rows[j].closed
+		if ti != tj {
+			return ti > tj
+		}
+		return rows[i].name < rows[j].name
+	})
+
+	var b strings.Builder
+	b.WriteString("# Label usage\n\n")
+	if len(rows) == 0 {
+		b.WriteString("No labels.\n")
+		return []byte(b.String())
+	}
+	b.WriteString("| Label | Open | Closed | Last used |\n")
+	b.WriteString("|-------|------|--------|-----------|\n")
+	for _, u := range rows {
+		last := "never"
+		if !u.lastUsed.IsZero() {
+			last = u.lastUsed.UTC().Format("2006-01-02")
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d | %s |\n", strings.ReplaceAll(u.name, "|", `\|`), u.open, u.closed, last)
+	}
+	return []byte(b.String())
+}
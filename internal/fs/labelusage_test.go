@@ -0,0 +1,43 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestRenderLabelUsage(t *testing.T) {
+	t.Parallel()
+	bug := api.Label{ID: "l-bug", Name: "Bug"}
+	feature := api.Label{ID: "l-feature", Name: "Feature"}
+	stale := api.Label{ID: "l-stale", Name: "Stale"}
+	foreign := api.Label{ID: "l-other", Name: "Other team"}
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 12, 0, 0, 0, time.UTC) }
+	labelled := func(state string, created time.Time, labels ...api.Label) api.Issue {
+		return api.Issue{State: api.State{Type: state}, CreatedAt: created, Labels: api.Labels{Nodes: labels}}
+	}
+	issues := []api.Issue{
+		labelled("started", day(3), bug),
+		labelled("completed", day(9), bug, feature),
+		labelled("canceled", day(1), bug),
+		labelled("backlog", day(5), feature, foreign),
+	}
+
+	got := string(renderLabelUsage([]api.Label{bug, feature, stale}, issues))
+	want := "# Label usage\n\n" +
+		"| Label | Open | Closed | Last used |\n" +
+		"|-------|------|--------|-----------|\n" +
+		"| Bug | 1 | 2 | 2026-10-09 |\n" +
+		"| Feature | 1 | 1 | 2026-10-09 |\n" +
+		"| Other team | 1 | 0 | 2026-10-05 |\n" +
+		"| Stale | 0 | 0 | never |\n"
+	if got != want {
+		t.Errorf("usage.md =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := string(renderLabelUsage(nil, nil)); !strings.Contains(got, "No labels.") {
+		t.Errorf("no labels:\n%s", got)
+	}
+}
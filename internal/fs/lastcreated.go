@@ -0,0 +1,44 @@
+package fs
+
+import (
+	"context"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+)
+
+// The issues/.last-created sidecar.
+//
+// `.last` answers "what did my creates produce" as a YAML log; scripts chaining
+// a follow-up write onto a fresh issue want one thing only — where it is. So
+// issues/ also carries `.last-created`: the absolute path of the newest issue
+// created there (by mkdir or _create), one line, ready for
+// `cd "$(cat .last-created)"`. The create tail has already persisted the issue
+// and notified its directory entry by the time the writer's close returns, so
+// the path resolves immediately — no wait for the next sync.
+
+// lastCreatedName is the sidecar's filename in issues/.
+const lastCreatedName = ".last-created"
+
+// renderLastCreated renders the newest create recorded under key as an
+// absolute issue path under teamKey, or nothing before the first create.
+func (lfs *LinearFS) renderLastCreated(key, teamKey string) ([]byte, time.Time) {
+	results := lfs.GetWriteSuccess(key)
+	if len(results) == 0 {
+		return nil, time.Time{}
+	}
+	newest := results[len(results)-1]
+	path := lfs.teamPath(teamKey, "issues", newest.Path)
+	return []byte(path + "\n"), newest.Timestamp
+}
+
+// lookupLastCreated mounts issues/.last-created. Like .last it never caches:
+// the next create must show through immediately.
+func (lfs *LinearFS) lookupLastCreated(ctx context.Context, parent fs.InodeEmbedder, key, teamKey string, out *fuse.EntryOut) *fs.Inode {
+	render := func(context.Context) ([]byte, time.Time, time.Time) {
+		content, at := lfs.renderLastCreated(key, teamKey)
+		return content, at, at
+	}
+	return lfs.mountRenderFile(ctx, parent, lastCreatedName, render, lastCreatedIno(key), 0, out)
+}
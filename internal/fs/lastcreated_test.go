@@ -0,0 +1,30 @@
+package fs
+
+import (
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestRenderLastCreated pins issues/.last-created: empty before any create,
+// then the absolute path of the newest create only, where this mount shows
+// it: under a mount.root of the team, below the mountpoint itself.
+func TestRenderLastCreated(t *testing.T) {
+	t.Parallel()
+	lfs := newSuccessTestFS()
+	lfs.mountPoint = "/mnt/linear"
+	key := collectionSuccessKey("issues", "team-1")
+
+	if got, _ := lfs.renderLastCreated(key, "ENG"); got != nil {
+		t.Fatalf("before any create = %q, want empty", got)
+	}
+	lfs.AppendWriteSuccess(key, issueWriteResult(&api.Issue{Identifier: "ENG-1"}))
+	lfs.AppendWriteSuccess(key, issueWriteResult(&api.Issue{Identifier: "ENG-2"}))
+	if got, _ := lfs.renderLastCreated(key, "ENG"); string(got) != "/mnt/linear/teams/ENG/issues/ENG-2\n" {
+		t.Errorf("after two creates = %q, want the newest issue's path", got)
+	}
+	lfs.mountRoot = "teams/ENG"
+	if got, _ := lfs.renderLastCreated(key, "ENG"); string(got) != "/mnt/linear/issues/ENG-2\n" {
+		t.Errorf("under mount.root teams/ENG = %q, want the path below the mountpoint", got)
+	}
+}
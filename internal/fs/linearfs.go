@@ -18,6 +18,7 @@ import (
 	"github.com/jra3/linear-fuse/internal/repo"
 	"github.com/jra3/linear-fuse/internal/sync"
 	"github.com/jra3/linear-fuse/internal/telemetry"
+	"github.com/jra3/linear-fuse/internal/webhook"
 )
 
 // IssueError represents a validation error from a failed write operation
@@ -41,13 +42,54 @@ type LinearFS struct {
 
 	repo       *repo.SQLiteRepository // For all read operations
 	store      *db.Store              // SQLite store (owned by repo, kept for sync worker)
+	dbPath     string                 // the store's file ("" for an injected store); sized by /.linearfs/status
 	syncWorker *sync.Worker           // Background sync worker
 	requestLog io.Closer              // per-request debug log writer (nil when disabled); closed in Close
+	prStatuses *prStatusCache         // GitHub PR enrichment for .link files (nil when github.token is unset)
+	issueDirs  *issueDirNamer         // issues/ directory naming (nil = bare identifiers, the default)
+	iconPrefix bool                   // prefix team/project dir names with their emoji icon (see icondirname.go)
+	issueRmdir string                 // what rmdir of an issue does: config.IssueRmdir* (see issuetrash.go)
+	trash      *issueTrash            // issues/.archive/ contents (nil unless issueRmdir is trash)
+	confirms   *deleteConfirmations   // deletes awaiting a .confirm token (nil unless mount.confirm_deletes is set)
+	readOnly   bool                   // mount with "ro": the kernel refuses every write (mount.read_only; see mountOptions)
+	mountRoot  string                 // the subtree mounted at the mountpoint ("" = the workspace; see mountroot.go)
+	tombstones bool                   // list deleted comments as struck-through files (display.show_deleted_comments)
+	crossLinks bool                   // render issue references as relative links (display.cross_links; see crossLinker)
+	imagePaths bool                   // point CDN images at attachments/ (display.local_images; see crossLinker)
+	events     *eventLog              // sync-reported changes the /.events file streams (see events.go)
+	views      []customView           // config-defined teams/{KEY}/views/ (empty = no views/ dir)
+	recurring  []recurringIssue       // config-defined recurring issues, created by the sync worker
+	webhook    config.WebhookConfig   // real-time sync listener (zero = off; see webhook.go)
+	webhookMu  gosync.Mutex           // guards webhookID (set by the registering goroutine, read by Close)
+	webhookID  string                 // the webhook this mount registered ("" = none)
+	webhookLog *webhook.Handler       // the listener's handler, for /.linearfs/webhook-failures (nil = no listener)
+	liveSync   config.LiveSyncConfig  // real-time sync transport (zero = polling only; see livesync.go)
 	debug      bool
 	uid        uint32 // Owner UID for files/dirs
 	gid        uint32 // Owner GID for files/dirs
 	mountPoint string // Filesystem mount path (for README generation)
 
+	// Teams whose completed cycles post reports (empty = never post; see
+	// cyclereport.go).
+	cycleReports []config.CycleReportConfig
+
+	// needs-attention.md thresholds (zero = defaults; see attention.go).
+	attention config.AttentionConfig
+
+	// lint.md rule selection (zero = every rule; see lint.go).
+	lint config.LintConfig
+
+	// On-demand refresh policy (cache.staleness): the repository's per-kind
+	// thresholds, and whether opening issue.md refetches the issue.
+	staleness        repo.Staleness
+	revalidateOnOpen bool
+
+	// Team keys this mount is limited to (`teams:`; empty = every team),
+	// less those it leaves out (`exclude_teams:`): the sync worker syncs only
+	// these, and the repository lists only these.
+	teams        []string
+	excludeTeams []string
+
 	// Mount lifetime: every background goroutine LinearFS launches derives its
 	// ctx from lifeCtx via spawn, so Close can cancel + wait before tearing
 	// down the store the goroutines read (see spawn / Close).
@@ -98,16 +140,89 @@ func (b *BaseNode) LFS() *LinearFS {
 	return b.lfs
 }
 
+// NewAPIClient builds the Linear client for cfg's credential: the API key
+// when one is set, else the OAuth token `linearfs login` saved for the
+// oauth: application.
+func NewAPIClient(cfg *config.Config) (*api.Client, error) {
+	if cfg.APIKey != "" {
+		return api.NewClient(cfg.APIKey), nil
+	}
+	if cfg.OAuth.ClientID == "" {
+		return nil, fmt.Errorf("LINEAR_API_KEY not set - set env var, add api_key to config file, or configure oauth and run `linearfs login`")
+	}
+	tokens, err := api.LoadTokenSource(OAuthApp(cfg.OAuth), cfg.OAuth.TokenPath)
+	if err != nil {
+		return nil, err
+	}
+	return api.NewOAuthClient(tokens), nil
+}
+
+// OAuthApp is the api view of the oauth: config block.
+func OAuthApp(o config.OAuthConfig) api.OAuthApp {
+	return api.OAuthApp{ClientID: o.ClientID, ClientSecret: o.ClientSecret, RedirectURL: o.RedirectURL, Scopes: o.Scopes}
+}
+
 func NewLinearFS(cfg *config.Config, debug bool) (*LinearFS, error) {
-	if cfg.APIKey == "" {
-		return nil, fmt.Errorf("LINEAR_API_KEY not set - set env var or add api_key to config file")
+	client, err := NewAPIClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Config-defined views are compiled up front: a bad filter fails the
+	// mount instead of surfacing as an empty directory later.
+	views, err := compileViews(cfg.Views)
+	if err != nil {
+		return nil, err
+	}
+	recurring, err := compileRecurring(cfg.Recurring)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCycleReports(cfg.CycleReports); err != nil {
+		return nil, err
+	}
+	if cfg.Attention.StaleDays < 0 || cfg.Attention.SLAWarningHours < 0 {
+		return nil, fmt.Errorf("attention: thresholds must not be negative")
+	}
+	if err := validateLint(cfg.Lint); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateIssueRmdir(cfg.Mount.IssueRmdir); err != nil {
+		return nil, fmt.Errorf("mount: %w", err)
+	}
+	if err := config.ValidateMountRoot(cfg.Mount); err != nil {
+		return nil, fmt.Errorf("mount: %w", err)
+	}
+	if err := config.ValidateWebhook(cfg.Webhook); err != nil {
+		return nil, err
+	}
+	if err := config.ValidateLiveSync(cfg.LiveSync); err != nil {
+		return nil, err
+	}
+	if cfg.Mount.ConfirmDeletes < 0 {
+		return nil, fmt.Errorf("mount: confirm_deletes must not be negative")
+	}
+	if st := cfg.Cache.Staleness; st.Comments < 0 || st.Documents < 0 || st.Updates < 0 {
+		return nil, fmt.Errorf("cache: staleness values must not be negative")
+	}
+	lazy, err := api.ParseLazyIssueFields(cfg.API.LazyIssueFields)
+	if err != nil {
+		return nil, fmt.Errorf("api: %w", err)
+	}
+	if rl := cfg.API.RateLimit; rl.RequestsPerHour < 0 || rl.ComplexityPerHour < 0 || rl.Burst < 0 {
+		return nil, fmt.Errorf("api: rate_limit values must not be negative")
 	}
 
 	// Get current user's UID/GID for file ownership
 	uid := uint32(os.Getuid())
 	gid := uint32(os.Getgid())
 
-	client := api.NewClient(cfg.APIKey)
+	client.SetLazyIssueFields(lazy)
+	client.SetRateLimits(api.RateLimits{
+		RequestsPerHour:   float64(cfg.API.RateLimit.RequestsPerHour),
+		ComplexityPerHour: float64(cfg.API.RateLimit.ComplexityPerHour),
+		Burst:             cfg.API.RateLimit.Burst,
+	})
 
 	// Optional per-request JSONL debug log (telemetry.requests.*, default
 	// off). Wired at client construction — the config lives under telemetry
@@ -133,6 +248,15 @@ func NewLinearFS(cfg *config.Config, debug bool) (*LinearFS, error) {
 		verifierImpl:   client,
 		liveReaderImpl: client,
 		requestLog:     requestLog,
+		views:          views,
+		recurring:      recurring,
+		cycleReports:   cfg.CycleReports,
+		attention:      cfg.Attention,
+		lint:           cfg.Lint,
+		teams:          mountTeams(cfg),
+		excludeTeams:   cfg.ExcludeTeams,
+		webhook:        cfg.Webhook,
+		liveSync:       cfg.LiveSync,
 		debug:          debug,
 	}
 	// Mint the mount-lifetime context. Background is correct here: the mount's
@@ -141,6 +265,38 @@ func NewLinearFS(cfg *config.Config, debug bool) (*LinearFS, error) {
 	// Wire the feedback store's kernel-cache seam to this instance. The method
 	// value binds the pointer, so it is safe to set after lfs exists.
 	lfs.writeFeedback = newWriteFeedback(lfs.InvalidateUpdated)
+	lfs.issueDirs = newIssueDirNamer(cfg.Mount.IssueDirTemplate)
+	if lfs.issueDirs.flags() {
+		lfs.issueDirs.blocked = lfs.issueBlocked
+	}
+	lfs.iconPrefix = cfg.Mount.IconPrefix
+	lfs.issueRmdir = cfg.Mount.IssueRmdir
+	if lfs.issueRmdir == config.IssueRmdirTrash {
+		lfs.trash = newIssueTrash()
+	}
+	lfs.readOnly = cfg.Mount.ReadOnly
+	lfs.mountRoot = cfg.Mount.Root
+	if cfg.Mount.ConfirmDeletes > 0 {
+		lfs.confirms = newDeleteConfirmations(cfg.Mount.ConfirmDeletes)
+	}
+	lfs.staleness = repo.Staleness{
+		Comments:  cfg.Cache.Staleness.Comments,
+		Documents: cfg.Cache.Staleness.Documents,
+		Updates:   cfg.Cache.Staleness.Updates,
+	}
+	lfs.revalidateOnOpen = cfg.Cache.Staleness.RevalidateIssueOnOpen
+	lfs.tombstones = cfg.Display.ShowDeletedComments
+	lfs.crossLinks = cfg.Display.CrossLinks
+	lfs.imagePaths = cfg.Display.LocalImages
+	lfs.events = newEventLog()
+	if cfg.API.SchemaCheck {
+		lfs.spawn(lfs.logSchemaDrift)
+	}
+	// GitHub PR enrichment is opt-in: only a configured token creates the
+	// client, so a default mount never talks to api.github.com.
+	if cfg.GitHub.Token != "" {
+		lfs.prStatuses = newPRStatusCache(api.NewGitHubClient(cfg.GitHub.Token))
+	}
 	// The embedded-file cache's seams are late-bound: repo is wired later (in
 	// EnableSQLiteCache), so persist reads lfs.repo at call time — and no-ops
 	// while it is still nil (a fetch before the cache is enabled).
@@ -189,10 +345,19 @@ func (lfs *LinearFS) Close() {
 	}
 	lfs.lifeMu.Unlock()
 	lfs.lifeWG.Wait()
+	lfs.unregisterWebhook()
 	// Stop sync worker first
 	if lfs.syncWorker != nil {
 		lfs.syncWorker.Stop()
 	}
+	// Persist the budget's final state while the store is still open.
+	// intentionally best-effort: a lost final flush only under-counts the
+	// last interval's spend (recovers via the next run's first response).
+	if lfs.repo != nil {
+		if err := lfs.repo.PersistAPIBudget(context.Background()); err != nil {
+			log.Printf("[linearfs] Warning: failed to persist API budget: %v", err)
+		}
+	}
 	// Close repository (stops background refresh goroutines)
 	if lfs.repo != nil {
 		lfs.repo.Close()
@@ -213,6 +378,23 @@ func (lfs *LinearFS) Close() {
 // Everything here runs under lifeCtx (the mount lifetime) — a caller ctx would
 // be wrong, since the background work it starts must outlive the caller and
 // die with Close instead.
+// logSchemaDrift is the api.schema_check startup probe: it logs each field
+// linearfs queries that Linear has deprecated or dropped, so the fix can land
+// before the field starts failing requests.
+func (lfs *LinearFS) logSchemaDrift(ctx context.Context) {
+	drift, err := lfs.client.CheckSchemaDrift(ctx)
+	if err != nil {
+		log.Printf("[schema] Warning: schema check failed: %v", err)
+		return
+	}
+	for _, d := range drift {
+		log.Printf("[schema] Warning: %s", d)
+	}
+	if len(drift) == 0 {
+		log.Printf("[schema] every queried field is live and undeprecated")
+	}
+}
+
 func (lfs *LinearFS) EnableSQLiteCache(dbPath string) error {
 	if dbPath == "" {
 		dbPath = db.DefaultDBPath()
@@ -224,9 +406,26 @@ func (lfs *LinearFS) EnableSQLiteCache(dbPath string) error {
 	}
 
 	lfs.store = store
+	lfs.dbPath = dbPath
+	if store.ReadOnly() {
+		lfs.enableReadOnlyCache(store)
+		return nil
+	}
 
 	// Create repository with API client for on-demand fetching
 	lfs.repo = repo.NewSQLiteRepository(store, lfs.client)
+	lfs.repo.SetTeamFilter(lfs.teams, lfs.excludeTeams)
+	lfs.repo.SetStaleness(lfs.staleness)
+
+	// Seed the rate budget from the last run's windows before anything below
+	// spends from it, then keep them (and the hourly spend) persisted.
+	// intentionally best-effort: without the seed the budget starts unseen,
+	// exactly as before persistence existed (recovers via the first response's
+	// headers).
+	if err := lfs.repo.RestoreAPIBudget(lfs.lifeCtx); err != nil {
+		log.Printf("[sqlite] Warning: failed to restore API budget: %v", err)
+	}
+	lfs.spawn(lfs.persistBudgetLoop)
 
 	// H-1: Load viewer from SQLite cache immediately for /my views (no API wait)
 	if cachedViewerID, err := store.Queries().GetViewerUserID(lfs.lifeCtx); err == nil {
@@ -286,16 +485,86 @@ func (lfs *LinearFS) EnableSQLiteCache(dbPath string) error {
 	// Create and start sync worker. The worker keeps its own stop mechanism;
 	// it merely derives its ctx from the mount lifetime now, so Close's
 	// cancel aborts a mid-flight sync cycle before Stop is even called.
-	lfs.syncWorker = sync.NewWorker(lfs.client, store, sync.DefaultConfig())
+	syncCfg := sync.DefaultConfig()
+	syncCfg.Teams = lfs.teams
+	syncCfg.ExcludeTeams = lfs.excludeTeams
+	lfs.syncWorker = sync.NewWorker(lfs.client, store, syncCfg)
 	lfs.syncWorker.SetBudgetReporter(lfs.client)
 	lfs.syncWorker.SetCatchUpModeToggler(lfs.repo)
 	lfs.syncWorker.SetIssueIDReconciler(lfs.repo)
+	lfs.syncWorker.SetChangeListener(lfs)
+	// The worker's writes to Linear — replaying queued writes, creating
+	// recurring issues, posting cycle reports — are writes a read-only mount
+	// promises not to make; sync itself still keeps the cache current.
+	if !lfs.readOnly {
+		lfs.syncWorker.SetMutationReplayer(lfs)
+		if len(lfs.recurring) > 0 {
+			lfs.syncWorker.SetRecurringIssues(lfs, lfs.recurringSchedules())
+		}
+		if len(lfs.cycleReports) > 0 {
+			lfs.syncWorker.SetCycleReporter(lfs)
+		}
+	}
 	lfs.syncWorker.Start(lfs.lifeCtx)
 
+	if lfs.webhook.Listen != "" {
+		if err := lfs.startWebhook(lfs.webhook); err != nil {
+			return err
+		}
+	}
+	lfs.startLiveSync(lfs.liveSync)
+
 	log.Printf("[sqlite] Enabled persistent cache at %s", dbPath)
 	return nil
 }
 
+// enableReadOnlyCache serves a cache another linearfs process writes (the
+// store lost the writer lock, db/lock.go). On-demand fetches, the sync worker,
+// the viewer refresh, the webhook listener and the live-sync subscription all
+// write the store, so none of them run: the repository reads the cache as
+// that process keeps it, and the mount is read-only, since a write's local
+// upsert would fail.
+func (lfs *LinearFS) enableReadOnlyCache(store *db.Store) {
+	lfs.readOnly = true
+	lfs.repo = repo.NewSQLiteRepository(store, nil)
+	lfs.repo.SetTeamFilter(lfs.teams, lfs.excludeTeams)
+	lfs.repo.SetStaleness(lfs.staleness)
+	if cachedViewerID, err := store.Queries().GetViewerUserID(lfs.lifeCtx); err == nil {
+		if dbUser, err := store.Queries().GetUser(lfs.lifeCtx, cachedViewerID); err == nil {
+			apiUser := db.DBUserToAPIUser(dbUser)
+			lfs.repo.SetCurrentUser(&apiUser)
+		}
+	}
+	writer := "another linearfs process"
+	if pid := store.WriterPID(); pid != 0 {
+		writer = fmt.Sprintf("linearfs pid %d", pid)
+	}
+	log.Printf("[sqlite] %s is written by %s; serving it read-only, without sync", lfs.dbPath, writer)
+}
+
+// budgetPersistInterval is how often the rate budget's windows and spend are
+// written to SQLite. A crash loses at most one interval of spend accounting.
+const budgetPersistInterval = 30 * time.Second
+
+// persistBudgetLoop writes the API budget to SQLite every
+// budgetPersistInterval until the mount closes; Close writes the final state.
+func (lfs *LinearFS) persistBudgetLoop(ctx context.Context) {
+	ticker := time.NewTicker(budgetPersistInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// intentionally best-effort: the next tick rewrites the windows in
+			// full (recovers via the next persist).
+			if err := lfs.repo.PersistAPIBudget(ctx); err != nil {
+				log.Printf("[sqlite] Warning: failed to persist API budget: %v", err)
+			}
+		}
+	}
+}
+
 // HasSQLiteCache returns true if SQLite backend is enabled
 func (lfs *LinearFS) HasSQLiteCache() bool {
 	return lfs.repo != nil
@@ -362,7 +631,16 @@ func (lfs *LinearFS) UpsertLabel(ctx context.Context, teamID string, label api.L
 	if err != nil {
 		return err
 	}
-	return lfs.store.Queries().UpsertLabel(ctx, params)
+	// The catalog row before the upsert names what a rename renamed from; a
+	// create has none (sql.ErrNoRows) and nothing to propagate.
+	prev, prevErr := lfs.store.Queries().GetLabel(ctx, label.ID)
+	if err := lfs.store.Queries().UpsertLabel(ctx, params); err != nil {
+		return err
+	}
+	if prevErr == nil && prev.Name != label.Name {
+		lfs.propagateLabelRename(ctx, prev.Name, label)
+	}
+	return nil
 }
 
 // UpsertProject inserts or updates a project in SQLite.
@@ -456,6 +734,46 @@ func (lfs *LinearFS) FetchIssueByIdentifier(ctx context.Context, identifier stri
 	return issue, nil
 }
 
+// completeIssue returns issue with the fields a projected bulk sync left out
+// (api.lazy_issue_fields) fetched, or issue itself when its row is already
+// complete. A label the fetch brings in moves the issue between by/label
+// listings, so those are notified like an edit's.
+func (lfs *LinearFS) completeIssue(ctx context.Context, issue api.Issue) api.Issue {
+	if lfs.repo == nil {
+		return issue
+	}
+	full, err := lfs.repo.CompleteIssueFields(ctx, issue.ID)
+	if err != nil {
+		log.Printf("Failed to fetch full fields for %s: %v", issue.Identifier, err)
+		return issue // intentionally best-effort: the cached row still renders (recovers via the next open; the mark stays)
+	}
+	if full == nil {
+		return issue
+	}
+	invalidateIssueMoved(lfs, lfs.issueDirs, &issue, full)
+	return *full
+}
+
+// revalidateIssue returns issue as Linear has it now, fetched in the caller's
+// request (cache.staleness.revalidate_issue_on_open). ok is false when nothing
+// was fetched — a failure, or no client — and the cached row stands. Listings
+// the fetch moves the issue between are notified like an edit's.
+func (lfs *LinearFS) revalidateIssue(ctx context.Context, issue api.Issue) (api.Issue, bool) {
+	if lfs.repo == nil {
+		return issue, false
+	}
+	fresh, err := lfs.repo.RevalidateIssue(ctx, issue.ID)
+	if err != nil {
+		log.Printf("Failed to revalidate %s: %v", issue.Identifier, err)
+		return issue, false // intentionally best-effort: the cached row still renders (recovers via the next open)
+	}
+	if fresh == nil {
+		return issue, false
+	}
+	invalidateIssueMoved(lfs, lfs.issueDirs, &issue, fresh)
+	return *fresh, true
+}
+
 // GetFilteredIssuesByStatus fetches issues filtered by status name
 func (lfs *LinearFS) GetFilteredIssuesByStatus(ctx context.Context, teamID, statusName string) ([]api.Issue, error) {
 	state, err := lfs.repo.GetStateByName(ctx, teamID, statusName)
@@ -767,20 +1085,20 @@ func (lfs *LinearFS) projectLabelNames(ctx context.Context, ids []string) []stri
 // MountFS mounts an existing LinearFS instance at the given path.
 // This is useful for testing when you need to configure LinearFS before mounting.
 func MountFS(mountpoint string, lfs *LinearFS, debug bool) (*fuse.Server, error) {
-	root := &RootNode{BaseNode: BaseNode{lfs: lfs}}
+	root, rootAttr, err := lfs.rootNode(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
 	// Use longer timeouts to reduce kernel→userspace calls
 	attrTimeout := 60 * time.Second
 	entryTimeout := 30 * time.Second
 
 	opts := &fs.Options{
-		AttrTimeout:  &attrTimeout,
-		EntryTimeout: &entryTimeout,
-		MountOptions: fuse.MountOptions{
-			Name:   "linearfs",
-			FsName: "linear",
-			Debug:  debug,
-		},
+		AttrTimeout:    &attrTimeout,
+		EntryTimeout:   &entryTimeout,
+		MountOptions:   lfs.mountOptions(debug),
+		RootStableAttr: rootAttr,
 	}
 
 	server, err := fs.Mount(mountpoint, root, opts)
@@ -799,10 +1117,31 @@ func MountFS(mountpoint string, lfs *LinearFS, debug bool) (*fuse.Server, error)
 	}
 
 	lfs.SetServer(server)
-	lfs.mountPoint = mountpoint
+	// A watchdog remount (cmd/watchdog.go) mounts the same path again while
+	// handlers read mountPoint; only the first mount writes it.
+	if lfs.mountPoint != mountpoint {
+		lfs.mountPoint = mountpoint
+	}
 	return server, nil
 }
 
+// mountOptions are the FUSE options MountFS mounts with. A read-only mount
+// (mount.read_only, --read-only) passes "ro": the kernel then refuses every
+// create, write, rename, unlink, rmdir and setattr with EROFS before it
+// reaches a node, the .linearfs controls included, while the sync worker and
+// webhook deliveries go on updating the cache the reads come from.
+func (lfs *LinearFS) mountOptions(debug bool) fuse.MountOptions {
+	opts := fuse.MountOptions{
+		Name:   "linearfs",
+		FsName: "linear",
+		Debug:  debug,
+	}
+	if lfs.readOnly {
+		opts.Options = append(opts.Options, "ro")
+	}
+	return opts
+}
+
 // InjectTestStore sets up the SQLite store and repository for testing.
 // This is used by integration tests to pre-populate the database with fixtures.
 func (lfs *LinearFS) InjectTestStore(store *db.Store) error {
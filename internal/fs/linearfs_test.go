@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -372,3 +373,50 @@ func TestSpawnAfterCloseDeclines(t *testing.T) {
 		t.Error("spawn ran fn after Close; it must decline")
 	}
 }
+
+// TestMountOptionsReadOnly: mount.read_only mounts with "ro", leaving the
+// kernel to refuse writes; the default mount passes no options.
+func TestMountOptionsReadOnly(t *testing.T) {
+	t.Parallel()
+	for _, readOnly := range []bool{false, true} {
+		lfs, err := NewLinearFS(&config.Config{APIKey: "test-key", Mount: config.MountConfig{ReadOnly: readOnly}}, false)
+		if err != nil {
+			t.Fatalf("NewLinearFS failed: %v", err)
+		}
+		got := lfs.mountOptions(false).Options
+		if readOnly && !slices.Equal(got, []string{"ro"}) || !readOnly && len(got) != 0 {
+			t.Errorf("read_only %v: options = %q", readOnly, got)
+		}
+		lfs.Close()
+	}
+}
+
+// TestEnableSQLiteCacheReadOnly: a cache another process writes is served
+// read-only — no sync worker, a read-only mount — with the writer's rows.
+func TestEnableSQLiteCacheReadOnly(t *testing.T) {
+	t.Parallel()
+	dbPath := filepath.Join(t.TempDir(), "cache.db")
+	writer, err := db.Open(dbPath)
+	if err != nil {
+		t.Fatalf("db.Open: %v", err)
+	}
+	defer writer.Close()
+	if err := writer.Queries().UpsertTeam(context.Background(), db.UpsertTeamParams{ID: "team-1", Key: "TST", Name: "Test", SyncedAt: db.Now()}); err != nil {
+		t.Fatalf("UpsertTeam: %v", err)
+	}
+
+	lfs, err := NewLinearFS(&config.Config{APIKey: "test-key"}, false)
+	if err != nil {
+		t.Fatalf("NewLinearFS failed: %v", err)
+	}
+	defer lfs.Close()
+	if err := lfs.EnableSQLiteCache(dbPath); err != nil {
+		t.Fatalf("EnableSQLiteCache: %v", err)
+	}
+	if !lfs.readOnly || lfs.syncWorker != nil {
+		t.Errorf("read-only cache: readOnly %v, sync worker %v", lfs.readOnly, lfs.syncWorker != nil)
+	}
+	if teams, err := lfs.repo.GetTeams(context.Background()); err != nil || len(teams) != 1 {
+		t.Errorf("GetTeams = %v, %v; want the writer's team", teams, err)
+	}
+}
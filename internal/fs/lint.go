@@ -0,0 +1,127 @@
+package fs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// teamLintName is the per-team rule report in teams/{KEY}/.
+const teamLintName = "lint.md"
+
+// lintRule is one lint.md check. name is its key in `lint.disable`; check
+// reports whether an issue breaks the rule and, if so, the finding's detail.
+type lintRule struct {
+	name  string
+	title string
+	check func(issue api.Issue, attachments []api.Attachment, cfg config.LintConfig) (detail string, flagged bool)
+}
+
+// lintRules are every lint.md rule, in report order.
+var lintRules = []lintRule{
+	{name: "done-open-pr", title: "Done with an open pull request", check: lintDoneOpenPR},
+	{name: "unassigned-in-progress", title: "In progress with no assignee", check: lintUnassignedInProgress},
+}
+
+// validateLint checks the `lint:` config: every disabled name must be a rule,
+// so a typo fails the mount rather than silently leaving the rule on.
+func validateLint(cfg config.LintConfig) error {
+	for _, name := range cfg.Disable {
+		if !slices.ContainsFunc(lintRules, func(r lintRule) bool { return r.name == name }) {
+			return fmt.Errorf("lint: unknown rule %q in disable", name)
+		}
+	}
+	return nil
+}
+
+// lintDoneOpenPR flags a completed issue that still has an open pull request
+// attached: the work shipped without the PR merging, or the issue was closed
+// early.
+func lintDoneOpenPR(issue api.Issue, attachments []api.Attachment, _ config.LintConfig) (string, bool) {
+	if issue.State.Type != "completed" {
+		return "", false
+	}
+	var open []string
+	for _, att := range attachments {
+		if status, ok := openPullRequest(att); ok {
+			open = append(open, att.Title+" is "+status)
+		}
+	}
+	return strings.Join(open, "; "), len(open) > 0
+}
+
+// lintUnassignedInProgress flags an issue in a started state (or in one of
+// cfg.UnassignedStates) with nobody assigned.
+func lintUnassignedInProgress(issue api.Issue, _ []api.Attachment, cfg config.LintConfig) (string, bool) {
+	if issue.Assignee != nil {
+		return "", false
+	}
+	inProgress := issue.State.Type == "started"
+	if len(cfg.UnassignedStates) > 0 {
+		inProgress = slices.Contains(cfg.UnassignedStates, issue.State.Name)
+	}
+	return issue.State.Name, inProgress
+}
+
+// openPullRequest reports whether an attachment is a pull request Linear's
+// integration still records as open, and the status it records. Linear keeps
+// the PR's status in the attachment metadata ("open", "draft", "inReview",
+// "approved", "merged", "closed"); an attachment without one is not judged.
+func openPullRequest(att api.Attachment) (string, bool) {
+	status, _ := att.Metadata["status"].(string)
+	switch status {
+	case "", "merged", "closed":
+		return status, false
+	}
+	if _, _, _, ok := api.ParseGitHubPRURL(att.URL); !ok && att.SourceType != "github" && att.SourceType != "gitlab" {
+		return status, false
+	}
+	return status, true
+}
+
+// renderTeamLint renders a team's lint.md from its issues and their
+// attachments as SQLite holds them (attachments sync with issue details, so
+// an issue never opened is only checked once they have): each enabled rule's
+// findings, in identifier order.
+func renderTeamLint(team api.Team, issues []api.Issue, attachments map[string][]api.Attachment, cfg config.LintConfig) []byte {
+	issues = slices.Clone(issues)
+	slices.SortFunc(issues, func(a, b api.Issue) int { return strings.Compare(a.Identifier, b.Identifier) })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s: lint\n\n", team.Key)
+	var enabled []string
+	found := 0
+	var body strings.Builder
+	for _, rule := range lintRules {
+		if slices.Contains(cfg.Disable, rule.name) {
+			continue
+		}
+		enabled = append(enabled, rule.name)
+		var lines []string
+		for _, issue := range issues {
+			if detail, ok := rule.check(issue, attachments[issue.ID], cfg); ok {
+				lines = append(lines, fmt.Sprintf("- %s %s (%s)\n", issue.Identifier, issue.Title, detail))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		found += len(lines)
+		fmt.Fprintf(&body, "\n## %s (%d)\n\n", rule.title, len(lines))
+		body.WriteString(strings.Join(lines, ""))
+	}
+	if len(enabled) == 0 {
+		b.WriteString("Every rule is disabled.\n")
+		return []byte(b.String())
+	}
+	fmt.Fprintf(&b, "Rules: %s.\n", strings.Join(enabled, ", "))
+	if found == 0 {
+		b.WriteString("\nNo findings.\n")
+		return []byte(b.String())
+	}
+	b.WriteString(body.String())
+	return []byte(b.String())
+}
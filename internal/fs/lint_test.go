@@ -0,0 +1,74 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+func TestRenderTeamLint(t *testing.T) {
+	t.Parallel()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+	done := api.State{Name: "Done", Type: "completed"}
+	started := api.State{Name: "In Progress", Type: "started"}
+	review := api.State{Name: "In Review", Type: "started"}
+	pr := func(title, status string) api.Attachment {
+		return api.Attachment{Title: title, URL: "https://github.com/acme/app/pull/1", SourceType: "github", Metadata: map[string]any{"status": status}}
+	}
+	ada := &api.User{Name: "Ada"}
+	issues := []api.Issue{
+		{ID: "i3", Identifier: "ENG-3", Title: "Orphaned", State: review},
+		{ID: "i1", Identifier: "ENG-1", Title: "Shipped early", State: done, Assignee: ada},
+		{ID: "i2", Identifier: "ENG-2", Title: "Merged", State: done, Assignee: ada},
+		{ID: "i4", Identifier: "ENG-4", Title: "Owned", State: started, Assignee: ada},
+		{ID: "i5", Identifier: "ENG-5", Title: "Unassigned", State: started},
+		{ID: "i6", Identifier: "ENG-6", Title: "No status", State: done},
+	}
+	attachments := map[string][]api.Attachment{
+		"i1": {pr("PR #1", "open"), pr("PR #2", "merged")},
+		"i2": {pr("PR #3", "merged")},
+		"i6": {{Title: "Unknown", URL: "https://github.com/acme/app/pull/9"}},
+	}
+
+	got := string(renderTeamLint(team, issues, attachments, config.LintConfig{}))
+	for _, want := range []string{
+		"# ENG: lint\n\nRules: done-open-pr, unassigned-in-progress.\n",
+		"## Done with an open pull request (1)\n\n- ENG-1 Shipped early (PR #1 is open)\n",
+		"## In progress with no assignee (2)\n\n- ENG-3 Orphaned (In Review)\n- ENG-5 Unassigned (In Progress)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("lint.md missing %q:\n%s", want, got)
+		}
+	}
+	for _, absent := range []string{"ENG-2", "ENG-4", "ENG-6"} {
+		if strings.Contains(got, absent) {
+			t.Errorf("lint.md flags %s:\n%s", absent, got)
+		}
+	}
+
+	// Rules are configurable: narrowing the states drops ENG-3, disabling a
+	// rule drops its section.
+	got = string(renderTeamLint(team, issues, attachments, config.LintConfig{
+		Disable:          []string{"done-open-pr"},
+		UnassignedStates: []string{"In Progress"},
+	}))
+	if strings.Contains(got, "ENG-1") || strings.Contains(got, "ENG-3") || !strings.Contains(got, "- ENG-5 Unassigned") {
+		t.Errorf("configured rules:\n%s", got)
+	}
+
+	if got := string(renderTeamLint(team, nil, nil, config.LintConfig{})); !strings.Contains(got, "No findings.") {
+		t.Errorf("empty team:\n%s", got)
+	}
+}
+
+func TestValidateLint(t *testing.T) {
+	t.Parallel()
+	if err := validateLint(config.LintConfig{Disable: []string{"done-open-pr", "unassigned-in-progress"}}); err != nil {
+		t.Errorf("known rules: %v", err)
+	}
+	if err := validateLint(config.LintConfig{Disable: []string{"done-open-prs"}}); err == nil {
+		t.Error("an unknown rule name was accepted")
+	}
+}
@@ -0,0 +1,35 @@
+package fs
+
+import (
+	"log"
+
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/livesync"
+	"github.com/jra3/linear-fuse/internal/webhook"
+)
+
+// Real-time sync without a listener (live_sync:).
+//
+// With live_sync.transport: subscription the mount holds an outbound GraphQL
+// subscription (internal/livesync) instead of, or beside, a webhook listener
+// — the option for a mount behind NAT. Each event goes through a
+// webhook.Handler's Apply, so it is written and reported through Changed
+// exactly as a delivery is. An endpoint that cannot serve the subscription
+// is logged once and the transport idles; the sync worker runs unchanged
+// underneath either way.
+
+// startLiveSync starts the subscription transport when the config selects it.
+func (lfs *LinearFS) startLiveSync(cfg config.LiveSyncConfig) {
+	if cfg.Transport != config.LiveSyncSubscription {
+		return
+	}
+	url := cfg.URL
+	if url == "" {
+		url = config.DefaultLiveSyncURL
+	}
+	// The handler never serves HTTP, so it needs no signing secret.
+	handler := webhook.NewHandler(lfs.store, "", lfs)
+	sub := livesync.New(url, lfs.client.AuthHeader, handler)
+	lfs.spawn(sub.Run)
+	log.Printf("[livesync] Subscribing at %s", url)
+}
@@ -127,6 +127,18 @@ func (m *dirManifest) metaFile(name string, render renderFunc) {
 	})
 }
 
+// triggerFile adds a write-only (0200) trigger file (issue.patch) whose every
+// write cycle runs onFlush — the entity-directory twin of a collection's
+// _create.
+func (m *dirManifest) triggerFile(name string, onFlush func(ctx context.Context, content []byte) syscall.Errno) {
+	m.children = append(m.children, staticChild{
+		name: name, mode: syscall.S_IFREG,
+		build: func(ctx context.Context, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+			return m.parent.lfs.lookupTriggerFile(ctx, m.parent, onFlush, out), 0
+		},
+	})
+}
+
 // errorFile adds the .error feedback file (last failed write to this entity).
 func (m *dirManifest) errorFile(name string) {
 	m.children = append(m.children, staticChild{
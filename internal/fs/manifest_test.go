@@ -2,11 +2,13 @@ package fs
 
 import (
 	"reflect"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
 
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
 // TestDirManifestRoundTrip is the anti-drift guarantee for an entity directory's
@@ -25,10 +27,20 @@ func TestDirManifestRoundTrip(t *testing.T) {
 		attrNode:   attrNode{BaseNode: BaseNode{lfs: lfs}},
 		entityCell: entityCell[api.Issue]{val: api.Issue{ID: "i1", Identifier: "ENG-1", CreatedAt: created, UpdatedAt: updated}},
 	}
+	bigIssueDir := &IssueDirectoryNode{
+		attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}},
+		entityCell: entityCell[api.Issue]{val: api.Issue{ID: "i2", Identifier: "ENG-2", CreatedAt: created, UpdatedAt: updated,
+			Description: strings.Repeat("log line\n", marshal.BodyViewLimit/8)}},
+	}
 	projectDir := &ProjectNode{
 		attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}},
 		project:  api.Project{ID: "p1", CreatedAt: created, UpdatedAt: updated},
 	}
+	workspaceProjectDir := &ProjectNode{
+		attrNode:  attrNode{BaseNode: BaseNode{lfs: lfs}},
+		project:   api.Project{ID: "p1", CreatedAt: created, UpdatedAt: updated},
+		workspace: true,
+	}
 	initiativeDir := &InitiativeNode{
 		attrNode:   attrNode{BaseNode: BaseNode{lfs: lfs}},
 		entityCell: entityCell[api.Initiative]{val: api.Initiative{ID: "n1", CreatedAt: created, UpdatedAt: updated}},
@@ -42,18 +54,29 @@ func TestDirManifestRoundTrip(t *testing.T) {
 		{
 			name: "issue",
 			m:    issueDir.manifest(),
-			want: []string{"issue.md", "issue.meta", "history.md", ".error", ".last",
-				"comments", "docs", "children", "attachments", "relations"},
+			want: []string{"issue.md", "issue.meta", "history.md", "backlinks.md", "attachments.md", "issue.pdf", "issue.patch", ".error", ".last",
+				"comments", "drafts", "docs", "children", "attachments", "history", "relations", "relates", "blocks", "blocked-by"},
+		},
+		{
+			name: "issue with a cut description",
+			m:    bigIssueDir.manifest(),
+			want: []string{"issue.md", "issue.meta", "issue.full.md", "history.md", "backlinks.md", "attachments.md", "issue.pdf", "issue.patch", ".error", ".last",
+				"comments", "drafts", "docs", "children", "attachments", "history", "relations", "relates", "blocks", "blocked-by"},
 		},
 		{
 			name: "project",
 			m:    projectDir.manifest(),
-			want: []string{"project.md", "project.meta", ".error", "docs", "updates", "milestones", "links"},
+			want: []string{"project.md", "project.meta", "graph.dot", "graph.json", "timeline.csv", "timeline.json", ".error", "docs", "updates", "milestones", "links"},
+		},
+		{
+			name: "workspace project",
+			m:    workspaceProjectDir.manifest(),
+			want: []string{"project.md", "project.meta", "graph.dot", "graph.json", "timeline.csv", "timeline.json", ".error", "docs", "updates", "milestones", "links", "issues"},
 		},
 		{
 			name: "initiative",
 			m:    initiativeDir.manifest(),
-			want: []string{"initiative.md", "initiative.meta", ".error", "docs", "projects", "updates", "links"},
+			want: []string{"initiative.md", "initiative.meta", "rollup.md", ".error", "docs", "projects", "issues", "sub-initiatives", "updates", "links"},
 		},
 	}
 
@@ -111,7 +134,8 @@ func TestDirManifestSubdirsAreDirents(t *testing.T) {
 		attrNode:   attrNode{BaseNode: BaseNode{lfs: lfs}},
 		entityCell: entityCell[api.Issue]{val: api.Issue{ID: "i1", Identifier: "ENG-1"}},
 	}
-	dirs := map[string]bool{"comments": true, "docs": true, "children": true, "attachments": true, "relations": true}
+	dirs := map[string]bool{"comments": true, "drafts": true, "docs": true, "children": true, "attachments": true, "history": true, "relations": true,
+		"relates": true, "blocks": true, "blocked-by": true}
 	for _, e := range issueDir.manifest().entries() {
 		wantDir := dirs[e.Name]
 		isDir := e.Mode&syscall.S_IFDIR != 0
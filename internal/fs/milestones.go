@@ -12,10 +12,14 @@ import (
 	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
-// MilestonesNode represents a milestones/ directory within a project
+// MilestonesNode represents a milestones/ directory within a project: a file
+// per milestone, plus a directory per milestone of links to its issues.
+// workspace is set under the root projects/, which sits two levels shallower
+// than a team's projects/.
 type MilestonesNode struct {
 	attrNode
 	projectID string
+	workspace bool
 }
 
 var _ fs.NodeReaddirer = (*MilestonesNode)(nil)
@@ -24,7 +28,33 @@ var _ fs.NodeUnlinker = (*MilestonesNode)(nil)
 var _ fs.NodeGetattrer = (*MilestonesNode)(nil)
 
 func (n *MilestonesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
-	return n.collection().readdir(ctx)
+	c := n.collection()
+	ms, err := c.fetch(ctx)
+	if err != nil {
+		return fs.NewListDirStream(c.trio.entries()), 0
+	}
+	return fs.NewListDirStream(n.entries(c, ms)), 0
+}
+
+// entries is the collection listing followed by a directory per milestone.
+// Lookup resolves the collection first, so a directory name a file or trio
+// surface already holds (or an earlier milestone's) is left out rather than
+// listed as a dirent Lookup can't reach. Pure, for tests.
+func (n *MilestonesNode) entries(c collectionDir[api.ProjectMilestone], ms []api.ProjectMilestone) []fuse.DirEntry {
+	out := c.entries(ms)
+	listed := make(map[string]bool, len(out)+len(ms))
+	for _, e := range out {
+		listed[e.Name] = true
+	}
+	for _, m := range ms {
+		name := milestoneDirName(m)
+		if listed[name] {
+			continue
+		}
+		listed[name] = true
+		out = append(out, fuse.DirEntry{Name: name, Mode: syscall.S_IFDIR})
+	}
+	return out
 }
 
 // collection is the item-file surface (Readdir/Lookup/Unlink) for milestones/.
@@ -66,7 +96,28 @@ func (n *MilestonesNode) listing(ms []api.ProjectMilestone) namedListing[api.Pro
 }
 
 func (n *MilestonesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
-	return n.collection().lookup(ctx, name, out)
+	c := n.collection()
+	if inode, errno := c.lookup(ctx, name, out); errno != syscall.ENOENT {
+		return inode, errno
+	}
+
+	ms, err := c.fetch(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, m := range ms {
+		if milestoneDirName(m) == name {
+			node := &MilestoneIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, projectID: n.projectID, milestoneID: m.ID, workspace: n.workspace}
+			ino := milestoneIssuesDirIno(m.ID)
+			if n.workspace {
+				ino = workspaceMilestoneIssuesDirIno(m.ID)
+			}
+			// api.ProjectMilestone carries no timestamps: zero times (honest
+			// unknown), as for the other stateless containers.
+			return n.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), ino, 30*time.Second), 0
+		}
+	}
+	return nil, syscall.ENOENT
 }
 
 // buildMilestone mounts the read/write MilestoneFileNode for an existing
@@ -102,6 +153,69 @@ func milestoneFilename(m api.ProjectMilestone) string {
 	return safeName(m.Name, m.ID) + ".md"
 }
 
+// milestoneDirName returns the name of a milestone's issues directory: the
+// milestone file's name without the .md suffix.
+func milestoneDirName(m api.ProjectMilestone) string {
+	return safeName(m.Name, m.ID)
+}
+
+// MilestoneIssuesNode represents milestones/{name}/: a symlink per issue
+// assigned to the milestone, so `ls | wc -l` counts it.
+type MilestoneIssuesNode struct {
+	attrNode
+	projectID   string
+	milestoneID string
+	workspace   bool
+}
+
+var _ fs.NodeReaddirer = (*MilestoneIssuesNode)(nil)
+var _ fs.NodeLookuper = (*MilestoneIssuesNode)(nil)
+var _ fs.NodeGetattrer = (*MilestoneIssuesNode)(nil)
+
+func (n *MilestoneIssuesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.lfs.repo.GetIssuesByMilestone(ctx, n.projectID, n.milestoneID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, 0, len(issues))
+	for _, issue := range issues {
+		entries = append(entries, fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK})
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *MilestoneIssuesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.lfs.repo.GetIssuesByMilestone(ctx, n.projectID, n.milestoneID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			target, errno := milestoneIssueTarget(issue, n.workspace)
+			if errno != 0 {
+				return nil, errno
+			}
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
+
+// milestoneIssueTarget is the relative target for a link in
+// milestones/{name}/: six levels below the mount root under a team's
+// projects/, four under the root projects/. The tail is teamIssueTarget's
+// path-safe one.
+func milestoneIssueTarget(issue api.Issue, workspace bool) (string, syscall.Errno) {
+	target, errno := teamIssueTarget(issue)
+	if errno != 0 {
+		return "", errno
+	}
+	if workspace {
+		return "../../" + target, 0
+	}
+	return "../../../../" + target, 0
+}
+
 // MilestoneFileNode represents a single milestone file (read-write)
 type MilestoneFileNode struct {
 	BaseNode
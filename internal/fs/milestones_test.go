@@ -83,3 +83,45 @@ func TestMilestoneEditPreservesProjectAssociation(t *testing.T) {
 		t.Errorf("edited milestone %q not associated with project %q (clobbered to \"\")", n.milestone.ID, projectID)
 	}
 }
+
+// TestMilestonesEntriesListIssueDirs pins the milestones/ listing: after the
+// collection's files, a directory per milestone, leaving out any name Lookup
+// would resolve to a file or an earlier milestone first.
+func TestMilestonesEntriesListIssueDirs(t *testing.T) {
+	t.Parallel()
+	n := &MilestonesNode{projectID: "p-1"}
+	ms := []api.ProjectMilestone{
+		{ID: "ms-1", Name: "Beta"},
+		{ID: "ms-2", Name: "Beta.md"}, // its directory would shadow Beta's file
+		{ID: "ms-3", Name: "Beta"},    // same name as ms-1
+		{ID: "ms-4", Name: "GA"},
+	}
+	dirs := map[string]bool{}
+	for _, e := range n.entries(n.collection(), ms) {
+		if e.Mode == syscall.S_IFDIR {
+			if dirs[e.Name] {
+				t.Errorf("directory %q listed twice", e.Name)
+			}
+			dirs[e.Name] = true
+		}
+	}
+	if len(dirs) != 2 || !dirs["Beta"] || !dirs["GA"] {
+		t.Errorf("directories = %v, want Beta and GA", dirs)
+	}
+}
+
+// TestMilestoneIssueTarget pins the milestones/{name}/ link target at both
+// depths, with the shared unsynced-team ENOENT.
+func TestMilestoneIssueTarget(t *testing.T) {
+	t.Parallel()
+	issue := api.Issue{Identifier: "OPS-7", Team: &api.Team{Key: "OPS"}}
+	if target, errno := milestoneIssueTarget(issue, false); errno != 0 || target != "../../../../../../teams/OPS/issues/OPS-7" {
+		t.Errorf("team tree: target=%q errno=%v", target, errno)
+	}
+	if target, errno := milestoneIssueTarget(issue, true); errno != 0 || target != "../../../../teams/OPS/issues/OPS-7" {
+		t.Errorf("workspace tree: target=%q errno=%v", target, errno)
+	}
+	if _, errno := milestoneIssueTarget(api.Issue{Identifier: "OPS-8"}, false); errno != syscall.ENOENT {
+		t.Errorf("teamless issue: errno = %v, want ENOENT", errno)
+	}
+}
@@ -0,0 +1,131 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+// mount.root mounts one subtree of the full layout at the mountpoint — a
+// team, or one of its projects — for a purpose-specific mount, say one per
+// repo checkout, that leaves out the rest of the workspace. The subtree's
+// own node becomes the FUSE root, so everything below it (listings, writes,
+// .error sidecars) works as it does under teams/. What lives above it does not
+// exist in such a mount: the root README.md, .linearfs/, .events, and the
+// other top-level directories. Symlinks whose target climbs out of the
+// subtree dangle: into another team's issues/, say, or a project mount's
+// issue links into its team's.
+//
+// The subtree is resolved once per mount (and again on a watchdog remount),
+// so a team or project renamed since keeps serving under the mountpoint.
+// Paths the mount prints (issues/.last-created) are built by teamPath, so
+// they name the file where this mount shows it.
+
+// mountTeams is the team filter sync and teams/ apply: teams:, or with it
+// unset, mount.root's team alone — a subtree mount shows nothing of the
+// others, so syncing them would only spend API budget.
+func mountTeams(cfg *config.Config) []string {
+	if len(cfg.Teams) == 0 {
+		if key := config.MountRootTeam(cfg.Mount.Root); key != "" {
+			return []string{key}
+		}
+	}
+	return cfg.Teams
+}
+
+// teamPath is the absolute path of teams/{teamKey}/elem... in this mount:
+// under a mount.root of that team, the team directory is the mountpoint.
+func (lfs *LinearFS) teamPath(teamKey string, elem ...string) string {
+	base := []string{lfs.MountPoint(), "teams", teamKey}
+	root := strings.Trim(lfs.mountRoot, "/")
+	if config.MountRootTeam(root) == teamKey && strings.Count(root, "/") == 1 {
+		base = []string{lfs.MountPoint()}
+	}
+	return filepath.Join(append(base, elem...)...)
+}
+
+// rootNode resolves the node MountFS mounts and, for a subtree, the stable
+// attr that keeps its inode number the one it has in the full layout (nil
+// for the workspace root).
+func (lfs *LinearFS) rootNode(ctx context.Context) (fs.InodeEmbedder, *fs.StableAttr, error) {
+	if lfs.mountRoot == "" {
+		return &RootNode{BaseNode: BaseNode{lfs: lfs}}, nil, nil
+	}
+	// config.ValidateMountRoot admitted only teams/KEY[/projects/NAME].
+	parts := strings.Split(strings.Trim(lfs.mountRoot, "/"), "/")
+	team, err := lfs.mountRootTeam(ctx, parts[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("mount.root %s: %w", lfs.mountRoot, err)
+	}
+	if len(parts) == 2 {
+		node := &TeamNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, entityCell: entityCell[api.Team]{val: team}}
+		node.setAttr(dirAttr(team.CreatedAt, team.UpdatedAt))
+		return node, &fs.StableAttr{Ino: teamDirIno(team.ID)}, nil
+	}
+	project, err := lfs.mountRootProject(ctx, team, parts[3])
+	if err != nil {
+		return nil, nil, fmt.Errorf("mount.root %s: %w", lfs.mountRoot, err)
+	}
+	node := &ProjectNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, team: team, project: project}
+	node.setAttr(dirAttr(project.CreatedAt, project.UpdatedAt))
+	return node, &fs.StableAttr{Ino: projectDirIno(project.ID)}, nil
+}
+
+// mountRootTeam finds mount.root's team by the name teams/ lists it under.
+// A cache that has no teams yet (the first mount, before sync) is answered
+// from Linear; one that has them is authoritative, so a team left out by
+// teams: or exclude_teams: is not found.
+func (lfs *LinearFS) mountRootTeam(ctx context.Context, name string) (api.Team, error) {
+	var teams []api.Team
+	if lfs.repo != nil {
+		cached, err := lfs.repo.GetTeams(ctx)
+		if err != nil {
+			return api.Team{}, err
+		}
+		teams = cached
+	}
+	if len(teams) == 0 && lfs.client != nil {
+		live, err := lfs.client.GetTeams(ctx)
+		if err != nil {
+			return api.Team{}, err
+		}
+		teams = live
+	}
+	for _, team := range teams {
+		if lfs.iconDirNameMatches(name, team.Icon, team.Key) {
+			return team, nil
+		}
+	}
+	return api.Team{}, fmt.Errorf("no team %q", name)
+}
+
+// mountRootProject finds mount.root's project by the name the team's
+// projects/ lists it under, from Linear when the cache has none for the team.
+func (lfs *LinearFS) mountRootProject(ctx context.Context, team api.Team, name string) (api.Project, error) {
+	var projects []api.Project
+	if lfs.repo != nil {
+		cached, err := lfs.repo.GetTeamProjects(ctx, team.ID)
+		if err != nil {
+			return api.Project{}, err
+		}
+		projects = cached
+	}
+	if len(projects) == 0 && lfs.client != nil {
+		live, err := lfs.client.GetTeamProjects(ctx, team.ID)
+		if err != nil {
+			return api.Project{}, err
+		}
+		projects = live
+	}
+	for _, project := range projects {
+		if lfs.iconDirNameMatches(name, project.Icon, projectDirName(project)) {
+			return project, nil
+		}
+	}
+	return api.Project{}, fmt.Errorf("no project %q in team %s", name, team.Key)
+}
@@ -0,0 +1,93 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// TestRootNode: mount.root mounts the named team or project directory at the
+// mountpoint, under the inode number it has in the full layout, and a name
+// the cache does not know fails the mount.
+func TestRootNode(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	q := store.Queries()
+	if err := q.UpsertTeam(ctx, db.APITeamToDBTeam(api.Team{ID: "team-1", Key: "TST", Name: "Test"})); err != nil {
+		t.Fatalf("UpsertTeam: %v", err)
+	}
+	params, err := db.APIProjectToDBProject(api.Project{ID: "project-1", Name: "My Project", Slug: "my-project-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.UpsertProject(ctx, params); err != nil {
+		t.Fatalf("UpsertProject: %v", err)
+	}
+	if err := q.UpsertProjectTeam(ctx, db.UpsertProjectTeamParams{ProjectID: "project-1", TeamID: "team-1", SyncedAt: time.Now()}); err != nil {
+		t.Fatalf("UpsertProjectTeam: %v", err)
+	}
+
+	root, attr, err := lfs.rootNode(ctx)
+	if _, ok := root.(*RootNode); !ok || attr != nil || err != nil {
+		t.Errorf("rootNode() with no mount.root = %T, %v, %v; want the workspace root", root, attr, err)
+	}
+
+	lfs.mountRoot = "teams/TST"
+	root, attr, err = lfs.rootNode(ctx)
+	if err != nil {
+		t.Fatalf("rootNode(%s): %v", lfs.mountRoot, err)
+	}
+	if team, ok := root.(*TeamNode); !ok || team.entity().ID != "team-1" {
+		t.Errorf("rootNode(%s) = %T, want team-1's TeamNode", lfs.mountRoot, root)
+	}
+	if attr == nil || attr.Ino != teamDirIno("team-1") {
+		t.Errorf("rootNode(%s) attr = %+v, want teamDirIno", lfs.mountRoot, attr)
+	}
+
+	lfs.mountRoot = "/teams/TST/projects/my-project/"
+	root, attr, err = lfs.rootNode(ctx)
+	if err != nil {
+		t.Fatalf("rootNode(%s): %v", lfs.mountRoot, err)
+	}
+	if project, ok := root.(*ProjectNode); !ok || project.project.ID != "project-1" || project.team.ID != "team-1" {
+		t.Errorf("rootNode(%s) = %T, want project-1's ProjectNode", lfs.mountRoot, root)
+	}
+	if attr == nil || attr.Ino != projectDirIno("project-1") {
+		t.Errorf("rootNode(%s) attr = %+v, want projectDirIno", lfs.mountRoot, attr)
+	}
+
+	for _, missing := range []string{"teams/OPS", "teams/TST/projects/other"} {
+		lfs.mountRoot = missing
+		if _, _, err := lfs.rootNode(ctx); err == nil || !strings.Contains(err.Error(), missing) {
+			t.Errorf("rootNode(%s) err = %v, want one naming mount.root", missing, err)
+		}
+	}
+}
+
+// TestMountTeams: mount.root limits sync to its team unless teams: says
+// otherwise.
+func TestMountTeams(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		root  string
+		teams []string
+		want  []string
+	}{
+		{"", nil, nil},
+		{"teams/ENG", nil, []string{"ENG"}},
+		{"teams/ENG/projects/p", nil, []string{"ENG"}},
+		{"teams/ENG", []string{"ENG", "OPS"}, []string{"ENG", "OPS"}},
+	} {
+		cfg := &config.Config{Teams: tc.teams, Mount: config.MountConfig{Root: tc.root}}
+		if got := mountTeams(cfg); !slices.Equal(got, tc.want) {
+			t.Errorf("mountTeams(root %q, teams %v) = %v, want %v", tc.root, tc.teams, got, tc.want)
+		}
+	}
+}
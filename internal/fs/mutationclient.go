@@ -21,6 +21,7 @@ type MutationClient interface {
 	CreateIssue(ctx context.Context, input map[string]any) (*api.Issue, error)
 	UpdateIssue(ctx context.Context, issueID string, input map[string]any) error
 	ArchiveIssue(ctx context.Context, issueID string) error
+	UnarchiveIssue(ctx context.Context, issueID string) error
 
 	// Comments
 	CreateComment(ctx context.Context, issueID string, body string) (*api.Comment, error)
@@ -63,6 +64,7 @@ type MutationClient interface {
 	// Attachments
 	LinkURL(ctx context.Context, issueID, url, title string) (*api.Attachment, error)
 	DeleteAttachment(ctx context.Context, attachmentID string) error
+	UploadFile(ctx context.Context, filename, contentType string, content []byte) (string, error)
 
 	// Entity external links (project/initiative "Links / Resources")
 	CreateEntityExternalLink(ctx context.Context, input map[string]any) (*api.EntityExternalLink, error)
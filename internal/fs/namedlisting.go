@@ -7,8 +7,9 @@ import (
 )
 
 // namedListing owns the entity-derived filenames of a collection whose entries
-// are named directly from an entity field — documents (slug/title), labels
-// (name), and project milestones (name). It is the un-indexed sibling of
+// are named directly from entity fields — documents (slug/title), labels
+// (name), project milestones (name), and comments (creation time + short ID).
+// It is the un-indexed sibling of
 // indexedListing: where that module derives a name from an item's *position* in
 // a sorted order, this one derives it from the item's *identity*, so there is no
 // sort and no index. Deriving the name in one place is what lets a collection's
@@ -18,8 +19,9 @@ import (
 // nodes), so a change to one could silently strand a file.
 //
 // Ordering is the repo's job, not this module's: the SQLite list queries carry
-// the ORDER BY (labels by name, documents by title, milestones by sort_order — a
-// meaningful manual order that a filename sort would clobber), so namedListing
+// the ORDER BY (labels by name, documents by title, comments by creation,
+// milestones by sort_order — a meaningful manual order that a filename sort
+// would clobber), so namedListing
 // preserves the items slice as given and never sorts.
 //
 // Collisions are first-match, emit-once. Two entities can derive the same
@@ -146,6 +146,9 @@ func TestDirNodeLookupGetattrAgree(t *testing.T) {
 		"by-category":      &FilterCategoryNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}},
 		"by-value":         &FilterValueNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}},
 		"my-issues":        &MyIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}},
+		"docs-root":        &WorkspaceDocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}},
+		"docs-search":      &DocSearchNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}},
+		"docs-search-hits": &DocSearchResultsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}},
 	}
 
 	for name, node := range nodes {
@@ -0,0 +1,222 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// Offline writes.
+//
+// An issue.md save or a new comment that cannot reach Linear — the request
+// never left (api.IsUnreachable: the circuit breaker is open, or the
+// connection could not be made) — is not a failed write. It joins the SQLite
+// mutation queue and is applied to the local cache at once, so the mount reads
+// back the edit, and the sync worker replays it through ReplayMutation once
+// Linear answers again. A write to an issue that still has queued writes
+// queues too, connected or not, so one issue's writes reach Linear in the order
+// they were made. /.linearfs/pending/ lists the queue.
+//
+// The local copy keeps the issue's updatedAt: bumping it would move the team's
+// incremental-sync cursor past changes the worker has not fetched yet.
+
+// pendingCommentID is the local ID of a queued comment, until its replay swaps
+// in the comment Linear creates.
+func pendingCommentID(queueID int64) string {
+	return "pending-" + strconv.FormatInt(queueID, 10)
+}
+
+// mustQueue reports whether a write to issueID has to wait behind the issue's
+// queued writes rather than go to Linear now.
+func (lfs *LinearFS) mustQueue(ctx context.Context, issueID string) bool {
+	if lfs.store == nil {
+		return false
+	}
+	n, err := lfs.store.Queries().CountEntityQueuedMutations(ctx, issueID)
+	return err == nil && n > 0
+}
+
+// queueIssueUpdate queues a resolved issue update and applies it to the cached
+// issue, returning the local result.
+func (lfs *LinearFS) queueIssueUpdate(ctx context.Context, issue *api.Issue, updates map[string]any) (*api.Issue, error) {
+	if lfs.store == nil {
+		return nil, fmt.Errorf("SQLite not enabled")
+	}
+	if _, err := lfs.store.QueueMutation(ctx, db.MutationIssueUpdate, issue.ID, issue.Identifier,
+		db.IssueUpdatePayload{IssueID: issue.ID, Input: updates}); err != nil {
+		return nil, err
+	}
+	local := lfs.applyIssueUpdate(ctx, *issue, updates)
+	if err := lfs.UpsertIssue(ctx, local); err != nil {
+		// Queued all the same: the replay's re-fetch brings the row up to date.
+		log.Printf("Failed to cache queued update of %s: %v", issue.Identifier, err)
+	}
+	return &local, nil
+}
+
+// applyIssueUpdate is issue with a resolved update applied, as far as the
+// cache can tell: the scalars, and the status, assignee, and labels its IDs
+// name. A parent, project, milestone, or cycle change shows once replayed.
+func (lfs *LinearFS) applyIssueUpdate(ctx context.Context, issue api.Issue, updates map[string]any) api.Issue {
+	teamID := ""
+	if issue.Team != nil {
+		teamID = issue.Team.ID
+	}
+	for key, v := range updates {
+		switch key {
+		case "title":
+			issue.Title, _ = v.(string)
+		case "description":
+			issue.Description, _ = v.(string)
+		case "priority":
+			if p, ok := v.(int); ok {
+				issue.Priority = p
+			}
+		case "estimate":
+			issue.Estimate = nil
+			if e, ok := v.(int); ok {
+				f := float64(e)
+				issue.Estimate = &f
+			}
+		case "dueDate":
+			issue.DueDate = nil
+			if d, ok := v.(string); ok {
+				issue.DueDate = &d
+			}
+		case "stateId":
+			states, _ := lfs.repo.GetTeamStates(ctx, teamID)
+			if i := slices.IndexFunc(states, func(s api.State) bool { return s.ID == v }); i >= 0 {
+				issue.State = states[i]
+			}
+		case "assigneeId":
+			issue.Assignee = nil
+			if v != nil {
+				users, _ := lfs.repo.GetUsers(ctx)
+				if i := slices.IndexFunc(users, func(u api.User) bool { return u.ID == v }); i >= 0 {
+					issue.Assignee = &users[i]
+				}
+			}
+		case "labelIds":
+			ids, _ := v.([]string)
+			labels, _ := lfs.repo.GetTeamLabels(ctx, teamID)
+			issue.Labels.Nodes = slices.DeleteFunc(labels, func(l api.Label) bool { return !slices.Contains(ids, l.ID) })
+		case "removedLabelIds":
+			issue.Labels.Nodes = nil
+		}
+	}
+	return issue
+}
+
+// queueComment queues a new comment and caches it under a pending ID, which
+// the create tail then reports like any other comment.
+func (lfs *LinearFS) queueComment(ctx context.Context, issueID, body string) (*api.Comment, error) {
+	if lfs.store == nil {
+		return nil, fmt.Errorf("SQLite not enabled")
+	}
+	label := issueID
+	if issue, err := lfs.repo.GetIssueByID(ctx, issueID); err == nil && issue != nil {
+		label = issue.Identifier
+	}
+	id, err := lfs.store.QueueMutation(ctx, db.MutationCommentCreate, issueID, label,
+		db.CommentCreatePayload{IssueID: issueID, Body: body})
+	if err != nil {
+		return nil, err
+	}
+	now := db.Now()
+	comment := &api.Comment{ID: pendingCommentID(id), Body: body, CreatedAt: now, UpdatedAt: now}
+	if user, _ := lfs.repo.GetCurrentUser(ctx); user != nil {
+		comment.User = user
+	}
+	return comment, nil
+}
+
+// ReplayMutation sends one queued write to Linear and brings the cache up to
+// date with the result: the issue re-fetched, or the pending comment swapped
+// for the one Linear created. The sync worker deletes the queue row on
+// success. Implements sync.MutationReplayer.
+func (lfs *LinearFS) ReplayMutation(ctx context.Context, m db.MutationQueue) error {
+	switch m.Kind {
+	case db.MutationIssueUpdate:
+		var p db.IssueUpdatePayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode queued update: %w", err)
+		}
+		if err := lfs.mutator().UpdateIssue(ctx, p.IssueID, p.Input); err != nil {
+			return err
+		}
+		lfs.refreshReplayedIssue(ctx, p.IssueID)
+		return nil
+	case db.MutationCommentCreate:
+		var p db.CommentCreatePayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode queued comment: %w", err)
+		}
+		comment, err := lfs.mutator().CreateComment(ctx, p.IssueID, p.Body)
+		if err != nil {
+			return err
+		}
+		lfs.dropPendingComment(ctx, p.IssueID, pendingCommentID(m.ID))
+		if err := lfs.UpsertComment(ctx, p.IssueID, *comment); err != nil {
+			log.Printf("Failed to cache replayed comment on %s: %v", m.Label, err)
+		}
+		lfs.InvalidateCreated(commentsDirIno(p.IssueID), commentFilename(*comment))
+		return nil
+	default:
+		return fmt.Errorf("unknown queued mutation kind %q", m.Kind)
+	}
+}
+
+// DropMutation gives up on a queued write Linear keeps rejecting: its local
+// effect is undone (the issue re-fetched, the pending comment removed) and the
+// rejection lands in the issue's .error. Implements sync.MutationReplayer.
+func (lfs *LinearFS) DropMutation(ctx context.Context, m db.MutationQueue, cause error) {
+	switch m.Kind {
+	case db.MutationIssueUpdate:
+		lfs.refreshReplayedIssue(ctx, m.EntityID)
+	case db.MutationCommentCreate:
+		lfs.dropPendingComment(ctx, m.EntityID, pendingCommentID(m.ID))
+	}
+	msg, _ := classifyMutationErr("replay queued "+m.Kind+" on "+m.Label, cause)
+	lfs.SetIssueError(m.EntityID, msg)
+}
+
+// refreshReplayedIssue re-caches an issue from Linear after a replay. A failed
+// fetch is left to the next sync: the write itself bumped the issue's
+// updatedAt past the cursor.
+func (lfs *LinearFS) refreshReplayedIssue(ctx context.Context, issueID string) {
+	fresh, err := lfs.verify().GetIssue(ctx, issueID)
+	if err != nil {
+		log.Printf("Failed to re-fetch %s after replay: %v", issueID, err)
+		return
+	}
+	before, _ := lfs.repo.GetIssueByID(ctx, issueID)
+	if err := lfs.UpsertIssue(ctx, *fresh); err != nil {
+		log.Printf("Failed to cache %s after replay: %v", fresh.Identifier, err)
+		return
+	}
+	invalidateIssueMoved(lfs, lfs.issueDirs, before, fresh)
+	lfs.InvalidateUpdated(issueIno(issueID))
+	lfs.InvalidateUpdated(metaIno(issueID))
+}
+
+// dropPendingComment removes a queued comment's local stand-in.
+func (lfs *LinearFS) dropPendingComment(ctx context.Context, issueID, commentID string) {
+	q := lfs.store.Queries()
+	comments, _ := q.ListIssueComments(ctx, issueID)
+	for _, c := range comments {
+		if c.ID != commentID {
+			continue
+		}
+		if err := q.DeleteComment(ctx, c.ID); err != nil {
+			log.Printf("Failed to remove pending comment %s: %v", c.ID, err)
+			return
+		}
+		lfs.InvalidateDeleted(commentsDirIno(issueID), commentFilename(api.Comment{ID: c.ID, CreatedAt: c.CreatedAt}))
+	}
+}
@@ -0,0 +1,168 @@
+package fs
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/marshal"
+	"github.com/jra3/linear-fuse/internal/testutil/mockmutation"
+)
+
+// offlineMutator is the store-backed mock with its issue updates and comment
+// creates failing as unreachable while down is set.
+type offlineMutator struct {
+	*mockmutation.Client
+	down bool
+}
+
+func (m *offlineMutator) UpdateIssue(ctx context.Context, issueID string, input map[string]any) error {
+	if m.down {
+		return api.ErrUnreachable
+	}
+	return m.Client.UpdateIssue(ctx, issueID, input)
+}
+
+func (m *offlineMutator) CreateComment(ctx context.Context, issueID, body string) (*api.Comment, error) {
+	if m.down {
+		return nil, api.ErrUnreachable
+	}
+	return m.Client.CreateComment(ctx, issueID, body)
+}
+
+// TestOfflineWritesQueueAndReplay: with Linear unreachable, an issue.md save
+// (title and status) and a new comment succeed locally — cached at once and
+// listed under pending/ — and replaying the queue once Linear is back sends
+// them and swaps the pending comment for the real one.
+func TestOfflineWritesQueueAndReplay(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	q := store.Queries()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, st := range []db.UpsertStateParams{
+		{ID: "st-todo", TeamID: "team-1", Name: "Todo", Type: "unstarted", SyncedAt: now, Data: []byte(`{"id":"st-todo","name":"Todo","type":"unstarted"}`)},
+		{ID: "st-done", TeamID: "team-1", Name: "Done", Type: "completed", SyncedAt: now, Data: []byte(`{"id":"st-done","name":"Done","type":"completed"}`)},
+	} {
+		if err := q.UpsertState(ctx, st); err != nil {
+			t.Fatalf("UpsertState: %v", err)
+		}
+	}
+	issue := api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Before", State: api.State{ID: "st-todo", Name: "Todo", Type: "unstarted"},
+		Team: &api.Team{ID: "team-1", Key: "ENG"}, CreatedAt: now, UpdatedAt: now,
+	}
+	if err := lfs.UpsertIssue(ctx, issue); err != nil {
+		t.Fatalf("UpsertIssue: %v", err)
+	}
+	mutator := &offlineMutator{Client: mockmutation.New(mockmutation.WithStore(store)), down: true}
+	lfs.InjectTestMutationClient(mutator)
+
+	content, err := marshal.IssueToMarkdown(&issue)
+	if err != nil {
+		t.Fatalf("IssueToMarkdown: %v", err)
+	}
+	edited := strings.Replace(strings.Replace(string(content), "title: Before", "title: After", 1), "status: Todo", "status: Done", 1)
+	node := &IssueFileNode{BaseNode: BaseNode{lfs: lfs}, issue: issue, editBuffer: editBuffer{content: content}}
+	fh, _, errno := node.Open(ctx, syscall.O_WRONLY|syscall.O_TRUNC)
+	if errno != 0 {
+		t.Fatalf("Open = %v", errno)
+	}
+	if _, errno := node.Write(ctx, fh, []byte(edited), 0); errno != 0 {
+		t.Fatalf("Write = %v", errno)
+	}
+	if errno := node.Flush(ctx, fh); errno != 0 {
+		t.Fatalf("offline Flush = %v, want the save queued", errno)
+	}
+	cached, err := lfs.repo.GetIssueByID(ctx, issue.ID)
+	if err != nil || cached.Title != "After" || cached.State.Name != "Done" {
+		t.Fatalf("cached issue = %+v (%v), want the queued edit applied", cached, err)
+	}
+	if errno := lfs.postComment(ctx, issue.ID, "Written on a plane.", collectionErrorKey("comments", issue.ID)); errno != 0 {
+		t.Fatalf("offline postComment = %v, want the comment queued", errno)
+	}
+
+	pending := &PendingDirNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}}
+	names := readdirNames(t, pending)
+	if len(names) != 2 || names[0] != "000001-issue-update.json" || names[1] != "000002-comment-create.json" {
+		t.Fatalf("pending/ = %v, want the update then the comment", names)
+	}
+	comments, _ := q.ListIssueComments(ctx, issue.ID)
+	if len(comments) != 1 || comments[0].ID != pendingCommentID(2) {
+		t.Fatalf("comments = %+v, want the pending stand-in", comments)
+	}
+
+	// Back online: replay in order, as the sync worker does.
+	mutator.down = false
+	queued, _ := q.ListQueuedMutations(ctx)
+	for _, m := range queued {
+		if err := lfs.ReplayMutation(ctx, m); err != nil {
+			t.Fatalf("ReplayMutation(%s): %v", m.Kind, err)
+		}
+	}
+	cached, _ = lfs.repo.GetIssueByID(ctx, issue.ID)
+	if cached.Title != "After" || cached.State.ID != "st-done" {
+		t.Errorf("replayed issue = %+v, want the edit from Linear", cached)
+	}
+	comments, _ = q.ListIssueComments(ctx, issue.ID)
+	if len(comments) != 1 || comments[0].ID == pendingCommentID(2) || comments[0].Body != "Written on a plane." {
+		t.Errorf("comments = %+v, want the real comment in place of the stand-in", comments)
+	}
+}
+
+// TestOfflineSaveWaitsBehindQueue: while an issue has queued writes, a save
+// queues too, even with Linear reachable, so the writes replay in order.
+func TestOfflineSaveWaitsBehindQueue(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+
+	if _, err := store.QueueMutation(ctx, db.MutationIssueUpdate, "issue-1", "ENG-1",
+		db.IssueUpdatePayload{IssueID: "issue-1", Input: map[string]any{"title": "First"}}); err != nil {
+		t.Fatalf("QueueMutation: %v", err)
+	}
+	if !lfs.mustQueue(ctx, "issue-1") || lfs.mustQueue(ctx, "issue-2") {
+		t.Fatal("mustQueue should hold exactly the issue with queued writes")
+	}
+	if errno := lfs.postComment(ctx, "issue-1", "second", collectionErrorKey("comments", "issue-1")); errno != 0 {
+		t.Fatalf("postComment = %v", errno)
+	}
+	queued, _ := store.Queries().ListQueuedMutations(ctx)
+	if len(queued) != 2 || queued[1].Kind != db.MutationCommentCreate {
+		t.Fatalf("queue = %+v, want the comment behind the update", queued)
+	}
+}
+
+func TestPendingJSON(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+	m := db.MutationQueue{
+		ID: 7, Kind: db.MutationCommentCreate, Label: "ENG-42", QueuedAt: at, Attempts: 1,
+		LastError: sql.NullString{String: "Entity not found", Valid: true},
+		Payload:   []byte(`{"issueId":"issue-42","body":"hi"}`),
+	}
+	if got := pendingFilename(m); got != "000007-comment-create.json" {
+		t.Errorf("pendingFilename = %q", got)
+	}
+	want := `{
+  "kind": "comment-create",
+  "issue": "ENG-42",
+  "queuedAt": "2026-10-17T09:30:00Z",
+  "attempts": 1,
+  "lastError": "Entity not found",
+  "payload": {
+    "issueId": "issue-42",
+    "body": "hi"
+  }
+}
+`
+	if got := string(pendingJSON(m)); got != want {
+		t.Errorf("pendingJSON =\n%s\nwant\n%s", got, want)
+	}
+}
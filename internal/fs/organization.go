@@ -0,0 +1,64 @@
+package fs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// organizationMarkdown renders /organization.md: which workspace this mount is
+// talking to (name, URL key) and how it authenticates, so a user juggling
+// several companies can tell mounts apart at a glance. org is nil until the
+// first fetch lands; SAML/SCIM are "unknown" when the key is not an admin's.
+func organizationMarkdown(org *api.Organization) []byte {
+	if org == nil {
+		return []byte("# Organization\n\n*Not yet fetched — read again shortly.*\n")
+	}
+	url := "https://linear.app/" + org.URLKey
+	authMethods := org.AllowedAuthServices
+	if authMethods == nil {
+		authMethods = []string{}
+	}
+	fm := map[string]any{
+		"id":          org.ID,
+		"name":        org.Name,
+		"urlKey":      org.URLKey,
+		"url":         url,
+		"authMethods": authMethods,
+		"saml":        adminFlag(org.SAMLEnabled),
+		"scim":        adminFlag(org.SCIMEnabled),
+		"users":       org.UserCount,
+		"created":     marshal.FormatTimestamp(org.CreatedAt),
+		"updated":     marshal.FormatTimestamp(org.UpdatedAt),
+	}
+	methods := "any"
+	if len(authMethods) > 0 {
+		methods = strings.Join(authMethods, ", ")
+	}
+	body := fmt.Sprintf(`
+# %s
+
+- **URL:** %s
+- **URL key:** %s
+- **Auth methods:** %s
+- **SAML SSO:** %s
+- **SCIM:** %s
+- **Users:** %d
+`, org.Name, url, org.URLKey, methods, adminFlag(org.SAMLEnabled), adminFlag(org.SCIMEnabled), org.UserCount)
+	return renderWithFrontmatter(fm, body)
+}
+
+// adminFlag renders an admin-only boolean: enabled/disabled, or "unknown"
+// when the API key could not read it.
+func adminFlag(v *bool) string {
+	switch {
+	case v == nil:
+		return "unknown (requires admin token)"
+	case *v:
+		return "enabled"
+	default:
+		return "disabled"
+	}
+}
@@ -0,0 +1,84 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+const pendingDirName = "pending"
+
+// PendingDirNode is /.linearfs/pending/: the writes queued while Linear was
+// unreachable (offline.go), oldest first, one read-only {id}-{kind}.json each.
+// A file leaves once the sync worker has replayed its write. Empty when the
+// mount is caught up.
+type PendingDirNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*PendingDirNode)(nil)
+var _ fs.NodeLookuper = (*PendingDirNode)(nil)
+var _ fs.NodeGetattrer = (*PendingDirNode)(nil)
+
+func (n *PendingDirNode) queued(ctx context.Context) []db.MutationQueue {
+	if n.lfs.store == nil {
+		return nil
+	}
+	queued, err := n.lfs.store.Queries().ListQueuedMutations(ctx)
+	if err != nil {
+		log.Printf("Failed to list queued mutations: %v", err)
+	}
+	return queued
+}
+
+func (n *PendingDirNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	queued := n.queued(ctx)
+	entries := make([]fuse.DirEntry, len(queued))
+	for i, m := range queued {
+		entries[i] = fuse.DirEntry{Name: pendingFilename(m), Mode: syscall.S_IFREG}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *PendingDirNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	for _, m := range n.queued(ctx) {
+		if pendingFilename(m) == name {
+			return n.lookupRenderFile(ctx, out, name, func(context.Context) ([]byte, time.Time, time.Time) {
+				return pendingJSON(m), m.QueuedAt, m.QueuedAt
+			}, pendingMutationIno(name), inheritTimeout), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
+
+// pendingFilename is a queued write's name: its queue ID, zero-padded so the
+// listing sorts in replay order, and its kind.
+func pendingFilename(m db.MutationQueue) string {
+	return fmt.Sprintf("%06d-%s.json", m.ID, m.Kind)
+}
+
+// pendingJSON renders a queued write: what it is, which issue it belongs to,
+// when it was queued, its payload, and — once Linear has rejected a replay —
+// the attempts so far and the last rejection.
+func pendingJSON(m db.MutationQueue) []byte {
+	entry := struct {
+		Kind      string          `json:"kind"`
+		Issue     string          `json:"issue"`
+		QueuedAt  time.Time       `json:"queuedAt"`
+		Attempts  int64           `json:"attempts,omitempty"`
+		LastError string          `json:"lastError,omitempty"`
+		Payload   json.RawMessage `json:"payload"`
+	}{m.Kind, m.Label, m.QueuedAt.UTC(), m.Attempts, m.LastError.String, m.Payload}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return nil
+	}
+	return append(data, '\n')
+}
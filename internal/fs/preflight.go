@@ -14,7 +14,9 @@ package fs
 //     a live mount (that would kill a concurrent instance)
 //
 // The unmount is `fusermount3 -uz` by construction, never syscall umount2 —
-// unprivileged umount2 is EPERM on FUSE (recorded lesson).
+// unprivileged umount2 is EPERM on FUSE (recorded lesson). On macOS, where
+// neither fusermount3 nor /proc exists, it is `diskutil unmount force` and the
+// mount table comes from mount(8).
 
 import (
 	"bufio"
@@ -24,6 +26,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
@@ -44,18 +47,39 @@ func newMountPreflight() *mountPreflight {
 			var st syscall.Statfs_t
 			return syscall.Statfs(path, &st)
 		},
-		isMounted: procMounted,
-		unmount: func(path string) error {
-			out, err := exec.Command("fusermount3", "-uz", path).CombinedOutput()
-			if err != nil {
-				return fmt.Errorf("fusermount3 -uz %s: %v (%s)", path, err, strings.TrimSpace(string(out)))
-			}
-			return nil
-		},
-		logf: log.Printf,
+		isMounted: mountTableHas,
+		unmount:   ForceUnmount,
+		logf:      log.Printf,
 	}
 }
 
+// lazyUnmountArgv is the command that detaches a FUSE mount even when it is
+// dead or busy: processes still inside keep what they hold, nothing new can
+// enter, and the mountpoint is free at once.
+func lazyUnmountArgv(goos, path string) []string {
+	if goos == "darwin" {
+		return []string{"diskutil", "unmount", "force", path}
+	}
+	return []string{"fusermount3", "-uz", path}
+}
+
+// ForceUnmount lazily detaches the FUSE mount at path (see lazyUnmountArgv).
+// The preflight uses it on a dead mount; mount's shutdown uses it on one a
+// clean unmount could not release.
+func ForceUnmount(path string) error {
+	argv := lazyUnmountArgv(runtime.GOOS, path)
+	out, err := exec.Command(argv[0], argv[1:]...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %v (%s)", strings.Join(argv, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// UnmountHint is the manual lazy-unmount command for path, for error messages.
+func UnmountHint(path string) string {
+	return strings.Join(lazyUnmountArgv(runtime.GOOS, path), " ")
+}
+
 // PreflightMountpoint probes path before mounting: heals a dead FUSE mount
 // left by a crashed/killed instance (lazy unmount + verify), refuses a
 // healthy live mount, and is a no-op for a plain directory or missing path.
@@ -95,21 +119,54 @@ func (p *mountPreflight) run(path string) error {
 		}
 	}
 
-	p.logf("linearfs: dead mount at %s (%v); detaching with fusermount3 -uz", path, err)
+	p.logf("linearfs: dead mount at %s (%v); detaching with a lazy unmount", path, err)
 	if uerr := p.unmount(path); uerr != nil {
-		return fmt.Errorf("dead mount at %s and lazy unmount failed: %w (clean manually: fusermount3 -uz %s)", path, uerr, path)
+		return fmt.Errorf("dead mount at %s and lazy unmount failed: %w (clean manually: %s)", path, uerr, UnmountHint(path))
 	}
 
 	// Verify the detach took before letting the mount proceed.
 	if verr := p.statfs(path); verr != nil && !errors.Is(verr, syscall.ENOENT) {
-		return fmt.Errorf("mount at %s still wedged after fusermount3 -uz (statfs: %v); clean manually and retry", path, verr)
+		return fmt.Errorf("mount at %s still wedged after lazy unmount (statfs: %v); clean manually and retry", path, verr)
 	}
 	if mounted, merr := p.isMounted(path); merr == nil && mounted {
-		return fmt.Errorf("mount at %s still present after fusermount3 -uz; clean manually and retry", path)
+		return fmt.Errorf("mount at %s still present after lazy unmount; clean manually and retry", path)
 	}
 	return nil
 }
 
+// mountTableHas reports whether path is a mount point: from /proc/self/mounts
+// on Linux, from mount(8)'s listing on macOS.
+func mountTableHas(path string) (bool, error) {
+	if runtime.GOOS == "darwin" {
+		out, err := exec.Command("mount").Output()
+		if err != nil {
+			return false, err
+		}
+		return mountListingHas(string(out), path), nil
+	}
+	return procMounted(path)
+}
+
+// mountListingHas scans mount(8) output ("<source> on <path> (<options>)")
+// for path. macOS prints mount points unescaped, so the path is everything
+// between the first " on " and the last " (".
+func mountListingHas(listing, path string) bool {
+	target := filepath.Clean(path)
+	for _, line := range strings.Split(listing, "\n") {
+		_, rest, ok := strings.Cut(line, " on ")
+		if !ok {
+			continue
+		}
+		if i := strings.LastIndex(rest, " ("); i >= 0 {
+			rest = rest[:i]
+		}
+		if rest == target {
+			return true
+		}
+	}
+	return false
+}
+
 // procMounted reports whether path is a mount point per /proc/self/mounts.
 func procMounted(path string) (bool, error) {
 	f, err := os.Open("/proc/self/mounts")
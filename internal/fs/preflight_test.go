@@ -178,3 +178,28 @@ func TestUnescapeMountPath(t *testing.T) {
 		}
 	}
 }
+
+func TestLazyUnmountArgv(t *testing.T) {
+	if got := strings.Join(lazyUnmountArgv("linux", "/mnt/x"), " "); got != "fusermount3 -uz /mnt/x" {
+		t.Errorf("linux = %q", got)
+	}
+	if got := strings.Join(lazyUnmountArgv("darwin", "/Users/me/linear"), " "); got != "diskutil unmount force /Users/me/linear" {
+		t.Errorf("darwin = %q", got)
+	}
+}
+
+// TestMountListingHas parses macOS mount(8) output, including a mount point
+// with spaces and parentheses.
+func TestMountListingHas(t *testing.T) {
+	listing := "/dev/disk3s1s1 on / (apfs, sealed, local, read-only, journaled)\n" +
+		"linearfs@macfuse0 on /Users/me/My Linear (work) (macfuse, nodev, nosuid, synchronous, mounted by me)\n"
+	if !mountListingHas(listing, "/Users/me/My Linear (work)") {
+		t.Error("mount point with spaces and parentheses not found")
+	}
+	if !mountListingHas(listing, "/Users/me/My Linear (work)/") {
+		t.Error("trailing slash not cleaned")
+	}
+	if mountListingHas(listing, "/Users/me/My Linear") {
+		t.Error("prefix of a mount point matched")
+	}
+}
@@ -47,7 +47,7 @@ func (p *ProjectsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno
 	entries := p.trio().entries()
 	for _, project := range projects {
 		entries = append(entries, fuse.DirEntry{
-			Name: projectDirName(project),
+			Name: p.lfs.iconDirName(project.Icon, projectDirName(project)),
 			Mode: syscall.S_IFDIR,
 		})
 	}
@@ -73,7 +73,7 @@ func (p *ProjectsNode) Lookup(ctx context.Context, name string, out *fuse.EntryO
 	}
 
 	for _, project := range projects {
-		if projectDirName(project) == name {
+		if p.lfs.iconDirNameMatches(name, project.Icon, projectDirName(project)) {
 			node := &ProjectNode{attrNode: attrNode{BaseNode: BaseNode{lfs: p.lfs}}, team: team, project: project}
 			return p.newDirInode(ctx, out, name, node, dirAttr(project.CreatedAt, project.UpdatedAt), projectDirIno(project.ID), 30*time.Second), 0
 		}
@@ -136,7 +136,7 @@ func (p *ProjectsNode) Rmdir(ctx context.Context, name string) syscall.Errno {
 				return nil, err
 			}
 			for _, project := range projects {
-				if projectDirName(project) == name {
+				if p.lfs.iconDirNameMatches(name, project.Icon, projectDirName(project)) {
 					return &project, nil
 				}
 			}
@@ -177,11 +177,14 @@ func projectDirName(project api.Project) string {
 	return safeName(name, project.Slug)
 }
 
-// ProjectNode represents a single project directory
+// ProjectNode represents a single project directory, under a team's
+// projects/ or the workspace projects/ (workspace set). team is the team the
+// directory lives under, or for the workspace tree the project's primary team.
 type ProjectNode struct {
 	attrNode
-	team    api.Team
-	project api.Project
+	team      api.Team
+	project   api.Project
+	workspace bool
 }
 
 var _ fs.NodeReaddirer = (*ProjectNode)(nil)
@@ -216,6 +219,10 @@ func (p *ProjectNode) refreshFrom(fresh fs.InodeEmbedder) {
 func (p *ProjectNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 	_, project := p.entity()
 	entries := p.manifest().entries()
+	if p.workspace {
+		// The workspace tree lists its issues under issues/ instead.
+		return fs.NewListDirStream(entries), 0
+	}
 	// Dynamic tail: issue symlinks.
 	issues, err := p.lfs.GetProjectIssues(ctx, project.ID)
 	if err != nil {
@@ -232,6 +239,10 @@ func (p *ProjectNode) Lookup(ctx context.Context, name string, out *fuse.EntryOu
 		return child.build(ctx, out)
 	}
 
+	if p.workspace {
+		return nil, syscall.ENOENT
+	}
+
 	// Dynamic tail: an issue symlink, resolved only on a static-child miss.
 	_, project := p.entity()
 	issues, err := p.lfs.GetProjectIssues(ctx, project.ID)
@@ -251,11 +262,18 @@ func (p *ProjectNode) Lookup(ctx context.Context, name string, out *fuse.EntryOu
 // manifest declares a project directory's static children: the editable
 // project.md, the read-through project.meta, the .error sidecar, and the
 // docs/updates/milestones subdirs. The dynamic tail (issue symlinks) is appended
-// by Readdir/Lookup, not the manifest. Project children have a 0 timeout.
+// by Readdir/Lookup, not the manifest; the workspace tree has an issues/ subdir
+// in its place. Project children have a 0 timeout.
 func (p *ProjectNode) manifest() *dirManifest {
 	team, project := p.entity() // snapshot captured by the build closures
 	lfs := p.lfs
 	m := newDirManifest(&p.BaseNode, project.ID, project.CreatedAt, project.UpdatedAt, 0)
+	subdirIno := func(name string, teamIno uint64) uint64 {
+		if p.workspace {
+			return workspaceProjectSubdirIno(project.ID, name)
+		}
+		return teamIno
+	}
 
 	// project.md is editable-only; identity/status/dates live in project.meta.
 	m.file("project.md", projectInfoIno(project.ID), func(ctx context.Context) (fs.InodeEmbedder, []byte, syscall.Errno) {
@@ -276,20 +294,51 @@ func (p *ProjectNode) manifest() *dirManifest {
 		return node.metaContent(), proj.UpdatedAt, proj.CreatedAt
 	})
 
+	// graph.dot / graph.json: dependency graph over the project's issues
+	// (which may span teams).
+	for _, file := range graphFiles {
+		m.renderFile(file, projectExportIno(project.ID, file), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			content := renderIssueGraph(ctx, file, project.Name,
+				func(ctx context.Context) ([]api.Issue, error) { return lfs.repo.GetIssuesByProject(ctx, project.ID) },
+				func(ctx context.Context) ([]api.IssueRelation, error) {
+					return lfs.repo.GetProjectIssueRelations(ctx, project.ID)
+				})
+			return content, project.UpdatedAt, project.CreatedAt
+		})
+	}
+
+	// timeline.csv / timeline.json: project span, milestones, and issue
+	// start/due/completion for Gantt tooling. Read-through from the freshest
+	// project so edited start/target dates show up.
+	for _, file := range timelineFiles {
+		m.renderFile(file, projectExportIno(project.ID, file), func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			proj := project
+			if projs, err := lfs.repo.GetTeamProjects(ctx, team.ID); err == nil {
+				proj = freshestByID(projs, project.ID, func(p api.Project) string { return p.ID }, project)
+			}
+			return lfs.renderProjectTimeline(ctx, file, proj), proj.UpdatedAt, proj.CreatedAt
+		})
+	}
+
 	m.errorFile(".error")
 
-	m.subdir("docs", docsDirIno(project.ID), func() dirChild {
+	m.subdir("docs", subdirIno("docs", docsDirIno(project.ID)), func() dirChild {
 		return &DocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: project.ID}
 	})
-	m.subdir("updates", updatesDirIno(project.ID), func() dirChild {
+	m.subdir("updates", subdirIno("updates", updatesDirIno(project.ID)), func() dirChild {
 		return &UpdatesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: project.ID}
 	})
-	m.subdir("milestones", milestonesDirIno(project.ID), func() dirChild {
-		return &MilestonesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: project.ID}
+	m.subdir("milestones", subdirIno("milestones", milestonesDirIno(project.ID)), func() dirChild {
+		return &MilestonesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: project.ID, workspace: p.workspace}
 	})
-	m.subdir("links", linksDirIno(project.ID), func() dirChild {
+	m.subdir("links", subdirIno("links", linksDirIno(project.ID)), func() dirChild {
 		return &LinksNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: project.ID}
 	})
+	if p.workspace {
+		m.subdir("issues", workspaceProjectSubdirIno(project.ID, "issues"), func() dirChild {
+			return &ProjectIssuesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, projectID: project.ID}
+		})
+	}
 
 	return m
 }
@@ -509,7 +558,16 @@ func (p *ProjectInfoNode) Flush(ctx context.Context, f fs.FileHandle) syscall.Er
 				return append(edit.divergences(fresh.Name, fresh.Content), labels.divergences(fresh.LabelIds)...)
 			},
 		},
-		adopt:     func(fresh *api.Project) { p.project = *fresh },
+		adopt: func(fresh *api.Project) {
+			// A rename (or an icon change, under mount.icon_prefix) renames the
+			// project's directory under projects/.
+			oldName := p.lfs.iconDirName(p.project.Icon, projectDirName(p.project))
+			if newName := p.lfs.iconDirName(fresh.Icon, projectDirName(*fresh)); oldName != newName {
+				p.lfs.InvalidateRenamed(projectsDirIno(p.team.ID), oldName, newName, 0)
+				p.lfs.InvalidateRenamed(viewDirIno(workspaceProjectsName), oldName, newName, 0)
+			}
+			p.project = *fresh
+		},
 		coherence: []uint64{projectInfoIno(p.project.ID), metaIno(p.project.ID)}, // project.meta reflects the edit
 	})
 }
@@ -0,0 +1,108 @@
+package fs
+
+import (
+	"context"
+	"log"
+	gosync "sync"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// prStatusTTL is how long a fetched PR status is served before a read kicks a
+// background re-fetch. PR state moves on the scale of minutes (a review, a CI
+// run), and every refresh costs three GitHub requests.
+const prStatusTTL = 5 * time.Minute
+
+// prStatusMaxEntries bounds the cache, one entry per PR URL read; a full
+// cache drops its stale entries first, then everything.
+const prStatusMaxEntries = 1024
+
+// prStatusCache is the GitHub PR enrichment behind attachment .link files. It
+// is stale-while-revalidate like the repo's SWR reads: lookup never blocks on
+// GitHub — it returns whatever is cached (nil on first sight) and, when that is
+// missing or older than prStatusTTL, spawns one background fetch per URL. The
+// .link render is DIRECT_IO, so the next read shows the fetched status.
+type prStatusCache struct {
+	client     *api.GitHubClient
+	maxEntries int
+
+	mu       gosync.Mutex
+	entries  map[string]prStatusEntry
+	inflight map[string]bool
+}
+
+type prStatusEntry struct {
+	status    *api.PullRequestStatus
+	fetchedAt time.Time
+}
+
+func newPRStatusCache(client *api.GitHubClient) *prStatusCache {
+	return &prStatusCache{
+		client:     client,
+		maxEntries: prStatusMaxEntries,
+		entries:    make(map[string]prStatusEntry),
+		inflight:   make(map[string]bool),
+	}
+}
+
+// lookup returns the cached status for a GitHub PR URL, scheduling a refresh
+// through spawn when it is missing or stale. Non-PR URLs return nil without
+// touching the network.
+func (c *prStatusCache) lookup(spawn func(func(ctx context.Context)), url string) *api.PullRequestStatus {
+	owner, repo, number, ok := api.ParseGitHubPRURL(url)
+	if !ok {
+		return nil
+	}
+
+	c.mu.Lock()
+	entry, cached := c.entries[url]
+	fetch := (!cached || time.Since(entry.fetchedAt) > prStatusTTL) && !c.inflight[url]
+	if fetch {
+		c.inflight[url] = true
+	}
+	c.mu.Unlock()
+
+	if fetch {
+		spawn(func(ctx context.Context) {
+			status, err := c.client.PullRequestStatus(ctx, owner, repo, number)
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			delete(c.inflight, url)
+			if err != nil {
+				// intentionally best-effort: the .link keeps its last status (or none)
+				// (recovers via the next read after prStatusTTL re-fetching)
+				log.Printf("[github] PR status %s: %v", url, err)
+				status = entry.status
+			}
+			c.put(url, prStatusEntry{status: status, fetchedAt: time.Now()})
+		})
+	}
+	return entry.status
+}
+
+// put keeps a fetched entry, making room in a full cache as responseCache
+// does: stale entries go first, then, if none were, all of them. Callers
+// hold c.mu.
+func (c *prStatusCache) put(url string, entry prStatusEntry) {
+	if _, ok := c.entries[url]; !ok && len(c.entries) >= c.maxEntries {
+		for u, e := range c.entries {
+			if time.Since(e.fetchedAt) > prStatusTTL {
+				delete(c.entries, u)
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			clear(c.entries)
+		}
+	}
+	c.entries[url] = entry
+}
+
+// prStatus is the attachment render's enrichment hook: nil when GitHub
+// enrichment is off (no token) or the URL is not a GitHub PR.
+func (lfs *LinearFS) prStatus(url string) *api.PullRequestStatus {
+	if lfs.prStatuses == nil {
+		return nil
+	}
+	return lfs.prStatuses.lookup(lfs.spawn, url)
+}
@@ -0,0 +1,107 @@
+package fs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestPRStatusCacheLookup pins the SWR contract: the first read of a PR URL
+// returns nil and schedules one fetch, the next read serves the fetched status
+// without another request, and a non-PR URL never touches the network.
+func TestPRStatusCacheLookup(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/reviews"):
+			_, _ = w.Write([]byte(`[]`))
+		case strings.Contains(r.URL.Path, "/check-runs"):
+			_, _ = w.Write([]byte(`{"check_runs":[{"status":"completed","conclusion":"success"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"state":"open","head":{"sha":"abc"}}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := api.NewGitHubClient("ghp_test")
+	client.SetBaseURL(srv.URL)
+	cache := newPRStatusCache(client)
+	spawned := 0
+	syncSpawn := func(fn func(ctx context.Context)) {
+		spawned++
+		fn(context.Background())
+	}
+
+	const prURL = "https://github.com/acme/widgets/pull/1"
+	if got := cache.lookup(syncSpawn, prURL); got != nil {
+		t.Errorf("first lookup = %+v, want nil (nothing cached yet)", got)
+	}
+	got := cache.lookup(syncSpawn, prURL)
+	if got == nil || got.State != "open" || got.Checks != "success" {
+		t.Fatalf("second lookup = %+v, want open/success", got)
+	}
+	if spawned != 1 {
+		t.Errorf("spawned %d fetches, want 1 (fresh entry must not re-fetch)", spawned)
+	}
+
+	before := requests.Load()
+	if got := cache.lookup(syncSpawn, "https://example.com/not-a-pr"); got != nil {
+		t.Errorf("non-PR lookup = %+v, want nil", got)
+	}
+	if requests.Load() != before || spawned != 1 {
+		t.Error("non-PR URL must not schedule a fetch")
+	}
+}
+
+// TestPRStatusCachePut: a full cache makes room for a new URL by dropping
+// its stale entries, and drops everything when none are stale.
+func TestPRStatusCachePut(t *testing.T) {
+	t.Parallel()
+	cache := newPRStatusCache(nil)
+	cache.maxEntries = 2
+	fresh := prStatusEntry{fetchedAt: time.Now()}
+	stale := prStatusEntry{fetchedAt: time.Now().Add(-2 * prStatusTTL)}
+
+	cache.put("a", stale)
+	cache.put("b", fresh)
+	cache.put("c", fresh)
+	if _, ok := cache.entries["a"]; ok || len(cache.entries) != 2 {
+		t.Errorf("entries = %v, want the stale a dropped for c", cache.entries)
+	}
+	cache.put("b", fresh)
+	if len(cache.entries) != 2 {
+		t.Errorf("re-putting a kept URL changed the size to %d", len(cache.entries))
+	}
+	cache.put("d", fresh)
+	if _, ok := cache.entries["d"]; !ok || len(cache.entries) != 1 {
+		t.Errorf("entries = %v, want only d after a full, fresh cache", cache.entries)
+	}
+}
+
+func TestExternalAttachmentContentPRStatus(t *testing.T) {
+	t.Parallel()
+	att := api.Attachment{Title: "Fix it", URL: "https://github.com/acme/widgets/pull/1", SourceType: "github"}
+
+	plain := externalAttachmentContent(att, nil)
+	if strings.Contains(plain, "pr_state") {
+		t.Errorf("unenriched .link carries PR fields:\n%s", plain)
+	}
+
+	enriched := externalAttachmentContent(att, &api.PullRequestStatus{
+		State: "merged", Checks: "success", Reviewers: []string{"alice (approved)"},
+	})
+	for _, want := range []string{"pr_state: merged\n", "checks: success\n", "reviewers: alice (approved)\n"} {
+		if !strings.Contains(enriched, want) {
+			t.Errorf("enriched .link missing %q:\n%s", want, enriched)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/cron"
+	"github.com/jra3/linear-fuse/internal/marshal"
+	"github.com/jra3/linear-fuse/internal/sync"
+)
+
+// recurringDatePlaceholder expands, in a recurring template, to the
+// occurrence's date.
+const recurringDatePlaceholder = "{date}"
+
+// recurringIssue is one compiled `recurring:` config entry.
+type recurringIssue struct {
+	cfg      config.RecurringConfig
+	schedule *cron.Schedule
+	loc      *time.Location // nil = local time
+}
+
+// compileRecurring validates the configured recurring issues: a unique name,
+// a team, a parseable schedule and time zone, and a template that parses as an
+// issue spec. Like compileViews, a bad entry fails the mount — a recurring
+// issue that silently never fires is worse than a loud config error.
+func compileRecurring(cfgs []config.RecurringConfig) ([]recurringIssue, error) {
+	out := make([]recurringIssue, 0, len(cfgs))
+	seen := make(map[string]bool, len(cfgs))
+	for i, rc := range cfgs {
+		if strings.TrimSpace(rc.Name) == "" {
+			return nil, fmt.Errorf("recurring[%d]: name is required", i)
+		}
+		if seen[rc.Name] {
+			return nil, fmt.Errorf("recurring[%d]: duplicate name %q", i, rc.Name)
+		}
+		seen[rc.Name] = true
+		if rc.Team == "" {
+			return nil, fmt.Errorf("recurring[%d] %q: team is required", i, rc.Name)
+		}
+		s, err := cron.Parse(rc.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("recurring[%d] %q: %w", i, rc.Name, err)
+		}
+		var loc *time.Location
+		if rc.TimeZone != "" {
+			if loc, err = time.LoadLocation(rc.TimeZone); err != nil {
+				return nil, fmt.Errorf("recurring[%d] %q: timezone: %w", i, rc.Name, err)
+			}
+		}
+		if _, err := recurringSpec(rc, time.Now()); err != nil {
+			return nil, fmt.Errorf("recurring[%d] %q: template: %w", i, rc.Name, err)
+		}
+		out = append(out, recurringIssue{cfg: rc, schedule: s, loc: loc})
+	}
+	return out, nil
+}
+
+// recurringSpec renders a definition's template for one occurrence into an
+// issue create spec (names not yet resolved), exactly as issues/_create parses
+// its content.
+func recurringSpec(rc config.RecurringConfig, occurrence time.Time) (map[string]any, error) {
+	content := strings.ReplaceAll(rc.Template, recurringDatePlaceholder, occurrence.Format("2006-01-02"))
+	spec, err := marshal.MarkdownToIssueCreate([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	if t, _ := spec["title"].(string); t == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	return spec, nil
+}
+
+// recurringSchedules projects the compiled definitions into what the sync
+// worker schedules.
+func (lfs *LinearFS) recurringSchedules() []sync.RecurringSchedule {
+	out := make([]sync.RecurringSchedule, len(lfs.recurring))
+	for i, r := range lfs.recurring {
+		out[i] = sync.RecurringSchedule{Name: r.cfg.Name, Schedule: r.schedule, Location: r.loc}
+	}
+	return out
+}
+
+// CreateRecurringIssue is the sync worker's RecurringIssueCreator: it creates
+// the issue for one occurrence of the named definition through the ordinary
+// issue create tail, so the issue is persisted, reported in the team's
+// issues/.last (and .last-created), and visible in every view on return. A
+// failure lands in the team's issues/.error like any create.
+func (lfs *LinearFS) CreateRecurringIssue(ctx context.Context, name string, occurrence time.Time) (*api.Issue, error) {
+	var def *recurringIssue
+	for i := range lfs.recurring {
+		if lfs.recurring[i].cfg.Name == name {
+			def = &lfs.recurring[i]
+		}
+	}
+	if def == nil {
+		return nil, fmt.Errorf("no recurring definition %q", name)
+	}
+	teams, err := lfs.repo.GetTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var team *api.Team
+	for i := range teams {
+		if teams[i].Key == def.cfg.Team {
+			team = &teams[i]
+		}
+	}
+	if team == nil {
+		return nil, fmt.Errorf("recurring %q: no team with key %s", name, def.cfg.Team)
+	}
+
+	issue, errno := commitCreate(ctx, lfs, lfs.issueCreateSpec(
+		team.ID,
+		`create recurring issue "`+name+`"`,
+		collectionErrorKey("issues", team.ID),
+		issuesDirIno(team.ID),
+		func(ctx context.Context) (*api.Issue, error) {
+			spec, err := recurringSpec(def.cfg, occurrence)
+			if err != nil {
+				return nil, &FieldError{Field: "template", Message: err.Error()}
+			}
+			return lfs.createIssueFromSpec(ctx, *team, spec)
+		},
+	))
+	if errno != 0 {
+		return nil, fmt.Errorf("recurring %q: create failed (%v); see teams/%s/issues/.error", name, errno, def.cfg.Team)
+	}
+	return issue, nil
+}
@@ -0,0 +1,75 @@
+package fs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+func TestCompileRecurringErrors(t *testing.T) {
+	t.Parallel()
+	ok := config.RecurringConfig{Name: "standup", Team: "ENG", Schedule: "0 9 * * 1-5", Template: "---\ntitle: Standup {date}\n---\n"}
+	for name, mutate := range map[string]func(*config.RecurringConfig){
+		"no name":      func(rc *config.RecurringConfig) { rc.Name = "" },
+		"no team":      func(rc *config.RecurringConfig) { rc.Team = "" },
+		"bad schedule": func(rc *config.RecurringConfig) { rc.Schedule = "every monday" },
+		"bad timezone": func(rc *config.RecurringConfig) { rc.TimeZone = "Mars/Olympus" },
+		"no title":     func(rc *config.RecurringConfig) { rc.Template = "just a body" },
+		"bad priority": func(rc *config.RecurringConfig) { rc.Template = "---\ntitle: x\npriority: critical\n---\n" },
+	} {
+		rc := ok
+		mutate(&rc)
+		if _, err := compileRecurring([]config.RecurringConfig{rc}); err == nil {
+			t.Errorf("%s: compileRecurring = nil error", name)
+		}
+	}
+	if _, err := compileRecurring([]config.RecurringConfig{ok, ok}); err == nil {
+		t.Error("duplicate names: compileRecurring = nil error")
+	}
+	if got, err := compileRecurring([]config.RecurringConfig{ok}); err != nil || len(got) != 1 {
+		t.Errorf("valid definition: compileRecurring = %v, %v", got, err)
+	}
+}
+
+// TestCreateRecurringIssue drives the worker's creator seam: the template is
+// rendered for the occurrence's date and created through the issue create
+// tail, landing in the team's issues/.last.
+func TestCreateRecurringIssue(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+	team := api.Team{ID: "team-1", Key: "ENG", Name: "Engineering"}
+	if err := store.Queries().UpsertTeam(ctx, db.APITeamToDBTeam(team)); err != nil {
+		t.Fatalf("UpsertTeam: %v", err)
+	}
+	recurring, err := compileRecurring([]config.RecurringConfig{{
+		Name: "weekly-report", Team: "ENG", Schedule: "0 9 * * 1",
+		Template: "---\ntitle: Weekly report {date}\n---\nCollect the numbers.\n",
+	}})
+	if err != nil {
+		t.Fatalf("compileRecurring: %v", err)
+	}
+	lfs.recurring = recurring
+
+	occurrence := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	issue, err := lfs.CreateRecurringIssue(ctx, "weekly-report", occurrence)
+	if err != nil {
+		t.Fatalf("CreateRecurringIssue: %v", err)
+	}
+	if issue.Title != "Weekly report 2026-10-12" || !strings.Contains(issue.Description, "Collect the numbers.") {
+		t.Errorf("created %q / %q, want the rendered template", issue.Title, issue.Description)
+	}
+	last := lfs.GetWriteSuccess(collectionSuccessKey("issues", team.ID))
+	if len(last) != 1 || last[0].Identifier != issue.Identifier {
+		t.Errorf("issues/.last = %+v, want the recurring issue", last)
+	}
+
+	if _, err := lfs.CreateRecurringIssue(ctx, "missing", occurrence); err == nil {
+		t.Error("unknown definition: CreateRecurringIssue = nil error")
+	}
+}
@@ -10,9 +10,9 @@ import (
 // freshly-constructed one — so a node that bakes entity state at construction
 // (an editBuffer's content, a directory's entity, a render closure's capture)
 // would serve first-Lookup data for as long as the kernel remembers the
-// inode. The sync worker deliberately never notifies the kernel; freshness
-// arrives via attr/entry timeout expiry forcing a re-Lookup — and that
-// re-Lookup is exactly where this seam acts: the parent has just fetched the
+// inode. Freshness arrives via a re-Lookup — forced by a sync-change entry
+// notify (invalidateIssueSynced) or, failing that, by attr/entry timeout
+// expiry — and that re-Lookup is exactly where this seam acts: the parent has just fetched the
 // entity fresh and built a fresh node; if the bridge still knows an old node
 // under this name, push the fresh state into it.
 //
@@ -0,0 +1,159 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// relationLinkView is one of an issue directory's relation link directories:
+// which stored relation type it projects and from which end. relations/ is
+// the editable surface (.rel files, _create, rm); these are its walkable
+// twins — each entry a symlink to the related issue's directory, so
+// `cd blocked-by/ENG-12/blocked-by/` follows a dependency chain.
+type relationLinkView struct {
+	name    string // directory name inside the issue directory
+	relType string // stored relation type
+	// outgoing lists relations this issue owns (the related issue), inverse
+	// relations owned by another issue (the owner). "related" is symmetric,
+	// so relates/ takes both ends.
+	outgoing, inverse bool
+}
+
+// relationLinkViews are the issue directory's relation link directories, in
+// listing order.
+var relationLinkViews = []relationLinkView{
+	{name: "relates", relType: "related", outgoing: true, inverse: true},
+	{name: "blocks", relType: "blocks", outgoing: true},
+	{name: "blocked-by", relType: "blocks", inverse: true},
+}
+
+// RelationLinksNode is teams/{KEY}/issues/{ID}/{relates,blocks,blocked-by}/:
+// the issues one relation view reaches from this issue, as symlinks named by
+// identifier. Read-only — relations are created and removed under relations/.
+// A relation the sync worker brings in is entry-timeout bounded, like every
+// other remote change to a listing.
+type RelationLinksNode struct {
+	attrNode
+	issueID string
+	view    relationLinkView
+}
+
+var _ fs.NodeReaddirer = (*RelationLinksNode)(nil)
+var _ fs.NodeLookuper = (*RelationLinksNode)(nil)
+var _ fs.NodeGetattrer = (*RelationLinksNode)(nil)
+
+// linked resolves the view's related issues from the cached relations, once
+// each, skipping a related issue that is not in the cache (it would be a
+// dangling link).
+func (n *RelationLinksNode) linked(ctx context.Context) ([]api.Issue, error) {
+	var ids []string
+	if n.view.outgoing {
+		rels, err := n.lfs.repo.GetIssueRelations(ctx, n.issueID)
+		if err != nil {
+			return nil, err
+		}
+		for _, rel := range rels {
+			if rel.Type == n.view.relType && rel.RelatedIssue != nil {
+				ids = append(ids, rel.RelatedIssue.ID)
+			}
+		}
+	}
+	if n.view.inverse {
+		rels, err := n.lfs.repo.GetIssueInverseRelations(ctx, n.issueID)
+		if err != nil {
+			return nil, err
+		}
+		for _, rel := range rels {
+			if rel.Type == n.view.relType && rel.Issue != nil {
+				ids = append(ids, rel.Issue.ID)
+			}
+		}
+	}
+	seen := make(map[string]struct{}, len(ids))
+	issues := make([]api.Issue, 0, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		issue, err := n.lfs.repo.GetIssueByID(ctx, id)
+		if err != nil || issue == nil || issue.Identifier == "" {
+			continue
+		}
+		issues = append(issues, *issue)
+	}
+	return issues, nil
+}
+
+func (n *RelationLinksNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.linked(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(issues))
+	for i, issue := range issues {
+		entries[i] = fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *RelationLinksNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.linked(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			target, errno := relationLinkTarget(issue)
+			if errno != 0 {
+				return nil, errno
+			}
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
+
+// relationLinkTarget is the relative target for a link in a relation link
+// directory, five levels below the mount root (teams/{KEY}/issues/{ID}/
+// blocks/). A related issue may sit in another team, so the target climbs to
+// the root and reuses teamIssueTarget's path-safe tail.
+func relationLinkTarget(issue api.Issue) (string, syscall.Errno) {
+	target, errno := teamIssueTarget(issue)
+	if errno != 0 {
+		return "", errno
+	}
+	return "../../../" + target, 0
+}
+
+// relationLinkEntry names one symlink a relation projects into a relation
+// link directory.
+type relationLinkEntry struct {
+	dir  uint64
+	name string
+}
+
+// relationLinkEntries lists the link entries one relation owned by ownerID
+// projects: the owner's outgoing view naming the other issue, and the other
+// issue's inverse view naming the owner. The relations/ create and delete
+// tails invalidate exactly these, so both ends' link directories agree with
+// the .rel files at once.
+func relationLinkEntries(relType, ownerID, ownerIdent, otherID, otherIdent string) []relationLinkEntry {
+	var entries []relationLinkEntry
+	for _, v := range relationLinkViews {
+		if v.relType != relType {
+			continue
+		}
+		if v.outgoing {
+			entries = append(entries, relationLinkEntry{dir: relationLinksDirIno(ownerID, v.name), name: otherIdent})
+		}
+		if v.inverse {
+			entries = append(entries, relationLinkEntry{dir: relationLinksDirIno(otherID, v.name), name: ownerIdent})
+		}
+	}
+	return entries
+}
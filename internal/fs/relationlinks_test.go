@@ -0,0 +1,100 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// TestRelationLinks seeds ENG-1 blocks OPS-2 and ENG-3 related to ENG-1, and
+// checks each link directory from both ends: the owner's outgoing view, the
+// other issue's inverse view, relates/ taking both ends of the symmetric
+// relation, and a cross-team target climbing to the mount root.
+func TestRelationLinks(t *testing.T) {
+	t.Parallel()
+	lfs, store := linkTestLFS(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	eng, ops := &api.Team{ID: "team-eng", Key: "ENG"}, &api.Team{ID: "team-ops", Key: "OPS"}
+	for _, issue := range []api.Issue{
+		{ID: "issue-1", Identifier: "ENG-1", Title: "Blocker", Team: eng, CreatedAt: now, UpdatedAt: now},
+		{ID: "issue-2", Identifier: "OPS-2", Title: "Blocked", Team: ops, CreatedAt: now, UpdatedAt: now},
+		{ID: "issue-3", Identifier: "ENG-3", Title: "Sibling", Team: eng, CreatedAt: now, UpdatedAt: now},
+	} {
+		if err := lfs.UpsertIssue(ctx, issue); err != nil {
+			t.Fatalf("UpsertIssue: %v", err)
+		}
+	}
+	rel := func(id, typ, to string) api.IssueRelation {
+		return api.IssueRelation{ID: id, Type: typ, RelatedIssue: &api.ParentIssue{ID: to}, CreatedAt: now, UpdatedAt: now}
+	}
+	if err := fixtures.PopulateIssueRelations(ctx, store, "issue-1", []api.IssueRelation{rel("rel-1", "blocks", "issue-2")}); err != nil {
+		t.Fatalf("PopulateIssueRelations: %v", err)
+	}
+	if err := fixtures.PopulateIssueRelations(ctx, store, "issue-3", []api.IssueRelation{rel("rel-2", "related", "issue-1")}); err != nil {
+		t.Fatalf("PopulateIssueRelations: %v", err)
+	}
+
+	node := func(issueID, view string) *RelationLinksNode {
+		for _, v := range relationLinkViews {
+			if v.name == view {
+				return &RelationLinksNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}, issueID: issueID, view: v}
+			}
+		}
+		t.Fatalf("no relation link view %q", view)
+		return nil
+	}
+	for _, tc := range []struct {
+		issueID, view string
+		want          []string
+	}{
+		{"issue-1", "blocks", []string{"OPS-2"}},
+		{"issue-1", "blocked-by", nil},
+		{"issue-1", "relates", []string{"ENG-3"}},
+		{"issue-2", "blocked-by", []string{"ENG-1"}},
+		{"issue-2", "blocks", nil},
+		{"issue-3", "relates", []string{"ENG-1"}},
+	} {
+		if got := readdirNames(t, node(tc.issueID, tc.view)); !slices.Equal(got, tc.want) {
+			t.Errorf("%s %s/ = %v, want %v", tc.issueID, tc.view, got, tc.want)
+		}
+	}
+
+	if _, errno := node("issue-1", "blocks").Lookup(ctx, "ENG-3", &fuse.EntryOut{}); errno != syscall.ENOENT {
+		t.Errorf("Lookup of an unrelated issue = %v, want ENOENT", errno)
+	}
+	target, errno := relationLinkTarget(api.Issue{ID: "issue-2", Identifier: "OPS-2", Team: ops})
+	if errno != 0 || target != "../../../../../teams/OPS/issues/OPS-2" {
+		t.Errorf("relationLinkTarget = %q, %v", target, errno)
+	}
+}
+
+func TestRelationLinkEntries(t *testing.T) {
+	t.Parallel()
+	blocks := relationLinkEntries("blocks", "a", "ENG-1", "b", "ENG-2")
+	want := []relationLinkEntry{
+		{dir: relationLinksDirIno("a", "blocks"), name: "ENG-2"},
+		{dir: relationLinksDirIno("b", "blocked-by"), name: "ENG-1"},
+	}
+	if !slices.Equal(blocks, want) {
+		t.Errorf("blocks entries = %v, want %v", blocks, want)
+	}
+	related := relationLinkEntries("related", "a", "ENG-1", "b", "ENG-2")
+	want = []relationLinkEntry{
+		{dir: relationLinksDirIno("a", "relates"), name: "ENG-2"},
+		{dir: relationLinksDirIno("b", "relates"), name: "ENG-1"},
+	}
+	if !slices.Equal(related, want) {
+		t.Errorf("related entries = %v, want %v", related, want)
+	}
+	if got := relationLinkEntries("duplicate", "a", "ENG-1", "b", "ENG-2"); len(got) != 0 {
+		t.Errorf("duplicate has no link view, got %v", got)
+	}
+}
@@ -16,8 +16,9 @@ import (
 // RelationsNode represents the /teams/{KEY}/issues/{ID}/relations directory
 type RelationsNode struct {
 	attrNode
-	issueID string
-	teamID  string
+	issueID    string
+	identifier string // the issue's identifier, named by the other end's link dirs
+	teamID     string
 }
 
 var _ fs.NodeReaddirer = (*RelationsNode)(nil)
@@ -78,6 +79,14 @@ func (n *RelationsNode) deleteRelation(ctx context.Context, name string, e relat
 		},
 		dir:  relationsDirIno(n.issueID),
 		name: name,
+		invalidateExtra: func(r *api.IssueRelation) {
+			if r.RelatedIssue == nil {
+				return
+			}
+			for _, e := range relationLinkEntries(r.Type, n.issueID, n.identifier, r.RelatedIssue.ID, r.RelatedIssue.Identifier) {
+				n.lfs.InvalidateDeleted(e.dir, e.name)
+			}
+		},
 	})
 }
 
@@ -215,6 +224,11 @@ func (n *RelationsNode) createRelation(ctx context.Context, raw []byte) syscall.
 		entryName: func(*api.IssueRelation) string {
 			return relationFileName(relationType, relatedIdentifier)
 		},
+		invalidateExtra: func(*api.IssueRelation) {
+			for _, e := range relationLinkEntries(relationType, n.issueID, n.identifier, relatedID, relatedIdentifier) {
+				n.lfs.InvalidateCreated(e.dir, e.name)
+			}
+		},
 	})
 	return errno
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
 // fakeResolver resolves names via simple maps and errors on anything unknown. It
@@ -256,3 +257,36 @@ func TestFreshestByID(t *testing.T) {
 		t.Errorf("empty returned %+v, want fallback", got)
 	}
 }
+
+// TestIssueMarkdownEditResolvesToOneUpdate: an issue.md edit touching priority,
+// estimate, due date, milestone and cycle at once parses and resolves into the
+// single input map the write path sends as one UpdateIssue — names turned into
+// IDs, untouched fields absent.
+func TestIssueMarkdownEditResolvesToOneUpdate(t *testing.T) {
+	original := teamedIssue()
+	original.Title = "Ship it"
+	original.Priority = 3
+	original.Description = "body\n"
+	original.Project = &api.Project{ID: "proj-1", Name: "Apollo"}
+	original.ProjectMilestone = &api.ProjectMilestone{ID: "ms-0", Name: "Phase 0"}
+
+	content := []byte("---\ntitle: Ship it\npriority: high\nestimate: 5\ndueDate: 2026-11-01\n" +
+		"project: Apollo\nmilestone: Phase 1\ncycle: Sprint 42\n---\nbody\n")
+	updates, err := marshal.MarkdownToIssueUpdate(content, original)
+	if err != nil {
+		t.Fatalf("MarkdownToIssueUpdate: %v", err)
+	}
+	if ferr := resolveIssueUpdate(context.Background(), fullResolver(), original, updates); ferr != nil {
+		t.Fatalf("unexpected FieldError: %v", ferr)
+	}
+	want := map[string]any{
+		"priority":           2,
+		"estimate":           5,
+		"dueDate":            "2026-11-01",
+		"projectMilestoneId": "ms-1",
+		"cycleId":            "cycle-1",
+	}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("updates = %#v\nwant %#v", updates, want)
+	}
+}
@@ -32,10 +32,20 @@ func (r *RootNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 	entries := []fuse.DirEntry{
 		{Name: "README.md", Mode: syscall.S_IFREG},
 		{Name: "project-labels.md", Mode: syscall.S_IFREG},
+		{Name: "organization.md", Mode: syscall.S_IFREG},
+		{Name: eventsName, Mode: syscall.S_IFREG},
+		{Name: controlDirName, Mode: syscall.S_IFDIR},
 		{Name: "teams", Mode: syscall.S_IFDIR},
 		{Name: "users", Mode: syscall.S_IFDIR},
 		{Name: "my", Mode: syscall.S_IFDIR},
 		{Name: "initiatives", Mode: syscall.S_IFDIR},
+		{Name: workspaceProjectsName, Mode: syscall.S_IFDIR},
+		{Name: "views", Mode: syscall.S_IFDIR},
+		{Name: "docs", Mode: syscall.S_IFDIR},
+		{Name: activityDirName, Mode: syscall.S_IFDIR},
+	}
+	if r.lfs.confirms != nil {
+		entries = append(entries, fuse.DirEntry{Name: confirmName, Mode: syscall.S_IFREG})
 	}
 	return fs.NewListDirStream(entries), 0
 }
@@ -61,7 +71,41 @@ func (r *RootNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 				return projectLabelsMarkdown(labels), mtime, ctime
 			}, projectLabelsCatalogIno(), inheritTimeout), 0
 
-	// The four top-level containers are stateless — no entity backs them, so
+	case "organization.md":
+		// Workspace identity and auth settings. Cached in SQLite and
+		// refreshed on read (SWR); before the first fetch lands it renders a
+		// placeholder with zero times rather than ENOENT.
+		lfs := r.lfs
+		return r.lookupRenderFile(ctx, out, "organization.md",
+			func(ctx context.Context) ([]byte, time.Time, time.Time) {
+				org, err := lfs.repo.GetOrganization(ctx)
+				if err != nil {
+					return []byte("# Error loading organization\n"), time.Time{}, time.Time{}
+				}
+				if org == nil {
+					return organizationMarkdown(nil), time.Time{}, time.Time{}
+				}
+				return organizationMarkdown(org), org.UpdatedAt, org.CreatedAt
+			}, organizationIno(), inheritTimeout), 0
+
+	case eventsName:
+		// The long-poll change feed. Not a render file: its Read blocks per
+		// open handle (see events.go).
+		node := &EventsNode{BaseNode: BaseNode{lfs: r.lfs}}
+		return r.newFileInode(ctx, out, name, node, node.attr(), eventsIno(), 0), 0
+
+	case controlDirName:
+		node := &ControlDirNode{attrNode: attrNode{BaseNode: BaseNode{lfs: r.lfs}}}
+		return r.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), viewDirIno(name), inheritTimeout), 0
+
+	case confirmName:
+		// Write-only: a held delete's token runs it (deleteconfirm.go).
+		if r.lfs.confirms == nil {
+			return nil, syscall.ENOENT
+		}
+		return r.lfs.lookupTriggerFile(ctx, r, r.lfs.confirmDelete, out), 0
+
+	// The eight top-level containers are stateless — no entity backs them, so
 	// they report zero times (honest unknown) and key their inos on the fixed
 	// directory name.
 	case "teams":
@@ -80,6 +124,22 @@ func (r *RootNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 		node := &InitiativesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: r.lfs}}}
 		return r.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), viewDirIno(name), inheritTimeout), 0
 
+	case workspaceProjectsName:
+		node := &WorkspaceProjectsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: r.lfs}}}
+		return r.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), viewDirIno(name), inheritTimeout), 0
+
+	case "views":
+		node := &CustomViewsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: r.lfs}}}
+		return r.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), viewDirIno(name), inheritTimeout), 0
+
+	case "docs":
+		node := &WorkspaceDocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: r.lfs}}}
+		return r.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), docsDirIno(workspaceDocsParent), inheritTimeout), 0
+
+	case activityDirName:
+		node := &ActivityNode{attrNode: attrNode{BaseNode: BaseNode{lfs: r.lfs}}}
+		return r.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), viewDirIno(name), inheritTimeout), 0
+
 	default:
 		return nil, syscall.ENOENT
 	}
@@ -94,48 +154,81 @@ Mount point: %s (all paths below are relative to this mount point)
 </purpose>
 
 <directory_structure>
-teams/{KEY}/
-  team.md, states.md, labels.md     [read-only metadata]
+teams/{KEY}/                        [listed as "{emoji} {KEY}" under mount.icon_prefix; bare {KEY} always resolves]
+  team.md, states.md, labels.md     [read-only metadata; team.md carries the icon and private: true for a members-only team]
+  .team.json                        [read-only: id, key, name, icon, color, description; icon/color/description are also user.linear.* xattrs on the dir]
   project-labels.md                 [symlink to ../../project-labels.md]
+  graph.dot, graph.json             [read-only: dependency graph of the team's issues (parent + relation edges)]
+  needs-attention.md                [read-only: started issues untouched for days, SLAs breached or near breach]
+  metrics.md                        [read-only: median/p90 lead and cycle time per month of completion]
+  lint.md                           [read-only: done issues with open PRs, in-progress issues with no assignee]
   docs/                             [team-level documents; same surface as issues/docs]
-  issues/                           [mkdir "Title" for quick create]
+  issues/                           [mkdir "Title" for quick create; dirs named per mount.issue_dir_template ({flags}: .urgent/.blocked), bare {ID} always resolves]
     _create                         [write full frontmatter+body to create one issue with all fields]
+    _clone                          [write an identifier: new issue with its title, description, labels, project]
     .error                          [read-only: last failed issue creation]
     .last                           [read-only: YAML list of recent creations {identifier,url,path,title,status}]
+    .last-created                   [read-only: absolute path of the newest issue created here, one line]
+    .archived/{ID}/issue.md         [read-only: issues archived on Linear, newest first; kept by the full sync; mv .archived/{ID} . unarchives]
   recent/                           [read-only: issue symlinks, newest-first by updatedAt (ls recent/ | head)]
+  views/{name}/                     [read-only: issue symlinks matching a filter from the views: config (absent when none)]
   issues/{ID}/
-    issue.md                        [read/write: editable fields + body ONLY]
-    issue.meta                      [read-only: id, identifier, url, branch, created, updated, links, relations]
+    issue.md                        [read/write: editable fields + body ONLY; display.cross_links renders ENG-42 as [ENG-42](../ENG-42/issue.md); display.local_images points CDN images at attachments/]
+    issue.meta                      [read-only: id, identifier, url, branch, created, updated, links, relations, blockedBy, blocked, child* sub-issue rollup, ageDays/timeInCurrentState/leadTime, restricted]
+    issue.full.md                   [read-only, only when the description is over 256 KiB: issue.md whole; issue.md shows it cut at a marker line, and saving keeps the text past the marker]
+    history.md                      [read-only: activity log — state, assignee, priority, label changes]
+    history/                        [read-only: description versions sync has seen, newest 20]
+      {timestamp}.md                [the description as of that update (2026-10-17T09-30-00Z.md)]
+      diff-latest.patch             [unified diff of the newest change]
+    backlinks.md                    [read-only: issues, comments, docs mentioning this issue]
+    attachments.md                  [read-only: table of all attachments (title, source, URL, creator, created)]
+    issue.pdf                       [read-only: PDF of the metadata, description, and comments, for email/audits]
+    issue.patch                     [write-only: an RFC 6902 JSON Patch over the frontmatter fields + "description"; rejected patches change nothing, reason in .error]
     .error                          [read-only: last failed write here]
     .last                           [read-only: sub-issues created via children/]
     comments/                       [_create=trigger, .error=feedback, .last=created ids]
-      {id}.md                       [read/write: comment body ONLY, no frontmatter]
-      {id}.meta                     [read-only: id, author, created, updated]
+      {date}-{id}.md                [read/write: comment body ONLY, no frontmatter; name fixed at creation]
+      {date}-{id}.meta              [read-only: id, author, created, updated, deleted]
+      {date}-{id}.full.md           [read-only, only for a comment over 256 KiB: the whole body; the .md shows it cut]
+                                    [display.show_deleted_comments lists deleted comments struck through]
+    drafts/                         [local-only comment drafts, never sent until published]
+      {any-name}                    [read/write: stored in SQLite only]
+      publish                       [rename a draft here (mv reply.md publish) to post it as a comment]
+      .error, .last                 [read-only: publish feedback / posted comment ids]
     docs/                           [_create=trigger, .error=feedback, .last=created docs]
       {slug}.md                     [read/write: title, icon, color + body]
       {slug}.meta                   [read-only: id, url, creator, created, updated]
+      {slug}.backlinks.md           [read-only: issues, comments, docs linking to this document]
     attachments/                    [embedded files + external links]
       _create                       [write "URL [title]" to link]
       .error                        [read-only: last failed write here]
-      .last                         [read-only: recent successful links]
+      .last                         [read-only: recent successful links and uploads]
       *.png, *.pdf                  [read-only: embedded images/files]
-      *.link                        [read-only: external link info]
+      {any-file}                    [cp a file here to upload it and link it from the description]
+      *.link                        [read-only: external link info; GitHub PRs add pr_state, checks, reviewers when github.token is set]
     relations/                      [issue dependencies/links]
       _create                       [write "type ID" to create]
       .error                        [read-only: last failed write here]
       .last                         [read-only: recent created relations]
       {type}-{ID}.rel               [read-only info, rm to delete]
-    children/                       [symlinks to sub-issues, mkdir to create]
-  by/status|label|assignee/{value}/ [issue symlinks]
+    children/                       [symlinks to sub-issues; _create (full spec) or mkdir to create]
+    relates/, blocks/, blocked-by/  [read-only: symlinks to related issues, per relation type and direction]
+  by/status|label|assignee|creator|priority|project|cycle/{value}/ [issue symlinks; mv between by/status/ or by/assignee/ dirs changes that field]
+  by/blocked/                       [issue symlinks: open issues an open issue blocks]
   labels/                           [_create=trigger, .error=feedback, .last=created labels]
     {name}.md                       [read/write: name, color, description; rm to delete]
     {name}.meta                     [read-only: id]
-  projects/                         [mkdir "Name" to create a project]
+    usage.md                        [read-only: open/closed issue counts and last use per label]
+  templates/                        [read-only: Linear issue templates]
+    {name}.md                       [read-only: a _create spec; cp templates/Bug.md issues/_create]
+  projects/                         [mkdir "Name" to create a project; "{emoji} {slug}" under mount.icon_prefix]
     .error                          [read-only: last failed project creation]
     .last                           [read-only: recent project creations]
   projects/{slug}/
     project.md                      [read/write: editable fields + body ONLY]
-    project.meta                    [read-only: id, slug, url, status, lead, description, dates]
+    project.meta                    [read-only: id, slug, url, status, lead, description, icon, color, dates]
+    graph.dot, graph.json           [read-only: dependency graph of the project's issues]
+    timeline.csv, timeline.json     [read-only: Gantt rows: project span, milestones, issue created/due/completed]
     .error                          [read-only: last failed write here]
     docs/                           [same as issues]
     updates/                        [status updates]
@@ -149,6 +242,7 @@ teams/{KEY}/
       .last                         [read-only: recent created milestones]
       {name}.md                     [read/write: name, targetDate, sortOrder + body; rm to delete]
       {name}.meta                   [read-only: id]
+      {name}/                       [read-only: symlinks to the milestone's issues]
     links/                          [external links ("Links / Resources")]
       _create                       [write "URL [label]" to link]
       .error                        [read-only: last failed write here]
@@ -157,19 +251,44 @@ teams/{KEY}/
     {ISSUE-ID} symlinks
   cycles/
     current                         [symlink to active cycle]
+    next, previous                  [symlinks to the cycle starting next / the last one ended]
     {name}/                         [issue symlinks]
+      cycle.md                      [read-only: dates, progress]
+      scope-changes.md              [read-only, started cycles: issues added, removed, re-estimated since the start]
+      report.md                     [read-only, completed cycles: shipped, canceled, carried over]
 
 project-labels.md                   [read-only: workspace project-label catalog (groups, retired)]
+organization.md                     [read-only: workspace name, URL key, auth methods, SAML/SCIM (admin tokens)]
+.events                             [read blocks until sync (or a webhook delivery) brings a change; one JSON line per change {entity,id,identifier,team,action,at}]
+activity/{YYYY-MM-DD}.md            [read-only: workspace changelog per UTC day (issues created, completed, state changes), as sync observed them]
+.linearfs/emoji.json                [read-only: custom workspace emojis as {"name": "image URL"}, for rendering :name:]
+.linearfs/pending/{id}-{kind}.json  [read-only: an issue.md save or new comment queued while Linear was unreachable; sync sends them in order]
+.linearfs/status                    [read-only JSON: per-team last sync + issue count, cache size, rate-limit windows, log level]
+.linearfs/sync                      [write-only: "now" runs a full sync cycle]
+.linearfs/log-level                 [read/write: info, or debug for the [API]/[ratelimit] request traces]
+.linearfs/dircolors                 [read-only, with {flags} in mount.issue_dir_template: LS_COLORS rules for the .urgent/.blocked suffixes]
+.linearfs/webhook-failures          [read-only, with webhook.listen: deliveries refused, failed, or ignored as repeats; one JSON line each]
+
+projects/{slug}/                    [every project in the workspace once, whichever teams it spans]
+  (as teams/{KEY}/projects/{slug}/, built on the project's first team by key; issue links move to issues/)
+  issues/
+    {ID}                            [symlink to ../../../teams/{KEY}/issues/{ID}, in the issue's own team]
 
 initiatives/{slug}/
   initiative.md                     [read/write: editable fields + body ONLY]
-  initiative.meta                   [read-only: id, slug, url, status, owner, description, dates]
+  initiative.meta                   [read-only: id, slug, url, status, owner, parent, description, dates]
+  rollup.md                         [read-only: project/issue progress across this initiative and its sub-initiatives]
   .error                            [read-only: last failed write here]
   docs/                             [_create=trigger, .error=feedback]
     {slug}.md                       [read/write: title, icon, color + body]
     {slug}.meta                     [read-only: id, url, creator, created, updated]
+    {slug}.backlinks.md             [read-only: issues, comments, docs linking to this document]
   projects/                         [symlinks to team projects]
     {project-slug}                  [symlink to ../../../teams/{KEY}/projects/{slug}]
+  issues/                           [read-only: every issue in the linked projects (not sub-initiatives')]
+    {ISSUE-ID}                      [symlink to ../../../teams/{KEY}/issues/{ISSUE-ID}]
+  sub-initiatives/                  [symlinks to child initiatives]
+    {child-slug}                    [symlink to ../../{child-slug}]
   updates/                          [status updates]
     _create                         [write with health: onTrack|atRisk|offTrack]
     .error                          [read-only: last failed write here]
@@ -182,21 +301,34 @@ initiatives/{slug}/
     {label}.link                    [read-only: label, url; rm to delete]
 
 users/{name}/                       [issue symlinks + user.md]
+  workload.md                       [read-only: open assigned issues by priority, estimate totals, per team]
 my/assigned|created|active/         [your issue symlinks]
+views/{name}/                       [read-only: issue symlinks for each saved view from Linear's UI]
+docs/                               [standalone documents (no issue/team/project/initiative): {slug}.md read/write/rm/mv, .meta, .backlinks.md; new.md creates, .error/.last]
+docs/search/{query}/                [read-only: symlinks to documents whose title/content match every term (prefix match)]
 </directory_structure>
 
 <operations>
 READ:    cat %s/teams/ENG/issues/ENG-123/issue.md
 EDIT:    vim issue.md                 (edit frontmatter, save)
+         echo "note" >> issue.md      (append a paragraph to the description; frontmatter untouched)
+         echo '[{"op":"replace","path":"/status","value":"Done"}]' > issue.patch   (change single fields)
+         mv by/status/Todo/ENG-12 by/status/Done/   (change status)
+         mv by/assignee/unassigned/ENG-12 by/assignee/ada/   (assign)
 CREATE:  mkdir %s/teams/ENG/issues/"New Issue Title"   (quick: title only)
          printf -- '---\ntitle: Full Issue\npriority: high\nlabels: [Bug]\n---\nBody.\n' > issues/_create
          cat issues/.last                  (read back the new identifier/url/path)
+         cd "$(cat issues/.last-created)"  (chain onto the new issue immediately)
+         echo ENG-12 > issues/_clone       (duplicate an issue under a fresh identifier)
          mkdir children/"Sub-task Title"   (creates child issue)
+         printf -- '---\ntitle: Sub-task\n---\nBody.\n' > children/_create   (child issue from a full spec)
          mkdir %s/teams/ENG/projects/"New Project"
          echo "text" > comments/_create
+         vim drafts/reply.md; mv drafts/reply.md drafts/publish   (draft locally, post later)
          echo "text" > docs/"Title.md"
          echo "---\nhealth: atRisk\n---\nBlocked" > updates/_create
 LINK:    echo "https://github.com/org/repo/pull/123" > attachments/_create
+UPLOAD:  cp screenshot.png attachments/
          echo "https://notes.granola.ai/x [Onboarding Sync]" > projects/my-project/links/_create
          echo "blocks ENG-456" > relations/_create
          echo -e "Phase 1\nInitial milestone" > milestones/_create
@@ -207,6 +339,7 @@ INITIATIVES:
 DELETE:  rm relations/blocks-ENG-456.rel
          rm milestones/"Phase 1.md"
 ARCHIVE: rmdir %s/teams/ENG/issues/ENG-123
+         mv issues/.archived/ENG-123 issues/   (unarchive)
 SORT:    ls -lt %s/my/active/           (mtime = updatedAt)
 </operations>
 
@@ -214,13 +347,15 @@ SORT:    ls -lt %s/my/active/           (mtime = updatedAt)
 issue.md holds only editable fields (below) + the description body. Read-only
 identity/timestamps/links live in the sibling issue.meta (identifier, url,
 branch, created, updated, …). A successful write never rewrites issue.md.
+An issue Linear refuses your token (a private team you are not in) shows
+restricted: true in issue.meta, and opening its issue.md fails with EACCES.
 ---
 title: "Fix bug"                    [editable]
 status: "In Progress"               [must match states.md]
 assignee: "user@example.com"        [email or display name]
 priority: high                      [none|low|medium|high|urgent]
 labels: [Bug, Backend]              [must match labels.md]
-due: "2025-01-15"                   [YYYY-MM-DD]
+due: "2025-01-15"                   [YYYY-MM-DD; dueDate: also accepted]
 estimate: 3                         [points]
 parent: ENG-100                     [parent issue identifier]
 project: "Project Name"
@@ -272,8 +407,8 @@ Usage:
 </initiative_frontmatter>
 
 <permissions>
--r--r--r--  Read-only     team.md, states.md, user.md, every *.meta sidecar
--rw-r--r--  Editable      issue.md, project.md, initiative.md, comments/*.md, docs/*.md, milestones/*.md, labels/*.md
+-r--r--r--  Read-only     team.md, states.md, user.md, workload.md, every *.meta sidecar
+-rw-r--r--  Editable      issue.md, project.md, initiative.md, comments/*.md, drafts/*, docs/*.md, milestones/*.md, labels/*.md
 --w-------  Write-only    _create (write triggers creation; reads are rejected)
 lrwxrwxrwx  Symlink       Issues in by/, cycles/, projects/, users/
 
@@ -304,7 +439,7 @@ _create is a write-only trigger file (like /proc/sysrq-trigger):
 Every writable directory has a .error feedback file. After a failed write,
 cat the .error next to the file (or _create) you wrote to see what went wrong:
 
-  $ echo "priority: critical" >> issue.md  # invalid priority
+  $ sed -i 's/^priority: .*/priority: critical/' issue.md  # invalid priority
   $ cat .error
   Field: priority
   Value: "critical"
@@ -315,6 +450,8 @@ Failure model (every writable surface follows this contract):
 - A field longer than its limit (e.g. a too-long name) -> EMSGSIZE
 - Reference to something that doesn't exist (a relation target, rm of an unknown name) -> ENOENT
 - Rate-limited or timed out (the write did not take effect; retry shortly) -> EAGAIN
+- Linear unreachable while saving issue.md or posting a comment -> success: the
+  write is applied locally and queued in .linearfs/pending/ until sync sends it
 - Backend/API failure -> EIO
 - A mutation Linear accepted but whose local reflection fails after retries ->
   EIO, and the .error names the SAFE RECOVERY. For a create it NAMES the entity
@@ -1,6 +1,13 @@
 package fs
 
-import "strings"
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
 
 // reservedNames is the exact set of control literals a rendered fs name must
 // never collide with. They are the collectionTrio triggers (_create), the
@@ -35,7 +42,10 @@ var reservedNames = map[string]struct{}{
 // unique: two distinct remote names can never both escape into the same slot.
 //
 // The pass:
-//   - replaces /, \, NUL, and every C0 control char (< 0x20) with '-';
+//   - normalizes to Unicode NFC, so a name Linear stores decomposed ("e" plus a
+//     combining accent) renders as the same bytes as its composed twin;
+//   - replaces /, \, NUL, and every control char (C0, DEL, C1) with '-';
+//     invalid UTF-8 becomes U+FFFD;
 //   - trims trailing spaces and dots (a name ending in '.' or ' ' is a
 //     Windows/path footgun and "foo." collapses to "foo" on some layers);
 //   - if the result is "", ".", or ".." → returns id;
@@ -49,8 +59,8 @@ func safeName(raw, id string) string {
 	}
 	var b strings.Builder
 	b.Grow(len(raw))
-	for _, r := range raw {
-		if r == '/' || r == '\\' || r < 0x20 {
+	for _, r := range norm.NFC.String(raw) {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
 			b.WriteByte('-')
 			continue
 		}
@@ -66,3 +76,47 @@ func safeName(raw, id string) string {
 	}
 	return s
 }
+
+// uniqueNames is safeName over a whole listing: names[i] is item i's name, and
+// no two items share one. nameOf gives an item's raw name and id as safeName
+// takes them. Distinct remote names can sanitize alike ("a/b" and "a-b", "é"
+// composed and decomposed, or two labels both called "Bug"), and a listing
+// that showed both would resolve one of them nowhere. Of the items that land
+// on one name, the one with the smallest id keeps it and each other gets
+// "-<id>" appended, the reserved-literal escape. The choice does not depend on
+// the order the items come in, so a name is stable across listings until a
+// colliding entity appears or goes. Every listing that can collide builds its
+// names here and resolves a name by finding it in the result, so Readdir and
+// Lookup agree by construction.
+func uniqueNames[T any](items []T, nameOf func(T) (raw, id string)) []string {
+	names := make([]string, len(items))
+	ids := make([]string, len(items))
+	taken := make(map[string]int, len(items)) // name -> index of its owner
+	for i, it := range items {
+		raw, id := nameOf(it)
+		names[i], ids[i] = safeName(raw, id), id
+		if j, ok := taken[names[i]]; !ok || cmp.Less(id, ids[j]) {
+			taken[names[i]] = i
+		}
+	}
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int { return cmp.Compare(ids[a], ids[b]) })
+	for _, i := range order {
+		if taken[names[i]] == i {
+			continue
+		}
+		name := names[i]
+		for {
+			name = safeName(name+"-"+ids[i], ids[i])
+			if _, clash := taken[name]; !clash {
+				break
+			}
+		}
+		names[i] = name
+		taken[name] = i
+	}
+	return names
+}
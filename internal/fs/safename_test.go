@@ -1,8 +1,11 @@
 package fs
 
 import (
+	"slices"
 	"strings"
 	"testing"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/jra3/linear-fuse/internal/api"
 )
@@ -30,6 +33,9 @@ var hostileNames = []string{
 	"\x00",
 	"\x01\x02\x1f",
 	"tab\there",
+	"del\x7f",
+	"c1\u0085control",
+	"bad\xffutf8",
 	"new\nline",
 	"trailing   ",
 	"trailing...",
@@ -48,18 +54,21 @@ var hostileNames = []string{
 }
 
 // assertSafe checks the universal safety invariant every builder output must
-// satisfy: no path separators, no NUL, no C0 controls, never "", ".", "..",
-// and never exactly equal to a reserved control literal.
+// satisfy: no path separators, no NUL, no control chars, valid UTF-8, never
+// "", ".", "..", and never exactly equal to a reserved control literal.
 func assertSafe(t *testing.T, builder, raw, got string) {
 	t.Helper()
 	if strings.ContainsAny(got, "/\\\x00") {
 		t.Errorf("%s(%q) = %q: contains path separator or NUL", builder, raw, got)
 	}
 	for _, r := range got {
-		if r < 0x20 {
-			t.Errorf("%s(%q) = %q: contains C0 control char %#x", builder, raw, got, r)
+		if unicode.IsControl(r) {
+			t.Errorf("%s(%q) = %q: contains control char %#x", builder, raw, got, r)
 		}
 	}
+	if !utf8.ValidString(got) {
+		t.Errorf("%s(%q) = %q: not valid UTF-8", builder, raw, got)
+	}
 	if got == "" || got == "." || got == ".." {
 		t.Errorf("%s(%q) = %q: is empty/./.. (invalid path component)", builder, raw, got)
 	}
@@ -117,6 +126,50 @@ func TestSafeName_ContainingDotNotEscaped(t *testing.T) {
 	}
 }
 
+func TestSafeName_NFC(t *testing.T) {
+	composed, decomposed := "caf\u00e9", "cafe\u0301"
+	if got := safeName(decomposed, "id"); got != composed {
+		t.Errorf("safeName(%q) = %q, want the composed %q", decomposed, got, composed)
+	}
+	if got := safeName(composed, "id"); got != composed {
+		t.Errorf("safeName(%q) = %q, want it unchanged", composed, got)
+	}
+}
+
+// TestUniqueNames: names that sanitize alike are told apart by an -<id>
+// suffix on every one but the smallest id, whatever order they come in.
+func TestUniqueNames(t *testing.T) {
+	type named struct{ name, id string }
+	nameOf := func(n named) (string, string) { return n.name, n.id }
+	items := []named{
+		{"Bug", "lbl-b"},
+		{"a/b", "lbl-c"},
+		{"Bug", "lbl-a"},
+		{"a-b", "lbl-d"},
+		{"cafe\u0301", "lbl-f"},
+		{"caf\u00e9", "lbl-e"},
+		{"Feature", "lbl-g"},
+	}
+	want := []string{"Bug-lbl-b", "a-b", "Bug", "a-b-lbl-d", "caf\u00e9-lbl-f", "caf\u00e9", "Feature"}
+	if got := uniqueNames(items, nameOf); !slices.Equal(got, want) {
+		t.Errorf("uniqueNames = %q, want %q", got, want)
+	}
+
+	reversed := slices.Clone(items)
+	slices.Reverse(reversed)
+	got := uniqueNames(reversed, nameOf)
+	slices.Reverse(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("uniqueNames of the reversed listing = %q, want the same names %q", got, want)
+	}
+
+	// A suffixed name that another entity already holds is suffixed again.
+	clash := uniqueNames([]named{{"x", "1"}, {"x", "2"}, {"x-2", "3"}}, nameOf)
+	if !slices.Equal(clash, []string{"x", "x-2-2", "x-2"}) {
+		t.Errorf("uniqueNames with a suffix clash = %q", clash)
+	}
+}
+
 // --- Builder corpus: every name/target builder must produce a safe output for
 // every hostile input. ---
 
@@ -152,6 +205,13 @@ func TestBuilders_HostileCorpus(t *testing.T) {
 		// initiativeProjectDirName
 		assertSafe(t, "initiativeProjectDirName", raw, initiativeProjectDirName(api.InitiativeProject{ID: "ip-1", Slug: "ip-slug", Name: raw}))
 
+		// customViewDirName (saved view under /views/)
+		assertSafe(t, "customViewDirName", raw, customViewDirName(api.CustomView{ID: "cv-1", Name: raw}))
+
+		// issueDirNamer.name (templated issues/ listing name, via title)
+		namer := newIssueDirNamer("{identifier}-{slugified-title}")
+		assertSafe(t, "issueDirNamer.name", raw, namer.name(&api.Issue{ID: "iss-1", Identifier: "ENG-1", Title: raw}))
+
 		// assigneeHandle (by/assignee value)
 		assertSafe(t, "assigneeHandle", raw, assigneeHandle(&api.User{ID: "usr-2", DisplayName: raw}))
 
@@ -0,0 +1,108 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// scopeChangesName is a started cycle's mid-sprint scope log under
+// cycles/{name}/.
+const scopeChangesName = "scope-changes.md"
+
+// renderScopeChanges renders a cycle's scope-changes.md: the issues added to
+// it, removed from it and re-estimated in it after it started (before it
+// completed), as sync and webhooks recorded them (db.RecordScopeChanges).
+// Changes before the start are planning, not scope change, and are left out.
+// The file is timed at the last change shown.
+func renderScopeChanges(team api.Team, cycle api.Cycle, changes []api.ScopeChange) ([]byte, time.Time, time.Time) {
+	var added, removed, resized []api.ScopeChange
+	var delta float64
+	for _, c := range changes {
+		if c.At.Before(cycle.StartsAt) || (cycle.CompletedAt != nil && !c.At.Before(*cycle.CompletedAt)) {
+			continue
+		}
+		switch c.Kind {
+		case "added":
+			added = append(added, c)
+		case "removed":
+			removed = append(removed, c)
+		case "resized":
+			resized = append(resized, c)
+		default:
+			continue
+		}
+		delta += estimateOf(c.ToEstimate) - estimateOf(c.FromEstimate)
+	}
+
+	name := cycle.Name
+	if name == "" {
+		name = fmt.Sprintf("Cycle %d", cycle.Number)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s %s scope changes\n\n", team.Key, name)
+	fmt.Fprintf(&b, "Work added, removed and re-estimated since the cycle started %s (times UTC).\n", cycle.StartsAt.Format("Jan 2, 2006"))
+	shown := len(added) + len(removed) + len(resized)
+	if shown == 0 {
+		b.WriteString("\nNo scope changes.\n")
+		return []byte(b.String()), cycle.StartsAt, cycle.StartsAt
+	}
+	fmt.Fprintf(&b, "\nAdded %d, removed %d, resized %d: %+g points.\n", len(added), len(removed), len(resized), delta)
+
+	var last time.Time
+	section := func(title string, list []api.ScopeChange, detail func(api.ScopeChange) string) {
+		if len(list) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", title, len(list))
+		for _, c := range list {
+			fmt.Fprintf(&b, "- %s %s %s (%s)\n", c.At.UTC().Format("Jan 2 15:04"), c.Identifier, c.Title, detail(c))
+			if c.At.After(last) {
+				last = c.At
+			}
+		}
+	}
+	section("Added", added, func(c api.ScopeChange) string { return formatEstimate(c.ToEstimate) })
+	section("Removed", removed, func(c api.ScopeChange) string { return formatEstimate(c.FromEstimate) })
+	section("Resized", resized, func(c api.ScopeChange) string {
+		return formatEstimate(c.FromEstimate) + " → " + formatEstimate(c.ToEstimate)
+	})
+	return []byte(b.String()), last, cycle.StartsAt
+}
+
+func estimateOf(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
+
+// formatEstimate renders an estimate for scope-changes.md: "3 points", or
+// "unestimated".
+func formatEstimate(f *float64) string {
+	if f == nil {
+		return "unestimated"
+	}
+	if *f == 1 {
+		return "1 point"
+	}
+	return fmt.Sprintf("%g points", *f)
+}
+
+// lookupScopeChanges serves a started cycle's scope-changes.md, rendered on
+// each read so it follows changes as they are recorded.
+func (c *CycleDirNode) lookupScopeChanges(ctx context.Context, out *fuse.EntryOut, team api.Team, cycle api.Cycle) *fs.Inode {
+	return c.lookupRenderFile(ctx, out, scopeChangesName, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+		changes, err := c.lfs.repo.GetCycleScopeChanges(ctx, cycle.ID)
+		if err != nil {
+			log.Printf("Failed to read scope changes for cycle %s: %v", cycle.ID, err)
+		}
+		return renderScopeChanges(team, cycle, changes)
+	}, 0, inheritTimeout)
+}
@@ -0,0 +1,49 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestRenderScopeChanges(t *testing.T) {
+	t.Parallel()
+	team := api.Team{ID: "team-1", Key: "ENG"}
+	at := func(day, hour int) time.Time { return time.Date(2026, 10, day, hour, 0, 0, 0, time.UTC) }
+	completed := at(16, 0)
+	cycle := api.Cycle{ID: "cycle-1", Number: 42, StartsAt: at(2, 0), EndsAt: at(16, 0), CompletedAt: &completed}
+	one, two, five := 1.0, 2.0, 5.0
+	changes := []api.ScopeChange{
+		{At: at(1, 9), Kind: "added", Identifier: "ENG-1", Title: "Planned", ToEstimate: &two},
+		{At: at(3, 10), Kind: "added", Identifier: "ENG-2", Title: "Hotfix", ToEstimate: &one},
+		{At: at(5, 11), Kind: "resized", Identifier: "ENG-1", Title: "Planned", FromEstimate: &two, ToEstimate: &five},
+		{At: at(7, 12), Kind: "removed", Identifier: "ENG-3", Title: "Deferred", FromEstimate: &two},
+		{At: at(8, 13), Kind: "added", Identifier: "ENG-4", Title: "Spike"},
+		{At: at(16, 0), Kind: "removed", Identifier: "ENG-2", Title: "Hotfix", FromEstimate: &one},
+	}
+
+	data, mtime, _ := renderScopeChanges(team, cycle, changes)
+	got := string(data)
+	for _, want := range []string{
+		"# ENG Cycle 42 scope changes\n",
+		"Added 2, removed 1, resized 1: +2 points.\n",
+		"## Added (2)\n\n- Oct 3 10:00 ENG-2 Hotfix (1 point)\n- Oct 8 13:00 ENG-4 Spike (unestimated)\n",
+		"## Removed (1)\n\n- Oct 7 12:00 ENG-3 Deferred (2 points)\n",
+		"## Resized (1)\n\n- Oct 5 11:00 ENG-1 Planned (2 points → 5 points)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("scope changes missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Oct 1 ") || strings.Contains(got, "Oct 16 ") {
+		t.Errorf("changes before the start or at completion shown:\n%s", got)
+	}
+	if !mtime.Equal(at(8, 13)) {
+		t.Errorf("mtime = %v, want the last change shown", mtime)
+	}
+	if data, _, _ := renderScopeChanges(team, cycle, nil); !strings.Contains(string(data), "No scope changes.") {
+		t.Errorf("no changes:\n%s", data)
+	}
+}
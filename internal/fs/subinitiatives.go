@@ -0,0 +1,139 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// SubInitiativesNode is initiatives/{slug}/sub-initiatives/: one symlink per
+// direct child initiative, pointing at its sibling directory under
+// initiatives/. Every initiative keeps its one canonical directory at the top
+// level, so nesting never duplicates a subtree (or loops on a cyclic parent
+// link).
+type SubInitiativesNode struct {
+	attrNode
+	initiativeID string
+}
+
+var _ fs.NodeReaddirer = (*SubInitiativesNode)(nil)
+var _ fs.NodeLookuper = (*SubInitiativesNode)(nil)
+var _ fs.NodeGetattrer = (*SubInitiativesNode)(nil)
+
+func (n *SubInitiativesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	children, err := n.lfs.repo.GetChildInitiatives(ctx, n.initiativeID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(children))
+	for i, child := range children {
+		entries[i] = fuse.DirEntry{Name: initiativeDirName(child), Mode: syscall.S_IFLNK}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *SubInitiativesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	children, err := n.lfs.repo.GetChildInitiatives(ctx, n.initiativeID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, child := range children {
+		if initiativeDirName(child) == name {
+			// initiatives/{parent}/sub-initiatives/{child} -> initiatives/{child}.
+			// initiativeDirName is already safeName'd.
+			return n.newSymlinkInode(ctx, out, "../../"+name, child.CreatedAt, child.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
+
+// renderInitiativeRollup renders rollup.md for an initiative, reporting the
+// initiative's own times like the other generated files in its directory.
+func (lfs *LinearFS) renderInitiativeRollup(ctx context.Context, initiative api.Initiative) ([]byte, time.Time, time.Time) {
+	rollup, err := lfs.loadInitiativeRollup(ctx, initiative)
+	if err != nil {
+		return []byte("# Error loading rollup\n"), initiative.UpdatedAt, initiative.CreatedAt
+	}
+	return marshal.InitiativeRollupToMarkdown(rollup), initiative.UpdatedAt, initiative.CreatedAt
+}
+
+// loadInitiativeRollup walks the initiative's subtree depth-first (children
+// in the repo's sort order) and counts each initiative's linked projects and
+// their issues from SQLite. A project the sync worker hasn't stored yet
+// counts as a project with no issues.
+func (lfs *LinearFS) loadInitiativeRollup(ctx context.Context, root api.Initiative) (marshal.InitiativeRollup, error) {
+	rollup := marshal.InitiativeRollup{Name: root.Name}
+	visited := map[string]bool{}
+	counted := map[string]bool{} // project IDs already in Total
+
+	var walk func(init api.Initiative, depth int) error
+	walk = func(init api.Initiative, depth int) error {
+		// A parent cycle is malformed upstream data; cut it rather than recurse forever.
+		if visited[init.ID] {
+			return nil
+		}
+		visited[init.ID] = true
+
+		row := marshal.InitiativeRollupRow{Name: init.Name, Status: init.Status, Depth: depth}
+		for _, ref := range init.Projects.Nodes {
+			row.Projects++
+			project, err := lfs.repo.GetProjectByID(ctx, ref.ID)
+			if err != nil {
+				return err
+			}
+			issues, err := lfs.repo.GetIssuesByProject(ctx, ref.ID)
+			if err != nil {
+				return err
+			}
+			done := project != nil && project.State == "completed"
+			completed, canceled := 0, 0
+			for _, issue := range issues {
+				switch issue.State.Type {
+				case "completed":
+					completed++
+				case "canceled":
+					canceled++
+				}
+			}
+			if done {
+				row.ProjectsCompleted++
+			}
+			row.Issues += len(issues)
+			row.IssuesCompleted += completed
+			row.IssuesCanceled += canceled
+
+			if !counted[ref.ID] {
+				counted[ref.ID] = true
+				rollup.Total.Projects++
+				if done {
+					rollup.Total.ProjectsCompleted++
+				}
+				rollup.Total.Issues += len(issues)
+				rollup.Total.IssuesCompleted += completed
+				rollup.Total.IssuesCanceled += canceled
+			}
+		}
+		rollup.Rows = append(rollup.Rows, row)
+
+		children, err := lfs.repo.GetChildInitiatives(ctx, init.ID)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			if err := walk(child, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(root, 0); err != nil {
+		return marshal.InitiativeRollup{}, err
+	}
+	return rollup, nil
+}
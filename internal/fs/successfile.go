@@ -89,6 +89,7 @@ func (wf *writeFeedback) AppendWriteSuccess(key string, r WriteResult) {
 	wf.successes[key] = list
 	wf.successesMu.Unlock()
 	wf.invalidate(successIno(key))
+	wf.invalidate(lastCreatedIno(key))
 }
 
 // GetWriteSuccess returns a copy of the recorded successes for a collection key
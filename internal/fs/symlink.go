@@ -12,10 +12,10 @@ import (
 )
 
 // symlinkNode is the one symlink module behind every symlink view (issue
-// symlinks under by/, cycles/, recent/, projects/, users/, my/; project
-// symlinks under initiatives/; the cycles/current alias). The relative
-// target and entity timestamps are fixed at construction — Readlink and
-// Getattr only report them, so a view cannot grow per-call behaviour.
+// symlinks under by/, cycles/, recent/, projects/, initiatives/, users/,
+// my/; project symlinks under initiatives/; the cycles/current alias). The
+// relative target and entity timestamps are fixed at construction — Readlink
+// and Getattr only report them, so a view cannot grow per-call behaviour.
 type symlinkNode struct {
 	BaseNode
 	target    string
@@ -0,0 +1,108 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// Team presentation metadata.
+//
+// Linear shows a team with its icon, color and description. A wrapper that
+// builds a UI over the mount wants the same without parsing team.md, so the
+// team directory carries them two ways: .team.json, a read-only JSON file
+// beside team.md, and extended attributes on the directory itself
+// (`getfattr -d teams/ENG`), one per field that is set. Both are read from the
+// team row the last workspace sync stored.
+
+// teamJSONName is the team directory's JSON metadata file.
+const teamJSONName = ".team.json"
+
+// teamXattrPrefix namespaces the team directory's xattrs. Only the user.
+// namespace is open to unprivileged readers.
+const teamXattrPrefix = "user.linear."
+
+var _ fs.NodeGetxattrer = (*TeamNode)(nil)
+var _ fs.NodeListxattrer = (*TeamNode)(nil)
+
+// teamMeta is .team.json's shape. Every field is always present, "" when
+// Linear has none, so a reader can rely on the keys.
+type teamMeta struct {
+	ID          string    `json:"id"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	Icon        string    `json:"icon"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	Private     bool      `json:"private"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// teamJSON renders .team.json for team.
+func teamJSON(team api.Team) []byte {
+	data, err := json.MarshalIndent(teamMeta{
+		ID:          team.ID,
+		Key:         team.Key,
+		Name:        team.Name,
+		Icon:        team.Icon,
+		Color:       team.Color,
+		Description: team.Description,
+		Private:     team.Private,
+		CreatedAt:   team.CreatedAt,
+		UpdatedAt:   team.UpdatedAt,
+	}, "", "  ")
+	if err != nil {
+		return []byte("{}\n")
+	}
+	return append(data, '\n')
+}
+
+// teamXattrs are the team directory's xattrs in listing order: icon, color
+// and description, each only when set.
+func teamXattrs(team api.Team) [][2]string {
+	var attrs [][2]string
+	for _, kv := range [][2]string{
+		{"icon", team.Icon},
+		{"color", team.Color},
+		{"description", team.Description},
+	} {
+		if kv[1] != "" {
+			attrs = append(attrs, [2]string{teamXattrPrefix + kv[0], kv[1]})
+		}
+	}
+	return attrs
+}
+
+// Getxattr reads one of the team's xattrs. A field the team does not have is
+// ENODATA, as for any unset attribute.
+func (t *TeamNode) Getxattr(ctx context.Context, attr string, dest []byte) (uint32, syscall.Errno) {
+	for _, kv := range teamXattrs(t.entity()) {
+		if kv[0] == attr {
+			return copyXattr(dest, []byte(kv[1]))
+		}
+	}
+	return 0, syscall.ENODATA
+}
+
+// Listxattr lists the team's xattr names, NUL-terminated.
+func (t *TeamNode) Listxattr(ctx context.Context, dest []byte) (uint32, syscall.Errno) {
+	var names []byte
+	for _, kv := range teamXattrs(t.entity()) {
+		names = append(append(names, kv[0]...), 0)
+	}
+	return copyXattr(dest, names)
+}
+
+// copyXattr fills dest with value under the xattr calls' size protocol: a
+// dest too small for value gets ERANGE and the size needed.
+func copyXattr(dest, value []byte) (uint32, syscall.Errno) {
+	if len(dest) < len(value) {
+		return uint32(len(value)), syscall.ERANGE
+	}
+	return uint32(copy(dest, value)), 0
+}
@@ -0,0 +1,57 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"syscall"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestTeamJSON(t *testing.T) {
+	t.Parallel()
+	team := api.Team{ID: "team-1", Key: "ENG", Name: "Engineering", Icon: "Rocket", Color: "#5e6ad2", Description: "Platform work"}
+	var got teamMeta
+	if err := json.Unmarshal(teamJSON(team), &got); err != nil {
+		t.Fatalf(".team.json is not JSON: %v", err)
+	}
+	if got.Key != "ENG" || got.Icon != "Rocket" || got.Color != "#5e6ad2" || got.Description != "Platform work" {
+		t.Errorf(".team.json = %+v", got)
+	}
+
+	var keys map[string]any
+	if err := json.Unmarshal(teamJSON(api.Team{ID: "team-2", Key: "OPS"}), &keys); err != nil {
+		t.Fatalf(".team.json is not JSON: %v", err)
+	}
+	for _, k := range []string{"icon", "color", "description"} {
+		if v, ok := keys[k]; !ok || v != "" {
+			t.Errorf(".team.json %s = %v, %v; want present and empty", k, v, ok)
+		}
+	}
+}
+
+// TestTeamXattrs: the team dir lists an xattr per set field, reads each back,
+// answers a size probe, and reports an unset field as ENODATA.
+func TestTeamXattrs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	node := &TeamNode{entityCell: entityCell[api.Team]{val: api.Team{ID: "team-1", Key: "ENG", Color: "#5e6ad2", Description: "Platform work"}}}
+
+	buf := make([]byte, 256)
+	n, errno := node.Listxattr(ctx, buf)
+	if errno != 0 || string(buf[:n]) != "user.linear.color\x00user.linear.description\x00" {
+		t.Errorf("Listxattr = %q, %v", buf[:n], errno)
+	}
+
+	if size, errno := node.Getxattr(ctx, "user.linear.description", nil); errno != syscall.ERANGE || size != uint32(len("Platform work")) {
+		t.Errorf("size probe = %d, %v; want %d, ERANGE", size, errno, len("Platform work"))
+	}
+	n, errno = node.Getxattr(ctx, "user.linear.color", buf)
+	if errno != 0 || string(buf[:n]) != "#5e6ad2" {
+		t.Errorf("Getxattr(color) = %q, %v", buf[:n], errno)
+	}
+	if _, errno := node.Getxattr(ctx, "user.linear.icon", buf); errno != syscall.ENODATA {
+		t.Errorf("Getxattr(icon) on a team without one = %v, want ENODATA", errno)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hanwen/go-fuse/v2/fs"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
 // TeamsNode represents the /teams directory. Stateless container: zero times
@@ -30,7 +31,7 @@ func (t *TeamsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 	entries := make([]fuse.DirEntry, len(teams))
 	for i, team := range teams {
 		entries[i] = fuse.DirEntry{
-			Name: safeName(team.Key, team.ID),
+			Name: t.lfs.iconDirName(team.Icon, safeName(team.Key, team.ID)),
 			Mode: syscall.S_IFDIR,
 		}
 	}
@@ -45,7 +46,7 @@ func (t *TeamsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 	}
 
 	for _, team := range teams {
-		if team.Key == name {
+		if t.lfs.iconDirNameMatches(name, team.Icon, team.Key) {
 			node := &TeamNode{attrNode: attrNode{BaseNode: BaseNode{lfs: t.lfs}}, entityCell: entityCell[api.Team]{val: team}}
 			return t.newDirInode(ctx, out, name, node, dirAttr(team.CreatedAt, team.UpdatedAt), teamDirIno(team.ID), inheritTimeout), 0
 		}
@@ -76,9 +77,15 @@ func (t *TeamNode) refreshFrom(fresh fs.InodeEmbedder) {
 func (t *TeamNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 	entries := []fuse.DirEntry{
 		{Name: "team.md", Mode: syscall.S_IFREG},
+		{Name: teamJSONName, Mode: syscall.S_IFREG},
 		{Name: "states.md", Mode: syscall.S_IFREG},
 		{Name: "labels.md", Mode: syscall.S_IFREG},
 		{Name: "project-labels.md", Mode: syscall.S_IFLNK},
+		{Name: "graph.dot", Mode: syscall.S_IFREG},
+		{Name: "graph.json", Mode: syscall.S_IFREG},
+		{Name: needsAttentionName, Mode: syscall.S_IFREG},
+		{Name: teamMetricsName, Mode: syscall.S_IFREG},
+		{Name: teamLintName, Mode: syscall.S_IFREG},
 		{Name: "by", Mode: syscall.S_IFDIR},
 		{Name: "cycles", Mode: syscall.S_IFDIR},
 		{Name: "projects", Mode: syscall.S_IFDIR},
@@ -86,6 +93,10 @@ func (t *TeamNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 		{Name: "recent", Mode: syscall.S_IFDIR},
 		{Name: "docs", Mode: syscall.S_IFDIR},
 		{Name: "labels", Mode: syscall.S_IFDIR},
+		{Name: "templates", Mode: syscall.S_IFDIR},
+	}
+	if len(t.lfs.views) > 0 {
+		entries = append(entries, fuse.DirEntry{Name: "views", Mode: syscall.S_IFDIR})
 	}
 
 	return fs.NewListDirStream(entries), 0
@@ -99,6 +110,11 @@ func (t *TeamNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 			return teamMarkdown(team), team.UpdatedAt, team.CreatedAt
 		}, 0, inheritTimeout), 0
 
+	case teamJSONName:
+		return t.lookupRenderFile(ctx, out, name, func(context.Context) ([]byte, time.Time, time.Time) {
+			return teamJSON(team), team.UpdatedAt, team.CreatedAt
+		}, 0, inheritTimeout), 0
+
 	case "states.md":
 		// states.md has no single mtime (it lists a collection); report the
 		// team's times as a stable proxy — never now(). Content is fetched from
@@ -122,6 +138,57 @@ func (t *TeamNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 			return labelsMarkdown(team, labels), team.UpdatedAt, team.CreatedAt
 		}, 0, inheritTimeout), 0
 
+	case needsAttentionName:
+		// Staleness and SLA nudges, computed against the clock on each read.
+		// Like states.md it reports the team's times.
+		lfs := t.lfs
+		return t.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			issues, err := lfs.repo.GetTeamIssues(ctx, team.ID)
+			if err != nil {
+				return []byte("# Error loading issues\n"), team.UpdatedAt, team.CreatedAt
+			}
+			stale, slaWarning := attentionThresholds(lfs.attention)
+			return renderNeedsAttention(team, issues, stale, slaWarning, time.Now()), team.UpdatedAt, team.CreatedAt
+		}, 0, inheritTimeout), 0
+
+	case teamLintName:
+		// Rule findings over the cached issues and their attachments,
+		// recomputed on each read.
+		lfs := t.lfs
+		return t.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			issues, ierr := lfs.repo.GetTeamIssues(ctx, team.ID)
+			attachments, aerr := lfs.repo.GetTeamAttachments(ctx, team.ID)
+			if ierr != nil || aerr != nil {
+				return []byte("# Error loading issues\n"), team.UpdatedAt, team.CreatedAt
+			}
+			return renderTeamLint(team, issues, attachments, lfs.lint), team.UpdatedAt, team.CreatedAt
+		}, 0, inheritTimeout), 0
+
+	case teamMetricsName:
+		// Flow metrics over the team's completed issues, recomputed on each
+		// read. Like states.md it reports the team's times.
+		lfs := t.lfs
+		return t.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			issues, err := lfs.repo.GetTeamIssues(ctx, team.ID)
+			if err != nil {
+				return []byte("# Error loading issues\n"), team.UpdatedAt, team.CreatedAt
+			}
+			return renderTeamMetrics(team, issues), team.UpdatedAt, team.CreatedAt
+		}, 0, inheritTimeout), 0
+
+	case "graph.dot", "graph.json":
+		// Dependency-graph export over the team's issues: parent/child links
+		// and relations. Like states.md it reports the team's times.
+		lfs := t.lfs
+		return t.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			content := renderIssueGraph(ctx, name, team.Key,
+				func(ctx context.Context) ([]api.Issue, error) { return lfs.repo.GetTeamIssues(ctx, team.ID) },
+				func(ctx context.Context) ([]api.IssueRelation, error) {
+					return lfs.repo.GetTeamIssueRelations(ctx, team.ID)
+				})
+			return content, team.UpdatedAt, team.CreatedAt
+		}, 0, inheritTimeout), 0
+
 	case "project-labels.md":
 		// Ergonomics alias beside states.md/labels.md, where agents already
 		// look for validation references. A symlink (not a per-team file)
@@ -158,6 +225,14 @@ func (t *TeamNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 		na := nodeAttr{mode: 0555 | syscall.S_IFDIR, created: team.CreatedAt, updated: team.UpdatedAt}
 		return t.newDirInode(ctx, out, name, node, na, recentDirIno(team.ID), inheritTimeout), 0
 
+	case "views":
+		if len(t.lfs.views) == 0 {
+			return nil, syscall.ENOENT
+		}
+		node := &ViewsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: t.lfs}}, entityCell: entityCell[api.Team]{val: team}}
+		na := nodeAttr{mode: 0555 | syscall.S_IFDIR, created: team.CreatedAt, updated: team.UpdatedAt}
+		return t.newDirInode(ctx, out, name, node, na, teamViewsDirIno(team.ID), inheritTimeout), 0
+
 	case "docs":
 		node := &DocsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: t.lfs}}, teamID: team.ID}
 		return t.newDirInode(ctx, out, "docs", node, dirAttr(team.CreatedAt, team.UpdatedAt), docsDirIno(team.ID), 0), 0
@@ -165,6 +240,12 @@ func (t *TeamNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 	case "labels":
 		node := &LabelsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: t.lfs}}, teamID: team.ID}
 		return t.newDirInode(ctx, out, "labels", node, dirAttr(team.CreatedAt, team.UpdatedAt), labelsDirIno(team.ID), 0), 0
+
+	case "templates":
+		node := &TemplatesNode{attrNode: attrNode{BaseNode: BaseNode{lfs: t.lfs}}, entityCell: entityCell[api.Team]{val: team}}
+		// 0555: templates are edited in Linear; copy one out to use it.
+		na := nodeAttr{mode: 0555 | syscall.S_IFDIR, created: team.CreatedAt, updated: team.UpdatedAt}
+		return t.newDirInode(ctx, out, name, node, na, templatesDirIno(team.ID), inheritTimeout), 0
 	}
 
 	return nil, syscall.ENOENT
@@ -178,8 +259,11 @@ func teamMarkdown(team api.Team) []byte {
 		"key":     team.Key,
 		"name":    team.Name,
 		"icon":    team.Icon,
-		"created": team.CreatedAt.Format(time.RFC3339),
-		"updated": team.UpdatedAt.Format(time.RFC3339),
+		"created": marshal.FormatTimestamp(team.CreatedAt),
+		"updated": marshal.FormatTimestamp(team.UpdatedAt),
+	}
+	if team.Private {
+		fm["private"] = true
 	}
 	body := fmt.Sprintf(`
 # %s
@@ -76,4 +76,22 @@ func TestTeamCatalogHostileNames(t *testing.T) {
 			t.Errorf("team.md body missing the key bullet:\n%s", content)
 		}
 	})
+
+	t.Run("team.md private", func(t *testing.T) {
+		t.Parallel()
+		private := team
+		private.Private = true
+		for _, tc := range []struct {
+			team api.Team
+			want any
+		}{{team, nil}, {private, true}} {
+			doc, err := marshal.Parse(teamMarkdown(tc.team))
+			if err != nil {
+				t.Fatalf("team.md render is not parseable YAML frontmatter: %v", err)
+			}
+			if got := doc.Frontmatter["private"]; got != tc.want {
+				t.Errorf("private = %v, want %v", got, tc.want)
+			}
+		}
+	})
 }
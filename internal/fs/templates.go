@@ -0,0 +1,143 @@
+package fs
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TemplatesNode is teams/{KEY}/templates/: one read-only .md file per issue
+// template defined on the team in Linear. Each renders in the issues/_create
+// spec format, so `cp templates/Bug.md issues/_create` files an issue
+// pre-filled from the template (copy it elsewhere first to edit the title).
+type TemplatesNode struct {
+	attrNode
+	entityCell[api.Team]
+}
+
+var _ fs.NodeReaddirer = (*TemplatesNode)(nil)
+var _ fs.NodeLookuper = (*TemplatesNode)(nil)
+var _ fs.NodeGetattrer = (*TemplatesNode)(nil)
+
+// entity()/setEntity() are promoted from the embedded entityCell[api.Team].
+// refreshFrom is the nodeRefresher seam (refresh.go).
+func (n *TemplatesNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*TemplatesNode); ok {
+		n.setEntity(f.entity())
+	}
+}
+
+func (n *TemplatesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	templates, err := n.lfs.repo.GetTeamTemplates(ctx, n.entity().ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	names := templateFileNames(templates)
+	entries := make([]fuse.DirEntry, len(names))
+	for i, name := range names {
+		entries[i] = fuse.DirEntry{Name: name, Mode: syscall.S_IFREG}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *TemplatesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	team := n.entity()
+	templates, err := n.lfs.repo.GetTeamTemplates(ctx, team.ID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for i, fileName := range templateFileNames(templates) {
+		if fileName != name {
+			continue
+		}
+		t, lfs := templates[i], n.lfs
+		return n.lookupRenderFile(ctx, out, name, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			return lfs.renderTemplate(ctx, team, t), t.UpdatedAt, t.CreatedAt
+		}, templateIno(t.ID), inheritTimeout), 0
+	}
+	return nil, syscall.ENOENT
+}
+
+// templateFileNames are the templates' file names, in the order given: each
+// its name as shown in Linear, with names two templates share (Linear allows
+// it) made unique by uniqueNames.
+func templateFileNames(templates []api.Template) []string {
+	names := uniqueNames(templates, func(t api.Template) (string, string) { return t.Name, t.ID }) // safename:ok uniqueNames safeNames it
+	for i := range names {
+		names[i] += ".md"
+	}
+	return names
+}
+
+// renderTemplate resolves a template's state, label and assignee IDs against
+// the cached team catalogs and renders it (templateMarkdown). A catalog that
+// fails to load leaves its IDs unresolved rather than failing the read.
+func (lfs *LinearFS) renderTemplate(ctx context.Context, team api.Team, t api.Template) []byte {
+	data, err := t.IssueData()
+	if err != nil {
+		return []byte("# Error decoding template\n")
+	}
+	names := templateNames{states: map[string]string{}, labels: map[string]string{}, users: map[string]string{}}
+	if states, err := lfs.repo.GetTeamStates(ctx, team.ID); err == nil {
+		for _, s := range states {
+			names.states[s.ID] = s.Name
+		}
+	}
+	if labels, err := lfs.repo.GetTeamLabels(ctx, team.ID); err == nil {
+		for _, l := range labels {
+			names.labels[l.ID] = l.Name
+		}
+	}
+	if data.AssigneeID != "" {
+		if users, err := lfs.repo.GetUsers(ctx); err == nil {
+			for _, u := range users {
+				names.users[u.ID] = u.Email
+			}
+		}
+	}
+	return templateMarkdown(data, names)
+}
+
+// templateNames maps catalog IDs to the names issues/_create resolves:
+// state and label names, user emails.
+type templateNames struct {
+	states, labels, users map[string]string
+}
+
+// templateMarkdown renders an issue template as an issues/_create spec:
+// frontmatter under the keys MarkdownToIssueCreate reads, the template's
+// description as the body. An ID with no name in the catalog is omitted —
+// _create resolves names only, so emitting the raw ID would make the copy
+// fail to create.
+func templateMarkdown(d api.TemplateIssueData, names templateNames) []byte {
+	fm := map[string]any{"title": d.Title}
+	if name := names.states[d.StateID]; name != "" {
+		fm["status"] = name
+	}
+	if email := names.users[d.AssigneeID]; email != "" {
+		fm["assignee"] = email
+	}
+	if d.DueDate != "" {
+		fm["due"] = d.DueDate
+	}
+	if d.Priority != nil {
+		fm["priority"] = api.PriorityName(*d.Priority)
+	}
+	if d.Estimate != nil {
+		fm["estimate"] = *d.Estimate
+	}
+	var labels []string
+	for _, id := range d.LabelIDs {
+		if name := names.labels[id]; name != "" {
+			labels = append(labels, name)
+		}
+	}
+	if len(labels) > 0 {
+		fm["labels"] = labels
+	}
+	return renderWithFrontmatter(fm, d.Description)
+}
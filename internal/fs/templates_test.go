@@ -0,0 +1,69 @@
+package fs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// TestTemplateMarkdownRoundTrip: a rendered template parses back through
+// MarkdownToIssueCreate into the create input it describes — names where
+// _create resolves names — and an ID missing from the catalogs is dropped
+// rather than emitted raw.
+func TestTemplateMarkdownRoundTrip(t *testing.T) {
+	t.Parallel()
+	priority, estimate := 2, 3.0
+	data := api.TemplateIssueData{
+		Title:       "Bug: ",
+		Description: "## Steps to reproduce\n",
+		Priority:    &priority,
+		Estimate:    &estimate,
+		StateID:     "st-triage",
+		AssigneeID:  "u-gone",
+		LabelIDs:    []string{"l-bug", "l-gone"},
+	}
+	names := templateNames{
+		states: map[string]string{"st-triage": "Triage"},
+		labels: map[string]string{"l-bug": "Bug"},
+		users:  map[string]string{},
+	}
+
+	create, err := marshal.MarkdownToIssueCreate(templateMarkdown(data, names))
+	if err != nil {
+		t.Fatalf("MarkdownToIssueCreate: %v", err)
+	}
+	want := map[string]any{
+		"title":       "Bug: ",
+		"stateId":     "Triage",
+		"priority":    2,
+		"estimate":    3,
+		"labelIds":    []string{"Bug"},
+		"description": "## Steps to reproduce\n",
+	}
+	for k, v := range want {
+		if !reflect.DeepEqual(create[k], v) {
+			t.Errorf("create[%q] = %#v, want %#v", k, create[k], v)
+		}
+	}
+	if _, ok := create["assigneeId"]; ok {
+		t.Errorf("unresolved assignee emitted: %#v", create["assigneeId"])
+	}
+}
+
+func TestTemplateFileNames(t *testing.T) {
+	t.Parallel()
+	got := templateFileNames([]api.Template{
+		{ID: "tpl-2", Name: "Bug report"},
+		{ID: "tpl-1", Name: "a/b"},
+		{ID: "tpl-1b", Name: "Bug report"},
+	})
+	// The smaller id keeps the shared name.
+	if got[0] != "Bug report-tpl-2.md" || got[2] != "Bug report.md" {
+		t.Errorf("templateFileNames = %q, want both Bug report templates listed", got)
+	}
+	if got[1] == "a/b.md" {
+		t.Errorf("templateFileNames passed a slash through: %q", got[1])
+	}
+}
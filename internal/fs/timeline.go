@@ -0,0 +1,52 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// timelineFiles are the Gantt-style exports a project directory carries, one
+// per format. Both render the same marshal.ProjectTimeline.
+var timelineFiles = []string{"timeline.csv", "timeline.json"}
+
+// renderProjectTimeline loads the project's milestones and issues and renders
+// its timeline in the format named by file. A load failure renders as an
+// error row (CSV) or error object (JSON), so the file stays readable and says
+// why it is empty.
+func (lfs *LinearFS) renderProjectTimeline(ctx context.Context, file string, project api.Project) []byte {
+	tl, err := lfs.loadProjectTimeline(ctx, project)
+	var data []byte
+	if err == nil {
+		if path.Ext(file) == ".json" {
+			data, err = marshal.ProjectTimelineToJSON(tl)
+		} else {
+			data, err = marshal.ProjectTimelineToCSV(tl)
+		}
+	}
+	if err == nil {
+		return data
+	}
+	if path.Ext(file) == ".json" {
+		return jsonErrorBody("loading timeline: " + err.Error())
+	}
+	return []byte(fmt.Sprintf("error,\"%s\"\n", strings.ReplaceAll("loading timeline: "+err.Error(), `"`, `""`)))
+}
+
+// loadProjectTimeline assembles the timeline from SQLite: milestones and the
+// project's issues (which may span teams).
+func (lfs *LinearFS) loadProjectTimeline(ctx context.Context, project api.Project) (marshal.ProjectTimeline, error) {
+	milestones, err := lfs.repo.GetProjectMilestones(ctx, project.ID)
+	if err != nil {
+		return marshal.ProjectTimeline{}, err
+	}
+	issues, err := lfs.repo.GetIssuesByProject(ctx, project.ID)
+	if err != nil {
+		return marshal.ProjectTimeline{}, err
+	}
+	return marshal.BuildProjectTimeline(project, milestones, issues), nil
+}
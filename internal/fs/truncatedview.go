@@ -0,0 +1,48 @@
+package fs
+
+import (
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/marshal"
+)
+
+// Truncated views of huge bodies.
+//
+// A description or comment over marshal.BodyViewLimit (a pasted multi-MB log)
+// shows cut in issue.md or its comment file, ending in a marker line
+// (marshal.TruncatedView). SQLite keeps the whole text, and a read-only
+// "{base}.full.md" sibling serves it: issue.full.md beside issue.md, and
+// {date}-{id}.full.md beside a comment. The sibling is listed only while the
+// body is cut. Saving the cut file keeps the hidden remainder
+// (marshal.RestoreTruncatedBody), so an edit above the marker never drops
+// the rest of the log.
+
+// issueFullName is issue.md's full-content sibling.
+var issueFullName = fullSiblingName("issue.md")
+
+// fullSiblingName maps a file to its full-content sibling: "X.md" ->
+// "X.full.md".
+func fullSiblingName(mdName string) string {
+	return strings.TrimSuffix(mdName, ".md") + ".full.md"
+}
+
+// fullSiblingSource maps a possible sibling name back to the file it
+// completes: "X.full.md" -> ("X.md", true). Any other name is a miss.
+func fullSiblingSource(name string) (string, bool) {
+	base, ok := strings.CutSuffix(name, ".full.md")
+	if !ok || base == "" {
+		return "", false
+	}
+	return base + ".md", true
+}
+
+// bodyView is the body a file shows for body: linked through l, and cut and
+// marked (naming the sibling full) when it is over the view limit. The cut is
+// made on the raw body, so the save side can restore against it.
+func bodyView(l marshal.CrossLinker, body, full string) string {
+	head, cut := marshal.TruncateBody(body)
+	if !cut {
+		return l.Link(body)
+	}
+	return marshal.TruncatedView(l.Link(head), body, full)
+}
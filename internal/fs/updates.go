@@ -7,6 +7,7 @@ import (
 	"github.com/hanwen/go-fuse/v2/fs"
 	"github.com/hanwen/go-fuse/v2/fuse"
 	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/marshal"
 )
 
 // lookupUpdateFile serves a read-only status-update file (project or initiative)
@@ -33,8 +34,8 @@ func updateMarkdown(id, health string, created, updated time.Time, user *api.Use
 	fm := map[string]any{
 		"id":      id,
 		"health":  health,
-		"created": created.Format(time.RFC3339),
-		"updated": updated.Format(time.RFC3339),
+		"created": marshal.FormatTimestamp(created),
+		"updated": marshal.FormatTimestamp(updated),
 	}
 	if user != nil {
 		fm["author"] = user.Email
@@ -3,6 +3,7 @@ package fs
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -28,10 +29,11 @@ func (u *UsersNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 		return nil, syscall.EIO
 	}
 
+	names := userDirNames(users)
 	entries := make([]fuse.DirEntry, len(users))
-	for i, user := range users {
+	for i := range users {
 		entries[i] = fuse.DirEntry{
-			Name: userDirName(user),
+			Name: names[i],
 			Mode: syscall.S_IFDIR,
 		}
 	}
@@ -45,33 +47,41 @@ func (u *UsersNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 		return nil, syscall.EIO
 	}
 
-	for _, user := range users {
-		if userDirName(user) == name {
-			// api.User carries no time fields; the dir honestly reports zero
-			// (unknown) rather than a fabricated now().
-			node := &UserNode{attrNode: attrNode{BaseNode: BaseNode{lfs: u.lfs}}, entityCell: entityCell[api.User]{val: user}}
-			return u.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), userDirIno(user.ID), inheritTimeout), 0
-		}
+	if i := slices.Index(userDirNames(users), name); i >= 0 {
+		user := users[i]
+		// api.User carries no time fields; the dir honestly reports zero
+		// (unknown) rather than a fabricated now().
+		node := &UserNode{attrNode: attrNode{BaseNode: BaseNode{lfs: u.lfs}}, entityCell: entityCell[api.User]{val: user}}
+		return u.newDirInode(ctx, out, name, node, dirAttr(time.Time{}, time.Time{}), userDirIno(user.ID), inheritTimeout), 0
 	}
 
 	return nil, syscall.ENOENT
 }
 
+// userHandle is a user's raw handle: the display name, or the email's local
+// part when the display name is not set. users/ and by/assignee/ both name a
+// user by it.
+func userHandle(user api.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName // safename:ok raw handle; callers safeName it
+	}
+	if idx := strings.Index(user.Email, "@"); idx != -1 {
+		return user.Email[:idx]
+	}
+	return user.Email
+}
+
 // userDirName returns the directory name for a user (display name, or email
 // local part). safeName is the final safety pass over the chosen handle
 // (traversal/control chars, empty fallback to the user ID).
 func userDirName(user api.User) string {
-	// Use DisplayName as directory name (this is the user's handle)
-	handle := user.DisplayName
-	if handle == "" {
-		// Fallback to email local part if DisplayName not set
-		if idx := strings.Index(user.Email, "@"); idx != -1 {
-			handle = user.Email[:idx]
-		} else {
-			handle = user.Email
-		}
-	}
-	return safeName(handle, user.ID)
+	return safeName(userHandle(user), user.ID)
+}
+
+// userDirNames is users/'s listing: userDirName of each user, with handles
+// two users share made unique by uniqueNames.
+func userDirNames(users []api.User) []string {
+	return uniqueNames(users, func(u api.User) (string, string) { return userHandle(u), u.ID })
 }
 
 // UserNode represents a single user's directory (e.g., /users/alice).
@@ -101,14 +111,18 @@ func (u *UserNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
 		return nil, syscall.EIO
 	}
 
-	// +1 for user.md
-	entries := make([]fuse.DirEntry, len(issues)+1)
+	// +2 for user.md and workload.md
+	entries := make([]fuse.DirEntry, len(issues)+2)
 	entries[0] = fuse.DirEntry{
 		Name: "user.md",
 		Mode: syscall.S_IFREG,
 	}
+	entries[1] = fuse.DirEntry{
+		Name: workloadName,
+		Mode: syscall.S_IFREG,
+	}
 	for i, issue := range issues {
-		entries[i+1] = fuse.DirEntry{
+		entries[i+2] = fuse.DirEntry{
 			Name: issue.Identifier,
 			Mode: syscall.S_IFLNK, // Symlink to issue directory
 		}
@@ -126,6 +140,18 @@ func (u *UserNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut)
 			return userMarkdown(user), time.Time{}, time.Time{}
 		}, 0, inheritTimeout), 0
 	}
+	// workload.md summarizes the same assignee index the symlinks list, so it
+	// reports zero times too: its content turns on many issues, none of which
+	// own the file.
+	if name == workloadName {
+		return u.lookupRenderFile(ctx, out, workloadName, func(ctx context.Context) ([]byte, time.Time, time.Time) {
+			issues, err := u.lfs.repo.GetUserIssues(ctx, user.ID)
+			if err != nil {
+				return []byte("# Error loading workload\n"), time.Time{}, time.Time{}
+			}
+			return workloadMarkdown(user, issues), time.Time{}, time.Time{}
+		}, 0, inheritTimeout), 0
+	}
 
 	issues, err := u.lfs.repo.GetUserIssues(ctx, user.ID)
 	if err != nil {
@@ -0,0 +1,153 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/view"
+)
+
+// customView is one compiled `views:` config entry.
+type customView struct {
+	name   string
+	filter *view.Filter
+}
+
+// compileViews validates the configured views and parses their filters. A
+// view name is a directory name the user chose, so unlike remote names it is
+// rejected (failing the mount) rather than escaped: a typo'd config should be
+// loud, not silently renamed.
+func compileViews(cfgs []config.ViewConfig) ([]customView, error) {
+	views := make([]customView, 0, len(cfgs))
+	seen := make(map[string]bool, len(cfgs))
+	for i, vc := range cfgs {
+		// A name safeName would change is not one the mount can show as is.
+		if safeName(vc.Name, vc.Name) != vc.Name {
+			return nil, fmt.Errorf("views[%d]: invalid name %q: must be a single NFC path component, not reserved and not ending in a space or dot", i, vc.Name)
+		}
+		if seen[vc.Name] {
+			return nil, fmt.Errorf("views[%d]: duplicate name %q", i, vc.Name)
+		}
+		seen[vc.Name] = true
+		f, err := view.Parse(vc.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("views[%d] %q: %w", i, vc.Name, err)
+		}
+		views = append(views, customView{name: vc.Name, filter: f})
+	}
+	return views, nil
+}
+
+// ViewsNode is teams/{KEY}/views/: one directory per config-defined view.
+// Present only when views are configured.
+type ViewsNode struct {
+	attrNode
+	entityCell[api.Team]
+}
+
+var _ fs.NodeReaddirer = (*ViewsNode)(nil)
+var _ fs.NodeLookuper = (*ViewsNode)(nil)
+var _ fs.NodeGetattrer = (*ViewsNode)(nil)
+
+// entity()/setEntity() are promoted from the embedded entityCell[api.Team].
+// refreshFrom is the nodeRefresher seam (refresh.go).
+func (n *ViewsNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*ViewsNode); ok {
+		n.setEntity(f.entity())
+	}
+}
+
+func (n *ViewsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	entries := make([]fuse.DirEntry, len(n.lfs.views))
+	for i, v := range n.lfs.views {
+		entries[i] = fuse.DirEntry{Name: v.name, Mode: syscall.S_IFDIR}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *ViewsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	team := n.entity()
+	for i := range n.lfs.views {
+		if n.lfs.views[i].name != name {
+			continue
+		}
+		node := &ViewNode{attrNode: attrNode{BaseNode: BaseNode{lfs: n.lfs}}, entityCell: entityCell[api.Team]{val: team}, view: &n.lfs.views[i]}
+		// 0555: read-only view, like recent/.
+		na := nodeAttr{mode: 0555 | syscall.S_IFDIR, created: team.CreatedAt, updated: team.UpdatedAt}
+		return n.newDirInode(ctx, out, name, node, na, teamViewIno(team.ID, name), inheritTimeout), 0
+	}
+	return nil, syscall.ENOENT
+}
+
+// ViewNode is teams/{KEY}/views/{name}/: symlinks to the team's issues that
+// match the view's filter, evaluated against SQLite on every listing. The
+// view is immutable identity; the team snapshot is the volatile half.
+type ViewNode struct {
+	attrNode
+	entityCell[api.Team]
+	view *customView
+}
+
+var _ fs.NodeReaddirer = (*ViewNode)(nil)
+var _ fs.NodeLookuper = (*ViewNode)(nil)
+var _ fs.NodeGetattrer = (*ViewNode)(nil)
+
+func (n *ViewNode) refreshFrom(fresh fs.InodeEmbedder) {
+	if f, ok := fresh.(*ViewNode); ok {
+		n.setEntity(f.entity())
+	}
+}
+
+// matchingIssues is shared by Readdir and Lookup so `ls` and `stat` agree.
+func (n *ViewNode) matchingIssues(ctx context.Context) ([]api.Issue, error) {
+	issues, err := n.lfs.repo.GetTeamIssues(ctx, n.entity().ID)
+	if err != nil {
+		return nil, err
+	}
+	env := view.Env{Now: time.Now()}
+	// intentionally best-effort: without a viewer, assignee=me matches nothing
+	// rather than failing the listing (recovers via SetCurrentUser at mount).
+	if me, _ := n.lfs.repo.GetCurrentUser(ctx); me != nil {
+		env.ViewerID = me.ID
+	}
+	matched := issues[:0]
+	for i := range issues {
+		if n.view.filter.Match(&issues[i], env) {
+			matched = append(matched, issues[i])
+		}
+	}
+	return matched, nil
+}
+
+func (n *ViewNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.matchingIssues(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, len(issues))
+	for i, issue := range issues {
+		entries[i] = fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK}
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *ViewNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.matchingIssues(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			// From views/{name}/ go up 2 levels to the team dir, then into issues/.
+			target := fmt.Sprintf("../../issues/%s", safeName(issue.Identifier, issue.ID))
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
@@ -0,0 +1,41 @@
+package fs
+
+import (
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/config"
+)
+
+func TestCompileViews(t *testing.T) {
+	t.Parallel()
+	views, err := compileViews([]config.ViewConfig{
+		{Name: "my-urgent", Filter: "assignee=me priority<=high"},
+		{Name: "Needs Review", Filter: `label="Needs Review"`},
+	})
+	if err != nil {
+		t.Fatalf("compileViews: %v", err)
+	}
+	if len(views) != 2 || views[0].name != "my-urgent" || views[1].filter.String() != `label="Needs Review"` {
+		t.Errorf("compileViews = %+v", views)
+	}
+
+	for _, bad := range []config.ViewConfig{
+		{Name: "", Filter: "state=started"},
+		{Name: "..", Filter: "state=started"},
+		{Name: "a/b", Filter: "state=started"},
+		{Name: "tab\there", Filter: "state=started"},
+		{Name: "trailing.", Filter: "state=started"},
+		{Name: ".error", Filter: "state=started"}, // reserved control file
+		{Name: "ok", Filter: ""},
+		{Name: "ok", Filter: "color=red"},
+	} {
+		if _, err := compileViews([]config.ViewConfig{bad}); err == nil {
+			t.Errorf("compileViews(%+v) = nil error, want error", bad)
+		}
+	}
+
+	dup := []config.ViewConfig{{Name: "v", Filter: "state=started"}, {Name: "v", Filter: "label=bug"}}
+	if _, err := compileViews(dup); err == nil {
+		t.Error("compileViews with duplicate names = nil error, want error")
+	}
+}
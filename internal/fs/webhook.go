@@ -0,0 +1,177 @@
+package fs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/config"
+	"github.com/jra3/linear-fuse/internal/webhook"
+)
+
+// Real-time sync (webhook:).
+//
+// With webhook.listen set the mount serves a webhook.Handler on that address:
+// Linear posts each issue, comment, and project change, the handler writes it
+// into the store, and reports it through Changed — the same seam the sync
+// worker uses — so the kernel's cached view drops and /.events fires within
+// seconds of the edit in Linear. With webhook.url set the mount also
+// registers the webhook at startup and deletes it on Close — unless one was
+// already registered at that URL (`linearfs webhook setup`), which the mount
+// re-points at its secret and leaves in place. The sync worker
+// runs unchanged underneath; a delivery never arriving only costs latency.
+// Deliveries the handler refused, failed to apply, or had already applied are
+// listed in /.linearfs/webhook-failures, one JSON line each.
+
+// webhookLabel names the webhook the mount registers in Linear's settings.
+const webhookLabel = "linearfs"
+
+// webhookShutdownTimeout bounds draining in-flight deliveries and deleting
+// the registered webhook at Close.
+const webhookShutdownTimeout = 5 * time.Second
+
+// WebhookRegistrar is the slice of the API client webhook registration uses.
+type WebhookRegistrar interface {
+	ListWebhooks(ctx context.Context) ([]api.Webhook, error)
+	CreateWebhook(ctx context.Context, url, label, secret string, resourceTypes []string) (*api.Webhook, error)
+	UpdateWebhook(ctx context.Context, id, url, label, secret string, resourceTypes []string) (*api.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) error
+}
+
+// NewWebhookSecret mints a random signing secret.
+func NewWebhookSecret() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("webhook: mint secret: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// EnsureWebhook points linearfs's webhook at url, signed with secret: one
+// already registered there under webhookLabel is updated in place (and
+// re-enabled), otherwise one is created. created reports which, so the mount
+// deletes only a webhook it made. A linearfs webhook at another URL — another
+// machine's mount — is never touched.
+func EnsureWebhook(ctx context.Context, r WebhookRegistrar, url, secret string) (hook *api.Webhook, created bool, err error) {
+	hooks, err := r.ListWebhooks(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("list webhooks: %w", err)
+	}
+	for _, h := range hooks {
+		if h.Label == webhookLabel && h.URL == url {
+			hook, err := r.UpdateWebhook(ctx, h.ID, url, webhookLabel, secret, webhook.ResourceTypes)
+			if err != nil {
+				return nil, false, fmt.Errorf("update webhook %s: %w", h.ID, err)
+			}
+			return hook, false, nil
+		}
+	}
+	hook, err = r.CreateWebhook(ctx, url, webhookLabel, secret, webhook.ResourceTypes)
+	if err != nil {
+		return nil, false, fmt.Errorf("create webhook: %w", err)
+	}
+	return hook, true, nil
+}
+
+// RemoveWebhooks deletes linearfs's webhooks at url, or every webhook under
+// webhookLabel when url is empty (the leftovers of mounts that never reached
+// Close). It returns the ones deleted; on an error, those deleted before it.
+func RemoveWebhooks(ctx context.Context, r WebhookRegistrar, url string) ([]api.Webhook, error) {
+	hooks, err := r.ListWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks: %w", err)
+	}
+	var removed []api.Webhook
+	for _, h := range hooks {
+		if h.Label != webhookLabel || (url != "" && h.URL != url) {
+			continue
+		}
+		if err := r.DeleteWebhook(ctx, h.ID); err != nil {
+			return removed, fmt.Errorf("delete webhook %s: %w", h.ID, err)
+		}
+		removed = append(removed, h)
+	}
+	return removed, nil
+}
+
+// startWebhook binds the listener and serves deliveries until the mount
+// closes, registering the webhook when a URL is configured. A bind failure
+// fails the mount (the operator asked for a listener); a registration failure
+// is a warning, since the polling sync still covers every change.
+func (lfs *LinearFS) startWebhook(cfg config.WebhookConfig) error {
+	secret := cfg.Secret
+	if secret == "" {
+		var err error
+		if secret, err = NewWebhookSecret(); err != nil {
+			return err
+		}
+	}
+	ln, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	handler := webhook.NewHandler(lfs.store, secret, lfs)
+	lfs.webhookLog = handler
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	lfs.spawn(func(ctx context.Context) {
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+			defer cancel()
+			_ = srv.Shutdown(shutdownCtx)
+		}()
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("[webhook] Warning: listener stopped: %v", err)
+		}
+	})
+	log.Printf("[webhook] Listening on %s", ln.Addr())
+
+	if cfg.URL != "" {
+		lfs.spawn(func(ctx context.Context) {
+			hook, created, err := EnsureWebhook(ctx, lfs.client, cfg.URL, secret)
+			if err != nil {
+				log.Printf("[webhook] Warning: failed to register webhook (needs an admin API key); relying on the sync interval: %v", err)
+				return
+			}
+			if !created {
+				log.Printf("[webhook] Using webhook %s -> %s (already registered; left in place at unmount)", hook.ID, hook.URL)
+				return
+			}
+			lfs.webhookMu.Lock()
+			lfs.webhookID = hook.ID
+			lfs.webhookMu.Unlock()
+			log.Printf("[webhook] Registered webhook %s -> %s", hook.ID, hook.URL)
+		})
+	}
+	return nil
+}
+
+// unregisterWebhook deletes the webhook startWebhook registered, if any. Run
+// from Close after the spawned goroutines are done. intentionally
+// best-effort: a webhook left behind posts to a dead listener, which Linear
+// disables after repeated failures (recovers via deleting it in Linear's
+// settings).
+func (lfs *LinearFS) unregisterWebhook() {
+	lfs.webhookMu.Lock()
+	id := lfs.webhookID
+	lfs.webhookID = ""
+	lfs.webhookMu.Unlock()
+	if id == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), webhookShutdownTimeout)
+	defer cancel()
+	if err := lfs.client.DeleteWebhook(ctx, id); err != nil {
+		log.Printf("[webhook] Warning: failed to delete webhook %s: %v", id, err)
+	}
+}
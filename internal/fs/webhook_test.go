@@ -0,0 +1,107 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// fakeRegistrar is a WebhookRegistrar over an in-memory webhook list that
+// records every call.
+type fakeRegistrar struct {
+	hooks   []api.Webhook
+	calls   []string
+	failDel string // an ID whose DeleteWebhook fails
+}
+
+func (f *fakeRegistrar) ListWebhooks(context.Context) ([]api.Webhook, error) {
+	f.calls = append(f.calls, "list")
+	return f.hooks, nil
+}
+
+func (f *fakeRegistrar) CreateWebhook(_ context.Context, url, label, _ string, _ []string) (*api.Webhook, error) {
+	f.calls = append(f.calls, "create "+url)
+	h := api.Webhook{ID: "new", URL: url, Label: label, Enabled: true}
+	f.hooks = append(f.hooks, h)
+	return &h, nil
+}
+
+func (f *fakeRegistrar) UpdateWebhook(_ context.Context, id, url, label, _ string, _ []string) (*api.Webhook, error) {
+	f.calls = append(f.calls, "update "+id)
+	return &api.Webhook{ID: id, URL: url, Label: label, Enabled: true}, nil
+}
+
+func (f *fakeRegistrar) DeleteWebhook(_ context.Context, id string) error {
+	f.calls = append(f.calls, "delete "+id)
+	if id == f.failDel {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+// TestEnsureWebhook pins the adopt-or-create rule: only a linearfs webhook at
+// the same URL is updated; another URL's, or another app's at this URL, is
+// left alone and a new one created.
+func TestEnsureWebhook(t *testing.T) {
+	t.Parallel()
+	const url = "https://hooks.example.com/"
+	existing := []api.Webhook{
+		{ID: "other-app", URL: url, Label: "zapier"},
+		{ID: "other-mount", URL: "https://elsewhere.example.com/", Label: webhookLabel},
+	}
+
+	r := &fakeRegistrar{hooks: existing}
+	hook, created, err := EnsureWebhook(context.Background(), r, url, "s3cret")
+	if err != nil || !created || hook.ID != "new" {
+		t.Fatalf("no match: hook=%+v created=%v err=%v, want a new webhook", hook, created, err)
+	}
+	if want := []string{"list", "create " + url}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+
+	r = &fakeRegistrar{hooks: append(existing, api.Webhook{ID: "ours", URL: url, Label: webhookLabel})}
+	hook, created, err = EnsureWebhook(context.Background(), r, url, "s3cret")
+	if err != nil || created || hook.ID != "ours" {
+		t.Fatalf("match: hook=%+v created=%v err=%v, want ours updated", hook, created, err)
+	}
+	if want := []string{"list", "update ours"}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+// TestRemoveWebhooks: a URL deletes only linearfs's webhook there; an empty
+// URL deletes every linearfs webhook; another app's is never deleted, and a
+// failed delete stops with the ones already gone reported.
+func TestRemoveWebhooks(t *testing.T) {
+	t.Parallel()
+	hooks := []api.Webhook{
+		{ID: "a", URL: "https://a.example.com/", Label: webhookLabel},
+		{ID: "other-app", URL: "https://a.example.com/", Label: "zapier"},
+		{ID: "b", URL: "https://b.example.com/", Label: webhookLabel},
+	}
+	ids := func(hs []api.Webhook) []string {
+		var out []string
+		for _, h := range hs {
+			out = append(out, h.ID)
+		}
+		return out
+	}
+
+	removed, err := RemoveWebhooks(context.Background(), &fakeRegistrar{hooks: hooks}, "https://a.example.com/")
+	if err != nil || !reflect.DeepEqual(ids(removed), []string{"a"}) {
+		t.Errorf("by URL: removed %v, err %v; want [a]", ids(removed), err)
+	}
+
+	removed, err = RemoveWebhooks(context.Background(), &fakeRegistrar{hooks: hooks}, "")
+	if err != nil || !reflect.DeepEqual(ids(removed), []string{"a", "b"}) {
+		t.Errorf("all: removed %v, err %v; want [a b]", ids(removed), err)
+	}
+
+	removed, err = RemoveWebhooks(context.Background(), &fakeRegistrar{hooks: hooks, failDel: "b"}, "")
+	if err == nil || !reflect.DeepEqual(ids(removed), []string{"a"}) {
+		t.Errorf("failing delete: removed %v, err %v; want [a] and an error", ids(removed), err)
+	}
+}
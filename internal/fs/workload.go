@@ -0,0 +1,97 @@
+package fs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// workloadName is the per-user summary file in users/{handle}/.
+const workloadName = "workload.md"
+
+// workloadRow is one team's (or the total's) share of a user's open work.
+type workloadRow struct {
+	byPriority map[string]int
+	open       int
+	estimate   float64
+}
+
+func (r *workloadRow) add(issue api.Issue) {
+	if r.byPriority == nil {
+		r.byPriority = make(map[string]int, len(priorityBuckets))
+	}
+	r.byPriority[api.PriorityName(issue.Priority)]++
+	r.open++
+	if issue.Estimate != nil {
+		r.estimate += *issue.Estimate
+	}
+}
+
+// workloadMarkdown renders a user's workload.md from the issues assigned to
+// them (the assignee index behind users/{handle}/): open issues — anything not
+// completed or canceled — counted by priority and summed by estimate, per
+// team and in total. The frontmatter carries the same figures for scripts.
+func workloadMarkdown(user api.User, issues []api.Issue) []byte {
+	var total workloadRow
+	teams := make(map[string]*workloadRow)
+	for _, issue := range issues {
+		if issue.State.Type == "completed" || issue.State.Type == "canceled" {
+			continue
+		}
+		key := "?"
+		if issue.Team != nil {
+			key = issue.Team.Key
+		}
+		if teams[key] == nil {
+			teams[key] = &workloadRow{}
+		}
+		teams[key].add(issue)
+		total.add(issue)
+	}
+	keys := make([]string, 0, len(teams))
+	for k := range teams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmTeams := make(map[string]any, len(keys))
+	for _, k := range keys {
+		fmTeams[k] = map[string]any{"open": teams[k].open, "estimate": teams[k].estimate}
+	}
+	fm := map[string]any{
+		"user":     user.Email,
+		"open":     total.open,
+		"estimate": total.estimate,
+		"teams":    fmTeams,
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n# Workload: %s\n\n", user.Name)
+	if total.open == 0 {
+		b.WriteString("No open issues.\n")
+		return renderWithFrontmatter(fm, b.String())
+	}
+	b.WriteString("| Team |")
+	for _, p := range priorityBuckets {
+		fmt.Fprintf(&b, " %s |", p)
+	}
+	b.WriteString(" Open | Estimate |\n|------|")
+	b.WriteString(strings.Repeat("---|", len(priorityBuckets)+2))
+	b.WriteString("\n")
+	row := func(label string, r workloadRow) {
+		fmt.Fprintf(&b, "| %s |", label)
+		for _, p := range priorityBuckets {
+			fmt.Fprintf(&b, " %d |", r.byPriority[p])
+		}
+		fmt.Fprintf(&b, " %d | %g |\n", r.open, r.estimate)
+	}
+	for _, k := range keys {
+		row(k, *teams[k])
+	}
+	if len(keys) > 1 {
+		row("**Total**", total)
+	}
+	return renderWithFrontmatter(fm, b.String())
+}
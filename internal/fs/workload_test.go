@@ -0,0 +1,43 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestWorkloadMarkdown(t *testing.T) {
+	t.Parallel()
+	user := api.User{ID: "user-1", Name: "Ada", Email: "ada@example.com"}
+	eng, ops := &api.Team{Key: "ENG"}, &api.Team{Key: "OPS"}
+	three, two := 3.0, 2.0
+	started := api.State{Name: "In Progress", Type: "started"}
+	issues := []api.Issue{
+		{Identifier: "ENG-1", Team: eng, State: started, Priority: 1, Estimate: &three},
+		{Identifier: "ENG-2", Team: eng, State: api.State{Name: "Todo", Type: "unstarted"}, Priority: 1},
+		{Identifier: "ENG-3", Team: eng, State: api.State{Name: "Done", Type: "completed"}, Priority: 2, Estimate: &three},
+		{Identifier: "OPS-1", Team: ops, State: started, Priority: 4, Estimate: &two},
+		{Identifier: "OPS-2", Team: ops, State: api.State{Name: "Canceled", Type: "canceled"}, Priority: 0},
+	}
+
+	got := string(workloadMarkdown(user, issues))
+	for _, want := range []string{
+		"user: ada@example.com",
+		"open: 3",
+		"estimate: 5",
+		"# Workload: Ada",
+		"| Team | urgent | high | medium | low | none | Open | Estimate |",
+		"| ENG | 2 | 0 | 0 | 0 | 0 | 2 | 3 |",
+		"| OPS | 0 | 0 | 0 | 1 | 0 | 1 | 2 |",
+		"| **Total** | 2 | 0 | 0 | 1 | 0 | 3 | 5 |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("workload.md missing %q:\n%s", want, got)
+		}
+	}
+
+	if got := string(workloadMarkdown(user, issues[2:3])); !strings.Contains(got, "No open issues.") {
+		t.Errorf("no open issues:\n%s", got)
+	}
+}
@@ -0,0 +1,155 @@
+package fs
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// workspaceProjectsName is the root projects/ directory: every project in the
+// workspace at one path, whichever teams it spans.
+const workspaceProjectsName = "projects"
+
+// workspaceProject is one entry of the root projects/ listing: the project and
+// the team its directory is built against.
+type workspaceProject struct {
+	team    api.Team
+	project api.Project
+}
+
+// workspaceProjects lists every project across the mounted teams, once each,
+// paired with its primary team: the first by key, the rule
+// GetProjectPrimaryTeamKey owns. Built from the per-team listings so a project
+// only appears once a team it belongs to has synced.
+func (lfs *LinearFS) workspaceProjects(ctx context.Context) ([]workspaceProject, error) {
+	teams, err := lfs.repo.GetTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(teams, func(a, b api.Team) int { return strings.Compare(a.Key, b.Key) })
+
+	var out []workspaceProject
+	seen := make(map[string]bool)
+	for _, team := range teams {
+		projects, err := lfs.repo.GetTeamProjects(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, project := range projects {
+			if seen[project.ID] {
+				continue
+			}
+			seen[project.ID] = true
+			out = append(out, workspaceProject{team: team, project: project})
+		}
+	}
+	slices.SortStableFunc(out, func(a, b workspaceProject) int { return strings.Compare(a.project.Name, b.project.Name) })
+	return out, nil
+}
+
+// WorkspaceProjectsNode represents the /projects directory. Stateless
+// container: zero times (honest unknown); Getattr comes from the attrNode
+// mixin.
+type WorkspaceProjectsNode struct {
+	attrNode
+}
+
+var _ fs.NodeReaddirer = (*WorkspaceProjectsNode)(nil)
+var _ fs.NodeLookuper = (*WorkspaceProjectsNode)(nil)
+var _ fs.NodeGetattrer = (*WorkspaceProjectsNode)(nil)
+
+func (p *WorkspaceProjectsNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	projects, err := p.lfs.workspaceProjects(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+
+	// Two teams' projects can share a name; the first (what Lookup resolves)
+	// is listed, so the listing never carries a duplicate dirent.
+	entries := make([]fuse.DirEntry, 0, len(projects))
+	listed := make(map[string]bool, len(projects))
+	for _, wp := range projects {
+		name := p.lfs.iconDirName(wp.project.Icon, projectDirName(wp.project))
+		if listed[name] {
+			continue
+		}
+		listed[name] = true
+		entries = append(entries, fuse.DirEntry{Name: name, Mode: syscall.S_IFDIR})
+	}
+
+	return fs.NewListDirStream(entries), 0
+}
+
+func (p *WorkspaceProjectsNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	projects, err := p.lfs.workspaceProjects(ctx)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+
+	for _, wp := range projects {
+		if p.lfs.iconDirNameMatches(name, wp.project.Icon, projectDirName(wp.project)) {
+			node := &ProjectNode{attrNode: attrNode{BaseNode: BaseNode{lfs: p.lfs}}, team: wp.team, project: wp.project, workspace: true}
+			return p.newDirInode(ctx, out, name, node, dirAttr(wp.project.CreatedAt, wp.project.UpdatedAt), workspaceProjectDirIno(wp.project.ID), 30*time.Second), 0
+		}
+	}
+
+	return nil, syscall.ENOENT
+}
+
+// ProjectIssuesNode represents /projects/{name}/issues/: a symlink per issue
+// in the project, into the issue's own team, which may not be the project's
+// primary team.
+type ProjectIssuesNode struct {
+	attrNode
+	projectID string
+}
+
+var _ fs.NodeReaddirer = (*ProjectIssuesNode)(nil)
+var _ fs.NodeLookuper = (*ProjectIssuesNode)(nil)
+var _ fs.NodeGetattrer = (*ProjectIssuesNode)(nil)
+
+func (n *ProjectIssuesNode) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	issues, err := n.lfs.repo.GetIssuesByProject(ctx, n.projectID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	entries := make([]fuse.DirEntry, 0, len(issues))
+	for _, issue := range issues {
+		entries = append(entries, fuse.DirEntry{Name: issue.Identifier, Mode: syscall.S_IFLNK})
+	}
+	return fs.NewListDirStream(entries), 0
+}
+
+func (n *ProjectIssuesNode) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	issues, err := n.lfs.repo.GetIssuesByProject(ctx, n.projectID)
+	if err != nil {
+		return nil, syscall.EIO
+	}
+	for _, issue := range issues {
+		if issue.Identifier == name {
+			target, errno := projectIssueTarget(issue)
+			if errno != 0 {
+				return nil, errno
+			}
+			return n.newSymlinkInode(ctx, out, target, issue.CreatedAt, issue.UpdatedAt), 0
+		}
+	}
+	return nil, syscall.ENOENT
+}
+
+// projectIssueTarget is the relative target for a link in
+// projects/{name}/issues/, three levels below the mount root; the tail is
+// teamIssueTarget's path-safe one.
+func projectIssueTarget(issue api.Issue) (string, syscall.Errno) {
+	target, errno := teamIssueTarget(issue)
+	if errno != 0 {
+		return "", errno
+	}
+	return "../" + target, 0
+}
@@ -0,0 +1,130 @@
+package fs
+
+import (
+	"context"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+// TestWorkspaceProjectsOncePerProjectOnPrimaryTeam pins the root projects/
+// listing: a project two teams share appears once, built against its
+// first-by-key team (the GetProjectPrimaryTeamKey rule), and the listing is
+// name-ordered across teams.
+func TestWorkspaceProjectsOncePerProjectOnPrimaryTeam(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := fixtures.NewTestSQLiteStore(t)
+
+	for _, team := range []api.Team{{ID: "team-z", Key: "ZZZ", Name: "Alpha"}, {ID: "team-a", Key: "AAA", Name: "Zulu"}} {
+		if err := fixtures.PopulateTeam(ctx, store, team, nil, nil, nil); err != nil {
+			t.Fatalf("populate %s: %v", team.Key, err)
+		}
+	}
+	shared := api.Project{ID: "project-shared", Name: "Shared", Slug: "shared"}
+	for _, link := range []struct {
+		project api.Project
+		teamID  string
+	}{
+		{shared, "team-z"},
+		{shared, "team-a"},
+		{api.Project{ID: "project-z", Name: "Apollo", Slug: "apollo"}, "team-z"},
+		{api.Project{ID: "project-a", Name: "Zephyr", Slug: "zephyr"}, "team-a"},
+	} {
+		if err := fixtures.PopulateProject(ctx, store, link.project, link.teamID); err != nil {
+			t.Fatalf("populate %s: %v", link.project.ID, err)
+		}
+	}
+
+	lfs := &LinearFS{}
+	if err := lfs.InjectTestStore(store); err != nil {
+		t.Fatalf("inject store: %v", err)
+	}
+
+	projects, err := lfs.workspaceProjects(ctx)
+	if err != nil {
+		t.Fatalf("workspaceProjects: %v", err)
+	}
+	got := map[string]string{}
+	var order []string
+	for _, wp := range projects {
+		got[wp.project.ID] = wp.team.Key
+		order = append(order, wp.project.Name)
+	}
+	if want := map[string]string{"project-shared": "AAA", "project-z": "ZZZ", "project-a": "AAA"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("project teams = %v, want %v", got, want)
+	}
+	if want := []string{"Apollo", "Shared", "Zephyr"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+
+	node := &WorkspaceProjectsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}}
+	stream, errno := node.Readdir(ctx)
+	if errno != 0 {
+		t.Fatalf("Readdir errno = %v", errno)
+	}
+	var names []string
+	for stream.HasNext() {
+		e, _ := stream.Next()
+		names = append(names, e.Name)
+	}
+	if want := []string{"apollo", "shared", "zephyr"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Readdir = %v, want %v", names, want)
+	}
+}
+
+// TestWorkspaceProjectsNameCollisionListedOnce pins the no-duplicate-dirent
+// rule: two teams' same-named projects list one entry, the one Lookup
+// resolves.
+func TestWorkspaceProjectsNameCollisionListedOnce(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	store := fixtures.NewTestSQLiteStore(t)
+
+	for _, team := range []api.Team{{ID: "team-a", Key: "AAA", Name: "A"}, {ID: "team-b", Key: "BBB", Name: "B"}} {
+		if err := fixtures.PopulateTeam(ctx, store, team, nil, nil, nil); err != nil {
+			t.Fatalf("populate %s: %v", team.Key, err)
+		}
+	}
+	if err := fixtures.PopulateProject(ctx, store, api.Project{ID: "p1", Name: "Roadmap", Slug: "r1"}, "team-a"); err != nil {
+		t.Fatalf("populate p1: %v", err)
+	}
+	if err := fixtures.PopulateProject(ctx, store, api.Project{ID: "p2", Name: "Roadmap", Slug: "r2"}, "team-b"); err != nil {
+		t.Fatalf("populate p2: %v", err)
+	}
+
+	lfs := &LinearFS{}
+	if err := lfs.InjectTestStore(store); err != nil {
+		t.Fatalf("inject store: %v", err)
+	}
+	node := &WorkspaceProjectsNode{attrNode: attrNode{BaseNode: BaseNode{lfs: lfs}}}
+	stream, errno := node.Readdir(ctx)
+	if errno != 0 {
+		t.Fatalf("Readdir errno = %v", errno)
+	}
+	var entries []fuse.DirEntry
+	for stream.HasNext() {
+		e, _ := stream.Next()
+		entries = append(entries, e)
+	}
+	if len(entries) != 1 || entries[0].Name != "roadmap" {
+		t.Errorf("Readdir = %v, want one roadmap entry", entries)
+	}
+}
+
+// TestProjectIssueTarget pins the issues/ link target: three levels up to the
+// root, then into the issue's own team, with the shared unsynced-team ENOENT.
+func TestProjectIssueTarget(t *testing.T) {
+	t.Parallel()
+	issue := api.Issue{Identifier: "OPS-7", Team: &api.Team{Key: "OPS"}}
+	if target, errno := projectIssueTarget(issue); errno != 0 || target != "../../../teams/OPS/issues/OPS-7" {
+		t.Errorf("target=%q errno=%v", target, errno)
+	}
+	if _, errno := projectIssueTarget(api.Issue{Identifier: "OPS-8"}); errno != syscall.ENOENT {
+		t.Errorf("teamless issue: errno = %v, want ENOENT", errno)
+	}
+}
@@ -27,14 +27,15 @@ func TestWriteFeedbackInvalidateSeam(t *testing.T) {
 		t.Fatalf("clear dropped = %v, want one more errorIno(ENT-1)", dropped)
 	}
 
-	// Append success drops the .last inode for the collection key.
+	// Append success drops the .last and .last-created inodes for the
+	// collection key.
 	key := collectionSuccessKey("issues", "team-1")
 	wf.AppendWriteSuccess(key, WriteResult{Identifier: "TST-1"})
 	if got := wf.GetWriteSuccess(key); len(got) != 1 || got[0].Identifier != "TST-1" {
 		t.Fatalf("GetWriteSuccess = %+v, want one TST-1", got)
 	}
-	if len(dropped) != 3 || dropped[2] != successIno(key) {
-		t.Fatalf("append dropped = %v, want successIno(key)", dropped)
+	if len(dropped) != 4 || dropped[2] != successIno(key) || dropped[3] != lastCreatedIno(key) {
+		t.Fatalf("append dropped = %v, want successIno(key), lastCreatedIno(key)", dropped)
 	}
 }
 
@@ -1,9 +1,17 @@
 package integration
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
 )
 
 func TestCommentsDirectoryListing(t *testing.T) {
@@ -272,3 +280,93 @@ func TestNewMdWriteOnly(t *testing.T) {
 		t.Errorf("_create should be writable: %v", err)
 	}
 }
+
+// TestCommentsListingOrderUnderSyncUpserts interleaves sync-side comment
+// upserts (straight into the store, as the worker writes them) with raw
+// readdirs of the mounted comments/ directory. Every listing must come back in
+// (createdAt, id) order — the order the final listing has — however the rows
+// were written, so a reader never sees the comments reshuffle mid-sync.
+// Readdirnames keeps the kernel's order; os.ReadDir would sort it away.
+func TestCommentsListingOrderUnderSyncUpserts(t *testing.T) {
+	ctx := context.Background()
+	if testStore == nil {
+		t.Skip("store-backed sync simulation requires fixture mode")
+	}
+
+	// A throwaway issue, so the shared fixture's comment counts stay put.
+	team := fixtures.FixtureAPITeam()
+	uniq := time.Now().UnixNano()
+	issueID := fmt.Sprintf("order-issue-%d", uniq)
+	identifier := fmt.Sprintf("TST-%d", 80000+uniq%10000)
+	row, err := db.APIIssueToDBIssue(fixtures.FixtureAPIIssue(
+		fixtures.WithIssueID(issueID, identifier),
+		fixtures.WithTitle("Comment Order Probe"),
+		fixtures.WithTeam(&team),
+	))
+	if err != nil {
+		t.Fatalf("convert issue: %v", err)
+	}
+	if err := testStore.Queries().UpsertIssue(ctx, row.ToUpsertParams()); err != nil {
+		t.Fatalf("upsert issue: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = testStore.Queries().DeleteIssueComments(context.Background(), issueID)
+		_ = testStore.Queries().DeleteIssue(context.Background(), issueID)
+	})
+
+	// Ten comments per timestamp, written newest id first within each.
+	base := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	var comments []api.Comment
+	for i := 0; i < 30; i++ {
+		comments = append(comments, api.Comment{
+			ID:        fmt.Sprintf("%08x-order", 0xb0000000+i),
+			Body:      fmt.Sprintf("comment %d", i),
+			CreatedAt: base.Add(time.Duration(i/10) * time.Minute),
+			UpdatedAt: base,
+		})
+	}
+	rank := make(map[string]int, len(comments))
+	for i, c := range comments {
+		rank[c.CreatedAt.Format("2006-01-02T15-04")+"-"+c.ID[:8]+".md"] = i
+	}
+
+	dir := commentsPath(testTeamKey, identifier)
+	listed := func() []string {
+		t.Helper()
+		f, err := os.Open(dir)
+		if err != nil {
+			t.Fatalf("open comments dir: %v", err)
+		}
+		defer f.Close()
+		all, err := f.Readdirnames(-1)
+		if err != nil {
+			t.Fatalf("readdir comments dir: %v", err)
+		}
+		var names []string
+		for _, name := range all {
+			if _, ok := rank[name]; ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	inOrder := func(names []string) bool {
+		return slices.IsSortedFunc(names, func(a, b string) int { return rank[a] - rank[b] })
+	}
+
+	for i := len(comments) - 1; i >= 0; i-- {
+		params, err := db.APICommentToDBComment(comments[i], issueID)
+		if err != nil {
+			t.Fatalf("convert comment: %v", err)
+		}
+		if err := testStore.Queries().UpsertComment(ctx, params); err != nil {
+			t.Fatalf("upsert comment: %v", err)
+		}
+		if names := listed(); !inOrder(names) {
+			t.Fatalf("listing after %d upserts out of (createdAt, id) order: %v", len(comments)-i, names)
+		}
+	}
+	if names := listed(); len(names) != len(comments) || !inOrder(names) {
+		t.Errorf("final listing = %v, want all %d comments in (createdAt, id) order", names, len(comments))
+	}
+}
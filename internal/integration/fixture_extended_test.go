@@ -231,7 +231,7 @@ func TestFixtureLabelFileContents(t *testing.T) {
 }
 
 // =============================================================================
-// Filter Views Tests (by/status, by/assignee, by/label)
+// Filter Views Tests (by/status, by/assignee, by/label, by/priority)
 // =============================================================================
 
 func TestFixtureByAssigneeDirectoryExists(t *testing.T) {
@@ -329,6 +329,27 @@ func TestFixtureUnassignedContainsIssues(t *testing.T) {
 	}
 }
 
+func TestFixtureByPriorityListing(t *testing.T) {
+	entries, err := os.ReadDir(byPriorityPath(testTeamKey))
+	if err != nil {
+		t.Fatalf("Failed to read by/priority directory: %v", err)
+	}
+
+	// Fixed bucket vocabulary (os.ReadDir sorts, so compare as a set)
+	got := make(map[string]bool)
+	for _, entry := range entries {
+		got[entry.Name()] = true
+	}
+	for _, bucket := range []string{"urgent", "high", "medium", "low", "none"} {
+		if !got[bucket] {
+			t.Errorf("Expected %s bucket in by/priority", bucket)
+		}
+	}
+	if len(entries) != 5 {
+		t.Errorf("Expected 5 priority buckets, got %d", len(entries))
+	}
+}
+
 // =============================================================================
 // Issue Children Directory Tests
 // =============================================================================
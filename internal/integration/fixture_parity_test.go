@@ -24,9 +24,13 @@ import (
 // fixture set. Each entry needs a reason: either the table has no mount-visible
 // render, or it is written only by machinery the fixture harness bypasses.
 var fixtureExcludedTables = map[string]string{
-	"sync_meta":           "sync-worker bookkeeping (last-sync watermarks); no mount-visible render",
-	"sync_schedule":       "sync-worker bookkeeping (persisted schedule timestamps, e.g. last full cycle); no mount-visible render",
-	"pending_detail_sync": "sync-worker retry ledger for failed detail fetches; no mount-visible render",
+	"sync_meta":            "sync-worker bookkeeping (last-sync watermarks); no mount-visible render",
+	"sync_schedule":        "sync-worker bookkeeping (persisted schedule timestamps, e.g. last full cycle); no mount-visible render",
+	"pending_detail_sync":  "sync-worker retry ledger for failed detail fetches; no mount-visible render",
+	"pending_issue_fields": "projected-sync marks (api.lazy_issue_fields); the fixture mount syncs nothing projected",
+	"api_budget_windows":   "rate-budget persistence (repo/budget.go); no mount-visible render",
+	"api_budget_hours":     "rate-budget spend ledger (repo/budget.go); no mount-visible render",
+	"webhook_deliveries":   "webhook replay ledger (webhook.Handler); the fixture mount runs no listener",
 }
 
 // TestSchemaFixtureCoverage asserts fixture coverage tracks the schema's table
@@ -226,6 +226,10 @@ func byLabelPath(teamKey string) string {
 	return filepath.Join(mountPoint, "teams", teamKey, "by", "label")
 }
 
+func byPriorityPath(teamKey string) string {
+	return filepath.Join(mountPoint, "teams", teamKey, "by", "priority")
+}
+
 // Retry helpers
 
 func readFileWithRetry(path string, maxWait time.Duration) ([]byte, error) {
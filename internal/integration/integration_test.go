@@ -17,6 +17,7 @@ import (
 	"github.com/jra3/linear-fuse/internal/api"
 	"github.com/jra3/linear-fuse/internal/config"
 	"github.com/jra3/linear-fuse/internal/db"
+	dbfixtures "github.com/jra3/linear-fuse/internal/db/fixtures"
 	"github.com/jra3/linear-fuse/internal/fs"
 	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
 )
@@ -174,6 +175,7 @@ func setupSQLiteFixtures() error {
 		Cache: config.CacheConfig{
 			TTL: 100 * time.Millisecond,
 		},
+		Views: []config.ViewConfig{{Name: "urgent", Filter: "priority<=high"}},
 	}
 
 	lfs, err = fs.NewLinearFS(cfg, false)
@@ -230,9 +232,6 @@ func setupSQLiteFixtures() error {
 // populateTestFixtures inserts test data into the SQLite database
 func populateTestFixtures(ctx context.Context, store *db.Store) error {
 	team := fixtures.FixtureAPITeam()
-	states := fixtures.FixtureAPIStates()
-	labels := fixtures.FixtureAPILabels()
-	users := fixtures.FixtureAPIUsers()
 
 	// Create a project, pre-labeled with a group child + a retired label (the
 	// carried-through case: labelIds is a full-set write, so a save that keeps
@@ -252,23 +251,9 @@ func populateTestFixtures(ctx context.Context, store *db.Store) error {
 		UpdatedAt: relation.UpdatedAt,
 	}
 
-	// Create issues with various configurations
+	// Create issues with various configurations. TST-1 (parent) and TST-2
+	// (its sub-issue) are seeded as a tree below; TST-6 with the project.
 	issues := []api.Issue{
-		fixtures.FixtureAPIIssue(
-			fixtures.WithIssueID("issue-1", "TST-1"),
-			fixtures.WithTitle("Test Issue 1"),
-			fixtures.WithDescription("This is test issue 1"),
-			fixtures.WithState(fixtures.FixtureAPIState("started")),
-			fixtures.WithPriority(2),
-			fixtures.WithRelations(relation),
-		),
-		fixtures.FixtureAPIIssue(
-			fixtures.WithIssueID("issue-2", "TST-2"),
-			fixtures.WithTitle("Test Issue 2"),
-			fixtures.WithDescription("This is test issue 2"),
-			fixtures.WithState(fixtures.FixtureAPIState("unstarted")),
-			fixtures.WithPriority(1),
-		),
 		fixtures.FixtureAPIIssue(
 			fixtures.WithIssueID("issue-3", "TST-3"),
 			fixtures.WithTitle("Test Issue 3 - High Priority"),
@@ -290,14 +275,6 @@ func populateTestFixtures(ctx context.Context, store *db.Store) error {
 			fixtures.WithDescription("This issue is completed"),
 			fixtures.WithState(fixtures.FixtureAPIState("completed")),
 		),
-		// Issue with project assignment
-		fixtures.FixtureAPIIssue(
-			fixtures.WithIssueID("issue-6", "TST-6"),
-			fixtures.WithTitle("Test Issue 6 - In Project"),
-			fixtures.WithDescription("This issue is assigned to a project"),
-			fixtures.WithState(fixtures.FixtureAPIState("started")),
-			fixtures.WithProject(&project),
-		),
 		// Issue without assignee
 		fixtures.FixtureAPIIssue(
 			fixtures.WithIssueID("issue-7", "TST-7"),
@@ -316,18 +293,42 @@ func populateTestFixtures(ctx context.Context, store *db.Store) error {
 		),
 	}
 
-	// Populate team with issues
-	if err := fixtures.PopulateTeam(ctx, store, team, states, labels, issues); err != nil {
+	// Populate team (catalog, users, membership) with issues
+	if err := dbfixtures.SeedTeam(ctx, store, team, issues...); err != nil {
 		return err
 	}
 
-	// Populate users
-	if err := fixtures.PopulateUsers(ctx, store, users); err != nil {
+	// TST-1 is parent of TST-2
+	if err := dbfixtures.SeedIssueTree(ctx, store,
+		fixtures.FixtureAPIIssue(
+			fixtures.WithIssueID("issue-1", "TST-1"),
+			fixtures.WithTitle("Test Issue 1"),
+			fixtures.WithDescription("This is test issue 1"),
+			fixtures.WithState(fixtures.FixtureAPIState("started")),
+			fixtures.WithPriority(2),
+			fixtures.WithRelations(relation),
+		),
+		fixtures.FixtureAPIIssue(
+			fixtures.WithIssueID("issue-2", "TST-2"),
+			fixtures.WithTitle("Test Issue 2"),
+			fixtures.WithDescription("This is test issue 2"),
+			fixtures.WithState(fixtures.FixtureAPIState("unstarted")),
+			fixtures.WithPriority(1),
+		),
+	); err != nil {
 		return err
 	}
 
-	// Populate project
-	if err := fixtures.PopulateProject(ctx, store, project, team.ID); err != nil {
+	// Populate project with its milestone and an issue
+	if err := dbfixtures.SeedProjectWithMilestones(ctx, store, team.ID, project,
+		[]api.ProjectMilestone{fixtures.FixtureAPIProjectMilestone()},
+		fixtures.FixtureAPIIssue(
+			fixtures.WithIssueID("issue-6", "TST-6"),
+			fixtures.WithTitle("Test Issue 6 - In Project"),
+			fixtures.WithDescription("This issue is assigned to a project"),
+			fixtures.WithState(fixtures.FixtureAPIState("started")),
+		),
+	); err != nil {
 		return err
 	}
 
@@ -379,11 +380,6 @@ func populateTestFixtures(ctx context.Context, store *db.Store) error {
 		return err
 	}
 
-	// Set up parent-child relationship: TST-1 is parent of TST-2
-	if err := fixtures.PopulateParentChildIssues(ctx, store, "issue-1", "issue-2"); err != nil {
-		return err
-	}
-
 	// Populate embedded files for issue-1
 	embeddedFiles := fixtures.FixtureAPIEmbeddedFiles()
 	if err := fixtures.PopulateEmbeddedFiles(ctx, store, "issue-1", embeddedFiles); err != nil {
@@ -395,10 +391,7 @@ func populateTestFixtures(ctx context.Context, store *db.Store) error {
 		return err
 	}
 
-	// Populate milestones and status updates for the project
-	if err := fixtures.PopulateProjectMilestones(ctx, store, project.ID, []api.ProjectMilestone{fixtures.FixtureAPIProjectMilestone()}); err != nil {
-		return err
-	}
+	// Populate status updates for the project
 	if err := fixtures.PopulateProjectUpdates(ctx, store, project.ID, []api.ProjectUpdate{fixtures.FixtureAPIProjectUpdate()}); err != nil {
 		return err
 	}
@@ -431,15 +424,6 @@ func populateTestFixtures(ctx context.Context, store *db.Store) error {
 		return err
 	}
 
-	// Populate team membership (backs the by/assignee value listing)
-	userIDs := make([]string, len(users))
-	for i, u := range users {
-		userIDs[i] = u.ID
-	}
-	if err := fixtures.PopulateTeamMembers(ctx, store, team.ID, userIDs); err != nil {
-		return err
-	}
-
 	// Populate the viewer identity (backs the my/ views; user-1 is the default
 	// fixture assignee, so my/assigned is non-empty)
 	if err := fixtures.PopulateViewer(ctx, store, "user-1"); err != nil {
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -50,6 +51,211 @@ func TestGeneratedReadmeMatchesBehavior(t *testing.T) {
 		}
 	}
 
+	// backlinks.md: documented in the issue-directory map, and really present
+	// and readable in a fixture issue directory.
+	if !strings.Contains(readme, "backlinks.md") {
+		t.Error("README does not mention backlinks.md")
+	}
+	if data, err := os.ReadFile(filepath.Join(issueDirPath(testTeamKey, "TST-1"), "backlinks.md")); err != nil {
+		t.Errorf("read TST-1/backlinks.md: %v", err)
+	} else if !strings.HasPrefix(string(data), "# Backlinks for TST-1") {
+		t.Errorf("backlinks.md header = %q", strings.SplitN(string(data), "\n", 2)[0])
+	}
+
+	// O_APPEND on issue.md appends to the description; the validation example
+	// must not suggest `>>` edits frontmatter.
+	if !strings.Contains(readme, `echo "note" >> issue.md`) {
+		t.Error("README does not document appending to issue.md")
+	}
+	if strings.Contains(readme, `echo "priority: critical" >> issue.md`) {
+		t.Error("README still shows >> as a frontmatter edit")
+	}
+
+	// drafts/: documented, and a draft written there reads back from SQLite
+	// (publishing needs the API, so only the local half is exercised here).
+	if !strings.Contains(readme, "drafts/") || !strings.Contains(readme, "publish") {
+		t.Error("README does not document drafts/ and the publish gesture")
+	}
+	draft := filepath.Join(issueDirPath(testTeamKey, "TST-1"), "drafts", "readme-check.md")
+	if err := os.WriteFile(draft, []byte("half-written reply\n"), 0644); err != nil {
+		t.Errorf("write draft: %v", err)
+	} else {
+		if data, err := os.ReadFile(draft); err != nil || string(data) != "half-written reply\n" {
+			t.Errorf("read draft = %q, %v", data, err)
+		}
+		if err := os.Remove(draft); err != nil {
+			t.Errorf("remove draft: %v", err)
+		}
+	}
+
+	// issues/.last-created: documented, and really served (empty until a create
+	// in this mount; the create path itself is covered by the write tests).
+	if !strings.Contains(readme, ".last-created") {
+		t.Error("README does not mention issues/.last-created")
+	}
+	if _, err := os.ReadFile(filepath.Join(issuesPath(testTeamKey), ".last-created")); err != nil {
+		t.Errorf("read issues/.last-created: %v", err)
+	}
+	// issues/_clone: documented, and write-only like _create.
+	if !strings.Contains(readme, "_clone") {
+		t.Error("README does not mention issues/_clone")
+	}
+	if _, err := os.ReadFile(filepath.Join(issuesPath(testTeamKey), "_clone")); err == nil {
+		t.Error("issues/_clone is readable, but README documents it as a write-only trigger")
+	}
+	// Completed cycles' report.md (the fixture has no completed cycle, so only
+	// the documentation half is checked).
+	if !strings.Contains(readme, "report.md") {
+		t.Error("README does not mention cycles/<name>/report.md")
+	}
+
+	// graph.dot/graph.json: documented in the team map, and really present and
+	// well-formed in the fixture team directory.
+	if !strings.Contains(readme, "graph.dot, graph.json") {
+		t.Error("README does not mention graph.dot/graph.json")
+	}
+	if data, err := os.ReadFile(filepath.Join(teamPath(testTeamKey), "graph.dot")); err != nil {
+		t.Errorf("read graph.dot: %v", err)
+	} else if !strings.HasPrefix(string(data), "digraph ") {
+		t.Errorf("graph.dot header = %q", strings.SplitN(string(data), "\n", 2)[0])
+	}
+	if data, err := os.ReadFile(filepath.Join(teamPath(testTeamKey), "graph.json")); err != nil {
+		t.Errorf("read graph.json: %v", err)
+	} else if !json.Valid(data) {
+		t.Errorf("graph.json is not valid JSON: %q", data)
+	}
+
+	// needs-attention.md: documented in the team map, and really readable in
+	// the fixture team directory.
+	if !strings.Contains(readme, "needs-attention.md") {
+		t.Error("README does not mention needs-attention.md")
+	}
+	if data, err := os.ReadFile(filepath.Join(teamPath(testTeamKey), "needs-attention.md")); err != nil {
+		t.Errorf("read needs-attention.md: %v", err)
+	} else if !strings.HasPrefix(string(data), "# "+testTeamKey+": needs attention") {
+		t.Errorf("needs-attention.md header = %q", strings.SplitN(string(data), "\n", 2)[0])
+	}
+
+	// by/blocked/ and issue.meta's blocked flag: documented, and both served.
+	if !strings.Contains(readme, "by/blocked/") || !strings.Contains(readme, "blockedBy") {
+		t.Error("README does not document by/blocked/ and blockedBy")
+	}
+	if _, err := os.ReadDir(filepath.Join(teamPath(testTeamKey), "by", "blocked")); err != nil {
+		t.Errorf("read by/blocked/: %v", err)
+	}
+	if data, err := os.ReadFile(filepath.Join(issueDirPath(testTeamKey, "TST-1"), "issue.meta")); err != nil {
+		t.Errorf("read TST-1/issue.meta: %v", err)
+	} else if !strings.Contains(string(data), "blocked: ") {
+		t.Errorf("issue.meta has no blocked flag:\n%s", data)
+	}
+
+	// users/<name>/workload.md: documented in the users map, and readable in
+	// the first fixture user's directory.
+	if !strings.Contains(readme, "workload.md") {
+		t.Error("README does not mention workload.md")
+	}
+	if users, err := os.ReadDir(usersPath()); err != nil || len(users) == 0 {
+		t.Errorf("read users/: %v (%d entries)", err, len(users))
+	} else if data, err := os.ReadFile(filepath.Join(userPath(users[0].Name()), "workload.md")); err != nil {
+		t.Errorf("read workload.md: %v", err)
+	} else if !strings.Contains(string(data), "# Workload: ") {
+		t.Errorf("workload.md has no heading: %q", data)
+	}
+
+	// issue.pdf: documented in the issue map, and a real PDF.
+	if !strings.Contains(readme, "issue.pdf") {
+		t.Error("README does not mention issue.pdf")
+	}
+	if data, err := os.ReadFile(filepath.Join(issueDirPath(testTeamKey, "TST-1"), "issue.pdf")); err != nil {
+		t.Errorf("read TST-1/issue.pdf: %v", err)
+	} else if !strings.HasPrefix(string(data), "%PDF-") {
+		t.Errorf("issue.pdf header = %q", data[:min(len(data), 8)])
+	}
+
+	// docs/search/: documented at the root, and any query lists (possibly
+	// empty) rather than failing.
+	if !strings.Contains(readme, "docs/search/") {
+		t.Error("README does not mention docs/search/")
+	}
+	if _, err := os.ReadDir(filepath.Join(rootPath(), "docs", "search", "document")); err != nil {
+		t.Errorf("read docs/search/document: %v", err)
+	}
+
+	// mount.icon_prefix: documented, and the bare team key still resolves
+	// (the fixture mount leaves the prefix off).
+	if !strings.Contains(readme, "mount.icon_prefix") {
+		t.Error("README does not mention mount.icon_prefix")
+	}
+	if data, err := os.ReadFile(filepath.Join(teamPath(testTeamKey), "team.md")); err != nil {
+		t.Errorf("read team.md: %v", err)
+	} else if !strings.Contains(string(data), "icon:") {
+		t.Errorf("team.md has no icon: %q", data)
+	}
+
+	// .events: documented at the root and listed (never read here: a read
+	// blocks until sync reports a change).
+	if !strings.Contains(readme, ".events") {
+		t.Error("README does not mention .events")
+	}
+	if _, err := os.Stat(filepath.Join(rootPath(), ".events")); err != nil {
+		t.Errorf("stat .events: %v", err)
+	}
+
+	// organization.md: documented at the root and always readable (a
+	// placeholder before the first fetch, never ENOENT).
+	if !strings.Contains(readme, "organization.md") {
+		t.Error("README does not mention organization.md")
+	}
+	if data, err := os.ReadFile(filepath.Join(rootPath(), "organization.md")); err != nil {
+		t.Errorf("read organization.md: %v", err)
+	} else if !strings.Contains(string(data), "# ") {
+		t.Errorf("organization.md has no heading: %q", data)
+	}
+
+	// views/: documented in the team map; the fixture config defines
+	// "urgent" (priority<=high), which must hold TST-2 (urgent) and not TST-3
+	// (low).
+	if !strings.Contains(readme, "views/{name}/") {
+		t.Error("README does not mention views/{name}/")
+	}
+	if entries, err := os.ReadDir(filepath.Join(teamPath(testTeamKey), "views", "urgent")); err != nil {
+		t.Errorf("read views/urgent: %v", err)
+	} else {
+		names := map[string]bool{}
+		for _, e := range entries {
+			names[e.Name()] = true
+		}
+		if !names["TST-2"] || names["TST-3"] {
+			t.Errorf("views/urgent = %v, want TST-2 and not TST-3", names)
+		}
+	}
+
+	// Root views/ (Linear saved views): documented, and always listable —
+	// empty in fixture mode, where nothing fetches the view list.
+	if !strings.Contains(readme, "saved view") {
+		t.Error("README does not mention saved views")
+	}
+	if _, err := os.ReadDir(filepath.Join(rootPath(), "views")); err != nil {
+		t.Errorf("read views/: %v", err)
+	}
+
+	// Sub-initiatives (rollup.md + sub-initiatives/): documented, and present on
+	// every initiative even when it has no children.
+	for _, want := range []string{"rollup.md", "sub-initiatives/"} {
+		if !strings.Contains(readme, want) {
+			t.Errorf("README does not mention %q", want)
+		}
+	}
+	initDir := filepath.Join(initiativesPath(), "test-initiative")
+	if data, err := os.ReadFile(filepath.Join(initDir, "rollup.md")); err != nil {
+		t.Errorf("read rollup.md: %v", err)
+	} else if !strings.Contains(string(data), "# Rollup") {
+		t.Errorf("rollup.md missing heading:\n%s", data)
+	}
+	if _, err := os.ReadDir(filepath.Join(initDir, "sub-initiatives")); err != nil {
+		t.Errorf("read sub-initiatives/: %v", err)
+	}
+
 	// The meta split moved server fields out of the editable files. The README's
 	// frontmatter templates must not document them as editable-file fields, or an
 	// agent will look for/edit fields that no longer live there (the exact "the
@@ -8,14 +8,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jra3/linear-fuse/internal/api"
 	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/sync"
 	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
 )
 
 // TestRemoteUpdateVisibleAfterKernelRevalidation is the decisive experiment
 // for the captured-entity staleness question (round-15 review, candidate 1):
-// when a REMOTE change lands in SQLite (the sync worker's job — it never
-// notifies the kernel), freshness relies on the kernel re-asking userspace
+// when a REMOTE change lands in SQLite without a kernel notify (the sync
+// worker notifies now — see TestSyncChangeNotifiesKernel — but a notify can be
+// abandoned by boundedNotify), freshness relies on the kernel re-asking userspace
 // via Lookup after its caches drop. go-fuse then reuses the already-known
 // node for a stable ino (bridge.addNewChild: "child is ignored"), so if the
 // serving nodes bake the entity at first Lookup, the re-Lookup serves the
@@ -77,8 +80,8 @@ func TestRemoteUpdateVisibleAfterKernelRevalidation(t *testing.T) {
 	defer pin.Close()
 
 	// Simulate the sync worker landing a remote edit: same issue, new title,
-	// newer updatedAt — written straight to the store, no kernel notification
-	// (faithful to production sync).
+	// newer updatedAt — written straight to the store, with the kernel
+	// notification deliberately skipped (the fallback path under test).
 	renamed := fixtures.FixtureAPIIssue(
 		fixtures.WithIssueID(issueID, identifier),
 		fixtures.WithTitle("Renamed By Remote Sync"),
@@ -93,8 +96,8 @@ func TestRemoteUpdateVisibleAfterKernelRevalidation(t *testing.T) {
 		t.Fatalf("upsert: %v", err)
 	}
 
-	// Let the kernel's caches expire for real — the production freshness
-	// mechanism. The sync worker never notifies the kernel; entry timeouts
+	// Let the kernel's caches expire for real — the fallback freshness
+	// mechanism. With no notify, entry timeouts
 	// (30s) make the next path walk re-Lookup every component, and each
 	// re-Lookup runs the nodeRefresher seam. (The ino namespace is total now —
 	// every ancestor dir has a derivable stable ino — but timeout-driven
@@ -140,6 +143,94 @@ func TestRemoteUpdateVisibleAfterKernelRevalidation(t *testing.T) {
 	}
 }
 
+// TestSyncChangeNotifiesKernel pins the guarantee for sync-driven changes:
+// once the worker reports a change (lfs.Changed, exactly what it calls after
+// its upsert), the kernel's cached views are dropped at once — no 30s wait. A
+// pinned, already-read issue.md re-reads with the remote title and a fresh
+// mtime, and a remotely created issue is listed in issues/ and by/status/ on
+// the next readdir. This is what stat-polling watchers and editors' "file
+// changed on disk" checks rely on; inotify events are not part of it (FUSE
+// has no way to raise them for remote changes — watch /.events instead).
+func TestSyncChangeNotifiesKernel(t *testing.T) {
+	ctx := context.Background()
+	if testStore == nil {
+		t.Skip("store-backed sync simulation requires fixture mode")
+	}
+
+	team := fixtures.FixtureAPITeam()
+	uniq := time.Now().UnixNano()
+	issueID := fmt.Sprintf("notify-issue-%d", uniq)
+	identifier := fmt.Sprintf("TST-%d", 80000+uniq%10000)
+	upsert := func(issue api.Issue) {
+		t.Helper()
+		row, err := db.APIIssueToDBIssue(issue)
+		if err != nil {
+			t.Fatalf("convert: %v", err)
+		}
+		if err := testStore.Queries().UpsertIssue(ctx, row.ToUpsertParams()); err != nil {
+			t.Fatalf("upsert: %v", err)
+		}
+	}
+	t.Cleanup(func() { _ = testStore.Queries().DeleteIssue(context.Background(), issueID) })
+
+	// Prime the kernel's negative and listing caches before the issue exists.
+	if _, err := os.ReadDir(issuesPath(testTeamKey)); err != nil {
+		t.Fatalf("list issues/: %v", err)
+	}
+	if _, err := os.Stat(issueDirPath(testTeamKey, identifier)); !os.IsNotExist(err) {
+		t.Fatalf("stat before create: %v", err)
+	}
+
+	created := fixtures.FixtureAPIIssue(
+		fixtures.WithIssueID(issueID, identifier),
+		fixtures.WithTitle("Notify Probe Original"),
+		fixtures.WithTeam(&team),
+	)
+	upsert(created)
+	lfs.Changed(sync.Change{Entity: "issue", ID: issueID, Identifier: identifier, Team: testTeamKey, Action: "created", Issue: &created})
+
+	for _, dir := range []string{issuesPath(testTeamKey), byStatusPath(testTeamKey, created.State.Name)} {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("list %s: %v", dir, err)
+		}
+		found := false
+		for _, e := range entries {
+			found = found || e.Name() == identifier
+		}
+		if !found {
+			t.Errorf("%s does not list the synced-in %s right after the notify", dir, identifier)
+		}
+	}
+
+	path := issueFilePath(testTeamKey, identifier)
+	if data, err := os.ReadFile(path); err != nil || !strings.Contains(string(data), "Notify Probe Original") {
+		t.Fatalf("first read: %v\n%s", err, data)
+	}
+	pin, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("pin open: %v", err)
+	}
+	defer pin.Close()
+	stBefore, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+
+	renamed := created
+	renamed.Title = "Renamed By Remote Sync"
+	renamed.UpdatedAt = stBefore.ModTime().Add(time.Minute)
+	upsert(renamed)
+	lfs.Changed(sync.Change{Entity: "issue", ID: issueID, Identifier: identifier, Team: testTeamKey, Action: "updated", Issue: &renamed, Previous: &created})
+
+	if data, err := os.ReadFile(path); err != nil || !strings.Contains(string(data), "Renamed By Remote Sync") {
+		t.Errorf("issue.md not fresh right after the sync notify: %v\n%s", err, data)
+	}
+	if st, err := os.Stat(path); err != nil || !st.ModTime().After(stBefore.ModTime()) {
+		t.Errorf("issue.md mtime not advanced right after the sync notify: %v", err)
+	}
+}
+
 // TestRejectedSaveKeepsDirtyContentReadable pins the size half of the
 // dirty-buffer-wins rule: a rejected save (EINVAL) deliberately leaves the
 // user's content in the edit buffer so it can be corrected and re-saved — and
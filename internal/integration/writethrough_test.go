@@ -0,0 +1,165 @@
+package integration
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The write-through contract: once a write through the mount returns, every
+// derived view of what it changed already reflects it — issues/, recent/,
+// by/*, my/ — with no sleep and no retry. The handlers upsert SQLite and then
+// notify the kernel (issuecoherence.go) before they reply, and boundedNotify
+// waits for each notify, so there is no window to poll across. A test that
+// needs waitForDirEntry after its own write is papering over a missing
+// invalidation.
+//
+// assertWriteThrough codifies that: it primes the kernel's caches of every view
+// first (a readdir and a lookup, so a stale listing and a stale or negative
+// dentry are both in place), runs the write, then checks each view exactly
+// once. A new derived view (a future issue search/ dir, a new by/ facet) joins
+// by adding a view to the tests below — and an entry to issueMemberships.
+//
+// The polling helpers (dirHas/dirLacks) are for changes the mount did not make:
+// API-direct setup and the sync worker.
+
+// view is one place a write must (or must no longer) be visible: name listed in
+// dir and, when listed, resolvable by lookup. Absence is judged by the listing
+// alone, since issues/ deliberately resolves any identifier it can fetch,
+// archived or not.
+type view struct {
+	dir, name string
+	want      bool
+}
+
+// listed is a view in which name must appear.
+func listed(dir, name string) view { return view{dir: dir, name: name, want: true} }
+
+// unlisted is a view from which name must be gone.
+func unlisted(dir, name string) view { return view{dir: dir, name: name} }
+
+// primeViews fills the kernel's caches for each view. Errors are ignored: a
+// view the write is about to create may not resolve yet, and that negative
+// lookup is exactly the cache entry the write must drop.
+func primeViews(views ...view) {
+	for _, v := range views {
+		_, _ = os.ReadDir(v.dir)
+		_, _ = os.Lstat(filepath.Join(v.dir, v.name))
+	}
+}
+
+// checkViews asserts each view once — one readdir, one lookup — and reports
+// every mismatch rather than stopping at the first, so one run names all the
+// views a missing invalidation left stale.
+func checkViews(t *testing.T, views ...view) {
+	t.Helper()
+	for _, v := range views {
+		inListing := false
+		if entries, err := os.ReadDir(v.dir); err == nil {
+			for _, e := range entries {
+				if e.Name() == v.name {
+					inListing = true
+					break
+				}
+			}
+		} else if v.want || !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("readdir %s: %v", v.dir, err)
+			continue
+		}
+		_, err := os.Lstat(filepath.Join(v.dir, v.name))
+		switch {
+		case v.want && !inListing:
+			t.Errorf("%s missing from listing of %s right after the write", v.name, v.dir)
+		case v.want && err != nil:
+			t.Errorf("%s listed in %s but lookup failed right after the write: %v", v.name, v.dir, err)
+		case !v.want && inListing:
+			t.Errorf("%s still listed in %s right after the write", v.name, v.dir)
+		}
+	}
+}
+
+// assertWriteThrough primes views, runs write, and checks views — the whole
+// contract for a write whose affected names are known up front. A create,
+// whose name is only known afterwards, calls primeViews and checkViews itself.
+func assertWriteThrough(t *testing.T, write func() error, views ...view) {
+	t.Helper()
+	primeViews(views...)
+	if err := write(); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	checkViews(t, views...)
+}
+
+// TestWriteThrough_IssueCreateAndEdit runs the contract over an issue's whole
+// derived surface: a _create lands in issues/, recent/, and its status and
+// priority buckets; an issue.md edit then moves it between buckets.
+func TestWriteThrough_IssueCreateAndEdit(t *testing.T) {
+	if liveAPIMode {
+		t.Skip("fixture-mode write-through check; uses the mock mutator")
+	}
+	enableMockMutations(t)
+
+	const title = "Write Through Harness Probe"
+	buckets := []string{
+		byStatusPath(testTeamKey, "Backlog"),
+		byStatusPath(testTeamKey, "In Progress"),
+		filepath.Join(byPriorityPath(testTeamKey), "low"),
+		filepath.Join(byPriorityPath(testTeamKey), "high"),
+	}
+	var prime []view
+	for _, dir := range append([]string{issuesPath(testTeamKey), recentPath(testTeamKey)}, buckets...) {
+		prime = append(prime, view{dir: dir})
+	}
+	primeViews(prime...)
+
+	spec := "---\ntitle: " + title + "\nstatus: Backlog\npriority: low\n---\n"
+	if err := writeCreateSpec(t, spec); err != nil {
+		t.Fatalf("create via _create should succeed with mock mutator: %v", err)
+	}
+	last := lastEntryByTitle(t, issuesLastPath(testTeamKey), title)
+	if last == nil || last["identifier"] == "" {
+		t.Fatalf("issues/.last has no identity for %q: %v", title, last)
+	}
+	id := last["identifier"]
+	defer func() { _ = os.Remove(issueDirPath(testTeamKey, id)) }() // best-effort cleanup (archive)
+
+	checkViews(t,
+		listed(issuesPath(testTeamKey), id),
+		listed(recentPath(testTeamKey), id),
+		listed(buckets[0], id),
+		unlisted(buckets[1], id),
+		listed(buckets[2], id),
+		unlisted(buckets[3], id),
+	)
+
+	path := issueFilePath(testTeamKey, id)
+	assertWriteThrough(t, func() error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if content, err = modifyFrontmatter(content, "status", "In Progress"); err != nil {
+			return err
+		}
+		if content, err = modifyFrontmatter(content, "priority", "high"); err != nil {
+			return err
+		}
+		return os.WriteFile(path, content, 0o644)
+	},
+		listed(issuesPath(testTeamKey), id),
+		listed(recentPath(testTeamKey), id),
+		unlisted(buckets[0], id),
+		listed(buckets[1], id),
+		unlisted(buckets[2], id),
+		listed(buckets[3], id),
+	)
+
+	// Archive: the issue leaves every view at once.
+	var gone []view
+	for _, dir := range append([]string{issuesPath(testTeamKey), recentPath(testTeamKey)}, buckets...) {
+		gone = append(gone, unlisted(dir, id))
+	}
+	assertWriteThrough(t, func() error { return os.Remove(issueDirPath(testTeamKey, id)) }, gone...)
+}
@@ -0,0 +1,364 @@
+// Package livesync is the subscription transport for real-time sync
+// (live_sync.transport: subscription): an outbound websocket to Linear's
+// GraphQL endpoint speaking graphql-transport-ws, so a mount behind NAT — no
+// public URL for a webhook — still hears about changes within seconds. Each
+// change event arrives in the webhook delivery envelope and is handed to an
+// Applier, in practice a webhook.Handler, which writes it into the store and
+// reports it through the sync worker's ChangeListener seam like any delivery.
+//
+// The polling sync keeps running underneath as the reconciler: an event lost
+// while the socket was down is picked up at the next cycle. Before
+// subscribing, the session introspects the endpoint's subscription root; an
+// endpoint that does not serve the change feed (Linear's public API has no
+// subscription root today) or refuses the credential is logged once and the
+// transport goes idle, leaving the mount on polling. Anything else reconnects
+// with backoff.
+package livesync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/webhook"
+)
+
+// Subprotocol is the websocket subprotocol spoken (graphql-ws's successor,
+// github.com/enisdenjo/graphql-ws).
+const Subprotocol = "graphql-transport-ws"
+
+// ChangeField is the subscription root field the transport subscribes to: a
+// stream of change events shaped like webhook deliveries.
+const ChangeField = "entityChanged"
+
+// querySubscriptionFields introspects the subscription root's fields; a
+// schema without one answers subscriptionType: null.
+const querySubscriptionFields = `
+query SubscriptionFields {
+  __schema {
+    subscriptionType { fields { name } }
+  }
+}
+`
+
+// subscriptionChanges streams change events in the webhook envelope. data is
+// a JSON scalar: the entity in Linear's webhook shape.
+const subscriptionChanges = `
+subscription LiveSync($resourceTypes: [String!]!) {
+  entityChanged(resourceTypes: $resourceTypes) {
+    action
+    type
+    data
+    url
+  }
+}
+`
+
+// connectionAckTimeout bounds the wait for the server to accept
+// connection_init (graphql-transport-ws closes a connection that sends
+// nothing for the same reason).
+const connectionAckTimeout = 10 * time.Second
+
+// Retry delays between sessions: doubled after each failed one, reset once a
+// session gets as far as subscribing.
+const (
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 5 * time.Minute
+)
+
+// Applier writes one change event, in the webhook delivery envelope, into the
+// store and reports it. webhook.Handler implements it.
+type Applier interface {
+	Apply(ctx context.Context, event []byte) error
+}
+
+// Subscriber holds the subscription for the life of the mount.
+type Subscriber struct {
+	url   string
+	auth  func() string // the Authorization header value, fetched per session so OAuth refreshes
+	apply Applier
+
+	retryDelay time.Duration // the first delay after a failed session (minRetryDelay; tests lower it)
+}
+
+// New returns a Subscriber to the endpoint at url (ws:// or wss://),
+// authenticating with the header value auth returns and applying events with
+// apply.
+func New(url string, auth func() string, apply Applier) *Subscriber {
+	return &Subscriber{url: url, auth: auth, apply: apply, retryDelay: minRetryDelay}
+}
+
+// Run holds the subscription until ctx is done, reconnecting with backoff
+// after a dropped session. It returns early, logging why, when the endpoint
+// can never serve the subscription.
+func (s *Subscriber) Run(ctx context.Context) {
+	delay := s.retryDelay
+	for {
+		subscribed, err := s.session(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if permanent(err) {
+			log.Printf("[livesync] Warning: %v; relying on the sync interval", err)
+			return
+		}
+		if subscribed {
+			delay = s.retryDelay
+		}
+		log.Printf("[livesync] Session ended: %v; reconnecting in %s", err, delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// errUnsupported is an endpoint whose schema has no change feed.
+var errUnsupported = fmt.Errorf("endpoint serves no %s subscription", ChangeField)
+
+// closeError is a close frame from the server, with its status code.
+type closeError struct {
+	Code   int
+	Reason string
+}
+
+func (e *closeError) Error() string {
+	return fmt.Sprintf("closed by server: %d %s", e.Code, e.Reason)
+}
+
+// operationError is an error message the server answered an operation with.
+type operationError struct {
+	Op       string
+	Messages []string
+}
+
+func (e *operationError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Op, e.Messages)
+}
+
+// permanent reports whether err means retrying cannot help: the endpoint has
+// no change feed, rejects the operation, is missing, or refuses the
+// credential. Timeouts and rate limits are retried.
+func permanent(err error) bool {
+	if errors.Is(err, errUnsupported) {
+		return true
+	}
+	var opErr *operationError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var hsErr *handshakeError
+	if errors.As(err, &hsErr) {
+		return hsErr.Status >= 400 && hsErr.Status < 500 &&
+			hsErr.Status != http.StatusRequestTimeout && hsErr.Status != http.StatusTooManyRequests
+	}
+	var clErr *closeError
+	if errors.As(err, &clErr) {
+		// graphql-transport-ws: 4400 invalid message, 4401 unauthorized,
+		// 4403 forbidden.
+		return clErr.Code == 4400 || clErr.Code == 4401 || clErr.Code == 4403
+	}
+	return false
+}
+
+// message is one graphql-transport-ws message.
+type message struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// result is the payload of a next message.
+type result struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// session runs one connection: handshake, introspection, then events until
+// the connection drops. subscribed reports whether it got as far as
+// subscribing.
+func (s *Subscriber) session(ctx context.Context) (subscribed bool, err error) {
+	header := http.Header{}
+	if auth := s.auth(); auth != "" {
+		header.Set("Authorization", auth)
+	}
+	c, err := dial(ctx, s.url, Subprotocol, header)
+	if err != nil {
+		return false, err
+	}
+	// Reads block; dropping the connection is what ends one on shutdown.
+	stop := context.AfterFunc(ctx, func() { c.rwc.Close() })
+	defer stop()
+	defer c.close()
+
+	if err := s.handshake(c, header.Get("Authorization")); err != nil {
+		return false, err
+	}
+	if err := s.checkSchema(c); err != nil {
+		return false, err
+	}
+	if err := s.send(c, message{ID: "changes", Type: "subscribe"}, map[string]any{
+		"query":     subscriptionChanges,
+		"variables": map[string]any{"resourceTypes": webhook.ResourceTypes},
+	}); err != nil {
+		return false, err
+	}
+	log.Printf("[livesync] Subscribed at %s", s.url)
+
+	for {
+		m, err := s.read(c)
+		if err != nil {
+			return true, err
+		}
+		switch m.Type {
+		case "next":
+			s.applyNext(ctx, m.Payload)
+		case "error":
+			return true, operationErr("subscribe "+ChangeField, m.Payload)
+		case "complete":
+			return true, errors.New("server completed the subscription")
+		}
+	}
+}
+
+// handshake sends connection_init, carrying the credential again in the
+// payload for servers that read it there, and waits for connection_ack.
+func (s *Subscriber) handshake(c *conn, auth string) error {
+	if err := s.send(c, message{Type: "connection_init"}, map[string]string{"Authorization": auth}); err != nil {
+		return err
+	}
+	timer := time.AfterFunc(connectionAckTimeout, func() { c.rwc.Close() })
+	defer timer.Stop()
+	for {
+		m, err := s.read(c)
+		if err != nil {
+			return fmt.Errorf("connection_init: %w", err)
+		}
+		if m.Type == "connection_ack" {
+			return nil
+		}
+	}
+}
+
+// checkSchema runs querySubscriptionFields as a single-result operation and
+// fails with errUnsupported unless the root serves ChangeField.
+func (s *Subscriber) checkSchema(c *conn) error {
+	if err := s.send(c, message{ID: "schema", Type: "subscribe"}, map[string]string{"query": querySubscriptionFields}); err != nil {
+		return err
+	}
+	var fields []string
+	for {
+		m, err := s.read(c)
+		if err != nil {
+			return err
+		}
+		switch m.Type {
+		case "next":
+			var r struct {
+				Data struct {
+					Schema struct {
+						SubscriptionType *struct {
+							Fields []struct {
+								Name string `json:"name"`
+							} `json:"fields"`
+						} `json:"subscriptionType"`
+					} `json:"__schema"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(m.Payload, &r); err != nil {
+				return fmt.Errorf("introspection: %w", err)
+			}
+			if st := r.Data.Schema.SubscriptionType; st != nil {
+				for _, f := range st.Fields {
+					fields = append(fields, f.Name)
+				}
+			}
+		case "error":
+			return operationErr("introspection", m.Payload)
+		case "complete":
+			if !slices.Contains(fields, ChangeField) {
+				return errUnsupported
+			}
+			return nil
+		}
+	}
+}
+
+// applyNext applies the event a next message carries. A failure is logged
+// and skipped: the sync worker reconciles the entity at its next cycle.
+func (s *Subscriber) applyNext(ctx context.Context, payload json.RawMessage) {
+	var r result
+	if err := json.Unmarshal(payload, &r); err != nil {
+		log.Printf("[livesync] Malformed event: %v", err)
+		return
+	}
+	for _, e := range r.Errors {
+		log.Printf("[livesync] Event error: %s", e.Message)
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(r.Data, &data); err != nil || data[ChangeField] == nil {
+		return
+	}
+	if err := s.apply.Apply(ctx, data[ChangeField]); err != nil {
+		log.Printf("[livesync] Apply event: %v", err)
+	}
+}
+
+// send writes m with payload, when not nil, marshalled into it.
+func (s *Subscriber) send(c *conn, m message, payload any) error {
+	if payload != nil {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		m.Payload = raw
+	}
+	msg, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return c.writeText(msg)
+}
+
+// read returns the next protocol message, answering the server's pings.
+func (s *Subscriber) read(c *conn) (message, error) {
+	for {
+		raw, err := c.readMessage()
+		if err != nil {
+			return message{}, err
+		}
+		var m message
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return message{}, fmt.Errorf("malformed message: %w", err)
+		}
+		if m.Type == "ping" {
+			if err := s.send(c, message{Type: "pong"}, nil); err != nil {
+				return message{}, err
+			}
+			continue
+		}
+		return m, nil
+	}
+}
+
+// operationErr decodes an error message's payload, a list of GraphQL errors.
+func operationErr(op string, payload json.RawMessage) error {
+	var errs []struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(payload, &errs)
+	e := &operationError{Op: op}
+	for _, m := range errs {
+		e.Messages = append(e.Messages, m.Message)
+	}
+	return e
+}
@@ -0,0 +1,267 @@
+package livesync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// serverConn is the server end of one test session.
+type serverConn struct {
+	t  *testing.T
+	br *bufio.Reader
+	nc net.Conn
+}
+
+// newServer serves websocket sessions, running script on each; the session
+// number (from 1) lets a script behave differently on reconnect.
+func newServer(t *testing.T, script func(sc *serverConn, session int)) string {
+	t.Helper()
+	var sessions atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" || r.Header.Get("Sec-WebSocket-Protocol") != Subprotocol {
+			http.Error(w, "not a websocket", http.StatusBadRequest)
+			return
+		}
+		nc, brw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		defer nc.Close()
+		brw.WriteString("HTTP/1.1 101 Switching Protocols\r\n" +
+			"Upgrade: websocket\r\nConnection: Upgrade\r\n" +
+			"Sec-WebSocket-Accept: " + acceptKey(r.Header.Get("Sec-WebSocket-Key")) + "\r\n" +
+			"Sec-WebSocket-Protocol: " + Subprotocol + "\r\n\r\n")
+		brw.Flush()
+		script(&serverConn{t: t, br: brw.Reader, nc: nc}, int(sessions.Add(1)))
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+// read returns the client's next message, which must be masked; ok is false
+// once the client has gone.
+func (sc *serverConn) read() (m message, ok bool) {
+	for {
+		f, err := readFrame(sc.br)
+		if err != nil || f.op == opClose {
+			return message{}, false
+		}
+		if !f.masked {
+			sc.t.Error("client frame not masked")
+		}
+		if f.op == opPong {
+			continue
+		}
+		if err := json.Unmarshal(f.payload, &m); err != nil {
+			sc.t.Errorf("client message %q: %v", f.payload, err)
+		}
+		return m, true
+	}
+}
+
+// expect reads the client's next message and checks its type.
+func (sc *serverConn) expect(typ string) message {
+	m, ok := sc.read()
+	if !ok || m.Type != typ {
+		sc.t.Errorf("client sent %+v (open %v), want %s", m, ok, typ)
+	}
+	return m
+}
+
+func (sc *serverConn) send(m message) {
+	raw, _ := json.Marshal(m)
+	sc.frame(true, opText, raw)
+}
+
+// frame writes one unmasked frame; fin false leaves the message open for a
+// continuation.
+func (sc *serverConn) frame(fin bool, op byte, payload []byte) {
+	var buf bytes.Buffer
+	writeFrame(&buf, op, payload, false)
+	raw := buf.Bytes()
+	if !fin {
+		raw[0] &^= 0x80
+	}
+	if _, err := sc.nc.Write(raw); err != nil {
+		sc.t.Errorf("write frame: %v", err)
+	}
+}
+
+// accept answers connection_init and the introspection with a schema whose
+// subscription root has fields, leaving the client about to subscribe.
+func (sc *serverConn) accept(fields ...string) {
+	sc.expect("connection_init")
+	sc.send(message{Type: "connection_ack"})
+	m := sc.expect("subscribe")
+	var names []map[string]string
+	for _, f := range fields {
+		names = append(names, map[string]string{"name": f})
+	}
+	var st any
+	if names != nil {
+		st = map[string]any{"fields": names}
+	}
+	payload, _ := json.Marshal(map[string]any{"data": map[string]any{"__schema": map[string]any{"subscriptionType": st}}})
+	sc.send(message{ID: m.ID, Type: "next", Payload: payload})
+	sc.send(message{ID: m.ID, Type: "complete"})
+}
+
+// event renders a next message carrying one change event.
+func event(id, action, entityID string) []byte {
+	payload, _ := json.Marshal(map[string]any{"data": map[string]any{ChangeField: map[string]any{
+		"action": action, "type": "Issue", "data": map[string]string{"id": entityID},
+	}}})
+	raw, _ := json.Marshal(message{ID: id, Type: "next", Payload: payload})
+	return raw
+}
+
+// applier records applied events on a channel.
+type applier chan string
+
+func (a applier) Apply(ctx context.Context, ev []byte) error {
+	var d struct {
+		Action string `json:"action"`
+		Data   struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(ev, &d); err != nil {
+		return err
+	}
+	a <- d.Action + " " + d.Data.ID
+	return nil
+}
+
+func (a applier) next(t *testing.T) string {
+	t.Helper()
+	select {
+	case got := <-a:
+		return got
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event applied")
+		return ""
+	}
+}
+
+// runAsync runs s until the returned cancel, which waits for Run to return.
+func runAsync(s *Subscriber) (done chan struct{}, cancel func()) {
+	ctx, stop := context.WithCancel(context.Background())
+	done = make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	return done, func() {
+		stop()
+		<-done
+	}
+}
+
+func TestSubscriber_AppliesEvents(t *testing.T) {
+	t.Parallel()
+	url := newServer(t, func(sc *serverConn, _ int) {
+		init, _ := sc.read()
+		var auth map[string]string
+		_ = json.Unmarshal(init.Payload, &auth)
+		if init.Type != "connection_init" || auth["Authorization"] != "lin_api_test" {
+			t.Errorf("init = %+v", init)
+		}
+		sc.send(message{Type: "connection_ack"})
+		m := sc.expect("subscribe")
+		sc.send(message{ID: m.ID, Type: "next", Payload: json.RawMessage(
+			`{"data":{"__schema":{"subscriptionType":{"fields":[{"name":"other"},{"name":"` + ChangeField + `"}]}}}}`)})
+		sc.send(message{ID: m.ID, Type: "complete"})
+
+		sub := sc.expect("subscribe")
+		if !strings.Contains(string(sub.Payload), ChangeField) || !strings.Contains(string(sub.Payload), `"Issue"`) {
+			t.Errorf("subscribe payload = %s", sub.Payload)
+		}
+		sc.send(message{Type: "ping"})
+		sc.expect("pong")
+
+		sc.frame(true, opText, event(sub.ID, "create", "issue-1"))
+		// A fragmented event with a websocket ping between its fragments.
+		ev := event(sub.ID, "update", "issue-2")
+		sc.frame(false, opText, ev[:10])
+		sc.frame(true, opPing, []byte("hi"))
+		sc.frame(true, opContinuation, ev[10:])
+		for {
+			if _, ok := sc.read(); !ok {
+				return
+			}
+		}
+	})
+
+	a := make(applier, 4)
+	done, cancel := runAsync(New(url, func() string { return "lin_api_test" }, a))
+	defer cancel()
+	if got := a.next(t); got != "create issue-1" {
+		t.Errorf("first event = %q", got)
+	}
+	if got := a.next(t); got != "update issue-2" {
+		t.Errorf("fragmented event = %q", got)
+	}
+	select {
+	case <-done:
+		t.Fatal("Run returned while subscribed")
+	default:
+	}
+}
+
+func TestSubscriber_IdleWithoutChangeFeed(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		script func(sc *serverConn, session int)
+	}{
+		{name: "no subscription root", script: func(sc *serverConn, _ int) { sc.accept(); sc.read() }},
+		{name: "no change field", script: func(sc *serverConn, _ int) { sc.accept("issueUpdated"); sc.read() }},
+		{name: "unauthorized", script: func(sc *serverConn, _ int) {
+			sc.expect("connection_init")
+			sc.frame(true, opClose, append([]byte{0x11, 0x31}, "Unauthorized"...)) // 4401
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			done, cancel := runAsync(New(newServer(t, tt.script), func() string { return "" }, make(applier, 1)))
+			defer cancel()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Run kept retrying an endpoint that cannot serve the subscription")
+			}
+		})
+	}
+}
+
+func TestSubscriber_Reconnects(t *testing.T) {
+	t.Parallel()
+	url := newServer(t, func(sc *serverConn, session int) {
+		sc.accept(ChangeField)
+		sub := sc.expect("subscribe")
+		if session == 1 {
+			return // drop the connection
+		}
+		sc.frame(true, opText, event(sub.ID, "create", "issue-1"))
+		sc.read()
+	})
+	a := make(applier, 1)
+	s := New(url, func() string { return "" }, a)
+	s.retryDelay = 10 * time.Millisecond
+	_, cancel := runAsync(s)
+	defer cancel()
+	if got := a.next(t); got != "create issue-1" {
+		t.Errorf("event after reconnect = %q", got)
+	}
+}
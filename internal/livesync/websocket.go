@@ -0,0 +1,291 @@
+package livesync
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	gosync "sync"
+)
+
+// A minimal RFC 6455 client, enough for one graphql-transport-ws session:
+// text messages (fragmented or not), ping/pong, and close. No extensions are
+// negotiated, so frames carry no compression. The handshake goes through
+// net/http, which hands back a 101 response's connection as its Body.
+
+// Frame opcodes (RFC 6455 §5.2).
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// acceptGUID is appended to the handshake key to derive Sec-WebSocket-Accept.
+const acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxMessageBytes bounds one message, as maxBodyBytes bounds a webhook
+// delivery: an issue event with a long description is tens of KB.
+const maxMessageBytes = 1 << 20
+
+// handshakeError is a handshake the server answered with something other
+// than 101; Status is its HTTP status.
+type handshakeError struct {
+	Status int
+}
+
+func (e *handshakeError) Error() string {
+	return fmt.Sprintf("websocket handshake: %s", http.StatusText(e.Status))
+}
+
+// conn is one client websocket.
+type conn struct {
+	rwc io.ReadWriteCloser
+	br  *bufio.Reader
+
+	writeMu gosync.Mutex // a frame is written whole; pongs race the session's writes
+}
+
+// dial opens a websocket to rawURL (ws:// or wss://), offering subprotocol,
+// with header added to the handshake request.
+func dial(ctx context.Context, rawURL, subprotocol string, header http.Header) (*conn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	default:
+		return nil, fmt.Errorf("websocket: unsupported scheme %q", u.Scheme)
+	}
+	var nonce [16]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce[:])
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Protocol", subprotocol)
+
+	// The upgrade is HTTP/1.1 only: a TLS config of our own keeps the
+	// transport from negotiating h2.
+	client := &http.Client{Transport: &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{NextProtos: []string{"http/1.1"}},
+	}}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		resp.Body.Close()
+		return nil, &handshakeError{Status: resp.StatusCode}
+	}
+	rwc, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		resp.Body.Close()
+		return nil, errors.New("websocket handshake: connection not writable")
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		rwc.Close()
+		return nil, errors.New("websocket handshake: bad Sec-WebSocket-Accept")
+	}
+	if got := resp.Header.Get("Sec-WebSocket-Protocol"); got != subprotocol {
+		rwc.Close()
+		return nil, fmt.Errorf("websocket handshake: server chose subprotocol %q", got)
+	}
+	return &conn{rwc: rwc, br: bufio.NewReader(rwc)}, nil
+}
+
+// acceptKey derives the Sec-WebSocket-Accept a server answers key with.
+func acceptKey(key string) string {
+	sum := sha1.Sum([]byte(key + acceptGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// writeText sends one text message.
+func (c *conn) writeText(msg []byte) error {
+	return c.write(opText, msg)
+}
+
+// write sends one masked frame, as a client must.
+func (c *conn) write(op byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return writeFrame(c.rwc, op, payload, true)
+}
+
+// readMessage returns the next text or binary message, answering pings and
+// reassembling fragments on the way. A close from the server is answered and
+// reported as a *closeError.
+func (c *conn) readMessage() ([]byte, error) {
+	var msg []byte
+	inMessage := false
+	for {
+		f, err := readFrame(c.br)
+		if err != nil {
+			return nil, err
+		}
+		if f.masked {
+			return nil, errors.New("websocket: masked frame from server")
+		}
+		switch f.op {
+		case opPing:
+			if err := c.write(opPong, f.payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			_ = c.write(opClose, f.payload)
+			e := &closeError{Code: 1005} // no status code sent
+			if len(f.payload) >= 2 {
+				e.Code = int(binary.BigEndian.Uint16(f.payload))
+				e.Reason = string(f.payload[2:])
+			}
+			return nil, e
+		case opText, opBinary:
+			if inMessage {
+				return nil, errors.New("websocket: new message inside a fragmented one")
+			}
+			inMessage = true
+		case opContinuation:
+			if !inMessage {
+				return nil, errors.New("websocket: continuation without a message")
+			}
+		default:
+			return nil, fmt.Errorf("websocket: unknown opcode %#x", f.op)
+		}
+		if len(msg)+len(f.payload) > maxMessageBytes {
+			return nil, errors.New("websocket: message too large")
+		}
+		msg = append(msg, f.payload...)
+		if f.fin {
+			return msg, nil
+		}
+	}
+}
+
+// close sends a normal-closure frame and drops the connection.
+func (c *conn) close() error {
+	_ = c.write(opClose, []byte{0x03, 0xE8}) // 1000, normal closure
+	return c.rwc.Close()
+}
+
+// frame is one decoded frame, its payload unmasked.
+type frame struct {
+	fin     bool
+	op      byte
+	masked  bool
+	payload []byte
+}
+
+// readFrame decodes one frame. Control frames are bounded at 125 bytes and
+// data frames at maxMessageBytes.
+func readFrame(r *bufio.Reader) (frame, error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return frame{}, err
+	}
+	f := frame{fin: hdr[0]&0x80 != 0, op: hdr[0] & 0x0F, masked: hdr[1]&0x80 != 0}
+	if hdr[0]&0x70 != 0 {
+		return frame{}, errors.New("websocket: reserved bits set")
+	}
+	n := uint64(hdr[1] & 0x7F)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return frame{}, err
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return frame{}, err
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	if f.op >= opClose && (n > 125 || !f.fin) {
+		return frame{}, errors.New("websocket: malformed control frame")
+	}
+	if n > maxMessageBytes {
+		return frame{}, errors.New("websocket: frame too large")
+	}
+	var mask [4]byte
+	if f.masked {
+		if _, err := io.ReadFull(r, mask[:]); err != nil {
+			return frame{}, err
+		}
+	}
+	f.payload = make([]byte, n)
+	if _, err := io.ReadFull(r, f.payload); err != nil {
+		return frame{}, err
+	}
+	if f.masked {
+		for i := range f.payload {
+			f.payload[i] ^= mask[i%4]
+		}
+	}
+	return f, nil
+}
+
+// writeFrame encodes payload as one final frame, masked with a fresh key when
+// mask is set (client to server).
+func writeFrame(w io.Writer, op byte, payload []byte, mask bool) error {
+	buf := make([]byte, 0, 14+len(payload))
+	buf = append(buf, 0x80|op)
+	var maskBit byte
+	if mask {
+		maskBit = 0x80
+	}
+	switch n := len(payload); {
+	case n <= 125:
+		buf = append(buf, maskBit|byte(n))
+	case n <= 0xFFFF:
+		buf = append(buf, maskBit|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, maskBit|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+	if !mask {
+		buf = append(buf, payload...)
+		_, err := w.Write(buf)
+		return err
+	}
+	var key [4]byte
+	if _, err := rand.Read(key[:]); err != nil {
+		return err
+	}
+	buf = append(buf, key[:]...)
+	for i, b := range payload {
+		buf = append(buf, b^key[i%4])
+	}
+	_, err := w.Write(buf)
+	return err
+}
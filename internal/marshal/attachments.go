@@ -0,0 +1,54 @@
+package marshal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// AttachmentsToMarkdown renders an issue's attachments as one markdown table
+// (title, source, URL, creator, created) so linked PR/Slack/Sentry context is
+// greppable in a single file.
+func AttachmentsToMarkdown(identifier string, attachments []api.Attachment) []byte {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Attachments for %s\n\n", identifier))
+
+	if len(attachments) == 0 {
+		sb.WriteString("*No attachments*\n")
+		return []byte(sb.String())
+	}
+
+	sb.WriteString("| Title | Source | URL | Creator | Created |\n")
+	sb.WriteString("|-------|--------|-----|---------|---------|\n")
+	for _, a := range attachments {
+		source := a.SourceType
+		if source == "" {
+			source = "link"
+		}
+		creator := ""
+		if a.Creator != nil {
+			creator = a.Creator.Email
+			if creator == "" {
+				creator = a.Creator.Name
+			}
+		}
+		created := ""
+		if !a.CreatedAt.IsZero() {
+			created = FormatDisplayTime(a.CreatedAt)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+			tableCell(a.Title), tableCell(source), tableCell(a.URL), tableCell(creator), created))
+	}
+
+	return []byte(sb.String())
+}
+
+// tableCell makes a value safe inside a markdown table cell: pipes are escaped
+// and newlines folded so a remote title cannot break the row.
+func tableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r", "")
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,39 @@
+package marshal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestAttachmentsToMarkdown pins the one-table shape: a header row, one row per
+// attachment, and cells that cannot break the table however the remote title
+// is written.
+func TestAttachmentsToMarkdown(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	atts := []api.Attachment{
+		{Title: "Fix | escape\nnewline", SourceType: "github", URL: "https://github.com/o/r/pull/1", Creator: &api.User{Email: "a@example.com"}, CreatedAt: created},
+		{Title: "Plain link", URL: "https://example.com"},
+	}
+
+	got := string(AttachmentsToMarkdown("ENG-1", atts))
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	if lines[0] != "# Attachments for ENG-1" {
+		t.Errorf("header = %q", lines[0])
+	}
+	rows := lines[len(lines)-2:]
+	want0 := `| Fix \| escape newline | github | https://github.com/o/r/pull/1 | a@example.com | 2026-03-04T05:06:07Z |`
+	if rows[0] != want0 {
+		t.Errorf("row 0 = %q\n      want %q", rows[0], want0)
+	}
+	if !strings.Contains(rows[1], "| Plain link | link | https://example.com |") {
+		t.Errorf("row 1 = %q, want sourceless attachment shown as link", rows[1])
+	}
+
+	if empty := string(AttachmentsToMarkdown("ENG-2", nil)); !strings.Contains(empty, "*No attachments*") {
+		t.Errorf("empty render = %q", empty)
+	}
+}
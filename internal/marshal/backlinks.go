@@ -0,0 +1,61 @@
+package marshal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// BacklinksToMarkdown renders the issues, comments, and documents that mention
+// an issue as a markdown list, in the order given (newest first from the repo).
+func BacklinksToMarkdown(identifier string, links []api.Backlink) []byte {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Backlinks for %s\n\n", identifier))
+
+	if len(links) == 0 {
+		sb.WriteString("*No backlinks*\n")
+		return []byte(sb.String())
+	}
+
+	for _, link := range links {
+		sb.WriteString(formatBacklink(&link))
+		sb.WriteString("\n")
+	}
+
+	return []byte(sb.String())
+}
+
+// formatBacklink formats one backlink as a list item: what mentions the issue,
+// where, and when.
+func formatBacklink(link *api.Backlink) string {
+	var subject, where string
+	switch link.Kind {
+	case "comment":
+		subject = fmt.Sprintf("%s: %s", link.Identifier, link.Title)
+		where = "comment"
+		if link.Author != "" {
+			where += " by " + link.Author
+		}
+	case "document":
+		subject = link.Title
+		where = "document"
+		if link.Identifier != "" {
+			where += " on " + link.Identifier
+		}
+	default:
+		subject = fmt.Sprintf("%s: %s", link.Identifier, link.Title)
+		where = "description"
+	}
+
+	line := fmt.Sprintf("- **%s** (%s", subject, where)
+	if !link.UpdatedAt.IsZero() {
+		line += ", " + FormatDisplayTime(link.UpdatedAt)
+	}
+	line += ")"
+	if link.URL != "" {
+		line += " " + link.URL
+	}
+	return line
+}
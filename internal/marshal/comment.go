@@ -1,7 +1,7 @@
 package marshal
 
 import (
-	"time"
+	"strings"
 
 	"github.com/jra3/linear-fuse/internal/api"
 )
@@ -18,20 +18,77 @@ func CommentToMarkdown(comment *api.Comment) []byte {
 }
 
 // CommentMetaToMarkdown renders the read-only comment .meta sidecar:
-// server-managed identity, timestamps, and authorship as a frontmatter-only
-// block (empties omitted).
+// server-managed identity, permalink, timestamps, and authorship as a
+// frontmatter-only block (empties omitted).
 func CommentMetaToMarkdown(comment *api.Comment) ([]byte, error) {
 	fm := map[string]any{
 		"id":      comment.ID,
-		"created": comment.CreatedAt.Format(time.RFC3339),
-		"updated": comment.UpdatedAt.Format(time.RFC3339),
+		"shortId": CommentShortID(comment.ID),
+		"created": FormatTimestamp(comment.CreatedAt),
+		"updated": FormatTimestamp(comment.UpdatedAt),
+	}
+	// A row synced before permalinks were fetched has no URL until its next
+	// detail sync; omit it rather than guess the issue slug.
+	if comment.URL != "" {
+		fm["url"] = comment.URL
 	}
 	if comment.EditedAt != nil {
-		fm["edited"] = comment.EditedAt.Format(time.RFC3339)
+		fm["edited"] = FormatTimestamp(*comment.EditedAt)
 	}
 	if comment.User != nil {
 		fm["author"] = comment.User.Email
 		fm["authorName"] = comment.User.Name
 	}
+	if comment.DeletedAt != nil {
+		fm["deleted"] = FormatTimestamp(*comment.DeletedAt)
+	}
 	return Render(&Document{Frontmatter: fm})
 }
+
+// CommentTombstoneToMarkdown renders the read-only file a deleted comment
+// leaves behind (display.show_deleted_comments): who wrote it and when it went,
+// then the original body struck through line by line — strikethrough does not
+// span Markdown paragraphs, so each non-blank line carries its own markers.
+func CommentTombstoneToMarkdown(comment *api.Comment) []byte {
+	author := "unknown author"
+	if comment.User != nil {
+		switch {
+		case comment.User.Name != "" && comment.User.Email != "":
+			author = comment.User.Name + " <" + comment.User.Email + ">"
+		case comment.User.Name != "":
+			author = comment.User.Name
+		case comment.User.Email != "":
+			author = comment.User.Email
+		}
+	}
+	var b strings.Builder
+	b.WriteString("*Deleted comment by " + author)
+	if comment.DeletedAt != nil {
+		b.WriteString(", removed " + FormatDisplayTime(*comment.DeletedAt))
+	}
+	b.WriteString("*\n\n")
+	for _, line := range strings.Split(strings.TrimRight(comment.Body, "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString("~~" + strings.TrimSpace(line) + "~~\n")
+	}
+	return []byte(b.String())
+}
+
+// CommentShortID is the first group of a comment's UUID — the same 8 hex
+// digits Linear uses in a comment permalink (#comment-1a2b3c4d). An ID of any
+// other shape (never from Linear) is used whole.
+func CommentShortID(id string) string {
+	head, _, ok := strings.Cut(id, "-")
+	if !ok || len(head) != 8 {
+		return id
+	}
+	for _, r := range head {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return id
+		}
+	}
+	return head
+}
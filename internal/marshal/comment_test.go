@@ -38,12 +38,13 @@ func TestCommentMetaToMarkdown(t *testing.T) {
 	created := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
 	edited := created.Add(time.Hour)
 	full := &api.Comment{
-		ID:        "comment-123",
+		ID:        "1a2b3c4d-0000-4000-8000-000000000123",
 		Body:      "Body text",
 		CreatedAt: created,
 		UpdatedAt: edited,
 		EditedAt:  &edited,
 		User:      &api.User{Email: "test@example.com", Name: "Test User"},
+		URL:       "https://linear.app/acme/issue/ENG-1/title#comment-1a2b3c4d",
 	}
 
 	content, err := CommentMetaToMarkdown(full)
@@ -51,13 +52,16 @@ func TestCommentMetaToMarkdown(t *testing.T) {
 		t.Fatalf("CommentMetaToMarkdown: %v", err)
 	}
 	keys, doc := frontmatterKeys(t, content)
-	want := []string{"author", "authorName", "created", "edited", "id", "updated"}
+	want := []string{"author", "authorName", "created", "edited", "id", "shortId", "updated", "url"}
 	if !reflect.DeepEqual(keys, want) {
 		t.Errorf("comment .meta frontmatter keys = %v, want %v", keys, want)
 	}
 	if doc.Frontmatter["author"] != "test@example.com" {
 		t.Errorf("author = %v, want test@example.com", doc.Frontmatter["author"])
 	}
+	if doc.Frontmatter["shortId"] != "1a2b3c4d" || doc.Frontmatter["url"] != full.URL {
+		t.Errorf("permalink = %v / %v, want %s and short id 1a2b3c4d", doc.Frontmatter["url"], doc.Frontmatter["shortId"], full.URL)
+	}
 	if doc.Body != "" {
 		t.Errorf("meta must be frontmatter-only, got body %q", doc.Body)
 	}
@@ -67,7 +71,41 @@ func TestCommentMetaToMarkdown(t *testing.T) {
 	if err != nil {
 		t.Fatalf("CommentMetaToMarkdown(min): %v", err)
 	}
-	if keys, _ := frontmatterKeys(t, content); !reflect.DeepEqual(keys, []string{"created", "id", "updated"}) {
-		t.Errorf("minimal comment .meta keys = %v, want [created id updated]", keys)
+	if keys, _ := frontmatterKeys(t, content); !reflect.DeepEqual(keys, []string{"created", "id", "shortId", "updated"}) {
+		t.Errorf("minimal comment .meta keys = %v, want [created id shortId updated]", keys)
+	}
+
+	// A tombstone reports when it was deleted.
+	deleted := edited.Add(time.Hour)
+	content, err = CommentMetaToMarkdown(&api.Comment{ID: "comment-gone", CreatedAt: created, UpdatedAt: created, DeletedAt: &deleted})
+	if err != nil {
+		t.Fatalf("CommentMetaToMarkdown(tombstone): %v", err)
+	}
+	if keys, _ := frontmatterKeys(t, content); !reflect.DeepEqual(keys, []string{"created", "deleted", "id", "shortId", "updated"}) {
+		t.Errorf("tombstone .meta keys = %v, want [created deleted id shortId updated]", keys)
+	}
+}
+
+// TestCommentTombstoneToMarkdown pins the deleted-comment render: attribution
+// first, then every non-blank body line struck through on its own.
+func TestCommentTombstoneToMarkdown(t *testing.T) {
+	t.Parallel()
+	deleted := time.Date(2025, 1, 16, 9, 0, 0, 0, time.UTC)
+	got := string(CommentTombstoneToMarkdown(&api.Comment{
+		ID:        "comment-gone",
+		Body:      "First line\n\n  Second para\n",
+		User:      &api.User{Email: "test@example.com", Name: "Test User"},
+		DeletedAt: &deleted,
+	}))
+	want := "*Deleted comment by Test User <test@example.com>, removed 2025-01-16T09:00:00Z*\n\n" +
+		"~~First line~~\n\n~~Second para~~\n"
+	if got != want {
+		t.Errorf("CommentTombstoneToMarkdown() =\n%q\nwant\n%q", got, want)
+	}
+
+	// No user: the attribution still says who, honestly.
+	got = string(CommentTombstoneToMarkdown(&api.Comment{Body: "x"}))
+	if !strings.HasPrefix(got, "*Deleted comment by unknown author*\n") {
+		t.Errorf("userless tombstone = %q", got)
 	}
 }
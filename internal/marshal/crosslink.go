@@ -0,0 +1,229 @@
+package marshal
+
+import (
+	"regexp"
+	"strings"
+)
+
+// CrossLinker rewrites references to Linear issues in a rendered body into
+// relative links to the referenced issue.md inside the mount
+// (display.cross_links), so an editor or a static-site tool can follow them:
+// a bare identifier of a mounted team becomes [ENG-42](../ENG-42/issue.md),
+// and a Linear issue URL becomes the same link carrying the URL as its title.
+// Fenced code, inline code, existing links, and autolinks are left alone.
+// With Images set it also points inline images at the issue's local
+// attachments/ entries (display.local_images): ![shot](https://uploads.linear.app/…)
+// becomes ![shot](attachments/shot.png), so a previewer shows it from the cache.
+//
+// Unlink is the inverse the save paths apply before a body goes back to
+// Linear: exactly the shapes Link produces for this file's location turn back
+// into the identifier or URL they stand for, so an unchanged file saves as an
+// unchanged description. A body that already held such a link verbatim reads
+// back as the reference too.
+//
+// The zero value is disabled: both directions return the body unchanged.
+// Known enables the reference links and Images the image paths; either works
+// without the other.
+type CrossLinker struct {
+	// Team is the key of the team whose issues/ directory the file lives
+	// under; references to its issues stay within that directory.
+	Team string
+	// Depth is how many directories the file sits below issues/: 1 for
+	// issue.md, 2 for a comment under comments/.
+	Depth int
+	// Known reports whether a team key is mounted; a reference to any other
+	// team is left as written, since its link would dangle.
+	Known func(key string) bool
+	// Images maps a Linear CDN URL to the file's path relative to this file
+	// (attachments/shot.png from issue.md). It must be one-to-one: Unlink
+	// reads it backwards.
+	Images map[string]string
+}
+
+var (
+	// issueRefPattern matches a Linear issue URL (group 1: its identifier) or
+	// a bare identifier (group 2). Boundaries the regexp cannot express are
+	// checked in bareRefAt.
+	issueRefPattern = regexp.MustCompile(`https://linear\.app/[A-Za-z0-9_-]+/issue/([A-Z][A-Z0-9]*-[0-9]+)(?:/[A-Za-z0-9_%~-]*)?(?:#[A-Za-z0-9_-]+)?|\b([A-Z][A-Z0-9]*-[0-9]+)\b`)
+
+	// protectedSpanPattern matches the inline spans Link never rewrites
+	// inside: code spans, links and images, and autolinks.
+	protectedSpanPattern = regexp.MustCompile("`+[^`]*`+|!?\\[[^\\]]*\\]\\([^)]*\\)|<https?://[^>]*>")
+
+	// crossLinkPattern matches a link of the shape Link produces, for Unlink
+	// to check against this file's location.
+	crossLinkPattern = regexp.MustCompile(`\[([A-Z][A-Z0-9]*-[0-9]+)\]\(((?:\.\./)+(?:[A-Z][A-Z0-9]*/issues/)?[A-Z][A-Z0-9]*-[0-9]+/issue\.md)(?: "(https://linear\.app/[^"\s]+)")?\)`)
+
+	// imagePattern matches an inline image, capturing its destination (group
+	// 2); the alt text and any title around it are kept verbatim.
+	imagePattern = regexp.MustCompile(`^(!\[[^\]]*\]\()([^\s)]+)((?:\s+"[^"]*")?\))$`)
+
+	// imageSpanPattern finds inline images for Unlink.
+	imageSpanPattern = regexp.MustCompile(`!\[[^\]]*\]\([^\s)]+(?:\s+"[^"]*")?\)`)
+)
+
+func (l CrossLinker) enabled() bool { return l.Known != nil || l.Images != nil }
+
+// image returns span with its destination swapped through to, or span itself
+// when it is not an image whose destination to maps.
+func image(span string, to map[string]string) string {
+	m := imagePattern.FindStringSubmatch(span)
+	if m == nil {
+		return span
+	}
+	dest, ok := to[m[2]]
+	if !ok {
+		return span
+	}
+	return m[1] + dest + m[3]
+}
+
+// path is the relative path from the file to the identified issue's issue.md.
+func (l CrossLinker) path(identifier string) string {
+	key := identifier[:strings.LastIndexByte(identifier, '-')]
+	if key == l.Team {
+		return strings.Repeat("../", l.Depth) + identifier + "/issue.md"
+	}
+	return strings.Repeat("../", l.Depth+2) + key + "/issues/" + identifier + "/issue.md"
+}
+
+func (l CrossLinker) known(identifier string) bool {
+	return l.Known(identifier[:strings.LastIndexByte(identifier, '-')])
+}
+
+// Link rewrites the issue references in body into relative links.
+func (l CrossLinker) Link(body string) string {
+	if !l.enabled() {
+		return body
+	}
+	lines := strings.SplitAfter(body, "\n")
+	fence := ""
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if marker := fenceMarker(trimmed); marker != "" {
+			switch {
+			case fence == "":
+				fence = marker
+			case strings.HasPrefix(trimmed, fence):
+				fence = ""
+			}
+			continue
+		}
+		if fence != "" {
+			continue
+		}
+		lines[i] = l.linkLine(line)
+	}
+	return strings.Join(lines, "")
+}
+
+// fenceMarker returns the ``` or ~~~ run opening line, or "".
+func fenceMarker(line string) string {
+	for _, c := range []string{"```", "~~~"} {
+		if strings.HasPrefix(line, c) {
+			n := len(c)
+			for n < len(line) && line[n] == c[0] {
+				n++
+			}
+			return line[:n]
+		}
+	}
+	return ""
+}
+
+// linkLine rewrites the references in one line outside its protected spans.
+func (l CrossLinker) linkLine(line string) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range protectedSpanPattern.FindAllStringIndex(line, -1) {
+		b.WriteString(l.linkText(line[last:span[0]]))
+		b.WriteString(image(line[span[0]:span[1]], l.Images))
+		last = span[1]
+	}
+	b.WriteString(l.linkText(line[last:]))
+	return b.String()
+}
+
+func (l CrossLinker) linkText(text string) string {
+	if l.Known == nil {
+		return text
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range issueRefPattern.FindAllStringSubmatchIndex(text, -1) {
+		var link string
+		switch {
+		case m[2] >= 0: // URL
+			if ident := text[m[2]:m[3]]; l.known(ident) {
+				link = "[" + ident + "](" + l.path(ident) + ` "` + text[m[0]:m[1]] + `")`
+			}
+		case bareRefAt(text, m[0], m[1]):
+			if ident := text[m[4]:m[5]]; l.known(ident) {
+				link = "[" + ident + "](" + l.path(ident) + ")"
+			}
+		}
+		if link == "" {
+			continue
+		}
+		b.WriteString(text[last:m[0]])
+		b.WriteString(link)
+		last = m[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// bareRefAt reports whether text[start:end] stands alone as an identifier
+// rather than being part of a path, a URL, an address, or a longer token
+// (foo/ENG-42, ?id=ENG-42, ENG-42-fix, ENG-42.md).
+func bareRefAt(text string, start, end int) bool {
+	if start > 0 && strings.IndexByte("/-.@_=#&?~%+", text[start-1]) >= 0 {
+		return false
+	}
+	if end < len(text) {
+		next := text[end]
+		if strings.IndexByte("-/@_", next) >= 0 {
+			return false
+		}
+		if next == '.' && end+1 < len(text) && isWordByte(text[end+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// Unlink turns the links Link produced for this file back into the
+// references they stand for.
+func (l CrossLinker) Unlink(body string) string {
+	if !l.enabled() {
+		return body
+	}
+	if l.Images != nil {
+		local := make(map[string]string, len(l.Images))
+		for url, path := range l.Images {
+			local[path] = url
+		}
+		body = imageSpanPattern.ReplaceAllStringFunc(body, func(span string) string { return image(span, local) })
+	}
+	if l.Known == nil {
+		return body
+	}
+	return crossLinkPattern.ReplaceAllStringFunc(body, func(link string) string {
+		m := crossLinkPattern.FindStringSubmatch(link)
+		ident, target, url := m[1], m[2], m[3]
+		if target != l.path(ident) {
+			return link // another file's link (or a hand-written one): keep it
+		}
+		if url != "" {
+			if m := issueRefPattern.FindStringSubmatch(url); m == nil || m[0] != url || m[1] != ident {
+				return link
+			}
+			return url
+		}
+		return ident
+	})
+}
@@ -0,0 +1,97 @@
+package marshal
+
+import "testing"
+
+func testLinker(depth int) CrossLinker {
+	return CrossLinker{Team: "ENG", Depth: depth, Known: func(key string) bool { return key == "ENG" || key == "OPS" }}
+}
+
+func TestCrossLinkerLink(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name, in, want string
+		depth          int
+	}{
+		{"same-team identifier", "Blocked on ENG-42.", "Blocked on [ENG-42](../ENG-42/issue.md).", 1},
+		{"other-team identifier", "See OPS-7", "See [OPS-7](../../../OPS/issues/OPS-7/issue.md)", 1},
+		{"from a comment", "dup of ENG-42", "dup of [ENG-42](../../ENG-42/issue.md)", 2},
+		{
+			"issue URL keeps the URL as title",
+			"Ref https://linear.app/acme/issue/ENG-42/fix-login, thanks",
+			`Ref [ENG-42](../ENG-42/issue.md "https://linear.app/acme/issue/ENG-42/fix-login"), thanks`,
+			1,
+		},
+		{"unmounted team", "SEC-3 and https://linear.app/acme/issue/SEC-3", "SEC-3 and https://linear.app/acme/issue/SEC-3", 1},
+		{"not an identifier", "UTF-8, SHA-256 and ISO-8601", "UTF-8, SHA-256 and ISO-8601", 1},
+		{"part of a path or token", "eng/ENG-42 ENG-42-fix ENG-42.md ?id=ENG-42", "eng/ENG-42 ENG-42-fix ENG-42.md ?id=ENG-42", 1},
+		{"inline code", "run `git log ENG-42` first", "run `git log ENG-42` first", 1},
+		{"existing link", "[ENG-42 notes](https://example.com/ENG-42)", "[ENG-42 notes](https://example.com/ENG-42)", 1},
+		{"fenced code", "```\nENG-42\n```\nENG-43", "```\nENG-42\n```\n[ENG-43](../ENG-43/issue.md)", 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := testLinker(tc.depth).Link(tc.in); got != tc.want {
+				t.Errorf("Link(%q)\n got %q\nwant %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestCrossLinkerRoundTrip: Unlink restores exactly what Link rewrote, so
+// saving an unchanged file never changes the description.
+func TestCrossLinkerRoundTrip(t *testing.T) {
+	t.Parallel()
+	bodies := []string{
+		"Blocked on ENG-42 and OPS-7.\n\nhttps://linear.app/acme/issue/ENG-9/slug#comment-1ab\n",
+		"```go\n// ENG-1\n```\n- [x] ENG-2\n- SEC-3\n",
+		"no references at all",
+	}
+	for _, depth := range []int{1, 2} {
+		l := testLinker(depth)
+		for _, body := range bodies {
+			if got := l.Unlink(l.Link(body)); got != body {
+				t.Errorf("depth %d round trip of %q = %q", depth, body, got)
+			}
+		}
+	}
+}
+
+// TestCrossLinkerUnlinkOtherLocation: a link Link would have produced for a
+// different location is kept, not rewritten.
+func TestCrossLinkerUnlinkOtherLocation(t *testing.T) {
+	t.Parallel()
+	fromComment := testLinker(2).Link("ENG-42")
+	if got := testLinker(1).Unlink(fromComment); got != fromComment {
+		t.Errorf("Unlink at depth 1 rewrote a depth-2 link: %q", got)
+	}
+}
+
+// TestCrossLinkerImages: a mapped CDN image points at its local path, keeping
+// its alt text and title, and Unlink restores the URL; unmapped images and
+// plain links to the same URL are left alone.
+func TestCrossLinkerImages(t *testing.T) {
+	t.Parallel()
+	const cdn = "https://uploads.linear.app/a/b/shot.png"
+	l := CrossLinker{Images: map[string]string{cdn: "attachments/shot.png"}}
+	body := "![login](" + cdn + ` "after fix")` + " vs ![old](https://uploads.linear.app/a/c/old.png)\n[raw](" + cdn + ") ENG-42\n"
+	want := `![login](attachments/shot.png "after fix") vs ![old](https://uploads.linear.app/a/c/old.png)` + "\n[raw](" + cdn + ") ENG-42\n"
+	got := l.Link(body)
+	if got != want {
+		t.Fatalf("Link\n got %q\nwant %q", got, want)
+	}
+	if back := l.Unlink(got); back != body {
+		t.Errorf("Unlink = %q, want %q", back, body)
+	}
+}
+
+func TestCrossLinkerDisabled(t *testing.T) {
+	t.Parallel()
+	var l CrossLinker
+	body := "ENG-42 [ENG-42](../ENG-42/issue.md)"
+	if got := l.Link(body); got != body {
+		t.Errorf("disabled Link = %q", got)
+	}
+	if got := l.Unlink(body); got != body {
+		t.Errorf("disabled Unlink = %q", got)
+	}
+}
@@ -0,0 +1,158 @@
+package marshal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the unchanged lines shown around each change, as diff -u.
+const diffContext = 3
+
+// maxDiffCells caps the line-pair table UnifiedDiff builds; past it the
+// differing middle is shown as one replacement rather than aligned.
+const maxDiffCells = 1 << 20
+
+// diffOp is one line of an edit script: kept (' '), removed ('-'), or
+// added ('+'). aLine and bLine count the old and new lines before it.
+type diffOp struct {
+	kind         byte
+	line         string
+	aLine, bLine int
+}
+
+// UnifiedDiff renders the change from a to b as a unified diff (diff -u)
+// labelled fromName and toName, or nil when they are equal.
+func UnifiedDiff(fromName, toName, a, b string) []byte {
+	if a == b {
+		return nil
+	}
+	ops := diffLines(strings.SplitAfter(a, "\n"), strings.SplitAfter(b, "\n"))
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+	var changes []int
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	for k := 0; k < len(changes); {
+		first, last := changes[k], changes[k]
+		for k++; k < len(changes) && changes[k]-last <= 2*diffContext; k++ {
+			last = changes[k]
+		}
+		lo, hi := max(0, first-diffContext), min(len(ops), last+diffContext+1)
+		aCount, bCount := 0, 0
+		for _, op := range ops[lo:hi] {
+			if op.kind != '+' {
+				aCount++
+			}
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(ops[lo].aLine, aCount), hunkRange(ops[lo].bLine, bCount))
+		for _, op := range ops[lo:hi] {
+			out.WriteByte(op.kind)
+			out.WriteString(op.line)
+			if !strings.HasSuffix(op.line, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+	return []byte(out.String())
+}
+
+// hunkRange is one side of a hunk header: start is the count of lines before
+// the hunk, so an empty side names the line it follows.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// diffLines aligns two line lists on a longest common subsequence, after
+// trimming their common prefix and suffix.
+func diffLines(a, b []string) []diffOp {
+	a, b = dropEmptyTail(a), dropEmptyTail(b)
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	midA, midB := a[pre:len(a)-suf], b[pre:len(b)-suf]
+
+	var ops []diffOp
+	i, j := 0, 0
+	emit := func(kind byte, line string) {
+		ops = append(ops, diffOp{kind: kind, line: line, aLine: i, bLine: j})
+		if kind != '+' {
+			i++
+		}
+		if kind != '-' {
+			j++
+		}
+	}
+	for _, line := range a[:pre] {
+		emit(' ', line)
+	}
+	n, m := len(midA), len(midB)
+	if (n+1)*(m+1) > maxDiffCells {
+		for _, line := range midA {
+			emit('-', line)
+		}
+		for _, line := range midB {
+			emit('+', line)
+		}
+	} else {
+		// lcs[x][y] is the common subsequence length of midA[x:] and midB[y:].
+		lcs := make([][]int, n+1)
+		for x := range lcs {
+			lcs[x] = make([]int, m+1)
+		}
+		for x := n - 1; x >= 0; x-- {
+			for y := m - 1; y >= 0; y-- {
+				if midA[x] == midB[y] {
+					lcs[x][y] = lcs[x+1][y+1] + 1
+				} else {
+					lcs[x][y] = max(lcs[x+1][y], lcs[x][y+1])
+				}
+			}
+		}
+		x, y := 0, 0
+		for x < n || y < m {
+			switch {
+			case x < n && y < m && midA[x] == midB[y]:
+				emit(' ', midA[x])
+				x++
+				y++
+			case y == m || x < n && lcs[x+1][y] >= lcs[x][y+1]:
+				emit('-', midA[x])
+				x++
+			default:
+				emit('+', midB[y])
+				y++
+			}
+		}
+	}
+	for _, line := range a[len(a)-suf:] {
+		emit(' ', line)
+	}
+	return ops
+}
+
+// dropEmptyTail drops the empty element SplitAfter leaves after a final
+// newline (and the lone one an empty string splits into).
+func dropEmptyTail(lines []string) []string {
+	if n := len(lines); n > 0 && lines[n-1] == "" {
+		return lines[:n-1]
+	}
+	return lines
+}
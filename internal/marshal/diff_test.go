@@ -0,0 +1,29 @@
+package marshal
+
+import "testing"
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name, a, b, want string
+	}{
+		{"equal", "same\n", "same\n", ""},
+		{"one line changed", "a\nb\nc\n", "a\nB\nc\n", "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"from empty", "", "hello\n", "--- old\n+++ new\n@@ -0,0 +1 @@\n+hello\n"},
+		{"final newline dropped", "x\n", "x", "--- old\n+++ new\n@@ -1 +1 @@\n-x\n+x\n\\ No newline at end of file\n"},
+		{
+			"distant changes split into hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"1\n2\nX\n4\n5\n6\n7\n8\n9\n10\nY\n12\n13\n",
+			"--- old\n+++ new\n@@ -1,6 +1,6 @@\n 1\n 2\n-3\n+X\n 4\n 5\n 6\n" +
+				"@@ -8,5 +8,6 @@\n 8\n 9\n 10\n-11\n+Y\n 12\n+13\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := string(UnifiedDiff("old", "new", tc.a, tc.b)); got != tc.want {
+				t.Errorf("UnifiedDiff\n got %q\nwant %q", got, tc.want)
+			}
+		})
+	}
+}
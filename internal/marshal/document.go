@@ -2,7 +2,6 @@ package marshal
 
 import (
 	"strings"
-	"time"
 
 	"github.com/jra3/linear-fuse/internal/api"
 )
@@ -46,8 +45,8 @@ func DocumentMetaToMarkdown(doc *api.Document) ([]byte, error) {
 	fm := map[string]any{
 		"id":      doc.ID,
 		"url":     doc.URL,
-		"created": doc.CreatedAt.Format(time.RFC3339),
-		"updated": doc.UpdatedAt.Format(time.RFC3339),
+		"created": FormatTimestamp(doc.CreatedAt),
+		"updated": FormatTimestamp(doc.UpdatedAt),
 	}
 	if doc.Creator != nil {
 		fm["creator"] = doc.Creator.Email
@@ -133,3 +132,37 @@ func ParseNewDocument(content []byte) (title string, body string, err error) {
 
 	return
 }
+
+// DocumentOwner names where a document created from the workspace docs/
+// surface lives: exactly one of a team key, a project (slug or name), or an
+// initiative name.
+type DocumentOwner struct {
+	Team       string
+	Project    string
+	Initiative string
+}
+
+// ParseNewDocumentOwner reads the owner frontmatter (team, project, or
+// initiative) of a new workspace document. Exactly one must be set — the root
+// docs/ has no owner of its own to fall back on.
+func ParseNewDocumentOwner(content []byte) (DocumentOwner, error) {
+	doc, err := Parse(content)
+	if err != nil {
+		return DocumentOwner{}, err
+	}
+	str := func(key string) string {
+		s, _ := doc.Frontmatter[key].(string)
+		return strings.TrimSpace(s)
+	}
+	owner := DocumentOwner{Team: str("team"), Project: str("project"), Initiative: str("initiative")}
+	n := 0
+	for _, v := range []string{owner.Team, owner.Project, owner.Initiative} {
+		if v != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return DocumentOwner{}, &FieldError{Field: "owner", Message: "set exactly one of team, project, or initiative in the frontmatter"}
+	}
+	return owner, nil
+}
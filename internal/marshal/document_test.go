@@ -501,3 +501,31 @@ func TestDocumentRoundtripEmptyContent(t *testing.T) {
 		t.Errorf("a genuine edit was swallowed by the placeholder guard: %v", update)
 	}
 }
+
+func TestParseNewDocumentOwner(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content string
+		want    DocumentOwner
+		wantErr bool
+	}{
+		{name: "team", content: "---\ntitle: T\nteam: ENG\n---\nbody", want: DocumentOwner{Team: "ENG"}},
+		{name: "project", content: "---\ntitle: T\nproject: q1-launch\n---\n", want: DocumentOwner{Project: "q1-launch"}},
+		{name: "initiative", content: "---\ninitiative: Growth\n---\n", want: DocumentOwner{Initiative: "Growth"}},
+		{name: "none", content: "---\ntitle: T\n---\nbody", wantErr: true},
+		{name: "two", content: "---\nteam: ENG\nproject: q1-launch\n---\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseNewDocumentOwner([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("owner = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
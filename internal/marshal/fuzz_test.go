@@ -2,6 +2,7 @@ package marshal
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/jra3/linear-fuse/internal/api"
@@ -157,3 +158,41 @@ func FuzzEntityParsers(f *testing.F) {
 		_, _, _ = MarkdownToStatusUpdate(content)
 	})
 }
+
+// FuzzIssueRoundTrip asserts issue.md is a fixpoint for hostile issue
+// content: rendering an issue and parsing the untouched file back against the
+// same issue must yield no updates. Any update is a phantom write — saving an
+// unedited file would push a change to Linear — so remote strings (a title
+// with colons or delimiters, a status or label named like YAML, a description
+// that opens with frontmatter) must survive the trip exactly.
+func FuzzIssueRoundTrip(f *testing.F) {
+	f.Add("Fix bug", "In Progress", "Bug", "Description.")
+	f.Add("has: a colon", "Todo", "Q3: Bets", "---\ntitle: inner\n---\nbody")
+	f.Add("value with --- inside", "#done", "- dash", "")
+	f.Add("'quoted'", "null", "2026", "line\r\nbreaks\n\n\n")
+	f.Add(" padded ", "yes", "[x]", "\x00nul")
+	f.Fuzz(func(t *testing.T, title, status, label, description string) {
+		if strings.TrimSpace(label) == "" {
+			return // Linear requires a label name; a blank one is unreachable
+		}
+		issue := &api.Issue{
+			ID:          "issue-1",
+			Identifier:  "TST-1",
+			Title:       title,
+			Description: description,
+			State:       api.State{ID: "state-1", Name: status},
+			Labels:      api.Labels{Nodes: []api.Label{{ID: "label-1", Name: label}}},
+		}
+		content, err := IssueToMarkdown(issue)
+		if err != nil {
+			return // a render refusal is a clean outcome; no file is served
+		}
+		updates, err := MarkdownToIssueUpdate(content, issue)
+		if err != nil {
+			t.Fatalf("rendered issue.md did not parse back: %v\ncontent=%q", err, content)
+		}
+		if len(updates) != 0 {
+			t.Fatalf("unedited issue.md yields updates %v\ncontent=%q", updates, content)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package marshal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// IssueGraph is the dependency graph of a set of issues (a team or a project):
+// one node per issue, one edge per parent/child link or issue relation. Issues
+// outside the set that an edge points at are included as External nodes so no
+// edge dangles.
+type IssueGraph struct {
+	Name  string      `json:"name"`
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode is one issue in an IssueGraph, keyed by identifier.
+type GraphNode struct {
+	ID        string `json:"id"`
+	Title     string `json:"title,omitempty"`
+	State     string `json:"state,omitempty"`
+	StateType string `json:"stateType,omitempty"`
+	External  bool   `json:"external,omitempty"`
+}
+
+// GraphEdge is one directed edge between two identifiers. Type is "parent"
+// (From is the parent of To) or a relation type: "blocks" (From blocks To),
+// "duplicate", "related", "similar".
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// BuildIssueGraph assembles the graph for issues from their parent links and
+// relations (as returned by GetTeamIssueRelations/GetProjectIssueRelations:
+// Issue holds the owner's ID, RelatedIssue the enriched target). Relations
+// whose ends cannot be resolved to an identifier are skipped; symmetric
+// relations (related, similar) stored from both sides collapse to one edge.
+// Nodes and edges are sorted so the output is stable across renders.
+func BuildIssueGraph(name string, issues []api.Issue, relations []api.IssueRelation) IssueGraph {
+	g := IssueGraph{Name: name, Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+
+	nodes := make(map[string]GraphNode, len(issues))
+	identByID := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		identByID[issue.ID] = issue.Identifier
+		nodes[issue.Identifier] = GraphNode{
+			ID:        issue.Identifier,
+			Title:     issue.Title,
+			State:     issue.State.Name,
+			StateType: issue.State.Type,
+		}
+	}
+	external := func(ref *api.ParentIssue) string {
+		if ref == nil {
+			return ""
+		}
+		ident := ref.Identifier
+		if ident == "" {
+			ident = identByID[ref.ID]
+		}
+		if ident == "" {
+			return ""
+		}
+		if _, ok := nodes[ident]; !ok {
+			nodes[ident] = GraphNode{ID: ident, Title: ref.Title, External: true}
+		}
+		return ident
+	}
+
+	seen := make(map[GraphEdge]bool)
+	addEdge := func(e GraphEdge) {
+		if e.From == "" || e.To == "" || e.From == e.To {
+			return
+		}
+		key := e
+		if (e.Type == "related" || e.Type == "similar") && key.From > key.To {
+			key.From, key.To = key.To, key.From
+		}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		g.Edges = append(g.Edges, key)
+	}
+
+	for _, issue := range issues {
+		if parent := external(issue.Parent); parent != "" {
+			addEdge(GraphEdge{From: parent, To: issue.Identifier, Type: "parent"})
+		}
+	}
+	for _, rel := range relations {
+		addEdge(GraphEdge{From: external(rel.Issue), To: external(rel.RelatedIssue), Type: rel.Type})
+	}
+
+	for _, n := range nodes {
+		g.Nodes = append(g.Nodes, n)
+	}
+	sort.Slice(g.Nodes, func(i, j int) bool { return g.Nodes[i].ID < g.Nodes[j].ID })
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Type < b.Type
+	})
+	return g
+}
+
+// IssueGraphToJSON renders the graph as indented JSON for scripting.
+func IssueGraphToJSON(g IssueGraph) ([]byte, error) {
+	data, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// IssueGraphToDOT renders the graph in Graphviz DOT format (`dot -Tsvg
+// graph.dot`). Blocking edges are red, parent edges dashed, symmetric
+// relations undirected; done/canceled issues are greyed and external issues
+// drawn dashed so the remaining blocked work stands out.
+func IssueGraphToDOT(g IssueGraph) []byte {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("digraph %s {\n", dotQuote(g.Name)))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=rounded];\n")
+
+	for _, n := range g.Nodes {
+		label := n.ID
+		if n.Title != "" {
+			label += "\n" + n.Title
+		}
+		attrs := []string{"label=" + dotQuote(label)}
+		switch {
+		case n.External:
+			attrs = append(attrs, `style="rounded,dashed"`)
+		case n.StateType == "completed" || n.StateType == "canceled":
+			attrs = append(attrs, "color=gray", "fontcolor=gray")
+		}
+		sb.WriteString(fmt.Sprintf("  %s [%s];\n", dotQuote(n.ID), strings.Join(attrs, ", ")))
+	}
+
+	for _, e := range g.Edges {
+		var attrs string
+		switch e.Type {
+		case "parent":
+			attrs = `label="parent", style=dashed`
+		case "blocks":
+			attrs = `label="blocks", color=red`
+		case "duplicate":
+			attrs = `label="duplicate", style=dotted`
+		default:
+			attrs = fmt.Sprintf("label=%s, dir=none, style=dotted", dotQuote(e.Type))
+		}
+		sb.WriteString(fmt.Sprintf("  %s -> %s [%s];\n", dotQuote(e.From), dotQuote(e.To), attrs))
+	}
+
+	sb.WriteString("}\n")
+	return []byte(sb.String())
+}
+
+// dotQuote renders s as a DOT double-quoted string.
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
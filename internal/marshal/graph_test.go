@@ -0,0 +1,85 @@
+package marshal
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestBuildIssueGraph pins the edge set: parent links, owned relations, an
+// external endpoint promoted to a node, a symmetric relation stored from both
+// sides collapsed to one edge, and an unresolvable target dropped.
+func TestBuildIssueGraph(t *testing.T) {
+	t.Parallel()
+	issues := []api.Issue{
+		{ID: "i1", Identifier: "ENG-1", Title: "Epic", State: api.State{Name: "In Progress", Type: "started"}},
+		{ID: "i2", Identifier: "ENG-2", Title: "Child", Parent: &api.ParentIssue{ID: "i1", Identifier: "ENG-1"}},
+		{ID: "i3", Identifier: "ENG-3", Title: "Done", State: api.State{Type: "completed"}},
+	}
+	relations := []api.IssueRelation{
+		{Type: "blocks", Issue: &api.ParentIssue{ID: "i3"}, RelatedIssue: &api.ParentIssue{ID: "i2", Identifier: "ENG-2"}},
+		{Type: "blocks", Issue: &api.ParentIssue{ID: "i2"}, RelatedIssue: &api.ParentIssue{ID: "x9", Identifier: "OPS-9", Title: "Infra"}},
+		{Type: "related", Issue: &api.ParentIssue{ID: "i1"}, RelatedIssue: &api.ParentIssue{ID: "i3", Identifier: "ENG-3"}},
+		{Type: "related", Issue: &api.ParentIssue{ID: "i3"}, RelatedIssue: &api.ParentIssue{ID: "i1", Identifier: "ENG-1"}},
+		{Type: "blocks", Issue: &api.ParentIssue{ID: "i1"}, RelatedIssue: &api.ParentIssue{ID: "gone"}},
+	}
+
+	g := BuildIssueGraph("ENG", issues, relations)
+
+	wantEdges := []GraphEdge{
+		{From: "ENG-1", To: "ENG-2", Type: "parent"},
+		{From: "ENG-1", To: "ENG-3", Type: "related"},
+		{From: "ENG-2", To: "OPS-9", Type: "blocks"},
+		{From: "ENG-3", To: "ENG-2", Type: "blocks"},
+	}
+	if !reflect.DeepEqual(g.Edges, wantEdges) {
+		t.Errorf("edges = %+v\nwant %+v", g.Edges, wantEdges)
+	}
+	var ids []string
+	for _, n := range g.Nodes {
+		ids = append(ids, n.ID)
+		if n.ID == "OPS-9" && !n.External {
+			t.Errorf("OPS-9 should be external")
+		}
+	}
+	if want := []string{"ENG-1", "ENG-2", "ENG-3", "OPS-9"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("nodes = %v, want %v", ids, want)
+	}
+
+	dot := string(IssueGraphToDOT(g))
+	for _, want := range []string{
+		`digraph "ENG" {`,
+		`"ENG-1" [label="ENG-1\nEpic"];`,
+		`"ENG-3" [label="ENG-3\nDone", color=gray, fontcolor=gray];`,
+		`"OPS-9" [label="OPS-9\nInfra", style="rounded,dashed"];`,
+		`"ENG-3" -> "ENG-2" [label="blocks", color=red];`,
+		`"ENG-1" -> "ENG-3" [label="related", dir=none, style=dotted];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT missing %q:\n%s", want, dot)
+		}
+	}
+
+	data, err := IssueGraphToJSON(g)
+	if err != nil {
+		t.Fatalf("IssueGraphToJSON: %v", err)
+	}
+	var back IssueGraph
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("round-trip: %v", err)
+	}
+	if !reflect.DeepEqual(back, g) {
+		t.Errorf("JSON round-trip = %+v, want %+v", back, g)
+	}
+}
+
+// TestDotQuote covers titles that would otherwise break out of a DOT string.
+func TestDotQuote(t *testing.T) {
+	t.Parallel()
+	if got, want := dotQuote("a \"b\" \\ c\nd"), `"a \"b\" \\ c\nd"`; got != want {
+		t.Errorf("dotQuote = %s, want %s", got, want)
+	}
+}
@@ -3,7 +3,6 @@ package marshal
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/jra3/linear-fuse/internal/api"
 )
@@ -33,7 +32,7 @@ func formatHistoryEntry(entry *api.IssueHistoryEntry) string {
 	var sb strings.Builder
 
 	// Timestamp and actor
-	timestamp := entry.CreatedAt.Format(time.RFC3339)
+	timestamp := FormatDisplayTime(entry.CreatedAt)
 	actor := "System"
 	if entry.Actor != nil {
 		if entry.Actor.Email != "" {
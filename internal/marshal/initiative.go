@@ -1,8 +1,6 @@
 package marshal
 
 import (
-	"time"
-
 	"github.com/jra3/linear-fuse/internal/api"
 )
 
@@ -38,8 +36,8 @@ func InitiativeMetaToMarkdown(initiative *api.Initiative) ([]byte, error) {
 		"slug":    initiative.Slug,
 		"url":     initiative.URL,
 		"status":  initiative.Status,
-		"created": initiative.CreatedAt.Format(time.RFC3339),
-		"updated": initiative.UpdatedAt.Format(time.RFC3339),
+		"created": FormatTimestamp(initiative.CreatedAt),
+		"updated": FormatTimestamp(initiative.UpdatedAt),
 	}
 	if initiative.Description != "" {
 		fm["description"] = initiative.Description
@@ -60,6 +58,13 @@ func InitiativeMetaToMarkdown(initiative *api.Initiative) ([]byte, error) {
 	if initiative.TargetDate != nil {
 		fm["targetDate"] = *initiative.TargetDate
 	}
+	if initiative.Parent != nil {
+		fm["parent"] = map[string]any{
+			"id":   initiative.Parent.ID,
+			"name": initiative.Parent.Name,
+			"slug": initiative.Parent.Slug,
+		}
+	}
 	return Render(&Document{Frontmatter: fm})
 }
 
@@ -96,6 +96,27 @@ var issueScalarFields = []issueScalarField{
 	}, true},
 }
 
+// issueKeyAliases maps a scalar field's frontmatter key to the other spelling
+// the write parsers accept for it: the API field name, which is what Linear's
+// own payloads (and issue.meta readers) call it. Rendering always uses the
+// canonical key, and the canonical key wins when a file carries both.
+var issueKeyAliases = map[string]string{
+	"due": "dueDate",
+}
+
+// frontmatterValue returns the value fm holds for f, under its yamlKey or its
+// alias, and whether either key is present.
+func (f issueScalarField) frontmatterValue(fm map[string]any) (any, bool) {
+	if v, ok := fm[f.yamlKey]; ok {
+		return v, true
+	}
+	if alias, ok := issueKeyAliases[f.yamlKey]; ok {
+		v, ok := fm[alias]
+		return v, ok
+	}
+	return nil, false
+}
+
 // IssueToMarkdown converts a Linear issue to the editable-only markdown surface
 // (issue.md): the fields a writer may set, plus the description body. Server-
 // managed and write-volatile fields (id, url, updated, …) live in the read-only
@@ -148,8 +169,12 @@ func IssueToMarkdown(issue *api.Issue) ([]byte, error) {
 // managed, write-volatile fields (identity, timestamps, branch, external links,
 // and relations) as a YAML frontmatter block with no body. These are the fields
 // deliberately excluded from IssueToMarkdown so that editing issue.md never
-// races a server-written `updated:`.
-func IssueMetaToMarkdown(issue *api.Issue, attachments ...api.Attachment) ([]byte, error) {
+// races a server-written `updated:`. blockers are the issue's open blockers,
+// rendered as blockedBy plus the derived blocked flag; children are its synced
+// sub-issues, rolled up into the sub-issue progress fields. now is the
+// render time the flow metrics (ageDays, timeInCurrentState, leadTime) are
+// measured against.
+func IssueMetaToMarkdown(issue *api.Issue, now time.Time, blockers, children []api.Issue, attachments ...api.Attachment) ([]byte, error) {
 	fm := make(map[string]any)
 
 	// Identity + timestamps (read-only)
@@ -159,29 +184,37 @@ func IssueMetaToMarkdown(issue *api.Issue, attachments ...api.Attachment) ([]byt
 	if issue.Team != nil {
 		fm["team"] = issue.Team.Key
 	}
-	fm["created"] = issue.CreatedAt.Format(time.RFC3339)
-	fm["updated"] = issue.UpdatedAt.Format(time.RFC3339)
+	fm["created"] = FormatTimestamp(issue.CreatedAt)
+	fm["updated"] = FormatTimestamp(issue.UpdatedAt)
 	if issue.Creator != nil {
 		fm["creator"] = issue.Creator.Email
 	}
 	if issue.BranchName != "" {
 		fm["branch"] = issue.BranchName
 	}
+	// Restricted: Linear refused this token the issue's full fields, so
+	// issue.md cannot be opened (EACCES).
+	if issue.Restricted {
+		fm["restricted"] = true
+	}
 
 	// Workflow timestamps (read-only)
 	if issue.StartedAt != nil {
-		fm["started"] = issue.StartedAt.Format(time.RFC3339)
+		fm["started"] = FormatTimestamp(*issue.StartedAt)
 	}
 	if issue.CompletedAt != nil {
-		fm["completed"] = issue.CompletedAt.Format(time.RFC3339)
+		fm["completed"] = FormatTimestamp(*issue.CompletedAt)
 	}
 	if issue.CanceledAt != nil {
-		fm["canceled"] = issue.CanceledAt.Format(time.RFC3339)
+		fm["canceled"] = FormatTimestamp(*issue.CanceledAt)
 	}
 	if issue.ArchivedAt != nil {
-		fm["archived"] = issue.ArchivedAt.Format(time.RFC3339)
+		fm["archived"] = FormatTimestamp(*issue.ArchivedAt)
 	}
 
+	// Flow metrics (read-only), derived from the timestamps above.
+	issueFlowMetrics(fm, issue, now)
+
 	// External link attachments (read-only)
 	if len(attachments) > 0 {
 		links := make([]AttachmentLink, 0, len(attachments))
@@ -217,6 +250,45 @@ func IssueMetaToMarkdown(issue *api.Issue, attachments ...api.Attachment) ([]byt
 		fm["relations"] = relations
 	}
 
+	// Effective blocked state (read-only): blocked while any blocker is open.
+	// Always rendered, so `grep 'blocked: false'` finds workable issues.
+	fm["blocked"] = len(blockers) > 0
+	if len(blockers) > 0 {
+		ids := make([]string, len(blockers))
+		for i, b := range blockers {
+			ids[i] = b.Identifier
+		}
+		fm["blockedBy"] = ids
+	}
+
+	// Sub-issue rollup (read-only), as Linear shows sub-issue progress:
+	// canceled children drop out of both the estimate sum and the completion
+	// denominator. Only parents carry these fields.
+	if len(children) > 0 {
+		var total, done int
+		var estimate float64
+		for _, c := range children {
+			if c.State.Type == "canceled" {
+				continue
+			}
+			total++
+			if c.State.Type == "completed" {
+				done++
+			}
+			if c.Estimate != nil {
+				estimate += *c.Estimate
+			}
+		}
+		fm["childCount"] = len(children)
+		fm["childCompleted"] = done
+		fm["childEstimateSum"] = estimate
+		completion := 100
+		if total > 0 {
+			completion = done * 100 / total
+		}
+		fm["childCompletion"] = completion
+	}
+
 	// Meta is a frontmatter-only document (no body).
 	return Render(&Document{Frontmatter: fm})
 }
@@ -228,8 +300,28 @@ func MarkdownToIssueUpdate(content []byte, original *api.Issue) (map[string]any,
 		return nil, err
 	}
 
+	update, err := issueFieldUpdate(doc.Frontmatter, original)
+	if err != nil {
+		return nil, err
+	}
+
+	// Description (body). IssueToMarkdown renders a `# <Title>` placeholder for an
+	// empty description; a no-op rewrite of such an issue must not push that
+	// placeholder back as a real description (the byte-stable-write contract).
+	// A body shown cut (TruncatedView) gets its hidden remainder back first.
+	body := RestoreTruncatedBody(doc.Body, original.Description)
+	if body != original.Description && !isPlaceholderNoop(body, original.Description, original.Title) {
+		update["description"] = body
+	}
+
+	return update, nil
+}
+
+// issueFieldUpdate diffs the editable frontmatter fields in fm against
+// original: the update map MarkdownToIssueUpdate and IssuePatchToUpdate build,
+// minus the description.
+func issueFieldUpdate(fm map[string]any, original *api.Issue) (map[string]any, error) {
 	update := make(map[string]any)
-	fm := doc.Frontmatter
 
 	// Every editable field is coerced to its scalar form (ScalarToString) before
 	// comparison so a wrong-typed-but-meaningful value — an unquoted `due:` that
@@ -241,11 +333,12 @@ func MarkdownToIssueUpdate(content []byte, original *api.Issue) (map[string]any,
 	// Scalar fields (title, status, assignee, due, parent, project, milestone,
 	// cycle), table-driven: a present, non-empty value that differs from the
 	// current one is applied under the field's apiKey; a removable field absent
-	// from the frontmatter clears a value that was set. The apiKey names carry
-	// human values here — resolveIssueUpdate turns the relational ones into IDs.
+	// from the frontmatter (under its key and any alias, issueKeyAliases) clears
+	// a value that was set. The apiKey names carry human values here —
+	// resolveIssueUpdate turns the relational ones into IDs.
 	for _, f := range issueScalarFields {
 		origVal, origPresent := f.current(original)
-		if v, present := fm[f.yamlKey]; present {
+		if v, present := f.frontmatterValue(fm); present {
 			if s := ScalarToString(v); s != "" && s != origVal {
 				update[f.apiKey] = s
 			}
@@ -297,16 +390,25 @@ func MarkdownToIssueUpdate(content []byte, original *api.Issue) (map[string]any,
 		update["labelIds"] = []string{} // removed
 	}
 
-	// Description (body). IssueToMarkdown renders a `# <Title>` placeholder for an
-	// empty description; a no-op rewrite of such an issue must not push that
-	// placeholder back as a real description (the byte-stable-write contract).
-	if doc.Body != original.Description && !isPlaceholderNoop(doc.Body, original.Description, original.Title) {
-		update["description"] = doc.Body
-	}
-
 	return update, nil
 }
 
+// AppendToDescription returns description with appended added as a new
+// paragraph — the O_APPEND (`echo "note" >> issue.md`) save path. Surrounding
+// blank lines in appended are dropped; ok is false when nothing but
+// whitespace was appended, so the caller can skip the write.
+func AppendToDescription(description, appended string) (updated string, ok bool) {
+	note := strings.Trim(appended, "\r\n")
+	if strings.TrimSpace(note) == "" {
+		return description, false
+	}
+	base := strings.TrimRight(description, "\r\n")
+	if strings.TrimSpace(base) == "" {
+		return note, true
+	}
+	return base + "\n\n" + note, true
+}
+
 // MarkdownToIssueCreate parses a full issue spec (frontmatter + body) into a
 // create-input map for a brand-new issue. Unlike MarkdownToIssueUpdate it emits
 // every present editable field (there is no "original" to diff against), with
@@ -328,7 +430,8 @@ func MarkdownToIssueCreate(content []byte) (map[string]any, error) {
 	// numeric name (`cycle: 42`) — is applied, not silently dropped (#148); a
 	// missing key coerces to "" and is skipped, and unknown keys are ignored.
 	for _, f := range issueScalarFields {
-		if s := ScalarToString(fm[f.yamlKey]); s != "" {
+		v, _ := f.frontmatterValue(fm)
+		if s := ScalarToString(v); s != "" {
 			create[f.apiKey] = s
 		}
 	}
@@ -463,3 +566,38 @@ func stringSlicesEqual(a, b []string) bool {
 	}
 	return true
 }
+
+// issueFlowMetrics adds the derived flow fields to an issue.meta frontmatter:
+// ageDays (whole days since creation), timeInCurrentState (days since the
+// issue entered its current state type), and leadTime (days from creation to
+// completion). Durations are days to one decimal so awk can sum and compare
+// them directly.
+//
+// Linear stamps only startedAt, completedAt, and canceledAt, so
+// timeInCurrentState is known only in a started, completed, or canceled state;
+// an issue in triage, backlog, or unstarted has no entry time to measure from,
+// and the field is omitted rather than guessed from createdAt.
+func issueFlowMetrics(fm map[string]any, issue *api.Issue, now time.Time) {
+	fm["ageDays"] = int(now.Sub(issue.CreatedAt).Hours() / 24)
+
+	var entered *time.Time
+	switch issue.State.Type {
+	case "started":
+		entered = issue.StartedAt
+	case "completed":
+		entered = issue.CompletedAt
+	case "canceled":
+		entered = issue.CanceledAt
+	}
+	if entered != nil {
+		fm["timeInCurrentState"] = durationDays(now.Sub(*entered))
+	}
+	if issue.CompletedAt != nil {
+		fm["leadTime"] = durationDays(issue.CompletedAt.Sub(issue.CreatedAt))
+	}
+}
+
+// durationDays renders d in days, to one decimal.
+func durationDays(d time.Duration) float64 {
+	return math.Round(d.Hours()/24*10) / 10
+}
@@ -1,6 +1,7 @@
 package marshal
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -502,14 +503,18 @@ Original description`
 }
 
 // TestIssueMetaToMarkdown covers the read-only issue.meta surface: identity
-// fields plus external-link attachments (which moved out of issue.md in #150).
+// fields, external-link attachments (which moved out of issue.md in #150), and
+// the blockedBy/blocked annotation.
 func TestIssueMetaToMarkdown(t *testing.T) {
 	t.Parallel()
 	baseTime := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	three, two := 3.0, 2.0
 
 	tests := []struct {
 		name        string
 		issue       *api.Issue
+		blockers    []api.Issue
+		children    []api.Issue
 		attachments []api.Attachment
 		wantContain []string
 		wantMissing []string
@@ -601,6 +606,22 @@ func TestIssueMetaToMarkdown(t *testing.T) {
 				"links:", // Should NOT have links field when no attachments
 			},
 		},
+		{
+			name: "restricted issue is flagged",
+			issue: &api.Issue{
+				ID: "issue-private", Identifier: "SEC-1", Title: "Private",
+				CreatedAt: baseTime, UpdatedAt: baseTime, Restricted: true,
+			},
+			wantContain: []string{"restricted: true"},
+		},
+		{
+			name: "readable issue carries no restricted flag",
+			issue: &api.Issue{
+				ID: "issue-public", Identifier: "ENG-2", Title: "Public",
+				CreatedAt: baseTime, UpdatedAt: baseTime,
+			},
+			wantMissing: []string{"restricted:"},
+		},
 		{
 			name: "issue with nil attachments - no links field",
 			issue: &api.Issue{
@@ -623,11 +644,63 @@ func TestIssueMetaToMarkdown(t *testing.T) {
 				"links:",
 			},
 		},
+		{
+			name: "blocked issue lists its open blockers",
+			issue: &api.Issue{
+				ID:         "issue-blocked",
+				Identifier: "ENG-777",
+				Title:      "Waits on others",
+				State:      api.State{ID: "state-1", Name: "Todo"},
+				CreatedAt:  baseTime,
+				UpdatedAt:  baseTime,
+			},
+			blockers: []api.Issue{{Identifier: "ENG-1"}, {Identifier: "ENG-2"}},
+			wantContain: []string{
+				"blocked: true",
+				"blockedBy:\n    - ENG-1\n    - ENG-2\n",
+			},
+		},
+		{
+			name: "unblocked issue says so",
+			issue: &api.Issue{
+				ID:         "issue-free",
+				Identifier: "ENG-778",
+				Title:      "Free to go",
+				State:      api.State{ID: "state-1", Name: "Todo"},
+				CreatedAt:  baseTime,
+				UpdatedAt:  baseTime,
+			},
+			wantContain: []string{"blocked: false"},
+			wantMissing: []string{"blockedBy:", "childCount:"},
+		},
+		{
+			name: "parent rolls up its sub-issues",
+			issue: &api.Issue{
+				ID:         "issue-parent",
+				Identifier: "ENG-779",
+				Title:      "Epic",
+				State:      api.State{ID: "state-1", Name: "In Progress"},
+				CreatedAt:  baseTime,
+				UpdatedAt:  baseTime,
+			},
+			children: []api.Issue{
+				{Identifier: "ENG-780", State: api.State{Type: "completed"}, Estimate: &three},
+				{Identifier: "ENG-781", State: api.State{Type: "started"}, Estimate: &two},
+				{Identifier: "ENG-782", State: api.State{Type: "unstarted"}},
+				{Identifier: "ENG-783", State: api.State{Type: "canceled"}, Estimate: &three},
+			},
+			wantContain: []string{
+				"childCount: 4",
+				"childCompleted: 1",
+				"childEstimateSum: 5",
+				"childCompletion: 33",
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := IssueMetaToMarkdown(tt.issue, tt.attachments...)
+			got, err := IssueMetaToMarkdown(tt.issue, baseTime, tt.blockers, tt.children, tt.attachments...)
 			if err != nil {
 				t.Fatalf("IssueMetaToMarkdown() error: %v", err)
 			}
@@ -651,6 +724,88 @@ func TestIssueMetaToMarkdown(t *testing.T) {
 	}
 }
 
+// TestIssueMetaFlowMetrics pins the derived flow fields: age always, time in
+// the current state only where Linear stamps its entry, lead time once done.
+func TestIssueMetaFlowMetrics(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	started := created.Add(36 * time.Hour)
+	completed := created.Add(7*24*time.Hour + 6*time.Hour)
+	now := created.Add(10*24*time.Hour + 12*time.Hour)
+
+	tests := []struct {
+		name  string
+		issue api.Issue
+		want  map[string]any // nil value: key must be absent
+	}{
+		{
+			name:  "unstarted",
+			issue: api.Issue{State: api.State{Type: "unstarted"}, CreatedAt: created},
+			want:  map[string]any{"ageDays": 10, "timeInCurrentState": nil, "leadTime": nil},
+		},
+		{
+			name:  "started",
+			issue: api.Issue{State: api.State{Type: "started"}, CreatedAt: created, StartedAt: &started},
+			want:  map[string]any{"ageDays": 10, "timeInCurrentState": 9, "leadTime": nil},
+		},
+		{
+			name:  "completed",
+			issue: api.Issue{State: api.State{Type: "completed"}, CreatedAt: created, StartedAt: &started, CompletedAt: &completed},
+			want:  map[string]any{"ageDays": 10, "timeInCurrentState": 3.3, "leadTime": 7.3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := IssueMetaToMarkdown(&tt.issue, now, nil, nil)
+			if err != nil {
+				t.Fatalf("IssueMetaToMarkdown: %v", err)
+			}
+			doc, err := Parse(content)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			for key, want := range tt.want {
+				got, ok := doc.Frontmatter[key]
+				if want == nil {
+					if ok {
+						t.Errorf("%s = %v, want absent", key, got)
+					}
+					continue
+				}
+				if fmt.Sprint(got) != fmt.Sprint(want) {
+					t.Errorf("%s = %v, want %v", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendToDescription(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		description string
+		appended    string
+		want        string
+		wantOK      bool
+	}{
+		{"echo onto body", "Existing body.\n", "note\n", "Existing body.\n\nnote", true},
+		{"empty description", "", "note\n", "note", true},
+		{"multi-line note", "Body", "\n- a\n- b\n\n", "Body\n\n- a\n- b", true},
+		{"keeps note indentation", "Body", "    code\n", "Body\n\n    code", true},
+		{"whitespace only", "Body", " \n\n", "Body", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := AppendToDescription(tt.description, tt.appended)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("AppendToDescription(%q, %q) = %q, %v; want %q, %v", tt.description, tt.appended, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
 func TestStringSlicesEqual(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -884,6 +1039,41 @@ func TestMarkdownToIssueUpdateCoercesScalars(t *testing.T) {
 	}
 }
 
+// TestIssueDueDateAlias: `dueDate:` (the API field name) sets the due date on
+// edit and on create like `due:`, keeping an existing due date is not read as
+// a removal, and `due:` wins when a file carries both.
+func TestIssueDueDateAlias(t *testing.T) {
+	t.Parallel()
+	due := "2026-03-01"
+	original := &api.Issue{Title: "X", DueDate: &due, Description: "body"}
+
+	cases := []struct {
+		name, frontmatter string
+		want              any // update["dueDate"]; nil means no change
+	}{
+		{"alias sets", "dueDate: 2026-04-01", "2026-04-01"},
+		{"alias unchanged is no removal", "dueDate: \"2026-03-01\"", nil},
+		{"canonical key wins", "due: 2026-05-01\ndueDate: 2026-04-01", "2026-05-01"},
+	}
+	for _, tc := range cases {
+		update, err := MarkdownToIssueUpdate([]byte("---\ntitle: X\n"+tc.frontmatter+"\n---\nbody\n"), original)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if v, ok := update["dueDate"]; tc.want == nil && ok || tc.want != nil && v != tc.want {
+			t.Errorf("%s: update[dueDate] = %#v (present %v), want %#v", tc.name, v, ok, tc.want)
+		}
+	}
+
+	create, err := MarkdownToIssueCreate([]byte("---\ntitle: New\ndueDate: 2026-04-01\n---\n"))
+	if err != nil {
+		t.Fatalf("MarkdownToIssueCreate: %v", err)
+	}
+	if create["dueDate"] != "2026-04-01" {
+		t.Errorf("create[dueDate] = %#v, want \"2026-04-01\"", create["dueDate"])
+	}
+}
+
 // TestMarkdownToIssueUpdateQuotedEstimateDoesNotZero guards the worst update-path
 // finding: a quoted `estimate: "3"` matched neither int nor float and zeroed the
 // estimate on Linear. It must now coerce (or, if unparseable, leave untouched).
@@ -0,0 +1,377 @@
+package marshal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// The issue.patch format.
+//
+// An RFC 6902 JSON Patch against an issue's editable fields, for a writer (an
+// agent, a script) that wants one field changed without rendering, editing,
+// and re-parsing all of issue.md. The patch applies to this document: the
+// issue.md frontmatter keys, with the body as "description".
+//
+//	{"title": "…", "status": "In Progress", "assignee": "ada@example.com",
+//	 "due": "2026-11-01", "parent": "ENG-1", "project": "…", "milestone": "…",
+//	 "cycle": "…", "priority": "high", "labels": ["Bug"], "estimate": 3,
+//	 "description": "…"}
+//
+// An unset field is absent, so `add` sets it and `remove` clears it. Every
+// operation applies or none does, and the result is validated strictly — an
+// unknown field, a wrong-typed value, or a removed title, status, priority, or
+// description rejects the whole patch — before it is diffed against the issue
+// into the update map MarkdownToIssueUpdate builds.
+
+// patchOperation is one RFC 6902 operation. Value stays raw so an absent value
+// (nil) is told apart from an explicit null.
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// issuePatchRequired are the fields a patch may change but not remove.
+var issuePatchRequired = []string{"title", "status", "priority", "description"}
+
+// IssuePatchToUpdate applies an issue.patch to original and returns the fields
+// that changed, keyed as MarkdownToIssueUpdate keys them (relational fields
+// still human names for the resolver). A malformed patch, a failed operation
+// (including a failed `test`), or an invalid result is a *FieldError naming the
+// operation or field.
+func IssuePatchToUpdate(patch []byte, original *api.Issue) (map[string]any, error) {
+	var ops []patchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, &FieldError{Field: "patch", Message: "not a JSON Patch (an array of operations): " + err.Error()}
+	}
+	var doc any = issuePatchDocument(original)
+	for i, op := range ops {
+		var err error
+		if doc, err = applyPatchOperation(doc, op); err != nil {
+			path := ""
+			if op.Path != nil && *op.Path != "" {
+				path = " " + *op.Path
+			}
+			return nil, &FieldError{Field: fmt.Sprintf("patch[%d] (%s%s)", i, op.Op, path), Message: err.Error()}
+		}
+	}
+	fields := doc.(map[string]any)
+	if err := validateIssuePatch(fields, original); err != nil {
+		return nil, err
+	}
+
+	description := fields["description"].(string)
+	delete(fields, "description")
+	update, err := issueFieldUpdate(fields, original)
+	if err != nil {
+		return nil, err
+	}
+	if description != original.Description {
+		update["description"] = description
+	}
+	return update, nil
+}
+
+// issuePatchDocument is the JSON document an issue.patch applies to.
+func issuePatchDocument(issue *api.Issue) map[string]any {
+	doc := map[string]any{
+		"priority":    api.PriorityName(issue.Priority),
+		"description": issue.Description,
+	}
+	for _, f := range issueScalarFields {
+		if v, present := f.current(issue); present {
+			doc[f.yamlKey] = v
+		}
+	}
+	if len(issue.Labels.Nodes) > 0 {
+		labels := make([]any, len(issue.Labels.Nodes))
+		for i, l := range issue.Labels.Nodes {
+			labels[i] = l.Name
+		}
+		doc["labels"] = labels
+	}
+	if issue.Estimate != nil {
+		doc["estimate"] = *issue.Estimate
+	}
+	return doc
+}
+
+// validateIssuePatch checks a patched document field by field, in name order
+// so the first complaint is stable.
+func validateIssuePatch(doc map[string]any, original *api.Issue) error {
+	for _, key := range slices.Sorted(maps.Keys(doc)) {
+		v := doc[key]
+		switch key {
+		case "title", "status", "assignee", "due", "parent", "project", "milestone", "cycle", "description":
+			s, ok := v.(string)
+			if !ok {
+				return &FieldError{Field: key, Message: "must be a string"}
+			}
+			switch {
+			case s == "" && slices.Contains(issuePatchRequired, key):
+				if key != "description" {
+					return &FieldError{Field: key, Message: "must not be empty"}
+				}
+			case s == "":
+				return &FieldError{Field: key, Message: "must not be empty; remove the field to clear it"}
+			case key == "due":
+				if _, err := time.Parse("2006-01-02", s); err != nil {
+					return &FieldError{Field: key, Value: s, Message: "must be a date (YYYY-MM-DD)"}
+				}
+			}
+		case "priority":
+			if _, set, err := coercePriority(v); err != nil || !set {
+				return &FieldError{Field: key, Message: "must be a name (none|low|medium|high|urgent) or a number 0-4"}
+			}
+		case "estimate":
+			if e, ok := v.(float64); !ok || e != math.Trunc(e) || e < 0 {
+				return &FieldError{Field: key, Message: "must be a whole number of points"}
+			}
+		case "labels":
+			list, ok := v.([]any)
+			if !ok {
+				return &FieldError{Field: key, Message: "must be an array of label names"}
+			}
+			for _, item := range list {
+				if s, ok := item.(string); !ok || s == "" {
+					return &FieldError{Field: key, Message: "must be an array of label names"}
+				}
+			}
+		default:
+			return &FieldError{Field: key, Message: "not an editable issue field"}
+		}
+	}
+	for _, key := range issuePatchRequired {
+		if _, ok := doc[key]; !ok && (key != "status" || original.State.ID != "") {
+			return &FieldError{Field: key, Message: "cannot be removed"}
+		}
+	}
+	return nil
+}
+
+// applyPatchOperation applies one operation to doc and returns the result. The
+// root itself is not patchable: an issue.patch names fields.
+func applyPatchOperation(doc any, op patchOperation) (any, error) {
+	if op.Path == nil {
+		return nil, errors.New(`missing "path"`)
+	}
+	path, err := parsePointer(*op.Path)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, errors.New("the whole issue cannot be patched; name a field (e.g. /title)")
+	}
+	var value any
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, errors.New(`missing "value"`)
+		}
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return nil, err
+		}
+	case "move", "copy":
+		if op.From == nil {
+			return nil, errors.New(`missing "from"`)
+		}
+		from, err := parsePointer(*op.From)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = pointerGet(doc, from); err != nil {
+			return nil, err
+		}
+		if op.Op == "copy" {
+			value = cloneJSON(value)
+		} else {
+			if len(from) < len(path) && slices.Equal(from, path[:len(from)]) {
+				return nil, errors.New("cannot move a value into itself")
+			}
+			if doc, err = patchEdit(doc, from, pointerRemove); err != nil {
+				return nil, err
+			}
+		}
+	case "remove":
+	case "":
+		return nil, errors.New(`missing "op"`)
+	default:
+		return nil, fmt.Errorf("unknown op %q (want add, remove, replace, move, copy, or test)", op.Op)
+	}
+
+	switch op.Op {
+	case "add", "move", "copy":
+		return patchEdit(doc, path, func(parent any, key string) (any, error) { return pointerAdd(parent, key, value) })
+	case "replace":
+		return patchEdit(doc, path, func(parent any, key string) (any, error) { return pointerReplace(parent, key, value) })
+	case "remove":
+		return patchEdit(doc, path, pointerRemove)
+	}
+	// test
+	got, err := pointerGet(doc, path)
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(got, value) {
+		current, _ := json.Marshal(got)
+		return nil, fmt.Errorf("test failed: the current value is %s", current)
+	}
+	return doc, nil
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped tokens.
+func parsePointer(p string) ([]string, error) {
+	if p == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(p, "/") {
+		return nil, fmt.Errorf("path %q must start with /", p)
+	}
+	tokens := strings.Split(p[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// patchEdit walks doc to the container holding path's last token, applies edit
+// there, and returns doc with the edited container in place.
+func patchEdit(node any, path []string, edit func(parent any, key string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return edit(node, path[0])
+	}
+	child, err := pointerChild(node, path[0])
+	if err != nil {
+		return nil, err
+	}
+	edited, err := patchEdit(child, path[1:], edit)
+	if err != nil {
+		return nil, err
+	}
+	switch c := node.(type) {
+	case map[string]any:
+		c[path[0]] = edited
+	case []any:
+		i, _ := strconv.Atoi(path[0])
+		c[i] = edited
+	}
+	return node, nil
+}
+
+func pointerGet(node any, path []string) (any, error) {
+	for _, token := range path {
+		var err error
+		if node, err = pointerChild(node, token); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+func pointerChild(node any, token string) (any, error) {
+	switch c := node.(type) {
+	case map[string]any:
+		child, ok := c[token]
+		if !ok {
+			return nil, fmt.Errorf("no field %q", token)
+		}
+		return child, nil
+	case []any:
+		i, err := arrayIndex(token, len(c), false)
+		if err != nil {
+			return nil, err
+		}
+		return c[i], nil
+	}
+	return nil, fmt.Errorf("%q is not inside an object or array", token)
+}
+
+func pointerAdd(parent any, key string, value any) (any, error) {
+	switch c := parent.(type) {
+	case map[string]any:
+		c[key] = value
+		return c, nil
+	case []any:
+		i, err := arrayIndex(key, len(c), true)
+		if err != nil {
+			return nil, err
+		}
+		return slices.Insert(c, i, value), nil
+	}
+	return nil, fmt.Errorf("%q is not inside an object or array", key)
+}
+
+func pointerReplace(parent any, key string, value any) (any, error) {
+	if _, err := pointerChild(parent, key); err != nil {
+		return nil, err
+	}
+	switch c := parent.(type) {
+	case map[string]any:
+		c[key] = value
+	case []any:
+		i, _ := strconv.Atoi(key)
+		c[i] = value
+	}
+	return parent, nil
+}
+
+func pointerRemove(parent any, key string) (any, error) {
+	if _, err := pointerChild(parent, key); err != nil {
+		return nil, err
+	}
+	switch c := parent.(type) {
+	case map[string]any:
+		delete(c, key)
+	case []any:
+		i, _ := strconv.Atoi(key)
+		return slices.Delete(c, i, i+1), nil
+	}
+	return parent, nil
+}
+
+// arrayIndex parses an array token: a decimal index without leading zeros
+// below n, or up to n (and "-", the end) when adding.
+func arrayIndex(token string, n int, adding bool) (int, error) {
+	if adding && token == "-" {
+		return n, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || strconv.Itoa(i) != token {
+		return 0, fmt.Errorf("%q is not an array index", token)
+	}
+	if i > n || i == n && !adding {
+		return 0, fmt.Errorf("index %d is out of range (length %d)", i, n)
+	}
+	return i, nil
+}
+
+// cloneJSON deep-copies a decoded JSON value, so a copied value and its source
+// never share an array.
+func cloneJSON(v any) any {
+	switch c := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(c))
+		for k, e := range c {
+			out[k] = cloneJSON(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(c))
+		for i, e := range c {
+			out[i] = cloneJSON(e)
+		}
+		return out
+	}
+	return v
+}
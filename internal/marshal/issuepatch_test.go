@@ -0,0 +1,100 @@
+package marshal
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestIssuePatchToUpdate(t *testing.T) {
+	t.Parallel()
+	estimate := 3.0
+	issue := &api.Issue{
+		ID: "issue-1", Identifier: "ENG-1", Title: "Patch me", Description: "Body.",
+		Priority: 3, Estimate: &estimate,
+		State:    api.State{ID: "st-todo", Name: "Todo"},
+		Assignee: &api.User{ID: "user-1", Email: "ada@example.com"},
+		Labels:   api.Labels{Nodes: []api.Label{{ID: "l-bug", Name: "Bug"}}},
+	}
+
+	tests := []struct {
+		name  string
+		patch string
+		want  map[string]any
+	}{
+		{"replace title", `[{"op":"replace","path":"/title","value":"Patched"}]`,
+			map[string]any{"title": "Patched"}},
+		{"guarded status change", `[{"op":"test","path":"/status","value":"Todo"},{"op":"replace","path":"/status","value":"Done"}]`,
+			map[string]any{"stateId": "Done"}},
+		{"append a label", `[{"op":"add","path":"/labels/-","value":"Urgent"}]`,
+			map[string]any{"labelIds": []string{"Bug", "Urgent"}}},
+		{"clear the assignee", `[{"op":"remove","path":"/assignee"}]`,
+			map[string]any{"assigneeId": nil}},
+		{"set a due date", `[{"op":"add","path":"/due","value":"2026-11-01"}]`,
+			map[string]any{"dueDate": "2026-11-01"}},
+		{"priority by number", `[{"op":"replace","path":"/priority","value":1}]`,
+			map[string]any{"priority": 1}},
+		{"copy title into description", `[{"op":"copy","from":"/title","path":"/description"}]`,
+			map[string]any{"description": "Patch me"}},
+		{"no-op replace", `[{"op":"replace","path":"/estimate","value":3}]`,
+			map[string]any{}},
+		{"empty patch", `[]`, map[string]any{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IssuePatchToUpdate([]byte(tt.patch), issue)
+			if err != nil {
+				t.Fatalf("IssuePatchToUpdate: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("update = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIssuePatchToUpdateRejects: a bad patch is a FieldError naming the
+// operation or field, and nothing applies.
+func TestIssuePatchToUpdateRejects(t *testing.T) {
+	t.Parallel()
+	issue := &api.Issue{ID: "issue-1", Title: "Patch me", State: api.State{ID: "st-todo", Name: "Todo"}}
+
+	tests := []struct {
+		name      string
+		patch     string
+		wantField string
+		wantMsg   string
+	}{
+		{"not json", `{"op":"replace"}`, "patch", "not a JSON Patch"},
+		{"unknown op", `[{"op":"merge","path":"/title","value":"x"}]`, "patch[0] (merge /title)", "unknown op"},
+		{"missing value", `[{"op":"replace","path":"/title"}]`, "patch[0] (replace /title)", `missing "value"`},
+		{"replace unset field", `[{"op":"replace","path":"/project","value":"Apollo"}]`, "patch[0] (replace /project)", `no field "project"`},
+		{"failed test", `[{"op":"replace","path":"/title","value":"x"},{"op":"test","path":"/status","value":"Done"}]`,
+			"patch[1] (test /status)", `the current value is "Todo"`},
+		{"whole document", `[{"op":"replace","path":"","value":{}}]`, "patch[0] (replace)", "name a field"},
+		{"bad index", `[{"op":"add","path":"/labels","value":[]},{"op":"add","path":"/labels/01","value":"x"}]`,
+			"patch[1] (add /labels/01)", "not an array index"},
+		{"unknown field", `[{"op":"add","path":"/url","value":"x"}]`, "url", "not an editable issue field"},
+		{"wrong type", `[{"op":"replace","path":"/title","value":7}]`, "title", "must be a string"},
+		{"remove required", `[{"op":"remove","path":"/status"}]`, "status", "cannot be removed"},
+		{"bad priority", `[{"op":"replace","path":"/priority","value":"soon"}]`, "priority", "must be a name"},
+		{"bad estimate", `[{"op":"add","path":"/estimate","value":1.5}]`, "estimate", "whole number"},
+		{"bad due", `[{"op":"add","path":"/due","value":"tomorrow"}]`, "due", "YYYY-MM-DD"},
+		{"empty to clear", `[{"op":"add","path":"/cycle","value":""}]`, "cycle", "remove the field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := IssuePatchToUpdate([]byte(tt.patch), issue)
+			var ferr *FieldError
+			if !errors.As(err, &ferr) {
+				t.Fatalf("err = %v, want a *FieldError", err)
+			}
+			if ferr.Field != tt.wantField || !strings.Contains(ferr.Message, tt.wantMsg) {
+				t.Errorf("FieldError = %q: %q, want %q containing %q", ferr.Field, ferr.Message, tt.wantField, tt.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package marshal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/pdf"
+)
+
+// IssueToPDF renders the issue.pdf export: the issue's metadata, its
+// description (markdown source, as written), and its comments oldest first —
+// a self-contained copy to attach to an email or audit record. comments are
+// expected in creation order (as the repository returns them).
+func IssueToPDF(issue *api.Issue, comments []api.Comment) []byte {
+	var d pdf.Document
+	d.Heading(issue.Identifier + ": " + issue.Title)
+
+	var meta []string
+	field := func(name, value string) {
+		if value != "" {
+			meta = append(meta, fmt.Sprintf("%-10s %s", name+":", value))
+		}
+	}
+	field("Status", issue.State.Name)
+	field("Priority", api.PriorityName(issue.Priority))
+	field("Assignee", pdfUser(issue.Assignee))
+	field("Creator", pdfUser(issue.Creator))
+	if issue.Team != nil {
+		field("Team", issue.Team.Key)
+	}
+	if issue.Project != nil {
+		field("Project", issue.Project.Name)
+	}
+	if issue.Cycle != nil {
+		field("Cycle", issue.Cycle.Name)
+	}
+	labels := make([]string, 0, len(issue.Labels.Nodes))
+	for _, l := range issue.Labels.Nodes {
+		labels = append(labels, l.Name)
+	}
+	field("Labels", strings.Join(labels, ", "))
+	if issue.Estimate != nil {
+		field("Estimate", strconv.FormatFloat(*issue.Estimate, 'f', -1, 64))
+	}
+	if issue.DueDate != nil {
+		field("Due", *issue.DueDate)
+	}
+	field("Created", pdfTime(&issue.CreatedAt))
+	field("Updated", pdfTime(&issue.UpdatedAt))
+	field("Completed", pdfTime(issue.CompletedAt))
+	field("Canceled", pdfTime(issue.CanceledAt))
+	field("URL", issue.URL)
+	d.Paragraph(strings.Join(meta, "\n"))
+
+	d.Heading("Description")
+	if strings.TrimSpace(issue.Description) == "" {
+		d.Paragraph("(no description)")
+	} else {
+		d.Paragraph(strings.TrimSpace(issue.Description))
+	}
+
+	d.Heading(fmt.Sprintf("Comments (%d)", len(comments)))
+	for i, c := range comments {
+		if i > 0 {
+			d.Paragraph("")
+		}
+		author := pdfUser(c.User)
+		if author == "" {
+			author = "Unknown"
+		}
+		d.Paragraph(fmt.Sprintf("--- %s, %s ---", author, pdfTime(&c.CreatedAt)))
+		d.Paragraph(strings.TrimSpace(c.Body))
+	}
+	return d.Bytes()
+}
+
+func pdfUser(u *api.User) string {
+	switch {
+	case u == nil:
+		return ""
+	case u.Email != "":
+		return u.Name + " <" + u.Email + ">"
+	default:
+		return u.Name
+	}
+}
+
+func pdfTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return FormatDisplayTime(*t)
+}
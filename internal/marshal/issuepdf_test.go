@@ -0,0 +1,59 @@
+package marshal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestIssueToPDF(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	issue := &api.Issue{
+		Identifier:  "ENG-42",
+		Title:       "Rotate keys",
+		Description: "Rotate the signing keys.\n\n- [ ] staging\n- [ ] prod",
+		State:       api.State{Name: "In Progress"},
+		Priority:    2,
+		Assignee:    &api.User{Name: "Ada", Email: "ada@example.com"},
+		Team:        &api.Team{Key: "ENG"},
+		Labels:      api.Labels{Nodes: []api.Label{{Name: "security"}, {Name: "ops"}}},
+		CreatedAt:   created,
+		UpdatedAt:   created,
+		URL:         "https://linear.app/acme/issue/ENG-42",
+	}
+	comments := []api.Comment{
+		{Body: "Staging done.", CreatedAt: created.Add(time.Hour), User: &api.User{Name: "Grace"}},
+		{Body: "Prod scheduled.", CreatedAt: created.Add(2 * time.Hour)},
+	}
+
+	out := IssueToPDF(issue, comments)
+	if !bytes.HasPrefix(out, []byte("%PDF-")) {
+		t.Fatalf("not a PDF: %q", out[:min(len(out), 20)])
+	}
+	for _, want := range []string{
+		"(ENG-42: Rotate keys)",
+		"(Status:    In Progress)",
+		"(Priority:  high)",
+		"(Assignee:  Ada <ada@example.com>)",
+		"(Labels:    security, ops)",
+		"(Created:   2026-10-01T09:30:00Z)",
+		"(- [ ] staging)",
+		"(Comments \\(2\\))",
+		"(--- Grace, 2026-10-01T10:30:00Z ---)",
+		"(Staging done.)",
+		"(--- Unknown, 2026-10-01T11:30:00Z ---)",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("issue.pdf missing %q", want)
+		}
+	}
+
+	empty := IssueToPDF(&api.Issue{Identifier: "ENG-1", Title: "Bare"}, nil)
+	if !strings.Contains(string(empty), "(\\(no description\\))") || !strings.Contains(string(empty), "(Comments \\(0\\))") {
+		t.Errorf("bare issue:\n%s", empty)
+	}
+}
@@ -21,7 +21,11 @@ func TestEveryEditableRenderHasMetaTwin(t *testing.T) {
 	// Read-only generated renders with no editable file — no .meta twin exists
 	// or should. Extending this list is a deliberate act with a reason.
 	readOnly := map[string]string{
-		"History": "history.md is a read-only generated file (renderFile), not an editable entity",
+		"History":          "history.md is a read-only generated file (renderFile), not an editable entity",
+		"Backlinks":        "backlinks.md is a read-only generated file (renderFile), not an editable entity",
+		"Attachments":      "attachments.md is a read-only generated table (renderFile); each attachment's own entry is its .link file",
+		"InitiativeRollup": "rollup.md is a read-only generated summary (renderFile) over an initiative's subtree",
+		"CommentTombstone": "a deleted comment's tombstone is read-only (renderFile); its .meta is the comment's own CommentMetaToMarkdown, with deleted:",
 	}
 
 	files, err := filepath.Glob("*.go")
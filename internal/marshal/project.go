@@ -1,8 +1,6 @@
 package marshal
 
 import (
-	"time"
-
 	"github.com/jra3/linear-fuse/internal/api"
 )
 
@@ -48,12 +46,18 @@ func ProjectMetaToMarkdown(project *api.Project) ([]byte, error) {
 		"slug":    project.Slug,
 		"url":     project.URL,
 		"status":  status,
-		"created": project.CreatedAt.Format(time.RFC3339),
-		"updated": project.UpdatedAt.Format(time.RFC3339),
+		"created": FormatTimestamp(project.CreatedAt),
+		"updated": FormatTimestamp(project.UpdatedAt),
 	}
 	if project.Description != "" {
 		fm["description"] = project.Description
 	}
+	if project.Icon != "" {
+		fm["icon"] = project.Icon
+	}
+	if project.Color != "" {
+		fm["color"] = project.Color
+	}
 	if project.Lead != nil {
 		fm["lead"] = map[string]any{
 			"id":    project.Lead.ID,
@@ -87,6 +87,8 @@ func TestProjectMetaToMarkdown(t *testing.T) {
 		Slug:        "api-gateway",
 		URL:         "https://linear.app/projects/api-gateway",
 		Description: "Short summary (read-only here, distinct from content).",
+		Icon:        "🚀",
+		Color:       "#5e6ad2",
 		Status:      &api.Status{Name: "In Progress"},
 		Lead:        &api.User{ID: "u1", Name: "Ada", Email: "ada@example.com"},
 		StartDate:   &start,
@@ -101,7 +103,7 @@ func TestProjectMetaToMarkdown(t *testing.T) {
 	}
 	keys, doc := frontmatterKeys(t, content)
 	// The short description is read-only here (#5); content is the editable body.
-	want := []string{"created", "description", "id", "lead", "slug", "startDate", "status", "targetDate", "updated", "url"}
+	want := []string{"color", "created", "description", "icon", "id", "lead", "slug", "startDate", "status", "targetDate", "updated", "url"}
 	if !reflect.DeepEqual(keys, want) {
 		t.Errorf("project.meta frontmatter keys = %v, want %v", keys, want)
 	}
@@ -0,0 +1,72 @@
+package marshal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InitiativeRollupRow is one initiative's line in rollup.md: counts over the
+// projects linked to it directly (not its sub-initiatives'). Depth is 0 for
+// the initiative the file belongs to, 1 for its children, and so on.
+type InitiativeRollupRow struct {
+	Name              string
+	Status            string
+	Depth             int
+	Projects          int
+	ProjectsCompleted int
+	Issues            int
+	IssuesCompleted   int
+	IssuesCanceled    int
+}
+
+// InitiativeRollup is an initiative's subtree, parent before children. Total
+// counts each project once even when several initiatives in the subtree link
+// it.
+type InitiativeRollup struct {
+	Name  string
+	Rows  []InitiativeRollupRow
+	Total InitiativeRollupRow
+}
+
+// InitiativeRollupToMarkdown renders rollup.md: a totals line and one table
+// row per initiative in the subtree, sub-initiatives indented under their
+// parent. Progress is completed issues over issues not canceled.
+func InitiativeRollupToMarkdown(r InitiativeRollup) []byte {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Rollup for %s\n\n", r.Name))
+
+	subs := len(r.Rows) - 1
+	t := r.Total
+	sb.WriteString(fmt.Sprintf("Across this initiative and %d sub-initiative%s: %d project%s (%d completed), %d issue%s (%d completed, %d canceled), progress %s.\n\n",
+		subs, plural(subs), t.Projects, plural(t.Projects), t.ProjectsCompleted,
+		t.Issues, plural(t.Issues), t.IssuesCompleted, t.IssuesCanceled, rollupProgress(t)))
+
+	sb.WriteString("| Initiative | Status | Projects | Completed projects | Issues | Completed issues | Progress |\n")
+	sb.WriteString("|------------|--------|----------|--------------------|--------|------------------|----------|\n")
+	for _, row := range r.Rows {
+		name := tableCell(row.Name)
+		if row.Depth > 0 {
+			name = strings.Repeat("  ", row.Depth-1) + "↳ " + name
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d | %d | %d | %s |\n",
+			name, tableCell(row.Status), row.Projects, row.ProjectsCompleted,
+			row.Issues, row.IssuesCompleted, rollupProgress(row)))
+	}
+	return []byte(sb.String())
+}
+
+// rollupProgress is completed over non-canceled issues, "-" when there are none.
+func rollupProgress(row InitiativeRollupRow) string {
+	active := row.Issues - row.IssuesCanceled
+	if active <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", row.IssuesCompleted*100/active)
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
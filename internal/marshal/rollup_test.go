@@ -0,0 +1,31 @@
+package marshal
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInitiativeRollupToMarkdown(t *testing.T) {
+	t.Parallel()
+	out := string(InitiativeRollupToMarkdown(InitiativeRollup{
+		Name: "Platform",
+		Rows: []InitiativeRollupRow{
+			{Name: "Platform", Status: "Active", Projects: 1, Issues: 4, IssuesCompleted: 1, IssuesCanceled: 2},
+			{Name: "Auth | SSO", Status: "Planned", Depth: 1},
+			{Name: "SCIM", Depth: 2, Projects: 1, ProjectsCompleted: 1, Issues: 2, IssuesCompleted: 2},
+		},
+		Total: InitiativeRollupRow{Projects: 2, ProjectsCompleted: 1, Issues: 6, IssuesCompleted: 3, IssuesCanceled: 2},
+	}))
+
+	for _, want := range []string{
+		"# Rollup for Platform\n",
+		"Across this initiative and 2 sub-initiatives: 2 projects (1 completed), 6 issues (3 completed, 2 canceled), progress 75%.",
+		"| Platform | Active | 1 | 0 | 4 | 1 | 50% |",
+		`| ↳ Auth \| SSO | Planned | 0 | 0 | 0 | 0 | - |`,
+		"|   ↳ SCIM |  | 1 | 1 | 2 | 2 | 100% |",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rollup missing %q:\n%s", want, out)
+		}
+	}
+}
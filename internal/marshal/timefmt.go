@@ -0,0 +1,88 @@
+package marshal
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// TimeStyle is how rendered files show timestamps (config `display:`). The
+// zero value is the historical rendering: RFC 3339 in the timestamp's own
+// zone (UTC, as Linear sends it).
+type TimeStyle struct {
+	loc    *time.Location // nil = leave the zone as is
+	layout string         // "" = RFC 3339
+}
+
+// NewTimeStyle builds a TimeStyle from the config strings: an IANA timezone
+// name (or "Local"; "" keeps UTC) and a Go time layout for body text ("" keeps
+// RFC 3339). A layout with no time fields in it is rejected — it would print
+// the same constant for every timestamp.
+func NewTimeStyle(timezone, layout string) (TimeStyle, error) {
+	var s TimeStyle
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return TimeStyle{}, fmt.Errorf("display.timezone %q: %w", timezone, err)
+		}
+		s.loc = loc
+	}
+	if layout != "" {
+		a := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+		b := time.Date(2012, 11, 24, 16, 37, 48, 0, time.UTC)
+		if a.Format(layout) == b.Format(layout) {
+			return TimeStyle{}, fmt.Errorf("display.time_format %q: no date or time fields (use Go layout syntax, e.g. \"02.01.2006 15:04\")", layout)
+		}
+		s.layout = layout
+	}
+	return s, nil
+}
+
+func (s TimeStyle) in(t time.Time) time.Time {
+	if s.loc != nil {
+		return t.In(s.loc)
+	}
+	return t
+}
+
+// Machine formats a frontmatter timestamp: always RFC 3339 so scripts can
+// parse it, shifted into the configured zone.
+func (s TimeStyle) Machine(t time.Time) string {
+	return s.in(t).Format(time.RFC3339)
+}
+
+// Display formats a timestamp in a generated body (history.md, tables,
+// issue.pdf) with the configured layout and zone.
+func (s TimeStyle) Display(t time.Time) string {
+	if s.layout == "" {
+		return s.Machine(t)
+	}
+	return s.in(t).Format(s.layout)
+}
+
+// timeStyle is the process-wide style, set once at mount from config. An
+// atomic pointer, so tests building filesystems in parallel never race a read.
+var timeStyle atomic.Pointer[TimeStyle]
+
+// SetTimeStyle installs the process-wide TimeStyle every render uses.
+func SetTimeStyle(s TimeStyle) {
+	timeStyle.Store(&s)
+}
+
+func currentTimeStyle() TimeStyle {
+	if s := timeStyle.Load(); s != nil {
+		return *s
+	}
+	return TimeStyle{}
+}
+
+// FormatTimestamp renders a machine (frontmatter) timestamp in the current
+// TimeStyle.
+func FormatTimestamp(t time.Time) string {
+	return currentTimeStyle().Machine(t)
+}
+
+// FormatDisplayTime renders a body-text timestamp in the current TimeStyle.
+func FormatDisplayTime(t time.Time) string {
+	return currentTimeStyle().Display(t)
+}
@@ -0,0 +1,71 @@
+package marshal
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // hermetic zone lookups, whatever the host has installed
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestTimeStyle(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2026, 10, 17, 22, 30, 0, 0, time.UTC)
+
+	var zero TimeStyle
+	if got := zero.Machine(ts); got != "2026-10-17T22:30:00Z" {
+		t.Errorf("default Machine = %q", got)
+	}
+	if got := zero.Display(ts); got != "2026-10-17T22:30:00Z" {
+		t.Errorf("default Display = %q", got)
+	}
+
+	tokyo, err := NewTimeStyle("Asia/Tokyo", "02.01.2006 15:04")
+	if err != nil {
+		t.Fatalf("NewTimeStyle: %v", err)
+	}
+	// Machine fields stay RFC 3339 (still parseable), shifted to the zone.
+	if got := tokyo.Machine(ts); got != "2026-10-18T07:30:00+09:00" {
+		t.Errorf("Machine = %q", got)
+	}
+	if got := tokyo.Display(ts); got != "18.10.2026 07:30" {
+		t.Errorf("Display = %q", got)
+	}
+
+	for _, bad := range []struct{ tz, layout string }{
+		{"Mars/Olympus_Mons", ""},
+		{"", "no fields here"},
+	} {
+		if _, err := NewTimeStyle(bad.tz, bad.layout); err == nil {
+			t.Errorf("NewTimeStyle(%q, %q) accepted", bad.tz, bad.layout)
+		}
+	}
+}
+
+// TestSetTimeStyleReachesRenders is deliberately not parallel: it swaps the
+// process-wide style, which the parallel tests (run after it) rely on being
+// the default.
+func TestSetTimeStyleReachesRenders(t *testing.T) {
+	style, err := NewTimeStyle("Asia/Tokyo", "2006/01/02 15:04")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetTimeStyle(style)
+	t.Cleanup(func() { SetTimeStyle(TimeStyle{}) })
+
+	ts := time.Date(2026, 10, 17, 22, 30, 0, 0, time.UTC)
+	meta, err := IssueMetaToMarkdown(&api.Issue{ID: "i", Identifier: "ENG-1", CreatedAt: ts, UpdatedAt: ts}, ts, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(meta), `created: "2026-10-18T07:30:00+09:00"`) {
+		t.Errorf("issue.meta created not in the configured zone:\n%s", meta)
+	}
+	history := HistoryToMarkdown("ENG-1", []api.IssueHistoryEntry{{CreatedAt: ts, FromPriority: ptrInt(1), ToPriority: ptrInt(2)}})
+	if !strings.Contains(string(history), "2026/10/18 07:30") {
+		t.Errorf("history.md not in the configured layout:\n%s", history)
+	}
+}
+
+func ptrInt(i int) *int { return &i }
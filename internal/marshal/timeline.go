@@ -0,0 +1,141 @@
+package marshal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TimelineEntry is one bar or marker on a project's Gantt timeline. Kind is
+// "project" (the project's own start/target span), "milestone" (a marker at
+// its target date), or "issue" (created → due, with completion). Dates are
+// YYYY-MM-DD and empty when unknown.
+type TimelineEntry struct {
+	Kind      string `json:"kind"`
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Milestone string `json:"milestone,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Start     string `json:"start,omitempty"`
+	Due       string `json:"due,omitempty"`
+	Completed string `json:"completed,omitempty"`
+}
+
+// ProjectTimeline is the timeline.json document for one project.
+type ProjectTimeline struct {
+	Project string          `json:"project"`
+	Entries []TimelineEntry `json:"entries"`
+}
+
+// BuildProjectTimeline lays out a project's span, milestones (by target date,
+// then sort order), and issues (by start, then identifier). An issue's start
+// is its createdAt; Completed is its completedAt or, failing that, canceledAt.
+func BuildProjectTimeline(project api.Project, milestones []api.ProjectMilestone, issues []api.Issue) ProjectTimeline {
+	tl := ProjectTimeline{Project: project.Name, Entries: []TimelineEntry{}}
+
+	tl.Entries = append(tl.Entries, TimelineEntry{
+		Kind:   "project",
+		ID:     project.Slug,
+		Title:  project.Name,
+		Status: project.State,
+		Start:  dateOnly(project.StartDate),
+		Due:    dateOnly(project.TargetDate),
+	})
+
+	ms := append([]api.ProjectMilestone(nil), milestones...)
+	sort.SliceStable(ms, func(i, j int) bool {
+		di, dj := dateOnly(ms[i].TargetDate), dateOnly(ms[j].TargetDate)
+		if di != dj {
+			return di != "" && (dj == "" || di < dj)
+		}
+		return ms[i].SortOrder < ms[j].SortOrder
+	})
+	for _, m := range ms {
+		tl.Entries = append(tl.Entries, TimelineEntry{
+			Kind:  "milestone",
+			ID:    m.ID,
+			Title: m.Name,
+			Due:   dateOnly(m.TargetDate),
+		})
+	}
+
+	rows := make([]TimelineEntry, 0, len(issues))
+	for _, issue := range issues {
+		e := TimelineEntry{
+			Kind:   "issue",
+			ID:     issue.Identifier,
+			Title:  issue.Title,
+			Status: issue.State.Name,
+			Start:  timeDate(&issue.CreatedAt),
+			Due:    dateOnly(issue.DueDate),
+		}
+		if issue.ProjectMilestone != nil {
+			e.Milestone = issue.ProjectMilestone.Name
+		}
+		if issue.CompletedAt != nil {
+			e.Completed = timeDate(issue.CompletedAt)
+		} else if issue.CanceledAt != nil {
+			e.Completed = timeDate(issue.CanceledAt)
+		}
+		rows = append(rows, e)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Start != rows[j].Start {
+			return rows[i].Start < rows[j].Start
+		}
+		return rows[i].ID < rows[j].ID
+	})
+	tl.Entries = append(tl.Entries, rows...)
+	return tl
+}
+
+// timelineCSVHeader is timeline.csv's header row, in TimelineEntry field order.
+var timelineCSVHeader = []string{"kind", "id", "title", "milestone", "status", "start", "due", "completed"}
+
+// ProjectTimelineToCSV renders the timeline as RFC 4180 CSV with a header row.
+func ProjectTimelineToCSV(tl ProjectTimeline) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(timelineCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, e := range tl.Entries {
+		if err := w.Write([]string{e.Kind, e.ID, e.Title, e.Milestone, e.Status, e.Start, e.Due, e.Completed}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ProjectTimelineToJSON renders the timeline as indented JSON.
+func ProjectTimelineToJSON(tl ProjectTimeline) ([]byte, error) {
+	data, err := json.MarshalIndent(tl, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// dateOnly trims a Linear date/timestamp string to its YYYY-MM-DD prefix.
+func dateOnly(s *string) string {
+	if s == nil || len(*s) < len("2006-01-02") {
+		return ""
+	}
+	return (*s)[:len("2006-01-02")]
+}
+
+// timeDate formats a timestamp as a UTC YYYY-MM-DD date, empty when unset.
+func timeDate(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
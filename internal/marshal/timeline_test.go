@@ -0,0 +1,64 @@
+package marshal
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestBuildProjectTimeline pins row order (project, dated milestones before
+// undated, issues by start) and the date sources for each column.
+func TestBuildProjectTimeline(t *testing.T) {
+	t.Parallel()
+	str := func(s string) *string { return &s }
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 15, 0, 0, 0, time.UTC) }
+	done, canceled := day(9), day(12)
+
+	project := api.Project{Name: "Launch", Slug: "launch", State: "started", StartDate: str("2026-05-01"), TargetDate: str("2026-06-30")}
+	milestones := []api.ProjectMilestone{
+		{ID: "m-undated", Name: "Later", SortOrder: 1},
+		{ID: "m-beta", Name: "Beta", TargetDate: str("2026-06-01"), SortOrder: 2},
+	}
+	issues := []api.Issue{
+		{Identifier: "ENG-2", Title: "Second", CreatedAt: day(5), CanceledAt: &canceled},
+		{Identifier: "ENG-1", Title: "First, with comma", CreatedAt: day(3), DueDate: str("2026-05-20"),
+			CompletedAt: &done, State: api.State{Name: "Done"}, ProjectMilestone: &api.ProjectMilestone{Name: "Beta"}},
+	}
+
+	tl := BuildProjectTimeline(project, milestones, issues)
+	want := []TimelineEntry{
+		{Kind: "project", ID: "launch", Title: "Launch", Status: "started", Start: "2026-05-01", Due: "2026-06-30"},
+		{Kind: "milestone", ID: "m-beta", Title: "Beta", Due: "2026-06-01"},
+		{Kind: "milestone", ID: "m-undated", Title: "Later"},
+		{Kind: "issue", ID: "ENG-1", Title: "First, with comma", Milestone: "Beta", Status: "Done", Start: "2026-05-03", Due: "2026-05-20", Completed: "2026-05-09"},
+		{Kind: "issue", ID: "ENG-2", Title: "Second", Start: "2026-05-05", Completed: "2026-05-12"},
+	}
+	if !reflect.DeepEqual(tl.Entries, want) {
+		t.Fatalf("entries =\n%+v\nwant\n%+v", tl.Entries, want)
+	}
+
+	csvOut, err := ProjectTimelineToCSV(tl)
+	if err != nil {
+		t.Fatalf("ProjectTimelineToCSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(csvOut)), "\n")
+	if lines[0] != "kind,id,title,milestone,status,start,due,completed" {
+		t.Errorf("CSV header = %q", lines[0])
+	}
+	if want := `issue,ENG-1,"First, with comma",Beta,Done,2026-05-03,2026-05-20,2026-05-09`; lines[4] != want {
+		t.Errorf("CSV row = %q, want %q", lines[4], want)
+	}
+
+	jsonOut, err := ProjectTimelineToJSON(tl)
+	if err != nil {
+		t.Fatalf("ProjectTimelineToJSON: %v", err)
+	}
+	var back ProjectTimeline
+	if err := json.Unmarshal(jsonOut, &back); err != nil || !reflect.DeepEqual(back, tl) {
+		t.Errorf("JSON round-trip = %+v (err %v), want %+v", back, err, tl)
+	}
+}
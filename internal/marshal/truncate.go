@@ -0,0 +1,87 @@
+package marshal
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Truncated body views.
+//
+// A description or comment can run to megabytes — a pasted log — and a file
+// that size makes `cat` and editors crawl. A file shows such a body cut at
+// BodyViewLimit, at a line break where there is one, followed by a marker line
+// naming the read-only sibling that holds it whole. The marker is also what
+// lets a save of the cut view keep the rest: RestoreTruncatedBody splices the
+// hidden remainder back in, so editing above the marker edits the head and
+// leaves the remainder as it was. SQLite always stores the full body.
+
+// BodyViewLimit is the largest body, in bytes, a file shows whole.
+const BodyViewLimit = 256 << 10
+
+// truncationMarker starts the marker line TruncatedView appends.
+const truncationMarker = "<!-- linear-fuse: truncated"
+
+// TruncateBody returns the part of body a file shows: body itself when it fits
+// in BodyViewLimit, else its head and truncated = true. The head ends at the
+// last line break within the limit, or for one long line at a rune boundary.
+func TruncateBody(body string) (head string, truncated bool) {
+	if len(body) <= BodyViewLimit {
+		return body, false
+	}
+	return body[:truncationCut(body)], true
+}
+
+// truncationCut is where TruncateBody cuts a body over the limit.
+func truncationCut(body string) int {
+	if i := strings.LastIndexByte(body[:BodyViewLimit], '\n'); i >= 0 {
+		return i + 1
+	}
+	cut := BodyViewLimit
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+	if cut == 0 { // not UTF-8 at all; any byte will do
+		cut = BodyViewLimit
+	}
+	return cut
+}
+
+// TruncatedView is the body a file shows for body when TruncateBody cut it:
+// head (the cut head, cross-linked or not) and then the marker line, which
+// names full, the sibling file holding the whole body.
+func TruncatedView(head, body, full string) string {
+	if !strings.HasSuffix(head, "\n") {
+		head += "\n"
+	}
+	hidden := len(body) - truncationCut(body)
+	return fmt.Sprintf("%s%s, %d KiB more in %s; edit above this line, the rest is kept on save -->\n",
+		head, truncationMarker, (hidden+1023)/1024, full)
+}
+
+// RestoreTruncatedBody is the save side of TruncatedView. Given the body a
+// writer saved and the full original, it returns the body to store. If
+// original was cut and the saved body still has the marker line, the text
+// above the marker replaces the head and the hidden remainder follows it.
+// Text below the marker is appended after the remainder. Otherwise edited is
+// returned as is, so a writer who deletes the marker saves exactly what they
+// wrote.
+func RestoreTruncatedBody(edited, original string) string {
+	if len(original) <= BodyViewLimit {
+		return edited
+	}
+	i := strings.LastIndex(edited, "\n"+truncationMarker)
+	if i < 0 {
+		return edited
+	}
+	above, marker := edited[:i+1], edited[i+1:]
+	var below string
+	if j := strings.IndexByte(marker, '\n'); j >= 0 {
+		below = marker[j+1:]
+	}
+	cut := truncationCut(original)
+	if original[cut-1] != '\n' { // TruncatedView broke the line for the marker
+		above = strings.TrimSuffix(above, "\n")
+	}
+	return above + original[cut:] + below
+}
@@ -0,0 +1,105 @@
+package marshal
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// bigLog is a body over BodyViewLimit made of short lines.
+func bigLog() string {
+	var b strings.Builder
+	for i := 0; b.Len() <= BodyViewLimit+4096; i++ {
+		b.WriteString("2026-10-17T09:00:00Z INFO request served in 12ms\n")
+	}
+	return b.String()
+}
+
+func TestTruncateBody(t *testing.T) {
+	t.Parallel()
+	if head, cut := TruncateBody("short\n"); cut || head != "short\n" {
+		t.Errorf("short body = %q, %v; want it whole", head, cut)
+	}
+
+	body := bigLog()
+	head, cut := TruncateBody(body)
+	if !cut || len(head) > BodyViewLimit || !strings.HasSuffix(head, "\n") || !strings.HasPrefix(body, head) {
+		t.Errorf("log cut = %v, len %d, want a line-aligned prefix within the limit", cut, len(head))
+	}
+
+	// One long line is cut at a rune boundary, never inside "é".
+	line := strings.Repeat("é", BodyViewLimit)
+	head, _ = TruncateBody(line)
+	if !strings.HasPrefix(line, head) || len(head)%2 != 0 {
+		t.Errorf("long line cut at %d bytes, inside a rune", len(head))
+	}
+}
+
+// TestRestoreTruncatedBody: saving the cut view back keeps the hidden
+// remainder — unchanged, after an edit above the marker, and with text added
+// below it — while deleting the marker saves the file as written.
+func TestRestoreTruncatedBody(t *testing.T) {
+	t.Parallel()
+	for name, body := range map[string]string{
+		"lines":    bigLog(),
+		"one line": strings.Repeat("x", BodyViewLimit+10),
+	} {
+		head, _ := TruncateBody(body)
+		view := TruncatedView(head, body, "issue.full.md")
+		if !strings.Contains(view, "issue.full.md") {
+			t.Errorf("%s: marker does not name the full sibling", name)
+		}
+		if got := RestoreTruncatedBody(view, body); got != body {
+			t.Errorf("%s: unchanged view restored to %d bytes, want the %d-byte original", name, len(got), len(body))
+		}
+		if got := RestoreTruncatedBody("Summary\n"+view, body); got != "Summary\n"+body {
+			t.Errorf("%s: edit above the marker not kept with the remainder", name)
+		}
+		if got := RestoreTruncatedBody(view+"Follow-up\n", body); got != body+"Follow-up\n" {
+			t.Errorf("%s: text below the marker not appended after the remainder", name)
+		}
+		if got := RestoreTruncatedBody(head, body); got != head {
+			t.Errorf("%s: marker deleted, yet the remainder came back", name)
+		}
+	}
+
+	// Marker text in a body that was never cut is just text.
+	small := "see <!-- linear-fuse: truncated -->\n"
+	if got := RestoreTruncatedBody("\n"+small, small); got != "\n"+small {
+		t.Errorf("small body restored to %q", got)
+	}
+}
+
+// TestMarkdownToIssueUpdateTruncatedNoop: rewriting issue.md as rendered with
+// a cut description is a no-op, and a title edit does not touch the body.
+func TestMarkdownToIssueUpdateTruncatedNoop(t *testing.T) {
+	t.Parallel()
+	original := &api.Issue{Title: "Crash log", Description: bigLog()}
+	head, _ := TruncateBody(original.Description)
+	shown := *original
+	shown.Description = TruncatedView(head, original.Description, "issue.full.md")
+	content, err := IssueToMarkdown(&shown)
+	if err != nil {
+		t.Fatalf("IssueToMarkdown: %v", err)
+	}
+
+	update, err := MarkdownToIssueUpdate(content, original)
+	if err != nil {
+		t.Fatalf("MarkdownToIssueUpdate: %v", err)
+	}
+	if len(update) != 0 {
+		t.Errorf("no-op rewrite of a cut issue.md updated %v", slices.Sorted(maps.Keys(update)))
+	}
+
+	edited := strings.Replace(string(content), "title: Crash log", "title: Crash log (triaged)", 1)
+	update, err = MarkdownToIssueUpdate([]byte(edited), original)
+	if err != nil {
+		t.Fatalf("MarkdownToIssueUpdate: %v", err)
+	}
+	if _, ok := update["description"]; ok || update["title"] != "Crash log (triaged)" {
+		t.Errorf("title edit of a cut issue.md updated %v", slices.Sorted(maps.Keys(update)))
+	}
+}
@@ -0,0 +1,210 @@
+// Package pdf is a minimal, dependency-free PDF writer for the filesystem's
+// read-only exports (issue.pdf). It lays out plain text only — headings and
+// wrapped paragraphs on US Letter pages — in the built-in Courier fonts, so
+// no font is embedded and a fixed advance width makes wrapping exact. Text is
+// WinAnsi encoded (Latin-1 plus typographic punctuation); any other rune
+// renders as '?'.
+//
+// Output is deterministic (no creation date or random ID), so a file's size
+// and bytes change only when its content does.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page geometry, in points (1/72 inch).
+const (
+	pageWidth    = 612 // US Letter
+	pageHeight   = 792
+	margin       = 54
+	bodySize     = 10
+	headingSize  = 13
+	lineSpacing  = 1.35
+	courierWidth = 0.6 // advance width of every Courier glyph, in ems
+)
+
+// Document accumulates text blocks and renders them with Bytes.
+type Document struct {
+	blocks []block
+}
+
+type block struct {
+	text    string
+	heading bool
+}
+
+// Heading adds a bold heading line (wrapped if long).
+func (d *Document) Heading(text string) {
+	d.blocks = append(d.blocks, block{text: text, heading: true})
+}
+
+// Paragraph adds body text. Newlines are kept as line breaks and long lines
+// wrap at word boundaries; an empty string adds a blank line.
+func (d *Document) Paragraph(text string) {
+	d.blocks = append(d.blocks, block{text: text})
+}
+
+// line is one laid-out line of text.
+type line struct {
+	text    string
+	heading bool
+}
+
+// layout wraps every block into lines and splits them into pages.
+func (d *Document) layout() [][]line {
+	var lines []line
+	for _, b := range d.blocks {
+		size := bodySize
+		if b.heading {
+			size = headingSize
+			if len(lines) > 0 {
+				lines = append(lines, line{}) // space above a heading
+			}
+		}
+		cols := int((pageWidth - 2*margin) / (float64(size) * courierWidth))
+		for _, raw := range strings.Split(b.text, "\n") {
+			for _, w := range wrap(raw, cols) {
+				lines = append(lines, line{text: w, heading: b.heading})
+			}
+		}
+	}
+
+	var pages [][]line
+	var page []line
+	y := float64(pageHeight - margin)
+	for _, l := range lines {
+		h := lineHeight(l)
+		if y-h < margin && len(page) > 0 {
+			pages = append(pages, page)
+			page, y = nil, float64(pageHeight-margin)
+		}
+		page = append(page, l)
+		y -= h
+	}
+	return append(pages, page) // always at least one (possibly empty) page
+}
+
+func lineHeight(l line) float64 {
+	if l.heading {
+		return headingSize * lineSpacing
+	}
+	return bodySize * lineSpacing
+}
+
+// wrap breaks s into lines of at most cols runes, at spaces where possible
+// and mid-word only for a word longer than a whole line.
+func wrap(s string, cols int) []string {
+	s = strings.TrimRight(strings.ReplaceAll(s, "\t", "    "), " ")
+	if s == "" {
+		return []string{""}
+	}
+	var out []string
+	r := []rune(s)
+	for len(r) > cols {
+		cut := cols
+		for i := cols; i > 0; i-- {
+			if r[i] == ' ' {
+				cut = i
+				break
+			}
+		}
+		out = append(out, strings.TrimRight(string(r[:cut]), " "))
+		r = []rune(strings.TrimLeft(string(r[cut:]), " "))
+	}
+	return append(out, string(r))
+}
+
+// Bytes renders the document as a complete PDF file.
+func (d *Document) Bytes() []byte {
+	pages := d.layout()
+
+	// Object numbering: 1 catalog, 2 page tree, 3 body font, 4 heading font,
+	// then a (page, content stream) pair per page.
+	var objs []string
+	objs = append(objs, "<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	objs = append(objs, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	objs = append(objs, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	objs = append(objs, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range pages {
+		objs = append(objs, fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		stream := contentStream(page)
+		objs = append(objs, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(stream), stream))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objs))
+	for i, obj := range objs {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objs)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objs)+1, xref)
+	return buf.Bytes()
+}
+
+// contentStream draws one page's lines top-down from the top margin.
+func contentStream(page []line) string {
+	var b strings.Builder
+	y := float64(pageHeight - margin)
+	for _, l := range page {
+		y -= lineHeight(l)
+		if l.text == "" {
+			continue
+		}
+		font, size := "F1", bodySize
+		if l.heading {
+			font, size = "F2", headingSize
+		}
+		fmt.Fprintf(&b, "BT /%s %d Tf %d %.2f Td (%s) Tj ET\n", font, size, margin, y, escape(l.text))
+	}
+	return b.String()
+}
+
+// winAnsiExtra maps the punctuation WinAnsi places in 0x80-0x9F (where
+// Latin-1 has control codes) — the characters markdown prose actually uses.
+var winAnsiExtra = map[rune]byte{
+	'\u20ac': 0x80,                 // euro
+	'\u2026': 0x85,                 // ellipsis
+	'\u2018': 0x91, '\u2019': 0x92, // single quotes
+	'\u201c': 0x93, '\u201d': 0x94, // double quotes
+	'\u2022': 0x95,                 // bullet
+	'\u2013': 0x96, '\u2014': 0x97, // en and em dash
+}
+
+// escape encodes s as the body of a PDF literal string: WinAnsi bytes with
+// the delimiters and backslash escaped and control characters dropped.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || (r >= 0x7f && r < 0xa0):
+			// dropped
+		case r < 0x80:
+			b.WriteRune(r)
+		case r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		case winAnsiExtra[r] != 0:
+			fmt.Fprintf(&b, "\\%03o", winAnsiExtra[r])
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestBytesStructure checks the file is well-formed enough for any reader:
+// header, an xref whose offsets land on their objects, and a trailer whose
+// startxref points at the xref.
+func TestBytesStructure(t *testing.T) {
+	t.Parallel()
+	var d Document
+	d.Heading("ENG-1: Fix (the) bug")
+	d.Paragraph("Body text with a backslash \\ and café — ok.")
+	out := d.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("missing header/trailer:\n%s", out)
+	}
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if m == nil {
+		t.Fatal("no startxref")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(out[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref %d does not point at the xref table", xref)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	if len(entries) != 6 { // catalog, pages, 2 fonts, 1 page + stream
+		t.Fatalf("xref has %d objects, want 6", len(entries))
+	}
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		if want := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(out[off:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q", i+1, out[off:off+10])
+		}
+	}
+
+	for _, want := range []string{
+		`(ENG-1: Fix \(the\) bug) Tj`,
+		`backslash \\ and caf\351 \227 ok.`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("content stream missing %q", want)
+		}
+	}
+
+	// Deterministic: the same document renders the same bytes.
+	if again := d.Bytes(); !bytes.Equal(out, again) {
+		t.Error("Bytes is not deterministic")
+	}
+}
+
+func TestLayoutWrapsAndPaginates(t *testing.T) {
+	t.Parallel()
+	if got := wrap("aaa bbb ccc", 7); strings.Join(got, "|") != "aaa bbb|ccc" {
+		t.Errorf("wrap at spaces = %q", got)
+	}
+	if got := wrap("abcdefghij", 4); strings.Join(got, "|") != "abcd|efgh|ij" {
+		t.Errorf("wrap long word = %q", got)
+	}
+
+	var d Document
+	for i := 0; i < 200; i++ {
+		d.Paragraph(fmt.Sprintf("line %d", i))
+	}
+	pages := d.layout()
+	if len(pages) < 2 {
+		t.Fatalf("200 lines laid out on %d page(s), want several", len(pages))
+	}
+	if !strings.Contains(string(d.Bytes()), fmt.Sprintf("/Count %d", len(pages))) {
+		t.Error("page tree count does not match layout")
+	}
+}
@@ -71,7 +71,7 @@ func PersistIssueDetails(ctx context.Context, deps Deps, issueID string, details
 			return upsertErr
 		},
 		Prune: pruneWhenComplete(len(details.Comments) < api.IssueDetailsPageSize, func(ctx context.Context) error {
-			return deps.Q.PruneIssueComments(ctx, db.PruneIssueCommentsParams{IssueID: issueID, SyncedAt: cutoff})
+			return deps.Q.PruneIssueComments(ctx, db.PruneIssueCommentsParams{DeletedAt: sql.NullTime{Time: db.Now(), Valid: true}, IssueID: issueID, SyncedAt: cutoff})
 		}),
 	}) && clean
 
@@ -91,21 +91,27 @@ func PersistIssueDetails(ctx context.Context, deps Deps, issueID string, details
 		}),
 	}) && clean
 
-	clean = Collection(ctx, CollectionSpec[api.Attachment]{
-		Label: "attachment " + issueID,
-		Kind:  "attachment",
-		Items: details.Attachments,
-		Upsert: func(ctx context.Context, attachment api.Attachment) error {
-			params, err := db.APIAttachmentToDBAttachment(attachment, issueID)
-			if err != nil {
-				return err
-			}
-			return deps.Q.UpsertAttachment(ctx, params)
-		},
-		Prune: pruneWhenComplete(len(details.Attachments) < api.IssueDetailsPageSize, func(ctx context.Context) error {
-			return deps.Q.PruneIssueAttachments(ctx, db.PruneIssueAttachmentsParams{IssueID: issueID, SyncedAt: cutoff})
-		}),
-	}) && clean
+	// Attachments projected out of the fetch (api.lazy_issue_fields) were not
+	// asked for: the empty list proves nothing, so the cached rows stand
+	// untouched. The collection is skipped, not failed — clean still reports
+	// the four that were fetched, and the caller decides about the stamp.
+	if !details.AttachmentsOmitted {
+		clean = Collection(ctx, CollectionSpec[api.Attachment]{
+			Label: "attachment " + issueID,
+			Kind:  "attachment",
+			Items: details.Attachments,
+			Upsert: func(ctx context.Context, attachment api.Attachment) error {
+				params, err := db.APIAttachmentToDBAttachment(attachment, issueID)
+				if err != nil {
+					return err
+				}
+				return deps.Q.UpsertAttachment(ctx, params)
+			},
+			Prune: pruneWhenComplete(len(details.Attachments) < api.IssueDetailsPageSize, func(ctx context.Context) error {
+				return deps.Q.PruneIssueAttachments(ctx, db.PruneIssueAttachmentsParams{IssueID: issueID, SyncedAt: cutoff})
+			}),
+		}) && clean
+	}
 
 	// Relations: the outgoing rows this issue owns (issue_id = issueID).
 	// Before this, relations were persisted ONLY by the FUSE create
@@ -0,0 +1,68 @@
+package reconcile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// FuzzExtractEmbeddedFiles drives the embedded-file URL extraction with
+// hostile issue content (descriptions and comments are anyone's text). Every
+// spec it returns becomes an attachments/ file and a CDN HEAD request, so
+// beyond "never panic" it checks the shape each spec must have: one spec per
+// CDN URL, the URL free of whitespace and trailing punctuation, an ID derived
+// from that URL alone, and a non-empty filename and MIME type.
+func FuzzExtractEmbeddedFiles(f *testing.F) {
+	for _, s := range []string{
+		"",
+		"no urls here",
+		"![image.png](https://uploads.linear.app/a/b/image.png)",
+		"[spec.pdf](https://uploads.linear.app/a/b/spec.pdf) and https://uploads.linear.app/c/d/raw.png.",
+		"https://uploads.linear.app/",
+		"https://uploads.linear.app/a/b/c?x=1#frag",
+		"[](https://uploads.linear.app/a/b/empty-name.png)",
+		"[a]](https://uploads.linear.app/x)",
+		"<https://uploads.linear.app/a/b/angle.png>",
+		"https://uploads.linear.app/12345678-1234-1234-1234-123456789abc-screenshot.png",
+		"https://uploads.linear.app/a/b/trailing.,;:!?",
+		"`https://uploads.linear.app/a/b/code.png`",
+		"https://uploads.linear.app/a/b/日本語.png",
+		"https://evil.example/https://uploads.linear.app/a/b/x.png",
+	} {
+		f.Add(s)
+	}
+	const prefix = "https://uploads.linear.app/"
+	f.Fuzz(func(t *testing.T, content string) {
+		specs := extractEmbeddedFiles(content, "issue-1", "description")
+		if n := len(linearCDNPattern.FindAllString(content, -1)); len(specs) != n {
+			t.Fatalf("%d specs for %d CDN URLs in %q", len(specs), n, content)
+		}
+		for _, spec := range specs {
+			if !strings.HasPrefix(spec.URL, prefix) {
+				t.Fatalf("URL %q is not a Linear CDN URL (content %q)", spec.URL, content)
+			}
+			if strings.ContainsAny(spec.URL, " \t\n\r") {
+				t.Fatalf("URL %q holds whitespace", spec.URL)
+			}
+			if strings.TrimRight(spec.URL, ".,;:!?") != spec.URL {
+				t.Fatalf("URL %q keeps trailing punctuation", spec.URL)
+			}
+			hash := sha256.Sum256([]byte(spec.URL))
+			if spec.ID != hex.EncodeToString(hash[:16]) {
+				t.Fatalf("ID %q is not derived from URL %q", spec.ID, spec.URL)
+			}
+			if spec.IssueID != "issue-1" || spec.Source != "description" {
+				t.Fatalf("spec lost its issue/source: %+v", spec)
+			}
+			// The filename may be link text (slashes included — safeName
+			// sanitises it at the FUSE boundary), but never empty.
+			if spec.Filename == "" || spec.MimeType == "" {
+				t.Fatalf("empty filename or MIME type: %+v", spec)
+			}
+		}
+		if extractFilename(content) == "" {
+			t.Fatalf("extractFilename(%q) is empty", content)
+		}
+	})
+}
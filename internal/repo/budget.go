@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// budgetHoursRetention bounds api_budget_hours: a week of hourly spend is
+// enough to see a pattern, and the table never grows past ~170 rows.
+const budgetHoursRetention = 7 * 24 * time.Hour
+
+// RestoreAPIBudget seeds the client's rate budget from the windows the last
+// run persisted (api/budgetstate.go), so the priority ladder governs the
+// startup burst instead of spending blind until the first response. Call it
+// before the sync worker starts. A no-op without a client.
+func (r *SQLiteRepository) RestoreAPIBudget(ctx context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+	rows, err := r.store.Queries().ListAPIBudgetWindows(ctx)
+	if err != nil {
+		return fmt.Errorf("list budget windows: %w", err)
+	}
+	windows := make([]api.BudgetWindow, len(rows))
+	for i, row := range rows {
+		windows[i] = api.BudgetWindow{Axis: row.Axis, Limit: row.LimitValue, Remaining: row.Remaining}
+		if row.ResetAt.Valid {
+			windows[i].ResetAt = row.ResetAt.Time
+		}
+	}
+	r.client.RestoreBudget(windows)
+	return nil
+}
+
+// PersistAPIBudget writes the client's current budget windows and the spend
+// recorded since the last call, and prunes spend older than the retention.
+// Spend taken from the client is gone from it: if a write fails those hours
+// are under-counted, which only affects the status report — the windows (what
+// RestoreAPIBudget reads) are rewritten in full every call.
+func (r *SQLiteRepository) PersistAPIBudget(ctx context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+	now := db.Now()
+	windows := r.client.BudgetWindows()
+	spend := r.client.TakeBudgetSpend()
+	q := r.store.Queries()
+	for _, w := range windows {
+		if err := q.UpsertAPIBudgetWindow(ctx, db.UpsertAPIBudgetWindowParams{
+			Axis:       w.Axis,
+			LimitValue: w.Limit,
+			Remaining:  w.Remaining,
+			ResetAt:    db.ToNullTime(w.ResetAt.UTC()),
+			ObservedAt: now,
+		}); err != nil {
+			return fmt.Errorf("upsert budget window %s: %w", w.Axis, err)
+		}
+	}
+	for _, h := range spend {
+		if err := q.AddAPIBudgetHour(ctx, db.AddAPIBudgetHourParams{
+			Hour:       h.Hour,
+			Requests:   h.Requests,
+			Complexity: h.Complexity,
+		}); err != nil {
+			return fmt.Errorf("add budget hour: %w", err)
+		}
+	}
+	if err := q.DeleteAPIBudgetHoursBefore(ctx, now.Add(-budgetHoursRetention).Truncate(time.Hour)); err != nil {
+		return fmt.Errorf("prune budget hours: %w", err)
+	}
+	return nil
+}
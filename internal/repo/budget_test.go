@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestAPIBudget_PersistRestore: windows persisted by one client seed a fresh
+// client (the restarted daemon) through the same store.
+func TestAPIBudget_PersistRestore(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	resetAt := time.Now().Add(20 * time.Minute).UTC().Truncate(time.Second)
+	first := api.NewClient("test-key")
+	first.RestoreBudget([]api.BudgetWindow{
+		{Axis: "requests", Limit: 1500, Remaining: 120, ResetAt: resetAt},
+	})
+	if err := NewSQLiteRepository(store, first).PersistAPIBudget(ctx); err != nil {
+		t.Fatalf("PersistAPIBudget: %v", err)
+	}
+
+	second := api.NewClient("test-key")
+	if err := NewSQLiteRepository(store, second).RestoreAPIBudget(ctx); err != nil {
+		t.Fatalf("RestoreAPIBudget: %v", err)
+	}
+	got := second.BudgetWindows()
+	if len(got) != 1 {
+		t.Fatalf("restored windows = %+v, want 1", got)
+	}
+	if got[0].Axis != "requests" || got[0].Limit != 1500 || got[0].Remaining != 120 || !got[0].ResetAt.Equal(resetAt) {
+		t.Errorf("restored window = %+v", got[0])
+	}
+}
+
+// TestAPIBudget_NilClient: offline (no client) both calls are no-ops.
+func TestAPIBudget_NilClient(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	if err := repo.PersistAPIBudget(context.Background()); err != nil {
+		t.Errorf("PersistAPIBudget: %v", err)
+	}
+	if err := repo.RestoreAPIBudget(context.Background()); err != nil {
+		t.Errorf("RestoreAPIBudget: %v", err)
+	}
+}
@@ -34,14 +34,14 @@ func newSWRMetrics() swrMetrics {
 	m := otel.Meter("linearfs/swr")
 	return swrMetrics{
 		triggers: telemetry.MustInt64Counter(m, "linearfs.swr.triggers",
-			metric.WithDescription("SWR staleness verdicts, by kind and decision (triggered|fresh|deduped|sem_dropped)")),
+			metric.WithDescription("SWR staleness verdicts, by kind and decision (triggered|fresh|deduped|queue_full)")),
 		refreshOutcomes: telemetry.MustInt64Counter(m, "linearfs.swr.refresh_outcomes",
 			metric.WithDescription("Completed background refreshes, by kind and outcome (ok|error|orphaned)")),
 	}
 }
 
 // recordTrigger counts one staleness verdict. fresh means swrStale said no;
-// triggered/deduped/sem_dropped are triggerBackgroundRefresh's three exits.
+// triggered/deduped/queue_full are triggerBackgroundRefresh's three exits.
 // The nil-client (fixture-mode) returns record nothing — there is no SWR
 // machinery to observe.
 func (m swrMetrics) recordTrigger(kind refreshKind, decision string) {
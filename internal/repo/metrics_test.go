@@ -58,9 +58,10 @@ func newMetricsTestRepo(t *testing.T) *SQLiteRepository {
 		refreshing:         make(map[string]bool),
 		refreshContext:     ctx,
 		refreshCancel:      cancel,
-		refreshSem:         make(chan struct{}, maxConcurrentRefreshes),
+		refreshQueue:       make(chan refreshJob, refreshQueueSize),
 		metrics:            newSWRMetrics(),
 	}
+	r.startRefreshWorkers()
 	t.Cleanup(r.Close)
 	return r
 }
@@ -121,7 +122,7 @@ func staleSpec(kind refreshKind, id string, refresh func(context.Context) error)
 }
 
 // TestSWRTriggersCounter drives all four decisions: fresh (swrStale says no),
-// sem_dropped (semaphore full), triggered, and deduped (same key in flight).
+// queue_full (no room to queue), triggered, and deduped (same key in flight).
 func TestSWRTriggersCounter(t *testing.T) {
 	reader := withTestMeter(t)
 	r := newMetricsTestRepo(t)
@@ -137,17 +138,15 @@ func TestSWRTriggersCounter(t *testing.T) {
 		},
 	})
 
-	// sem_dropped: fill the semaphore, then a stale spec is dropped.
-	for i := 0; i < maxConcurrentRefreshes; i++ {
-		r.refreshSem <- struct{}{}
-	}
+	// queue_full: with a queue nothing reads (the workers hold the real
+	// one), a stale spec is dropped.
+	queue := r.refreshQueue
+	r.refreshQueue = make(chan refreshJob)
 	r.maybeRefreshSWR(staleSpec(kindHistory, "h-dropped", func(context.Context) error {
-		t.Error("sem-dropped spec fired a refresh")
+		t.Error("dropped spec fired a refresh")
 		return nil
 	}))
-	for i := 0; i < maxConcurrentRefreshes; i++ {
-		<-r.refreshSem
-	}
+	r.refreshQueue = queue
 
 	// triggered, then deduped while the first is still in flight.
 	started := make(chan struct{})
@@ -170,7 +169,7 @@ func TestSWRTriggersCounter(t *testing.T) {
 		want     int64
 	}{
 		{kindProjectDocs, "fresh", 1},
-		{kindHistory, "sem_dropped", 1},
+		{kindHistory, "queue_full", 1},
 		{kindHistory, "triggered", 1},
 		{kindHistory, "deduped", 1},
 	} {
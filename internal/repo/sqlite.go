@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"slices"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -15,9 +17,10 @@ import (
 	"github.com/jra3/linear-fuse/internal/reconcile"
 )
 
-// Default staleness threshold for on-demand data (comments, documents, updates).
+// Default staleness threshold for on-demand data (documents, updates, links).
 // Set to 5 minutes (2.5× the 2-minute sync interval) so genuinely missed syncs
 // get caught by user access without causing redundant refreshes on every read.
+// Documents and updates can each override it (SetStaleness).
 const defaultStalenessThreshold = 5 * time.Minute
 
 // reconcileCooldown is the minimum gap between proactive reconciliation
@@ -41,11 +44,16 @@ const reconcileCooldown = 6 * time.Hour
 // For on-demand data (comments, documents, updates), it implements
 // stale-while-revalidate: returns cached data immediately and triggers
 // a background refresh if the data is stale.
-// maxConcurrentRefreshes limits how many background refresh goroutines can
-// be in-flight at once. When the limit is reached, new refresh requests are
-// silently dropped — callers already have cached data to return.
+// maxConcurrentRefreshes is the size of the background refresh worker pool:
+// at most this many refreshes (and so API calls) are in flight at once, however
+// many stale reads a recursive grep makes.
 const maxConcurrentRefreshes = 10
 
+// refreshQueueSize bounds the refreshes waiting for a worker. When the queue is
+// full, new refresh requests are dropped — callers already have cached data to
+// return, and the next read of a still-stale entity asks again.
+const refreshQueueSize = 256
+
 // refreshTimeout caps how long a background refresh can block waiting for
 // a rate limiter token. Prevents indefinite blocking during budget exhaustion.
 const refreshTimeout = 30 * time.Second
@@ -55,6 +63,10 @@ type SQLiteRepository struct {
 	client             *api.Client   // Optional: for fallback/on-demand fetch
 	currentUser        *api.User     // Cached current user
 	stalenessThreshold time.Duration // How long before data is considered stale
+	staleness          Staleness     // per-family overrides (SetStaleness)
+	catchUp            bool          // catch-up mode is on (SetCatchUpMode)
+	teams              []string      // team keys GetTeams returns; empty = all (SetTeamFilter)
+	excludeTeams       []string      // team keys GetTeams leaves out (SetTeamFilter)
 
 	// extractor owns embedded-file extraction (HEAD + upsert) for the SWR
 	// issue-details path. Nil in fixture mode (no client) — Deps.Extract nil
@@ -67,8 +79,8 @@ type SQLiteRepository struct {
 	refreshContext context.Context
 	refreshCancel  context.CancelFunc
 
-	// Semaphore to limit concurrent background refreshes
-	refreshSem chan struct{}
+	// Queue feeding the maxConcurrentRefreshes workers (startRefreshWorkers)
+	refreshQueue chan refreshJob
 
 	// SWR-layer instruments, bound at construction (zero value = no-op).
 	metrics swrMetrics
@@ -91,10 +103,11 @@ func NewSQLiteRepository(store *db.Store, client *api.Client) *SQLiteRepository
 		refreshing:         make(map[string]bool),
 		refreshContext:     ctx,
 		refreshCancel:      cancel,
-		refreshSem:         make(chan struct{}, maxConcurrentRefreshes),
+		refreshQueue:       make(chan refreshJob, refreshQueueSize),
 		metrics:            newSWRMetrics(),
 	}
 	if client != nil {
+		r.startRefreshWorkers()
 		r.extractor = &reconcile.Extractor{Q: store.Queries(), CDN: api.NewCDNClient(client.AuthHeader)}
 	}
 	return r
@@ -107,6 +120,7 @@ const catchUpStaleness = 30 * time.Minute
 // SetCatchUpMode toggles between normal (5min) and catch-up (30min) staleness thresholds.
 // Called by the sync worker when it detects a large batch of changed issues.
 func (r *SQLiteRepository) SetCatchUpMode(active bool) {
+	r.catchUp = active
 	if active {
 		r.stalenessThreshold = catchUpStaleness
 		log.Printf("[repo] catch-up mode enabled: staleness threshold increased to %s", catchUpStaleness)
@@ -116,19 +130,69 @@ func (r *SQLiteRepository) SetCatchUpMode(active bool) {
 	}
 }
 
+// SetStaleness sets the per-family SWR thresholds; see Staleness.
+func (r *SQLiteRepository) SetStaleness(s Staleness) {
+	r.staleness = s
+}
+
 // Close stops any background refresh operations
 func (r *SQLiteRepository) Close() {
 	r.refreshCancel()
 }
 
-// triggerBackgroundRefresh starts a background refresh if not already in progress.
-// Uses a semaphore to limit concurrency — if too many refreshes are in-flight,
-// new requests are dropped. This prevents stampedes after connectivity loss.
-//
-// It takes the refreshKind (not a pre-built key) so its three exits — the
-// round-18 leak surface — record linearfs.swr.triggers with the bounded kind
-// attribute; the dedup key is still minted only by refreshKind.key. The
-// nil-client return records nothing.
+// refreshJob is one queued background refresh: its dedup key (with the kind
+// it was minted from, for metrics) and the refresh itself.
+type refreshJob struct {
+	kind refreshKind
+	key  string
+	fn   func(context.Context) error
+}
+
+// startRefreshWorkers starts the pool that runs queued refreshes. The workers
+// live until Close cancels refreshContext; without a client nothing is ever
+// queued, so NewSQLiteRepository starts none.
+func (r *SQLiteRepository) startRefreshWorkers() {
+	for range maxConcurrentRefreshes {
+		go r.refreshWorker(r.refreshQueue)
+	}
+}
+
+func (r *SQLiteRepository) refreshWorker(queue <-chan refreshJob) {
+	for {
+		select {
+		case <-r.refreshContext.Done():
+			return
+		case job := <-queue:
+			r.runRefresh(job)
+		}
+	}
+}
+
+// runRefresh runs one refresh under refreshTimeout, counted from when a worker
+// picks it up rather than from when it was queued, and releases its dedup key.
+func (r *SQLiteRepository) runRefresh(job refreshJob) {
+	defer func() {
+		r.refreshMu.Lock()
+		delete(r.refreshing, job.key)
+		r.refreshMu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(r.refreshContext, refreshTimeout)
+	defer cancel()
+	err := job.fn(ctx)
+	r.metrics.recordRefreshOutcome(job.kind, err)
+	if err != nil {
+		if r.refreshContext.Err() == nil && ctx.Err() == nil {
+			log.Printf("[repo] background refresh %s failed: %v", job.key, err)
+		}
+	}
+}
+
+// triggerBackgroundRefresh queues a background refresh if one for the same key
+// isn't already queued or running. The worker pool bounds how many run at
+// once; when the queue is full the request is dropped. This keeps a stampede
+// after connectivity loss (or a grep over a stale tree) to a fixed number of
+// goroutines and in-flight API calls.
 func (r *SQLiteRepository) triggerBackgroundRefresh(kind refreshKind, id string, refreshFn func(context.Context) error) {
 	if r.client == nil {
 		return
@@ -144,37 +208,17 @@ func (r *SQLiteRepository) triggerBackgroundRefresh(kind refreshKind, id string,
 	r.refreshing[key] = true
 	r.refreshMu.Unlock()
 
-	// Try to acquire semaphore without blocking. If full, drop this refresh —
-	// the caller already has cached data to return.
+	// Queue without blocking. If full, drop this refresh — the caller already
+	// has cached data to return.
 	select {
-	case r.refreshSem <- struct{}{}:
+	case r.refreshQueue <- refreshJob{kind: kind, key: key, fn: refreshFn}:
+		r.metrics.recordTrigger(kind, "triggered")
 	default:
 		r.refreshMu.Lock()
 		delete(r.refreshing, key)
 		r.refreshMu.Unlock()
-		r.metrics.recordTrigger(kind, "sem_dropped")
-		return
+		r.metrics.recordTrigger(kind, "queue_full")
 	}
-
-	r.metrics.recordTrigger(kind, "triggered")
-	go func() {
-		defer func() {
-			<-r.refreshSem
-			r.refreshMu.Lock()
-			delete(r.refreshing, key)
-			r.refreshMu.Unlock()
-		}()
-
-		ctx, cancel := context.WithTimeout(r.refreshContext, refreshTimeout)
-		defer cancel()
-		err := refreshFn(ctx)
-		r.metrics.recordRefreshOutcome(kind, err)
-		if err != nil {
-			if r.refreshContext.Err() == nil && ctx.Err() == nil {
-				log.Printf("[repo] background refresh %s failed: %v", key, err)
-			}
-		}
-	}()
 }
 
 // maybeScheduleReconcile fires a proactive reconciliation pass if no pass
@@ -412,9 +456,22 @@ func (r *SQLiteRepository) GetTeams(ctx context.Context) ([]api.Team, error) {
 	if err != nil {
 		return nil, fmt.Errorf("list teams: %w", err)
 	}
+	if len(r.teams) > 0 || len(r.excludeTeams) > 0 {
+		teams = slices.DeleteFunc(teams, func(t db.Team) bool {
+			return len(r.teams) > 0 && !slices.Contains(r.teams, t.Key) || slices.Contains(r.excludeTeams, t.Key)
+		})
+	}
 	return db.DBTeamsToAPITeams(teams), nil
 }
 
+// SetTeamFilter limits GetTeams to the given team keys less the excluded
+// ones (the `teams:` and `exclude_teams:` config), hiding teams a cache
+// shared with an unfiltered mount still holds. Call before serving; empty
+// lists lift the filter.
+func (r *SQLiteRepository) SetTeamFilter(keys, exclude []string) {
+	r.teams, r.excludeTeams = keys, exclude
+}
+
 // =============================================================================
 // Issues
 // =============================================================================
@@ -439,6 +496,136 @@ func (r *SQLiteRepository) GetIssueByID(ctx context.Context, id string) (*api.Is
 		db.DBIssueToAPIIssue)
 }
 
+// GetTeamArchivedIssues returns the team's archived issues, most recently
+// archived first. Reads SQLite only: the full sync keeps archived_issues.
+func (r *SQLiteRepository) GetTeamArchivedIssues(ctx context.Context, teamID string) ([]api.Issue, error) {
+	rows, err := r.store.Queries().ListTeamArchivedIssues(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("list team archived issues: %w", err)
+	}
+	issues := make([]api.Issue, 0, len(rows))
+	for _, row := range rows {
+		issue, err := db.DBArchivedIssueToAPIIssue(row)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+// GetArchivedIssueByIdentifier returns an archived issue, nil if none has
+// the identifier.
+func (r *SQLiteRepository) GetArchivedIssueByIdentifier(ctx context.Context, identifier string) (*api.Issue, error) {
+	return queryOne("get archived issue by identifier",
+		func() (db.ArchivedIssue, error) {
+			return r.store.Queries().GetArchivedIssueByIdentifier(ctx, identifier)
+		},
+		db.DBArchivedIssueToAPIIssue)
+}
+
+// CompleteIssueFields fetches the full issue when its row came from a
+// projected bulk sync (api.lazy_issue_fields) — its description and labels
+// may be stale or absent — upserts it, and clears the mark. It returns nil
+// with no error when the row is already complete, and never fetches without a
+// client. Unlike the SWR paths this runs in the caller's request: issue.md
+// renders from what it returns, so a background refresh would serve the
+// blank first.
+//
+// A fetch Linear refuses this token (api.IsForbidden) is not an error: the
+// cached row is marked restricted and returned, and the mark cleared — the
+// fields will not arrive by asking again on every open.
+func (r *SQLiteRepository) CompleteIssueFields(ctx context.Context, issueID string) (*api.Issue, error) {
+	if r.client == nil {
+		return nil, nil
+	}
+	q := r.store.Queries()
+	if n, err := q.HasPendingIssueFields(ctx, issueID); err != nil || n == 0 {
+		return nil, err
+	}
+	return r.fetchIssue(ctx, issueID)
+}
+
+// RevalidateIssue fetches the issue from Linear in the caller's request and
+// upserts it, whatever the cached row holds: the always-revalidate-on-open
+// path for issue.md. Like CompleteIssueFields it returns nil with no error
+// without a client, and a refused fetch marks the row restricted.
+func (r *SQLiteRepository) RevalidateIssue(ctx context.Context, issueID string) (*api.Issue, error) {
+	if r.client == nil {
+		return nil, nil
+	}
+	return r.fetchIssue(ctx, issueID)
+}
+
+// fetchIssue is the fetch-and-persist tail CompleteIssueFields and
+// RevalidateIssue share. It clears any lazy-field mark: the fetched row is
+// complete.
+func (r *SQLiteRepository) fetchIssue(ctx context.Context, issueID string) (*api.Issue, error) {
+	q := r.store.Queries()
+	issue, err := r.client.GetIssue(ctx, issueID)
+	if api.IsForbidden(err) {
+		restricted, markErr := r.markIssueRestricted(ctx, issueID, true)
+		if markErr != nil {
+			return nil, fmt.Errorf("mark issue restricted: %w", markErr)
+		}
+		if err := q.DeletePendingIssueFields(ctx, issueID); err != nil {
+			log.Printf("[repo] clear pending fields %s: %v", restricted.Identifier, err)
+		}
+		return restricted, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetch full issue: %w", err)
+	}
+	data, err := db.APIIssueToDBIssue(*issue)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+		return nil, fmt.Errorf("upsert full issue: %w", err)
+	}
+	if r.extractor != nil && issue.Description != "" {
+		r.extractor.ExtractAndStore(ctx, issue.ID, issue.Description, "description")
+	}
+	if err := r.store.RecordDescriptionVersion(ctx, issue.ID, issue.Description, issue.UpdatedAt); err != nil {
+		log.Printf("[repo] record description version %s: %v", issue.Identifier, err)
+	}
+	if err := q.DeletePendingIssueFields(ctx, issueID); err != nil {
+		log.Printf("[repo] clear pending fields %s: %v", issue.Identifier, err)
+	}
+	return issue, nil
+}
+
+// markIssueRestricted records whether Linear lets this token read the issue
+// in full (api.Issue.Restricted) and returns the cached issue as marked. The
+// row is rewritten only when the mark changes; a successful fetch of the
+// issue itself writes it clear on its own.
+func (r *SQLiteRepository) markIssueRestricted(ctx context.Context, issueID string, restricted bool) (*api.Issue, error) {
+	q := r.store.Queries()
+	row, err := q.GetIssueByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := db.DBIssueToAPIIssue(row)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Restricted == restricted {
+		return &issue, nil
+	}
+	issue.Restricted = restricted
+	data, err := db.APIIssueToDBIssue(issue)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+		return nil, err
+	}
+	if restricted {
+		log.Printf("[repo] issue %s is restricted: Linear refused this token its full fields", issue.Identifier)
+	}
+	return &issue, nil
+}
+
 func (r *SQLiteRepository) GetIssueChildren(ctx context.Context, parentID string) ([]api.Issue, error) {
 	issues, err := r.store.Queries().ListTeamIssuesByParent(ctx, sql.NullString{String: parentID, Valid: true})
 	if err != nil {
@@ -474,26 +661,54 @@ func (r *SQLiteRepository) GetIssuesByAssignee(ctx context.Context, teamID, assi
 }
 
 func (r *SQLiteRepository) GetIssuesByLabel(ctx context.Context, teamID, labelID string) ([]api.Issue, error) {
-	// Get label name first
-	label, err := r.store.Queries().GetLabel(ctx, labelID)
+	// Use the store's JSON-based label query. It matches the label's ID, not
+	// its name: two labels can share a name.
+	issues, err := r.store.ListIssuesByLabelID(ctx, teamID, labelID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return []api.Issue{}, nil
-		}
-		return nil, fmt.Errorf("get label: %w", err)
+		return nil, fmt.Errorf("list issues by label: %w", err)
 	}
+	return db.DBIssuesToAPIIssues(issues)
+}
 
-	// Use the store's JSON-based label query
-	issues, err := r.store.ListIssuesByLabel(ctx, teamID, label.Name)
+// GetIssuesByPriority returns a team's issues at one numeric priority
+// (0 none, 1 urgent … 4 low) — the backing query for by/priority/{bucket}/.
+func (r *SQLiteRepository) GetIssuesByPriority(ctx context.Context, teamID string, priority int) ([]api.Issue, error) {
+	issues, err := r.store.Queries().ListTeamIssuesByPriority(ctx, db.ListTeamIssuesByPriorityParams{
+		TeamID:   teamID,
+		Priority: sql.NullInt64{Int64: int64(priority), Valid: true},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("list issues by label: %w", err)
+		return nil, fmt.Errorf("list issues by priority: %w", err)
+	}
+	return db.DBIssuesToAPIIssues(issues)
+}
+
+// GetIssuesByCreator returns the team's issues filed by one user — the
+// backing query for by/creator/{email}/.
+func (r *SQLiteRepository) GetIssuesByCreator(ctx context.Context, teamID, creatorID string) ([]api.Issue, error) {
+	issues, err := r.store.Queries().ListTeamIssuesByCreator(ctx, db.ListTeamIssuesByCreatorParams{
+		TeamID:    teamID,
+		CreatorID: sql.NullString{String: creatorID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list issues by creator: %w", err)
 	}
 	return db.DBIssuesToAPIIssues(issues)
 }
 
-// NB: GetIssuesByPriority was deleted (round 19) — it had no production
-// caller (there is no by/priority/ view). Its sqlc query
-// (ListTeamIssuesByPriority) was removed in the round-20 dead-code prune.
+// GetTeamIssueCreators returns everyone who filed one of the team's synced
+// issues, by email. Only ID and Email are set: the issues row carries no more.
+func (r *SQLiteRepository) GetTeamIssueCreators(ctx context.Context, teamID string) ([]api.User, error) {
+	rows, err := r.store.Queries().ListTeamIssueCreators(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("list issue creators: %w", err)
+	}
+	users := make([]api.User, len(rows))
+	for i, row := range rows {
+		users[i] = api.User{ID: row.CreatorID.String, Email: row.CreatorEmail.String}
+	}
+	return users, nil
+}
 
 func (r *SQLiteRepository) GetUnassignedIssues(ctx context.Context, teamID string) ([]api.Issue, error) {
 	issues, err := r.store.Queries().ListTeamUnassignedIssues(ctx, teamID)
@@ -503,6 +718,26 @@ func (r *SQLiteRepository) GetUnassignedIssues(ctx context.Context, teamID strin
 	return db.DBIssuesToAPIIssues(issues)
 }
 
+// GetBlockedIssues returns the team's open issues that at least one open
+// issue blocks — the by/blocked/ view.
+func (r *SQLiteRepository) GetBlockedIssues(ctx context.Context, teamID string) ([]api.Issue, error) {
+	issues, err := r.store.Queries().ListTeamBlockedIssues(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("list blocked issues: %w", err)
+	}
+	return db.DBIssuesToAPIIssues(issues)
+}
+
+// GetIssueOpenBlockers returns the open issues blocking issueID. A blocker
+// not in the cache is not counted: its state is unknown.
+func (r *SQLiteRepository) GetIssueOpenBlockers(ctx context.Context, issueID string) ([]api.Issue, error) {
+	issues, err := r.store.Queries().ListIssueOpenBlockers(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("list issue blockers: %w", err)
+	}
+	return db.DBIssuesToAPIIssues(issues)
+}
+
 func (r *SQLiteRepository) GetIssuesByProject(ctx context.Context, projectID string) ([]api.Issue, error) {
 	issues, err := r.store.Queries().ListProjectIssues(ctx, sql.NullString{String: projectID, Valid: true})
 	if err != nil {
@@ -511,6 +746,19 @@ func (r *SQLiteRepository) GetIssuesByProject(ctx context.Context, projectID str
 	return db.DBIssuesToAPIIssues(issues)
 }
 
+// GetIssuesByMilestone returns a project's issues assigned to one of its
+// milestones.
+func (r *SQLiteRepository) GetIssuesByMilestone(ctx context.Context, projectID, milestoneID string) ([]api.Issue, error) {
+	issues, err := r.store.Queries().ListIssuesByMilestone(ctx, db.ListIssuesByMilestoneParams{
+		ProjectID:   sql.NullString{String: projectID, Valid: true},
+		MilestoneID: milestoneID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list issues by milestone: %w", err)
+	}
+	return db.DBIssuesToAPIIssues(issues)
+}
+
 func (r *SQLiteRepository) GetIssuesByCycle(ctx context.Context, cycleID string) ([]api.Issue, error) {
 	issues, err := r.store.Queries().ListCycleIssues(ctx, sql.NullString{String: cycleID, Valid: true})
 	if err != nil {
@@ -741,6 +989,17 @@ func (r *SQLiteRepository) GetIssueComments(ctx context.Context, issueID string)
 	return db.DBCommentsToAPIComments(comments)
 }
 
+// GetIssueCommentsWithDeleted is GetIssueComments plus tombstones (DeletedAt
+// set), for the comments/ listing (display.show_deleted_comments).
+func (r *SQLiteRepository) GetIssueCommentsWithDeleted(ctx context.Context, issueID string) ([]api.Comment, error) {
+	comments, err := r.store.Queries().ListIssueCommentsWithDeleted(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("list issue comments: %w", err)
+	}
+
+	return db.DBCommentsToAPIComments(comments)
+}
+
 // MaybeRefreshIssueDetails triggers a combined refresh of comments, documents,
 // and attachments for an issue if any of them are stale. Uses a single API call
 // via GetIssueDetails instead of three separate calls.
@@ -791,6 +1050,11 @@ func (r *SQLiteRepository) MaybeRefreshIssueDetails(issueID string) {
 			return r.refreshIssueDetails(ctx, issueID)
 		},
 		orphan: func(ctx context.Context) { r.deleteOrphanIssue(ctx, issueID) },
+		restrict: func(ctx context.Context) {
+			if _, err := r.markIssueRestricted(ctx, issueID, true); err != nil {
+				log.Printf("[repo] mark issue %s restricted: %v", issueID, err)
+			}
+		},
 	})
 }
 
@@ -818,9 +1082,15 @@ func (r *SQLiteRepository) deleteOrphanIssue(ctx context.Context, issueID string
 	if err := q.DeleteIssueHistoryCache(ctx, issueID); err != nil {
 		log.Printf("[repo] orphan cleanup: history for %s: %v", issueID, err)
 	}
+	if err := q.DeleteIssueDescriptionVersions(ctx, issueID); err != nil {
+		log.Printf("[repo] orphan cleanup: description versions for %s: %v", issueID, err)
+	}
 	if err := q.DeletePendingDetailSync(ctx, issueID); err != nil {
 		log.Printf("[repo] orphan cleanup: pending sync for %s: %v", issueID, err)
 	}
+	if err := q.DeletePendingIssueFields(ctx, issueID); err != nil {
+		log.Printf("[repo] orphan cleanup: pending fields for %s: %v", issueID, err)
+	}
 	if err := q.DeleteIssue(ctx, issueID); err != nil {
 		log.Printf("[repo] orphan cleanup: issue %s: %v", issueID, err)
 		return
@@ -918,6 +1188,11 @@ func (r *SQLiteRepository) refreshIssueDetails(ctx context.Context, issueID stri
 			log.Printf("[repo] stamp detail synced %s: %v", issueID, err)
 		}
 	}
+	// Details arriving means the token can read the issue after all (its
+	// team membership changed back).
+	if _, err := r.markIssueRestricted(ctx, issueID, false); err != nil {
+		log.Printf("[repo] clear issue %s restricted: %v", issueID, err)
+	}
 	return nil
 }
 
@@ -1074,6 +1349,80 @@ func (r *SQLiteRepository) refreshTeamDocuments(ctx context.Context, teamID stri
 	return nil
 }
 
+// standaloneDocsScheduleKey is the sync_schedule stamp of the last clean
+// GetAllDocuments drain. Standalone documents have no owner row to hang a
+// MAX(synced_at) on, and an empty set would read as never synced.
+const standaloneDocsScheduleKey = "standalone_documents"
+
+// GetStandaloneDocuments returns the documents no issue, team, project, or
+// initiative owns (the root docs/), refreshing them in the background when
+// the last drain is stale.
+func (r *SQLiteRepository) GetStandaloneDocuments(ctx context.Context) ([]api.Document, error) {
+	docs, err := r.store.Queries().ListStandaloneDocuments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list standalone documents: %w", err)
+	}
+
+	r.maybeRefreshSWR(swrSpec{
+		kind: kindStandaloneDocs,
+		id:   "workspace",
+		syncedAt: func() (interface{}, error) {
+			return r.store.Queries().GetSyncSchedule(context.Background(), standaloneDocsScheduleKey)
+		},
+		refresh: r.refreshStandaloneDocuments,
+		// No orphan handler: the workspace is not an entity that can vanish.
+	})
+
+	return db.DBDocumentsToAPIDocuments(docs)
+}
+
+// refreshStandaloneDocuments drains every document and stores it. The drain
+// is the complete set, so it licenses pruning the standalone rows it no
+// longer returned — rows with an owner belong to that owner's refresh. Every
+// document is upserted, not just the standalone ones, which also keeps
+// docs/search/ current for documents whose docs/ was never listed.
+func (r *SQLiteRepository) refreshStandaloneDocuments(ctx context.Context) error {
+	docs, err := r.client.GetAllDocuments(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := db.Now()
+	clean := reconcile.Collection(ctx, reconcile.CollectionSpec[api.Document]{
+		Label: "workspace document",
+		Kind:  "document",
+		Items: docs,
+		Upsert: func(ctx context.Context, doc api.Document) error {
+			params, err := db.APIDocumentToDBDocument(doc)
+			if err != nil {
+				return err
+			}
+			return r.store.Queries().UpsertDocument(ctx, params)
+		},
+		Prune: func(ctx context.Context) error {
+			return r.store.Queries().PruneStandaloneDocuments(ctx, start)
+		},
+	})
+	if !clean {
+		return nil // stays stale: the next listing retries
+	}
+	return r.store.Queries().UpsertSyncSchedule(ctx, db.UpsertSyncScheduleParams{
+		Key:     standaloneDocsScheduleKey,
+		LastRun: db.Now(),
+	})
+}
+
+// SearchDocuments full-text searches every synced document (title and
+// content), best match first, capped at limit. Local-only: it searches what
+// sync and the per-parent docs/ refreshes have cached, with no API fallback.
+func (r *SQLiteRepository) SearchDocuments(ctx context.Context, query string, limit int) ([]api.Document, error) {
+	docs, err := r.store.SearchDocuments(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("search documents: %w", err)
+	}
+	return db.DBDocumentsToAPIDocuments(docs)
+}
+
 // =============================================================================
 // Initiatives
 // =============================================================================
@@ -1086,6 +1435,15 @@ func (r *SQLiteRepository) GetInitiatives(ctx context.Context) ([]api.Initiative
 	return db.DBInitiativesToAPIInitiatives(initiatives)
 }
 
+// GetChildInitiatives returns an initiative's direct sub-initiatives.
+func (r *SQLiteRepository) GetChildInitiatives(ctx context.Context, initiativeID string) ([]api.Initiative, error) {
+	initiatives, err := r.store.Queries().ListChildInitiatives(ctx, sql.NullString{String: initiativeID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list child initiatives: %w", err)
+	}
+	return db.DBInitiativesToAPIInitiatives(initiatives)
+}
+
 // =============================================================================
 // Status Updates
 // =============================================================================
@@ -1196,6 +1554,26 @@ func (r *SQLiteRepository) GetIssueAttachments(ctx context.Context, issueID stri
 	return db.DBAttachmentsToAPIAttachments(attachments)
 }
 
+// GetTeamAttachments returns the cached attachments on a team's issues keyed
+// by issue ID, in one query rather than one per issue. Like
+// GetIssueAttachments it reads the cache only: attachments synced with issue
+// details, so an issue never opened may have none yet.
+func (r *SQLiteRepository) GetTeamAttachments(ctx context.Context, teamID string) (map[string][]api.Attachment, error) {
+	rows, err := r.store.Queries().ListTeamAttachments(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("list team attachments: %w", err)
+	}
+	attachments, err := db.DBAttachmentsToAPIAttachments(rows)
+	if err != nil {
+		return nil, err
+	}
+	byIssue := make(map[string][]api.Attachment)
+	for i, att := range attachments {
+		byIssue[rows[i].IssueID] = append(byIssue[rows[i].IssueID], att)
+	}
+	return byIssue, nil
+}
+
 // GetProjectLinks returns a project's external links ("Links / Resources"),
 // refreshing from the API on read when the local rows are stale (SWR), the same
 // contract as GetProjectDocuments.
@@ -1374,6 +1752,370 @@ func (r *SQLiteRepository) upsertHistoryCache(ctx context.Context, issueID strin
 	}
 }
 
+// GetIssueDescriptionVersions returns the issue's kept description versions,
+// oldest first. Reads SQLite only: versions are recorded as sync observes them.
+func (r *SQLiteRepository) GetIssueDescriptionVersions(ctx context.Context, issueID string) ([]api.DescriptionVersion, error) {
+	rows, err := r.store.Queries().ListDescriptionVersions(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("list description versions: %w", err)
+	}
+	versions := make([]api.DescriptionVersion, len(rows))
+	for i, row := range rows {
+		versions[i] = api.DescriptionVersion{ObservedAt: row.ObservedAt, Description: row.Description}
+	}
+	return versions, nil
+}
+
+// GetActivityDays returns the days (YYYY-MM-DD, UTC) with recorded activity,
+// oldest first. Reads SQLite only, like GetActivity.
+func (r *SQLiteRepository) GetActivityDays(ctx context.Context) ([]string, error) {
+	days, err := r.store.Queries().ListActivityDays(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list activity days: %w", err)
+	}
+	return days, nil
+}
+
+// GetActivity returns the issue changes recorded for day, in time order.
+// Reads SQLite only: activity is recorded as sync and webhooks observe it.
+func (r *SQLiteRepository) GetActivity(ctx context.Context, day string) ([]api.ActivityEntry, error) {
+	rows, err := r.store.Queries().ListActivityByDay(ctx, day)
+	if err != nil {
+		return nil, fmt.Errorf("list activity: %w", err)
+	}
+	entries := make([]api.ActivityEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = api.ActivityEntry{
+			At:         row.At,
+			Kind:       row.Kind,
+			IssueID:    row.IssueID,
+			Identifier: row.Identifier,
+			TeamKey:    row.TeamKey,
+			Title:      row.Title,
+			FromState:  row.FromState,
+			ToState:    row.ToState,
+		}
+	}
+	return entries, nil
+}
+
+// GetCycleScopeChanges returns the scope changes recorded for a cycle, in
+// time order. Reads SQLite only, like GetActivity.
+func (r *SQLiteRepository) GetCycleScopeChanges(ctx context.Context, cycleID string) ([]api.ScopeChange, error) {
+	rows, err := r.store.Queries().ListCycleScopeChanges(ctx, cycleID)
+	if err != nil {
+		return nil, fmt.Errorf("list scope changes: %w", err)
+	}
+	estimate := func(f sql.NullFloat64) *float64 {
+		if !f.Valid {
+			return nil
+		}
+		return &f.Float64
+	}
+	changes := make([]api.ScopeChange, len(rows))
+	for i, row := range rows {
+		changes[i] = api.ScopeChange{
+			At:           row.At,
+			Kind:         row.Kind,
+			IssueID:      row.IssueID,
+			Identifier:   row.Identifier,
+			Title:        row.Title,
+			FromEstimate: estimate(row.FromEstimate),
+			ToEstimate:   estimate(row.ToEstimate),
+		}
+	}
+	return changes, nil
+}
+
+// =============================================================================
+// Organization
+// =============================================================================
+
+// GetOrganization returns the cached workspace settings behind /organization.md,
+// refreshing from the API on read when the cache is stale (TTL SWR). Returns
+// nil, nil before the first fetch has landed — the file renders a placeholder
+// and fills in on a later read.
+func (r *SQLiteRepository) GetOrganization(ctx context.Context) (*api.Organization, error) {
+	r.maybeRefreshSWR(swrSpec{
+		kind: kindOrganization,
+		id:   "workspace",
+		syncedAt: func() (interface{}, error) {
+			row, err := r.store.Queries().GetOrganizationCache(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return row.SyncedAt, nil
+		},
+		refresh: r.refreshOrganization,
+	})
+
+	row, err := r.store.Queries().GetOrganizationCache(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get organization cache: %w", err)
+	}
+	var org api.Organization
+	if err := json.Unmarshal(row.Data, &org); err != nil {
+		return nil, fmt.Errorf("unmarshal organization cache: %w", err)
+	}
+	return &org, nil
+}
+
+// refreshOrganization fetches the organization and replaces the cached row.
+func (r *SQLiteRepository) refreshOrganization(ctx context.Context) error {
+	org, err := r.client.GetOrganization(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(org)
+	if err != nil {
+		return fmt.Errorf("marshal organization: %w", err)
+	}
+	return r.store.Queries().SetOrganizationCache(ctx, db.SetOrganizationCacheParams{
+		SyncedAt: db.Now(),
+		Data:     data,
+	})
+}
+
+// =============================================================================
+// Custom Views
+// =============================================================================
+
+// GetCustomViews returns the cached Linear saved views behind /views/,
+// refreshing from the API on read when the cache is stale (TTL SWR). Returns
+// an empty list before the first fetch has landed.
+func (r *SQLiteRepository) GetCustomViews(ctx context.Context) ([]api.CustomView, error) {
+	r.maybeRefreshSWR(swrSpec{
+		kind: kindCustomViews,
+		id:   "workspace",
+		syncedAt: func() (interface{}, error) {
+			row, err := r.store.Queries().GetCustomViewsCache(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return row.SyncedAt, nil
+		},
+		refresh: r.refreshCustomViews,
+	})
+
+	row, err := r.store.Queries().GetCustomViewsCache(ctx)
+	if err == sql.ErrNoRows {
+		return []api.CustomView{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get custom views cache: %w", err)
+	}
+	var views []api.CustomView
+	if err := json.Unmarshal(row.Data, &views); err != nil {
+		return nil, fmt.Errorf("unmarshal custom views cache: %w", err)
+	}
+	return views, nil
+}
+
+// refreshCustomViews fetches the saved views and replaces the cached list.
+func (r *SQLiteRepository) refreshCustomViews(ctx context.Context) error {
+	views, err := r.client.GetCustomViews(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(views)
+	if err != nil {
+		return fmt.Errorf("marshal custom views: %w", err)
+	}
+	return r.store.Queries().SetCustomViewsCache(ctx, db.SetCustomViewsCacheParams{
+		SyncedAt: db.Now(),
+		Data:     data,
+	})
+}
+
+// GetCustomViewIssues returns the locally synced issues a saved view matches.
+// Membership is evaluated by Linear (the view's filter lives server-side) and
+// cached per view, refreshed on read when stale (TTL SWR); an issue the sync
+// worker hasn't stored yet is omitted until it has.
+func (r *SQLiteRepository) GetCustomViewIssues(ctx context.Context, viewID string) ([]api.Issue, error) {
+	r.maybeRefreshSWR(swrSpec{
+		kind: kindCustomViewIssues,
+		id:   viewID,
+		syncedAt: func() (interface{}, error) {
+			return r.store.Queries().GetCustomViewIssueCacheSyncedAt(context.Background(), viewID)
+		},
+		refresh: func(ctx context.Context) error { return r.refreshCustomViewIssues(ctx, viewID) },
+	})
+
+	issues, err := r.store.Queries().ListCustomViewIssues(ctx, viewID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom view issues: %w", err)
+	}
+	return db.DBIssuesToAPIIssues(issues)
+}
+
+// refreshCustomViewIssues fetches a view's matching issue IDs and replaces
+// its cached membership.
+func (r *SQLiteRepository) refreshCustomViewIssues(ctx context.Context, viewID string) error {
+	ids, err := r.client.GetCustomViewIssueIDs(ctx, viewID)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return fmt.Errorf("marshal custom view issue ids: %w", err)
+	}
+	return r.store.Queries().UpsertCustomViewIssueCache(ctx, db.UpsertCustomViewIssueCacheParams{
+		ViewID:   viewID,
+		SyncedAt: db.Now(),
+		IssueIds: data,
+	})
+}
+
+// =============================================================================
+// Emojis
+// =============================================================================
+
+// GetEmojis returns the cached custom workspace emojis behind
+// /.linearfs/emoji.json, refreshing from the API on read when the cache is
+// stale (TTL SWR). Returns an empty list before the first fetch has landed.
+func (r *SQLiteRepository) GetEmojis(ctx context.Context) ([]api.Emoji, error) {
+	r.maybeRefreshSWR(swrSpec{
+		kind: kindEmojis,
+		id:   "workspace",
+		syncedAt: func() (interface{}, error) {
+			row, err := r.store.Queries().GetEmojiCache(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return row.SyncedAt, nil
+		},
+		refresh: r.refreshEmojis,
+	})
+
+	row, err := r.store.Queries().GetEmojiCache(ctx)
+	if err == sql.ErrNoRows {
+		return []api.Emoji{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get emoji cache: %w", err)
+	}
+	var emojis []api.Emoji
+	if err := json.Unmarshal(row.Data, &emojis); err != nil {
+		return nil, fmt.Errorf("unmarshal emoji cache: %w", err)
+	}
+	return emojis, nil
+}
+
+// refreshEmojis fetches the custom emojis and replaces the cached list.
+func (r *SQLiteRepository) refreshEmojis(ctx context.Context) error {
+	emojis, err := r.client.GetEmojis(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(emojis)
+	if err != nil {
+		return fmt.Errorf("marshal emojis: %w", err)
+	}
+	return r.store.Queries().SetEmojiCache(ctx, db.SetEmojiCacheParams{
+		SyncedAt: db.Now(),
+		Data:     data,
+	})
+}
+
+// =============================================================================
+// Templates
+// =============================================================================
+
+// GetTeamTemplates returns a team's cached issue templates behind
+// teams/{KEY}/templates/, refreshing from the API on read when the cache is
+// stale (TTL SWR). Returns an empty list before the first fetch has landed.
+func (r *SQLiteRepository) GetTeamTemplates(ctx context.Context, teamID string) ([]api.Template, error) {
+	r.maybeRefreshSWR(swrSpec{
+		kind: kindTeamTemplates,
+		id:   teamID,
+		syncedAt: func() (interface{}, error) {
+			row, err := r.store.Queries().GetTeamTemplatesCache(context.Background(), teamID)
+			if err != nil {
+				return nil, err
+			}
+			return row.SyncedAt, nil
+		},
+		refresh: func(ctx context.Context) error { return r.refreshTeamTemplates(ctx, teamID) },
+	})
+
+	row, err := r.store.Queries().GetTeamTemplatesCache(ctx, teamID)
+	if err == sql.ErrNoRows {
+		return []api.Template{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get team templates cache: %w", err)
+	}
+	var templates []api.Template
+	if err := json.Unmarshal(row.Data, &templates); err != nil {
+		return nil, fmt.Errorf("unmarshal team templates cache: %w", err)
+	}
+	return templates, nil
+}
+
+// refreshTeamTemplates fetches a team's issue templates and replaces its
+// cached list.
+func (r *SQLiteRepository) refreshTeamTemplates(ctx context.Context, teamID string) error {
+	templates, err := r.client.GetTeamTemplates(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(templates)
+	if err != nil {
+		return fmt.Errorf("marshal team templates: %w", err)
+	}
+	return r.store.Queries().SetTeamTemplatesCache(ctx, db.SetTeamTemplatesCacheParams{
+		TeamID:   teamID,
+		SyncedAt: db.Now(),
+		Data:     data,
+	})
+}
+
+// =============================================================================
+// Backlinks
+// =============================================================================
+
+// GetIssueBacklinks returns the cached issue descriptions, comments, and
+// documents that mention an issue, newest first: its identifier as a whole
+// token, which also covers the issue's URL (it embeds the identifier). Reads
+// the mention index, so it shows what the sync worker has indexed.
+func (r *SQLiteRepository) GetIssueBacklinks(ctx context.Context, issueID, identifier string) ([]api.Backlink, error) {
+	return r.backlinks(ctx, identifier, issueID, "")
+}
+
+// GetDocumentBacklinks returns the cached issue descriptions, comments, and
+// other documents that link to a document's URL, newest first.
+func (r *SQLiteRepository) GetDocumentBacklinks(ctx context.Context, doc api.Document) ([]api.Backlink, error) {
+	target := db.DocumentMentionTarget(doc.URL)
+	if target == "" {
+		return nil, nil
+	}
+	return r.backlinks(ctx, target, "", doc.ID)
+}
+
+func (r *SQLiteRepository) backlinks(ctx context.Context, target, issueID, documentID string) ([]api.Backlink, error) {
+	sources, err := r.store.ListBacklinks(ctx, target, issueID, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("list backlinks: %w", err)
+	}
+	links := make([]api.Backlink, 0, len(sources))
+	for _, src := range sources {
+		links = append(links, api.Backlink{
+			Kind:       src.Kind,
+			ID:         src.ID,
+			Identifier: src.Identifier,
+			Title:      src.Title,
+			Author:     src.Author,
+			URL:        src.URL,
+			UpdatedAt:  src.UpdatedAt,
+		})
+	}
+	sort.SliceStable(links, func(i, j int) bool { return links[i].UpdatedAt.After(links[j].UpdatedAt) })
+	return links, nil
+}
+
 // =============================================================================
 // Issue Relations
 // =============================================================================
@@ -1443,3 +2185,37 @@ func (r *SQLiteRepository) GetIssueInverseRelations(ctx context.Context, issueID
 	}
 	return result, nil
 }
+
+// GetTeamIssueRelations returns every relation owned by one of the team's
+// issues — the edge set of the team's graph.dot/graph.json. RelatedIssue is
+// enriched as for GetIssueRelations; Issue carries only the owner's ID, since
+// the owner is by construction one of the team's issues the caller already
+// holds.
+func (r *SQLiteRepository) GetTeamIssueRelations(ctx context.Context, teamID string) ([]api.IssueRelation, error) {
+	relations, err := r.store.Queries().ListTeamIssueRelations(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("list team issue relations: %w", err)
+	}
+	return r.relationEdges(ctx, relations), nil
+}
+
+// GetProjectIssueRelations is GetTeamIssueRelations scoped to a project's
+// issues (which may span teams).
+func (r *SQLiteRepository) GetProjectIssueRelations(ctx context.Context, projectID string) ([]api.IssueRelation, error) {
+	relations, err := r.store.Queries().ListProjectIssueRelations(ctx, sql.NullString{String: projectID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list project issue relations: %w", err)
+	}
+	return r.relationEdges(ctx, relations), nil
+}
+
+// relationEdges maps stored relations to outgoing views that also record the
+// owning issue's ID, so a graph renderer can draw both ends.
+func (r *SQLiteRepository) relationEdges(ctx context.Context, relations []db.IssueRelation) []api.IssueRelation {
+	result := make([]api.IssueRelation, len(relations))
+	for i, rel := range relations {
+		result[i] = r.relationView(ctx, rel, relOutgoing)
+		result[i].Issue = &api.ParentIssue{ID: rel.IssueID}
+	}
+	return result
+}
@@ -3,7 +3,9 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"path/filepath"
 	"sync/atomic"
 	"testing"
@@ -53,6 +55,26 @@ func TestSQLiteRepository_Teams(t *testing.T) {
 	if len(teams) != 2 {
 		t.Errorf("Expected 2 teams, got %d", len(teams))
 	}
+
+	// A team filter hides the other team
+	repo.SetTeamFilter([]string{"DSN"}, nil)
+	teams, err = repo.GetTeams(ctx)
+	if err != nil {
+		t.Fatalf("GetTeams failed: %v", err)
+	}
+	if len(teams) != 1 || teams[0].Key != "DSN" {
+		t.Errorf("filtered GetTeams = %v, want DSN only", teams)
+	}
+
+	// So does excluding it
+	repo.SetTeamFilter(nil, []string{"DSN"})
+	teams, err = repo.GetTeams(ctx)
+	if err != nil {
+		t.Fatalf("GetTeams failed: %v", err)
+	}
+	if len(teams) != 1 || teams[0].Key == "DSN" {
+		t.Errorf("excluding GetTeams = %v, want DSN left out", teams)
+	}
 }
 
 func TestSQLiteRepository_Issues(t *testing.T) {
@@ -197,6 +219,78 @@ func TestSQLiteRepository_FilteredIssues(t *testing.T) {
 	if len(assigned) != 1 {
 		t.Errorf("Expected 1 assigned issue, got %d", len(assigned))
 	}
+
+	// Test GetIssuesByPriority
+	urgent, err := repo.GetIssuesByPriority(ctx, "team-1", 1)
+	if err != nil {
+		t.Fatalf("GetIssuesByPriority failed: %v", err)
+	}
+	if len(urgent) != 2 {
+		t.Errorf("Expected 2 urgent issues, got %d", len(urgent))
+	}
+	none, err := repo.GetIssuesByPriority(ctx, "team-1", 0)
+	if err != nil {
+		t.Fatalf("GetIssuesByPriority failed: %v", err)
+	}
+	if len(none) != 0 {
+		t.Errorf("Expected 0 no-priority issues, got %d", len(none))
+	}
+}
+
+func TestSQLiteRepository_IssueBacklinks(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	older := time.Now().Add(-time.Hour)
+	issues := []api.Issue{
+		{ID: "i1", Identifier: "TST-1", Title: "Target", Team: &team, Description: "Mentions itself: TST-1", CreatedAt: older, UpdatedAt: older},
+		{ID: "i2", Identifier: "TST-2", Title: "Refers", Team: &team, Description: "Blocked on TST-1.", CreatedAt: older, UpdatedAt: older},
+		{ID: "i3", Identifier: "TST-3", Title: "Near miss", Team: &team, Description: "See TST-12 and XTST-1", CreatedAt: older, UpdatedAt: older},
+	}
+	for _, issue := range issues {
+		data, _ := db.APIIssueToDBIssue(issue)
+		if err := store.Queries().UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	// A comment on another issue links via URL; one on the target itself does not count.
+	user := api.User{ID: "u1", Email: "alice@example.com"}
+	onOther, _ := db.APICommentToDBComment(api.Comment{ID: "c1", Body: "Dup of https://linear.app/x/issue/TST-1/target", User: &user, CreatedAt: time.Now(), UpdatedAt: time.Now()}, "i3")
+	onSelf, _ := db.APICommentToDBComment(api.Comment{ID: "c2", Body: "TST-1 note", User: &user, CreatedAt: time.Now(), UpdatedAt: time.Now()}, "i1")
+	for _, c := range []db.UpsertCommentParams{onOther, onSelf} {
+		if err := store.Queries().UpsertComment(ctx, c); err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	// Nothing shows until the sync worker has indexed the texts.
+	if links, err := repo.GetIssueBacklinks(ctx, "i1", "TST-1"); err != nil || len(links) != 0 {
+		t.Fatalf("GetIssueBacklinks before indexing = %+v, %v; want none", links, err)
+	}
+	if _, err := store.IndexMentions(ctx); err != nil {
+		t.Fatalf("IndexMentions: %v", err)
+	}
+
+	links, err := repo.GetIssueBacklinks(ctx, "i1", "TST-1")
+	if err != nil {
+		t.Fatalf("GetIssueBacklinks failed: %v", err)
+	}
+	if len(links) != 2 {
+		t.Fatalf("Expected 2 backlinks, got %d: %+v", len(links), links)
+	}
+	// Newest first: the comment was written after the issues.
+	if links[0].Kind != "comment" || links[0].Identifier != "TST-3" || links[0].Author != "alice@example.com" {
+		t.Errorf("links[0] = %+v, want comment on TST-3 by alice", links[0])
+	}
+	if links[1].Kind != "issue" || links[1].Identifier != "TST-2" {
+		t.Errorf("links[1] = %+v, want issue TST-2", links[1])
+	}
 }
 
 func TestSQLiteRepository_States(t *testing.T) {
@@ -608,6 +702,68 @@ func TestSQLiteRepository_IssuesByProject(t *testing.T) {
 	}
 }
 
+func TestSQLiteRepository_IssuesByMilestone(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+
+	team := api.Team{ID: "team-1", Key: "TST", Name: "Test", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	if err := store.Queries().UpsertTeam(ctx, db.APITeamToDBTeam(team)); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	project := api.Project{ID: "project-1", Name: "Project Alpha", Slug: "alpha", State: "started", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	// Two issues in milestone-1, one in milestone-2, one in none.
+	milestones := map[string]*api.ProjectMilestone{
+		"TST-1": {ID: "milestone-1", Name: "Beta"},
+		"TST-2": {ID: "milestone-1", Name: "Beta"},
+		"TST-3": {ID: "milestone-2", Name: "GA"},
+		"TST-4": nil,
+	}
+	for ident, m := range milestones {
+		issue := api.Issue{
+			ID:               "id-" + ident,
+			Identifier:       ident,
+			Title:            "Milestone Issue",
+			Team:             &team,
+			State:            api.State{ID: "state-1"},
+			Project:          &project,
+			ProjectMilestone: m,
+			CreatedAt:        time.Now(),
+			UpdatedAt:        time.Now(),
+		}
+		issueData, _ := db.APIIssueToDBIssue(issue)
+		if err := store.Queries().UpsertIssue(ctx, issueData.ToUpsertParams()); err != nil {
+			t.Fatalf("setup: %v", err)
+		}
+	}
+
+	issues, err := repo.GetIssuesByMilestone(ctx, "project-1", "milestone-1")
+	if err != nil {
+		t.Fatalf("GetIssuesByMilestone failed: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues in milestone-1, got %d", len(issues))
+	}
+	for _, issue := range issues {
+		if issue.ProjectMilestone == nil || issue.ProjectMilestone.ID != "milestone-1" {
+			t.Errorf("%s: ProjectMilestone = %+v, want milestone-1", issue.Identifier, issue.ProjectMilestone)
+		}
+	}
+
+	// A milestone ID is only matched within its own project.
+	issues, err = repo.GetIssuesByMilestone(ctx, "project-2", "milestone-1")
+	if err != nil {
+		t.Fatalf("GetIssuesByMilestone failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("Expected no issues for another project, got %d", len(issues))
+	}
+}
+
 func TestSQLiteRepository_IssuesByCycle(t *testing.T) {
 	t.Parallel()
 	store, cleanup := setupTestDB(t)
@@ -1124,6 +1280,22 @@ func TestSQLiteRepository_Initiatives(t *testing.T) {
 	if len(initiatives) != 1 {
 		t.Errorf("Expected 1 initiative, got %d", len(initiatives))
 	}
+
+	// Sub-initiatives: the parent link lands in parent_id and lists back
+	// through GetChildInitiatives; a top-level initiative has no children.
+	child := api.Initiative{ID: "init-2", Name: "Child", Slug: "child",
+		Parent: &api.ParentInitiative{ID: "init-1", Name: "Test Initiative", Slug: "test-initiative"}}
+	childParams, _ := db.APIInitiativeToDBInitiative(child)
+	if err := store.Queries().UpsertInitiative(ctx, childParams); err != nil {
+		t.Fatalf("setup child: %v", err)
+	}
+	children, err := repo.GetChildInitiatives(ctx, "init-1")
+	if err != nil || len(children) != 1 || children[0].ID != "init-2" || children[0].Parent == nil {
+		t.Errorf("GetChildInitiatives(init-1) = %+v, %v; want [init-2] with parent", children, err)
+	}
+	if none, err := repo.GetChildInitiatives(ctx, "init-2"); err != nil || len(none) != 0 {
+		t.Errorf("GetChildInitiatives(init-2) = %+v, %v; want none", none, err)
+	}
 }
 
 func TestSQLiteRepository_ProjectUpdates(t *testing.T) {
@@ -1612,6 +1784,106 @@ func TestMaybeRefreshIssueDetails_EmptyFamiliesNoRefetchLoop(t *testing.T) {
 	}
 }
 
+// TestCompleteIssueFields: a row marked by a projected bulk sync is completed
+// from one full fetch and unmarked; an unmarked row costs no request.
+func TestCompleteIssueFields(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetResponse("Issue", map[string]any{"issue": map[string]any{
+		"id": "issue-1", "identifier": "TST-1", "title": "Projected", "description": "Full body",
+		"team":      map[string]any{"id": "team-1", "key": "TST"},
+		"labels":    map[string]any{"nodes": []map[string]any{{"id": "label-1", "name": "Bug"}}},
+		"createdAt": "2026-01-01T00:00:00Z", "updatedAt": "2026-01-02T00:00:00Z",
+	}})
+	client := api.NewClient("test-key")
+	client.SetAPIURL(mock.URL())
+	repo := NewSQLiteRepository(store, client)
+	defer repo.Close()
+
+	for id, ident := range map[string]string{"issue-1": "TST-1", "issue-2": "TST-2"} {
+		data := &db.IssueData{ID: id, Identifier: ident, Title: "Projected",
+			TeamID: "team-1", CreatedAt: db.Now(), UpdatedAt: db.Now(), Data: []byte("{}")}
+		if err := store.Queries().UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+			t.Fatalf("seed issue: %v", err)
+		}
+	}
+	if err := store.Queries().UpsertPendingIssueFields(ctx, db.UpsertPendingIssueFieldsParams{IssueID: "issue-1", QueuedAt: db.Now()}); err != nil {
+		t.Fatalf("mark issue: %v", err)
+	}
+
+	if full, err := repo.CompleteIssueFields(ctx, "issue-2"); err != nil || full != nil {
+		t.Fatalf("unmarked issue: got %v, %v; want nothing to do", full, err)
+	}
+	if n := len(mock.Calls()); n != 0 {
+		t.Fatalf("unmarked issue cost %d requests, want 0", n)
+	}
+
+	full, err := repo.CompleteIssueFields(ctx, "issue-1")
+	if err != nil || full == nil {
+		t.Fatalf("marked issue: got %v, %v; want the full issue", full, err)
+	}
+	cached, err := repo.GetIssueByID(ctx, "issue-1")
+	if err != nil {
+		t.Fatalf("GetIssueByID: %v", err)
+	}
+	if cached.Description != "Full body" || len(cached.Labels.Nodes) != 1 {
+		t.Errorf("cached issue = %q %+v, want the fetched body and labels", cached.Description, cached.Labels.Nodes)
+	}
+	if n, err := store.Queries().HasPendingIssueFields(ctx, "issue-1"); err != nil || n != 0 {
+		t.Errorf("mark after completion = %d, %v; want cleared", n, err)
+	}
+	if full, err := repo.CompleteIssueFields(ctx, "issue-1"); err != nil || full != nil || len(mock.Calls()) != 1 {
+		t.Errorf("second open: got %v, %v after %d requests; want no refetch", full, err, len(mock.Calls()))
+	}
+}
+
+// TestCompleteIssueFieldsForbidden: a full fetch Linear refuses this token
+// returns the cached row marked restricted instead of an error, persists the
+// mark, and clears the pending mark so the next open does not ask again.
+func TestCompleteIssueFieldsForbidden(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	mock := testutil.NewMockLinearServer()
+	defer mock.Close()
+	mock.SetFailures("Issue", http.StatusForbidden)
+	client := api.NewClient("test-key")
+	client.SetAPIURL(mock.URL())
+	repo := NewSQLiteRepository(store, client)
+	defer repo.Close()
+
+	seed, err := db.APIIssueToDBIssue(api.Issue{ID: "issue-1", Identifier: "TST-1", Title: "Private",
+		Team: &api.Team{ID: "team-1"}, CreatedAt: db.Now(), UpdatedAt: db.Now()})
+	if err != nil {
+		t.Fatalf("convert issue: %v", err)
+	}
+	if err := store.Queries().UpsertIssue(ctx, seed.ToUpsertParams()); err != nil {
+		t.Fatalf("seed issue: %v", err)
+	}
+	if err := store.Queries().UpsertPendingIssueFields(ctx, db.UpsertPendingIssueFieldsParams{IssueID: "issue-1", QueuedAt: db.Now()}); err != nil {
+		t.Fatalf("mark issue: %v", err)
+	}
+
+	got, err := repo.CompleteIssueFields(ctx, "issue-1")
+	if err != nil || got == nil || !got.Restricted {
+		t.Fatalf("forbidden fetch: got %+v, %v; want the cached issue marked restricted", got, err)
+	}
+	cached, err := repo.GetIssueByID(ctx, "issue-1")
+	if err != nil || !cached.Restricted {
+		t.Errorf("cached issue restricted = %v (%v), want persisted", cached != nil && cached.Restricted, err)
+	}
+	if n, err := store.Queries().HasPendingIssueFields(ctx, "issue-1"); err != nil || n != 0 {
+		t.Errorf("pending mark after refusal = %d, %v; want cleared", n, err)
+	}
+}
+
 // The four Get*Documents/Get*Updates read paths must be safe no-ops in fixture
 // mode (nil client): maybeRefreshSWR short-circuits, so the read returns
 // whatever is cached without touching the API. Exercised through the real
@@ -1696,6 +1968,22 @@ func TestSQLiteRepository_Attachments(t *testing.T) {
 	if len(attachments) != 0 {
 		t.Errorf("Expected 0 attachments, got %d", len(attachments))
 	}
+
+	// Test GetTeamAttachments - keyed by issue, scoped to the team's issues
+	issueData, _ := db.APIIssueToDBIssue(api.Issue{ID: issueID, Identifier: "ENG-123", Team: &api.Team{ID: "team-1"}})
+	if err := store.Queries().UpsertIssue(ctx, issueData.ToUpsertParams()); err != nil {
+		t.Fatalf("UpsertIssue failed: %v", err)
+	}
+	byIssue, err := repo.GetTeamAttachments(ctx, "team-1")
+	if err != nil {
+		t.Fatalf("GetTeamAttachments failed: %v", err)
+	}
+	if len(byIssue) != 1 || len(byIssue[issueID]) != 1 || byIssue[issueID][0].ID != "attach-1" {
+		t.Errorf("GetTeamAttachments = %v, want attach-1 under %s", byIssue, issueID)
+	}
+	if byIssue, _ := repo.GetTeamAttachments(ctx, "team-2"); len(byIssue) != 0 {
+		t.Errorf("GetTeamAttachments for another team = %v, want none", byIssue)
+	}
 }
 
 func TestSQLiteRepository_EmbeddedFiles(t *testing.T) {
@@ -1866,44 +2154,92 @@ func TestTriggerBackgroundRefresh_Timeout(t *testing.T) {
 	_ = origTimeout
 }
 
-func TestTriggerBackgroundRefresh_SemaphoreDropsExcess(t *testing.T) {
-	t.Parallel()
-	store, cleanup := setupTestDB(t)
-	defer cleanup()
-
-	client := api.NewClient("test-key")
-	repo := NewSQLiteRepository(store, client)
-	defer repo.Close()
-
-	// Fill the semaphore with blocking refreshes
+// fillRefreshWorkers occupies every worker with a refresh that blocks until
+// the returned release is called, and waits until all of them are running.
+func fillRefreshWorkers(t *testing.T, repo *SQLiteRepository) (release func()) {
+	t.Helper()
 	blocker := make(chan struct{})
+	started := make(chan struct{}, maxConcurrentRefreshes)
 	for i := 0; i < maxConcurrentRefreshes; i++ {
 		key := fmt.Sprintf("blocker-%d", i)
 		repo.triggerBackgroundRefresh("test", key, func(ctx context.Context) error {
+			started <- struct{}{}
 			<-blocker // block until released
 			return nil
 		})
 	}
+	for i := 0; i < maxConcurrentRefreshes; i++ {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("workers never picked up the blocking refreshes")
+		}
+	}
+	return func() { close(blocker) }
+}
 
-	// Give goroutines a moment to start
-	time.Sleep(50 * time.Millisecond)
+func TestTriggerBackgroundRefresh_QueuesExcess(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
 
-	// This refresh should be dropped (semaphore full)
-	dropped := true
-	repo.triggerBackgroundRefresh("test", "should-be-dropped", func(ctx context.Context) error {
-		dropped = false
+	client := api.NewClient("test-key")
+	repo := NewSQLiteRepository(store, client)
+	defer repo.Close()
+
+	release := fillRefreshWorkers(t, repo)
+
+	// With every worker busy, a further refresh waits in the queue...
+	ran := make(chan struct{})
+	repo.triggerBackgroundRefresh("test", "queued", func(ctx context.Context) error {
+		close(ran)
 		return nil
 	})
+	select {
+	case <-ran:
+		t.Fatal("queued refresh ran while every worker was busy")
+	case <-time.After(50 * time.Millisecond):
+	}
 
-	// Give a moment for it to potentially execute
-	time.Sleep(50 * time.Millisecond)
+	// ...and runs once a worker frees up.
+	release()
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Error("queued refresh never ran after the workers freed up")
+	}
+}
+
+func TestTriggerBackgroundRefresh_QueueDropsOverflow(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	client := api.NewClient("test-key")
+	repo := NewSQLiteRepository(store, client)
+	defer repo.Close()
 
-	if !dropped {
-		t.Error("expected excess refresh to be dropped when semaphore is full")
+	release := fillRefreshWorkers(t, repo)
+	defer release()
+
+	// Fill the queue behind the busy workers
+	for i := 0; i < refreshQueueSize; i++ {
+		repo.triggerBackgroundRefresh("test", fmt.Sprintf("queued-%d", i), func(ctx context.Context) error { return nil })
 	}
 
-	// Clean up: release all blockers
-	close(blocker)
+	// This refresh should be dropped (queue full)
+	repo.triggerBackgroundRefresh("test", "should-be-dropped", func(ctx context.Context) error {
+		t.Error("expected excess refresh to be dropped when the queue is full")
+		return nil
+	})
+
+	// A dropped refresh leaves no dedup key behind, so a later read can retry.
+	repo.refreshMu.Lock()
+	pending := repo.refreshing["test:should-be-dropped"]
+	repo.refreshMu.Unlock()
+	if pending {
+		t.Error("dropped refresh still marked in flight")
+	}
 }
 
 func TestTriggerBackgroundRefresh_DeduplicatesByKey(t *testing.T) {
@@ -2634,4 +2970,223 @@ func TestIssueRelationView(t *testing.T) {
 	if inv[0].Issue.Identifier != "ENG-1" || inv[0].Issue.Title != "Source" {
 		t.Errorf("inverse end not enriched: %+v", inv[0].Issue)
 	}
+
+	// Team scope (graph.dot's edge set): both ends present, owner by ID.
+	edges, err := repo.GetTeamIssueRelations(ctx, team.ID)
+	if err != nil {
+		t.Fatalf("GetTeamIssueRelations: %v", err)
+	}
+	if len(edges) != 1 || edges[0].Issue == nil || edges[0].Issue.ID != src.ID ||
+		edges[0].RelatedIssue == nil || edges[0].RelatedIssue.Identifier != "ENG-2" {
+		t.Fatalf("team edges = %+v, want src -> ENG-2", edges)
+	}
+	if other, err := repo.GetTeamIssueRelations(ctx, "other-team"); err != nil || len(other) != 0 {
+		t.Errorf("other team edges = %v (err %v), want none", other, err)
+	}
+	if proj, err := repo.GetProjectIssueRelations(ctx, "no-such-project"); err != nil || len(proj) != 0 {
+		t.Errorf("project edges = %v (err %v), want none", proj, err)
+	}
+}
+
+// TestBlockedIssues covers the effective blocked state behind issue.meta and
+// by/blocked/: an issue is blocked while an open issue blocks it, a completed
+// blocker frees it, and a completed issue is never listed as blocked.
+func TestBlockedIssues(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+	now := time.Now()
+
+	team := api.Team{ID: "team-1", Key: "TST"}
+	upsert := func(id, ident, stateType string) {
+		t.Helper()
+		data, err := db.APIIssueToDBIssue(api.Issue{ID: id, Identifier: ident, Title: ident, Team: &team,
+			State: api.State{ID: "state-" + stateType, Name: stateType, Type: stateType}, CreatedAt: now, UpdatedAt: now})
+		if err != nil {
+			t.Fatalf("convert issue %s: %v", id, err)
+		}
+		if err := store.Queries().UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+			t.Fatalf("upsert issue %s: %v", id, err)
+		}
+	}
+	blocks := func(id, blocker, blocked string) {
+		t.Helper()
+		if err := store.Queries().UpsertIssueRelation(ctx, db.UpsertIssueRelationParams{
+			ID: id, IssueID: blocker, RelatedIssueID: blocked, Type: "blocks", SyncedAt: now,
+		}); err != nil {
+			t.Fatalf("upsert relation: %v", err)
+		}
+	}
+	upsert("iss-1", "TST-1", "started")   // blocks TST-2
+	upsert("iss-2", "TST-2", "unstarted") // blocked by TST-1 (open) and TST-3 (done)
+	upsert("iss-3", "TST-3", "completed") // blocks TST-2 and TST-4
+	upsert("iss-4", "TST-4", "unstarted") // only a completed blocker
+	upsert("iss-5", "TST-5", "completed") // blocked by TST-1, but done itself
+	blocks("rel-1", "iss-1", "iss-2")
+	blocks("rel-2", "iss-3", "iss-2")
+	blocks("rel-3", "iss-3", "iss-4")
+	blocks("rel-4", "iss-1", "iss-5")
+	if err := store.Queries().UpsertIssueRelation(ctx, db.UpsertIssueRelationParams{
+		ID: "rel-5", IssueID: "iss-1", RelatedIssueID: "iss-4", Type: "related", SyncedAt: now,
+	}); err != nil {
+		t.Fatalf("upsert relation: %v", err)
+	}
+
+	blockers, err := repo.GetIssueOpenBlockers(ctx, "iss-2")
+	if err != nil {
+		t.Fatalf("GetIssueOpenBlockers: %v", err)
+	}
+	if len(blockers) != 1 || blockers[0].Identifier != "TST-1" {
+		t.Errorf("TST-2 blockers = %+v, want [TST-1]", blockers)
+	}
+	if blockers, _ := repo.GetIssueOpenBlockers(ctx, "iss-4"); len(blockers) != 0 {
+		t.Errorf("TST-4 blockers = %+v, want none (completed blocker, related link)", blockers)
+	}
+
+	blocked, err := repo.GetBlockedIssues(ctx, team.ID)
+	if err != nil {
+		t.Fatalf("GetBlockedIssues: %v", err)
+	}
+	if len(blocked) != 1 || blocked[0].Identifier != "TST-2" {
+		t.Errorf("blocked = %+v, want [TST-2]", blocked)
+	}
+}
+
+// TestSQLiteRepository_Organization: before the first fetch the organization
+// reads as nil (placeholder, not an error); once cached it round-trips,
+// including the unknown-vs-false distinction of the admin-only flags.
+func TestSQLiteRepository_Organization(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+
+	org, err := repo.GetOrganization(ctx)
+	if err != nil || org != nil {
+		t.Fatalf("GetOrganization before fetch = %+v, %v; want nil, nil", org, err)
+	}
+
+	scim := false
+	data, err := json.Marshal(api.Organization{ID: "org-1", Name: "Acme", URLKey: "acme", SCIMEnabled: &scim})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if err := store.Queries().SetOrganizationCache(ctx, db.SetOrganizationCacheParams{SyncedAt: db.Now(), Data: data}); err != nil {
+		t.Fatalf("SetOrganizationCache: %v", err)
+	}
+
+	org, err = repo.GetOrganization(ctx)
+	if err != nil || org == nil {
+		t.Fatalf("GetOrganization = %+v, %v", org, err)
+	}
+	if org.Name != "Acme" || org.URLKey != "acme" {
+		t.Errorf("organization = %+v", org)
+	}
+	if org.SAMLEnabled != nil || org.SCIMEnabled == nil || *org.SCIMEnabled {
+		t.Errorf("flags = saml %v scim %v, want unknown/false", org.SAMLEnabled, org.SCIMEnabled)
+	}
+}
+
+// TestSQLiteRepository_Emojis: the emoji list reads empty before the first
+// fetch, then serves the cached list.
+func TestSQLiteRepository_Emojis(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+
+	emojis, err := repo.GetEmojis(ctx)
+	if err != nil || len(emojis) != 0 {
+		t.Fatalf("GetEmojis before fetch = %+v, %v; want empty", emojis, err)
+	}
+	data, _ := json.Marshal([]api.Emoji{{ID: "e-1", Name: "shipit", URL: "https://uploads.linear.app/e/shipit.png"}})
+	if err := store.Queries().SetEmojiCache(ctx, db.SetEmojiCacheParams{SyncedAt: db.Now(), Data: data}); err != nil {
+		t.Fatalf("SetEmojiCache: %v", err)
+	}
+	if emojis, err = repo.GetEmojis(ctx); err != nil || len(emojis) != 1 || emojis[0].Name != "shipit" {
+		t.Fatalf("GetEmojis = %+v, %v", emojis, err)
+	}
+}
+
+// TestSQLiteRepository_TeamTemplates: a team's templates read empty before
+// the first fetch, then serve the cached list — keyed per team.
+func TestSQLiteRepository_TeamTemplates(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+
+	templates, err := repo.GetTeamTemplates(ctx, "team-1")
+	if err != nil || len(templates) != 0 {
+		t.Fatalf("GetTeamTemplates before fetch = %+v, %v; want empty", templates, err)
+	}
+	data, _ := json.Marshal([]api.Template{{ID: "tpl-1", Name: "Bug", Type: api.TemplateTypeIssue}})
+	if err := store.Queries().SetTeamTemplatesCache(ctx, db.SetTeamTemplatesCacheParams{TeamID: "team-1", SyncedAt: db.Now(), Data: data}); err != nil {
+		t.Fatalf("SetTeamTemplatesCache: %v", err)
+	}
+	if templates, err = repo.GetTeamTemplates(ctx, "team-1"); err != nil || len(templates) != 1 || templates[0].Name != "Bug" {
+		t.Fatalf("GetTeamTemplates = %+v, %v", templates, err)
+	}
+	if templates, err = repo.GetTeamTemplates(ctx, "team-2"); err != nil || len(templates) != 0 {
+		t.Fatalf("GetTeamTemplates(other team) = %+v, %v; want empty", templates, err)
+	}
+}
+
+// TestSQLiteRepository_CustomViews: the view list reads empty before the first
+// fetch, and a view's cached membership resolves against the issues table —
+// an ID the sync worker hasn't stored yet is skipped, not an error.
+func TestSQLiteRepository_CustomViews(t *testing.T) {
+	t.Parallel()
+	store, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	repo := NewSQLiteRepository(store, nil)
+	ctx := context.Background()
+
+	views, err := repo.GetCustomViews(ctx)
+	if err != nil || len(views) != 0 {
+		t.Fatalf("GetCustomViews before fetch = %+v, %v; want empty", views, err)
+	}
+	data, _ := json.Marshal([]api.CustomView{{ID: "cv-1", Name: "My bugs", SlugID: "abc"}})
+	if err := store.Queries().SetCustomViewsCache(ctx, db.SetCustomViewsCacheParams{SyncedAt: db.Now(), Data: data}); err != nil {
+		t.Fatalf("SetCustomViewsCache: %v", err)
+	}
+	if views, err = repo.GetCustomViews(ctx); err != nil || len(views) != 1 || views[0].Name != "My bugs" {
+		t.Fatalf("GetCustomViews = %+v, %v", views, err)
+	}
+
+	team := api.Team{ID: "team-1", Key: "TST"}
+	for _, issue := range []api.Issue{
+		{ID: "i1", Identifier: "TST-1", Team: &team, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: "i2", Identifier: "TST-2", Team: &team, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	} {
+		row, _ := db.APIIssueToDBIssue(issue)
+		if err := store.Queries().UpsertIssue(ctx, row.ToUpsertParams()); err != nil {
+			t.Fatalf("UpsertIssue: %v", err)
+		}
+	}
+
+	if issues, err := repo.GetCustomViewIssues(ctx, "cv-1"); err != nil || len(issues) != 0 {
+		t.Fatalf("GetCustomViewIssues before fetch = %+v, %v; want empty", issues, err)
+	}
+	ids, _ := json.Marshal([]string{"i2", "i-not-synced"})
+	if err := store.Queries().UpsertCustomViewIssueCache(ctx, db.UpsertCustomViewIssueCacheParams{ViewID: "cv-1", SyncedAt: db.Now(), IssueIds: ids}); err != nil {
+		t.Fatalf("UpsertCustomViewIssueCache: %v", err)
+	}
+	issues, err := repo.GetCustomViewIssues(ctx, "cv-1")
+	if err != nil || len(issues) != 1 || issues[0].Identifier != "TST-2" {
+		t.Fatalf("GetCustomViewIssues = %+v, %v; want [TST-2]", issues, err)
+	}
+	if issues[0].Team == nil || issues[0].Team.Key != "TST" {
+		t.Errorf("issue team = %+v, want key TST (symlink targets need it)", issues[0].Team)
+	}
 }
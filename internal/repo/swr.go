@@ -24,10 +24,16 @@ const (
 	kindProjectDocs       refreshKind = "project-docs"
 	kindInitiativeDocs    refreshKind = "initiative-docs"
 	kindTeamDocs          refreshKind = "team-docs"
+	kindStandaloneDocs    refreshKind = "standalone-docs"
 	kindProjectUpdates    refreshKind = "project-updates"
 	kindInitiativeUpdates refreshKind = "initiative-updates"
 	kindProjectLinks      refreshKind = "project-links"
 	kindInitiativeLinks   refreshKind = "initiative-links"
+	kindOrganization      refreshKind = "organization"
+	kindCustomViews       refreshKind = "custom-views"
+	kindEmojis            refreshKind = "emojis"
+	kindCustomViewIssues  refreshKind = "custom-view-issues"
+	kindTeamTemplates     refreshKind = "team-templates"
 )
 
 // key is the one factory for a refresh's dedup-map key.
@@ -35,6 +41,53 @@ func (k refreshKind) key(id string) string {
 	return string(k) + ":" + id
 }
 
+// Staleness overrides the SWR thresholds per surface family (SetStaleness,
+// from cache.staleness in the config). A zero field keeps the family's
+// default.
+type Staleness struct {
+	// Comments bounds how long an issue's comments, documents and attachments
+	// (one issue-details fetch) go unrefreshed. That surface is event-driven —
+	// refetched whenever the issue changed since the last fetch — so by
+	// default it has no age limit; a value adds one, for comments that land
+	// without touching the issue.
+	Comments time.Duration
+	// Documents and Updates replace defaultStalenessThreshold for the docs/
+	// and updates/ listings.
+	Documents time.Duration
+	Updates   time.Duration
+}
+
+// ttl is the threshold a TTL surface of kind is judged against: the family's
+// configured value, else base (the default, or the catch-up threshold while
+// catch-up mode is on). Catch-up raises a configured value to its own
+// threshold, never lowers it. Pure, for tests.
+func (s Staleness) ttl(kind refreshKind, base time.Duration, catchUp bool) time.Duration {
+	var set time.Duration
+	switch kind {
+	case kindProjectDocs, kindInitiativeDocs, kindTeamDocs, kindStandaloneDocs:
+		set = s.Documents
+	case kindProjectUpdates, kindInitiativeUpdates:
+		set = s.Updates
+	}
+	if set == 0 {
+		return base
+	}
+	if catchUp && set < catchUpStaleness {
+		return catchUpStaleness
+	}
+	return set
+}
+
+// maxAge is the age limit an event-driven surface of kind adds to its
+// changed-since-synced check; zero means none. Catch-up mode never reaches it
+// (see swrStale).
+func (s Staleness) maxAge(kind refreshKind) time.Duration {
+	if kind == kindIssueDetails {
+		return s.Comments
+	}
+	return 0
+}
+
 // swrSpec declares one SWR surface: how to decide staleness, how to refresh,
 // and what to delete when the entity turns out to be gone upstream.
 type swrSpec struct {
@@ -60,6 +113,11 @@ type swrSpec struct {
 	// entity-not-found rejection (the deleteOrphan* helpers). The module owns
 	// this classification; refresh tails don't inspect their own errors.
 	orphan func(ctx context.Context)
+
+	// restrict marks the local rows restricted when refresh's error is Linear
+	// refusing this token the entity (api.IsForbidden). Optional: a surface
+	// with nothing to mark leaves it nil and the error passes through.
+	restrict func(ctx context.Context)
 }
 
 // swrStale is the pure staleness decision behind maybeRefreshSWR, one function
@@ -110,9 +168,24 @@ func orphanOnNotFound(refresh func(context.Context) error, orphan func(context.C
 	}
 }
 
+// restrictOnForbidden wraps a refresh with the permission classification:
+// when Linear refuses this token the entity, restrict marks it, so its body
+// reads as denied rather than as whatever the cache last held. Any other
+// error passes through untouched.
+func restrictOnForbidden(refresh func(context.Context) error, restrict func(context.Context)) func(context.Context) error {
+	return func(ctx context.Context) error {
+		err := refresh(ctx)
+		if err != nil && restrict != nil && api.IsForbidden(err) {
+			restrict(ctx)
+		}
+		return err
+	}
+}
+
 // maybeRefreshSWR is the one entry point for stale-while-revalidate: decide
 // staleness per the spec's flavor and, if stale, trigger the deduplicated
-// background refresh (wrapped with the orphan classification). In fixture
+// background refresh (wrapped with the orphan and permission
+// classifications). In fixture
 // mode (nil client) it never fires — before even querying syncedAt.
 func (r *SQLiteRepository) maybeRefreshSWR(spec swrSpec) {
 	if r.client == nil {
@@ -130,12 +203,18 @@ func (r *SQLiteRepository) maybeRefreshSWR(spec swrSpec) {
 	}
 
 	ts, err := spec.syncedAt()
-	if !swrStale(ts, err, changed, eventDriven, r.stalenessThreshold) {
+	stale := swrStale(ts, err, changed, eventDriven, r.staleness.ttl(spec.kind, r.stalenessThreshold, r.catchUp))
+	if !stale && eventDriven {
+		if age := r.staleness.maxAge(spec.kind); age > 0 {
+			stale = staleSince(ts, err, age)
+		}
+	}
+	if !stale {
 		r.metrics.recordTrigger(spec.kind, "fresh")
 		return
 	}
 
-	r.triggerBackgroundRefresh(spec.kind, spec.id, orphanOnNotFound(spec.refresh, spec.orphan))
+	r.triggerBackgroundRefresh(spec.kind, spec.id, orphanOnNotFound(restrictOnForbidden(spec.refresh, spec.restrict), spec.orphan))
 }
 
 // issueChangedAt is the event source for issue-scoped surfaces (details,
@@ -22,8 +22,9 @@ func newSWRTestRepo(t *testing.T) *SQLiteRepository {
 		refreshing:         make(map[string]bool),
 		refreshContext:     ctx,
 		refreshCancel:      cancel,
-		refreshSem:         make(chan struct{}, maxConcurrentRefreshes),
+		refreshQueue:       make(chan refreshJob, refreshQueueSize),
 	}
+	r.startRefreshWorkers()
 	t.Cleanup(r.Close)
 	return r
 }
@@ -141,6 +142,34 @@ func TestOrphanOnNotFound(t *testing.T) {
 	}
 }
 
+// TestRestrictOnForbidden: only a forbidden-shaped refresh error marks the
+// entity restricted; every error passes through unchanged.
+func TestRestrictOnForbidden(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	forbidden := &api.GraphQLError{Message: "Forbidden", Code: "FORBIDDEN"}
+	notFound := fmt.Errorf("GraphQL error: Entity not found: Issue")
+
+	for _, refreshErr := range []error{forbidden, notFound, nil} {
+		restricted := false
+		wrapped := restrictOnForbidden(
+			func(context.Context) error { return refreshErr },
+			func(context.Context) { restricted = true },
+		)
+		if err := wrapped(ctx); err != refreshErr {
+			t.Errorf("wrapped refresh error = %v, want passthrough %v", err, refreshErr)
+		}
+		if want := refreshErr == error(forbidden); restricted != want {
+			t.Errorf("refresh error %v: restrict called = %v, want %v", refreshErr, restricted, want)
+		}
+	}
+
+	// nil restrict must be tolerated (most surfaces have nothing to mark).
+	if err := restrictOnForbidden(func(context.Context) error { return forbidden }, nil)(ctx); err != forbidden {
+		t.Errorf("nil-restrict wrapped error = %v, want %v", err, forbidden)
+	}
+}
+
 // TestMaybeRefreshSWR_NilClientNoop: in fixture mode (nil client) the
 // coordinator must short-circuit before consulting any closure.
 func TestMaybeRefreshSWR_NilClientNoop(t *testing.T) {
@@ -276,3 +305,72 @@ func TestMaybeRefreshSWR_CatchUpReachesTTLOnly(t *testing.T) {
 		t.Error("TTL refresh did not fire for 10min-old data at the default 5min threshold")
 	}
 }
+
+// TestStalenessTTL pins the per-family threshold: a configured value replaces
+// the default for its family only, and catch-up mode raises it but never
+// lowers it.
+func TestStalenessTTL(t *testing.T) {
+	t.Parallel()
+	s := Staleness{Documents: time.Minute, Updates: time.Hour}
+	cases := []struct {
+		name    string
+		s       Staleness
+		kind    refreshKind
+		base    time.Duration
+		catchUp bool
+		want    time.Duration
+	}{
+		{"unset keeps the default", Staleness{}, kindProjectDocs, defaultStalenessThreshold, false, defaultStalenessThreshold},
+		{"unset follows catch-up", Staleness{}, kindProjectDocs, catchUpStaleness, true, catchUpStaleness},
+		{"documents override", s, kindTeamDocs, defaultStalenessThreshold, false, time.Minute},
+		{"updates override", s, kindInitiativeUpdates, defaultStalenessThreshold, false, time.Hour},
+		{"other families keep the default", s, kindProjectLinks, defaultStalenessThreshold, false, defaultStalenessThreshold},
+		{"catch-up raises a short override", s, kindStandaloneDocs, catchUpStaleness, true, catchUpStaleness},
+		{"catch-up keeps a longer override", s, kindProjectUpdates, catchUpStaleness, true, time.Hour},
+	}
+	for _, c := range cases {
+		if got := c.s.ttl(c.kind, c.base, c.catchUp); got != c.want {
+			t.Errorf("%s: ttl = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+// TestMaybeRefreshSWR_CommentsMaxAge: with a comments threshold set, issue
+// details synced longer ago than it refresh even though the issue hasn't
+// changed; unset, the surface stays purely event-driven.
+func TestMaybeRefreshSWR_CommentsMaxAge(t *testing.T) {
+	t.Parallel()
+	synced := time.Now().Add(-10 * time.Minute)
+	changed := synced.Add(-time.Hour)
+	for i, c := range []struct {
+		comments time.Duration
+		want     bool
+	}{
+		{0, false},
+		{time.Hour, false},
+		{5 * time.Minute, true},
+	} {
+		repo := newSWRTestRepo(t)
+		repo.SetStaleness(Staleness{Comments: c.comments})
+		fired := make(chan struct{}, 1)
+		repo.maybeRefreshSWR(swrSpec{
+			kind:      kindIssueDetails,
+			id:        fmt.Sprintf("issue-%d", i),
+			syncedAt:  func() (interface{}, error) { return synced, nil },
+			changedAt: func() (time.Time, bool) { return changed, true },
+			refresh: func(context.Context) error {
+				fired <- struct{}{}
+				return nil
+			},
+		})
+		got := false
+		select {
+		case <-fired:
+			got = true
+		case <-time.After(200 * time.Millisecond):
+		}
+		if got != c.want {
+			t.Errorf("comments=%v: refresh fired = %v, want %v", c.comments, got, c.want)
+		}
+	}
+}
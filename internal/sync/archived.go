@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// archivedScheduleKey keys a team's archived-issues watermark — the newest
+// archivedAt already kept — in the sync_schedule table.
+func archivedScheduleKey(teamID string) string {
+	return "archived_issues:" + teamID
+}
+
+// syncArchivedIssues keeps the team's archived issues for issues/.archived/.
+// Linear's issue queries leave archived issues out, so the incremental sync
+// never sees one again once it is archived; this fetches those archived past
+// the team's watermark (all of them the first time) and keeps them in
+// archived_issues. Full cycles only: archiving is rare, and .archived/ may
+// lag by a full-sync interval.
+//
+// An issue still in the live issues table (archived since it last synced)
+// leaves it here, as a webhook's archive delivery does, instead of lingering
+// in issues/ until the hourly reconcile sweep. The watermark advances only
+// after every issue is kept, so a failed upsert is fetched again next time.
+func (w *Worker) syncArchivedIssues(ctx context.Context, team api.Team) error {
+	q := w.store.Queries()
+	key := archivedScheduleKey(team.ID)
+	since, _ := q.GetSyncSchedule(ctx, key) // missing = the zero time: fetch all
+
+	issues, err := w.client.GetTeamArchivedIssues(ctx, team.ID, since)
+	if err != nil {
+		return fmt.Errorf("fetch archived issues: %w", err)
+	}
+	newest := since
+	for _, issue := range issues {
+		params, err := db.APIIssueToDBArchivedIssue(issue)
+		if err != nil {
+			return err
+		}
+		if err := q.UpsertArchivedIssue(ctx, params); err != nil {
+			return fmt.Errorf("upsert archived issue %s: %w", issue.Identifier, err)
+		}
+		if params.ArchivedAt.After(newest) {
+			newest = params.ArchivedAt
+		}
+		if _, err := q.GetIssueByID(ctx, issue.ID); err == nil {
+			if err := q.DeleteIssue(ctx, issue.ID); err != nil {
+				log.Printf("[sync] drop archived issue %s: %v", issue.Identifier, err)
+				continue
+			}
+			w.notifyChange(Change{Entity: "issue", ID: issue.ID, Identifier: issue.Identifier, Team: team.Key, Action: "removed", Previous: &issue})
+		}
+	}
+	if newest.After(since) {
+		if err := q.UpsertSyncSchedule(ctx, db.UpsertSyncScheduleParams{Key: key, LastRun: newest}); err != nil {
+			log.Printf("[sync] persist %s watermark failed: %v", key, err)
+		}
+	}
+	if len(issues) > 0 {
+		log.Printf("[sync] team %s: kept %d archived issues", team.Key, len(issues))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// TestSyncArchivedIssues: a full cycle keeps the team's archived issues,
+// drops one that was still live from issues (reporting it removed), and
+// fetches only past its watermark after; an issue back from the archive
+// leaves archived_issues.
+func TestSyncArchivedIssues(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+	q := store.Queries()
+
+	team := &api.Team{ID: "team-1", Key: "TST"}
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	at := func(m int) *time.Time { t := base.Add(time.Duration(m) * time.Minute); return &t }
+	mock := newMockAPIClient()
+	mock.teams = []api.Team{*team}
+	mock.issuesByTeam[team.ID] = []api.Issue{{ID: "i2", Identifier: "TST-2", Team: team, UpdatedAt: base}}
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+	listener := &recordingListener{}
+	worker.SetChangeListener(listener)
+	if err := worker.SyncNow(ctx); err != nil {
+		t.Fatalf("first SyncNow: %v", err)
+	}
+
+	// TST-2 is archived; Linear's issue list stops returning it.
+	mock.issuesByTeam[team.ID] = nil
+	mock.archivedByTeam = map[string][]api.Issue{team.ID: {
+		{ID: "i1", Identifier: "TST-1", Title: "Old", Team: team, UpdatedAt: *at(1), ArchivedAt: at(1)},
+		{ID: "i2", Identifier: "TST-2", Title: "Done", Team: team, UpdatedAt: *at(2), ArchivedAt: at(2)},
+	}}
+	if err := worker.syncArchivedIssues(ctx, *team); err != nil {
+		t.Fatalf("syncArchivedIssues: %v", err)
+	}
+	rows, err := q.ListTeamArchivedIssues(ctx, team.ID)
+	if err != nil || len(rows) != 2 || rows[0].Identifier != "TST-2" {
+		t.Fatalf("archived = %+v, %v; want TST-2 then TST-1", rows, err)
+	}
+	if _, err := q.GetIssueByID(ctx, "i2"); err == nil {
+		t.Error("archived TST-2 is still a live issue")
+	}
+	if len(listener.changes) != 1 || listener.changes[0].Action != "removed" || listener.changes[0].ID != "i2" {
+		t.Errorf("changes = %+v, want TST-2 removed", listener.changes)
+	}
+	if mark, err := q.GetSyncSchedule(ctx, archivedScheduleKey(team.ID)); err != nil || !mark.Equal(*at(2)) {
+		t.Errorf("watermark = %v, %v; want %v", mark, err, *at(2))
+	}
+
+	// A later pass asks only past the watermark.
+	mock.archivedByTeam[team.ID] = append(mock.archivedByTeam[team.ID],
+		api.Issue{ID: "i3", Identifier: "TST-3", Team: team, UpdatedAt: *at(3), ArchivedAt: at(3)})
+	if err := q.DeleteArchivedIssue(ctx, "i1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := worker.syncArchivedIssues(ctx, *team); err != nil {
+		t.Fatalf("second syncArchivedIssues: %v", err)
+	}
+	if rows, _ := q.ListTeamArchivedIssues(ctx, team.ID); len(rows) != 2 || rows[0].Identifier != "TST-3" {
+		t.Errorf("archived after second pass = %+v, want TST-3 and TST-2 only", rows)
+	}
+
+	// Unarchived, TST-3 comes back through the issue list.
+	mock.issuesByTeam[team.ID] = []api.Issue{{ID: "i3", Identifier: "TST-3", Team: team, UpdatedAt: *at(4)}}
+	if err := worker.SyncNow(ctx); err != nil {
+		t.Fatalf("SyncNow: %v", err)
+	}
+	if _, err := q.GetArchivedIssueByIdentifier(ctx, "TST-3"); err == nil {
+		t.Error("unarchived TST-3 is still in archived_issues")
+	}
+	if _, err := q.GetIssueByID(ctx, "i3"); err != nil {
+		t.Errorf("unarchived TST-3 is not a live issue: %v", err)
+	}
+}
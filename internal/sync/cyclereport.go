@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// CycleCompletionReporter is told about each cycle the worker observes
+// completing, after the cycle's row (with its completedAt) is in SQLite.
+// Implemented by fs.LinearFS.ReportCompletedCycle, which posts the cycle's
+// report as the configured project/initiative updates.
+type CycleCompletionReporter interface {
+	ReportCompletedCycle(ctx context.Context, team api.Team, cycle api.Cycle) error
+}
+
+// SetCycleReporter wires the cycle completion reporter. When unset the worker
+// never reports.
+func (w *Worker) SetCycleReporter(r CycleCompletionReporter) {
+	w.cycleReporter = r
+}
+
+// cycleReportScheduleKey keys a team's report watermark — the completedAt of
+// the newest cycle already reported — in the sync_schedule table.
+func cycleReportScheduleKey(teamID string) string {
+	return "cycle_report:" + teamID
+}
+
+// maybeReportCompletedCycles reports each of the team's cycles that completed
+// past the team's watermark, oldest first. It reads SQLite only, so it rides
+// every cycle; completedAt itself arrives with the full cycle's metadata sync.
+//
+// A team seen for the first time stamps now without reporting — enabling
+// reports must not post one update per historical cycle. A failed report
+// stops the sweep with the watermark on the last success, so the next cycle
+// retries it; the watermark is stamped only after the report, so a crash in
+// between can at worst repeat one report.
+func (w *Worker) maybeReportCompletedCycles(ctx context.Context, team api.Team) {
+	if w.cycleReporter == nil {
+		return
+	}
+	q := w.store.Queries()
+	key := cycleReportScheduleKey(team.ID)
+	last, err := q.GetSyncSchedule(ctx, key)
+	if err != nil || last.IsZero() {
+		// First sight (or an unreadable ledger, where not posting is the safe
+		// direction): baseline without reporting.
+		w.stampCycleReport(ctx, key, w.now())
+		return
+	}
+
+	rows, err := q.ListTeamCycles(ctx, team.ID)
+	if err != nil {
+		log.Printf("[sync] cycle reports %s: list cycles failed: %v", team.Key, err)
+		return
+	}
+	var due []api.Cycle
+	for _, c := range db.DBCyclesToAPICycles(rows) {
+		if c.CompletedAt != nil && c.CompletedAt.After(last) {
+			due = append(due, c)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].CompletedAt.Before(*due[j].CompletedAt) })
+
+	for _, c := range due {
+		if err := w.cycleReporter.ReportCompletedCycle(ctx, team, c); err != nil {
+			log.Printf("[sync] cycle reports %s: cycle %d failed (retrying next cycle): %v", team.Key, c.Number, err)
+			return
+		}
+		log.Printf("[sync] cycle reports %s: reported cycle %d", team.Key, c.Number)
+		w.stampCycleReport(ctx, key, *c.CompletedAt)
+	}
+}
+
+// stampCycleReport advances a team's report watermark.
+func (w *Worker) stampCycleReport(ctx context.Context, key string, at time.Time) {
+	if err := w.store.Queries().UpsertSyncSchedule(ctx, db.UpsertSyncScheduleParams{
+		Key:     key,
+		LastRun: at,
+	}); err != nil {
+		log.Printf("[sync] persist %s watermark failed: %v", key, err)
+	}
+}
@@ -0,0 +1,84 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// recordingReporter is a CycleCompletionReporter that records the cycle
+// numbers it reported, failing while fail is set.
+type recordingReporter struct {
+	reported []int
+	fail     bool
+}
+
+func (r *recordingReporter) ReportCompletedCycle(_ context.Context, _ api.Team, cycle api.Cycle) error {
+	if r.fail {
+		return errors.New("boom")
+	}
+	r.reported = append(r.reported, cycle.Number)
+	return nil
+}
+
+// TestCycleReportsOncePerCompletion scripts cycle completions against the
+// fake clock: a first sight baselines (so a cycle completed before it is never
+// reported), each cycle completed after it reports exactly once and oldest
+// first, and a failed report stays due.
+func TestCycleReportsOncePerCompletion(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	worker, _, clock := cycleTestWorker(t, store)
+	reporter := &recordingReporter{}
+	worker.SetCycleReporter(reporter)
+	team := api.Team{ID: "team-1", Key: "TST"}
+
+	upsertCycle := func(number int, completedAt *time.Time) {
+		t.Helper()
+		params, err := db.APICycleToDBCycle(api.Cycle{ID: fmt.Sprintf("cycle-%d", number), Number: number, CompletedAt: completedAt}, team.ID)
+		if err != nil {
+			t.Fatalf("APICycleToDBCycle: %v", err)
+		}
+		if err := store.Queries().UpsertCycle(ctx, params); err != nil {
+			t.Fatalf("UpsertCycle: %v", err)
+		}
+	}
+	at := func(d time.Duration) *time.Time {
+		ts := clock.now().Add(d)
+		return &ts
+	}
+
+	upsertCycle(1, at(-24*time.Hour)) // completed before reports were enabled
+	worker.maybeReportCompletedCycles(ctx, team)
+	if len(reporter.reported) != 0 {
+		t.Fatalf("first sight reported %v, want a baseline only", reporter.reported)
+	}
+
+	clock.advance(time.Hour)
+	upsertCycle(3, at(-10*time.Minute))
+	upsertCycle(2, at(-20*time.Minute))
+	upsertCycle(4, nil) // still running
+	worker.maybeReportCompletedCycles(ctx, team)
+	worker.maybeReportCompletedCycles(ctx, team)
+	if got := reporter.reported; len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Fatalf("reported %v, want [2 3] once each", got)
+	}
+
+	clock.advance(time.Hour)
+	upsertCycle(4, at(-time.Minute))
+	reporter.fail = true
+	worker.maybeReportCompletedCycles(ctx, team)
+	reporter.fail = false
+	worker.maybeReportCompletedCycles(ctx, team)
+	if got := reporter.reported; len(got) != 3 || got[2] != 4 {
+		t.Fatalf("after a failed report, reported %v, want the retry to report 4", got)
+	}
+}
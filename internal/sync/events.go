@@ -0,0 +1,51 @@
+package sync
+
+import "github.com/jra3/linear-fuse/internal/api"
+
+// Change is one entity change written to SQLite — by the worker, or by the
+// webhook listener (internal/webhook), which reports through the same seam.
+type Change struct {
+	Entity     string // "issue", "comment", or "project"
+	ID         string
+	Identifier string // human key (ENG-123); "" for entities without one
+	Team       string // team key
+	Action     string // "created", "updated", or "removed"
+
+	// Issue is the issue as just written; Previous is the row it replaced
+	// (nil on "created", or when the old row could not be decoded). Together
+	// they tell the listener which listings the issue moved between. On an
+	// issue "removed", Issue is nil and Previous is the row that was deleted.
+	Issue, Previous *api.Issue
+
+	// IssueID is a comment's issue; Comment is the comment as written
+	// (tombstoned on "removed").
+	IssueID string
+	Comment *api.Comment
+
+	// Project is the project as written, or as deleted on "removed"; TeamIDs
+	// are the teams whose projects/ list it.
+	Project *api.Project
+	TeamIDs []string
+}
+
+// ChangeListener is told about each change after its row is in SQLite, so a
+// listener that reads the cache back sees the new state. Implemented by
+// fs.LinearFS.Changed, which invalidates the kernel's caches of what changed
+// and feeds the .events long-poll file. Called on the sync goroutine (or a
+// webhook request's), so an implementation must return promptly.
+type ChangeListener interface {
+	Changed(c Change)
+}
+
+// SetChangeListener wires the change listener. When unset the worker reports
+// nothing.
+func (w *Worker) SetChangeListener(l ChangeListener) {
+	w.changes = l
+}
+
+// notifyChange reports c to the listener, if any.
+func (w *Worker) notifyChange(c Change) {
+	if w.changes != nil {
+		w.changes.Changed(c)
+	}
+}
@@ -0,0 +1,127 @@
+package sync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// recordingListener is a ChangeListener that records what it was told.
+type recordingListener struct {
+	changes []Change
+}
+
+func (r *recordingListener) Changed(c Change) { r.changes = append(r.changes, c) }
+
+// TestSyncReportsIssueChanges pins the .events feed's source: a team's first
+// sync (the cache filling) reports nothing, and a later one reports each new
+// or updated issue once, with its action.
+func TestSyncReportsIssueChanges(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	teamID := "team-1"
+	team := &api.Team{ID: teamID, Key: "TST"}
+	base := time.Now().Add(-time.Hour)
+	mock := newMockAPIClient()
+	mock.teams = []api.Team{*team}
+	mock.issuesByTeam[teamID] = []api.Issue{
+		{ID: "i1", Identifier: "TST-1", Team: team, UpdatedAt: base},
+	}
+
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+	listener := &recordingListener{}
+	worker.SetChangeListener(listener)
+	if err := worker.SyncNow(ctx); err != nil {
+		t.Fatalf("first SyncNow: %v", err)
+	}
+	if len(listener.changes) != 0 {
+		t.Fatalf("first sync reported %+v, want nothing", listener.changes)
+	}
+
+	mock.issuesByTeam[teamID] = []api.Issue{
+		{ID: "i2", Identifier: "TST-2", Team: team, UpdatedAt: base.Add(2 * time.Minute)},
+		{ID: "i1", Identifier: "TST-1", Team: team, UpdatedAt: base.Add(time.Minute)},
+	}
+	if err := worker.SyncNow(ctx); err != nil {
+		t.Fatalf("second SyncNow: %v", err)
+	}
+	want := []Change{
+		{Entity: "issue", ID: "i2", Identifier: "TST-2", Team: "TST", Action: "created"},
+		{Entity: "issue", ID: "i1", Identifier: "TST-1", Team: "TST", Action: "updated"},
+	}
+	if len(listener.changes) != len(want) {
+		t.Fatalf("changes = %+v, want %+v", listener.changes, want)
+	}
+	for i := range want {
+		got := listener.changes[i]
+		if got.Issue == nil || got.Issue.ID != want[i].ID {
+			t.Errorf("change %d carries issue %+v", i, got.Issue)
+		}
+		// A created issue has nothing before it; an updated one carries the
+		// row it replaced, so the listener can diff listings.
+		if (got.Action == "created") != (got.Previous == nil) {
+			t.Errorf("change %d (%s) previous = %+v", i, got.Action, got.Previous)
+		}
+		got.Issue, got.Previous = nil, nil
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("change %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+// TestSyncRecordsActivity: /activity/ is fed like .events, with or without a
+// listener — nothing from the first sync, then a new issue's creation and an
+// existing issue's state change.
+func TestSyncRecordsActivity(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	teamID := "team-1"
+	team := &api.Team{ID: teamID, Key: "TST"}
+	base := time.Now().UTC().Add(-time.Hour)
+	todo, done := api.State{ID: "s-todo", Name: "Todo"}, api.State{ID: "s-done", Name: "Done"}
+	mock := newMockAPIClient()
+	mock.teams = []api.Team{*team}
+	mock.issuesByTeam[teamID] = []api.Issue{
+		{ID: "i1", Identifier: "TST-1", Team: team, State: todo, CreatedAt: base, UpdatedAt: base},
+	}
+
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+	if err := worker.SyncNow(ctx); err != nil {
+		t.Fatalf("first SyncNow: %v", err)
+	}
+	if days, _ := store.Queries().ListActivityDays(ctx); len(days) != 0 {
+		t.Fatalf("first sync recorded activity on %v", days)
+	}
+
+	mock.issuesByTeam[teamID] = []api.Issue{
+		{ID: "i2", Identifier: "TST-2", Team: team, State: todo, CreatedAt: base.Add(2 * time.Minute), UpdatedAt: base.Add(2 * time.Minute)},
+		{ID: "i1", Identifier: "TST-1", Team: team, State: done, CreatedAt: base, UpdatedAt: base.Add(time.Minute)},
+	}
+	if err := worker.SyncNow(ctx); err != nil {
+		t.Fatalf("second SyncNow: %v", err)
+	}
+	var got []string
+	days, _ := store.Queries().ListActivityDays(ctx)
+	for _, day := range days {
+		rows, err := store.Queries().ListActivityByDay(ctx, day)
+		if err != nil {
+			t.Fatalf("ListActivityByDay: %v", err)
+		}
+		for _, r := range rows {
+			got = append(got, r.Kind+" "+r.Identifier)
+		}
+	}
+	want := []string{"state TST-1", "created TST-2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("activity = %v, want %v", got, want)
+	}
+}
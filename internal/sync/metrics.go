@@ -25,6 +25,7 @@ import (
 // syncMetrics holds the Worker-bound sync instruments (meter "linearfs/sync").
 type syncMetrics struct {
 	cycleDuration      metric.Float64Histogram // linearfs.sync.cycle_duration {mode}, seconds
+	teamDuration       metric.Float64Histogram // linearfs.sync.team_duration {team, outcome}, seconds
 	detailOutcomes     metric.Int64Counter     // linearfs.sync.detail_outcomes {outcome}
 	probeOutcomes      metric.Int64Counter     // linearfs.sync.probe_outcomes {kind, outcome}
 	reconcileDeletions metric.Int64Counter     // linearfs.sync.reconcile_deletions {kind}
@@ -36,6 +37,9 @@ func newSyncMetrics() syncMetrics {
 		cycleDuration: telemetry.MustFloat64Histogram(m, "linearfs.sync.cycle_duration",
 			metric.WithUnit("s"),
 			metric.WithDescription("Duration of one sync cycle, by mode (lean|full); budget-skipped cycles record ~0")),
+		teamDuration: telemetry.MustFloat64Histogram(m, "linearfs.sync.team_duration",
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of one team's issue sync within a cycle, by team key and outcome (ok|error)")),
 		detailOutcomes: telemetry.MustInt64Counter(m, "linearfs.sync.detail_outcomes",
 			metric.WithDescription("Issues leaving syncDetails' per-issue ledger, by outcome (synced|deferred)")),
 		probeOutcomes: telemetry.MustInt64Counter(m, "linearfs.sync.probe_outcomes",
@@ -71,6 +75,18 @@ func (sm syncMetrics) recordCycle(d time.Duration, mode cycleMode) {
 		metric.WithAttributes(attribute.String("mode", string(mode))))
 }
 
+// recordTeam records one team's issue sync, attributed with the team key (a
+// label bounded by the mount's teams) and whether it failed.
+func (sm syncMetrics) recordTeam(d time.Duration, teamKey string, err error) {
+	outcome := "ok"
+	if err != nil {
+		outcome = "error"
+	}
+	sm.teamDuration.Record(context.Background(), d.Seconds(), metric.WithAttributes(
+		attribute.String("team", teamKey),
+		attribute.String("outcome", outcome)))
+}
+
 // recordDetailOutcomes counts issues leaving syncDetails' ledger. Every issue
 // lands in exactly one outcome, so summing both series gives issues processed.
 func (sm syncMetrics) recordDetailOutcomes(ctx context.Context, synced, deferred int) {
@@ -126,3 +142,33 @@ func registerPendingDepthGauge(q *db.Queries) {
 		log.Printf("telemetry: pending_depth callback not registered: %v", err)
 	}
 }
+
+// registerRowCountGauge installs the linearfs.db.rows observable gauge: the
+// cache's entity tables' row counts, counted at export intervals like
+// pending_depth. A count error skips the observation.
+func registerRowCountGauge(q *db.Queries) {
+	meter := otel.Meter("linearfs/sync")
+	rows, err := meter.Int64ObservableGauge("linearfs.db.rows",
+		metric.WithDescription("Rows in the SQLite cache, by table (issues|comments|documents|projects|initiatives|attachments|users|embedded_files)"))
+	if err != nil {
+		log.Printf("telemetry: db.rows gauge not registered: %v", err)
+		return
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		counts, err := q.CountCachedRows(ctx)
+		if err != nil {
+			return nil // skip this observation; the collect must not fail on a DB hiccup
+		}
+		for table, n := range map[string]int64{
+			"issues": counts.Issues, "comments": counts.Comments, "documents": counts.Documents,
+			"projects": counts.Projects, "initiatives": counts.Initiatives, "attachments": counts.Attachments,
+			"users": counts.Users, "embedded_files": counts.EmbeddedFiles,
+		} {
+			o.ObserveInt64(rows, n, metric.WithAttributes(attribute.String("table", table)))
+		}
+		return nil
+	}, rows)
+	if err != nil {
+		log.Printf("telemetry: db.rows callback not registered: %v", err)
+	}
+}
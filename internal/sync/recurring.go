@@ -0,0 +1,112 @@
+package sync
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/cron"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// RecurringSchedule is one `recurring:` definition as the worker sees it: the
+// name that keys its ledger, its schedule, and the zone the schedule is read
+// in (nil = the mount's local time). What an occurrence creates is the
+// RecurringIssueCreator's business.
+type RecurringSchedule struct {
+	Name     string
+	Schedule *cron.Schedule
+	Location *time.Location
+}
+
+// RecurringIssueCreator creates the issue for one occurrence of a recurring
+// definition, through the same create tail as issues/_create (persist, .last,
+// kernel invalidation). Implemented by fs.LinearFS.CreateRecurringIssue.
+type RecurringIssueCreator interface {
+	CreateRecurringIssue(ctx context.Context, name string, occurrence time.Time) (*api.Issue, error)
+}
+
+// SetRecurringIssues wires the recurring definitions and their creator. When
+// unset (or empty) the worker never creates anything.
+func (w *Worker) SetRecurringIssues(creator RecurringIssueCreator, schedules []RecurringSchedule) {
+	w.recurring = creator
+	w.recurringSchedules = schedules
+}
+
+// recurringScheduleKey keys a definition's watermark — the latest occurrence
+// already handled — in the sync_schedule table, restart-safe like the
+// full-cycle stamp.
+func recurringScheduleKey(name string) string {
+	return "recurring:" + name
+}
+
+// maybeCreateRecurringIssues creates the issue for each definition whose
+// latest occurrence (at or before now) is past its persisted watermark. It
+// rides every cycle, so an occurrence fires within one sync interval.
+//
+// Occurrences missed while the mount was down collapse into one: only the
+// latest is created, never a backlog. A definition seen for the first time
+// stamps its current occurrence without creating — adding a weekly
+// definition on a Wednesday waits for next Monday rather than firing at once.
+// A failed create leaves the watermark alone, so the next cycle retries; the
+// watermark is stamped only after the create tail has persisted the issue
+// and the audit row, so a crash in between can at worst repeat one creation.
+func (w *Worker) maybeCreateRecurringIssues(ctx context.Context) {
+	if w.recurring == nil {
+		return
+	}
+	q := w.store.Queries()
+	now := w.now()
+	for _, def := range w.recurringSchedules {
+		loc := def.Location
+		if loc == nil {
+			loc = time.Local
+		}
+		occurrence := def.Schedule.Prev(now.In(loc))
+		if occurrence.IsZero() {
+			continue
+		}
+		key := recurringScheduleKey(def.Name)
+		last, err := q.GetSyncSchedule(ctx, key)
+		if err != nil || last.IsZero() {
+			// First sight (or an unreadable ledger, where not creating is the
+			// safe direction): baseline without creating.
+			w.stampRecurring(ctx, key, occurrence)
+			continue
+		}
+		if !occurrence.After(last) {
+			continue
+		}
+
+		issue, err := w.recurring.CreateRecurringIssue(ctx, def.Name, occurrence)
+		if err != nil {
+			log.Printf("[sync] recurring %q: create for %s failed (retrying next cycle): %v", def.Name, occurrence.Format(time.RFC3339), err)
+			continue
+		}
+		if err := q.InsertRecurringIssueLog(ctx, db.InsertRecurringIssueLogParams{
+			Name:       def.Name,
+			Occurrence: occurrence,
+			IssueID:    issue.ID,
+			Identifier: issue.Identifier,
+			CreatedAt:  now,
+		}); err != nil {
+			// intentionally best-effort: the issue exists and the watermark
+			// below still prevents a duplicate; only the audit row is lost
+			// (recovers via the issue itself, which is in issues/.last).
+			log.Printf("[sync] recurring %q: audit log insert for %s failed: %v", def.Name, issue.Identifier, err)
+		}
+		log.Printf("[sync] recurring %q: created %s for %s", def.Name, issue.Identifier, occurrence.Format(time.RFC3339))
+		w.stampRecurring(ctx, key, occurrence)
+	}
+}
+
+// stampRecurring advances a definition's watermark.
+func (w *Worker) stampRecurring(ctx context.Context, key string, occurrence time.Time) {
+	if err := w.store.Queries().UpsertSyncSchedule(ctx, db.UpsertSyncScheduleParams{
+		Key:     key,
+		LastRun: occurrence,
+	}); err != nil {
+		log.Printf("[sync] persist %s watermark failed: %v", key, err)
+	}
+}
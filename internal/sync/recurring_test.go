@@ -0,0 +1,87 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/cron"
+)
+
+// recordingCreator is a RecurringIssueCreator that records each occurrence it
+// was asked for, failing while fail is set.
+type recordingCreator struct {
+	calls []time.Time
+	fail  bool
+}
+
+func (c *recordingCreator) CreateRecurringIssue(_ context.Context, name string, occurrence time.Time) (*api.Issue, error) {
+	if c.fail {
+		return nil, errors.New("boom")
+	}
+	c.calls = append(c.calls, occurrence)
+	n := len(c.calls)
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", n), Identifier: fmt.Sprintf("TST-%d", n)}, nil
+}
+
+// TestRecurringIssuesFireOncePerOccurrence scripts the recurring schedule
+// against the fake clock: a first sight baselines without creating, each new
+// occurrence creates exactly once (with an audit row), a failed create stays
+// due, and occurrences missed while down collapse into one.
+func TestRecurringIssuesFireOncePerOccurrence(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	worker, _, clock := cycleTestWorker(t, store)
+	hourly, err := cron.Parse("@hourly")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	creator := &recordingCreator{}
+	worker.SetRecurringIssues(creator, []RecurringSchedule{{Name: "standup", Schedule: hourly, Location: time.UTC}})
+
+	worker.maybeCreateRecurringIssues(ctx) // 12:00: first sight
+	if len(creator.calls) != 0 {
+		t.Fatalf("first sight created %v, want a baseline only", creator.calls)
+	}
+	clock.advance(30 * time.Minute) // 12:30: same occurrence
+	worker.maybeCreateRecurringIssues(ctx)
+	if len(creator.calls) != 0 {
+		t.Fatalf("same occurrence created %v", creator.calls)
+	}
+
+	clock.advance(31 * time.Minute) // 13:01: the 13:00 occurrence
+	worker.maybeCreateRecurringIssues(ctx)
+	worker.maybeCreateRecurringIssues(ctx)
+	if len(creator.calls) != 1 {
+		t.Fatalf("13:00 occurrence created %d times, want once", len(creator.calls))
+	}
+
+	clock.advance(time.Hour) // 14:01, but the create fails: stays due
+	creator.fail = true
+	worker.maybeCreateRecurringIssues(ctx)
+	creator.fail = false
+	worker.maybeCreateRecurringIssues(ctx)
+	if len(creator.calls) != 2 {
+		t.Fatalf("after a failed create, calls = %d, want the retry to create", len(creator.calls))
+	}
+
+	clock.advance(5 * time.Hour) // 19:01: five missed occurrences
+	worker.maybeCreateRecurringIssues(ctx)
+	if len(creator.calls) != 3 {
+		t.Fatalf("missed occurrences created %d issues, want one", len(creator.calls)-2)
+	}
+
+	log, err := store.Queries().ListRecurringIssueLog(ctx, "standup")
+	if err != nil {
+		t.Fatalf("ListRecurringIssueLog: %v", err)
+	}
+	if len(log) != 3 || log[0].Identifier != "TST-3" || !log[0].Occurrence.Equal(creator.calls[2]) {
+		t.Errorf("audit log = %+v, want three rows, newest TST-3 at %v", log, creator.calls[2])
+	}
+}
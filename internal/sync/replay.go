@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// MutationReplayer sends a write queued while Linear was unreachable and
+// brings the local cache up to date with the result, or undoes the write's
+// local effect once it is given up on. Implemented by fs.LinearFS
+// (ReplayMutation / DropMutation), which queued it.
+type MutationReplayer interface {
+	ReplayMutation(ctx context.Context, m db.MutationQueue) error
+	DropMutation(ctx context.Context, m db.MutationQueue, cause error)
+}
+
+// maxMutationAttempts bounds the replays of a queued write Linear rejects
+// (bad input, a deleted issue): past it the write is dropped rather than
+// blocking its issue's later writes for good.
+const maxMutationAttempts = 3
+
+// SetMutationReplayer wires the mutation replayer. When unset the worker never
+// touches the queue.
+func (w *Worker) SetMutationReplayer(r MutationReplayer) {
+	w.replayer = r
+}
+
+// replayMutations replays the mutation queue, oldest first, at the top of
+// every cycle — so writes made offline reach Linear within one sync interval
+// of it answering again, and before the cycle's fetches read the issues back.
+//
+// A replayed write leaves the queue. A write that again could not be sent
+// (unreachable, rate-limited, deferred) stops the pass: everything after it is
+// as stuck, and the next cycle retries in order. A write Linear rejects counts
+// an attempt and holds its issue's later writes until the next cycle; at
+// maxMutationAttempts it is dropped and its local effect undone.
+func (w *Worker) replayMutations(ctx context.Context) {
+	if w.replayer == nil {
+		return
+	}
+	q := w.store.Queries()
+	queued, err := q.ListQueuedMutations(ctx)
+	if err != nil {
+		log.Printf("[sync] list queued mutations failed: %v", err)
+		return
+	}
+	held := make(map[string]bool)
+	for _, m := range queued {
+		if held[m.EntityID] {
+			continue
+		}
+		err := w.replayer.ReplayMutation(ctx, m)
+		switch {
+		case err == nil:
+			log.Printf("[sync] replayed queued %s on %s", m.Kind, m.Label)
+			if err := q.DeleteQueuedMutation(ctx, m.ID); err != nil {
+				log.Printf("[sync] dequeue mutation %d failed: %v", m.ID, err)
+			}
+		case api.IsUnreachable(err) || api.IsRateLimited(err) || api.IsDeferred(err) || ctx.Err() != nil:
+			log.Printf("[sync] replay of queued %s on %s deferred: %v", m.Kind, m.Label, err)
+			return
+		case m.Attempts+1 >= maxMutationAttempts:
+			log.Printf("[sync] dropping queued %s on %s after %d rejections: %v", m.Kind, m.Label, m.Attempts+1, err)
+			if err := q.DeleteQueuedMutation(ctx, m.ID); err != nil {
+				log.Printf("[sync] dequeue mutation %d failed: %v", m.ID, err)
+			}
+			w.replayer.DropMutation(ctx, m, err)
+		default:
+			log.Printf("[sync] queued %s on %s rejected: %v", m.Kind, m.Label, err)
+			if err := q.RecordMutationAttempt(ctx, db.RecordMutationAttemptParams{
+				LastError: sql.NullString{String: err.Error(), Valid: true},
+				ID:        m.ID,
+			}); err != nil {
+				log.Printf("[sync] record mutation attempt %d failed: %v", m.ID, err)
+			}
+			held[m.EntityID] = true
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+)
+
+// fakeReplayer answers each replay with the error queued for its issue (nil
+// when none) and records what it replayed and dropped.
+type fakeReplayer struct {
+	errs     map[string]error
+	replayed []string
+	dropped  []string
+}
+
+func (f *fakeReplayer) ReplayMutation(ctx context.Context, m db.MutationQueue) error {
+	f.replayed = append(f.replayed, fmt.Sprintf("%s/%s", m.EntityID, m.Kind))
+	return f.errs[m.EntityID]
+}
+
+func (f *fakeReplayer) DropMutation(ctx context.Context, m db.MutationQueue, cause error) {
+	f.dropped = append(f.dropped, fmt.Sprintf("%s/%s", m.EntityID, m.Kind))
+}
+
+func queueTestMutation(t *testing.T, store *db.Store, issueID, kind string) {
+	t.Helper()
+	if _, err := store.QueueMutation(context.Background(), kind, issueID, issueID, struct{}{}); err != nil {
+		t.Fatalf("QueueMutation: %v", err)
+	}
+}
+
+func queuedIssues(t *testing.T, store *db.Store) []string {
+	t.Helper()
+	queued, err := store.Queries().ListQueuedMutations(context.Background())
+	if err != nil {
+		t.Fatalf("ListQueuedMutations: %v", err)
+	}
+	var ids []string
+	for _, m := range queued {
+		ids = append(ids, m.EntityID)
+	}
+	return ids
+}
+
+// TestReplayMutations: replayed writes leave the queue; a rejected write
+// counts an attempt and holds its issue's later writes, and is dropped at the
+// bound; an unreachable replay stops the pass with the rest still queued.
+func TestReplayMutations(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	queueTestMutation(t, store, "ok", db.MutationIssueUpdate)
+	queueTestMutation(t, store, "bad", db.MutationIssueUpdate)
+	queueTestMutation(t, store, "bad", db.MutationCommentCreate)
+	queueTestMutation(t, store, "ok", db.MutationCommentCreate)
+
+	replayer := &fakeReplayer{errs: map[string]error{"bad": errors.New("Entity not found")}}
+	worker := NewWorker(newMockAPIClient(), store, Config{Interval: time.Hour})
+	worker.SetMutationReplayer(replayer)
+
+	worker.replayMutations(ctx)
+	if got := fmt.Sprint(replayer.replayed); got != "[ok/issue-update bad/issue-update ok/comment-create]" {
+		t.Errorf("replayed = %s, want bad's comment held behind its rejected update", got)
+	}
+	if got := fmt.Sprint(queuedIssues(t, store)); got != "[bad bad]" {
+		t.Errorf("queue = %s, want only bad's writes left", got)
+	}
+
+	for i := 1; i < maxMutationAttempts; i++ {
+		worker.replayMutations(ctx)
+	}
+	if got := fmt.Sprint(replayer.dropped); got != "[bad/issue-update]" {
+		t.Errorf("dropped = %s, want the update dropped at the bound", got)
+	}
+
+	replayer.errs["bad"] = fmt.Errorf("circuit breaker open: %w", api.ErrUnreachable)
+	replayer.replayed = nil
+	worker.replayMutations(ctx)
+	if got := fmt.Sprint(replayer.replayed); got != "[bad/comment-create]" {
+		t.Errorf("replayed = %s, want one attempt", got)
+	}
+	queued, _ := store.Queries().ListQueuedMutations(ctx)
+	if len(queued) != 1 || queued[0].Attempts != 1 {
+		t.Errorf("queue = %+v, want the comment kept, the unreachable replay not counted", queued)
+	}
+}
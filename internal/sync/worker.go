@@ -8,8 +8,10 @@ package sync
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -25,6 +27,12 @@ type APIClient interface {
 	GetTeams(ctx context.Context) ([]api.Team, error)
 	GetTeamIssuesPage(ctx context.Context, teamID string, cursor string, pageSize int) ([]api.Issue, api.PageInfo, error)
 
+	// Complexity-aware sizes for the bulk paths (api/batchsize.go): the page
+	// size for the next GetTeamIssuesPage and the issue count for the next
+	// GetIssueDetailsBatch, learned from measured per-query complexity.
+	IssuesPageSize() int
+	DetailsBatchSize() int
+
 	// Consolidated team metadata (states, labels, cycles, projects, members in one call)
 	GetTeamMetadata(ctx context.Context, teamID string) (*api.TeamMetadata, error)
 
@@ -57,6 +65,15 @@ type APIClient interface {
 	// seam and are mock-drivable in tests.
 	GetTeamIssueIDs(ctx context.Context, teamID string) ([]string, error)
 
+	// A team's issues archived after since (all of them for the zero time),
+	// complete or an error — see syncArchivedIssues.
+	GetTeamArchivedIssues(ctx context.Context, teamID string, since time.Time) ([]api.Issue, error)
+
+	// Bulk-sync field projection (api.lazy_issue_fields): the heavy fields
+	// GetTeamIssuesPage and GetIssueDetailsBatch leave out. The worker keeps
+	// the cached values of a projected-out field rather than blanking them.
+	LazyIssueFields() api.LazyIssueFields
+
 	// Auth
 	AuthHeader() string
 
@@ -72,15 +89,6 @@ type APIClient interface {
 	RateLimitResetAt() time.Time
 }
 
-// detailsBatchSize is the number of issues per batched details fetch. Linear
-// caps a SINGLE query at 10k complexity, and the per-issue details selection
-// has grown to ~860 points (measured live 2026-07-10: a batch of 15 scored
-// 12,897 and was rejected with "Query too complex" — silently unnoticed for
-// days because the budget ladder was starving detail sync entirely, #239).
-// 10 × ~860 ≈ 8.6k leaves headroom for the selection to grow a little; if a
-// batch is ever rejected again, this constant is the first suspect.
-const detailsBatchSize = 10
-
 // Budget thresholds for rate limit awareness.
 // Detail batches (~2001 complexity each) are expensive; we defer them when budget is tight.
 const (
@@ -117,9 +125,12 @@ type Worker struct {
 	extractor        *reconcile.Extractor // embedded-file extraction (HEAD + upsert)
 	interval         time.Duration
 	fullSyncInterval time.Duration // minimum time between full cycles (see cycleMode)
+	teams            []string      // team keys to sync; empty = every team
+	excludeTeams     []string      // team keys never synced
 
 	stopCh   chan struct{}
 	doneCh   chan struct{}
+	nowCh    chan struct{} // TriggerSync requests; buffered 1, so requests coalesce
 	mu       sync.RWMutex
 	running  bool
 	lastSync time.Time
@@ -129,6 +140,19 @@ type Worker struct {
 	cycle    atomic.Int64       // sync-cycle counter; rotates the team order
 	metrics  syncMetrics        // sync-layer instruments, bound at construction
 
+	// Config-defined recurring issues (optional; see recurring.go).
+	recurring          RecurringIssueCreator
+	recurringSchedules []RecurringSchedule
+
+	// Cycle completion reports (optional; see cyclereport.go).
+	cycleReporter CycleCompletionReporter
+
+	// Change notifications for the .events file (optional; see events.go).
+	changes ChangeListener
+
+	// Offline write replay (optional; see replay.go).
+	replayer MutationReplayer
+
 	// Clock seam: EVERY timing decision in this file goes through these
 	// three fields — no bare time-package clock calls (Now/Since/Until/
 	// NewTimer/NewTicker), the greppable rule; see clock.go and CONTEXT.md
@@ -155,6 +179,12 @@ type Config struct {
 	FullSyncInterval time.Duration
 	// PageSize for API pagination (default: 100)
 	PageSize int
+	// Teams limits sync to these team keys (the `teams:` config); empty
+	// syncs every team the credential can see.
+	Teams []string
+	// ExcludeTeams are team keys never synced (the `exclude_teams:` config),
+	// even when Teams lists them.
+	ExcludeTeams []string
 }
 
 // DefaultConfig returns a Config with default values
@@ -177,14 +207,18 @@ func NewWorker(client APIClient, store *db.Store, cfg Config) *Worker {
 	// The observable pending-depth gauge registers here too: construction is
 	// the sync layer's one binding point (phase-2 pattern).
 	registerPendingDepthGauge(store.Queries())
+	registerRowCountGauge(store.Queries())
 	return &Worker{
 		client:           client,
 		store:            store,
 		extractor:        &reconcile.Extractor{Q: store.Queries(), CDN: api.NewCDNClient(client.AuthHeader)},
 		interval:         cfg.Interval,
 		fullSyncInterval: cfg.FullSyncInterval,
+		teams:            cfg.Teams,
+		excludeTeams:     cfg.ExcludeTeams,
 		stopCh:           make(chan struct{}),
 		doneCh:           make(chan struct{}),
+		nowCh:            make(chan struct{}, 1),
 		metrics:          newSyncMetrics(),
 		now:              realNow,
 		newTimer:         realNewTimer,
@@ -256,6 +290,19 @@ func (w *Worker) SyncNow(ctx context.Context) error {
 	return w.syncCycle(ctx, cycleFull)
 }
 
+// TriggerSync asks the run loop for a SyncNow without waiting for it. The
+// cycle runs on the worker's goroutine once any cycle in flight finishes, so
+// it never overlaps a scheduled one. Requests made while one is pending
+// coalesce; TriggerSync reports whether this call queued a new one.
+func (w *Worker) TriggerSync() bool {
+	select {
+	case w.nowCh <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
 func (w *Worker) run(ctx context.Context) {
 	defer func() {
 		w.mu.Lock()
@@ -290,6 +337,11 @@ func (w *Worker) run(ctx context.Context) {
 			if err := w.syncAllTeams(ctx); err != nil {
 				log.Printf("[sync] sync failed: %v", err)
 			}
+		case <-w.nowCh:
+			log.Printf("[sync] sync requested")
+			if err := w.SyncNow(ctx); err != nil {
+				log.Printf("[sync] requested sync failed: %v", err)
+			}
 		}
 	}
 }
@@ -381,6 +433,11 @@ func (w *Worker) syncCycle(ctx context.Context, mode cycleMode) error {
 	start := w.now()
 	defer func() { w.metrics.recordCycle(w.now().Sub(start), mode) }()
 
+	// Writes queued while Linear was unreachable go first, ahead of the
+	// budget skip: they are the user's, and the client's admission ladder
+	// already reserves budget for mutations.
+	w.replayMutations(ctx)
+
 	// Skip entire sync cycle when budget is critically high
 	if w.budgetExceeds(budgetSkipSyncPct) {
 		count, pct := 0, 0.0
@@ -413,6 +470,11 @@ func (w *Worker) syncCycle(ctx context.Context, mode cycleMode) error {
 	if err != nil {
 		return fmt.Errorf("get teams: %w", err)
 	}
+	if len(w.teams) > 0 || len(w.excludeTeams) > 0 {
+		teams = slices.DeleteFunc(teams, func(t api.Team) bool {
+			return len(w.teams) > 0 && !slices.Contains(w.teams, t.Key) || slices.Contains(w.excludeTeams, t.Key)
+		})
+	}
 
 	// Rotate the starting team each cycle. Teams sync in order against one
 	// token bucket, so under budget pressure the deferrals always land on
@@ -445,6 +507,9 @@ func (w *Worker) syncCycle(ctx context.Context, mode cycleMode) error {
 			if err := w.syncTeamMetadata(ctx, team); err != nil {
 				log.Printf("[sync] sync team %s metadata failed: %v", team.Key, err)
 			}
+			if err := w.syncArchivedIssues(ctx, team); err != nil {
+				log.Printf("[sync] sync team %s archived issues failed: %v", team.Key, err)
+			}
 		} else {
 			if err := w.probeTeamProjects(ctx, team); err != nil {
 				log.Printf("[sync] projects probe %s failed: %v", team.Key, err)
@@ -456,6 +521,10 @@ func (w *Worker) syncCycle(ctx context.Context, mode cycleMode) error {
 			log.Printf("[sync] sync team %s failed: %v", team.Key, err)
 			// Continue with other teams
 		}
+
+		// Cycle completion reports: after the issues sync, so a report built
+		// from SQLite sees the cycle's issues as fresh as this cycle made them.
+		w.maybeReportCompletedCycles(ctx, team)
 	}
 
 	// Scheduled issue-ID reconcile sweep: rides the cycle (any speed) and
@@ -464,6 +533,20 @@ func (w *Worker) syncCycle(ctx context.Context, mode cycleMode) error {
 	// (the early returns above) leaves the sweep due too.
 	w.maybeReconcileIssueIDs(ctx)
 
+	// Recurring issues: also after the team loop, so a skipped cycle leaves
+	// a due occurrence due. Each definition's watermark decides for itself.
+	w.maybeCreateRecurringIssues(ctx)
+
+	// Mention index for backlinks.md: after the team loop, so it covers
+	// every text this cycle synced. Incremental — only texts synced since
+	// they were last indexed are read — so an idle cycle costs one scan of
+	// the sources' sync stamps, and reads never scan the texts themselves.
+	if n, err := w.store.IndexMentions(ctx); err != nil {
+		log.Printf("[sync] mention index failed: %v", err)
+	} else if n > 0 {
+		log.Printf("[sync] mention index: indexed=%d", n)
+	}
+
 	// A full cycle that ran to completion stamps the persisted schedule so
 	// the next fullSyncInterval's worth of cycles run lean. Stamped through
 	// the clock seam: the next cycle's nextCycleMode compares against w.now().
@@ -532,8 +615,9 @@ type SyncTeamResult struct {
 	Duration      time.Duration
 }
 
-func (w *Worker) syncTeam(ctx context.Context, team api.Team) error {
+func (w *Worker) syncTeam(ctx context.Context, team api.Team) (err error) {
 	start := w.now()
+	defer func() { w.metrics.recordTeam(w.now().Sub(start), team.Key, err) }()
 
 	// Get last sync metadata
 	meta, err := w.store.Queries().GetSyncMeta(ctx, team.ID)
@@ -542,7 +626,7 @@ func (w *Worker) syncTeam(ctx context.Context, team api.Team) error {
 		lastSyncedUpdatedAt = meta.LastIssueUpdatedAt.Time
 	}
 
-	added, updated, pages, err := w.syncTeamIssues(ctx, team.ID, lastSyncedUpdatedAt)
+	added, updated, pages, err := w.syncTeamIssues(ctx, team, lastSyncedUpdatedAt)
 
 	// Disable catch-up mode after sync completes (or fails)
 	if w.catchUp != nil && (added+updated) > 50 {
@@ -578,9 +662,11 @@ func (w *Worker) syncTeam(ctx context.Context, team api.Team) error {
 }
 
 // syncTeamIssues fetches issues ordered by updatedAt DESC and stops when hitting unchanged issues
-func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUpdatedAt time.Time) (added, updated, pages int, err error) {
+func (w *Worker) syncTeamIssues(ctx context.Context, team api.Team, lastSyncedUpdatedAt time.Time) (added, updated, pages int, err error) {
+	teamID := team.ID
 	var cursor string
 	var pendingDetailIssues []issueRef
+	lazy := w.client.LazyIssueFields()
 
 	for {
 		// Check for cancellation
@@ -591,7 +677,7 @@ func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUp
 		}
 
 		// Fetch next page of issues ordered by updatedAt DESC
-		issues, pageInfo, fetchErr := w.client.GetTeamIssuesPage(ctx, teamID, cursor, 100)
+		issues, pageInfo, fetchErr := w.client.GetTeamIssuesPage(ctx, teamID, cursor, w.client.IssuesPageSize())
 		if fetchErr != nil {
 			return added, updated, pages, fmt.Errorf("fetch issues: %w", fetchErr)
 		}
@@ -617,8 +703,11 @@ func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUp
 			}
 
 			// Check if issue already exists
-			_, getErr := w.store.Queries().GetIssueByID(ctx, issue.ID)
+			prevRow, getErr := w.store.Queries().GetIssueByID(ctx, issue.ID)
 			isNew := getErr != nil
+			if !isNew && lazy.IssueFields() {
+				carryLazyFields(&issue, prevRow, lazy)
+			}
 
 			// Convert and upsert
 			data, convErr := db.APIIssueToDBIssue(issue)
@@ -631,10 +720,32 @@ func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUp
 				log.Printf("[sync] upsert issue %s failed: %v", issue.Identifier, upsertErr)
 				continue
 			}
+			// A new row may be an issue back from the archive.
+			if isNew {
+				if err := w.store.Queries().DeleteArchivedIssue(ctx, issue.ID); err != nil {
+					log.Printf("[sync] drop unarchived issue %s: %v", issue.Identifier, err)
+				}
+			}
+
+			// A projected row's carried-over fields may be stale (the issue
+			// changed since they were fetched): mark it so opening issue.md
+			// fetches the full issue first (repo.CompleteIssueFields).
+			if lazy.IssueFields() {
+				if err := w.store.Queries().UpsertPendingIssueFields(ctx, db.UpsertPendingIssueFieldsParams{IssueID: issue.ID, QueuedAt: db.Now()}); err != nil {
+					log.Printf("[sync] mark %s pending full fields: %v", issue.Identifier, err)
+				}
+			}
 
-			// Extract embedded files from issue description
-			if issue.Description != "" {
-				w.extractor.ExtractAndStore(ctx, issue.ID, issue.Description, "description")
+			// Extract embedded files from issue description (a carried-over
+			// description was extracted when it was fetched), and keep it as
+			// a version for issues/{ID}/history/ if it changed
+			if !lazy.Description {
+				if issue.Description != "" {
+					w.extractor.ExtractAndStore(ctx, issue.ID, issue.Description, "description")
+				}
+				if err := w.store.RecordDescriptionVersion(ctx, issue.ID, issue.Description, issue.UpdatedAt); err != nil {
+					log.Printf("[sync] record description version %s: %v", issue.Identifier, err)
+				}
 			}
 
 			// Queue for batch details sync
@@ -643,7 +754,7 @@ func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUp
 			// Sync details in batches. The outcome is ignored here: any
 			// gated/deferred issue landed in pending_detail_sync, so the next
 			// cycle's drain retries it.
-			if len(pendingDetailIssues) >= detailsBatchSize {
+			if len(pendingDetailIssues) >= w.client.DetailsBatchSize() {
 				w.syncDetails(ctx, pendingDetailIssues)
 				pendingDetailIssues = nil
 			}
@@ -653,6 +764,29 @@ func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUp
 			} else {
 				updated++
 			}
+			// A team's first sync is the cache filling, not the workspace
+			// changing: it reports and records nothing, or every listener and
+			// /activity/ would see one "created" per historical issue.
+			if !lastSyncedUpdatedAt.IsZero() {
+				c := Change{Entity: "issue", ID: issue.ID, Identifier: issue.Identifier, Team: team.Key, Action: "created", Issue: &issue}
+				if !isNew {
+					c.Action = "updated"
+					if prev, err := db.DBIssueToAPIIssue(prevRow); err == nil {
+						c.Previous = &prev
+					}
+				}
+				// An updated row that could not be decoded has no known
+				// previous state to diff against, so it records nothing.
+				if isNew || c.Previous != nil {
+					if err := w.store.RecordIssueActivity(ctx, c.Previous, &issue); err != nil {
+						log.Printf("[sync] record activity %s: %v", issue.Identifier, err)
+					}
+					if err := w.store.RecordScopeChanges(ctx, c.Previous, &issue); err != nil {
+						log.Printf("[sync] record scope changes %s: %v", issue.Identifier, err)
+					}
+				}
+				w.notifyChange(c)
+			}
 		}
 
 		// Enable catch-up mode when we detect a large sync, suppressing
@@ -683,6 +817,21 @@ func (w *Worker) syncTeamIssues(ctx context.Context, teamID string, lastSyncedUp
 	return added, updated, pages, nil
 }
 
+// carryLazyFields copies the fields a projected page left out from the cached
+// row into issue, so the upsert keeps them instead of blanking them.
+func carryLazyFields(issue *api.Issue, prevRow db.Issue, lazy api.LazyIssueFields) {
+	prev, err := db.DBIssueToAPIIssue(prevRow)
+	if err != nil {
+		return // intentionally best-effort: an unreadable cached row has nothing to carry (recovers via CompleteIssueFields on open)
+	}
+	if lazy.Description {
+		issue.Description = prev.Description
+	}
+	if lazy.Labels {
+		issue.Labels = prev.Labels
+	}
+}
+
 // CleanupArchivedIssues removes issues that have been archived in Linear
 // This should be called periodically to clean up the local database
 func (w *Worker) CleanupArchivedIssues(ctx context.Context, teamID string) (int64, error) {
@@ -1407,6 +1556,13 @@ func (w *Worker) syncDetails(ctx context.Context, issues []issueRef) detailOutco
 
 	// Fetch all details in one API call
 	detailsMap, err := w.client.GetIssueDetailsBatch(ctx, ids)
+	// Gate 3b: issues too complex even alone and trimmed. The rest came
+	// back; they are persisted below, and the loop defers the rejected ones.
+	var rejected *api.DetailsTooComplexError
+	if errors.As(err, &rejected) {
+		log.Printf("[sync] batch fetch details: deferring %d issues too complex to fetch: %v", len(rejected.IssueIDs), err)
+		err = nil
+	}
 	if err != nil {
 		if api.IsDeferred(err) {
 			// Gate 3a: our OWN admission ladder deferred this batch — a local,
@@ -1434,17 +1590,24 @@ func (w *Worker) syncDetails(ctx context.Context, issues []issueRef) detailOutco
 	// contributes COMPLETENESS (page-size checks), so a prune fires only when
 	// the fetch was clean AND complete.
 	//
-	// Completeness relies on GetIssueDetailsBatch's documented all-or-nothing
-	// contract: a nil error guarantees a non-nil map entry for every requested
-	// ID, so a partially-failed response never reaches this loop as a
-	// short-but-"complete" details struct. The nil branch below is a trap for
-	// a violation of that contract, not expected flow.
+	// Completeness relies on GetIssueDetailsBatch's documented contract: a
+	// nil error guarantees a non-nil map entry for every requested ID, and a
+	// DetailsTooComplexError one for every ID it does not name, so a
+	// partially-failed response never reaches this loop as a
+	// short-but-"complete" details struct. The nil branch below defers the
+	// rejected issues; for any other ID it is a trap for a violation of that
+	// contract, not expected flow.
 	deps := reconcile.Deps{Q: w.store.Queries(), Extract: w.extractor.ExtractAndStore}
 	var outcome detailOutcome
 	now := db.Now()
 	for _, issue := range issues {
 		details := detailsMap[issue.ID]
 		if details == nil {
+			if rejected != nil && slices.Contains(rejected.IssueIDs, issue.ID) {
+				w.deferDetailIssues(ctx, []issueRef{issue})
+				outcome.deferred = append(outcome.deferred, issue)
+				continue
+			}
 			log.Printf("[sync] CONTRACT VIOLATION: GetIssueDetailsBatch returned nil error but no details for %s (%s) — deferring", issue.Identifier, issue.ID)
 			w.deferDetailIssues(ctx, []issueRef{issue})
 			outcome.deferred = append(outcome.deferred, issue)
@@ -1469,9 +1632,13 @@ func (w *Worker) syncDetails(ctx context.Context, issues []issueRef) detailOutco
 		// detail families uniformly (comments/documents/attachments/relations):
 		// it lives on the issues row, so an empty family can no longer read as
 		// "never synced" (the old per-row touches could not stamp rows that
-		// did not exist).
-		if err := w.store.Queries().StampIssueDetailSynced(ctx, db.StampIssueDetailSyncedParams{DetailSyncedAt: db.ToNullTime(now), ID: issue.ID}); err != nil {
-			log.Printf("[sync] stamp detail synced %s: %v", issue.Identifier, err)
+		// did not exist). A batch with attachments projected out covers only
+		// four, so it leaves the stamp alone: the issue keeps reading stale
+		// and browsing into it runs the SWR path's full fetch.
+		if !details.AttachmentsOmitted {
+			if err := w.store.Queries().StampIssueDetailSynced(ctx, db.StampIssueDetailSyncedParams{DetailSyncedAt: db.ToNullTime(now), ID: issue.ID}); err != nil {
+				log.Printf("[sync] stamp detail synced %s: %v", issue.Identifier, err)
+			}
 		}
 		// H-5: Remove the cleanly synced issue from the pending queue
 		_ = w.store.Queries().DeletePendingDetailSync(ctx, issue.ID)
@@ -1503,8 +1670,8 @@ func (w *Worker) drainPendingDetailSync(ctx context.Context) {
 
 	for len(issues) > 0 {
 		batch := issues
-		if len(batch) > detailsBatchSize {
-			batch = issues[:detailsBatchSize]
+		if n := w.client.DetailsBatchSize(); len(batch) > n {
+			batch = issues[:n]
 		}
 		issues = issues[len(batch):]
 
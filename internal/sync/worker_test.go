@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -115,13 +116,17 @@ type mockAPIClient struct {
 	detailsByIssue      map[string]*api.IssueDetails // issueID -> canned details for GetIssueDetailsBatch
 	detailsCalls        int32                        // number of GetIssueDetailsBatch calls (incl. failed ones)
 	onDetailsBatch      func()                       // if set, runs inside GetIssueDetailsBatch (simulates writes racing the fetch)
+	tooComplexIssues    []string                     // issue IDs GetIssueDetailsBatch leaves out with a DetailsTooComplexError
 	onTeamMetadata      func()                       // if set, runs inside GetTeamMetadata (simulates writes racing the fetch)
 	onWorkspace         func()                       // if set, runs inside GetWorkspace (simulates writes racing the fetch)
 	viewerErr           error                        // if set, GetViewer (the cold-start budget probe) fails with this
 	getViewerCalls      int32
-	projectsProbeErr    error               // if set, GetTeamProjectsNewestPage fails with this (probe-error tests)
-	issueIDsByTeam      map[string][]string // teamID -> authoritative bare issue IDs (the reconcile sweep's drain)
-	issueIDsErr         error               // if set, GetTeamIssueIDs fails with this (all-or-nothing drain tests)
+	projectsProbeErr    error                  // if set, GetTeamProjectsNewestPage fails with this (probe-error tests)
+	issueIDsByTeam      map[string][]string    // teamID -> authoritative bare issue IDs (the reconcile sweep's drain)
+	issueIDsErr         error                  // if set, GetTeamIssueIDs fails with this (all-or-nothing drain tests)
+	archivedByTeam      map[string][]api.Issue // teamID -> archived issues (GetTeamArchivedIssues filters by since)
+	lazy                api.LazyIssueFields    // projected-out fields: blanked from issue pages, omitted from detail batches
+	detailsBatchSize    int                    // DetailsBatchSize's answer; 0 = the client's initial 10
 	opMu                gosync.Mutex
 	opOrder             []string // call order across GetViewer/GetWorkspace/GetTeamMetadata/GetTeams/GetTeamProjectsNewestPage (probe-sequencing + lean/full cycle tests)
 }
@@ -201,6 +206,19 @@ func (m *mockAPIClient) GetTeamIssuesPage(ctx context.Context, teamID string, cu
 	}
 
 	page := issues[offset:end]
+	if m.lazy.IssueFields() {
+		projected := make([]api.Issue, len(page))
+		for i, issue := range page {
+			if m.lazy.Description {
+				issue.Description = ""
+			}
+			if m.lazy.Labels {
+				issue.Labels = api.Labels{}
+			}
+			projected[i] = issue
+		}
+		page = projected
+	}
 	hasNext := end < len(issues)
 	nextCursor := ""
 	if hasNext {
@@ -327,6 +345,23 @@ func (m *mockAPIClient) GetIssueDetailsBatch(ctx context.Context, issueIDs []str
 			Documents: []api.Document{},
 		}
 	}
+	if m.lazy.Attachments {
+		for id, d := range result {
+			omitted := *d
+			omitted.Attachments, omitted.AttachmentsOmitted = nil, true
+			result[id] = &omitted
+		}
+	}
+	var rejected []string
+	for _, id := range issueIDs {
+		if slices.Contains(m.tooComplexIssues, id) {
+			delete(result, id)
+			rejected = append(rejected, id)
+		}
+	}
+	if len(rejected) > 0 {
+		return result, &api.DetailsTooComplexError{IssueIDs: rejected, Err: errors.New("Query too complex")}
+	}
 	return result, nil
 }
 
@@ -338,6 +373,33 @@ func (m *mockAPIClient) GetTeamIssueIDs(ctx context.Context, teamID string) ([]s
 	return m.issueIDsByTeam[teamID], nil
 }
 
+func (m *mockAPIClient) GetTeamArchivedIssues(ctx context.Context, teamID string, since time.Time) ([]api.Issue, error) {
+	var result []api.Issue
+	for _, issue := range m.archivedByTeam[teamID] {
+		if issue.ArchivedAt != nil && issue.ArchivedAt.After(since) {
+			result = append(result, issue)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAPIClient) LazyIssueFields() api.LazyIssueFields {
+	return m.lazy
+}
+
+// IssuesPageSize: the mock pages by m.pageSize when set, so the requested
+// size only matters when it is not.
+func (m *mockAPIClient) IssuesPageSize() int {
+	return 100
+}
+
+func (m *mockAPIClient) DetailsBatchSize() int {
+	if m.detailsBatchSize > 0 {
+		return m.detailsBatchSize
+	}
+	return 10
+}
+
 func (m *mockAPIClient) AuthHeader() string {
 	return "Bearer test-token"
 }
@@ -447,6 +509,52 @@ func TestWorkerSyncAllTeams(t *testing.T) {
 	}
 }
 
+// TestWorkerSyncTeamFilter checks Config.Teams and Config.ExcludeTeams: a
+// team outside the filter, or excluded even though it is listed, is neither
+// stored nor synced.
+func TestWorkerSyncTeamFilter(t *testing.T) {
+	t.Parallel()
+	for _, cfg := range []Config{
+		{Interval: time.Hour, Teams: []string{"ENG"}},
+		{Interval: time.Hour, ExcludeTeams: []string{"DSN"}},
+		{Interval: time.Hour, Teams: []string{"ENG", "DSN"}, ExcludeTeams: []string{"DSN"}},
+	} {
+		store := openTestStore(t)
+		defer store.Close()
+		ctx := context.Background()
+
+		mock := newMockAPIClient()
+		mock.teams = []api.Team{
+			{ID: "team-1", Key: "ENG", Name: "Engineering"},
+			{ID: "team-2", Key: "DSN", Name: "Design"},
+		}
+		now := time.Now()
+		mock.issuesByTeam["team-2"] = []api.Issue{
+			{ID: "issue-3", Identifier: "DSN-1", Title: "Design Issue", Team: &api.Team{ID: "team-2"}, UpdatedAt: now},
+		}
+
+		worker := NewWorker(mock, store, cfg)
+		if err := worker.SyncNow(ctx); err != nil {
+			t.Fatalf("SyncNow failed: %v", err)
+		}
+
+		teams, err := store.Queries().ListTeams(ctx)
+		if err != nil {
+			t.Fatalf("ListTeams failed: %v", err)
+		}
+		if len(teams) != 1 || teams[0].Key != "ENG" {
+			t.Errorf("teams %v less %v: stored teams = %v, want ENG only", cfg.Teams, cfg.ExcludeTeams, teams)
+		}
+		dsnIssues, err := store.Queries().ListTeamIssues(ctx, "team-2")
+		if err != nil {
+			t.Fatalf("ListTeamIssues failed: %v", err)
+		}
+		if len(dsnIssues) != 0 {
+			t.Errorf("teams %v less %v: filtered-out team synced %d issues", cfg.Teams, cfg.ExcludeTeams, len(dsnIssues))
+		}
+	}
+}
+
 func TestWorkerSyncUntilUnchanged(t *testing.T) {
 	t.Parallel()
 	store := openTestStore(t)
@@ -1545,6 +1653,48 @@ func TestRunLoopTickFiresSyncCycle(t *testing.T) {
 	}
 }
 
+// TestTriggerSyncRunsOneCycleOnTheLoop: a TriggerSync made while a request is
+// already pending coalesces into it, and the loop serves the one request with
+// a full cycle.
+func TestTriggerSyncRunsOneCycleOnTheLoop(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+
+	clock := newFakeClock()
+	mock := newMockAPIClient()
+	mock.teams = []api.Team{{ID: "team-1", Key: "TST", Name: "Test"}}
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+	clock.install(worker)
+
+	// Queued before the loop starts, so both land while the first is pending.
+	if !worker.TriggerSync() {
+		t.Fatal("first TriggerSync = false, want it queued")
+	}
+	if worker.TriggerSync() {
+		t.Error("second TriggerSync = true, want it coalesced into the pending one")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	worker.Start(ctx)
+	// The loop takes the request at its first select, after the initial sync;
+	// the tick send then completes only once the requested cycle has run.
+	deadline := time.Now().Add(5 * time.Second)
+	for len(worker.nowCh) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the run loop never took the sync request")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	clock.tickerCh <- time.Time{}
+	worker.Stop()
+
+	if calls := atomic.LoadInt32(&mock.getTeamsCalls); calls != 3 {
+		t.Errorf("GetTeams calls = %d, want 3 (initial sync + one requested + the tick)", calls)
+	}
+}
+
 // =============================================================================
 // Lean/Full Cycle Taxonomy Tests (#242)
 // =============================================================================
@@ -2381,6 +2531,42 @@ func TestSyncDetailsFetchFailureDefersAll(t *testing.T) {
 	}
 }
 
+// TestSyncDetailsTooComplexDefersOnlyRejected: an issue too complex to fetch
+// even alone is deferred, while the rest of its batch persists as usual and
+// the outcome is not gated.
+func TestSyncDetailsTooComplexDefersOnlyRejected(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	mock := newMockAPIClient()
+	mock.tooComplexIssues = []string{"issue-2"}
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+
+	outcome := worker.syncDetails(ctx, []issueRef{
+		{ID: "issue-1", Identifier: "TST-1"},
+		{ID: "issue-2", Identifier: "TST-2"},
+	})
+
+	if outcome.gated {
+		t.Error("a partial result should not gate the outcome")
+	}
+	if len(outcome.synced) != 1 || outcome.synced[0].ID != "issue-1" {
+		t.Errorf("synced = %v, want issue-1", outcome.synced)
+	}
+	if len(outcome.deferred) != 1 || outcome.deferred[0].ID != "issue-2" {
+		t.Errorf("deferred = %v, want issue-2", outcome.deferred)
+	}
+	pending, err := store.Queries().ListPendingDetailSync(ctx)
+	if err != nil {
+		t.Fatalf("ListPendingDetailSync: %v", err)
+	}
+	if len(pending) != 1 || pending[0].IssueID != "issue-2" {
+		t.Errorf("pending = %+v, want issue-2 alone", pending)
+	}
+}
+
 // TestDrainStopsWhenGated: the drain loop must stop at the first gated
 // outcome instead of burning an API call per remaining batch — with more than
 // one batch pending and a persistently failing fetch, exactly one
@@ -2391,8 +2577,13 @@ func TestDrainStopsWhenGated(t *testing.T) {
 	defer store.Close()
 	ctx := context.Background()
 
+	mock := newMockAPIClient()
+	mock.simulateError = errors.New("boom: internal server error") // non-rate-limit → gate 4 every time
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+
 	// Two batches' worth of pending issues.
-	for i := 0; i < detailsBatchSize+1; i++ {
+	batchSize := mock.DetailsBatchSize()
+	for i := 0; i < batchSize+1; i++ {
 		if err := store.Queries().UpsertPendingDetailSync(ctx, db.UpsertPendingDetailSyncParams{
 			IssueID:    fmt.Sprintf("issue-%02d", i),
 			Identifier: fmt.Sprintf("TST-%02d", i),
@@ -2402,10 +2593,6 @@ func TestDrainStopsWhenGated(t *testing.T) {
 		}
 	}
 
-	mock := newMockAPIClient()
-	mock.simulateError = errors.New("boom: internal server error") // non-rate-limit → gate 4 every time
-	worker := NewWorker(mock, store, Config{Interval: time.Hour})
-
 	worker.drainPendingDetailSync(ctx)
 
 	if calls := atomic.LoadInt32(&mock.detailsCalls); calls != 1 {
@@ -2417,8 +2604,45 @@ func TestDrainStopsWhenGated(t *testing.T) {
 	if err != nil {
 		t.Fatalf("ListPendingDetailSync: %v", err)
 	}
-	if len(pending) != detailsBatchSize+1 {
-		t.Errorf("pending = %d, want %d (gated batches keep their retry)", len(pending), detailsBatchSize+1)
+	if len(pending) != batchSize+1 {
+		t.Errorf("pending = %d, want %d (gated batches keep their retry)", len(pending), batchSize+1)
+	}
+}
+
+// TestDrainUsesClientBatchSize: the drain sizes its batches by the client's
+// complexity-aware DetailsBatchSize, not a fixed count — a client that has
+// learned a batch of 10 is too complex gets smaller batches.
+func TestDrainUsesClientBatchSize(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	for i := 0; i < 7; i++ {
+		if err := store.Queries().UpsertPendingDetailSync(ctx, db.UpsertPendingDetailSyncParams{
+			IssueID:    fmt.Sprintf("issue-%02d", i),
+			Identifier: fmt.Sprintf("TST-%02d", i),
+			QueuedAt:   db.Now(),
+		}); err != nil {
+			t.Fatalf("seed pending: %v", err)
+		}
+	}
+
+	mock := newMockAPIClient()
+	mock.detailsBatchSize = 3
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+
+	worker.drainPendingDetailSync(ctx)
+
+	if calls := atomic.LoadInt32(&mock.detailsCalls); calls != 3 {
+		t.Errorf("GetIssueDetailsBatch called %d times, want 3 (batches of 3, 3, 1)", calls)
+	}
+	pending, err := store.Queries().ListPendingDetailSync(ctx)
+	if err != nil {
+		t.Fatalf("ListPendingDetailSync: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("pending = %d after drain, want 0", len(pending))
 	}
 }
 
@@ -2737,3 +2961,79 @@ func TestLeanCycleProbeMissingWatermarkEscalates(t *testing.T) {
 		t.Errorf("watermark not re-stamped after escalation: %v", err)
 	}
 }
+
+// TestSyncTeamIssuesLazyFields: with description, labels, and attachments
+// projected out of bulk sync (api.lazy_issue_fields), a changed issue keeps
+// its cached values for all three rather than being blanked by the fields the
+// page did not carry, is marked for a full fetch on open, and is left
+// detail-unstamped so a browse still runs the full SWR fetch.
+func TestSyncTeamIssuesLazyFields(t *testing.T) {
+	t.Parallel()
+	store := openTestStore(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	cached := api.Issue{
+		ID: "issue-1", Identifier: "TST-1", Title: "Cached", Description: "Cached body",
+		Team:      &api.Team{ID: "team-1"},
+		Labels:    api.Labels{Nodes: []api.Label{{ID: "label-1", Name: "Bug"}}},
+		CreatedAt: time.Now().Add(-time.Hour), UpdatedAt: time.Now().Add(-time.Hour),
+	}
+	data, err := db.APIIssueToDBIssue(cached)
+	if err != nil {
+		t.Fatalf("convert issue: %v", err)
+	}
+	if err := store.Queries().UpsertIssue(ctx, data.ToUpsertParams()); err != nil {
+		t.Fatalf("seed issue: %v", err)
+	}
+	att, err := db.APIAttachmentToDBAttachment(api.Attachment{ID: "att-1", Title: "PR", URL: "https://x/pr", CreatedAt: time.Now(), UpdatedAt: time.Now()}, "issue-1")
+	if err != nil {
+		t.Fatalf("convert attachment: %v", err)
+	}
+	if err := store.Queries().UpsertAttachment(ctx, att); err != nil {
+		t.Fatalf("seed attachment: %v", err)
+	}
+
+	mock := newMockAPIClient()
+	mock.lazy = api.LazyIssueFields{Description: true, Labels: true, Attachments: true}
+	changed := cached
+	changed.Title, changed.Description, changed.UpdatedAt = "Renamed", "Remote body", time.Now()
+	mock.issuesByTeam["team-1"] = []api.Issue{
+		changed,
+		{ID: "issue-2", Identifier: "TST-2", Title: "New", Description: "New body", Team: &api.Team{ID: "team-1"}, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	worker := NewWorker(mock, store, Config{Interval: time.Hour})
+
+	if _, _, _, err := worker.syncTeamIssues(ctx, api.Team{ID: "team-1", Key: "TST"}, time.Time{}); err != nil {
+		t.Fatalf("syncTeamIssues: %v", err)
+	}
+
+	row, err := store.Queries().GetIssueByID(ctx, "issue-1")
+	if err != nil {
+		t.Fatalf("GetIssueByID: %v", err)
+	}
+	got, err := db.DBIssueToAPIIssue(row)
+	if err != nil {
+		t.Fatalf("DBIssueToAPIIssue: %v", err)
+	}
+	if got.Title != "Renamed" {
+		t.Errorf("title = %q, want the page's %q", got.Title, "Renamed")
+	}
+	if got.Description != "Cached body" {
+		t.Errorf("description = %q, want the cached body kept", got.Description)
+	}
+	if len(got.Labels.Nodes) != 1 || got.Labels.Nodes[0].Name != "Bug" {
+		t.Errorf("labels = %+v, want the cached [Bug] kept", got.Labels.Nodes)
+	}
+	for _, id := range []string{"issue-1", "issue-2"} {
+		if n, err := store.Queries().HasPendingIssueFields(ctx, id); err != nil || n != 1 {
+			t.Errorf("%s pending full fields = %d, %v; want marked", id, n, err)
+		}
+		if stamp := detailSyncedAt(t, store, id); stamp.Valid {
+			t.Errorf("%s detail-stamped without its attachments", id)
+		}
+	}
+	if atts, err := store.Queries().ListIssueAttachments(ctx, "issue-1"); err != nil || len(atts) != 1 {
+		t.Errorf("attachments = %d, %v; want the cached one untouched", len(atts), err)
+	}
+}
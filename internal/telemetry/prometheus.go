@@ -0,0 +1,185 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// The Prometheus rendering: an opt-in HTTP endpoint (telemetry.prometheus.
+// listen) serving GET /metrics in the Prometheus text exposition format. A
+// ManualReader on the same provider collects on each scrape, so the endpoint
+// carries every instrument at full cardinality with nothing exported between
+// scrapes. The format is rendered here rather than through the Prometheus
+// client library: the SDK's collected data maps onto it directly.
+
+// prometheusContentType is the text exposition format's media type.
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// startPrometheus listens on addr and serves /metrics from reader. The
+// returned shutdown stops the server.
+func startPrometheus(addr string, reader sdkmetric.Reader) (func(context.Context) error, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	srv := &http.Server{Handler: prometheusHandler(reader), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			log.Printf("telemetry: prometheus endpoint stopped: %v", err)
+		}
+	}()
+	log.Printf("telemetry: serving Prometheus metrics on http://%s/metrics", ln.Addr())
+	return srv.Shutdown, nil
+}
+
+// prometheusHandler serves one collect per scrape.
+func prometheusHandler(reader sdkmetric.Reader) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metrics", func(w http.ResponseWriter, r *http.Request) {
+		var rm metricdata.ResourceMetrics
+		if err := reader.Collect(r.Context(), &rm); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", prometheusContentType)
+		_, _ = w.Write([]byte(renderPrometheus(&rm)))
+	})
+	return mux
+}
+
+// renderPrometheus is the pure projection from collected metric data to the
+// text exposition format, metrics sorted by name. Dots become underscores, a
+// seconds unit adds a _seconds suffix, and a monotonic sum is a counter with a
+// _total suffix; other sums and gauges are gauges, histograms histograms.
+func renderPrometheus(rm *metricdata.ResourceMetrics) string {
+	var metrics []metricdata.Metrics
+	for _, sm := range rm.ScopeMetrics {
+		metrics = append(metrics, sm.Metrics...)
+	}
+	sort.SliceStable(metrics, func(i, j int) bool { return metrics[i].Name < metrics[j].Name })
+
+	var b strings.Builder
+	for _, m := range metrics {
+		name := prometheusName(m.Name)
+		if m.Unit == "s" && !strings.HasSuffix(name, "_seconds") {
+			name += "_seconds"
+		}
+		switch data := m.Data.(type) {
+		case metricdata.Gauge[int64]:
+			writeScalars(&b, name, "gauge", m.Description, data.DataPoints)
+		case metricdata.Gauge[float64]:
+			writeScalars(&b, name, "gauge", m.Description, data.DataPoints)
+		case metricdata.Sum[int64]:
+			writeSum(&b, name, m.Description, data.IsMonotonic, data.DataPoints)
+		case metricdata.Sum[float64]:
+			writeSum(&b, name, m.Description, data.IsMonotonic, data.DataPoints)
+		case metricdata.Histogram[int64]:
+			writeHistograms(&b, name, m.Description, data.DataPoints)
+		case metricdata.Histogram[float64]:
+			writeHistograms(&b, name, m.Description, data.DataPoints)
+		}
+	}
+	return b.String()
+}
+
+// helpEscaper escapes a HELP line's text.
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	if help != "" {
+		fmt.Fprintf(b, "# HELP %s %s\n", name, helpEscaper.Replace(help))
+	}
+	fmt.Fprintf(b, "# TYPE %s %s\n", name, kind)
+}
+
+func writeSum[N int64 | float64](b *strings.Builder, name, help string, monotonic bool, dps []metricdata.DataPoint[N]) {
+	if !monotonic {
+		writeScalars(b, name, "gauge", help, dps)
+		return
+	}
+	if !strings.HasSuffix(name, "_total") {
+		name += "_total"
+	}
+	writeScalars(b, name, "counter", help, dps)
+}
+
+func writeScalars[N int64 | float64](b *strings.Builder, name, kind, help string, dps []metricdata.DataPoint[N]) {
+	writeHeader(b, name, kind, help)
+	for _, dp := range dps {
+		fmt.Fprintf(b, "%s%s %s\n", name, prometheusLabels(dp.Attributes, ""), prometheusValue(float64(dp.Value)))
+	}
+}
+
+func writeHistograms[N int64 | float64](b *strings.Builder, name, help string, dps []metricdata.HistogramDataPoint[N]) {
+	writeHeader(b, name, "histogram", help)
+	for _, dp := range dps {
+		var cumulative uint64
+		for i, bound := range dp.Bounds {
+			cumulative += dp.BucketCounts[i]
+			fmt.Fprintf(b, "%s_bucket%s %d\n", name, prometheusLabels(dp.Attributes, prometheusValue(bound)), cumulative)
+		}
+		fmt.Fprintf(b, "%s_bucket%s %d\n", name, prometheusLabels(dp.Attributes, "+Inf"), dp.Count)
+		fmt.Fprintf(b, "%s_sum%s %s\n", name, prometheusLabels(dp.Attributes, ""), prometheusValue(float64(dp.Sum)))
+		fmt.Fprintf(b, "%s_count%s %d\n", name, prometheusLabels(dp.Attributes, ""), dp.Count)
+	}
+}
+
+// labelEscaper escapes a label value the three ways the format defines.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// prometheusLabels renders an attribute set (already key-sorted) as
+// {k="v",...}, with a histogram bucket's le label last; "" when empty.
+func prometheusLabels(set attribute.Set, le string) string {
+	var pairs []string
+	for _, kv := range set.ToSlice() {
+		pairs = append(pairs, prometheusName(string(kv.Key))+`="`+labelEscaper.Replace(kv.Value.Emit())+`"`)
+	}
+	if le != "" {
+		pairs = append(pairs, `le="`+le+`"`)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// prometheusName maps an instrument or attribute name onto Prometheus's
+// [a-zA-Z_:][a-zA-Z0-9_:]* alphabet.
+func prometheusName(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == ':':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9' && i > 0:
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+func prometheusValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
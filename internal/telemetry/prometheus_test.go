@@ -0,0 +1,83 @@
+package telemetry
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestRenderPrometheus(t *testing.T) {
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{
+		{
+			Name: "linearfs.sync.team_duration", Unit: "s", Description: "Duration of one team's sync",
+			Data: metricdata.Histogram[float64]{DataPoints: []metricdata.HistogramDataPoint[float64]{{
+				Attributes:   attribute.NewSet(attribute.String("team", "ENG")),
+				Bounds:       []float64{0.5, 5},
+				BucketCounts: []uint64{1, 2, 1},
+				Count:        4, Sum: 12.5,
+			}}},
+		},
+		{
+			Name: "linearfs.api.requests", Description: "Requests sent",
+			Data: metricdata.Sum[int64]{IsMonotonic: true, DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: attribute.NewSet(attribute.String("op", "GetViewer"), attribute.String("outcome", `ok "quoted"`)),
+				Value:      7,
+			}}},
+		},
+		{
+			Name: "linearfs.db.rows",
+			Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: attribute.NewSet(attribute.String("table", "issues")), Value: 1200,
+			}}},
+		},
+	}}}}
+
+	want := `# HELP linearfs_api_requests_total Requests sent
+# TYPE linearfs_api_requests_total counter
+linearfs_api_requests_total{op="GetViewer",outcome="ok \"quoted\""} 7
+# TYPE linearfs_db_rows gauge
+linearfs_db_rows{table="issues"} 1200
+# HELP linearfs_sync_team_duration_seconds Duration of one team's sync
+# TYPE linearfs_sync_team_duration_seconds histogram
+linearfs_sync_team_duration_seconds_bucket{team="ENG",le="0.5"} 1
+linearfs_sync_team_duration_seconds_bucket{team="ENG",le="5"} 3
+linearfs_sync_team_duration_seconds_bucket{team="ENG",le="+Inf"} 4
+linearfs_sync_team_duration_seconds_sum{team="ENG"} 12.5
+linearfs_sync_team_duration_seconds_count{team="ENG"} 4
+`
+	if got := renderPrometheus(rm); got != want {
+		t.Errorf("renderPrometheus =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestPrometheusHandlerScrapesLiveInstruments: a scrape collects the provider's
+// current values through the ManualReader.
+func TestPrometheusHandlerScrapesLiveInstruments(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+	ops := MustInt64Counter(provider.Meter("linearfs/fuse"), "linearfs.fuse.ops")
+	ops.Add(context.Background(), 3, metric.WithAttributes(attribute.String("op", "flush")))
+
+	srv := httptest.NewServer(prometheusHandler(reader))
+	t.Cleanup(srv.Close)
+	resp, err := srv.Client().Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if ct := resp.Header.Get("Content-Type"); ct != prometheusContentType {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if !strings.Contains(string(body), `linearfs_fuse_ops_total{op="flush"} 3`) {
+		t.Errorf("scrape missing the counter:\n%s", body)
+	}
+}
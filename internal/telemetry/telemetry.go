@@ -6,7 +6,9 @@
 //     exist, and
 //   - an opt-in JSONL file export — a second PeriodicReader (config-gated,
 //     default off) writing one JSON line per export through a size-capped
-//     rotation writer.
+//     rotation writer, and
+//   - an opt-in Prometheus endpoint — a ManualReader collected on each scrape
+//     of GET /metrics at the configured listen address (prometheus.go).
 //
 // Init registers the provider globally (otel.SetMeterProvider), so instrument
 // sites elsewhere in the tree just call otel.Meter("linearfs/<layer>") and
@@ -45,9 +47,9 @@ const (
 // vars and are carried on the linearfs.build.info heartbeat gauge.
 //
 // The returned shutdown flushes both readers (a final export) and releases the
-// file writer; call it on unmount/exit. Failure to set up the optional file
-// exporter degrades to summary-only (logged, not fatal) — telemetry must never
-// block mounting.
+// file writer and the Prometheus listener; call it on unmount/exit. Failure to
+// set up the optional file exporter or Prometheus endpoint drops just that
+// rendering (logged, not fatal) — telemetry must never block mounting.
 func Init(cfg config.TelemetryConfig, version, commit string) (func(context.Context) error, error) {
 	res := resource.NewSchemaless(
 		attribute.String("service.name", "linearfs"),
@@ -72,6 +74,17 @@ func Init(cfg config.TelemetryConfig, version, commit string) (func(context.Cont
 		}
 	}
 
+	var stopPrometheus func(context.Context) error
+	if cfg.Prometheus.Listen != "" {
+		reader := sdkmetric.NewManualReader()
+		if stop, err := startPrometheus(cfg.Prometheus.Listen, reader); err != nil {
+			log.Printf("telemetry: prometheus endpoint disabled: %v", err)
+		} else {
+			stopPrometheus = stop
+			opts = append(opts, sdkmetric.WithReader(reader))
+		}
+	}
+
 	provider := sdkmetric.NewMeterProvider(opts...)
 	otel.SetMeterProvider(provider)
 
@@ -80,6 +93,9 @@ func Init(cfg config.TelemetryConfig, version, commit string) (func(context.Cont
 	}
 
 	shutdown := func(ctx context.Context) error {
+		if stopPrometheus != nil {
+			_ = stopPrometheus(ctx)
+		}
 		err := provider.Shutdown(ctx)
 		if rot != nil {
 			if cerr := rot.Close(); err == nil {
@@ -236,6 +236,15 @@ func ArchiveIssueResponse(success bool) map[string]any {
 	}
 }
 
+// UnarchiveIssueResponse returns a response for UnarchiveIssue mutation.
+func UnarchiveIssueResponse(success bool) map[string]any {
+	return map[string]any{
+		"issueUnarchive": map[string]any{
+			"success": success,
+		},
+	}
+}
+
 // CreateIssueResponse returns a response for CreateIssue mutation.
 func CreateIssueResponse(issue map[string]any) map[string]any {
 	return map[string]any{
@@ -175,6 +175,13 @@ func WithAssignee(user *api.User) IssueOption {
 	}
 }
 
+// WithCreator sets the issue creator.
+func WithCreator(user *api.User) IssueOption {
+	return func(i *api.Issue) {
+		i.Creator = user
+	}
+}
+
 // WithPriority sets the issue priority.
 func WithPriority(p int) IssueOption {
 	return func(i *api.Issue) {
@@ -210,6 +217,14 @@ func WithCycle(cycle *api.IssueCycle) IssueOption {
 	}
 }
 
+// WithMilestone puts the issue in a project milestone (see
+// SeedProjectWithMilestones, which sets the project edge).
+func WithMilestone(milestone *api.ProjectMilestone) IssueOption {
+	return func(i *api.Issue) {
+		i.ProjectMilestone = milestone
+	}
+}
+
 // WithParent sets the issue parent.
 func WithParent(parent *api.ParentIssue) IssueOption {
 	return func(i *api.Issue) {
@@ -21,6 +21,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/url"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -251,17 +252,26 @@ func (c *Client) UpdateIssue(ctx context.Context, issueID string, input map[stri
 	if sid, ok := input["stateId"].(string); ok && sid != "" {
 		iss.State = api.State{ID: sid, Name: c.stateName(ctx, sid)}
 	}
+	if v, ok := input["assigneeId"]; ok {
+		iss.Assignee = nil
+		if aid, ok := v.(string); ok && aid != "" {
+			iss.Assignee = &api.User{ID: aid}
+		}
+	}
 	c.issueEdit[issueID] = iss
 	return nil
 }
 
 func (c *Client) ArchiveIssue(ctx context.Context, issueID string) error { return nil }
 
+func (c *Client) UnarchiveIssue(ctx context.Context, issueID string) error { return nil }
+
 // ---- Comments ----
 
 func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*api.Comment, error) {
 	n := c.next()
-	return &api.Comment{ID: fmt.Sprintf("mock-comment-%d", n), Body: body, CreatedAt: c.now, UpdatedAt: c.now}, nil
+	id := fmt.Sprintf("mock-comment-%d", n)
+	return &api.Comment{ID: id, Body: body, URL: "https://linear.app/test/issue/" + issueID + "#comment-" + id, CreatedAt: c.now, UpdatedAt: c.now}, nil
 }
 
 func (c *Client) UpdateComment(ctx context.Context, commentID string, body string) (*api.Comment, error) {
@@ -510,6 +520,11 @@ func (c *Client) LinkURL(ctx context.Context, issueID, url, title string) (*api.
 
 func (c *Client) DeleteAttachment(ctx context.Context, attachmentID string) error { return nil }
 
+func (c *Client) UploadFile(ctx context.Context, filename, contentType string, content []byte) (string, error) {
+	n := c.next()
+	return fmt.Sprintf("https://uploads.linear.app/mock/%d/%s", n, url.PathEscape(filename)), nil
+}
+
 // ---- Entity external links (project/initiative "Links / Resources") ----
 
 func (c *Client) CreateEntityExternalLink(ctx context.Context, input map[string]any) (*api.EntityExternalLink, error) {
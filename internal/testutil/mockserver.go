@@ -3,11 +3,15 @@ package testutil
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 // MockLinearServer is a test server that simulates the Linear GraphQL API.
@@ -19,6 +23,29 @@ type MockLinearServer struct {
 	sequences map[string][]any // query/mutation name -> per-call responses
 	errors    map[string]error // query/mutation name -> error to return
 	calls     []GraphQLCall    // recorded calls for assertions
+
+	// Transport realism: see SetPaginated, SetLatency, SetFailures, and
+	// SetComplexityBudget.
+	paginated  map[string]pagedConn // query name -> connection served page by page
+	latency    time.Duration        // delay before every response
+	failures   map[string][]int     // query/mutation name -> HTTP statuses for the next calls
+	complexity map[string]int       // query/mutation name -> X-Complexity cost
+	budget     *complexityBudget    // nil: no X-RateLimit-Complexity-* headers
+}
+
+// pagedConn is a connection SetPaginated serves: nodes in pages of pageSize,
+// nested in the response at path.
+type pagedConn struct {
+	nodes    []any
+	pageSize int
+	path     []string
+}
+
+// complexityBudget is the simulated complexity window SetComplexityBudget
+// configures.
+type complexityBudget struct {
+	limit, remaining int
+	resetAt          time.Time
 }
 
 // GraphQLCall records a GraphQL request for test assertions.
@@ -31,9 +58,12 @@ type GraphQLCall struct {
 // NewMockLinearServer creates a new mock server ready for use.
 func NewMockLinearServer() *MockLinearServer {
 	m := &MockLinearServer{
-		responses: make(map[string]any),
-		sequences: make(map[string][]any),
-		errors:    make(map[string]error),
+		responses:  make(map[string]any),
+		sequences:  make(map[string][]any),
+		errors:     make(map[string]error),
+		paginated:  make(map[string]pagedConn),
+		failures:   make(map[string][]int),
+		complexity: make(map[string]int),
 	}
 
 	m.Server = httptest.NewServer(http.HandlerFunc(m.handleRequest))
@@ -69,6 +99,63 @@ func (m *MockLinearServer) SetResponseSequence(operation string, pages ...any) {
 	m.sequences[operation] = pages
 }
 
+// SetPaginated serves nodes as a cursor-paginated connection for an
+// operation, pageSize at a time, the way Linear does: each page carries
+// pageInfo {hasNextPage endCursor}, and the request's "after" variable picks
+// the page. path nests the connection in the response ("team", "issues" for
+// {team {issues {...}}}). Unlike SetResponseSequence the page served depends on
+// the cursor sent, not on the call count, so a client that re-requests a page
+// or threads the wrong cursor gets what Linear would give it. An unknown
+// cursor is answered with a GraphQL error. Takes precedence over SetResponse;
+// a SetResponseSequence takes precedence over it.
+func (m *MockLinearServer) SetPaginated(operation string, pageSize int, nodes []any, path ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	m.paginated[operation] = pagedConn{nodes: nodes, pageSize: pageSize, path: path}
+}
+
+// SetLatency delays every response by d. A request whose context ends first
+// (a client timeout, a cancelled drain) is abandoned without a response.
+func (m *MockLinearServer) SetLatency(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latency = d
+}
+
+// SetFailures fails the next calls of an operation with the given HTTP
+// statuses, one per call in order; a 0 serves that call normally, and calls
+// past the script are all served. A 429 carries Linear's RATELIMITED error
+// envelope, a 500 a plain server error, so an intermittent outage is scripted
+// as e.g. SetFailures("Op", 0, 500) — page one succeeds, page two fails — and
+// a retry can be asserted to recover on the call after.
+func (m *MockLinearServer) SetFailures(operation string, statuses ...int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures[operation] = append([]int{}, statuses...)
+}
+
+// SetComplexity sets the X-Complexity cost an operation reports and spends
+// from the SetComplexityBudget window. Operations without one cost 1.
+func (m *MockLinearServer) SetComplexity(operation string, cost int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.complexity[operation] = cost
+}
+
+// SetComplexityBudget simulates Linear's complexity window: every response
+// carries X-Complexity and X-RateLimit-Complexity-{Limit,Remaining,Reset},
+// and a call costing more than what remains is refused the way Linear
+// refuses it — HTTP 400 with a RATELIMITED error — until resetAt passes,
+// when the window refills to limit.
+func (m *MockLinearServer) SetComplexityBudget(limit int, resetAt time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.budget = &complexityBudget{limit: limit, remaining: limit, resetAt: resetAt}
+}
+
 // SetError configures the mock to return an error for a specific operation.
 func (m *MockLinearServer) SetError(operation string, err error) {
 	m.mu.Lock()
@@ -93,13 +180,19 @@ func (m *MockLinearServer) LastCall() *GraphQLCall {
 	return &m.calls[len(m.calls)-1]
 }
 
-// Reset clears all responses, errors, and recorded calls.
+// Reset clears all responses, errors, simulated transport behavior, and
+// recorded calls.
 func (m *MockLinearServer) Reset() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.responses = make(map[string]any)
 	m.sequences = make(map[string][]any)
 	m.errors = make(map[string]error)
+	m.paginated = make(map[string]pagedConn)
+	m.failures = make(map[string][]int)
+	m.complexity = make(map[string]int)
+	m.latency = 0
+	m.budget = nil
 	m.calls = nil
 }
 
@@ -144,7 +237,40 @@ func (m *MockLinearServer) handleRequest(w http.ResponseWriter, r *http.Request)
 			opCalls++
 		}
 	}
+	latency := m.latency
+	m.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			timer.Stop()
+			return
+		}
+	}
+
+	// Scripted transport failures come first: a 500 or 429 never reaches the
+	// resolver, so it neither spends budget nor advances a sequence's page.
+	m.mu.Lock()
+	var status int
+	if queued := m.failures[operation]; len(queued) > 0 {
+		status, m.failures[operation] = queued[0], queued[1:]
+	}
 	m.mu.Unlock()
+	switch {
+	case status == http.StatusTooManyRequests:
+		writeJSON(w, status, rateLimitedBody)
+		return
+	case status != 0:
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	if !m.spendComplexity(w, operation) {
+		writeJSON(w, http.StatusBadRequest, rateLimitedBody)
+		return
+	}
 
 	// Check for configured error
 	m.mu.RLock()
@@ -170,6 +296,16 @@ func (m *MockLinearServer) handleRequest(w http.ResponseWriter, r *http.Request)
 			idx = len(seq) - 1
 		}
 		data, ok = seq[idx], true
+	} else if pc, has := m.paginated[operation]; has {
+		page, err := pc.page(req.Variables["after"])
+		if err != nil {
+			m.mu.RUnlock()
+			writeJSON(w, http.StatusOK, map[string]any{
+				"errors": []map[string]any{{"message": err.Error()}},
+			})
+			return
+		}
+		data, ok = page, true
 	} else {
 		data, ok = m.responses[operation]
 	}
@@ -187,6 +323,85 @@ func (m *MockLinearServer) handleRequest(w http.ResponseWriter, r *http.Request)
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// rateLimitedBody is Linear's refusal when a request or complexity budget is
+// exhausted.
+var rateLimitedBody = map[string]any{
+	"errors": []map[string]any{{
+		"message":    "Rate limit exceeded",
+		"extensions": map[string]any{"code": "RATELIMITED"},
+	}},
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// spendComplexity charges operation's cost against the simulated window and
+// sets the complexity headers. It reports false when the window cannot cover
+// the cost; the headers then show what is left. Without a budget it only
+// reports the cost of operations given one by SetComplexity.
+func (m *MockLinearServer) spendComplexity(w http.ResponseWriter, operation string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cost, priced := m.complexity[operation]
+	if !priced {
+		cost = 1
+	}
+	if m.budget == nil {
+		if priced {
+			w.Header().Set("X-Complexity", strconv.Itoa(cost))
+		}
+		return true
+	}
+	b := m.budget
+	if !time.Now().Before(b.resetAt) {
+		b.remaining = b.limit
+		b.resetAt = time.Now().Add(time.Hour)
+	}
+	ok := cost <= b.remaining
+	if ok {
+		b.remaining -= cost
+	}
+	h := w.Header()
+	h.Set("X-Complexity", strconv.Itoa(cost))
+	h.Set("X-RateLimit-Complexity-Limit", strconv.Itoa(b.limit))
+	h.Set("X-RateLimit-Complexity-Remaining", strconv.Itoa(b.remaining))
+	h.Set("X-RateLimit-Complexity-Reset", strconv.FormatInt(b.resetAt.UnixMilli(), 10))
+	return ok
+}
+
+// pageCursorPrefix marks the opaque cursors SetPaginated hands out; the
+// suffix is the offset of the page's first node.
+const pageCursorPrefix = "mock-cursor:"
+
+// page renders the page of the connection that starts after cursor (nil or
+// "" for the first page), nested at the connection's path.
+func (pc pagedConn) page(after any) (any, error) {
+	start := 0
+	if a, _ := after.(string); a != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(a, pageCursorPrefix))
+		if err != nil || !strings.HasPrefix(a, pageCursorPrefix) || n < 0 || n > len(pc.nodes) {
+			return nil, fmt.Errorf("invalid cursor %q", a)
+		}
+		start = n
+	}
+	end := min(start+pc.pageSize, len(pc.nodes))
+	endCursor := ""
+	if end > start {
+		endCursor = pageCursorPrefix + strconv.Itoa(end)
+	}
+	var out any = map[string]any{
+		"pageInfo": map[string]any{"hasNextPage": end < len(pc.nodes), "endCursor": endCursor},
+		"nodes":    append([]any{}, pc.nodes[start:end]...),
+	}
+	for i := len(pc.path) - 1; i >= 0; i-- {
+		out = map[string]any{pc.path[i]: out}
+	}
+	return out, nil
+}
+
 // extractOperation extracts the operation name from a GraphQL query.
 func extractOperation(query string) string {
 	matches := operationRegex.FindStringSubmatch(query)
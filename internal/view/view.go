@@ -0,0 +1,333 @@
+// Package view parses and evaluates the filter expressions behind
+// config-defined views (teams/{KEY}/views/{name}/). It is pure: no SQLite, no
+// FUSE — the fs layer hands it issues read from SQLite and lists the matches
+// as symlinks.
+//
+// An expression is whitespace-separated terms, all of which must match:
+//
+//	state=started,unstarted label!=Blocked assignee=me priority<=high due<=7d
+//
+// Each term is field, operator, and a comma-separated value list (= matches
+// any value, != matches none). Values containing spaces are double-quoted:
+// label="Needs Review". Fields:
+//
+//   - state (alias status): state name or state type (backlog, unstarted,
+//     started, completed, canceled), case-insensitive.
+//   - label: label name, case-insensitive; "none" means no labels.
+//   - assignee: "me", "none", an email, or a display name / email local part.
+//   - priority: urgent, high, medium, low, none (or 0-4). <, <=, >, >= compare
+//     urgency: priority<=high is urgent or high; none is the least urgent.
+//   - due: "none", "overdue", or with <, <=, >, >= a date: YYYY-MM-DD, "today",
+//     or "Nd" (N days from today). An issue without a due date never matches
+//     an ordering comparison.
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+// Filter is a parsed view expression: a conjunction of terms.
+type Filter struct {
+	expr  string
+	terms []term
+}
+
+// Env is the evaluation context a filter needs beyond the issue itself.
+type Env struct {
+	ViewerID string    // resolves assignee=me; empty matches no one
+	Now      time.Time // anchors due=overdue, today, and Nd (local date)
+}
+
+type term struct {
+	field  string
+	op     string
+	values []string
+}
+
+// ops lists the operators two-character-first so "<=" is not read as "<".
+var ops = []string{"!=", "<=", ">=", "=", "<", ">"}
+
+// Parse parses a view expression. An empty expression is an error: a view
+// that matches every issue is the issues/ directory.
+func Parse(expr string) (*Filter, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+	f := &Filter{expr: expr}
+	for _, tok := range tokens {
+		t, err := parseTerm(tok)
+		if err != nil {
+			return nil, err
+		}
+		f.terms = append(f.terms, t)
+	}
+	return f, nil
+}
+
+// String returns the expression the filter was parsed from.
+func (f *Filter) String() string { return f.expr }
+
+// tokenize splits on unquoted whitespace, stripping the quotes.
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+	inQuote, started := false, false
+	for _, r := range expr {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			started = true
+		case !inQuote && (r == ' ' || r == '\t' || r == '\n'):
+			if started {
+				tokens = append(tokens, cur.String())
+				cur.Reset()
+				started = false
+			}
+		default:
+			cur.WriteRune(r)
+			started = true
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quote in %q", expr)
+	}
+	if started {
+		tokens = append(tokens, cur.String())
+	}
+	return tokens, nil
+}
+
+func parseTerm(tok string) (term, error) {
+	// The operator starts at the first operator character; ops is ordered so
+	// the two-character form wins.
+	i := strings.IndexAny(tok, "!<>=")
+	if i > 0 {
+		for _, op := range ops {
+			if !strings.HasPrefix(tok[i:], op) {
+				continue
+			}
+			t := term{field: strings.ToLower(tok[:i]), op: op}
+			if t.field == "status" {
+				t.field = "state"
+			}
+			for _, v := range strings.Split(tok[i+len(op):], ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					t.values = append(t.values, v)
+				}
+			}
+			if len(t.values) == 0 {
+				return term{}, fmt.Errorf("term %q: missing value", tok)
+			}
+			return t, t.validate(tok)
+		}
+	}
+	return term{}, fmt.Errorf("term %q: expected field, operator (=, !=, <, <=, >, >=), and value", tok)
+}
+
+// validate rejects unknown fields, ordering operators on unordered fields, and
+// values that can never match (bad priority names, unparseable dates).
+func (t term) validate(tok string) error {
+	ordering := t.op != "=" && t.op != "!="
+	switch t.field {
+	case "state", "label", "assignee":
+		if ordering {
+			return fmt.Errorf("term %q: %s supports only = and !=", tok, t.field)
+		}
+	case "priority":
+		if ordering && len(t.values) != 1 {
+			return fmt.Errorf("term %q: %s takes one value", tok, t.op)
+		}
+		for _, v := range t.values {
+			if _, err := priorityRank(v); err != nil {
+				return fmt.Errorf("term %q: %w", tok, err)
+			}
+		}
+	case "due":
+		if !ordering {
+			for _, v := range t.values {
+				if v = strings.ToLower(v); v != "none" && v != "overdue" {
+					return fmt.Errorf("term %q: due=/due!= take none or overdue; compare dates with <, <=, >, >=", tok)
+				}
+			}
+			return nil
+		}
+		if len(t.values) != 1 {
+			return fmt.Errorf("term %q: %s takes one value", tok, t.op)
+		}
+		if _, err := dueBound(t.values[0], time.Now()); err != nil {
+			return fmt.Errorf("term %q: %w", tok, err)
+		}
+	default:
+		return fmt.Errorf("term %q: unknown field %q (want state, label, assignee, priority, due)", tok, t.field)
+	}
+	return nil
+}
+
+// Match reports whether issue satisfies every term.
+func (f *Filter) Match(issue *api.Issue, env Env) bool {
+	for _, t := range f.terms {
+		if !t.match(issue, env) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t term) match(issue *api.Issue, env Env) bool {
+	switch t.op {
+	case "=":
+		return t.anyValue(issue, env)
+	case "!=":
+		return !t.anyValue(issue, env)
+	}
+	// Ordering comparisons (validated: priority or due, one value).
+	var got, bound int
+	switch t.field {
+	case "priority":
+		got = rankOf(issue.Priority)
+		bound, _ = priorityRank(t.values[0])
+	case "due":
+		if issue.DueDate == nil {
+			return false
+		}
+		due, err := time.ParseInLocation("2006-01-02", dateOnly(*issue.DueDate), env.Now.Location())
+		if err != nil {
+			return false
+		}
+		b, _ := dueBound(t.values[0], env.Now)
+		got, bound = dayNumber(due), dayNumber(b)
+	}
+	switch t.op {
+	case "<":
+		return got < bound
+	case "<=":
+		return got <= bound
+	case ">":
+		return got > bound
+	default: // ">="
+		return got >= bound
+	}
+}
+
+// anyValue reports whether the issue's field equals any of the term's values.
+func (t term) anyValue(issue *api.Issue, env Env) bool {
+	for _, v := range t.values {
+		if t.equals(issue, v, env) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t term) equals(issue *api.Issue, v string, env Env) bool {
+	switch t.field {
+	case "state":
+		return strings.EqualFold(issue.State.Name, v) || strings.EqualFold(issue.State.Type, v)
+	case "label":
+		if strings.EqualFold(v, "none") {
+			return len(issue.Labels.Nodes) == 0
+		}
+		for _, l := range issue.Labels.Nodes {
+			if strings.EqualFold(l.Name, v) {
+				return true
+			}
+		}
+		return false
+	case "assignee":
+		a := issue.Assignee
+		switch strings.ToLower(v) {
+		case "none":
+			return a == nil
+		case "me":
+			return a != nil && env.ViewerID != "" && a.ID == env.ViewerID
+		}
+		if a == nil {
+			return false
+		}
+		local, _, _ := strings.Cut(a.Email, "@")
+		return strings.EqualFold(a.Email, v) || strings.EqualFold(a.DisplayName, v) ||
+			strings.EqualFold(a.Name, v) || strings.EqualFold(local, v)
+	case "priority":
+		r, _ := priorityRank(v)
+		return rankOf(issue.Priority) == r
+	case "due":
+		if strings.EqualFold(v, "none") {
+			return issue.DueDate == nil
+		}
+		// overdue: due strictly before today.
+		if issue.DueDate == nil {
+			return false
+		}
+		due, err := time.ParseInLocation("2006-01-02", dateOnly(*issue.DueDate), env.Now.Location())
+		return err == nil && dayNumber(due) < dayNumber(env.Now)
+	}
+	return false
+}
+
+// priorityRank maps a priority value to its urgency rank: urgent=1 … low=4,
+// none=5 (the least urgent, not the most as Linear's numeric 0 would suggest).
+func priorityRank(v string) (int, error) {
+	if n, err := strconv.Atoi(v); err == nil {
+		if n < 0 || n > 4 {
+			return 0, fmt.Errorf("invalid priority %q: must be 0-4", v)
+		}
+		return rankOf(n), nil
+	}
+	p, err := api.ValidatePriority(strings.ToLower(v))
+	if err != nil {
+		return 0, err
+	}
+	return rankOf(p), nil
+}
+
+// rankOf maps Linear's numeric priority (0 = none) to urgency rank.
+func rankOf(p int) int {
+	if p < 1 || p > 4 {
+		return 5
+	}
+	return p
+}
+
+// dueBound resolves a due comparison value to a date relative to now.
+func dueBound(v string, now time.Time) (time.Time, error) {
+	lv := strings.ToLower(v)
+	switch {
+	case lv == "today":
+		return now, nil
+	case strings.HasSuffix(lv, "d"):
+		n, err := strconv.Atoi(strings.TrimSuffix(lv, "d"))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid due offset %q: want Nd, e.g. 7d", v)
+		}
+		return now.AddDate(0, 0, n), nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", v, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid due date %q: want YYYY-MM-DD, today, or Nd", v)
+	}
+	return t, nil
+}
+
+// dayNumber is a date's day count since the epoch in its own location, so
+// comparisons are by calendar day regardless of time of day.
+func dayNumber(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// dateOnly trims a Linear date/timestamp to its YYYY-MM-DD prefix.
+func dateOnly(s string) string {
+	if len(s) > len("2006-01-02") {
+		return s[:len("2006-01-02")]
+	}
+	return s
+}
@@ -0,0 +1,95 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+)
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{
+		"",
+		"   ",
+		"state",               // no operator
+		"=started",            // no field
+		"state=",              // no value
+		"color=red",           // unknown field
+		"label<Bug",           // ordering on an unordered field
+		"priority=critical",   // not a priority
+		"priority<=high,low",  // ordering takes one value
+		"due=7d",              // due= takes none/overdue
+		"due<=next-week",      // not a date
+		`label="Needs Review`, // unterminated quote
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) = nil error, want error", expr)
+		}
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC)
+	env := Env{ViewerID: "u-me", Now: now}
+	str := func(s string) *string { return &s }
+
+	me := &api.User{ID: "u-me", Email: "me@example.com", DisplayName: "me"}
+	other := &api.User{ID: "u-2", Email: "alice@example.com", DisplayName: "alice"}
+	issues := map[string]api.Issue{
+		"mine-urgent-soon": {State: api.State{Name: "In Progress", Type: "started"}, Assignee: me, Priority: 1,
+			DueDate: str("2026-05-12"), Labels: api.Labels{Nodes: []api.Label{{Name: "Needs Review"}}}},
+		"alice-low-overdue": {State: api.State{Name: "Todo", Type: "unstarted"}, Assignee: other, Priority: 4,
+			DueDate: str("2026-05-01")},
+		"unassigned-none": {State: api.State{Name: "Backlog", Type: "backlog"}, Priority: 0,
+			Labels: api.Labels{Nodes: []api.Label{{Name: "Bug"}}}},
+	}
+
+	cases := []struct {
+		expr string
+		want []string
+	}{
+		{"state=started,unstarted", []string{"alice-low-overdue", "mine-urgent-soon"}},
+		{`status="in progress"`, []string{"mine-urgent-soon"}},
+		{"assignee=me", []string{"mine-urgent-soon"}},
+		{"assignee=alice@example.com", []string{"alice-low-overdue"}},
+		{"assignee=none", []string{"unassigned-none"}},
+		{"label=bug", []string{"unassigned-none"}},
+		{`label="Needs Review"`, []string{"mine-urgent-soon"}},
+		{"label=none", []string{"alice-low-overdue"}},
+		{"label!=bug state!=backlog", []string{"alice-low-overdue", "mine-urgent-soon"}},
+		{"priority<=high", []string{"mine-urgent-soon"}},
+		{"priority>=low", []string{"alice-low-overdue", "unassigned-none"}},
+		{"priority=none", []string{"unassigned-none"}},
+		{"due<=7d", []string{"alice-low-overdue", "mine-urgent-soon"}},
+		{"due=overdue", []string{"alice-low-overdue"}},
+		{"due=none", []string{"unassigned-none"}},
+		{"due>=today", []string{"mine-urgent-soon"}},
+		{"due<2026-05-05 assignee!=me", []string{"alice-low-overdue"}},
+	}
+	for _, tc := range cases {
+		f, err := Parse(tc.expr)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tc.expr, err)
+			continue
+		}
+		var got []string
+		for _, name := range []string{"alice-low-overdue", "mine-urgent-soon", "unassigned-none"} {
+			issue := issues[name]
+			if f.Match(&issue, env) {
+				got = append(got, name)
+			}
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%q matched %v, want %v", tc.expr, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%q matched %v, want %v", tc.expr, got, tc.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,383 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/sync"
+)
+
+// A webhook's data is the entity in Linear's webhook shape, close to but not
+// the GraphQL shape the cache stores: to-one edges come as both an object and
+// an id (assignee / assigneeId), labels as a bare array, and edges the
+// payload does not carry at all (children, relations, milestones) are simply
+// absent. So each delivery is decoded OVER the cached row — a field the
+// payload omits keeps its synced value — and the few shape differences are
+// reconciled by hand. Fields only the sync fetches stay as last synced.
+
+// Apply writes one change event in the delivery envelope into the store and
+// reports it. It is the entry for a transport that authenticates its events
+// itself (internal/livesync's subscription), so no signature, timestamp, or
+// delivery ID is checked, and nothing lands in the failure log.
+func (h *Handler) Apply(ctx context.Context, event []byte) error {
+	var d delivery
+	if err := json.Unmarshal(event, &d); err != nil {
+		return fmt.Errorf("malformed event: %w", err)
+	}
+	return h.apply(ctx, d)
+}
+
+// apply writes one verified delivery into the store and reports it. Resource
+// types outside ResourceTypes are acknowledged and ignored.
+func (h *Handler) apply(ctx context.Context, d delivery) error {
+	switch d.Type {
+	case "Issue":
+		return h.applyIssue(ctx, d)
+	case "Comment":
+		return h.applyComment(ctx, d)
+	case "Project":
+		return h.applyProject(ctx, d)
+	}
+	return nil
+}
+
+// entityID reads the id every payload's data carries.
+func entityID(data json.RawMessage) (string, error) {
+	var ref struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &ref); err != nil {
+		return "", err
+	}
+	if ref.ID == "" {
+		return "", errors.New("data has no id")
+	}
+	return ref.ID, nil
+}
+
+// cachedIssue returns the cached row for id decoded, or nil when there is none.
+func (h *Handler) cachedIssue(ctx context.Context, id string) (*api.Issue, error) {
+	row, err := h.store.Queries().GetIssueByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	issue, err := db.DBIssueToAPIIssue(row)
+	if err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// archivedIssue returns the archived row for id decoded, or nil when there is
+// none.
+func (h *Handler) archivedIssue(ctx context.Context, id string) (*api.Issue, error) {
+	row, err := h.store.Queries().GetArchivedIssueByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	issue, err := db.DBArchivedIssueToAPIIssue(row)
+	if err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+// issueData is an Issue payload: the GraphQL-shaped fields decode into the
+// embedded Issue, the bare label array into Labels (it shadows Issue.Labels).
+type issueData struct {
+	api.Issue
+	Labels   *[]api.Label `json:"labels"`
+	LabelIDs *[]string    `json:"labelIds"`
+}
+
+// applyIssue upserts (or, on remove or archive, deletes) an issue. An
+// archived issue moves to archived_issues (issues/.archived/), and one back
+// from the archive leaves it. A delivery older than the row either table
+// holds is dropped.
+func (h *Handler) applyIssue(ctx context.Context, d delivery) error {
+	id, err := entityID(d.Data)
+	if err != nil {
+		return fmt.Errorf("issue: %w", err)
+	}
+	prev, err := h.cachedIssue(ctx, id)
+	if err != nil {
+		return err
+	}
+	if d.Action == "remove" {
+		if err := h.store.Queries().DeleteArchivedIssue(ctx, id); err != nil {
+			return err
+		}
+		return h.removeIssue(ctx, prev)
+	}
+
+	// Decode over a second copy of the cached row: json.Unmarshal writes
+	// through the row's pointers, which must not alias Previous.
+	var data issueData
+	if prev != nil {
+		base, err := h.cachedIssue(ctx, id)
+		if err != nil {
+			return err
+		}
+		data.Issue = *base
+	}
+	if err := json.Unmarshal(d.Data, &data); err != nil {
+		return fmt.Errorf("issue %s: %w", id, err)
+	}
+	issue := data.Issue
+	if err := reconcileIssueEdges(&issue, d.Data, data); err != nil {
+		return fmt.Errorf("issue %s: %w", id, err)
+	}
+	// Deliveries can arrive out of order; never let an older one overwrite
+	// a newer row (the sync may already have brought it in). Checked before
+	// the archive moves, so a stale archive can't drop a live issue and a
+	// stale update can't pull a newer archived one back out.
+	newest := prev
+	if newest == nil {
+		if newest, err = h.archivedIssue(ctx, id); err != nil {
+			return err
+		}
+	}
+	if newest != nil && issue.UpdatedAt.Before(newest.UpdatedAt) {
+		return nil
+	}
+	if issue.ArchivedAt != nil {
+		params, err := db.APIIssueToDBArchivedIssue(issue)
+		if err != nil {
+			return err
+		}
+		if err := h.store.Queries().UpsertArchivedIssue(ctx, params); err != nil {
+			return err
+		}
+		return h.removeIssue(ctx, prev)
+	}
+	if prev == nil {
+		if err := h.store.Queries().DeleteArchivedIssue(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	row, err := db.APIIssueToDBIssue(issue)
+	if err != nil {
+		return err
+	}
+	if err := h.store.Queries().UpsertIssue(ctx, row.ToUpsertParams()); err != nil {
+		return err
+	}
+	// A payload without a description decoded over the cached one, so this
+	// records only a real change.
+	if err := h.store.RecordDescriptionVersion(ctx, issue.ID, issue.Description, issue.UpdatedAt); err != nil {
+		log.Printf("[webhook] record description version %s: %v", issue.Identifier, err)
+	}
+	if err := h.store.RecordIssueActivity(ctx, prev, &issue); err != nil {
+		log.Printf("[webhook] record activity %s: %v", issue.Identifier, err)
+	}
+	if err := h.store.RecordScopeChanges(ctx, prev, &issue); err != nil {
+		log.Printf("[webhook] record scope changes %s: %v", issue.Identifier, err)
+	}
+	c := sync.Change{Entity: "issue", ID: issue.ID, Identifier: issue.Identifier, Team: teamKey(&issue), Action: "created", Issue: &issue, Previous: prev}
+	if prev != nil {
+		c.Action = "updated"
+	}
+	h.notify(c)
+	return nil
+}
+
+// removeIssue forgets a deleted or archived issue; prev nil (never cached)
+// is a no-op.
+func (h *Handler) removeIssue(ctx context.Context, prev *api.Issue) error {
+	if prev == nil {
+		return nil
+	}
+	if err := h.store.Queries().DeleteIssue(ctx, prev.ID); err != nil {
+		return err
+	}
+	h.notify(sync.Change{Entity: "issue", ID: prev.ID, Identifier: prev.Identifier, Team: teamKey(prev), Action: "removed", Previous: prev})
+	return nil
+}
+
+// reconcileIssueEdges applies what the payload says about the issue's to-one
+// edges and labels where its shape differs from the cached row's.
+func reconcileIssueEdges(issue *api.Issue, raw json.RawMessage, data issueData) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+	// cleared reports whether the payload sets an edge to nothing: its id
+	// present and null, with no object alongside.
+	cleared := func(idKey, objKey string) bool {
+		v, ok := fields[idKey]
+		obj, hasObj := fields[objKey]
+		return ok && string(v) == "null" && (!hasObj || string(obj) == "null")
+	}
+	if cleared("assigneeId", "assignee") {
+		issue.Assignee = nil
+	}
+	if cleared("projectId", "project") {
+		issue.Project = nil
+	}
+	if cleared("projectMilestoneId", "projectMilestone") {
+		issue.ProjectMilestone = nil
+	}
+	if cleared("cycleId", "cycle") {
+		issue.Cycle = nil
+	}
+	if cleared("parentId", "parent") {
+		issue.Parent = nil
+	}
+
+	switch {
+	case data.Labels != nil:
+		issue.Labels = api.Labels{Nodes: *data.Labels}
+	case data.LabelIDs != nil:
+		// Only ids: keep the cached labels still applied. A newly applied
+		// label's name arrives with the next sync.
+		keep := make(map[string]bool, len(*data.LabelIDs))
+		for _, id := range *data.LabelIDs {
+			keep[id] = true
+		}
+		var nodes []api.Label
+		for _, l := range issue.Labels.Nodes {
+			if keep[l.ID] {
+				nodes = append(nodes, l)
+			}
+		}
+		issue.Labels = api.Labels{Nodes: nodes}
+	}
+	return nil
+}
+
+// teamKey is the issue's team key, or "" when the row has no team.
+func teamKey(issue *api.Issue) string {
+	if issue.Team == nil {
+		return ""
+	}
+	return issue.Team.Key
+}
+
+// commentData is a Comment payload: the comment plus its issue's id.
+type commentData struct {
+	api.Comment
+	IssueID string `json:"issueId"`
+}
+
+// applyComment upserts a comment, or tombstones it on remove (the comments/
+// listing shows deleted comments under display.show_deleted_comments).
+func (h *Handler) applyComment(ctx context.Context, d delivery) error {
+	var data commentData
+	if err := json.Unmarshal(d.Data, &data); err != nil {
+		return fmt.Errorf("comment: %w", err)
+	}
+	comment := data.Comment
+	if comment.ID == "" || data.IssueID == "" {
+		return errors.New("comment: data has no id or issueId")
+	}
+	if comment.URL == "" {
+		comment.URL = d.URL
+	}
+	q := h.store.Queries()
+	c := sync.Change{Entity: "comment", ID: comment.ID, IssueID: data.IssueID, Comment: &comment}
+	if issue, err := h.cachedIssue(ctx, data.IssueID); err == nil && issue != nil {
+		c.Identifier, c.Team = issue.Identifier, teamKey(issue)
+	}
+
+	switch d.Action {
+	case "remove":
+		now := db.Now()
+		if err := q.TombstoneComment(ctx, db.TombstoneCommentParams{DeletedAt: sql.NullTime{Time: now, Valid: true}, ID: comment.ID}); err != nil {
+			return err
+		}
+		comment.DeletedAt = &now
+		c.Action = "removed"
+	default:
+		params, err := db.APICommentToDBComment(comment, data.IssueID)
+		if err != nil {
+			return err
+		}
+		if err := q.UpsertComment(ctx, params); err != nil {
+			return err
+		}
+		c.Action = "updated"
+		if d.Action == "create" {
+			c.Action = "created"
+		}
+	}
+	h.notify(c)
+	return nil
+}
+
+// projectData is a Project payload: the project plus the teams it belongs to.
+type projectData struct {
+	api.Project
+	TeamIDs []string `json:"teamIds"`
+}
+
+// applyProject upserts a project and its team associations, or deletes it
+// on remove.
+func (h *Handler) applyProject(ctx context.Context, d delivery) error {
+	id, err := entityID(d.Data)
+	if err != nil {
+		return fmt.Errorf("project: %w", err)
+	}
+	q := h.store.Queries()
+	var data projectData
+	row, err := q.GetProject(ctx, id)
+	switch {
+	case err == nil:
+		if data.Project, err = db.DBProjectToAPIProject(row); err != nil {
+			return err
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+	if err := json.Unmarshal(d.Data, &data); err != nil {
+		return fmt.Errorf("project %s: %w", id, err)
+	}
+	project := data.Project
+	c := sync.Change{Entity: "project", ID: project.ID, Project: &project, TeamIDs: data.TeamIDs}
+
+	if d.Action == "remove" {
+		if err := q.DeleteProjectTeams(ctx, id); err != nil {
+			return err
+		}
+		if err := q.DeleteProject(ctx, id); err != nil {
+			return err
+		}
+		c.Action = "removed"
+		h.notify(c)
+		return nil
+	}
+
+	params, err := db.APIProjectToDBProject(project)
+	if err != nil {
+		return err
+	}
+	if err := q.UpsertProject(ctx, params); err != nil {
+		return err
+	}
+	for _, teamID := range data.TeamIDs {
+		if err := q.UpsertProjectTeam(ctx, db.UpsertProjectTeamParams{ProjectID: id, TeamID: teamID, SyncedAt: db.Now()}); err != nil {
+			// intentionally best-effort: the association only places the
+			// project in a team's projects/; the next metadata sync rewrites
+			// it (recovers via the next sync).
+			log.Printf("[webhook] project %s: associate team %s: %v", id, teamID, err)
+		}
+	}
+	c.Action = "updated"
+	if d.Action == "create" {
+		c.Action = "created"
+	}
+	h.notify(c)
+	return nil
+}
@@ -0,0 +1,217 @@
+// Package webhook applies Linear webhook deliveries to the SQLite cache, so a
+// change made in the Linear UI reaches the mount within seconds instead of at
+// the next sync cycle. Handler is an http.Handler: it verifies each delivery's
+// signature and freshness, refuses to apply the same delivery twice, writes
+// the issue, comment, or project it carries into the store, keeps a bounded
+// log of what it refused or failed to apply, and reports the change through
+// the sync worker's
+// ChangeListener seam, which invalidates the kernel's caches and feeds
+// /.events exactly as a sync-observed change does. The polling sync keeps
+// running underneath: a delivery that is lost, refused, or out of order is
+// reconciled at the next cycle.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	gosync "sync"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/sync"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the raw body under the
+// webhook's signing secret.
+const SignatureHeader = "Linear-Signature"
+
+// DeliveryHeader carries the delivery's unique ID. Linear sends a retry of a
+// delivery under the same ID.
+const DeliveryHeader = "Linear-Delivery"
+
+// ResourceTypes are the Linear resource types Handler applies — what the
+// mount subscribes its registered webhook to.
+var ResourceTypes = []string{"Issue", "Comment", "Project"}
+
+// maxBodyBytes bounds one delivery. An issue payload with a long description
+// is tens of KB; anything past this is not a Linear delivery.
+const maxBodyBytes = 1 << 20
+
+// maxClockSkew is how far a delivery's webhookTimestamp may sit from now. A
+// signed body replayed later than this is refused, as Linear recommends.
+const maxClockSkew = time.Minute
+
+// deliveryRetention is how long an applied delivery's ID is remembered. The
+// timestamp check alone refuses a replay after maxClockSkew, but Linear
+// retries a delivery it saw fail for hours, and a retry of one that was
+// applied after all must not apply twice.
+const deliveryRetention = 24 * time.Hour
+
+// maxFailures bounds the failure log; the oldest entry goes first.
+const maxFailures = 100
+
+// Failure is one delivery the handler refused, could not apply, or had
+// already applied.
+type Failure struct {
+	At       time.Time `json:"at"`
+	Delivery string    `json:"delivery,omitempty"` // the Linear-Delivery ID
+	Type     string    `json:"type,omitempty"`     // empty until the body parses
+	Action   string    `json:"action,omitempty"`
+	Status   int       `json:"status"` // the HTTP status answered
+	Error    string    `json:"error"`
+}
+
+// Handler applies verified deliveries to the store.
+type Handler struct {
+	store   *db.Store
+	secret  []byte
+	changes sync.ChangeListener // nil: apply without reporting
+	now     func() time.Time
+
+	failMu   gosync.Mutex
+	failures []Failure // newest last, at most maxFailures
+}
+
+// NewHandler returns a Handler that verifies deliveries against secret and
+// reports each applied change to changes (which may be nil).
+func NewHandler(store *db.Store, secret string, changes sync.ChangeListener) *Handler {
+	return &Handler{store: store, secret: []byte(secret), changes: changes, now: time.Now}
+}
+
+// delivery is the envelope Linear posts.
+type delivery struct {
+	Action           string          `json:"action"` // create, update, remove
+	Type             string          `json:"type"`   // Issue, Comment, Project, …
+	Data             json.RawMessage `json:"data"`
+	URL              string          `json:"url"`
+	WebhookTimestamp int64           `json:"webhookTimestamp"` // Unix ms
+}
+
+// ServeHTTP answers 200 once the delivery is applied (or deliberately
+// ignored, or already applied). A failure to apply answers 500 so Linear
+// retries; a delivery that fails verification answers 401 and is never
+// applied. Everything but a plain success lands in the failure log.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	f := Failure{Delivery: r.Header.Get(DeliveryHeader)}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+	if err != nil {
+		h.refuse(w, f, http.StatusBadRequest, "read body", err)
+		return
+	}
+	if len(body) > maxBodyBytes {
+		h.refuse(w, f, http.StatusRequestEntityTooLarge, "body too large", nil)
+		return
+	}
+	if !h.verify(body, r.Header.Get(SignatureHeader)) {
+		h.refuse(w, f, http.StatusUnauthorized, "bad signature", nil)
+		return
+	}
+	var d delivery
+	if err := json.Unmarshal(body, &d); err != nil {
+		h.refuse(w, f, http.StatusBadRequest, "malformed delivery", err)
+		return
+	}
+	f.Type, f.Action = d.Type, d.Action
+	if skew := h.now().Sub(time.UnixMilli(d.WebhookTimestamp)).Abs(); skew > maxClockSkew {
+		h.refuse(w, f, http.StatusUnauthorized, "stale delivery", nil)
+		return
+	}
+
+	// A delivery without an ID (not one Linear sends) is keyed by its body:
+	// a byte-identical replay is still caught.
+	id := f.Delivery
+	if id == "" {
+		sum := sha256.Sum256(body)
+		id = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	first, err := h.recordDelivery(r, id)
+	if err != nil {
+		log.Printf("[webhook] record delivery %s: %v", id, err)
+		h.refuse(w, f, http.StatusInternalServerError, "record delivery failed", err)
+		return
+	}
+	if !first {
+		h.logFailure(f, http.StatusOK, "duplicate delivery: already applied, ignored")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err := h.apply(r.Context(), d); err != nil {
+		log.Printf("[webhook] apply %s %s: %v", d.Type, d.Action, err)
+		// Forget the ID so Linear's retry is applied.
+		if ferr := h.store.Queries().DeleteWebhookDelivery(r.Context(), id); ferr != nil {
+			log.Printf("[webhook] forget delivery %s: %v", id, ferr)
+		}
+		h.refuse(w, f, http.StatusInternalServerError, "apply failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// recordDelivery records id as applied and reports whether it was new,
+// pruning IDs past deliveryRetention as it goes.
+func (h *Handler) recordDelivery(r *http.Request, id string) (bool, error) {
+	now := h.now()
+	n, err := h.store.Queries().RecordWebhookDelivery(r.Context(), db.RecordWebhookDeliveryParams{DeliveryID: id, ReceivedAt: now})
+	if err != nil {
+		return false, err
+	}
+	if err := h.store.Queries().DeleteWebhookDeliveriesBefore(r.Context(), now.Add(-deliveryRetention)); err != nil {
+		log.Printf("[webhook] prune delivery IDs: %v", err)
+	}
+	return n > 0, nil
+}
+
+// refuse answers status with msg and logs the failure, with err's detail.
+func (h *Handler) refuse(w http.ResponseWriter, f Failure, status int, msg string, err error) {
+	detail := msg
+	if err != nil {
+		detail += ": " + err.Error()
+	}
+	h.logFailure(f, status, detail)
+	http.Error(w, msg, status)
+}
+
+func (h *Handler) logFailure(f Failure, status int, detail string) {
+	f.At, f.Status, f.Error = h.now(), status, detail
+	h.failMu.Lock()
+	defer h.failMu.Unlock()
+	if len(h.failures) == maxFailures {
+		h.failures = append(h.failures[:0], h.failures[1:]...)
+	}
+	h.failures = append(h.failures, f)
+}
+
+// Failures returns the failure log, oldest first.
+func (h *Handler) Failures() []Failure {
+	h.failMu.Lock()
+	defer h.failMu.Unlock()
+	return append([]Failure(nil), h.failures...)
+}
+
+// verify checks signature against the HMAC of body in constant time.
+func (h *Handler) verify(body []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil || len(got) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// notify reports c to the listener, if any.
+func (h *Handler) notify(c sync.Change) {
+	if h.changes != nil {
+		h.changes.Changed(c)
+	}
+}
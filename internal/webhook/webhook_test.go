@@ -0,0 +1,435 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jra3/linear-fuse/internal/api"
+	"github.com/jra3/linear-fuse/internal/db"
+	"github.com/jra3/linear-fuse/internal/sync"
+	"github.com/jra3/linear-fuse/internal/testutil/fixtures"
+)
+
+const testSecret = "s3cret"
+
+// recorder is a ChangeListener that keeps every change.
+type recorder struct{ changes []sync.Change }
+
+func (r *recorder) Changed(c sync.Change) { r.changes = append(r.changes, c) }
+
+func newTestHandler(t *testing.T) (*Handler, *db.Store, *recorder) {
+	t.Helper()
+	store, err := db.Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	rec := &recorder{}
+	return NewHandler(store, testSecret, rec), store, rec
+}
+
+// post delivers a signed envelope and returns the status code.
+func post(t *testing.T, h *Handler, action, typ string, data any) int {
+	t.Helper()
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(delivery{Action: action, Type: typ, Data: raw, WebhookTimestamp: time.Now().UnixMilli()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries++
+	return postDelivery(h, body, sign(testSecret, body), fmt.Sprintf("delivery-%d", deliveries))
+}
+
+// deliveries numbers post's Linear-Delivery IDs, one per delivery as Linear
+// sends them.
+var deliveries int
+
+func postRaw(h *Handler, body []byte, signature string) int {
+	return postDelivery(h, body, signature, "")
+}
+
+func postDelivery(h *Handler, body []byte, signature, id string) int {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set(SignatureHeader, signature)
+	if id != "" {
+		req.Header.Set(DeliveryHeader, id)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w.Code
+}
+
+// issuePayload renders issue in the webhook's shape: labels as a bare array.
+func issuePayload(t *testing.T, issue api.Issue) map[string]any {
+	t.Helper()
+	raw, err := json.Marshal(issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]any
+	if err := json.Unmarshal(raw, &m); err != nil {
+		t.Fatal(err)
+	}
+	m["labels"] = issue.Labels.Nodes
+	return m
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestServeHTTP_RefusesUnverified(t *testing.T) {
+	h, store, rec := newTestHandler(t)
+	issue := fixtures.FixtureAPIIssue()
+	raw, _ := json.Marshal(issue)
+
+	fresh, _ := json.Marshal(delivery{Action: "create", Type: "Issue", Data: raw, WebhookTimestamp: time.Now().UnixMilli()})
+	if code := postRaw(h, fresh, sign("wrong", fresh)); code != http.StatusUnauthorized {
+		t.Errorf("bad signature: got %d, want 401", code)
+	}
+	if code := postRaw(h, fresh, ""); code != http.StatusUnauthorized {
+		t.Errorf("no signature: got %d, want 401", code)
+	}
+	stale, _ := json.Marshal(delivery{Action: "create", Type: "Issue", Data: raw, WebhookTimestamp: time.Now().Add(-time.Hour).UnixMilli()})
+	if code := postRaw(h, stale, sign(testSecret, stale)); code != http.StatusUnauthorized {
+		t.Errorf("stale delivery: got %d, want 401", code)
+	}
+
+	if _, err := store.Queries().GetIssueByID(context.Background(), issue.ID); err == nil {
+		t.Error("an unverified delivery was applied")
+	}
+	if len(rec.changes) != 0 {
+		t.Errorf("unverified deliveries reported %d changes", len(rec.changes))
+	}
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET: got %d, want 405", w.Code)
+	}
+}
+
+// TestServeHTTP_DeduplicatesDeliveries: a delivery ID already applied is
+// acknowledged and not applied again, even re-signed under a fresh timestamp;
+// an ID whose apply failed is forgotten, so Linear's retry applies.
+func TestServeHTTP_DeduplicatesDeliveries(t *testing.T) {
+	h, store, rec := newTestHandler(t)
+	issue := fixtures.FixtureAPIIssue()
+	raw, _ := json.Marshal(issuePayload(t, issue))
+	body, _ := json.Marshal(delivery{Action: "create", Type: "Issue", Data: raw, WebhookTimestamp: time.Now().UnixMilli()})
+
+	if code := postDelivery(h, body, sign(testSecret, body), "d-1"); code != http.StatusOK {
+		t.Fatalf("first delivery: got %d, want 200", code)
+	}
+	again, _ := json.Marshal(delivery{Action: "create", Type: "Issue", Data: raw, WebhookTimestamp: time.Now().UnixMilli() + 1})
+	if code := postDelivery(h, again, sign(testSecret, again), "d-1"); code != http.StatusOK {
+		t.Errorf("repeated delivery: got %d, want 200", code)
+	}
+	if code := postRaw(h, body, sign(testSecret, body)); code != http.StatusOK {
+		t.Errorf("first ID-less delivery: got %d, want 200", code)
+	}
+	if code := postRaw(h, body, sign(testSecret, body)); code != http.StatusOK {
+		t.Errorf("replayed ID-less delivery: got %d, want 200", code)
+	}
+	if len(rec.changes) != 2 {
+		t.Errorf("changes = %d, want 2 (each repeat ignored)", len(rec.changes))
+	}
+
+	// A delivery whose apply fails leaves no ID behind.
+	bad, _ := json.Marshal(delivery{Action: "create", Type: "Issue", Data: json.RawMessage(`{"title":"no id"}`), WebhookTimestamp: time.Now().UnixMilli()})
+	for i := range 2 {
+		if code := postDelivery(h, bad, sign(testSecret, bad), "d-2"); code != http.StatusInternalServerError {
+			t.Errorf("failing delivery attempt %d: got %d, want 500 (applied again, not deduplicated)", i, code)
+		}
+	}
+	var n int
+	if err := store.DB().QueryRow("SELECT COUNT(*) FROM webhook_deliveries").Scan(&n); err != nil || n != 2 {
+		t.Errorf("recorded deliveries = %d (%v), want 2", n, err)
+	}
+}
+
+// TestFailures logs refused, failed, and repeated deliveries, newest last,
+// and keeps at most maxFailures.
+func TestFailures(t *testing.T) {
+	h, _, _ := newTestHandler(t)
+	raw, _ := json.Marshal(issuePayload(t, fixtures.FixtureAPIIssue()))
+	body, _ := json.Marshal(delivery{Action: "update", Type: "Issue", Data: raw, WebhookTimestamp: time.Now().UnixMilli()})
+
+	postDelivery(h, body, sign("wrong", body), "d-bad")
+	postDelivery(h, body, sign(testSecret, body), "d-ok")
+	postDelivery(h, body, sign(testSecret, body), "d-ok")
+
+	got := h.Failures()
+	if len(got) != 2 {
+		t.Fatalf("failures = %+v, want the bad signature and the repeat", got)
+	}
+	if f := got[0]; f.Delivery != "d-bad" || f.Status != http.StatusUnauthorized || f.Error != "bad signature" || f.Type != "" {
+		t.Errorf("failures[0] = %+v", f)
+	}
+	if f := got[1]; f.Delivery != "d-ok" || f.Status != http.StatusOK || f.Type != "Issue" || f.Action != "update" || !strings.Contains(f.Error, "duplicate") {
+		t.Errorf("failures[1] = %+v", f)
+	}
+
+	for i := range maxFailures {
+		postDelivery(h, body, "", fmt.Sprintf("d-%d", i))
+	}
+	got = h.Failures()
+	if len(got) != maxFailures || got[len(got)-1].Delivery != fmt.Sprintf("d-%d", maxFailures-1) {
+		t.Errorf("after overflow: %d failures, newest %q; want %d ending at the last", len(got), got[len(got)-1].Delivery, maxFailures)
+	}
+}
+
+func TestApplyIssue_Lifecycle(t *testing.T) {
+	h, store, rec := newTestHandler(t)
+	ctx := context.Background()
+	issue := fixtures.FixtureAPIIssue()
+
+	if code := post(t, h, "create", "Issue", issuePayload(t, issue)); code != http.StatusOK {
+		t.Fatalf("create: got %d", code)
+	}
+	row, err := store.Queries().GetIssueByID(ctx, issue.ID)
+	if err != nil {
+		t.Fatalf("created issue not in store: %v", err)
+	}
+	if row.Title != issue.Title {
+		t.Errorf("title = %q, want %q", row.Title, issue.Title)
+	}
+
+	// An update carries only what changed, plus a cleared assignee; the
+	// rest of the row must survive.
+	update := map[string]any{
+		"id":         issue.ID,
+		"title":      "Renamed",
+		"assigneeId": nil,
+		"updatedAt":  issue.UpdatedAt.Add(time.Hour),
+	}
+	if code := post(t, h, "update", "Issue", update); code != http.StatusOK {
+		t.Fatalf("update: got %d", code)
+	}
+	got, err := h.cachedIssue(ctx, issue.ID)
+	if err != nil || got == nil {
+		t.Fatalf("updated issue: %v", err)
+	}
+	if got.Title != "Renamed" || got.Assignee != nil {
+		t.Errorf("after update: title %q, assignee %v", got.Title, got.Assignee)
+	}
+	if got.Description != issue.Description || got.Team == nil || got.Team.Key != issue.Team.Key {
+		t.Errorf("update dropped fields it did not carry: %+v", got)
+	}
+
+	// An older delivery arriving late must not roll the row back.
+	stale := map[string]any{"id": issue.ID, "title": "Old", "updatedAt": issue.UpdatedAt}
+	if code := post(t, h, "update", "Issue", stale); code != http.StatusOK {
+		t.Fatalf("stale update: got %d", code)
+	}
+	if got, _ := h.cachedIssue(ctx, issue.ID); got.Title != "Renamed" {
+		t.Errorf("out-of-order delivery overwrote title: %q", got.Title)
+	}
+
+	if code := post(t, h, "remove", "Issue", map[string]any{"id": issue.ID}); code != http.StatusOK {
+		t.Fatalf("remove: got %d", code)
+	}
+	if got, _ := h.cachedIssue(ctx, issue.ID); got != nil {
+		t.Error("removed issue still in store")
+	}
+
+	var actions []string
+	for _, c := range rec.changes {
+		actions = append(actions, c.Action)
+	}
+	if strings.Join(actions, ",") != "created,updated,removed" {
+		t.Errorf("reported actions = %v", actions)
+	}
+	if last := rec.changes[len(rec.changes)-1]; last.Previous == nil || last.Identifier != issue.Identifier {
+		t.Errorf("removed change lacks the deleted row: %+v", last)
+	}
+}
+
+// TestApplyIssue_Archive: an archive delivery moves the issue from issues to
+// archived_issues, and an unarchive moves it back.
+func TestApplyIssue_Archive(t *testing.T) {
+	h, store, _ := newTestHandler(t)
+	ctx := context.Background()
+	q := store.Queries()
+	issue := fixtures.FixtureAPIIssue()
+	if code := post(t, h, "create", "Issue", issuePayload(t, issue)); code != http.StatusOK {
+		t.Fatalf("create: got %d", code)
+	}
+
+	archivedAt := issue.UpdatedAt.Add(time.Hour)
+	archive := map[string]any{"id": issue.ID, "archivedAt": archivedAt, "updatedAt": archivedAt}
+	if code := post(t, h, "update", "Issue", archive); code != http.StatusOK {
+		t.Fatalf("archive: got %d", code)
+	}
+	if got, _ := h.cachedIssue(ctx, issue.ID); got != nil {
+		t.Error("archived issue still in issues")
+	}
+	row, err := q.GetArchivedIssueByIdentifier(ctx, issue.Identifier)
+	if err != nil || row.Title != issue.Title || !row.ArchivedAt.Equal(archivedAt) {
+		t.Errorf("archived row = %+v, %v", row, err)
+	}
+
+	unarchived := issuePayload(t, issue)
+	unarchived["updatedAt"] = archivedAt.Add(time.Hour)
+	if code := post(t, h, "update", "Issue", unarchived); code != http.StatusOK {
+		t.Fatalf("unarchive: got %d", code)
+	}
+	if _, err := q.GetArchivedIssueByIdentifier(ctx, issue.Identifier); err == nil {
+		t.Error("unarchived issue still in archived_issues")
+	}
+	if got, _ := h.cachedIssue(ctx, issue.ID); got == nil {
+		t.Error("unarchived issue not back in issues")
+	}
+}
+
+// TestApplyIssue_StaleArchiveMoves: a delivery older than the row either
+// table holds moves nothing — a stale archive leaves the live issue, and a
+// stale update leaves a newer archived one archived.
+func TestApplyIssue_StaleArchiveMoves(t *testing.T) {
+	h, store, _ := newTestHandler(t)
+	ctx := context.Background()
+	q := store.Queries()
+	issue := fixtures.FixtureAPIIssue()
+	if code := post(t, h, "create", "Issue", issuePayload(t, issue)); code != http.StatusOK {
+		t.Fatalf("create: got %d", code)
+	}
+
+	before := issue.UpdatedAt.Add(-time.Hour)
+	stale := map[string]any{"id": issue.ID, "archivedAt": before, "updatedAt": before}
+	if code := post(t, h, "update", "Issue", stale); code != http.StatusOK {
+		t.Fatalf("stale archive: got %d", code)
+	}
+	if got, _ := h.cachedIssue(ctx, issue.ID); got == nil {
+		t.Error("stale archive removed the live issue")
+	}
+	if _, err := q.GetArchivedIssueByID(ctx, issue.ID); err == nil {
+		t.Error("stale archive wrote an archived row")
+	}
+
+	archivedAt := issue.UpdatedAt.Add(time.Hour)
+	archive := map[string]any{"id": issue.ID, "archivedAt": archivedAt, "updatedAt": archivedAt}
+	if code := post(t, h, "update", "Issue", archive); code != http.StatusOK {
+		t.Fatalf("archive: got %d", code)
+	}
+	if code := post(t, h, "update", "Issue", issuePayload(t, issue)); code != http.StatusOK {
+		t.Fatalf("stale update: got %d", code)
+	}
+	if _, err := q.GetArchivedIssueByID(ctx, issue.ID); err != nil {
+		t.Errorf("stale update dropped the archived row: %v", err)
+	}
+	if got, _ := h.cachedIssue(ctx, issue.ID); got != nil {
+		t.Error("stale update pulled the archived issue back into issues")
+	}
+}
+
+func TestApplyComment_CreateAndRemove(t *testing.T) {
+	h, store, rec := newTestHandler(t)
+	ctx := context.Background()
+	issue := fixtures.FixtureAPIIssue()
+	if code := post(t, h, "create", "Issue", issuePayload(t, issue)); code != http.StatusOK {
+		t.Fatalf("create issue: got %d", code)
+	}
+
+	comment := map[string]any{
+		"id":        "comment-1",
+		"body":      "hello",
+		"issueId":   issue.ID,
+		"createdAt": issue.CreatedAt,
+		"updatedAt": issue.CreatedAt,
+	}
+	if code := post(t, h, "create", "Comment", comment); code != http.StatusOK {
+		t.Fatalf("create comment: got %d", code)
+	}
+	rows, err := store.Queries().ListIssueComments(ctx, issue.ID)
+	if err != nil || len(rows) != 1 || rows[0].Body != "hello" {
+		t.Fatalf("comments after create = %v, %v", rows, err)
+	}
+
+	if code := post(t, h, "remove", "Comment", comment); code != http.StatusOK {
+		t.Fatalf("remove comment: got %d", code)
+	}
+	if rows, _ := store.Queries().ListIssueComments(ctx, issue.ID); len(rows) != 0 {
+		t.Errorf("removed comment still listed: %v", rows)
+	}
+	if rows, _ := store.Queries().ListIssueCommentsWithDeleted(ctx, issue.ID); len(rows) != 1 {
+		t.Errorf("removed comment not tombstoned: %v", rows)
+	}
+
+	last := rec.changes[len(rec.changes)-1]
+	if last.Entity != "comment" || last.Action != "removed" || last.IssueID != issue.ID || last.Identifier != issue.Identifier {
+		t.Errorf("removed comment change = %+v", last)
+	}
+}
+
+func TestApply_UnsignedEvent(t *testing.T) {
+	h, store, rec := newTestHandler(t)
+	ctx := context.Background()
+	issue := fixtures.FixtureAPIIssue()
+	raw, err := json.Marshal(issuePayload(t, issue))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := json.Marshal(delivery{Action: "create", Type: "Issue", Data: raw})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Apply(ctx, event); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if _, err := store.Queries().GetIssueByID(ctx, issue.ID); err != nil {
+		t.Errorf("applied issue not stored: %v", err)
+	}
+	if len(rec.changes) != 1 || rec.changes[0].ID != issue.ID {
+		t.Errorf("changes = %+v", rec.changes)
+	}
+	if f := h.Failures(); len(f) != 0 {
+		t.Errorf("failures = %+v", f)
+	}
+
+	if err := h.Apply(ctx, []byte("{")); err == nil {
+		t.Error("Apply(malformed) = nil, want error")
+	}
+}
+
+func TestApplyProject_TeamAssociation(t *testing.T) {
+	h, store, rec := newTestHandler(t)
+	ctx := context.Background()
+	project := api.Project{ID: "project-1", Name: "Launch", Slug: "launch", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	data := struct {
+		api.Project
+		TeamIDs []string `json:"teamIds"`
+	}{project, []string{"team-1"}}
+
+	if code := post(t, h, "create", "Project", data); code != http.StatusOK {
+		t.Fatalf("create project: got %d", code)
+	}
+	listed, err := store.Queries().ListTeamProjects(ctx, "team-1")
+	if err != nil || len(listed) != 1 || listed[0].ID != project.ID {
+		t.Fatalf("team projects = %v, %v", listed, err)
+	}
+
+	if code := post(t, h, "remove", "Project", data); code != http.StatusOK {
+		t.Fatalf("remove project: got %d", code)
+	}
+	if listed, _ := store.Queries().ListTeamProjects(ctx, "team-1"); len(listed) != 0 {
+		t.Errorf("removed project still listed: %v", listed)
+	}
+	if len(rec.changes) != 2 || rec.changes[1].Action != "removed" || len(rec.changes[1].TeamIDs) != 1 {
+		t.Errorf("project changes = %+v", rec.changes)
+	}
+}